@@ -19,17 +19,12 @@
 package gateway
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/wso2/api-platform/cli/internal/gateway"
 	"github.com/wso2/api-platform/cli/utils"
-	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -39,17 +34,33 @@ ap gateway apply --file petstore-api.yaml
 ap gateway apply -f petstore-api.yaml
 
 # Apply a resource from a JSON file
-ap gateway apply --file petstore-api.json`
+ap gateway apply --file petstore-api.json
+
+# Apply every manifest in a directory tree (multi-document YAML is supported)
+ap gateway apply -f gateway/ --recursive
+
+# Show what would change without modifying the gateway
+ap gateway apply -f gateway/ -R --dry-run
+
+# Also delete resources labelled team=payments that are no longer in the directory
+ap gateway apply -f gateway/ -R --prune -l team=payments`
 )
 
 var (
-	applyFilePath string
+	applyFilePath  string
+	applyRecursive bool
+	applyDryRun    bool
+	applyPrune     bool
+	applySelector  string
 )
 
 var applyCmd = &cobra.Command{
-	Use:     ApplyCmdLiteral,
-	Short:   "Apply a resource to the gateway",
-	Long:    "Create or update a gateway resource (API, MCP proxy, etc.) from a YAML or JSON file.",
+	Use:   ApplyCmdLiteral,
+	Short: "Apply resources to the gateway",
+	Long: "Create or update gateway resources (APIs, MCP proxies, LLM providers, secrets, certificates, " +
+		"subscription plans, etc.) from a YAML or JSON file or a directory of them. Resources are applied " +
+		"in dependency order: secrets and certificates, subscription plans, LLM provider templates, " +
+		"providers, proxies, then APIs.",
 	Example: ApplyCmdExample,
 	Run: func(cmd *cobra.Command, args []string) {
 		if err := runApplyCommand(); err != nil {
@@ -60,52 +71,32 @@ var applyCmd = &cobra.Command{
 }
 
 func init() {
-	utils.AddStringFlag(applyCmd, utils.FlagFile, &applyFilePath, "", "Path to the resource file")
+	utils.AddStringFlag(applyCmd, utils.FlagFile, &applyFilePath, "", "Path to a resource file or a directory of resource files")
+	utils.AddBoolFlag(applyCmd, utils.FlagRecursive, &applyRecursive, false, "Read sub-directories of the --file directory")
+	utils.AddBoolFlag(applyCmd, utils.FlagDryRun, &applyDryRun, false, "Print the changes without applying them")
+	utils.AddBoolFlag(applyCmd, utils.FlagPrune, &applyPrune, false, "Delete live resources matching --selector that are not in the manifests")
+	utils.AddStringFlag(applyCmd, utils.FlagSelector, &applySelector, "", "Label selector (key=value[,key=value]) restricting --prune")
 	applyCmd.MarkFlagRequired(utils.FlagFile)
 }
 
-// ResourceMetadata represents the metadata section of a resource
-type ResourceMetadata struct {
-	Name string `yaml:"name"`
-}
-
-// ResourceDefinition represents the basic structure of a resource file
-type ResourceDefinition struct {
-	Kind     string           `yaml:"kind"`
-	Metadata ResourceMetadata `yaml:"metadata"`
-}
-
 func runApplyCommand() error {
-	// Read the file
-	fileContent, err := os.ReadFile(applyFilePath)
-	if err != nil {
-		return fmt.Errorf("failed to read file: %w", err)
+	prune := gateway.PruneOptions{Enabled: applyPrune}
+	if applyPrune {
+		if applySelector == "" {
+			return fmt.Errorf("--%s requires --%s", utils.FlagPrune, utils.FlagSelector)
+		}
+		selector, err := gateway.ParseLabelSelector(applySelector)
+		if err != nil {
+			return err
+		}
+		prune.Selector = selector
+	} else if applySelector != "" {
+		return fmt.Errorf("--%s is only used with --%s", utils.FlagSelector, utils.FlagPrune)
 	}
 
-	// Detect if the content is JSON and convert to YAML if needed
-	fileContent, err = utils.ConvertJSONToYAMLIfNeeded(fileContent)
+	resources, err := gateway.LoadResources(applyFilePath, applyRecursive)
 	if err != nil {
-		return fmt.Errorf("failed to process file content: %w", err)
-	}
-
-	// Parse the YAML to extract kind and metadata.name
-	var resourceDef ResourceDefinition
-	if err := yaml.Unmarshal(fileContent, &resourceDef); err != nil {
-		return fmt.Errorf("failed to parse YAML: %w", err)
-	}
-
-	// Validate required fields
-	if resourceDef.Kind == "" {
-		return fmt.Errorf("'kind' field is required in the resource file")
-	}
-	if resourceDef.Metadata.Name == "" {
-		return fmt.Errorf("'metadata.name' field is required in the resource file")
-	}
-
-	// Get the resource handler for this kind
-	handler := gateway.GetResourceHandler(resourceDef.Kind)
-	if handler == nil {
-		return fmt.Errorf("unsupported resource kind: %s", resourceDef.Kind)
+		return err
 	}
 
 	// Create a gateway client for the active gateway
@@ -114,131 +105,50 @@ func runApplyCommand() error {
 		return err
 	}
 
-	// Create the resource object
-	resource := gateway.Resource{
-		Kind:    resourceDef.Kind,
-		Handle:  resourceDef.Metadata.Name,
-		RawYAML: fileContent,
-	}
-
-	// Apply the resource (check if exists, then create or update)
-	return applyResource(client, handler, resource)
-}
-
-func applyResource(client *gateway.Client, handler gateway.ResourceHandler, resource gateway.Resource) error {
-	// Check if the resource already exists
-	exists, err := resourceExists(client, handler, resource.Handle)
-	if err != nil {
-		return fmt.Errorf("failed to check resource existence: %w", err)
-	}
-
-	var resp *http.Response
-	var operation string
-
-	if exists {
-		// Update existing resource
-		operation = "update"
-		endpoint := handler.UpdateEndpoint(resource.Handle)
-		resp, err = client.PutYAML(endpoint, bytes.NewReader(resource.RawYAML))
-	} else {
-		// Create new resource
-		operation = "create"
-		endpoint := handler.CreateEndpoint()
-		resp, err = client.PostYAML(endpoint, bytes.NewReader(resource.RawYAML))
-	}
-
-	if err != nil {
-		return fmt.Errorf("failed to %s resource: %w", operation, err)
-	}
-	defer resp.Body.Close()
-
-	// Read the success response body
-	body, err := io.ReadAll(resp.Body)
+	changes, err := gateway.PlanApply(client, resources, prune)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
-	}
-
-	// Parse the success response
-	var responseData map[string]interface{}
-	if err := json.Unmarshal(body, &responseData); err != nil {
-		// If we can't parse JSON, just show success
-		fmt.Println("Status: success")
-		if exists {
-			fmt.Printf("Message: %s updated successfully\n", resource.Kind)
-		} else {
-			fmt.Printf("Message: %s applied successfully\n", resource.Kind)
-		}
-		fmt.Printf("ID: %s\n", resource.Handle)
-		return nil
-	}
-
-	// Display the response. Management API POST/PUT responses are now the full
-	// k8s-shaped resource body ({apiVersion, kind, metadata, spec, status}).
-	// The server-managed status block carries the id/state/timestamps.
-	fmt.Println("Status: success")
-
-	if exists {
-		fmt.Printf("Message: %s updated successfully\n", resource.Kind)
-	} else {
-		fmt.Printf("Message: %s applied successfully\n", resource.Kind)
+		return err
 	}
 
-	// Id: prefer status.id (server-assigned handle), then metadata.name, then
-	// the handle parsed from the applied YAML.
-	id := resource.Handle
-	if status, ok := responseData["status"].(map[string]interface{}); ok {
-		if v, ok := status["id"].(string); ok && v != "" {
-			id = v
-		}
+	suffix := ""
+	if applyDryRun {
+		suffix = " (dry run)"
 	}
-	if id == resource.Handle {
-		if md, ok := responseData["metadata"].(map[string]interface{}); ok {
-			if name, ok := md["name"].(string); ok && name != "" {
-				id = name
+	counts := map[gateway.ChangeAction]int{}
+	for _, ch := range changes {
+		if !applyDryRun {
+			if err := gateway.ExecuteChange(client, ch); err != nil {
+				return fmt.Errorf("failed to %s %s '%s': %w", ch.Action, ch.Kind, ch.Handle, err)
 			}
 		}
-	}
-	fmt.Printf("ID: %s\n", id)
-
-	// Timestamps: read from the new status block, fall back to legacy fields
-	// for backwards compatibility with any non-k8s-shaped resources.
-	if status, ok := responseData["status"].(map[string]interface{}); ok {
-		if v, ok := status["createdAt"].(string); ok && v != "" {
-			fmt.Printf("Created At: %s\n", v)
-		}
-		if v, ok := status["updatedAt"].(string); ok && v != "" {
-			fmt.Printf("Updated At: %s\n", v)
-		}
-		if v, ok := status["state"].(string); ok && v != "" {
-			fmt.Printf("State: %s\n", v)
-		}
-	} else {
-		if v, ok := responseData["createdAt"].(string); ok {
-			fmt.Printf("Created At: %s\n", v)
-		} else if v, ok := responseData["timestamp"].(string); ok {
-			fmt.Printf("Timestamp: %s\n", v)
+		counts[ch.Action]++
+		fmt.Printf("%s/%s %s%s\n", ch.Kind, ch.Handle, applyActionPastTense(ch.Action), suffix)
+		if applyDryRun {
+			printFieldDiffs(ch, "    ")
 		}
 	}
-
+	fmt.Printf("\n%d created, %d updated, %d unchanged, %d deleted%s\n",
+		counts[gateway.ChangeCreate], counts[gateway.ChangeUpdate],
+		counts[gateway.ChangeUnchanged], counts[gateway.ChangeDelete], suffix)
 	return nil
 }
 
-func resourceExists(client *gateway.Client, handler gateway.ResourceHandler, handle string) (bool, error) {
-	endpoint := handler.GetEndpoint(handle)
-	resp, err := client.Get(endpoint)
-	if err != nil {
-		return false, err
+func applyActionPastTense(a gateway.ChangeAction) string {
+	switch a {
+	case gateway.ChangeCreate:
+		return "created"
+	case gateway.ChangeUpdate:
+		return "updated"
+	case gateway.ChangeDelete:
+		return "deleted"
+	default:
+		return "unchanged"
 	}
-	defer resp.Body.Close()
+}
 
-	// 200 means it exists, 404 means it doesn't
-	if resp.StatusCode == http.StatusOK {
-		return true, nil
-	}
-	if resp.StatusCode == http.StatusNotFound {
-		return false, nil
+// printFieldDiffs prints the field-level changes of an update, masking secret material.
+func printFieldDiffs(ch gateway.Change, indent string) {
+	for _, d := range ch.Diffs {
+		fmt.Printf("%s%s\n", indent, gateway.FormatFieldDiff(d, gateway.IsSensitiveField(ch.Kind, d.Path)))
 	}
-
-	// Any other status code is an error — delegate to centralized formatter
-	return false, utils.FormatHTTPError("query", resp, "Gateway Controller")
 }
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/wso2/api-platform/cli/internal/gateway"
+	"github.com/wso2/api-platform/cli/utils"
+)
+
+const (
+	DiffCmdLiteral = "diff"
+	DiffCmdExample = `# Compare a resource file with the live resource on the gateway
+ap gateway diff -f petstore-api.yaml
+
+# Compare a directory tree of manifests
+ap gateway diff -f gateway/ --recursive`
+)
+
+var (
+	diffFilePath  string
+	diffRecursive bool
+)
+
+var diffCmd = &cobra.Command{
+	Use:   DiffCmdLiteral,
+	Short: "Show differences between resource files and the gateway",
+	Long: "Compare resources from a YAML or JSON file or a directory of them with the live resources on the " +
+		"gateway and print the fields that `ap gateway apply` would change. Secret values are masked.",
+	Example: DiffCmdExample,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runDiffCommand(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	utils.AddStringFlag(diffCmd, utils.FlagFile, &diffFilePath, "", "Path to a resource file or a directory of resource files")
+	utils.AddBoolFlag(diffCmd, utils.FlagRecursive, &diffRecursive, false, "Read sub-directories of the --file directory")
+	diffCmd.MarkFlagRequired(utils.FlagFile)
+}
+
+func runDiffCommand() error {
+	resources, err := gateway.LoadResources(diffFilePath, diffRecursive)
+	if err != nil {
+		return err
+	}
+
+	// Create a gateway client for the active gateway
+	client, err := gateway.NewClientForActive()
+	if err != nil {
+		return err
+	}
+
+	changes, err := gateway.PlanApply(client, resources, gateway.PruneOptions{})
+	if err != nil {
+		return err
+	}
+
+	differences := 0
+	for _, ch := range changes {
+		switch ch.Action {
+		case gateway.ChangeCreate:
+			fmt.Printf("+ %s/%s (not on the gateway)\n", ch.Kind, ch.Handle)
+		case gateway.ChangeUpdate:
+			fmt.Printf("~ %s/%s\n", ch.Kind, ch.Handle)
+			printFieldDiffs(ch, "    ")
+		default:
+			continue
+		}
+		differences++
+	}
+	if differences == 0 {
+		fmt.Println("No differences found.")
+	}
+	return nil
+}
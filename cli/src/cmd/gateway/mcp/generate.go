@@ -34,13 +34,18 @@ const (
 ap gateway mcp generate --server http://localhost:3001/mcp --output target
 
 # Generate MCP configuration with default output directory (current directory)
-ap gateway mcp generate --server http://localhost:3001/mcp`
+ap gateway mcp generate --server http://localhost:3001/mcp
+
+# Generate MCP configuration that exposes a deployed REST API as tools
+ap gateway mcp generate --from-openapi reading-list.yaml --rest-api reading-list-api-v1.0 --output target`
 )
 
 var (
-	generateServer string
-	generateOutput string
-	generateHeader string
+	generateServer      string
+	generateOutput      string
+	generateHeader      string
+	generateFromOpenAPI string
+	generateRestAPI     string
 )
 
 var generateCmd = &cobra.Command{
@@ -63,14 +68,28 @@ func init() {
 		cwd = "."
 	}
 
-	utils.AddStringFlag(generateCmd, utils.FlagServer, &generateServer, "", "MCP server URL (required)")
+	utils.AddStringFlag(generateCmd, utils.FlagServer, &generateServer, "", "MCP server URL")
 	utils.AddStringFlag(generateCmd, utils.FlagOutput, &generateOutput, cwd, "Output directory for generated configuration")
 	utils.AddStringFlag(generateCmd, utils.FlagHeader, &generateHeader, "", "HTTP header to include in MCP requests. Format: 'Name: Value'")
+	utils.AddStringFlag(generateCmd, utils.FlagFromOpenAPI, &generateFromOpenAPI, "", "OpenAPI definition of a deployed REST API to expose as MCP tools")
+	utils.AddStringFlag(generateCmd, utils.FlagRestAPI, &generateRestAPI, "", "Handle of the deployed REST API (required with --from-openapi)")
 
-	generateCmd.MarkFlagRequired(utils.FlagServer)
+	generateCmd.MarkFlagsOneRequired(utils.FlagServer, utils.FlagFromOpenAPI)
+	generateCmd.MarkFlagsMutuallyExclusive(utils.FlagServer, utils.FlagFromOpenAPI)
+	generateCmd.MarkFlagsMutuallyExclusive(utils.FlagFromOpenAPI, utils.FlagHeader)
 }
 
 func runGenerateCommand() error {
+	if generateFromOpenAPI != "" {
+		if strings.TrimSpace(generateRestAPI) == "" {
+			return fmt.Errorf("--%s is required with --%s", utils.FlagRestAPI, utils.FlagFromOpenAPI)
+		}
+		return mcpgen.GenerateFromOpenAPI(generateFromOpenAPI, strings.TrimSpace(generateRestAPI), generateOutput)
+	}
+	if generateRestAPI != "" {
+		return fmt.Errorf("--%s can only be used with --%s", utils.FlagRestAPI, utils.FlagFromOpenAPI)
+	}
+
 	var headerName, headerValue string
 	if strings.TrimSpace(generateHeader) != "" {
 		parts := strings.SplitN(generateHeader, ":", 2)
@@ -54,6 +54,7 @@ func init() {
 	GatewayCmd.AddCommand(currentCmd)
 	GatewayCmd.AddCommand(healthCmd)
 	GatewayCmd.AddCommand(applyCmd)
+	GatewayCmd.AddCommand(diffCmd)
 	GatewayCmd.AddCommand(image.ImageCmd)
 	GatewayCmd.AddCommand(restapi.APICmd)
 	GatewayCmd.AddCommand(mcp.McpCmd)
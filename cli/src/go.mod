@@ -3,6 +3,7 @@ module github.com/wso2/api-platform/cli
 go 1.26.2
 
 require (
+	github.com/getkin/kin-openapi v0.133.0
 	github.com/spf13/cobra v1.10.1
 	github.com/wso2/api-platform/gateway/gateway-controller v1.0.0
 	golang.org/x/term v0.40.0
@@ -17,7 +18,6 @@ require (
 	github.com/bytedance/sonic/loader v0.4.0 // indirect
 	github.com/cloudwego/base64x v0.1.6 // indirect
 	github.com/gabriel-vasile/mimetype v1.4.12 // indirect
-	github.com/getkin/kin-openapi v0.133.0 // indirect
 	github.com/gin-contrib/sse v1.1.0 // indirect
 	github.com/gin-gonic/gin v1.11.0 // indirect
 	github.com/go-openapi/jsonpointer v0.21.0 // indirect
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ChangeAction is what apply does with one resource.
+type ChangeAction string
+
+const (
+	ChangeCreate    ChangeAction = "create"
+	ChangeUpdate    ChangeAction = "update"
+	ChangeUnchanged ChangeAction = "unchanged"
+	ChangeDelete    ChangeAction = "delete"
+)
+
+// Change is one planned step of an apply.
+type Change struct {
+	Kind   string
+	Handle string
+	// Source is the manifest the resource came from; empty for pruned resources.
+	Source string
+	Action ChangeAction
+	// Diffs lists the changed fields of an update.
+	Diffs []FieldDiff
+
+	resource *Resource
+	live     map[string]interface{}
+}
+
+// PruneOptions enables deletion of live resources that are missing from the manifests.
+type PruneOptions struct {
+	Enabled bool
+	// Selector restricts pruning to live resources carrying all of these labels.
+	Selector map[string]string
+}
+
+// ParseLabelSelector parses an equality selector such as "team=payments,env=prod".
+func ParseLabelSelector(s string) (map[string]string, error) {
+	selector := map[string]string{}
+	for _, term := range strings.Split(s, ",") {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(term, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" || strings.Contains(v, "=") {
+			return nil, fmt.Errorf("invalid label selector term '%s': expected key=value", term)
+		}
+		selector[k] = strings.TrimSpace(v)
+	}
+	if len(selector) == 0 {
+		return nil, fmt.Errorf("label selector must not be empty")
+	}
+	return selector, nil
+}
+
+// PlanApply computes the changes that bring the gateway in line with resources, which must already
+// be in dependency order (see LoadResources). Only reads are made against the gateway.
+func PlanApply(c *Client, resources []Resource, prune PruneOptions) ([]Change, error) {
+	changes := make([]Change, 0, len(resources))
+	desired := make(map[string]struct{}, len(resources))
+	for i := range resources {
+		r := &resources[i]
+		desired[r.Kind+"/"+r.Handle] = struct{}{}
+
+		store := resourceStoreFor(r.Kind)
+		live, err := store.get(c, r.Handle)
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to read %s '%s': %w", r.Source, r.Kind, r.Handle, err)
+		}
+		ch := Change{Kind: r.Kind, Handle: r.Handle, Source: r.Source, resource: r, live: live}
+		if live == nil {
+			ch.Action = ChangeCreate
+		} else {
+			want, err := store.desired(*r)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", r.Source, err)
+			}
+			ch.Diffs = DiffResource(want, live)
+			if len(ch.Diffs) == 0 {
+				ch.Action = ChangeUnchanged
+			} else {
+				ch.Action = ChangeUpdate
+			}
+		}
+		changes = append(changes, ch)
+	}
+
+	if !prune.Enabled {
+		return changes, nil
+	}
+	if len(prune.Selector) == 0 {
+		return nil, fmt.Errorf("prune requires a label selector")
+	}
+	for i := len(applyOrder) - 1; i >= 0; i-- {
+		kind := applyOrder[i]
+		store := resourceStoreFor(kind)
+		if !store.prunable() {
+			continue
+		}
+		items, err := store.list(c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s resources for prune: %w", kind, err)
+		}
+		var pruned []Change
+		for _, item := range items {
+			name, labels := itemNameAndLabels(item)
+			if name == "" || !labelsMatch(labels, prune.Selector) {
+				continue
+			}
+			if _, keep := desired[kind+"/"+name]; keep {
+				continue
+			}
+			pruned = append(pruned, Change{Kind: kind, Handle: name, Action: ChangeDelete, live: item})
+		}
+		sort.Slice(pruned, func(a, b int) bool { return pruned[a].Handle < pruned[b].Handle })
+		changes = append(changes, pruned...)
+	}
+	return changes, nil
+}
+
+// ExecuteChange performs ch against the gateway. Unchanged resources are left alone.
+func ExecuteChange(c *Client, ch Change) error {
+	store := resourceStoreFor(ch.Kind)
+	switch ch.Action {
+	case ChangeCreate:
+		return store.create(c, *ch.resource)
+	case ChangeUpdate:
+		return store.update(c, *ch.resource, ch.live)
+	case ChangeDelete:
+		return store.remove(c, ch.Handle, ch.live)
+	default:
+		return nil
+	}
+}
+
+func itemNameAndLabels(item map[string]interface{}) (string, map[string]interface{}) {
+	md, _ := item["metadata"].(map[string]interface{})
+	name, _ := md["name"].(string)
+	labels, _ := md["labels"].(map[string]interface{})
+	return name, labels
+}
+
+func labelsMatch(labels map[string]interface{}, selector map[string]string) bool {
+	for k, v := range selector {
+		got, ok := labels[k]
+		if !ok || fmt.Sprint(got) != v {
+			return false
+		}
+	}
+	return true
+}
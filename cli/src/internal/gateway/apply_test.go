@@ -0,0 +1,209 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/wso2/api-platform/cli/internal/config"
+	"github.com/wso2/api-platform/cli/utils"
+)
+
+// fakeManagementAPI serves canned GET responses and records every write.
+type fakeManagementAPI struct {
+	mu     sync.Mutex
+	gets   map[string]interface{}
+	writes []string
+}
+
+func (f *fakeManagementAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.Method == http.MethodGet {
+		body, ok := f.gets[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+		return
+	}
+	payload, _ := io.ReadAll(r.Body)
+	f.writes = append(f.writes, r.Method+" "+r.URL.Path+" "+strings.TrimSpace(string(payload)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("{}"))
+}
+
+func newFakeClient(t *testing.T, f *fakeManagementAPI) *Client {
+	t.Helper()
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return NewClient(&config.Gateway{Name: "test", Server: srv.URL, Auth: utils.AuthTypeNone})
+}
+
+func restAPI(name, version string, labels map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"kind":     ResourceKindRestAPI,
+		"metadata": map[string]interface{}{"name": name, "labels": labels},
+		"spec":     map[string]interface{}{"version": version},
+	}
+}
+
+func TestPlanApply_CreateUpdateUnchangedAndPrune(t *testing.T) {
+	payments := map[string]interface{}{"team": "payments"}
+	f := &fakeManagementAPI{gets: map[string]interface{}{
+		utils.GatewayAPIsPath + "/petstore": restAPI("petstore", "v1.0", payments),
+		utils.GatewayAPIsPath + "/orders":   restAPI("orders", "v1.0", payments),
+		utils.GatewayAPIsPath: map[string]interface{}{"apis": []interface{}{
+			restAPI("petstore", "v1.0", payments),
+			restAPI("orders", "v1.0", payments),
+			restAPI("legacy", "v1.0", payments),
+			restAPI("billing", "v1.0", map[string]interface{}{"team": "billing"}),
+		}},
+	}}
+	c := newFakeClient(t, f)
+
+	resources, err := ParseResources([]byte(`kind: Secret
+metadata:
+  name: db-password
+spec:
+  value: s3cr3t
+---
+kind: RestApi
+metadata:
+  name: petstore
+  labels:
+    team: payments
+spec:
+  version: v1.1
+---
+kind: RestApi
+metadata:
+  name: orders
+  labels:
+    team: payments
+spec:
+  version: v1.0
+`), "apis.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	SortResourcesForApply(resources)
+
+	changes, err := PlanApply(c, resources, PruneOptions{Enabled: true, Selector: map[string]string{"team": "payments"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []string
+	for _, ch := range changes {
+		got = append(got, ch.Kind+"/"+ch.Handle+":"+string(ch.Action))
+	}
+	want := "Secret/db-password:create,RestApi/petstore:update,RestApi/orders:unchanged,RestApi/legacy:delete"
+	if strings.Join(got, ",") != want {
+		t.Fatalf("expected %s, got %s", want, strings.Join(got, ","))
+	}
+	if len(changes[1].Diffs) != 1 || changes[1].Diffs[0].Path != "spec.version" {
+		t.Errorf("expected a spec.version diff, got %+v", changes[1].Diffs)
+	}
+
+	for _, ch := range changes {
+		if err := ExecuteChange(c, ch); err != nil {
+			t.Fatalf("unexpected error executing %s/%s: %v", ch.Kind, ch.Handle, err)
+		}
+	}
+	if len(f.writes) != 3 {
+		t.Fatalf("expected 3 writes, got %v", f.writes)
+	}
+	if !strings.HasPrefix(f.writes[0], "POST "+utils.GatewaySecretsPath+" ") {
+		t.Errorf("expected secret create, got %s", f.writes[0])
+	}
+	if !strings.HasPrefix(f.writes[1], "PUT "+utils.GatewayAPIsPath+"/petstore ") {
+		t.Errorf("expected petstore update, got %s", f.writes[1])
+	}
+	if !strings.HasPrefix(f.writes[2], "DELETE "+utils.GatewayAPIsPath+"/legacy") {
+		t.Errorf("expected legacy delete, got %s", f.writes[2])
+	}
+}
+
+func TestPlanApply_PruneRequiresSelector(t *testing.T) {
+	c := newFakeClient(t, &fakeManagementAPI{})
+	_, err := PlanApply(c, nil, PruneOptions{Enabled: true})
+	if err == nil || !strings.Contains(err.Error(), "label selector") {
+		t.Fatalf("expected selector error, got %v", err)
+	}
+}
+
+func TestPlanApply_SubscriptionPlanMatchedByName(t *testing.T) {
+	f := &fakeManagementAPI{gets: map[string]interface{}{
+		utils.GatewaySubscriptionPlansPath: map[string]interface{}{"subscriptionPlans": []interface{}{
+			map[string]interface{}{
+				"id": "plan-1", "planName": "gold", "gatewayId": "gw", "createdAt": "2026-01-01T00:00:00Z",
+				"throttleLimitCount": 100, "throttleLimitUnit": "Min", "status": "ACTIVE",
+			},
+		}},
+	}}
+	c := newFakeClient(t, f)
+
+	resources, err := ParseResources([]byte(`kind: SubscriptionPlan
+metadata:
+  name: gold
+spec:
+  throttleLimitCount: 500
+  throttleLimitUnit: Min
+  status: ACTIVE
+`), "plans.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	changes, err := PlanApply(c, resources, PruneOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(changes) != 1 || changes[0].Action != ChangeUpdate {
+		t.Fatalf("expected one update, got %+v", changes)
+	}
+	if err := ExecuteChange(c, changes[0]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(f.writes) != 1 || !strings.HasPrefix(f.writes[0], "PUT "+utils.GatewaySubscriptionPlansPath+"/plan-1 ") ||
+		!strings.Contains(f.writes[0], `"planName":"gold"`) {
+		t.Errorf("unexpected writes %v", f.writes)
+	}
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	selector, err := ParseLabelSelector("team=payments, env=prod")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(selector) != 2 || selector["team"] != "payments" || selector["env"] != "prod" {
+		t.Errorf("unexpected selector %v", selector)
+	}
+	for _, bad := range []string{"", "team", "=x", "a=b=c"} {
+		if _, err := ParseLabelSelector(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FieldDiff is one field that differs between a manifest and the live resource.
+type FieldDiff struct {
+	Path string
+	// Old is the live value; nil when the manifest adds the field.
+	Old interface{}
+	// New is the manifest value; nil when the manifest drops the field.
+	New interface{}
+}
+
+// sensitiveValue replaces secret material in diff output.
+const sensitiveValue = "(sensitive)"
+
+// DiffResource compares desired (a manifest document) with live (the resource returned by the
+// gateway). Only fields set in the manifest are compared, so server-populated fields such as status
+// and defaults do not show up; metadata.labels and metadata.annotations are compared as a whole so
+// removed entries are reported. Lists are compared element by element.
+func DiffResource(desired, live map[string]interface{}) []FieldDiff {
+	var diffs []FieldDiff
+	diffValue("", normalizeJSON(desired), normalizeJSON(live), &diffs)
+	return diffs
+}
+
+func diffValue(path string, desired, live interface{}, diffs *[]FieldDiff) {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			if !reflect.DeepEqual(desired, live) {
+				*diffs = append(*diffs, FieldDiff{Path: path, Old: live, New: desired})
+			}
+			return
+		}
+		for _, k := range sortedKeys(d) {
+			diffValue(joinFieldPath(path, k), d[k], l[k], diffs)
+		}
+		if path == "metadata.labels" || path == "metadata.annotations" {
+			for _, k := range sortedKeys(l) {
+				if _, set := d[k]; !set {
+					*diffs = append(*diffs, FieldDiff{Path: joinFieldPath(path, k), Old: l[k]})
+				}
+			}
+		}
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			*diffs = append(*diffs, FieldDiff{Path: path, Old: live, New: desired})
+			return
+		}
+		for i := 0; i < len(d) || i < len(l); i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(l):
+				*diffs = append(*diffs, FieldDiff{Path: p, New: d[i]})
+			case i >= len(d):
+				*diffs = append(*diffs, FieldDiff{Path: p, Old: l[i]})
+			default:
+				diffValue(p, d[i], l[i], diffs)
+			}
+		}
+	default:
+		if !reflect.DeepEqual(desired, live) {
+			*diffs = append(*diffs, FieldDiff{Path: path, Old: live, New: desired})
+		}
+	}
+}
+
+// FormatFieldDiff renders d as one line: "+" for added, "-" for removed and "~" for changed fields.
+// Values under a sensitive path are masked.
+func FormatFieldDiff(d FieldDiff, sensitive bool) string {
+	render := func(v interface{}) string {
+		if sensitive {
+			return sensitiveValue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+	switch {
+	case d.Old == nil:
+		return fmt.Sprintf("+ %s: %s", d.Path, render(d.New))
+	case d.New == nil:
+		return fmt.Sprintf("- %s: %s", d.Path, render(d.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", d.Path, render(d.Old), render(d.New))
+	}
+}
+
+// IsSensitiveField reports whether path of a kind holds secret material that must not be printed.
+func IsSensitiveField(kind, path string) bool {
+	return kind == ResourceKindSecret && (path == "spec.value" || strings.HasPrefix(path, "spec.value."))
+}
+
+// normalizeJSON round-trips v through JSON so YAML-decoded manifests (ints, timestamps) compare equal
+// to JSON-decoded API responses (float64, strings).
+func normalizeJSON(v interface{}) interface{} {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return v
+	}
+	return out
+}
+
+func joinFieldPath(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"testing"
+)
+
+func TestDiffResource(t *testing.T) {
+	desired := map[string]interface{}{
+		"kind": "RestApi",
+		"metadata": map[string]interface{}{
+			"name":   "petstore",
+			"labels": map[string]interface{}{"team": "payments"},
+		},
+		"spec": map[string]interface{}{
+			"version":    "v1.1",
+			"replicas":   2,
+			"operations": []interface{}{"GET /pets", "POST /pets"},
+		},
+	}
+	live := map[string]interface{}{
+		"kind": "RestApi",
+		"metadata": map[string]interface{}{
+			"name":   "petstore",
+			"labels": map[string]interface{}{"team": "payments", "env": "dev"},
+		},
+		"spec": map[string]interface{}{
+			"version":    "v1.0",
+			"replicas":   float64(2),
+			"operations": []interface{}{"GET /pets"},
+		},
+		"status": map[string]interface{}{"state": "deployed"},
+	}
+
+	diffs := DiffResource(desired, live)
+	got := map[string]FieldDiff{}
+	for _, d := range diffs {
+		got[d.Path] = d
+	}
+	if len(diffs) != 3 {
+		t.Fatalf("expected 3 diffs, got %+v", diffs)
+	}
+	if d, ok := got["metadata.labels.env"]; !ok || d.Old != "dev" || d.New != nil {
+		t.Errorf("expected removed label, got %+v", d)
+	}
+	if d, ok := got["spec.version"]; !ok || d.Old != "v1.0" || d.New != "v1.1" {
+		t.Errorf("expected changed version, got %+v", d)
+	}
+	if d, ok := got["spec.operations[1]"]; !ok || d.Old != nil || d.New != "POST /pets" {
+		t.Errorf("expected added operation, got %+v", d)
+	}
+}
+
+func TestDiffResource_NoChanges(t *testing.T) {
+	doc := map[string]interface{}{"spec": map[string]interface{}{"timeout": 30}}
+	live := map[string]interface{}{"spec": map[string]interface{}{"timeout": float64(30), "extra": true}}
+	if diffs := DiffResource(doc, live); len(diffs) != 0 {
+		t.Errorf("expected no diffs, got %+v", diffs)
+	}
+}
+
+func TestFormatFieldDiff(t *testing.T) {
+	tests := []struct {
+		diff      FieldDiff
+		sensitive bool
+		want      string
+	}{
+		{FieldDiff{Path: "spec.a", New: "x"}, false, `+ spec.a: "x"`},
+		{FieldDiff{Path: "spec.a", Old: 1.0}, false, "- spec.a: 1"},
+		{FieldDiff{Path: "spec.a", Old: "x", New: "y"}, false, `~ spec.a: "x" -> "y"`},
+		{FieldDiff{Path: "spec.value", Old: "old", New: "new"}, true, "~ spec.value: (sensitive) -> (sensitive)"},
+	}
+	for _, tt := range tests {
+		if got := FormatFieldDiff(tt.diff, tt.sensitive); got != tt.want {
+			t.Errorf("FormatFieldDiff(%+v) = %q, want %q", tt.diff, got, tt.want)
+		}
+	}
+}
+
+func TestIsSensitiveField(t *testing.T) {
+	if !IsSensitiveField(ResourceKindSecret, "spec.value") {
+		t.Error("expected Secret spec.value to be sensitive")
+	}
+	if IsSensitiveField(ResourceKindSecret, "spec.description") {
+		t.Error("expected Secret spec.description not to be sensitive")
+	}
+	if IsSensitiveField(ResourceKindRestAPI, "spec.value") {
+		t.Error("expected RestApi spec.value not to be sensitive")
+	}
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/wso2/api-platform/cli/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadResources reads gateway resources from path. A file may hold several YAML documents separated by
+// "---" or a single JSON document; a directory is read for *.yaml, *.yml and *.json files (and its
+// sub-directories when recursive is set). Resources are returned in dependency order.
+func LoadResources(path string, recursive bool) ([]Resource, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var files []string
+	if !info.IsDir() {
+		files = []string{path}
+	} else {
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				return walkErr
+			}
+			if d.IsDir() {
+				if p != path && !recursive {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if isManifestFile(p) {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory %s: %w", path, err)
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no .yaml, .yml or .json files found in %s", path)
+		}
+	}
+
+	var resources []Resource
+	seen := make(map[string]string)
+	for _, f := range files {
+		content, err := os.ReadFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+		parsed, err := ParseResources(content, f)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range parsed {
+			key := r.Kind + "/" + r.Handle
+			if prev, dup := seen[key]; dup {
+				return nil, fmt.Errorf("%s: %s '%s' is already defined in %s", r.Source, r.Kind, r.Handle, prev)
+			}
+			seen[key] = r.Source
+			resources = append(resources, r)
+		}
+	}
+	SortResourcesForApply(resources)
+	return resources, nil
+}
+
+// ParseResources splits content into resources. source names the origin in error messages.
+func ParseResources(content []byte, source string) ([]Resource, error) {
+	content, err := utils.ConvertJSONToYAMLIfNeeded(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: failed to process file content: %w", source, err)
+	}
+
+	type document struct {
+		index int
+		body  map[string]interface{}
+	}
+	var docs []document
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	for idx := 1; ; idx++ {
+		var doc map[string]interface{}
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("%s: failed to parse YAML document %d: %w", source, idx, err)
+		}
+		if len(doc) == 0 {
+			// Empty document, e.g. a trailing "---".
+			continue
+		}
+		docs = append(docs, document{index: idx, body: doc})
+	}
+
+	resources := make([]Resource, 0, len(docs))
+	for _, d := range docs {
+		docSource := source
+		if len(docs) > 1 {
+			docSource = fmt.Sprintf("%s#%d", source, d.index)
+		}
+		r, err := resourceFromDocument(d.body, docSource)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+func resourceFromDocument(doc map[string]interface{}, source string) (Resource, error) {
+	kind, _ := doc["kind"].(string)
+	if kind == "" {
+		return Resource{}, fmt.Errorf("%s: 'kind' field is required in the resource file", source)
+	}
+	if !IsApplyableKind(kind) {
+		return Resource{}, fmt.Errorf("%s: unsupported resource kind: %s", source, kind)
+	}
+	metadata, _ := doc["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	if name == "" {
+		return Resource{}, fmt.Errorf("%s: 'metadata.name' field is required in the resource file", source)
+	}
+
+	labels := map[string]string{}
+	if raw, ok := metadata["labels"].(map[string]interface{}); ok {
+		for k, v := range raw {
+			labels[k] = fmt.Sprint(v)
+		}
+	}
+
+	raw, err := yaml.Marshal(doc)
+	if err != nil {
+		return Resource{}, fmt.Errorf("%s: failed to encode resource: %w", source, err)
+	}
+	return Resource{
+		Kind:    kind,
+		Handle:  name,
+		RawYAML: raw,
+		Labels:  labels,
+		Source:  source,
+		Doc:     doc,
+	}, nil
+}
+
+// SortResourcesForApply orders resources so dependencies are applied first; resources of the same
+// kind keep their file order.
+func SortResourcesForApply(resources []Resource) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		return applyKindRank(resources[i].Kind) < applyKindRank(resources[j].Kind)
+	})
+}
+
+func isManifestFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeManifest(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestParseResources_MultiDocument(t *testing.T) {
+	content := `kind: RestApi
+metadata:
+  name: petstore
+  labels:
+    team: payments
+spec:
+  context: /petstore
+---
+---
+kind: Secret
+metadata:
+  name: openai-key
+spec:
+  value: s3cr3t
+`
+	resources, err := ParseResources([]byte(content), "all.yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resources) != 2 {
+		t.Fatalf("expected 2 resources, got %d", len(resources))
+	}
+	if resources[0].Kind != ResourceKindRestAPI || resources[0].Handle != "petstore" {
+		t.Errorf("unexpected first resource %s/%s", resources[0].Kind, resources[0].Handle)
+	}
+	if resources[0].Labels["team"] != "payments" {
+		t.Errorf("expected team label, got %v", resources[0].Labels)
+	}
+	if resources[0].Source != "all.yaml#1" || resources[1].Source != "all.yaml#3" {
+		t.Errorf("unexpected sources %q, %q", resources[0].Source, resources[1].Source)
+	}
+}
+
+func TestParseResources_SingleDocumentKeepsFileName(t *testing.T) {
+	resources, err := ParseResources([]byte(`{"kind":"Mcp","metadata":{"name":"weather"}}`), "mcp.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resources) != 1 || resources[0].Source != "mcp.json" {
+		t.Fatalf("unexpected resources %+v", resources)
+	}
+}
+
+func TestParseResources_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"missing kind", "metadata:\n  name: a\n", "'kind' field is required"},
+		{"unsupported kind", "kind: Gateway\nmetadata:\n  name: a\n", "unsupported resource kind: Gateway"},
+		{"missing name", "kind: RestApi\nmetadata: {}\n", "'metadata.name' field is required"},
+		{"invalid yaml", "kind: [RestApi\n", "failed to parse YAML document 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseResources([]byte(tt.content), "f.yaml")
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLoadResources_DirectoryOrderAndRecursion(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, filepath.Join(dir, "apis.yaml"), "kind: RestApi\nmetadata:\n  name: petstore\n")
+	writeManifest(t, filepath.Join(dir, "llm.yaml"), `kind: LlmProxy
+metadata:
+  name: chat
+---
+kind: LlmProvider
+metadata:
+  name: openai
+---
+kind: LlmProviderTemplate
+metadata:
+  name: openai-template
+`)
+	writeManifest(t, filepath.Join(dir, "README.md"), "not a manifest")
+	writeManifest(t, filepath.Join(dir, "secrets", "keys.yml"), "kind: Secret\nmetadata:\n  name: openai-key\n")
+
+	resources, err := LoadResources(dir, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resources) != 4 {
+		t.Fatalf("expected 4 resources without recursion, got %d", len(resources))
+	}
+
+	resources, err = LoadResources(dir, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var got []string
+	for _, r := range resources {
+		got = append(got, r.Kind)
+	}
+	want := []string{
+		ResourceKindSecret, ResourceKindLLMProviderTemplate, ResourceKindLLMProvider,
+		ResourceKindLLMProxy, ResourceKindRestAPI,
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("expected order %v, got %v", want, got)
+	}
+}
+
+func TestLoadResources_Duplicate(t *testing.T) {
+	dir := t.TempDir()
+	writeManifest(t, filepath.Join(dir, "a.yaml"), "kind: RestApi\nmetadata:\n  name: petstore\n")
+	writeManifest(t, filepath.Join(dir, "b.yaml"), "kind: RestApi\nmetadata:\n  name: petstore\n")
+
+	_, err := LoadResources(dir, false)
+	if err == nil || !strings.Contains(err.Error(), "is already defined in") {
+		t.Fatalf("expected duplicate error, got %v", err)
+	}
+}
+
+func TestLoadResources_EmptyDirectory(t *testing.T) {
+	_, err := LoadResources(t.TempDir(), true)
+	if err == nil || !strings.Contains(err.Error(), "no .yaml, .yml or .json files") {
+		t.Fatalf("expected empty directory error, got %v", err)
+	}
+}
@@ -0,0 +1,337 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package gateway
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/wso2/api-platform/cli/utils"
+)
+
+// resourceStore reads and writes one resource kind through the management API. Live resources are
+// returned in manifest shape ({metadata, spec, ...}) so they can be diffed against manifests.
+type resourceStore interface {
+	// get returns the live resource, or nil when it does not exist.
+	get(c *Client, handle string) (map[string]interface{}, error)
+	// desired returns the part of the manifest that is compared with the live resource.
+	desired(r Resource) (map[string]interface{}, error)
+	create(c *Client, r Resource) error
+	update(c *Client, r Resource, live map[string]interface{}) error
+	remove(c *Client, handle string, live map[string]interface{}) error
+	// prunable reports whether live resources carry labels, which prune selects on.
+	prunable() bool
+	list(c *Client) ([]map[string]interface{}, error)
+}
+
+func resourceStoreFor(kind string) resourceStore {
+	switch kind {
+	case ResourceKindCertificate:
+		return certificateStore{}
+	case ResourceKindSubscriptionPlan:
+		return subscriptionPlanStore{}
+	default:
+		return collectionStore{handler: GetResourceHandler(kind)}
+	}
+}
+
+// collectionStore manages k8s-shaped kinds that are created from the manifest as-is.
+type collectionStore struct {
+	handler ResourceHandler
+}
+
+func (s collectionStore) get(c *Client, handle string) (map[string]interface{}, error) {
+	return getJSONObject(c, s.handler.GetEndpoint(handle))
+}
+
+func (s collectionStore) desired(r Resource) (map[string]interface{}, error) {
+	return r.Doc, nil
+}
+
+func (s collectionStore) create(c *Client, r Resource) error {
+	resp, err := c.PostYAML(s.handler.CreateEndpoint(), bytes.NewReader(r.RawYAML))
+	return drainResponse(resp, err)
+}
+
+func (s collectionStore) update(c *Client, r Resource, _ map[string]interface{}) error {
+	resp, err := c.PutYAML(s.handler.UpdateEndpoint(r.Handle), bytes.NewReader(r.RawYAML))
+	return drainResponse(resp, err)
+}
+
+func (s collectionStore) remove(c *Client, handle string, _ map[string]interface{}) error {
+	resp, err := c.Delete(s.handler.DeleteEndpoint(handle))
+	return drainResponse(resp, err)
+}
+
+func (s collectionStore) prunable() bool {
+	return true
+}
+
+func (s collectionStore) list(c *Client) ([]map[string]interface{}, error) {
+	return listJSONObjects(c, s.handler.ListEndpoint(), s.handler.ListKey())
+}
+
+// subscriptionPlanStore maps SubscriptionPlan manifests ({metadata.name, spec}) onto the plan API,
+// which is keyed by a server-assigned id and identifies plans by planName.
+type subscriptionPlanStore struct{}
+
+// subscriptionPlanServerFields are response fields that are not part of the manifest spec.
+var subscriptionPlanServerFields = map[string]bool{
+	"id": true, "planName": true, "gatewayId": true, "createdAt": true, "updatedAt": true,
+}
+
+func (s subscriptionPlanStore) find(c *Client, name string) (map[string]interface{}, error) {
+	plans, err := listJSONObjects(c, utils.GatewaySubscriptionPlansPath, "subscriptionPlans")
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range plans {
+		if planName, _ := p["planName"].(string); planName == name {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s subscriptionPlanStore) get(c *Client, handle string) (map[string]interface{}, error) {
+	plan, err := s.find(c, handle)
+	if err != nil || plan == nil {
+		return nil, err
+	}
+	spec := map[string]interface{}{}
+	for k, v := range plan {
+		if !subscriptionPlanServerFields[k] {
+			spec[k] = v
+		}
+	}
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{"name": handle},
+		"spec":     spec,
+		"id":       plan["id"],
+	}, nil
+}
+
+func (s subscriptionPlanStore) desired(r Resource) (map[string]interface{}, error) {
+	spec, _ := r.Doc["spec"].(map[string]interface{})
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{"name": r.Handle},
+		"spec":     spec,
+	}, nil
+}
+
+func (s subscriptionPlanStore) payload(r Resource) ([]byte, error) {
+	body := map[string]interface{}{}
+	if spec, ok := r.Doc["spec"].(map[string]interface{}); ok {
+		for k, v := range spec {
+			body[k] = v
+		}
+	}
+	body["planName"] = r.Handle
+	return json.Marshal(normalizeJSON(body))
+}
+
+func (s subscriptionPlanStore) create(c *Client, r Resource) error {
+	body, err := s.payload(r)
+	if err != nil {
+		return err
+	}
+	resp, err := c.Post(utils.GatewaySubscriptionPlansPath, bytes.NewReader(body))
+	return drainResponse(resp, err)
+}
+
+func (s subscriptionPlanStore) update(c *Client, r Resource, live map[string]interface{}) error {
+	body, err := s.payload(r)
+	if err != nil {
+		return err
+	}
+	id, _ := live["id"].(string)
+	resp, err := c.Put(fmt.Sprintf(utils.GatewaySubscriptionPlanByIDPath, url.PathEscape(id)), bytes.NewReader(body))
+	return drainResponse(resp, err)
+}
+
+func (s subscriptionPlanStore) remove(c *Client, _ string, live map[string]interface{}) error {
+	id, _ := live["id"].(string)
+	resp, err := c.Delete(fmt.Sprintf(utils.GatewaySubscriptionPlanByIDPath, url.PathEscape(id)))
+	return drainResponse(resp, err)
+}
+
+func (s subscriptionPlanStore) prunable() bool {
+	return false
+}
+
+func (s subscriptionPlanStore) list(*Client) ([]map[string]interface{}, error) {
+	return nil, nil
+}
+
+// certificateStore maps Certificate manifests ({metadata.name, spec.certificate: PEM}) onto the
+// certificate API. The API does not return PEM content, so certificates are compared on the subject,
+// issuer and expiry of the first certificate, and replaced (delete + upload) when they differ.
+type certificateStore struct{}
+
+// certificateTimeLayout matches how the gateway renders notAfter.
+const certificateTimeLayout = "2006-01-02 15:04:05"
+
+func (s certificateStore) find(c *Client, name string) (map[string]interface{}, error) {
+	certs, err := listJSONObjects(c, utils.GatewayCertificatesPath, "certificates")
+	if err != nil {
+		return nil, err
+	}
+	for _, cert := range certs {
+		if n, _ := cert["name"].(string); n == name {
+			return cert, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s certificateStore) get(c *Client, handle string) (map[string]interface{}, error) {
+	cert, err := s.find(c, handle)
+	if err != nil || cert == nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{"name": handle},
+		"spec": map[string]interface{}{
+			"subject":  cert["subject"],
+			"issuer":   cert["issuer"],
+			"notAfter": cert["notAfter"],
+		},
+		"id": cert["id"],
+	}, nil
+}
+
+func (s certificateStore) desired(r Resource) (map[string]interface{}, error) {
+	pemData, err := s.pem(r)
+	if err != nil {
+		return nil, err
+	}
+	for rest := []byte(pemData); ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("Certificate '%s': spec.certificate has no PEM certificate", r.Handle)
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("Certificate '%s': %w", r.Handle, err)
+		}
+		return map[string]interface{}{
+			"metadata": map[string]interface{}{"name": r.Handle},
+			"spec": map[string]interface{}{
+				"subject":  cert.Subject.String(),
+				"issuer":   cert.Issuer.String(),
+				"notAfter": cert.NotAfter.UTC().Format(certificateTimeLayout),
+			},
+		}, nil
+	}
+}
+
+func (s certificateStore) pem(r Resource) (string, error) {
+	spec, _ := r.Doc["spec"].(map[string]interface{})
+	pemData, _ := spec["certificate"].(string)
+	if pemData == "" {
+		return "", fmt.Errorf("Certificate '%s': spec.certificate is required", r.Handle)
+	}
+	return pemData, nil
+}
+
+func (s certificateStore) create(c *Client, r Resource) error {
+	pemData, err := s.pem(r)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(map[string]string{"name": r.Handle, "certificate": pemData})
+	if err != nil {
+		return err
+	}
+	resp, err := c.Post(utils.GatewayCertificatesPath, bytes.NewReader(body))
+	return drainResponse(resp, err)
+}
+
+func (s certificateStore) update(c *Client, r Resource, live map[string]interface{}) error {
+	if err := s.remove(c, r.Handle, live); err != nil {
+		return err
+	}
+	return s.create(c, r)
+}
+
+func (s certificateStore) remove(c *Client, _ string, live map[string]interface{}) error {
+	id, _ := live["id"].(string)
+	resp, err := c.Delete(fmt.Sprintf(utils.GatewayCertificateByIDPath, url.PathEscape(id)))
+	return drainResponse(resp, err)
+}
+
+func (s certificateStore) prunable() bool {
+	return false
+}
+
+func (s certificateStore) list(*Client) ([]map[string]interface{}, error) {
+	return nil, nil
+}
+
+// getJSONObject GETs path and decodes a JSON object; it returns nil for 404.
+func getJSONObject(c *Client, path string) (map[string]interface{}, error) {
+	resp, err := c.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	var obj map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&obj); err != nil {
+		return nil, fmt.Errorf("failed to parse response from %s: %w", path, err)
+	}
+	return obj, nil
+}
+
+// listJSONObjects GETs a collection and returns the objects under key.
+func listJSONObjects(c *Client, path, key string) ([]map[string]interface{}, error) {
+	body, err := getJSONObject(c, path)
+	if err != nil || body == nil {
+		return nil, err
+	}
+	raw, _ := body[key].([]interface{})
+	items := make([]map[string]interface{}, 0, len(raw))
+	for _, it := range raw {
+		if obj, ok := it.(map[string]interface{}); ok {
+			items = append(items, obj)
+		}
+	}
+	return items, nil
+}
+
+func drainResponse(resp *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
@@ -19,22 +19,51 @@
 package gateway
 
 import (
-	"fmt"
+	"net/url"
 
 	"github.com/wso2/api-platform/cli/utils"
 )
 
 // ResourceKind represents the type of gateway resource
 const (
-	ResourceKindRestAPI = "RestApi"
-	ResourceKindMCP     = "Mcp"
+	ResourceKindRestAPI             = "RestApi"
+	ResourceKindWebSubAPI           = "WebSubApi"
+	ResourceKindMCP                 = "Mcp"
+	ResourceKindLLMProviderTemplate = "LlmProviderTemplate"
+	ResourceKindLLMProvider         = "LlmProvider"
+	ResourceKindLLMProxy            = "LlmProxy"
+	ResourceKindSecret              = "Secret"
+	ResourceKindCertificate         = "Certificate"
+	ResourceKindSubscriptionPlan    = "SubscriptionPlan"
 )
 
+// applyOrder lists every kind `ap gateway apply` understands, dependencies first: secrets and
+// certificates are referenced by templates, providers and APIs; templates by providers; providers by
+// proxies. Prune walks the list in reverse.
+var applyOrder = []string{
+	ResourceKindSecret,
+	ResourceKindCertificate,
+	ResourceKindSubscriptionPlan,
+	ResourceKindLLMProviderTemplate,
+	ResourceKindLLMProvider,
+	ResourceKindLLMProxy,
+	ResourceKindMCP,
+	ResourceKindRestAPI,
+	ResourceKindWebSubAPI,
+}
+
 // Resource represents a parsed gateway resource
 type Resource struct {
 	Kind    string
 	Handle  string // metadata.name
 	RawYAML []byte
+
+	// Labels is metadata.labels, used by prune selectors.
+	Labels map[string]string
+	// Source identifies where the resource was read from ("file.yaml#2" for the second document).
+	Source string
+	// Doc is the decoded document, used for diffing.
+	Doc map[string]interface{}
 }
 
 // ResourceHandler defines the interface for handling different resource kinds
@@ -47,46 +76,81 @@ type ResourceHandler interface {
 
 	// UpdateEndpoint returns the PUT endpoint to update an existing resource
 	UpdateEndpoint(handle string) string
-}
 
-// RestAPIHandler handles RestApi kind resources
-type RestAPIHandler struct{}
+	// DeleteEndpoint returns the DELETE endpoint to remove a resource
+	DeleteEndpoint(handle string) string
 
-func (h *RestAPIHandler) GetEndpoint(handle string) string {
-	return fmt.Sprintf(utils.GatewayAPIByIDPath, handle)
+	// ListEndpoint returns the GET endpoint listing all resources of the kind
+	ListEndpoint() string
+
+	// ListKey is the field of the list response holding the resources
+	ListKey() string
 }
 
-func (h *RestAPIHandler) CreateEndpoint() string {
-	return utils.GatewayAPIsPath
+// collectionHandler handles kinds served as a k8s-shaped collection keyed by metadata.name
+// (/<collection> and /<collection>/{handle}).
+type collectionHandler struct {
+	path    string
+	listKey string
 }
 
-func (h *RestAPIHandler) UpdateEndpoint(handle string) string {
-	return fmt.Sprintf(utils.GatewayAPIByIDPath, handle)
+func (h *collectionHandler) GetEndpoint(handle string) string {
+	return h.path + "/" + url.PathEscape(handle)
 }
 
-// MCPHandler handles mcp kind resources
-type MCPHandler struct{}
+func (h *collectionHandler) CreateEndpoint() string {
+	return h.path
+}
 
-func (h *MCPHandler) GetEndpoint(handle string) string {
-	return fmt.Sprintf(utils.GatewayMCPProxyByIDPath, handle)
+func (h *collectionHandler) UpdateEndpoint(handle string) string {
+	return h.path + "/" + url.PathEscape(handle)
 }
 
-func (h *MCPHandler) CreateEndpoint() string {
-	return utils.GatewayMCPProxiesPath
+func (h *collectionHandler) DeleteEndpoint(handle string) string {
+	return h.path + "/" + url.PathEscape(handle)
 }
 
-func (h *MCPHandler) UpdateEndpoint(handle string) string {
-	return fmt.Sprintf(utils.GatewayMCPProxyByIDPath, handle)
+func (h *collectionHandler) ListEndpoint() string {
+	return h.path
 }
 
-// GetResourceHandler returns the appropriate handler for a resource kind
+func (h *collectionHandler) ListKey() string {
+	return h.listKey
+}
+
+// GetResourceHandler returns the appropriate handler for a resource kind. Certificate and
+// SubscriptionPlan are keyed by server-assigned IDs and have no handler; see resourceStoreFor.
 func GetResourceHandler(kind string) ResourceHandler {
 	switch kind {
 	case ResourceKindRestAPI:
-		return &RestAPIHandler{}
+		return &collectionHandler{path: utils.GatewayAPIsPath, listKey: "apis"}
 	case ResourceKindMCP:
-		return &MCPHandler{}
+		return &collectionHandler{path: utils.GatewayMCPProxiesPath, listKey: "mcpProxies"}
+	case ResourceKindWebSubAPI:
+		return &collectionHandler{path: utils.GatewayWebSubAPIsPath, listKey: "apis"}
+	case ResourceKindLLMProviderTemplate:
+		return &collectionHandler{path: utils.GatewayLLMProviderTemplatesPath, listKey: "templates"}
+	case ResourceKindLLMProvider:
+		return &collectionHandler{path: utils.GatewayLLMProvidersPath, listKey: "providers"}
+	case ResourceKindLLMProxy:
+		return &collectionHandler{path: utils.GatewayLLMProxiesPath, listKey: "proxies"}
+	case ResourceKindSecret:
+		return &collectionHandler{path: utils.GatewaySecretsPath, listKey: "secrets"}
 	default:
 		return nil
 	}
 }
+
+// IsApplyableKind reports whether `ap gateway apply` can manage kind.
+func IsApplyableKind(kind string) bool {
+	return applyKindRank(kind) >= 0
+}
+
+func applyKindRank(kind string) int {
+	for i, k := range applyOrder {
+		if k == kind {
+			return i
+		}
+	}
+	return -1
+}
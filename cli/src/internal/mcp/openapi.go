@@ -0,0 +1,400 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	gwmodels "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"gopkg.in/yaml.v3"
+)
+
+// bodyArgument is the tool argument the gateway sends as the JSON request body
+const bodyArgument = "body"
+
+// maxToolNameLength is the longest operation name the gateway accepts
+const maxToolNameLength = 128
+
+// maxSchemaDepth bounds the inlining of recursive schemas
+const maxSchemaDepth = 16
+
+// componentSchemaPrefix prefixes the references to the component schemas of a definition
+const componentSchemaPrefix = "#/components/schemas/"
+
+var (
+	pathParamPattern = regexp.MustCompile(`\{([^{}]+)\}`)
+	toolNamePattern  = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+)
+
+// operationMethods lists the methods the gateway can expose as tools, in the order the
+// tools of one path are generated
+var operationMethods = []gwmodels.MCPRestOperationMethod{
+	gwmodels.MCPRestOperationMethodGET,
+	gwmodels.MCPRestOperationMethodPOST,
+	gwmodels.MCPRestOperationMethodPUT,
+	gwmodels.MCPRestOperationMethodPATCH,
+	gwmodels.MCPRestOperationMethodDELETE,
+	gwmodels.MCPRestOperationMethodHEAD,
+}
+
+// GenerateFromOpenAPI generates a VirtualMcp configuration that exposes the operations of
+// a deployed RestApi as MCP tools, using the OpenAPI definition of the API for the tool
+// names, descriptions and input schemas
+func GenerateFromOpenAPI(specPath string, restAPI string, outputDir string) error {
+	fmt.Printf("Generating MCP configuration from OpenAPI definition: %s\n", specPath)
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	doc, err := loader.LoadFromFile(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to load OpenAPI definition: %w", err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return fmt.Errorf("unsupported OpenAPI version %q; only OpenAPI 3.x definitions are supported", doc.OpenAPI)
+	}
+
+	operations, err := buildRestOperations(doc)
+	if err != nil {
+		return err
+	}
+	if len(operations) == 0 {
+		return fmt.Errorf("no operations found in the OpenAPI definition")
+	}
+	fmt.Printf("→ Generated Tools: %d\n", len(operations))
+	fmt.Println("---------------------------------------------------")
+
+	if err := generateVirtualMCPConfigFile(restAPI, operations, outputDir); err != nil {
+		return fmt.Errorf("failed to generate MCP configuration file: %w", err)
+	}
+
+	fmt.Println("MCP generated successfully.")
+	return nil
+}
+
+// buildRestOperations converts every operation of the definition into a tool
+func buildRestOperations(doc *openapi3.T) ([]gwmodels.MCPRestOperation, error) {
+	if doc.Paths == nil {
+		return nil, nil
+	}
+	paths := make([]string, 0, doc.Paths.Len())
+	for path := range doc.Paths.Map() {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var operations []gwmodels.MCPRestOperation
+	names := make(map[string]int)
+	for _, path := range paths {
+		item := doc.Paths.Value(path)
+		if item == nil {
+			continue
+		}
+		for _, method := range operationMethods {
+			op := item.GetOperation(string(method))
+			if op == nil {
+				continue
+			}
+			operation, err := buildRestOperation(doc, path, method, item.Parameters, op)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate tool for %s %s: %w", method, path, err)
+			}
+			// Keep the tool names unique, as agents call the tools by name
+			names[operation.Name]++
+			if n := names[operation.Name]; n > 1 {
+				operation.Name = fmt.Sprintf("%s_%d", operation.Name, n)
+			}
+			operations = append(operations, operation)
+		}
+	}
+	return operations, nil
+}
+
+func buildRestOperation(doc *openapi3.T, path string, method gwmodels.MCPRestOperationMethod,
+	pathParams openapi3.Parameters, op *openapi3.Operation) (gwmodels.MCPRestOperation, error) {
+	operation := gwmodels.MCPRestOperation{
+		Name:   toolName(op.OperationID, string(method), path),
+		Method: method,
+		Path:   path,
+	}
+	if op.Summary != "" {
+		summary := op.Summary
+		operation.Title = &summary
+	}
+	description := op.Description
+	if description == "" {
+		description = op.Summary
+	}
+	if description != "" {
+		operation.Description = &description
+	}
+
+	properties := make(map[string]any)
+	required := []string{}
+	var params []gwmodels.MCPRestParameter
+	for _, param := range mergeParameters(pathParams, op.Parameters) {
+		var in gwmodels.MCPRestParameterIn
+		switch param.In {
+		case openapi3.ParameterInPath:
+			in = gwmodels.MCPRestParameterInPath
+		case openapi3.ParameterInQuery:
+			in = gwmodels.MCPRestParameterInQuery
+		case openapi3.ParameterInHeader:
+			in = gwmodels.MCPRestParameterInHeader
+		default:
+			// Cookies cannot be set through the gateway
+			continue
+		}
+		params = append(params, gwmodels.MCPRestParameter{Name: param.Name, In: in})
+
+		schema := map[string]any{"type": "string"}
+		if param.Schema != nil {
+			inlined, err := inlineSchema(doc, param.Schema, 0)
+			if err != nil {
+				return operation, err
+			}
+			schema = inlined
+		}
+		if param.Description != "" {
+			schema["description"] = param.Description
+		}
+		properties[param.Name] = schema
+		if param.Required || param.In == openapi3.ParameterInPath {
+			required = append(required, param.Name)
+		}
+	}
+
+	if op.RequestBody != nil && op.RequestBody.Value != nil {
+		body := op.RequestBody.Value
+		if media := jsonMediaType(body.Content); media != nil && media.Schema != nil {
+			schema, err := inlineSchema(doc, media.Schema, 0)
+			if err != nil {
+				return operation, err
+			}
+			if body.Description != "" {
+				if _, ok := schema["description"]; !ok {
+					schema["description"] = body.Description
+				}
+			}
+			properties[bodyArgument] = schema
+			if body.Required {
+				required = append(required, bodyArgument)
+			}
+		}
+	}
+
+	if len(params) > 0 {
+		operation.Parameters = &params
+	}
+	inputSchema := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		inputSchema["required"] = required
+	}
+	data, err := json.Marshal(inputSchema)
+	if err != nil {
+		return operation, err
+	}
+	schemaStr := string(data)
+	operation.InputSchema = &schemaStr
+	return operation, nil
+}
+
+// mergeParameters returns the path-level parameters overridden by the operation-level
+// parameters of the same name and location
+func mergeParameters(pathParams, opParams openapi3.Parameters) []*openapi3.Parameter {
+	var merged []*openapi3.Parameter
+	index := make(map[string]int)
+	for _, refs := range []openapi3.Parameters{pathParams, opParams} {
+		for _, ref := range refs {
+			if ref == nil || ref.Value == nil {
+				continue
+			}
+			key := ref.Value.In + ":" + ref.Value.Name
+			if i, ok := index[key]; ok {
+				merged[i] = ref.Value
+				continue
+			}
+			index[key] = len(merged)
+			merged = append(merged, ref.Value)
+		}
+	}
+	return merged
+}
+
+// jsonMediaType returns the JSON media type of a request body, if it has one
+func jsonMediaType(content openapi3.Content) *openapi3.MediaType {
+	if media := content.Get("application/json"); media != nil {
+		return media
+	}
+	types := make([]string, 0, len(content))
+	for mediaType := range content {
+		types = append(types, mediaType)
+	}
+	sort.Strings(types)
+	for _, mediaType := range types {
+		if strings.HasSuffix(mediaType, "+json") {
+			return content[mediaType]
+		}
+	}
+	return nil
+}
+
+// toolName returns the operation ID as a valid tool name, or a name derived from the method
+// and path, e.g. GET /books/{id} becomes get_books_by_id
+func toolName(operationID, method, path string) string {
+	name := strings.Trim(toolNamePattern.ReplaceAllString(operationID, "_"), "_")
+	if name == "" {
+		parts := []string{strings.ToLower(method)}
+		for _, segment := range strings.Split(path, "/") {
+			if match := pathParamPattern.FindStringSubmatch(segment); match != nil && match[0] == segment {
+				segment = "by_" + match[1]
+			}
+			segment = strings.Trim(toolNamePattern.ReplaceAllString(segment, "_"), "_")
+			if segment != "" {
+				parts = append(parts, segment)
+			}
+		}
+		name = strings.Join(parts, "_")
+	}
+	if len(name) > maxToolNameLength {
+		name = name[:maxToolNameLength]
+	}
+	return name
+}
+
+// inlineSchema returns the JSON Schema of a schema reference with the references to
+// component schemas replaced by the schemas themselves, since agents only see the tool's
+// input schema
+func inlineSchema(doc *openapi3.T, ref *openapi3.SchemaRef, depth int) (map[string]any, error) {
+	if ref.Value == nil {
+		return nil, fmt.Errorf("unresolved schema reference %q", ref.Ref)
+	}
+	data, err := json.Marshal(ref.Value)
+	if err != nil {
+		return nil, err
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+	inlined, err := inlineRefs(doc, schema, depth)
+	if err != nil {
+		return nil, err
+	}
+	return inlined.(map[string]any), nil
+}
+
+func inlineRefs(doc *openapi3.T, node any, depth int) (any, error) {
+	switch v := node.(type) {
+	case map[string]any:
+		if ref, ok := v["$ref"].(string); ok {
+			if depth >= maxSchemaDepth {
+				// Recursive schemas are cut off rather than expanded forever
+				return map[string]any{"type": "object"}, nil
+			}
+			name, local := strings.CutPrefix(ref, componentSchemaPrefix)
+			if !local || doc.Components == nil || doc.Components.Schemas[name] == nil {
+				return nil, fmt.Errorf("unresolved schema reference %q", ref)
+			}
+			return inlineSchema(doc, doc.Components.Schemas[name], depth+1)
+		}
+		for key, value := range v {
+			inlined, err := inlineRefs(doc, value, depth)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = inlined
+		}
+		return v, nil
+	case []any:
+		for i, value := range v {
+			inlined, err := inlineRefs(doc, value, depth)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = inlined
+		}
+		return v, nil
+	default:
+		return node, nil
+	}
+}
+
+// upstreamName derives a valid upstream name from the RestApi handle, which may contain
+// characters such as dots that upstream names do not allow
+func upstreamName(restAPI string) string {
+	name := strings.Trim(toolNamePattern.ReplaceAllString(restAPI, "-"), "-_")
+	if len(name) > 64 {
+		name = strings.TrimRight(name[:64], "-_")
+	}
+	if name == "" {
+		return "rest-api"
+	}
+	return name
+}
+
+// generateVirtualMCPConfigFile writes a VirtualMcp configuration with the RestApi as its
+// only upstream
+func generateVirtualMCPConfigFile(restAPI string, operations []gwmodels.MCPRestOperation, outputDir string) error {
+	contextPath := "/generated"
+	specVersion := ProtocolVersion
+
+	mcp := gwmodels.MCPProxyConfiguration{
+		ApiVersion: gwmodels.MCPProxyConfigurationApiVersionGatewayApiPlatformWso2Comv1alpha1,
+		Kind:       gwmodels.MCPProxyConfigurationKindVirtualMcp,
+		Metadata: gwmodels.Metadata{
+			Name: "Generated-MCP-v1.0",
+		},
+		Spec: gwmodels.MCPProxyConfigData{
+			DisplayName: "Generated-MCP",
+			Version:     "v1.0",
+			Context:     &contextPath,
+			SpecVersion: &specVersion,
+			Upstreams: &[]gwmodels.MCPVirtualUpstream{{
+				Name:       upstreamName(restAPI),
+				RestApi:    &restAPI,
+				Operations: &operations,
+			}},
+		},
+	}
+
+	out, err := yaml.Marshal(&mcp)
+	if err != nil {
+		return err
+	}
+
+	// Create output directory if it doesn't exist
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	outputPath := filepath.Join(outputDir, "generated-mcp.yaml")
+	if err := os.WriteFile(outputPath, out, 0644); err != nil {
+		return err
+	}
+
+	fmt.Printf("→ Generated MCP configuration YAML file: %s\n", outputPath)
+	return nil
+}
@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcp
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	gwmodels "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"gopkg.in/yaml.v3"
+)
+
+const readingListSpec = `openapi: 3.0.3
+info:
+  title: Reading List
+  version: v1.0
+paths:
+  /books:
+    get:
+      operationId: listBooks
+      summary: List books
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+        - name: session
+          in: cookie
+          schema:
+            type: string
+    post:
+      summary: Add a book
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Book'
+  /books/{id}:
+    parameters:
+      - name: id
+        in: path
+        required: true
+        description: ID of the book
+        schema:
+          type: string
+    get:
+      operationId: get book
+      description: Get a book by its ID
+      parameters:
+        - name: X-Trace
+          in: header
+          schema:
+            type: string
+components:
+  schemas:
+    Book:
+      type: object
+      required: [title]
+      properties:
+        title:
+          type: string
+        related:
+          type: array
+          items:
+            $ref: '#/components/schemas/Book'
+`
+
+func generateFromSpec(t *testing.T, spec string) gwmodels.MCPProxyConfiguration {
+	t.Helper()
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "reading-list.yaml")
+	if err := os.WriteFile(specPath, []byte(spec), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateFromOpenAPI(specPath, "reading-list-api-v1.0", dir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "generated-mcp.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cfg gwmodels.MCPProxyConfiguration
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		t.Fatalf("generated configuration is not valid YAML: %v", err)
+	}
+	return cfg
+}
+
+func inputSchema(t *testing.T, op gwmodels.MCPRestOperation) map[string]any {
+	t.Helper()
+	if op.InputSchema == nil {
+		t.Fatalf("operation %s has no input schema", op.Name)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal([]byte(*op.InputSchema), &schema); err != nil {
+		t.Fatalf("input schema of %s is not valid JSON: %v", op.Name, err)
+	}
+	return schema
+}
+
+func TestGenerateFromOpenAPI(t *testing.T) {
+	cfg := generateFromSpec(t, readingListSpec)
+
+	if cfg.Kind != gwmodels.MCPProxyConfigurationKindVirtualMcp {
+		t.Fatalf("expected kind VirtualMcp, got %s", cfg.Kind)
+	}
+	if cfg.Spec.Upstreams == nil || len(*cfg.Spec.Upstreams) != 1 {
+		t.Fatalf("expected one upstream, got %v", cfg.Spec.Upstreams)
+	}
+	upstream := (*cfg.Spec.Upstreams)[0]
+	if upstream.Name != "reading-list-api-v1-0" {
+		t.Errorf("expected upstream name reading-list-api-v1-0, got %s", upstream.Name)
+	}
+	if upstream.RestApi == nil || *upstream.RestApi != "reading-list-api-v1.0" {
+		t.Errorf("expected restApi reading-list-api-v1.0, got %v", upstream.RestApi)
+	}
+	if upstream.Url != nil {
+		t.Errorf("expected no url, got %s", *upstream.Url)
+	}
+
+	ops := *upstream.Operations
+	var names []string
+	for _, op := range ops {
+		names = append(names, op.Name)
+	}
+	if want := []string{"listBooks", "post_books", "get_book"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("expected tools %v, got %v", want, names)
+	}
+
+	// Cookie parameters are left out
+	list := ops[0]
+	if want := []gwmodels.MCPRestParameter{{Name: "limit", In: gwmodels.MCPRestParameterInQuery}}; !reflect.DeepEqual(*list.Parameters, want) {
+		t.Errorf("expected parameters %v, got %v", want, *list.Parameters)
+	}
+	if list.Title == nil || *list.Title != "List books" || list.Description == nil || *list.Description != "List books" {
+		t.Errorf("expected the summary as title and description, got %v, %v", list.Title, list.Description)
+	}
+	limit := inputSchema(t, list)["properties"].(map[string]any)["limit"].(map[string]any)
+	if limit["type"] != "integer" {
+		t.Errorf("expected the parameter schema to be kept, got %v", limit)
+	}
+
+	// Request bodies become the body argument, with component schemas inlined
+	add := inputSchema(t, ops[1])
+	if !reflect.DeepEqual(add["required"], []any{"body"}) {
+		t.Errorf("expected the body to be required, got %v", add["required"])
+	}
+	body := add["properties"].(map[string]any)["body"].(map[string]any)
+	related := body["properties"].(map[string]any)["related"].(map[string]any)["items"].(map[string]any)
+	if _, ok := related["$ref"]; ok {
+		t.Errorf("expected component schemas to be inlined, got %v", related)
+	}
+	if related["type"] != "object" {
+		t.Errorf("expected the inlined Book schema, got %v", related)
+	}
+
+	// Path-level parameters apply to the operations of the path
+	get := ops[2]
+	if get.Description == nil || *get.Description != "Get a book by its ID" {
+		t.Errorf("expected the operation description, got %v", get.Description)
+	}
+	wantParams := []gwmodels.MCPRestParameter{
+		{Name: "id", In: gwmodels.MCPRestParameterInPath},
+		{Name: "X-Trace", In: gwmodels.MCPRestParameterInHeader},
+	}
+	if !reflect.DeepEqual(*get.Parameters, wantParams) {
+		t.Errorf("expected parameters %v, got %v", wantParams, *get.Parameters)
+	}
+	getSchema := inputSchema(t, get)
+	if !reflect.DeepEqual(getSchema["required"], []any{"id"}) {
+		t.Errorf("expected the path parameter to be required, got %v", getSchema["required"])
+	}
+	id := getSchema["properties"].(map[string]any)["id"].(map[string]any)
+	if id["description"] != "ID of the book" {
+		t.Errorf("expected the parameter description, got %v", id)
+	}
+}
+
+func TestGenerateFromOpenAPI_RejectsSwagger2(t *testing.T) {
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "swagger.json")
+	spec := `{"swagger":"2.0","info":{"title":"Books","version":"1.0"},"paths":{}}`
+	if err := os.WriteFile(specPath, []byte(spec), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := GenerateFromOpenAPI(specPath, "books", dir); err == nil {
+		t.Fatal("expected an error for a Swagger 2.0 definition")
+	}
+}
+
+func TestToolName(t *testing.T) {
+	tests := []struct {
+		operationID, method, path, want string
+	}{
+		{"listBooks", "GET", "/books", "listBooks"},
+		{"", "GET", "/books/{id}/reviews", "get_books_by_id_reviews"},
+		{"", "DELETE", "/", "delete"},
+		{"books.v1/get", "GET", "/books", "books_v1_get"},
+	}
+	for _, tt := range tests {
+		if got := toolName(tt.operationID, tt.method, tt.path); got != tt.want {
+			t.Errorf("toolName(%q, %q, %q) = %q, want %q", tt.operationID, tt.method, tt.path, got, tt.want)
+		}
+	}
+}
@@ -49,6 +49,17 @@ const (
 	GatewayMCPProxiesPath   = GatewayManagementBasePath + "/mcp-proxies"
 	GatewayMCPProxyByIDPath = GatewayManagementBasePath + "/mcp-proxies/%s"
 
+	// Collections managed declaratively by `ap gateway apply`.
+	GatewayWebSubAPIsPath           = GatewayManagementBasePath + "/websub-apis"
+	GatewayLLMProviderTemplatesPath = GatewayManagementBasePath + "/llm-provider-templates"
+	GatewayLLMProvidersPath         = GatewayManagementBasePath + "/llm-providers"
+	GatewayLLMProxiesPath           = GatewayManagementBasePath + "/llm-proxies"
+	GatewaySecretsPath              = GatewayManagementBasePath + "/secrets"
+	GatewayCertificatesPath         = GatewayManagementBasePath + "/certificates"
+	GatewayCertificateByIDPath      = GatewayManagementBasePath + "/certificates/%s"
+	GatewaySubscriptionPlansPath    = GatewayManagementBasePath + "/subscription-plans"
+	GatewaySubscriptionPlanByIDPath = GatewayManagementBasePath + "/subscription-plans/%s"
+
 	// Health endpoint (served on the gateway-controller's admin port).
 	GatewayHealthPath = GatewayAdminBasePath + "/health"
 
@@ -42,6 +42,12 @@ const (
 	FlagGatewayControllerImage = "gateway-controller-base-image"
 	FlagRouterBaseImage        = "router-base-image"
 	FlagHeader                 = "header"
+	FlagRecursive              = "recursive"
+	FlagDryRun                 = "dry-run"
+	FlagPrune                  = "prune"
+	FlagSelector               = "selector"
+	FlagFromOpenAPI            = "from-openapi"
+	FlagRestAPI                = "rest-api"
 )
 
 var shortFlags = map[string]string{
@@ -51,6 +57,8 @@ var shortFlags = map[string]string{
 	FlagOutput:        "o",
 	FlagFile:          "f",
 	FlagVersion:       "v",
+	FlagRecursive:     "R",
+	FlagSelector:      "l",
 }
 
 func GetShortFlags() []string {
@@ -19,9 +19,12 @@
 package main
 
 import (
+	"time"
+
 	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/config"
 	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors"
 	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors/brokerdriver/kafka"
+	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors/receiver/mqtt"
 	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors/receiver/websocket"
 	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors/receiver/websub"
 )
@@ -60,4 +63,20 @@ func registerConnectors(registry *connectors.Registry, cfg *config.Config) {
 			ConsumerGroupPrefix: cfg.Kafka.ConsumerGroupPrefix,
 		})
 	})
+
+	// All MQTT channels share a single listener; it only opens once an MQTT receiver starts.
+	mqttServer := mqtt.NewServer(mqtt.ServerConfig{
+		Port:           cfg.Server.MQTTPort,
+		MaxPacketSize:  cfg.MQTT.MaxPacketSizeBytes,
+		MaxInflight:    cfg.MQTT.MaxInflight,
+		ConnectTimeout: time.Duration(cfg.MQTT.ConnectTimeoutSeconds) * time.Second,
+		APIKeyUsername: cfg.MQTT.APIKeyUsername,
+		APIKeyHeader:   cfg.MQTT.APIKeyHeader,
+	})
+	registry.RegisterReceiver("mqtt", func(ecfg connectors.ReceiverConfig) (connectors.Receiver, error) {
+		return mqtt.NewReceiver(ecfg, mqtt.Options{
+			Server:              mqttServer,
+			ConsumerGroupPrefix: cfg.Kafka.ConsumerGroupPrefix,
+		})
+	})
 }
@@ -52,17 +52,32 @@ type WebSubApiBinding struct {
 	Receiver     ReceiverSpec     `yaml:"receiver"`
 	BrokerDriver BrokerDriverSpec `yaml:"broker-driver"`
 	Policies     PolicyBindings   `yaml:"policies"`
+	CloudEvents  CloudEventsSpec  `yaml:"cloudEvents"`
 }
 
 // ChannelDef defines a single channel (topic) within a WebSubApi.
 type ChannelDef struct {
 	Name     string         `yaml:"name"`
 	Policies PolicyBindings `yaml:"policies"`
+	Message  *MessageDef    `yaml:"message"` // optional payload schema (AsyncAPI message)
+}
+
+// MessageDef describes the payload of a channel, following the AsyncAPI message object.
+type MessageDef struct {
+	ContentType      string                 `yaml:"contentType"`
+	SchemaFormat     string                 `yaml:"schemaFormat"` // AsyncAPI schema format; JSON Schema when empty
+	Payload          map[string]interface{} `yaml:"payload"`
+	OnInvalidMessage string                 `yaml:"onInvalidMessage"` // "reject" (default) or "dead-letter"
+}
+
+// CloudEventsSpec controls how CloudEvents 1.0 events are emitted to subscribers.
+type CloudEventsSpec struct {
+	Mode string `yaml:"mode"` // "binary", "structured" or empty (passthrough)
 }
 
 // ReceiverSpec defines the receiver connector type and configuration.
 type ReceiverSpec struct {
-	Type         string `yaml:"type"` // "websub" or "websocket"
+	Type         string `yaml:"type"` // "websub", "websocket" or "mqtt"
 	Path         string `yaml:"path"`
 	Backpressure string `yaml:"backpressure"` // "drop-oldest", "block", "close"
 }
@@ -92,6 +107,8 @@ type PolicyRef struct {
 	Name    string                 `yaml:"name"`
 	Version string                 `yaml:"version"`
 	Params  map[string]interface{} `yaml:"params"`
+	Timeout string                 `yaml:"timeout,omitempty"`
+	OnError string                 `yaml:"onError,omitempty"`
 }
 
 // ChannelsConfig is the top-level structure of the channels.yaml file.
@@ -126,6 +143,12 @@ func WebSubApiSubscriptionTopic(apiName, version string) string {
 	return JoinNormalizedTopic(apiName, version, "__subscriptions")
 }
 
+// WebSubApiDeadLetterTopic derives the dead-letter topic for a WebSubApi channel.
+// Format: {normalized-api-name}_{normalized-version}_{normalized-channel-name}_{normalized-dead-letter-suffix}
+func WebSubApiDeadLetterTopic(apiName, version, channelName string) string {
+	return JoinNormalizedTopic(apiName, version, channelName, "__deadletter")
+}
+
 // WebSubApiBasePath derives the shared WebSub HTTP base path for an API.
 // It accepts base contexts ("/repos"), version templates ("/repos/$version"),
 // and already-resolved paths ("/repos/v1") without duplicating the version.
@@ -36,6 +36,7 @@ type Config struct {
 	Server       ServerConfig       `koanf:"server"`
 	Kafka        KafkaConfig        `koanf:"kafka"`
 	WebSub       WebSubConfig       `koanf:"websub"`
+	MQTT         MQTTConfig         `koanf:"mqtt"`
 	PolicyEngine PolicyEngineConfig `koanf:"policy_engine"`
 	ControlPlane ControlPlaneConfig `koanf:"controlplane"`
 	Logging      LoggingConfig      `koanf:"logging"`
@@ -51,6 +52,7 @@ type ServerConfig struct {
 	WebSubTLSCertFile string `koanf:"websub_tls_cert_file"`
 	WebSubTLSKeyFile  string `koanf:"websub_tls_key_file"`
 	WebSocketPort     int    `koanf:"websocket_port"`
+	MQTTPort          int    `koanf:"mqtt_port"`
 	AdminPort         int    `koanf:"admin_port"`
 	MetricsPort       int    `koanf:"metrics_port"`
 }
@@ -80,6 +82,15 @@ type WebSubConfig struct {
 	SubscriptionsTopicName     string `koanf:"subscriptions_topic_name"`
 }
 
+// MQTTConfig holds MQTT receiver settings.
+type MQTTConfig struct {
+	MaxPacketSizeBytes    int    `koanf:"max_packet_size_bytes"`
+	MaxInflight           int    `koanf:"max_inflight"`
+	ConnectTimeoutSeconds int    `koanf:"connect_timeout_seconds"`
+	APIKeyUsername        string `koanf:"api_key_username"`
+	APIKeyHeader          string `koanf:"api_key_header"`
+}
+
 // PolicyEngineConfig points to the policy engine configuration.
 type PolicyEngineConfig struct {
 	ConfigFile string `koanf:"config_file"`
@@ -107,6 +118,7 @@ func DefaultConfig() *Config {
 			WebSubHTTPPort:  8080,
 			WebSubHTTPSPort: 8443,
 			WebSocketPort:   8081,
+			MQTTPort:        1883,
 			AdminPort:       9002,
 			MetricsPort:     9003,
 		},
@@ -123,6 +135,13 @@ func DefaultConfig() *Config {
 			DefaultLeaseSeconds:        0,
 			SubscriptionsTopicName:     "__subscriptions",
 		},
+		MQTT: MQTTConfig{
+			MaxPacketSizeBytes:    1048576,
+			MaxInflight:           64,
+			ConnectTimeoutSeconds: 10,
+			APIKeyUsername:        "apikey",
+			APIKeyHeader:          "api-key",
+		},
 		Logging: LoggingConfig{
 			Level:  "info",
 			Format: "text",
@@ -176,6 +195,7 @@ func Load(path string) (*Config, map[string]interface{}, error) {
 		"websub_https_port", cfg.Server.WebSubHTTPSPort,
 		"websub_tls_enabled", cfg.Server.WebSubTLSEnabled,
 		"websocket_port", cfg.Server.WebSocketPort,
+		"mqtt_port", cfg.Server.MQTTPort,
 		"admin_port", cfg.Server.AdminPort,
 		"kafka_brokers", cfg.Kafka.Brokers,
 		"log_level", cfg.Logging.Level,
@@ -197,6 +217,8 @@ func mapEnvKey(key string) string {
 		return "kafka." + strings.TrimPrefix(name, "kafka_")
 	case strings.HasPrefix(name, "websub_"):
 		return "websub." + strings.TrimPrefix(name, "websub_")
+	case strings.HasPrefix(name, "mqtt_"):
+		return "mqtt." + strings.TrimPrefix(name, "mqtt_")
 	case strings.HasPrefix(name, "policy_engine_"):
 		return "policy_engine." + strings.TrimPrefix(name, "policy_engine_")
 	case strings.HasPrefix(name, "controlplane_"):
@@ -221,6 +243,7 @@ func mapEnvValue(path, value string) interface{} {
 	case "server.websub_http_port",
 		"server.websub_https_port",
 		"server.websocket_port",
+		"server.mqtt_port",
 		"server.admin_port",
 		"server.metrics_port",
 		"websub.verification_timeout_seconds",
@@ -228,7 +251,10 @@ func mapEnvValue(path, value string) interface{} {
 		"websub.delivery_initial_delay_ms",
 		"websub.delivery_max_delay_ms",
 		"websub.delivery_concurrency",
-		"websub.default_lease_seconds":
+		"websub.default_lease_seconds",
+		"mqtt.max_packet_size_bytes",
+		"mqtt.max_inflight",
+		"mqtt.connect_timeout_seconds":
 		if n, err := strconv.Atoi(value); err == nil {
 			return n
 		}
@@ -288,6 +314,26 @@ func validate(cfg *Config) error {
 		return err
 	}
 
+	if err := validateMQTTConfig(cfg.MQTT); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMQTTConfig(mqttCfg MQTTConfig) error {
+	if mqttCfg.MaxPacketSizeBytes <= 0 {
+		return fmt.Errorf("mqtt.max_packet_size_bytes must be a positive integer, got %d", mqttCfg.MaxPacketSizeBytes)
+	}
+	if mqttCfg.MaxInflight <= 0 || mqttCfg.MaxInflight > 65535 {
+		return fmt.Errorf("mqtt.max_inflight must be between 1 and 65535, got %d", mqttCfg.MaxInflight)
+	}
+	if mqttCfg.ConnectTimeoutSeconds <= 0 {
+		return fmt.Errorf("mqtt.connect_timeout_seconds must be a positive integer, got %d", mqttCfg.ConnectTimeoutSeconds)
+	}
+	if mqttCfg.APIKeyUsername != "" && strings.TrimSpace(mqttCfg.APIKeyHeader) == "" {
+		return fmt.Errorf("mqtt.api_key_header is required when mqtt.api_key_username is set")
+	}
 	return nil
 }
 
@@ -346,6 +392,7 @@ func validateServerPorts(serverCfg ServerConfig) error {
 		{name: "server.websub_http_port", value: serverCfg.WebSubHTTPPort},
 		{name: "server.websub_https_port", value: serverCfg.WebSubHTTPSPort},
 		{name: "server.websocket_port", value: serverCfg.WebSocketPort},
+		{name: "server.mqtt_port", value: serverCfg.MQTTPort},
 		{name: "server.admin_port", value: serverCfg.AdminPort},
 		{name: "server.metrics_port", value: serverCfg.MetricsPort},
 	}
@@ -271,3 +271,23 @@ metrics_port = 9003
 		t.Fatalf("expected error %q, got %q", want, err.Error())
 	}
 }
+
+func TestLoadRejectsInvalidMQTTInflight(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(configPath, []byte(`
+[mqtt]
+max_inflight = 70000
+`), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	_, _, err := Load(configPath)
+	if err == nil {
+		t.Fatalf("expected load to fail for out-of-range mqtt.max_inflight")
+	}
+
+	want := "mqtt.max_inflight must be between 1 and 65535, got 70000"
+	if err.Error() != want {
+		t.Fatalf("expected error %q, got %q", want, err.Error())
+	}
+}
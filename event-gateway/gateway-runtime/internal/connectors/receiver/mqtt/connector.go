@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mqtt
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+
+	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors"
+)
+
+// Options holds MQTT-specific configuration passed at registration time.
+type Options struct {
+	Server              *Server // shared listener for all MQTT channels
+	ConsumerGroupPrefix string
+}
+
+// MQTTReceiver bridges MQTT 3.1.1/5 device clients to a broker-backed channel.
+// Client PUBLISH packets run the inbound chain and are forwarded to the broker;
+// each client SUBSCRIBE runs the subscribe chain and gets a dedicated broker-driver
+// consumer whose messages run the outbound chain before delivery (1:1 passthrough).
+type MQTTReceiver struct {
+	server       *Server
+	channel      connectors.ChannelInfo
+	processor    connectors.MessageProcessor
+	brokerDriver connectors.BrokerDriver
+	opts         Options
+	routes       map[string]topicRoute
+
+	mu      sync.Mutex
+	started bool
+}
+
+// NewReceiver creates an MQTT receiver for a single channel binding and registers
+// its topics on the shared MQTT server provided in opts.
+func NewReceiver(cfg connectors.ReceiverConfig, opts Options) (connectors.Receiver, error) {
+	if opts.Server == nil {
+		return nil, fmt.Errorf("MQTT receiver for channel %q requires a shared MQTT server", cfg.Channel.Name)
+	}
+
+	e := &MQTTReceiver{
+		server:       opts.Server,
+		channel:      cfg.Channel,
+		processor:    cfg.Processor,
+		brokerDriver: cfg.BrokerDriver,
+		opts:         opts,
+	}
+
+	routes := TopicsForChannel(cfg.Channel)
+	for topic, route := range routes {
+		if !validTopicName(topic) {
+			return nil, fmt.Errorf("channel %q maps to invalid MQTT topic %q", cfg.Channel.Name, topic)
+		}
+		route.receiver = e
+		routes[topic] = route
+	}
+	if err := opts.Server.register(routes); err != nil {
+		return nil, err
+	}
+	e.routes = routes
+
+	return e, nil
+}
+
+// Start ensures the broker topics exist and opens the shared MQTT listener.
+func (e *MQTTReceiver) Start(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.started {
+		return nil
+	}
+
+	brokerTopics := make([]string, 0, len(e.routes))
+	mqttTopics := make([]string, 0, len(e.routes))
+	for topic, route := range e.routes {
+		brokerTopics = append(brokerTopics, route.brokerTopic)
+		mqttTopics = append(mqttTopics, topic)
+	}
+	if err := e.brokerDriver.EnsureTopics(ctx, brokerTopics); err != nil {
+		return fmt.Errorf("failed to ensure broker topics: %w", err)
+	}
+
+	if err := e.server.acquire(); err != nil {
+		return err
+	}
+	e.started = true
+
+	sort.Strings(mqttTopics)
+	slog.Info("MQTT receiver started",
+		"channel", e.channel.Name,
+		"topics", mqttTopics,
+	)
+	return nil
+}
+
+// Stop removes the channel's topics from the shared server, stops the client
+// subscriptions bound to them and releases the listener.
+func (e *MQTTReceiver) Stop(ctx context.Context) error {
+	e.server.unregister(e)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.started {
+		e.server.release(ctx)
+		e.started = false
+	}
+	return nil
+}
@@ -0,0 +1,553 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors"
+)
+
+type fakeProcessor struct {
+	mu              sync.Mutex
+	rejectInbound   bool
+	rejectSubscribe bool
+	subscribed      []*connectors.Message
+	inbound         []map[string][]string // headers seen by the inbound chain
+}
+
+func (p *fakeProcessor) ProcessSubscribe(_ context.Context, _ string, msg *connectors.Message) (*connectors.Message, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subscribed = append(p.subscribed, msg)
+	return msg, p.rejectSubscribe, nil
+}
+
+func (p *fakeProcessor) ProcessUnsubscribe(_ context.Context, _ string, msg *connectors.Message) (*connectors.Message, bool, error) {
+	return msg, false, nil
+}
+
+func (p *fakeProcessor) ProcessInbound(_ context.Context, _ string, msg *connectors.Message) (*connectors.Message, bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	headers := make(map[string][]string, len(msg.Headers))
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+	p.inbound = append(p.inbound, headers)
+	return msg, p.rejectInbound, nil
+}
+
+func (p *fakeProcessor) ProcessOutbound(_ context.Context, _ string, msg *connectors.Message) (*connectors.Message, bool, error) {
+	return msg, false, nil
+}
+
+type fakeConsumer struct {
+	started chan struct{}
+}
+
+func (c *fakeConsumer) Start(context.Context) error {
+	close(c.started)
+	return nil
+}
+
+func (c *fakeConsumer) Stop(context.Context) error { return nil }
+
+type fakeBrokerDriver struct {
+	mu         sync.Mutex
+	publishErr error
+	published  map[string][]*connectors.Message
+	handlers   map[string]connectors.MessageHandler
+	consumers  map[string]*fakeConsumer
+}
+
+func newFakeBrokerDriver() *fakeBrokerDriver {
+	return &fakeBrokerDriver{
+		published: make(map[string][]*connectors.Message),
+		handlers:  make(map[string]connectors.MessageHandler),
+		consumers: make(map[string]*fakeConsumer),
+	}
+}
+
+func (b *fakeBrokerDriver) Publish(_ context.Context, topic string, msg *connectors.Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.publishErr != nil {
+		return b.publishErr
+	}
+	b.published[topic] = append(b.published[topic], msg)
+	return nil
+}
+
+func (b *fakeBrokerDriver) Subscribe(_ string, topics []string, handler connectors.MessageHandler) (connectors.Receiver, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := &fakeConsumer{started: make(chan struct{})}
+	b.handlers[topics[0]] = handler
+	b.consumers[topics[0]] = c
+	return c, nil
+}
+
+func (b *fakeBrokerDriver) consumer(topic string) (*fakeConsumer, connectors.MessageHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.consumers[topic], b.handlers[topic]
+}
+
+func (b *fakeBrokerDriver) SubscribeManual(string, []string, connectors.MessageHandler) (connectors.Receiver, error) {
+	return nil, errors.New("not implemented")
+}
+func (b *fakeBrokerDriver) Replay(context.Context, string, connectors.MessageHandler) error {
+	return nil
+}
+func (b *fakeBrokerDriver) TopicExists(context.Context, string) (bool, error) { return true, nil }
+func (b *fakeBrokerDriver) EnsureTopics(context.Context, []string) error      { return nil }
+func (b *fakeBrokerDriver) EnsureCompactedTopic(context.Context, string) error {
+	return nil
+}
+func (b *fakeBrokerDriver) DeleteTopics(context.Context, []string) error { return nil }
+func (b *fakeBrokerDriver) Close() error                                 { return nil }
+
+type testClient struct {
+	t       *testing.T
+	conn    net.Conn
+	reader  *bufio.Reader
+	version byte
+}
+
+func startTestReceiver(t *testing.T, processor *fakeProcessor, broker *fakeBrokerDriver) *Server {
+	t.Helper()
+	return startTestReceiverWithConfig(t, ServerConfig{ListenerAddress: "127.0.0.1:0", APIKeyUsername: "apikey", APIKeyHeader: "api-key"}, processor, broker)
+}
+
+func startTestReceiverWithConfig(t *testing.T, config ServerConfig, processor *fakeProcessor, broker *fakeBrokerDriver) *Server {
+	t.Helper()
+	server := NewServer(config)
+	receiver, err := NewReceiver(connectors.ReceiverConfig{
+		Channel: connectors.ChannelInfo{
+			Name:              "device-telemetry",
+			Mode:              "protocol-mediation",
+			Context:           "/telemetry",
+			Version:           "v1",
+			PublicTopic:       "readings",
+			BrokerDriverTopic: "telemetry_v1_readings",
+		},
+		Processor:    processor,
+		BrokerDriver: broker,
+	}, Options{Server: server, ConsumerGroupPrefix: "egw"})
+	if err != nil {
+		t.Fatalf("NewReceiver() error = %v", err)
+	}
+	if err := receiver.Start(context.Background()); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	t.Cleanup(func() {
+		_ = receiver.Stop(context.Background())
+	})
+	return server
+}
+
+func dialTestClient(t *testing.T, server *Server, version byte, username, password string) (*testClient, *properties) {
+	t.Helper()
+	conn, err := net.Dial("tcp", server.Addr().String())
+	if err != nil {
+		t.Fatalf("dial error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	c := &testClient{t: t, conn: conn, reader: bufio.NewReader(conn), version: version}
+
+	var e encoder
+	e.string("MQTT")
+	e.byte(version)
+	flags := byte(0x02)
+	if username != "" {
+		flags |= 0x80
+	}
+	if password != "" {
+		flags |= 0x40
+	}
+	e.byte(flags)
+	e.uint16(30)
+	if version == protocolV5 {
+		e.properties(nil)
+	}
+	e.string("")
+	if username != "" {
+		e.string(username)
+	}
+	if password != "" {
+		e.string(password)
+	}
+	c.send(packetConnect, 0, e.buf.Bytes())
+
+	header, body := c.read()
+	if header.Type != packetConnack {
+		t.Fatalf("expected CONNACK, got packet type %d", header.Type)
+	}
+	d := decoder{buf: body}
+	_ = d.byte()
+	if code := d.byte(); code != 0 {
+		t.Fatalf("expected CONNACK success, got code 0x%02x", code)
+	}
+	var props properties
+	if version == protocolV5 {
+		props = d.properties()
+	}
+	if d.err != nil {
+		t.Fatalf("failed to decode CONNACK: %v", d.err)
+	}
+	return c, &props
+}
+
+func (c *testClient) send(packetType, flags byte, body []byte) {
+	c.t.Helper()
+	if err := writePacket(c.conn, packetType, flags, body); err != nil {
+		c.t.Fatalf("write error = %v", err)
+	}
+}
+
+func (c *testClient) read() (fixedHeader, []byte) {
+	c.t.Helper()
+	header, body, err := readPacket(c.reader, 0)
+	if err != nil {
+		c.t.Fatalf("read error = %v", err)
+	}
+	return header, body
+}
+
+func (c *testClient) publish(topic string, qos byte, packetID uint16, payload string) {
+	flags, body := encodePublish(c.version, &publishPacket{Topic: topic, QoS: qos, PacketID: packetID, Payload: []byte(payload)})
+	c.send(packetPublish, flags, body)
+}
+
+func (c *testClient) subscribe(packetID uint16, filter string, qos byte) []byte {
+	c.t.Helper()
+	var e encoder
+	e.uint16(packetID)
+	if c.version == protocolV5 {
+		e.properties(nil)
+	}
+	e.string(filter)
+	e.byte(qos)
+	c.send(packetSubscribe, 0x02, e.buf.Bytes())
+
+	header, body := c.read()
+	if header.Type != packetSuback {
+		c.t.Fatalf("expected SUBACK, got packet type %d", header.Type)
+	}
+	d := decoder{buf: body}
+	if id := d.uint16(); id != packetID {
+		c.t.Fatalf("expected SUBACK for packet %d, got %d", packetID, id)
+	}
+	if c.version == protocolV5 {
+		_ = d.properties()
+	}
+	return d.rest()
+}
+
+func TestPublishQoS1IsAcknowledgedAfterBrokerAccepts(t *testing.T) {
+	processor := &fakeProcessor{}
+	broker := newFakeBrokerDriver()
+	server := startTestReceiver(t, processor, broker)
+
+	client, _ := dialTestClient(t, server, protocolV311, "device-1", "s3cret")
+	client.publish("telemetry/v1/readings", 1, 7, `{"temp":21}`)
+
+	header, body := client.read()
+	if header.Type != packetPuback {
+		t.Fatalf("expected PUBACK, got packet type %d", header.Type)
+	}
+	ack, err := decodeAck(body)
+	if err != nil || ack.PacketID != 7 {
+		t.Fatalf("expected PUBACK for packet 7, got %+v (err %v)", ack, err)
+	}
+
+	broker.mu.Lock()
+	published := broker.published["telemetry_v1_readings"]
+	broker.mu.Unlock()
+	if len(published) != 1 || string(published[0].Value) != `{"temp":21}` {
+		t.Fatalf("expected one message published to the broker topic, got %#v", published)
+	}
+	if _, ok := published[0].Headers["authorization"]; ok {
+		t.Fatal("expected CONNECT credentials to be stripped before publishing to the broker")
+	}
+
+	processor.mu.Lock()
+	defer processor.mu.Unlock()
+	if len(processor.inbound) != 1 {
+		t.Fatalf("expected inbound chain to run once, got %d", len(processor.inbound))
+	}
+	if got := processor.inbound[0]["authorization"]; len(got) != 1 || got[0] != "Basic ZGV2aWNlLTE6czNjcmV0" {
+		t.Fatalf("expected basic credentials in policy headers, got %#v", got)
+	}
+}
+
+func TestPublishRejectedByPolicyReturnsNotAuthorizedToV5Clients(t *testing.T) {
+	processor := &fakeProcessor{rejectInbound: true}
+	broker := newFakeBrokerDriver()
+	server := startTestReceiver(t, processor, broker)
+
+	client, props := dialTestClient(t, server, protocolV5, "", "eyJhbGciOi.eyJzdWIi.sig")
+	if props.AssignedClientID == "" {
+		t.Fatal("expected an assigned client identifier for an empty client id")
+	}
+	if props.MaximumQoS == nil || *props.MaximumQoS != 1 {
+		t.Fatalf("expected maximum QoS 1 to be advertised, got %v", props.MaximumQoS)
+	}
+
+	client.publish("telemetry/v1/readings", 1, 3, "payload")
+	header, body := client.read()
+	if header.Type != packetPuback {
+		t.Fatalf("expected PUBACK, got packet type %d", header.Type)
+	}
+	ack, err := decodeAck(body)
+	if err != nil || ack.ReasonCode != reasonNotAuthorized {
+		t.Fatalf("expected not-authorized PUBACK, got %+v (err %v)", ack, err)
+	}
+
+	processor.mu.Lock()
+	got := processor.inbound[0]["authorization"]
+	processor.mu.Unlock()
+	if len(got) != 1 || got[0] != "Bearer eyJhbGciOi.eyJzdWIi.sig" {
+		t.Fatalf("expected bearer token in policy headers, got %#v", got)
+	}
+
+	broker.mu.Lock()
+	defer broker.mu.Unlock()
+	if len(broker.published) != 0 {
+		t.Fatalf("expected nothing to be published, got %#v", broker.published)
+	}
+}
+
+func TestPublishFailureDisconnectsV311ClientWithoutAck(t *testing.T) {
+	broker := newFakeBrokerDriver()
+	broker.publishErr = errors.New("broker unavailable")
+	server := startTestReceiver(t, &fakeProcessor{}, broker)
+
+	client, _ := dialTestClient(t, server, protocolV311, "", "")
+	client.publish("telemetry/v1/readings", 1, 9, "payload")
+
+	if _, _, err := readPacket(client.reader, 0); err == nil {
+		t.Fatal("expected the connection to be closed without a PUBACK")
+	}
+}
+
+func TestRejectedPublishDisconnectsV311ClientWithoutAck(t *testing.T) {
+	tests := []struct {
+		name      string
+		processor *fakeProcessor
+		topic     string
+	}{
+		{name: "unbound topic", processor: &fakeProcessor{}, topic: "unknown/topic"},
+		{name: "rejected by policy", processor: &fakeProcessor{rejectInbound: true}, topic: "telemetry/v1/readings"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broker := newFakeBrokerDriver()
+			server := startTestReceiver(t, tt.processor, broker)
+
+			client, _ := dialTestClient(t, server, protocolV311, "", "")
+			client.publish(tt.topic, 1, 5, "payload")
+
+			if _, _, err := readPacket(client.reader, 0); err == nil {
+				t.Fatal("expected the connection to be closed without a PUBACK")
+			}
+			broker.mu.Lock()
+			defer broker.mu.Unlock()
+			if len(broker.published) != 0 {
+				t.Fatalf("expected nothing to be published, got %#v", broker.published)
+			}
+		})
+	}
+}
+
+func TestDeliveryWaitsForInflightWindow(t *testing.T) {
+	broker := newFakeBrokerDriver()
+	server := startTestReceiverWithConfig(t, ServerConfig{ListenerAddress: "127.0.0.1:0", MaxInflight: 1}, &fakeProcessor{}, broker)
+
+	client, _ := dialTestClient(t, server, protocolV311, "", "")
+	client.subscribe(1, "telemetry/v1/readings", 1)
+	consumer, handler := broker.consumer("telemetry_v1_readings")
+	<-consumer.started
+
+	deliver := func(payload string) chan error {
+		done := make(chan error, 1)
+		go func() {
+			done <- handler(context.Background(), &connectors.Message{Value: []byte(payload), Topic: "telemetry_v1_readings"})
+		}()
+		return done
+	}
+
+	if err := <-deliver("first"); err != nil {
+		t.Fatalf("first delivery error = %v", err)
+	}
+	header, body := client.read()
+	first, err := decodePublish(protocolV311, header.Flags, body)
+	if err != nil || string(first.Payload) != "first" {
+		t.Fatalf("expected the first message, got %+v (err %v)", first, err)
+	}
+
+	// The window is full, so the second delivery holds the consumer back
+	second := deliver("second")
+	select {
+	case err := <-second:
+		t.Fatalf("expected the delivery to wait for a PUBACK, returned %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	client.send(packetPuback, 0, encodeAck(protocolV311, first.PacketID, reasonSuccess))
+	select {
+	case err := <-second:
+		if err != nil {
+			t.Fatalf("second delivery error = %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected the delivery to continue after the PUBACK")
+	}
+	header, body = client.read()
+	if p, err := decodePublish(protocolV311, header.Flags, body); err != nil || string(p.Payload) != "second" {
+		t.Fatalf("expected the second message, got %+v (err %v)", p, err)
+	}
+
+	// A delivery that cannot get a slot before its context ends fails instead of being dropped
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := handler(ctx, &connectors.Message{Value: []byte("third"), Topic: "telemetry_v1_readings"}); err == nil {
+		t.Fatal("expected an error when the in-flight window stays full")
+	}
+}
+
+func TestSubscribeDeliversBrokerMessages(t *testing.T) {
+	processor := &fakeProcessor{}
+	broker := newFakeBrokerDriver()
+	server := startTestReceiver(t, processor, broker)
+
+	client, _ := dialTestClient(t, server, protocolV5, "apikey", "key-123")
+	codes := client.subscribe(1, "telemetry/+/readings", 2)
+	if len(codes) != 1 || codes[0] != 1 {
+		t.Fatalf("expected QoS 1 to be granted, got %v", codes)
+	}
+
+	processor.mu.Lock()
+	if len(processor.subscribed) != 1 {
+		t.Fatalf("expected subscribe chain to run once, got %d", len(processor.subscribed))
+	}
+	if got := processor.subscribed[0].Headers["api-key"]; len(got) != 1 || got[0] != "key-123" {
+		t.Fatalf("expected API key header in subscribe policy headers, got %#v", got)
+	}
+	processor.mu.Unlock()
+
+	consumer, handler := broker.consumer("telemetry_v1_readings")
+	if consumer == nil {
+		t.Fatal("expected a broker-driver consumer for the subscription")
+	}
+	select {
+	case <-consumer.started:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected the consumer to be started after SUBACK")
+	}
+
+	err := handler(context.Background(), &connectors.Message{
+		Value:   []byte("21.5"),
+		Headers: map[string][]string{"content-type": {"text/plain"}, "x-device": {"d1"}},
+		Topic:   "telemetry_v1_readings",
+	})
+	if err != nil {
+		t.Fatalf("delivery handler error = %v", err)
+	}
+
+	header, body := client.read()
+	if header.Type != packetPublish {
+		t.Fatalf("expected PUBLISH, got packet type %d", header.Type)
+	}
+	p, err := decodePublish(protocolV5, header.Flags, body)
+	if err != nil {
+		t.Fatalf("decodePublish() error = %v", err)
+	}
+	if p.Topic != "telemetry/v1/readings" || p.QoS != 1 || p.PacketID == 0 || string(p.Payload) != "21.5" {
+		t.Fatalf("unexpected delivered PUBLISH: %+v", p)
+	}
+	if p.Props.ContentType != "text/plain" {
+		t.Fatalf("expected content type property, got %q", p.Props.ContentType)
+	}
+	if len(p.Props.UserProperties) != 1 || p.Props.UserProperties[0] != (userProperty{Key: "x-device", Value: "d1"}) {
+		t.Fatalf("expected headers as user properties, got %#v", p.Props.UserProperties)
+	}
+}
+
+func TestSubscribeRejectedByPolicy(t *testing.T) {
+	broker := newFakeBrokerDriver()
+	server := startTestReceiver(t, &fakeProcessor{rejectSubscribe: true}, broker)
+
+	client, _ := dialTestClient(t, server, protocolV311, "", "")
+	codes := client.subscribe(1, "telemetry/v1/readings", 0)
+	if len(codes) != 1 || codes[0] != 0x80 {
+		t.Fatalf("expected SUBACK failure, got %v", codes)
+	}
+
+	codes = client.subscribe(2, "unknown/topic", 0)
+	if len(codes) != 1 || codes[0] != 0x80 {
+		t.Fatalf("expected SUBACK failure for an unbound topic, got %v", codes)
+	}
+
+	if consumer, _ := broker.consumer("telemetry_v1_readings"); consumer != nil {
+		t.Fatal("expected no broker-driver consumer for a rejected subscription")
+	}
+}
+
+func TestTopicsForChannel(t *testing.T) {
+	routes := TopicsForChannel(connectors.ChannelInfo{
+		Context:  "/repos/$version",
+		Version:  "v2",
+		Channels: map[string]string{"issues": "repos_v2_issues"},
+	})
+	route, ok := routes["repos/v2/issues"]
+	if !ok || route.brokerTopic != "repos_v2_issues" || route.channelName != "issues" {
+		t.Fatalf("unexpected routes %#v", routes)
+	}
+}
+
+func TestTopicMatches(t *testing.T) {
+	tests := []struct {
+		filter string
+		topic  string
+		want   bool
+	}{
+		{"a/b/c", "a/b/c", true},
+		{"a/+/c", "a/b/c", true},
+		{"a/#", "a", true},
+		{"a/#", "a/b/c", true},
+		{"#", "$SYS/x", false},
+		{"a/+", "a/b/c", false},
+		{"a/b", "a/b/c", false},
+	}
+	for _, tt := range tests {
+		if got := topicMatches(tt.filter, tt.topic); got != tt.want {
+			t.Errorf("topicMatches(%q, %q) = %v, want %v", tt.filter, tt.topic, got, tt.want)
+		}
+	}
+}
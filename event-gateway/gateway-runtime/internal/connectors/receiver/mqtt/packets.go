@@ -0,0 +1,697 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mqtt
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// MQTT control packet types.
+const (
+	packetConnect     byte = 1
+	packetConnack     byte = 2
+	packetPublish     byte = 3
+	packetPuback      byte = 4
+	packetPubrec      byte = 5
+	packetPubrel      byte = 6
+	packetPubcomp     byte = 7
+	packetSubscribe   byte = 8
+	packetSuback      byte = 9
+	packetUnsubscribe byte = 10
+	packetUnsuback    byte = 11
+	packetPingreq     byte = 12
+	packetPingresp    byte = 13
+	packetDisconnect  byte = 14
+	packetAuth        byte = 15
+)
+
+// Supported protocol levels.
+const (
+	protocolV311 byte = 4
+	protocolV5   byte = 5
+)
+
+// MQTT 3.1.1 CONNACK return codes.
+const (
+	connackAccepted             byte = 0x00
+	connackUnacceptableProtocol byte = 0x01
+	connackIdentifierRejected   byte = 0x02
+)
+
+// MQTT 5 reason codes used by the receiver.
+const (
+	reasonSuccess               byte = 0x00
+	reasonNoSubscriptionExisted byte = 0x11
+	reasonUnspecifiedError      byte = 0x80
+	reasonMalformedPacket       byte = 0x81
+	reasonProtocolError         byte = 0x82
+	reasonNotAuthorized         byte = 0x87
+	reasonTopicFilterInvalid    byte = 0x8F
+	reasonTopicNameInvalid      byte = 0x90
+	reasonPacketTooLarge        byte = 0x95
+	reasonQoSNotSupported       byte = 0x9B
+	reasonSharedSubNotSupported byte = 0x9E
+)
+
+// MQTT 5 property identifiers.
+const (
+	propPayloadFormat        byte = 0x01
+	propMessageExpiry        byte = 0x02
+	propContentType          byte = 0x03
+	propResponseTopic        byte = 0x08
+	propCorrelationData      byte = 0x09
+	propSubscriptionID       byte = 0x0B
+	propSessionExpiry        byte = 0x11
+	propAssignedClientID     byte = 0x12
+	propServerKeepAlive      byte = 0x13
+	propAuthMethod           byte = 0x15
+	propAuthData             byte = 0x16
+	propRequestProblemInfo   byte = 0x17
+	propWillDelay            byte = 0x18
+	propRequestResponseInfo  byte = 0x19
+	propResponseInfo         byte = 0x1A
+	propServerReference      byte = 0x1C
+	propReasonString         byte = 0x1F
+	propReceiveMaximum       byte = 0x21
+	propTopicAliasMaximum    byte = 0x22
+	propTopicAlias           byte = 0x23
+	propMaximumQoS           byte = 0x24
+	propRetainAvailable      byte = 0x25
+	propUserProperty         byte = 0x26
+	propMaximumPacketSize    byte = 0x27
+	propWildcardSubAvailable byte = 0x28
+	propSubIDAvailable       byte = 0x29
+	propSharedSubAvailable   byte = 0x2A
+)
+
+// maxRemainingLength is the largest value a variable byte integer can encode.
+const maxRemainingLength = 268435455
+
+var errMalformedPacket = errors.New("malformed MQTT packet")
+
+// errPacketTooLarge is returned when a packet exceeds the configured maximum size.
+var errPacketTooLarge = errors.New("MQTT packet exceeds maximum packet size")
+
+// userProperty is an MQTT 5 user property (UTF-8 string pair).
+type userProperty struct {
+	Key   string
+	Value string
+}
+
+// properties holds the MQTT 5 properties the receiver reads or writes.
+// Properties that are not modelled are skipped on decode.
+type properties struct {
+	ContentType          string
+	ReasonString         string
+	AssignedClientID     string
+	UserProperties       []userProperty
+	ReceiveMaximum       uint16
+	TopicAlias           uint16
+	SubscriptionIDs      []int
+	MaximumQoS           *byte
+	RetainAvailable      *byte
+	WildcardSubAvailable *byte
+	SharedSubAvailable   *byte
+	SubIDAvailable       *byte
+	TopicAliasMaximum    *uint16
+}
+
+// fixedHeader is the first part of every MQTT control packet.
+type fixedHeader struct {
+	Type  byte
+	Flags byte
+}
+
+// connectPacket is a decoded CONNECT packet.
+type connectPacket struct {
+	ProtocolName  string
+	ProtocolLevel byte
+	CleanStart    bool
+	KeepAlive     uint16
+	ClientID      string
+	HasUsername   bool
+	Username      string
+	HasPassword   bool
+	Password      []byte
+	Props         properties
+}
+
+// publishPacket is a decoded or to-be-encoded PUBLISH packet.
+type publishPacket struct {
+	Topic    string
+	QoS      byte
+	Retain   bool
+	Dup      bool
+	PacketID uint16
+	Payload  []byte
+	Props    properties
+}
+
+// subscription is a single topic filter entry of a SUBSCRIBE packet.
+type subscription struct {
+	Filter string
+	QoS    byte
+}
+
+// subscribePacket is a decoded SUBSCRIBE packet.
+type subscribePacket struct {
+	PacketID      uint16
+	Subscriptions []subscription
+	Props         properties
+}
+
+// unsubscribePacket is a decoded UNSUBSCRIBE packet.
+type unsubscribePacket struct {
+	PacketID uint16
+	Filters  []string
+}
+
+// ackPacket is a decoded PUBACK-style acknowledgement.
+type ackPacket struct {
+	PacketID   uint16
+	ReasonCode byte
+}
+
+// readPacket reads a single control packet from r and returns its fixed header and body.
+// maxSize bounds the remaining length; zero disables the limit.
+func readPacket(r *bufio.Reader, maxSize int) (fixedHeader, []byte, error) {
+	first, err := r.ReadByte()
+	if err != nil {
+		return fixedHeader{}, nil, err
+	}
+	length, err := readVarint(r)
+	if err != nil {
+		return fixedHeader{}, nil, err
+	}
+	if maxSize > 0 && length > maxSize {
+		return fixedHeader{}, nil, errPacketTooLarge
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return fixedHeader{}, nil, err
+	}
+	return fixedHeader{Type: first >> 4, Flags: first & 0x0F}, body, nil
+}
+
+// writePacket frames body with the fixed header and writes it to w.
+func writePacket(w io.Writer, packetType, flags byte, body []byte) error {
+	var buf bytes.Buffer
+	buf.Grow(len(body) + 5)
+	buf.WriteByte(packetType<<4 | flags&0x0F)
+	writeVarint(&buf, len(body))
+	buf.Write(body)
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func decodeConnect(body []byte) (*connectPacket, error) {
+	d := decoder{buf: body}
+	p := &connectPacket{}
+	p.ProtocolName = d.string()
+	p.ProtocolLevel = d.byte()
+	flags := d.byte()
+	p.KeepAlive = d.uint16()
+	if d.err != nil {
+		return nil, d.err
+	}
+	if flags&0x01 != 0 {
+		return nil, fmt.Errorf("%w: reserved CONNECT flag set", errMalformedPacket)
+	}
+	if p.ProtocolLevel != protocolV311 && p.ProtocolLevel != protocolV5 {
+		// Return what we have so the caller can reply with the right CONNACK.
+		return p, nil
+	}
+	p.CleanStart = flags&0x02 != 0
+	willFlag := flags&0x04 != 0
+	p.HasPassword = flags&0x40 != 0
+	p.HasUsername = flags&0x80 != 0
+
+	if p.ProtocolLevel == protocolV5 {
+		p.Props = d.properties()
+	}
+	p.ClientID = d.string()
+	if willFlag {
+		if p.ProtocolLevel == protocolV5 {
+			_ = d.properties()
+		}
+		_ = d.string() // will topic
+		_ = d.binary() // will payload
+	}
+	if p.HasUsername {
+		p.Username = d.string()
+	}
+	if p.HasPassword {
+		p.Password = d.binary()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return p, nil
+}
+
+func decodePublish(version, flags byte, body []byte) (*publishPacket, error) {
+	d := decoder{buf: body}
+	p := &publishPacket{
+		Dup:    flags&0x08 != 0,
+		QoS:    (flags >> 1) & 0x03,
+		Retain: flags&0x01 != 0,
+	}
+	if p.QoS > 2 {
+		return nil, fmt.Errorf("%w: invalid QoS %d", errMalformedPacket, p.QoS)
+	}
+	p.Topic = d.string()
+	if p.QoS > 0 {
+		p.PacketID = d.uint16()
+	}
+	if version == protocolV5 {
+		p.Props = d.properties()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	p.Payload = d.rest()
+	return p, nil
+}
+
+func encodePublish(version byte, p *publishPacket) (byte, []byte) {
+	var e encoder
+	e.string(p.Topic)
+	if p.QoS > 0 {
+		e.uint16(p.PacketID)
+	}
+	if version == protocolV5 {
+		e.properties(&p.Props)
+	}
+	e.buf.Write(p.Payload)
+
+	flags := p.QoS << 1
+	if p.Dup {
+		flags |= 0x08
+	}
+	if p.Retain {
+		flags |= 0x01
+	}
+	return flags, e.buf.Bytes()
+}
+
+func decodeSubscribe(version byte, body []byte) (*subscribePacket, error) {
+	d := decoder{buf: body}
+	p := &subscribePacket{PacketID: d.uint16()}
+	if version == protocolV5 {
+		p.Props = d.properties()
+	}
+	for d.err == nil && d.remaining() > 0 {
+		filter := d.string()
+		options := d.byte()
+		p.Subscriptions = append(p.Subscriptions, subscription{Filter: filter, QoS: options & 0x03})
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if len(p.Subscriptions) == 0 {
+		return nil, fmt.Errorf("%w: SUBSCRIBE without topic filters", errMalformedPacket)
+	}
+	return p, nil
+}
+
+func decodeUnsubscribe(version byte, body []byte) (*unsubscribePacket, error) {
+	d := decoder{buf: body}
+	p := &unsubscribePacket{PacketID: d.uint16()}
+	if version == protocolV5 {
+		_ = d.properties()
+	}
+	for d.err == nil && d.remaining() > 0 {
+		p.Filters = append(p.Filters, d.string())
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if len(p.Filters) == 0 {
+		return nil, fmt.Errorf("%w: UNSUBSCRIBE without topic filters", errMalformedPacket)
+	}
+	return p, nil
+}
+
+func decodeAck(body []byte) (*ackPacket, error) {
+	d := decoder{buf: body}
+	p := &ackPacket{PacketID: d.uint16()}
+	if d.remaining() > 0 {
+		p.ReasonCode = d.byte()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return p, nil
+}
+
+func encodeConnack(version byte, sessionPresent bool, code byte, props *properties) []byte {
+	var e encoder
+	if sessionPresent {
+		e.byte(0x01)
+	} else {
+		e.byte(0x00)
+	}
+	e.byte(code)
+	if version == protocolV5 {
+		e.properties(props)
+	}
+	return e.buf.Bytes()
+}
+
+func encodeAck(version byte, packetID uint16, code byte) []byte {
+	var e encoder
+	e.uint16(packetID)
+	if version == protocolV5 && code != reasonSuccess {
+		e.byte(code)
+	}
+	return e.buf.Bytes()
+}
+
+func encodeSuback(version byte, packetID uint16, codes []byte) []byte {
+	var e encoder
+	e.uint16(packetID)
+	if version == protocolV5 {
+		e.properties(nil)
+	}
+	e.buf.Write(codes)
+	return e.buf.Bytes()
+}
+
+func encodeUnsuback(version byte, packetID uint16, codes []byte) []byte {
+	var e encoder
+	e.uint16(packetID)
+	if version == protocolV5 {
+		e.properties(nil)
+		e.buf.Write(codes)
+	}
+	return e.buf.Bytes()
+}
+
+func encodeDisconnect(version, code byte) []byte {
+	if version != protocolV5 {
+		return nil
+	}
+	var e encoder
+	e.byte(code)
+	e.properties(nil)
+	return e.buf.Bytes()
+}
+
+// decoder reads MQTT primitive types from a packet body. The first error is
+// sticky so callers can decode a sequence of fields and check err once.
+type decoder struct {
+	buf []byte
+	pos int
+	err error
+}
+
+func (d *decoder) remaining() int {
+	return len(d.buf) - d.pos
+}
+
+func (d *decoder) need(n int) bool {
+	if d.err != nil {
+		return false
+	}
+	if d.remaining() < n {
+		d.err = errMalformedPacket
+		return false
+	}
+	return true
+}
+
+func (d *decoder) byte() byte {
+	if !d.need(1) {
+		return 0
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b
+}
+
+func (d *decoder) uint16() uint16 {
+	if !d.need(2) {
+		return 0
+	}
+	v := binary.BigEndian.Uint16(d.buf[d.pos:])
+	d.pos += 2
+	return v
+}
+
+func (d *decoder) uint32() uint32 {
+	if !d.need(4) {
+		return 0
+	}
+	v := binary.BigEndian.Uint32(d.buf[d.pos:])
+	d.pos += 4
+	return v
+}
+
+func (d *decoder) varint() int {
+	if d.err != nil {
+		return 0
+	}
+	v, n, err := parseVarint(d.buf[d.pos:])
+	if err != nil {
+		d.err = err
+		return 0
+	}
+	d.pos += n
+	return v
+}
+
+func (d *decoder) binary() []byte {
+	n := int(d.uint16())
+	if !d.need(n) {
+		return nil
+	}
+	b := make([]byte, n)
+	copy(b, d.buf[d.pos:d.pos+n])
+	d.pos += n
+	return b
+}
+
+func (d *decoder) string() string {
+	return string(d.binary())
+}
+
+func (d *decoder) rest() []byte {
+	if d.err != nil {
+		return nil
+	}
+	b := make([]byte, d.remaining())
+	copy(b, d.buf[d.pos:])
+	d.pos = len(d.buf)
+	return b
+}
+
+func (d *decoder) properties() properties {
+	var p properties
+	length := d.varint()
+	if !d.need(length) {
+		return p
+	}
+	end := d.pos + length
+	for d.err == nil && d.pos < end {
+		id := d.byte()
+		switch id {
+		case propContentType:
+			p.ContentType = d.string()
+		case propReasonString:
+			p.ReasonString = d.string()
+		case propAssignedClientID:
+			p.AssignedClientID = d.string()
+		case propUserProperty:
+			k := d.string()
+			v := d.string()
+			p.UserProperties = append(p.UserProperties, userProperty{Key: k, Value: v})
+		case propReceiveMaximum:
+			p.ReceiveMaximum = d.uint16()
+		case propTopicAlias:
+			p.TopicAlias = d.uint16()
+		case propTopicAliasMaximum:
+			v := d.uint16()
+			p.TopicAliasMaximum = &v
+		case propSubscriptionID:
+			p.SubscriptionIDs = append(p.SubscriptionIDs, d.varint())
+		case propMaximumQoS:
+			v := d.byte()
+			p.MaximumQoS = &v
+		case propRetainAvailable:
+			v := d.byte()
+			p.RetainAvailable = &v
+		case propWildcardSubAvailable:
+			v := d.byte()
+			p.WildcardSubAvailable = &v
+		case propSharedSubAvailable:
+			v := d.byte()
+			p.SharedSubAvailable = &v
+		case propSubIDAvailable:
+			v := d.byte()
+			p.SubIDAvailable = &v
+		case propPayloadFormat, propRequestProblemInfo, propRequestResponseInfo:
+			_ = d.byte()
+		case propServerKeepAlive:
+			_ = d.uint16()
+		case propMessageExpiry, propSessionExpiry, propWillDelay, propMaximumPacketSize:
+			_ = d.uint32()
+		case propResponseTopic, propAuthMethod, propResponseInfo, propServerReference:
+			_ = d.string()
+		case propCorrelationData, propAuthData:
+			_ = d.binary()
+		default:
+			d.err = fmt.Errorf("%w: unknown property 0x%02x", errMalformedPacket, id)
+		}
+	}
+	if d.err == nil && d.pos != end {
+		d.err = fmt.Errorf("%w: property length mismatch", errMalformedPacket)
+	}
+	return p
+}
+
+// encoder writes MQTT primitive types into a packet body.
+type encoder struct {
+	buf bytes.Buffer
+}
+
+func (e *encoder) byte(b byte) {
+	e.buf.WriteByte(b)
+}
+
+func (e *encoder) uint16(v uint16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	e.buf.Write(b[:])
+}
+
+func (e *encoder) binary(b []byte) {
+	e.uint16(uint16(len(b)))
+	e.buf.Write(b)
+}
+
+func (e *encoder) string(s string) {
+	e.binary([]byte(s))
+}
+
+func (e *encoder) properties(p *properties) {
+	var props encoder
+	if p != nil {
+		if p.ContentType != "" {
+			props.byte(propContentType)
+			props.string(p.ContentType)
+		}
+		if p.AssignedClientID != "" {
+			props.byte(propAssignedClientID)
+			props.string(p.AssignedClientID)
+		}
+		if p.ReasonString != "" {
+			props.byte(propReasonString)
+			props.string(p.ReasonString)
+		}
+		if p.ReceiveMaximum > 0 {
+			props.byte(propReceiveMaximum)
+			props.uint16(p.ReceiveMaximum)
+		}
+		if p.TopicAliasMaximum != nil {
+			props.byte(propTopicAliasMaximum)
+			props.uint16(*p.TopicAliasMaximum)
+		}
+		for _, id := range p.SubscriptionIDs {
+			props.byte(propSubscriptionID)
+			writeVarint(&props.buf, id)
+		}
+		for _, prop := range []struct {
+			id    byte
+			value *byte
+		}{
+			{propMaximumQoS, p.MaximumQoS},
+			{propRetainAvailable, p.RetainAvailable},
+			{propWildcardSubAvailable, p.WildcardSubAvailable},
+			{propSharedSubAvailable, p.SharedSubAvailable},
+			{propSubIDAvailable, p.SubIDAvailable},
+		} {
+			if prop.value != nil {
+				props.byte(prop.id)
+				props.byte(*prop.value)
+			}
+		}
+		for _, up := range p.UserProperties {
+			props.byte(propUserProperty)
+			props.string(up.Key)
+			props.string(up.Value)
+		}
+	}
+	writeVarint(&e.buf, props.buf.Len())
+	e.buf.Write(props.buf.Bytes())
+}
+
+func readVarint(r io.ByteReader) (int, error) {
+	value := 0
+	multiplier := 1
+	for i := 0; i < 4; i++ {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		value += int(b&0x7F) * multiplier
+		if b&0x80 == 0 {
+			return value, nil
+		}
+		multiplier *= 128
+	}
+	return 0, fmt.Errorf("%w: variable byte integer too long", errMalformedPacket)
+}
+
+func parseVarint(b []byte) (int, int, error) {
+	value := 0
+	multiplier := 1
+	for i := 0; i < 4; i++ {
+		if i >= len(b) {
+			return 0, 0, errMalformedPacket
+		}
+		value += int(b[i]&0x7F) * multiplier
+		if b[i]&0x80 == 0 {
+			return value, i + 1, nil
+		}
+		multiplier *= 128
+	}
+	return 0, 0, fmt.Errorf("%w: variable byte integer too long", errMalformedPacket)
+}
+
+func writeVarint(buf *bytes.Buffer, value int) {
+	if value > maxRemainingLength {
+		value = maxRemainingLength
+	}
+	for {
+		b := byte(value % 128)
+		value /= 128
+		if value > 0 {
+			b |= 0x80
+		}
+		buf.WriteByte(b)
+		if value == 0 {
+			return
+		}
+	}
+}
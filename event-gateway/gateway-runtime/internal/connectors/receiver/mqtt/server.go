@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mqtt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+)
+
+// ServerConfig holds MQTT listener configuration.
+type ServerConfig struct {
+	Port            int
+	MaxPacketSize   int           // maximum accepted packet size in bytes
+	MaxInflight     int           // maximum unacknowledged QoS 1 deliveries per client
+	ConnectTimeout  time.Duration // time allowed between TCP accept and CONNECT
+	WriteTimeout    time.Duration
+	APIKeyUsername  string // CONNECT username that marks the password as an API key
+	APIKeyHeader    string // header that carries the API key into the policy chain
+	ListenerAddress string // optional override of ":<Port>", used in tests
+}
+
+// DefaultServerConfig returns sensible defaults.
+func DefaultServerConfig() ServerConfig {
+	return ServerConfig{
+		Port:           1883,
+		MaxPacketSize:  1 << 20,
+		MaxInflight:    64,
+		ConnectTimeout: 10 * time.Second,
+		WriteTimeout:   10 * time.Second,
+		APIKeyUsername: "apikey",
+		APIKeyHeader:   "api-key",
+	}
+}
+
+// Server is the shared MQTT listener. Every MQTT receiver registers its topics
+// on the same server so that all channels are reachable over a single port.
+// The TCP listener is opened when the first receiver starts and closed when the
+// last one stops.
+type Server struct {
+	config ServerConfig
+
+	mu       sync.RWMutex
+	routes   map[string]topicRoute // MQTT topic name → route
+	sessions map[*session]struct{}
+	listener net.Listener
+	active   int // number of started receivers
+	wg       sync.WaitGroup
+}
+
+// NewServer creates a shared MQTT server. It does not listen until a receiver starts.
+func NewServer(config ServerConfig) *Server {
+	defaults := DefaultServerConfig()
+	if config.MaxPacketSize <= 0 {
+		config.MaxPacketSize = defaults.MaxPacketSize
+	}
+	if config.MaxInflight <= 0 {
+		config.MaxInflight = defaults.MaxInflight
+	}
+	if config.ConnectTimeout <= 0 {
+		config.ConnectTimeout = defaults.ConnectTimeout
+	}
+	if config.WriteTimeout <= 0 {
+		config.WriteTimeout = defaults.WriteTimeout
+	}
+	if config.APIKeyHeader == "" {
+		config.APIKeyHeader = defaults.APIKeyHeader
+	}
+	return &Server{
+		config:   config,
+		routes:   make(map[string]topicRoute),
+		sessions: make(map[*session]struct{}),
+	}
+}
+
+// Addr returns the listener address, or nil if the server is not listening.
+func (s *Server) Addr() net.Addr {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// register adds the topic routes of a receiver. A topic can only be owned by one receiver.
+func (s *Server) register(routes map[string]topicRoute) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for topic, route := range routes {
+		if existing, ok := s.routes[topic]; ok && existing.receiver != route.receiver {
+			return fmt.Errorf("MQTT topic %q is already bound to channel %q", topic, existing.receiver.channel.Name)
+		}
+	}
+	for topic, route := range routes {
+		s.routes[topic] = route
+	}
+	return nil
+}
+
+// unregister removes every route owned by the receiver and drops client
+// subscriptions that were bound to it.
+func (s *Server) unregister(r *MQTTReceiver) {
+	s.mu.Lock()
+	for topic, route := range s.routes {
+		if route.receiver == r {
+			delete(s.routes, topic)
+		}
+	}
+	sessions := make([]*session, 0, len(s.sessions))
+	for sess := range s.sessions {
+		sessions = append(sessions, sess)
+	}
+	s.mu.Unlock()
+
+	for _, sess := range sessions {
+		sess.dropReceiver(r)
+	}
+}
+
+// route returns the route for an exact MQTT topic name.
+func (s *Server) route(topic string) (topicRoute, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	route, ok := s.routes[topic]
+	return route, ok
+}
+
+// matchRoutes returns every route whose MQTT topic matches the filter.
+func (s *Server) matchRoutes(filter string) []topicRoute {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var matched []topicRoute
+	for topic, route := range s.routes {
+		if topicMatches(filter, topic) {
+			matched = append(matched, route)
+		}
+	}
+	return matched
+}
+
+// acquire opens the listener if this is the first started receiver.
+func (s *Server) acquire() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listener == nil {
+		addr := s.config.ListenerAddress
+		if addr == "" {
+			addr = fmt.Sprintf(":%d", s.config.Port)
+		}
+		ln, err := net.Listen("tcp", addr)
+		if err != nil {
+			return fmt.Errorf("failed to start MQTT listener on %s: %w", addr, err)
+		}
+		s.listener = ln
+		s.wg.Add(1)
+		go s.acceptLoop(ln)
+		slog.Info("Starting server", "name", "MQTT", "protocol", "MQTT", "addr", ln.Addr().String())
+	}
+	s.active++
+	return nil
+}
+
+// release closes the listener and all client connections when the last receiver stops.
+func (s *Server) release(ctx context.Context) {
+	s.mu.Lock()
+	if s.active > 0 {
+		s.active--
+	}
+	if s.active > 0 || s.listener == nil {
+		s.mu.Unlock()
+		return
+	}
+	ln := s.listener
+	s.listener = nil
+	sessions := make([]*session, 0, len(s.sessions))
+	for sess := range s.sessions {
+		sessions = append(sessions, sess)
+	}
+	s.mu.Unlock()
+
+	_ = ln.Close()
+	for _, sess := range sessions {
+		sess.close()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		slog.Warn("Timed out waiting for MQTT connections to close")
+	}
+	slog.Info("MQTT listener stopped")
+}
+
+func (s *Server) acceptLoop(ln net.Listener) {
+	defer s.wg.Done()
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			slog.Error("MQTT accept failed", "error", err)
+			continue
+		}
+		sess := newSession(s, conn)
+		s.mu.Lock()
+		s.sessions[sess] = struct{}{}
+		s.mu.Unlock()
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			sess.serve()
+			s.mu.Lock()
+			delete(s.sessions, sess)
+			s.mu.Unlock()
+		}()
+	}
+}
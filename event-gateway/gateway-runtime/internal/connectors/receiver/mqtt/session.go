@@ -0,0 +1,677 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors"
+)
+
+const (
+	metadataClientID  = "mqtt_client_id"
+	metadataMQTTTopic = "mqtt_topic"
+	methodPublish     = "PUBLISH"
+	methodSubscribe   = "SUBSCRIBE"
+	methodUnsubscribe = "UNSUBSCRIBE"
+)
+
+// errClientDisconnect signals a graceful DISCONNECT from the client.
+var errClientDisconnect = errors.New("client disconnected")
+
+// clientSubscription is one broker-driver consumer backing a client topic filter.
+type clientSubscription struct {
+	route    topicRoute
+	qos      byte
+	consumer connectors.Receiver
+}
+
+// session is a single MQTT client connection.
+type session struct {
+	server *Server
+	conn   net.Conn
+	reader *bufio.Reader
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+
+	version     byte
+	clientID    string
+	credentials map[string]string // headers derived from CONNECT credentials
+	maxInflight int
+
+	writeMu sync.Mutex
+
+	mu            sync.Mutex
+	subscriptions map[string][]*clientSubscription // topic filter → consumers
+	inflight      map[uint16]struct{}
+	// inflightFreed is closed and replaced whenever a PUBACK frees an in-flight slot
+	inflightFreed chan struct{}
+	nextPacketID  uint16
+}
+
+func newSession(server *Server, conn net.Conn) *session {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &session{
+		server:        server,
+		conn:          conn,
+		reader:        bufio.NewReader(conn),
+		ctx:           ctx,
+		cancel:        cancel,
+		maxInflight:   server.config.MaxInflight,
+		subscriptions: make(map[string][]*clientSubscription),
+		inflight:      make(map[uint16]struct{}),
+		inflightFreed: make(chan struct{}),
+	}
+}
+
+// serve runs the read loop until the client disconnects or the server shuts down.
+func (s *session) serve() {
+	defer s.cleanup()
+
+	_ = s.conn.SetReadDeadline(time.Now().Add(s.server.config.ConnectTimeout))
+	header, body, err := readPacket(s.reader, s.server.config.MaxPacketSize)
+	if err != nil {
+		slog.Debug("MQTT connection closed before CONNECT", "remote", s.conn.RemoteAddr(), "error", err)
+		return
+	}
+	if header.Type != packetConnect {
+		slog.Warn("MQTT client sent packet before CONNECT", "remote", s.conn.RemoteAddr(), "type", header.Type)
+		return
+	}
+	keepAlive, err := s.handleConnect(body)
+	if err != nil {
+		slog.Warn("MQTT CONNECT rejected", "remote", s.conn.RemoteAddr(), "error", err)
+		return
+	}
+
+	for {
+		if keepAlive > 0 {
+			// The server must disconnect a client that is silent for 1.5x its keep alive.
+			_ = s.conn.SetReadDeadline(time.Now().Add(keepAlive * 3 / 2))
+		} else {
+			_ = s.conn.SetReadDeadline(time.Time{})
+		}
+
+		header, body, err := readPacket(s.reader, s.server.config.MaxPacketSize)
+		if err != nil {
+			switch {
+			case errors.Is(err, errPacketTooLarge):
+				s.disconnect(reasonPacketTooLarge)
+			case errors.Is(err, errMalformedPacket):
+				s.disconnect(reasonMalformedPacket)
+			case errors.Is(err, io.EOF), errors.Is(err, net.ErrClosed):
+			default:
+				slog.Debug("MQTT read error", "client_id", s.clientID, "error", err)
+			}
+			return
+		}
+
+		if err := s.dispatch(header, body); err != nil {
+			if !errors.Is(err, errClientDisconnect) {
+				slog.Warn("Closing MQTT connection", "client_id", s.clientID, "error", err)
+			}
+			return
+		}
+	}
+}
+
+func (s *session) dispatch(header fixedHeader, body []byte) error {
+	switch header.Type {
+	case packetPublish:
+		p, err := decodePublish(s.version, header.Flags, body)
+		if err != nil {
+			s.disconnect(reasonMalformedPacket)
+			return err
+		}
+		return s.handlePublish(p)
+	case packetPuback:
+		ack, err := decodeAck(body)
+		if err != nil {
+			s.disconnect(reasonMalformedPacket)
+			return err
+		}
+		s.mu.Lock()
+		if _, ok := s.inflight[ack.PacketID]; ok {
+			delete(s.inflight, ack.PacketID)
+			close(s.inflightFreed)
+			s.inflightFreed = make(chan struct{})
+		}
+		s.mu.Unlock()
+		return nil
+	case packetSubscribe:
+		p, err := decodeSubscribe(s.version, body)
+		if err != nil {
+			s.disconnect(reasonMalformedPacket)
+			return err
+		}
+		return s.handleSubscribe(p)
+	case packetUnsubscribe:
+		p, err := decodeUnsubscribe(s.version, body)
+		if err != nil {
+			s.disconnect(reasonMalformedPacket)
+			return err
+		}
+		return s.handleUnsubscribe(p)
+	case packetPingreq:
+		return s.write(packetPingresp, 0, nil)
+	case packetDisconnect:
+		return errClientDisconnect
+	default:
+		s.disconnect(reasonProtocolError)
+		return fmt.Errorf("unsupported packet type %d", header.Type)
+	}
+}
+
+// handleConnect validates CONNECT, records credentials and replies with CONNACK.
+// It returns the negotiated keep alive interval.
+func (s *session) handleConnect(body []byte) (time.Duration, error) {
+	p, err := decodeConnect(body)
+	if err != nil {
+		return 0, err
+	}
+
+	if p.ProtocolName != "MQTT" || (p.ProtocolLevel != protocolV311 && p.ProtocolLevel != protocolV5) {
+		// Reply in 3.1.1 form; clients on unknown levels cannot parse anything else.
+		_ = s.write(packetConnack, 0, encodeConnack(protocolV311, false, connackUnacceptableProtocol, nil))
+		return 0, fmt.Errorf("unsupported protocol %q level %d", p.ProtocolName, p.ProtocolLevel)
+	}
+	s.version = p.ProtocolLevel
+
+	connackProps := &properties{}
+	if p.ClientID == "" {
+		if s.version == protocolV311 && !p.CleanStart {
+			_ = s.write(packetConnack, 0, encodeConnack(s.version, false, connackIdentifierRejected, nil))
+			return 0, errors.New("empty client identifier requires a clean session")
+		}
+		p.ClientID = uuid.New().String()
+		connackProps.AssignedClientID = p.ClientID
+	}
+	s.clientID = p.ClientID
+	s.credentials = credentialHeaders(s.server.config, p)
+
+	if s.version == protocolV5 && p.Props.ReceiveMaximum > 0 && int(p.Props.ReceiveMaximum) < s.maxInflight {
+		s.maxInflight = int(p.Props.ReceiveMaximum)
+	}
+
+	maxQoS, retain, shared, subIDs := byte(1), byte(0), byte(0), byte(0)
+	connackProps.MaximumQoS = &maxQoS
+	connackProps.RetainAvailable = &retain
+	connackProps.SharedSubAvailable = &shared
+	connackProps.SubIDAvailable = &subIDs
+
+	// Sessions are not persisted, so session-present is always false.
+	if err := s.write(packetConnack, 0, encodeConnack(s.version, false, connackAccepted, connackProps)); err != nil {
+		return 0, err
+	}
+
+	slog.Info("MQTT client connected",
+		"client_id", s.clientID,
+		"protocol_level", s.version,
+		"remote", s.conn.RemoteAddr(),
+	)
+	return time.Duration(p.KeepAlive) * time.Second, nil
+}
+
+// handlePublish runs the inbound policy chain and forwards the message to the broker.
+// QoS 1 messages are acknowledged only after the broker-driver accepted the message.
+func (s *session) handlePublish(p *publishPacket) error {
+	if p.QoS > 1 {
+		s.disconnect(reasonQoSNotSupported)
+		return fmt.Errorf("QoS %d is not supported", p.QoS)
+	}
+	if p.Props.TopicAlias != 0 || !validTopicName(p.Topic) {
+		s.disconnect(reasonTopicNameInvalid)
+		return fmt.Errorf("invalid PUBLISH topic %q", p.Topic)
+	}
+
+	route, ok := s.server.route(p.Topic)
+	if !ok {
+		slog.Debug("Dropping MQTT publish to unbound topic", "client_id", s.clientID, "topic", p.Topic)
+		return s.nackPublish(p, reasonTopicNameInvalid, errors.New("topic is not bound to a channel"))
+	}
+
+	msg := &connectors.Message{
+		Value:   p.Payload,
+		Headers: s.messageHeaders(p.Props),
+		Topic:   route.channelName,
+		Metadata: map[string]interface{}{
+			"request_method":  methodPublish,
+			metadataClientID:  s.clientID,
+			metadataMQTTTopic: p.Topic,
+		},
+	}
+
+	receiver := route.receiver
+	processed, shortCircuited, err := receiver.processor.ProcessInbound(s.ctx, receiver.channel.Name, msg)
+	if err != nil {
+		slog.Error("MQTT inbound policy execution failed", "client_id", s.clientID, "topic", p.Topic, "error", err)
+		return s.nackPublish(p, reasonUnspecifiedError, err)
+	}
+	if shortCircuited {
+		slog.Info("MQTT publish rejected by policy", "client_id", s.clientID, "topic", p.Topic)
+		return s.nackPublish(p, reasonNotAuthorized, errors.New("rejected by policy"))
+	}
+
+	s.stripCredentials(processed)
+	if err := receiver.brokerDriver.Publish(s.ctx, route.brokerTopic, processed); err != nil {
+		slog.Error("Failed to publish MQTT message to broker-driver", "client_id", s.clientID, "topic", route.brokerTopic, "error", err)
+		return s.nackPublish(p, reasonUnspecifiedError, err)
+	}
+
+	return s.ackPublish(p, reasonSuccess)
+}
+
+// ackPublish acknowledges an accepted QoS 1 PUBLISH.
+func (s *session) ackPublish(p *publishPacket, reason byte) error {
+	if p.QoS == 0 {
+		return nil
+	}
+	return s.write(packetPuback, 0, encodeAck(s.version, p.PacketID, reason))
+}
+
+// nackPublish reports a QoS 1 PUBLISH that was not forwarded. MQTT 5 clients get a
+// failure PUBACK. MQTT 3.1.1 has no negative PUBACK, so those clients are disconnected
+// without an acknowledgement rather than told that a dropped message was accepted.
+func (s *session) nackPublish(p *publishPacket, reason byte, cause error) error {
+	if p.QoS == 0 {
+		return nil
+	}
+	if s.version == protocolV5 {
+		return s.write(packetPuback, 0, encodeAck(s.version, p.PacketID, reason))
+	}
+	return fmt.Errorf("publish to %q was not accepted: %w", p.Topic, cause)
+}
+
+func (s *session) handleSubscribe(p *subscribePacket) error {
+	if s.version == protocolV5 && len(p.Props.SubscriptionIDs) > 0 {
+		s.disconnect(reasonProtocolError)
+		return errors.New("subscription identifiers are not supported")
+	}
+
+	codes := make([]byte, len(p.Subscriptions))
+	var pending []*clientSubscription
+	for i, sub := range p.Subscriptions {
+		code, subs := s.subscribe(sub)
+		codes[i] = code
+		pending = append(pending, subs...)
+	}
+
+	if err := s.write(packetSuback, 0, encodeSuback(s.version, p.PacketID, codes)); err != nil {
+		return err
+	}
+
+	// Start consumers only after SUBACK so that deliveries never precede it.
+	for _, sub := range pending {
+		if err := sub.consumer.Start(s.ctx); err != nil {
+			slog.Error("Failed to start MQTT subscription consumer",
+				"client_id", s.clientID,
+				"topic", sub.route.mqttTopic,
+				"error", err,
+			)
+		}
+	}
+	return nil
+}
+
+// subscribe authorizes a topic filter against every matching channel and creates
+// one broker-driver consumer per matched topic. It returns the SUBACK reason code.
+func (s *session) subscribe(sub subscription) (byte, []*clientSubscription) {
+	if !validTopicFilter(sub.Filter) {
+		if strings.HasPrefix(sub.Filter, "$share/") {
+			return s.failureCode(reasonSharedSubNotSupported), nil
+		}
+		return s.failureCode(reasonTopicFilterInvalid), nil
+	}
+
+	routes := s.server.matchRoutes(sub.Filter)
+	if len(routes) == 0 {
+		slog.Debug("MQTT subscribe matched no bound topics", "client_id", s.clientID, "filter", sub.Filter)
+		return s.failureCode(reasonTopicFilterInvalid), nil
+	}
+
+	for _, route := range routes {
+		msg := &connectors.Message{
+			Headers: s.messageHeaders(properties{}),
+			Topic:   route.channelName,
+			Metadata: map[string]interface{}{
+				"request_method":  methodSubscribe,
+				metadataClientID:  s.clientID,
+				metadataMQTTTopic: route.mqttTopic,
+			},
+		}
+		_, shortCircuited, err := route.receiver.processor.ProcessSubscribe(s.ctx, route.receiver.channel.Name, msg)
+		if err != nil {
+			slog.Error("MQTT subscribe policy execution failed", "client_id", s.clientID, "filter", sub.Filter, "error", err)
+			return s.failureCode(reasonUnspecifiedError), nil
+		}
+		if shortCircuited {
+			slog.Info("MQTT subscribe rejected by policy", "client_id", s.clientID, "filter", sub.Filter)
+			return s.failureCode(reasonNotAuthorized), nil
+		}
+	}
+
+	qos := sub.QoS
+	if qos > 1 {
+		qos = 1
+	}
+
+	created := make([]*clientSubscription, 0, len(routes))
+	for _, route := range routes {
+		cs := &clientSubscription{route: route, qos: qos}
+		groupID := route.receiver.opts.ConsumerGroupPrefix + "-mqtt-" + uuid.New().String()
+		consumer, err := route.receiver.brokerDriver.Subscribe(groupID, []string{route.brokerTopic},
+			func(ctx context.Context, msg *connectors.Message) error {
+				return s.deliver(ctx, cs, msg)
+			})
+		if err != nil {
+			slog.Error("Failed to create MQTT subscription consumer",
+				"client_id", s.clientID,
+				"topic", route.mqttTopic,
+				"error", err,
+			)
+			stopSubscriptions(created)
+			return s.failureCode(reasonUnspecifiedError), nil
+		}
+		cs.consumer = consumer
+		created = append(created, cs)
+	}
+
+	// A repeated SUBSCRIBE for the same filter replaces the existing subscription.
+	s.mu.Lock()
+	previous := s.subscriptions[sub.Filter]
+	s.subscriptions[sub.Filter] = created
+	s.mu.Unlock()
+	stopSubscriptions(previous)
+
+	return qos, created
+}
+
+func (s *session) handleUnsubscribe(p *unsubscribePacket) error {
+	codes := make([]byte, len(p.Filters))
+	for i, filter := range p.Filters {
+		s.mu.Lock()
+		subs, ok := s.subscriptions[filter]
+		s.mu.Unlock()
+		if !ok {
+			codes[i] = reasonNoSubscriptionExisted
+			continue
+		}
+
+		codes[i] = reasonSuccess
+		for _, sub := range subs {
+			msg := &connectors.Message{
+				Headers: s.messageHeaders(properties{}),
+				Topic:   sub.route.channelName,
+				Metadata: map[string]interface{}{
+					"request_method":  methodUnsubscribe,
+					metadataClientID:  s.clientID,
+					metadataMQTTTopic: sub.route.mqttTopic,
+				},
+			}
+			_, shortCircuited, err := sub.route.receiver.processor.ProcessUnsubscribe(s.ctx, sub.route.receiver.channel.Name, msg)
+			if err != nil {
+				slog.Error("MQTT unsubscribe policy execution failed", "client_id", s.clientID, "filter", filter, "error", err)
+				codes[i] = reasonUnspecifiedError
+				break
+			}
+			if shortCircuited {
+				codes[i] = reasonNotAuthorized
+				break
+			}
+		}
+		if codes[i] != reasonSuccess {
+			continue
+		}
+
+		s.mu.Lock()
+		delete(s.subscriptions, filter)
+		s.mu.Unlock()
+		stopSubscriptions(subs)
+	}
+
+	return s.write(packetUnsuback, 0, encodeUnsuback(s.version, p.PacketID, codes))
+}
+
+// deliver runs the outbound policy chain and writes the message to the client.
+//
+// Outbound delivery is at most once: the broker offset does not wait for the client's
+// PUBACK and unacknowledged messages are not retransmitted. When the QoS 1 in-flight
+// window is full, deliver blocks until the client acknowledges a message, which holds
+// back the broker consumer instead of dropping the message.
+func (s *session) deliver(ctx context.Context, sub *clientSubscription, msg *connectors.Message) error {
+	receiver := sub.route.receiver
+	processed, shortCircuited, err := receiver.processor.ProcessOutbound(ctx, receiver.channel.Name, msg)
+	if err != nil {
+		return err
+	}
+	if shortCircuited {
+		return nil
+	}
+
+	p := &publishPacket{
+		Topic:   sub.route.mqttTopic,
+		QoS:     sub.qos,
+		Payload: processed.Value,
+	}
+	if s.version == protocolV5 {
+		p.Props = headersToProperties(processed.Headers)
+	}
+
+	if p.QoS > 0 {
+		packetID, err := s.reserveInflight(ctx)
+		if err != nil {
+			return fmt.Errorf("MQTT client %s did not acknowledge in time for delivery on %q: %w", s.clientID, p.Topic, err)
+		}
+		p.PacketID = packetID
+	}
+
+	flags, body := encodePublish(s.version, p)
+	return s.write(packetPublish, flags, body)
+}
+
+// reserveInflight waits for a free slot in the QoS 1 in-flight window and allocates a
+// packet identifier in it.
+func (s *session) reserveInflight(ctx context.Context) (uint16, error) {
+	for {
+		s.mu.Lock()
+		if len(s.inflight) < s.maxInflight {
+			id := s.allocatePacketID()
+			s.inflight[id] = struct{}{}
+			s.mu.Unlock()
+			return id, nil
+		}
+		freed := s.inflightFreed
+		s.mu.Unlock()
+
+		select {
+		case <-freed:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-s.ctx.Done():
+			return 0, errors.New("session closed")
+		}
+	}
+}
+
+// allocatePacketID returns the next free non-zero packet identifier. Callers hold s.mu.
+func (s *session) allocatePacketID() uint16 {
+	for {
+		s.nextPacketID++
+		if s.nextPacketID == 0 {
+			continue
+		}
+		if _, used := s.inflight[s.nextPacketID]; !used {
+			return s.nextPacketID
+		}
+	}
+}
+
+// dropReceiver stops every subscription bound to a receiver that is being removed.
+func (s *session) dropReceiver(r *MQTTReceiver) {
+	var dropped []*clientSubscription
+	s.mu.Lock()
+	for filter, subs := range s.subscriptions {
+		kept := subs[:0]
+		for _, sub := range subs {
+			if sub.route.receiver == r {
+				dropped = append(dropped, sub)
+			} else {
+				kept = append(kept, sub)
+			}
+		}
+		if len(kept) == 0 {
+			delete(s.subscriptions, filter)
+		} else {
+			s.subscriptions[filter] = kept
+		}
+	}
+	s.mu.Unlock()
+	stopSubscriptions(dropped)
+}
+
+func (s *session) write(packetType, flags byte, body []byte) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(s.server.config.WriteTimeout))
+	return writePacket(s.conn, packetType, flags, body)
+}
+
+// disconnect sends a DISCONNECT with a reason code to MQTT 5 clients.
+// MQTT 3.1.1 has no server-initiated DISCONNECT; the connection is just closed.
+func (s *session) disconnect(reason byte) {
+	if s.version == protocolV5 {
+		_ = s.write(packetDisconnect, 0, encodeDisconnect(s.version, reason))
+	}
+}
+
+// failureCode maps an MQTT 5 reason code to the SUBACK failure code of the session's protocol.
+func (s *session) failureCode(reason byte) byte {
+	if s.version == protocolV5 {
+		return reason
+	}
+	return 0x80
+}
+
+// messageHeaders builds policy headers from MQTT 5 properties and CONNECT credentials.
+func (s *session) messageHeaders(props properties) map[string][]string {
+	headers := make(map[string][]string, len(props.UserProperties)+len(s.credentials)+1)
+	for _, up := range props.UserProperties {
+		key := strings.ToLower(up.Key)
+		headers[key] = append(headers[key], up.Value)
+	}
+	if props.ContentType != "" {
+		headers["content-type"] = []string{props.ContentType}
+	}
+	for k, v := range s.credentials {
+		headers[k] = []string{v}
+	}
+	return headers
+}
+
+// stripCredentials removes the credential headers derived from CONNECT so they are
+// never forwarded to the broker.
+func (s *session) stripCredentials(msg *connectors.Message) {
+	for k := range s.credentials {
+		delete(msg.Headers, k)
+	}
+}
+
+func (s *session) close() {
+	s.closeOnce.Do(func() {
+		s.cancel()
+		_ = s.conn.Close()
+	})
+}
+
+func (s *session) cleanup() {
+	s.close()
+
+	s.mu.Lock()
+	var all []*clientSubscription
+	for filter, subs := range s.subscriptions {
+		all = append(all, subs...)
+		delete(s.subscriptions, filter)
+	}
+	s.mu.Unlock()
+	stopSubscriptions(all)
+
+	if s.clientID != "" {
+		slog.Info("MQTT client disconnected", "client_id", s.clientID)
+	}
+}
+
+func stopSubscriptions(subs []*clientSubscription) {
+	for _, sub := range subs {
+		if sub.consumer == nil {
+			continue
+		}
+		if err := sub.consumer.Stop(context.Background()); err != nil {
+			slog.Error("Failed to stop MQTT subscription consumer", "topic", sub.route.mqttTopic, "error", err)
+		}
+	}
+}
+
+// credentialHeaders maps CONNECT credentials onto the headers that the
+// authentication policies read:
+//   - username equal to APIKeyUsername: the password is an API key
+//   - no username (or an empty one): the password is a bearer token (e.g. a JWT)
+//   - username and password: HTTP Basic credentials
+func credentialHeaders(cfg ServerConfig, p *connectPacket) map[string]string {
+	if !p.HasPassword {
+		return nil
+	}
+	password := string(p.Password)
+	switch {
+	case cfg.APIKeyUsername != "" && p.HasUsername && p.Username == cfg.APIKeyUsername:
+		return map[string]string{strings.ToLower(cfg.APIKeyHeader): password}
+	case !p.HasUsername || p.Username == "":
+		return map[string]string{"authorization": "Bearer " + password}
+	default:
+		encoded := base64.StdEncoding.EncodeToString([]byte(p.Username + ":" + password))
+		return map[string]string{"authorization": "Basic " + encoded}
+	}
+}
+
+// headersToProperties maps message headers onto MQTT 5 user properties.
+func headersToProperties(headers map[string][]string) properties {
+	var props properties
+	for k, values := range headers {
+		if strings.EqualFold(k, "content-type") && len(values) > 0 {
+			props.ContentType = values[0]
+			continue
+		}
+		for _, v := range values {
+			props.UserProperties = append(props.UserProperties, userProperty{Key: k, Value: v})
+		}
+	}
+	return props
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mqtt
+
+import (
+	"strings"
+
+	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/binding"
+	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors"
+)
+
+// topicRoute maps a single MQTT topic name to a broker-driver topic of a channel binding.
+type topicRoute struct {
+	mqttTopic   string
+	channelName string // WebSubApi channel name, or the public topic for flat bindings
+	brokerTopic string
+	receiver    *MQTTReceiver
+}
+
+// TopicsForChannel derives the MQTT topic names exposed for a channel binding.
+// The topic is the channel's base path ({context}/{version}) without the leading
+// slash, followed by the channel name (WebSubApi) or the public topic (flat binding).
+// The returned map is keyed by MQTT topic name.
+func TopicsForChannel(ch connectors.ChannelInfo) map[string]topicRoute {
+	basePath := strings.TrimPrefix(binding.WebSubApiBasePath(ch.Context, ch.Version), "/")
+	routes := make(map[string]topicRoute)
+
+	if len(ch.Channels) > 0 {
+		for channelName, brokerTopic := range ch.Channels {
+			mqttTopic := joinTopic(basePath, channelName)
+			routes[mqttTopic] = topicRoute{
+				mqttTopic:   mqttTopic,
+				channelName: channelName,
+				brokerTopic: brokerTopic,
+			}
+		}
+		return routes
+	}
+
+	mqttTopic := joinTopic(basePath, ch.PublicTopic)
+	routes[mqttTopic] = topicRoute{
+		mqttTopic:   mqttTopic,
+		channelName: ch.PublicTopic,
+		brokerTopic: ch.BrokerDriverTopic,
+	}
+	return routes
+}
+
+func joinTopic(basePath, name string) string {
+	name = strings.Trim(name, "/")
+	switch {
+	case basePath == "":
+		return name
+	case name == "":
+		return basePath
+	default:
+		return basePath + "/" + name
+	}
+}
+
+// validTopicName reports whether name can be used in a PUBLISH packet.
+func validTopicName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, "+#\x00")
+}
+
+// validTopicFilter reports whether filter is a well-formed MQTT topic filter.
+// Shared subscriptions ($share/...) are not supported.
+func validTopicFilter(filter string) bool {
+	if filter == "" || strings.ContainsRune(filter, 0) || strings.HasPrefix(filter, "$share/") {
+		return false
+	}
+	levels := strings.Split(filter, "/")
+	for i, level := range levels {
+		switch {
+		case level == "#":
+			if i != len(levels)-1 {
+				return false
+			}
+		case level == "+":
+		case strings.ContainsAny(level, "+#"):
+			return false
+		}
+	}
+	return true
+}
+
+// topicMatches reports whether topic name matches the MQTT topic filter.
+func topicMatches(filter, topic string) bool {
+	filterLevels := strings.Split(filter, "/")
+	topicLevels := strings.Split(topic, "/")
+
+	// Wildcards at the first level must not match topics starting with '$'.
+	if strings.HasPrefix(topic, "$") && (filterLevels[0] == "+" || filterLevels[0] == "#") {
+		return false
+	}
+
+	for i, level := range filterLevels {
+		if level == "#" {
+			return true
+		}
+		if i >= len(topicLevels) {
+			return false
+		}
+		if level != "+" && level != topicLevels[i] {
+			return false
+		}
+	}
+	return len(filterLevels) == len(topicLevels)
+}
@@ -138,6 +138,9 @@ func (e *WebSubReceiver) Start(ctx context.Context) error {
 	for _, kafkaTopic := range e.channel.Channels {
 		topicsToEnsure = append(topicsToEnsure, kafkaTopic)
 	}
+	for _, deadLetterTopic := range e.channel.DeadLetterTopics {
+		topicsToEnsure = append(topicsToEnsure, deadLetterTopic)
+	}
 
 	if len(topicsToEnsure) > 0 {
 		if err := e.brokerDriver.EnsureTopics(ctx, topicsToEnsure); err != nil {
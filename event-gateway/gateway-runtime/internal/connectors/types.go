@@ -76,6 +76,7 @@ type ChannelInfo struct {
 	Ordering          string
 	Channels          map[string]string // channel-name → Kafka topic (WebSubApi only)
 	InternalSubTopic  string            // internal subscription sync topic (WebSubApi only)
+	DeadLetterTopics  map[string]string // channel-name → dead-letter topic for invalid messages (WebSubApi only)
 }
 
 // RouteMux is an HTTP request multiplexer that supports dynamic route registration.
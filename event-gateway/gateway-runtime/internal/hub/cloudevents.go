@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package hub
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	bindingcfg "github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/binding"
+	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors"
+)
+
+// CloudEvents 1.0 support.
+//
+// Inbound events are accepted in both content modes of the HTTP protocol binding
+// and normalized to binary mode: context attributes travel as lower-case "ce-"
+// headers, the event data is the message value and datacontenttype maps to the
+// content-type header. The attributes are also exposed in Metadata["cloudevent"].
+// Outbound events are emitted in the content mode configured for the binding.
+
+const (
+	// CloudEventsModeBinary emits context attributes as ce-* headers.
+	CloudEventsModeBinary = "binary"
+	// CloudEventsModeStructured emits the event as an application/cloudevents+json envelope.
+	CloudEventsModeStructured = "structured"
+
+	cloudEventsSpecVersion     = "1.0"
+	cloudEventsHeaderPrefix    = "ce-"
+	cloudEventsJSONContentType = "application/cloudevents+json"
+	cloudEventMetadataKey      = "cloudevent"
+	contentTypeHeader          = "content-type"
+)
+
+// decodeCloudEvent detects a CloudEvent in structured or binary content mode and
+// normalizes it to binary form. It reports false when msg is not a CloudEvent.
+func decodeCloudEvent(msg *connectors.Message) (bool, error) {
+	if msg.Headers == nil {
+		msg.Headers = make(map[string][]string)
+	}
+
+	if mediaType(headerValue(msg.Headers, contentTypeHeader)) == cloudEventsJSONContentType {
+		if err := decodeStructuredCloudEvent(msg); err != nil {
+			return true, err
+		}
+	} else if headerValue(msg.Headers, cloudEventsHeaderPrefix+"specversion") == "" {
+		return false, nil
+	}
+
+	// Canonicalize ce-* header names so downstream lookups are case-stable.
+	attrs := cloudEventAttributes(msg.Headers)
+	for name := range msg.Headers {
+		if strings.HasPrefix(strings.ToLower(name), cloudEventsHeaderPrefix) {
+			delete(msg.Headers, name)
+		}
+	}
+	for name, value := range attrs {
+		msg.Headers[cloudEventsHeaderPrefix+name] = []string{value}
+	}
+
+	if attrs["specversion"] != cloudEventsSpecVersion {
+		return true, fmt.Errorf("unsupported CloudEvents specversion %q", attrs["specversion"])
+	}
+	for _, required := range []string{"id", "source", "type"} {
+		if attrs[required] == "" {
+			return true, fmt.Errorf("CloudEvent is missing required attribute %q", required)
+		}
+	}
+
+	setCloudEventMetadata(msg, attrs)
+	return true, nil
+}
+
+// decodeStructuredCloudEvent unwraps an application/cloudevents+json envelope into binary form.
+func decodeStructuredCloudEvent(msg *connectors.Message) error {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(msg.Value, &envelope); err != nil {
+		return fmt.Errorf("invalid structured CloudEvent: %w", err)
+	}
+
+	attrs := make(map[string]string, len(envelope))
+	for name, raw := range envelope {
+		if name == "data" || name == "data_base64" {
+			continue
+		}
+		if bytes.Equal(raw, []byte("null")) {
+			continue
+		}
+		var s string
+		if err := json.Unmarshal(raw, &s); err == nil {
+			attrs[strings.ToLower(name)] = s
+			continue
+		}
+		attrs[strings.ToLower(name)] = string(raw)
+	}
+
+	dataContentType := attrs["datacontenttype"]
+	delete(attrs, "datacontenttype")
+
+	var data []byte
+	if raw, ok := envelope["data_base64"]; ok {
+		var encoded string
+		if err := json.Unmarshal(raw, &encoded); err != nil {
+			return fmt.Errorf("invalid CloudEvent data_base64: %w", err)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("invalid CloudEvent data_base64: %w", err)
+		}
+		data = decoded
+	} else if raw, ok := envelope["data"]; ok && !bytes.Equal(raw, []byte("null")) {
+		data = raw
+		// Non-JSON data is carried as a JSON string in the structured envelope.
+		if dataContentType != "" && !isJSONMediaType(dataContentType) {
+			var s string
+			if err := json.Unmarshal(raw, &s); err == nil {
+				data = []byte(s)
+			}
+		}
+		if dataContentType == "" {
+			dataContentType = "application/json"
+		}
+	}
+
+	for name := range msg.Headers {
+		if strings.HasPrefix(strings.ToLower(name), cloudEventsHeaderPrefix) {
+			delete(msg.Headers, name)
+		}
+	}
+	deleteHeader(msg.Headers, contentTypeHeader)
+	for name, value := range attrs {
+		msg.Headers[cloudEventsHeaderPrefix+name] = []string{value}
+	}
+	if dataContentType != "" {
+		msg.Headers[contentTypeHeader] = []string{dataContentType}
+	}
+	msg.Value = data
+	return nil
+}
+
+// ensureCloudEventAttributes assigns the required context attributes to a message
+// published without them, so that it can be emitted as a CloudEvent.
+func ensureCloudEventAttributes(msg *connectors.Message, binding *ChannelBinding) {
+	if msg.Headers == nil {
+		msg.Headers = make(map[string][]string)
+	}
+	source := bindingcfg.WebSubApiBasePath(binding.Context, binding.Version)
+	if source == "" {
+		source = "/" + binding.Name
+	}
+	eventType := msg.Topic
+	if eventType == "" {
+		eventType = binding.Name
+	}
+
+	attrs := map[string]string{
+		"specversion": cloudEventsSpecVersion,
+		"id":          uuid.NewString(),
+		"source":      source,
+		"type":        eventType,
+		"time":        time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	for name, value := range attrs {
+		msg.Headers[cloudEventsHeaderPrefix+name] = []string{value}
+	}
+	setCloudEventMetadata(msg, attrs)
+}
+
+// encodeCloudEvent emits a CloudEvent message in the given content mode. Messages
+// without CloudEvents attributes and binary-mode output are left unchanged.
+func encodeCloudEvent(msg *connectors.Message, mode string) error {
+	if msg == nil || mode != CloudEventsModeStructured {
+		return nil
+	}
+	attrs := cloudEventAttributes(msg.Headers)
+	if attrs["specversion"] == "" {
+		return nil
+	}
+
+	envelope := make(map[string]interface{}, len(attrs)+2)
+	for name, value := range attrs {
+		envelope[name] = value
+	}
+
+	dataContentType := headerValue(msg.Headers, contentTypeHeader)
+	if dataContentType != "" {
+		envelope["datacontenttype"] = dataContentType
+	}
+	if len(msg.Value) > 0 {
+		switch {
+		case (dataContentType == "" || isJSONMediaType(dataContentType)) && json.Valid(msg.Value):
+			envelope["data"] = json.RawMessage(msg.Value)
+		case strings.HasPrefix(mediaType(dataContentType), "text/") && utf8.Valid(msg.Value):
+			envelope["data"] = string(msg.Value)
+		default:
+			envelope["data_base64"] = base64.StdEncoding.EncodeToString(msg.Value)
+		}
+	}
+
+	value, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("failed to encode structured CloudEvent: %w", err)
+	}
+
+	for name := range msg.Headers {
+		if strings.HasPrefix(strings.ToLower(name), cloudEventsHeaderPrefix) {
+			delete(msg.Headers, name)
+		}
+	}
+	deleteHeader(msg.Headers, contentTypeHeader)
+	msg.Headers[contentTypeHeader] = []string{cloudEventsJSONContentType}
+	msg.Value = value
+	return nil
+}
+
+// cloudEventAttributes collects the context attributes carried in ce-* headers.
+func cloudEventAttributes(headers map[string][]string) map[string]string {
+	attrs := make(map[string]string)
+	for name, values := range headers {
+		lower := strings.ToLower(name)
+		if !strings.HasPrefix(lower, cloudEventsHeaderPrefix) || len(values) == 0 {
+			continue
+		}
+		attrs[strings.TrimPrefix(lower, cloudEventsHeaderPrefix)] = values[0]
+	}
+	return attrs
+}
+
+func setCloudEventMetadata(msg *connectors.Message, attrs map[string]string) {
+	if msg.Metadata == nil {
+		msg.Metadata = make(map[string]interface{})
+	}
+	msg.Metadata[cloudEventMetadataKey] = attrs
+}
+
+// headerValue returns the first value of a header, matching the name case-insensitively.
+func headerValue(headers map[string][]string, name string) string {
+	for k, values := range headers {
+		if strings.EqualFold(k, name) && len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// deleteHeader removes every case variant of a header.
+func deleteHeader(headers map[string][]string, name string) {
+	for k := range headers {
+		if strings.EqualFold(k, name) {
+			delete(headers, k)
+		}
+	}
+}
+
+func mediaType(contentType string) string {
+	if contentType == "" {
+		return ""
+	}
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return mt
+}
+
+func isJSONMediaType(contentType string) bool {
+	mt := mediaType(contentType)
+	return mt == "application/json" || mt == "text/json" || strings.HasSuffix(mt, "+json")
+}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"strings"
 	"sync"
 
@@ -54,6 +55,9 @@ type ChannelBinding struct {
 	Channels            map[string]string             // channel-name → Kafka topic (WebSubApi only)
 	KafkaTopicToChannel map[string]string             // Kafka topic → channel-name (reverse of Channels, cached)
 	ChannelChainKeys    map[string]ChannelChainKeySet // channel-name → per-channel chain keys
+	ChannelMessages     map[string]*ChannelMessage    // channel-name → payload schema (WebSubApi only)
+	CloudEventsMode     string                        // "binary", "structured" or "" (no CloudEvents attributes assigned)
+	BrokerDriver        connectors.BrokerDriver       // used to dead-letter messages that fail schema validation
 }
 
 // Hub is the central message router. It holds the policy engine reference and
@@ -297,7 +301,8 @@ func (h *Hub) ProcessUnsubscribe(ctx context.Context, bindingName string, msg *c
 }
 
 // ProcessInbound applies inbound policies to a message flowing from entrypoint to endpoint.
-// Hub-level policies are applied first, then per-channel policies if present.
+// CloudEvents are normalized to binary mode first. Hub-level policies are applied next,
+// then per-channel policies if present, and finally the channel's payload schema.
 // Returns the (possibly mutated) message and whether it was short-circuited.
 func (h *Hub) ProcessInbound(ctx context.Context, bindingName string, msg *connectors.Message) (*connectors.Message, bool, error) {
 	binding := h.GetBinding(bindingName)
@@ -305,6 +310,15 @@ func (h *Hub) ProcessInbound(ctx context.Context, bindingName string, msg *conne
 		return nil, false, fmt.Errorf("binding not found: %s", bindingName)
 	}
 
+	isCloudEvent, err := decodeCloudEvent(msg)
+	if err != nil {
+		slog.Info("Inbound message rejected: invalid CloudEvent", "binding", bindingName, "error", err)
+		return errorResponseMessage(http.StatusBadRequest, "invalid CloudEvent", err), true, nil
+	}
+	if !isCloudEvent && binding.CloudEventsMode != "" {
+		ensureCloudEventAttributes(msg, binding)
+	}
+
 	// Apply hub-level inbound chain first.
 	if binding.InboundChainKey != "" {
 		chain := h.engine.GetChain(binding.InboundChainKey)
@@ -378,11 +392,12 @@ func (h *Hub) ProcessInbound(ctx context.Context, bindingName string, msg *conne
 		}
 	}
 
-	return msg, false, nil
+	return h.validateInbound(ctx, binding, msg)
 }
 
 // ProcessOutbound applies outbound policies to a message flowing from endpoint to entrypoint.
-// Hub-level policies are applied first, then per-channel policies if present.
+// Hub-level policies are applied first, then per-channel policies if present. CloudEvents
+// are then emitted in the binding's content mode.
 // Returns the (possibly mutated) message and whether it was short-circuited.
 func (h *Hub) ProcessOutbound(ctx context.Context, bindingName string, msg *connectors.Message) (*connectors.Message, bool, error) {
 	binding := h.GetBinding(bindingName)
@@ -460,6 +475,10 @@ func (h *Hub) ProcessOutbound(ctx context.Context, bindingName string, msg *conn
 		}
 	}
 
+	if err := encodeCloudEvent(msg, binding.CloudEventsMode); err != nil {
+		return nil, false, err
+	}
+
 	return msg, false, nil
 }
 
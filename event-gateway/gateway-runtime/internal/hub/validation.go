@@ -0,0 +1,256 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/hamba/avro/v2"
+	"github.com/xeipuuv/gojsonschema"
+
+	bindingcfg "github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/binding"
+	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors"
+)
+
+const (
+	// OnInvalidMessageReject returns an error response to the publisher.
+	OnInvalidMessageReject = "reject"
+	// OnInvalidMessageDeadLetter accepts the message and routes it to the channel's dead-letter topic.
+	OnInvalidMessageDeadLetter = "dead-letter"
+
+	// validationErrorHeader carries the validation failure reason on dead-lettered messages.
+	validationErrorHeader = "x-validation-error"
+
+	// maxValidationReasons bounds the number of schema violations reported per message.
+	maxValidationReasons = 10
+)
+
+// MessageValidator validates a message payload against a channel schema.
+type MessageValidator interface {
+	Validate(payload []byte) error
+}
+
+// ChannelMessage holds the compiled payload schema of a channel and the action
+// taken for messages that do not match it.
+type ChannelMessage struct {
+	Validator        MessageValidator
+	OnInvalidMessage string // "reject" or "dead-letter"
+	DeadLetterTopic  string
+}
+
+// SchemaValidationError lists the reasons a payload does not match its channel schema.
+type SchemaValidationError struct {
+	Reasons []string
+}
+
+func (e *SchemaValidationError) Error() string {
+	return "payload does not match channel schema: " + strings.Join(e.Reasons, "; ")
+}
+
+// NewChannelMessage compiles the payload schema of a channel message definition.
+func NewChannelMessage(def bindingcfg.MessageDef, deadLetterTopic string) (*ChannelMessage, error) {
+	action := def.OnInvalidMessage
+	switch action {
+	case "":
+		action = OnInvalidMessageReject
+	case OnInvalidMessageReject, OnInvalidMessageDeadLetter:
+	default:
+		return nil, fmt.Errorf("unsupported onInvalidMessage action %q", def.OnInvalidMessage)
+	}
+
+	validator, err := newMessageValidator(def)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChannelMessage{
+		Validator:        validator,
+		OnInvalidMessage: action,
+		DeadLetterTopic:  deadLetterTopic,
+	}, nil
+}
+
+func newMessageValidator(def bindingcfg.MessageDef) (MessageValidator, error) {
+	if len(def.Payload) == 0 {
+		return nil, fmt.Errorf("message payload schema is empty")
+	}
+
+	switch {
+	case isAvroSchemaFormat(def.SchemaFormat):
+		data, err := json.Marshal(def.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode Avro schema: %w", err)
+		}
+		schema, err := avro.ParseBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid Avro schema: %w", err)
+		}
+		return &avroValidator{schema: schema}, nil
+	case isJSONSchemaFormat(def.SchemaFormat):
+		schema, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(def.Payload))
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON schema: %w", err)
+		}
+		return &jsonSchemaValidator{schema: schema}, nil
+	default:
+		return nil, fmt.Errorf("unsupported schema format %q", def.SchemaFormat)
+	}
+}
+
+// isAvroSchemaFormat reports whether an AsyncAPI schemaFormat denotes an Apache Avro schema.
+func isAvroSchemaFormat(schemaFormat string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(schemaFormat)), "application/vnd.apache.avro")
+}
+
+// isJSONSchemaFormat reports whether an AsyncAPI schemaFormat denotes a JSON Schema.
+// AsyncAPI schema objects are a JSON Schema superset, so they are accepted as well.
+func isJSONSchemaFormat(schemaFormat string) bool {
+	format := strings.ToLower(strings.TrimSpace(schemaFormat))
+	return format == "" ||
+		strings.HasPrefix(format, "application/schema+json") ||
+		strings.HasPrefix(format, "application/schema+yaml") ||
+		strings.HasPrefix(format, "application/vnd.aai.asyncapi")
+}
+
+// jsonSchemaValidator validates JSON payloads against a JSON Schema.
+type jsonSchemaValidator struct {
+	schema *gojsonschema.Schema
+}
+
+func (v *jsonSchemaValidator) Validate(payload []byte) error {
+	result, err := v.schema.Validate(gojsonschema.NewBytesLoader(payload))
+	if err != nil {
+		return &SchemaValidationError{Reasons: []string{"payload is not valid JSON"}}
+	}
+	if result.Valid() {
+		return nil
+	}
+
+	reasons := make([]string, 0, len(result.Errors()))
+	for _, e := range result.Errors() {
+		if len(reasons) == maxValidationReasons {
+			break
+		}
+		reasons = append(reasons, e.String())
+	}
+	return &SchemaValidationError{Reasons: reasons}
+}
+
+// avroValidator validates binary-encoded Avro payloads against an Avro schema.
+// The payload must decode completely; truncated data and trailing bytes are rejected.
+type avroValidator struct {
+	schema avro.Schema
+}
+
+func (v *avroValidator) Validate(payload []byte) error {
+	reader := avro.NewReader(nil, 0).Reset(payload)
+	var decoded any
+	reader.ReadVal(v.schema, &decoded)
+	if reader.Error != nil {
+		return &SchemaValidationError{Reasons: []string{fmt.Sprintf("payload is not a valid Avro datum: %v", reader.Error)}}
+	}
+	reader.Peek()
+	if !errors.Is(reader.Error, io.EOF) {
+		return &SchemaValidationError{Reasons: []string{"payload has trailing bytes after the Avro datum"}}
+	}
+	return nil
+}
+
+// validateInbound validates an inbound message against the schema of its channel.
+// Messages on channels without a schema pass through unchanged. Invalid messages are
+// either rejected with a 400 response or published to the channel's dead-letter topic
+// and acknowledged with a 202 response; both cases short-circuit the publish.
+func (h *Hub) validateInbound(ctx context.Context, binding *ChannelBinding, msg *connectors.Message) (*connectors.Message, bool, error) {
+	if msg.Topic == "" || len(binding.ChannelMessages) == 0 {
+		return msg, false, nil
+	}
+	channelMsg, ok := binding.ChannelMessages[msg.Topic]
+	if !ok || channelMsg == nil || channelMsg.Validator == nil {
+		return msg, false, nil
+	}
+
+	validationErr := channelMsg.Validator.Validate(msg.Value)
+	if validationErr == nil {
+		return msg, false, nil
+	}
+
+	if channelMsg.OnInvalidMessage == OnInvalidMessageDeadLetter && binding.BrokerDriver != nil && channelMsg.DeadLetterTopic != "" {
+		deadLetter := &connectors.Message{
+			Key:     msg.Key,
+			Value:   msg.Value,
+			Headers: cloneHeaders(msg.Headers),
+		}
+		deadLetter.Headers[validationErrorHeader] = []string{validationErr.Error()}
+		if err := binding.BrokerDriver.Publish(ctx, channelMsg.DeadLetterTopic, deadLetter); err != nil {
+			return nil, false, fmt.Errorf("failed to publish invalid message to dead-letter topic %s: %w", channelMsg.DeadLetterTopic, err)
+		}
+		slog.Info("Inbound message dead-lettered by schema validation",
+			"binding", binding.Name,
+			"channel", msg.Topic,
+			"topic", channelMsg.DeadLetterTopic,
+		)
+		return statusMessage(http.StatusAccepted, nil), true, nil
+	}
+
+	slog.Info("Inbound message rejected by schema validation", "binding", binding.Name, "channel", msg.Topic)
+	return errorResponseMessage(http.StatusBadRequest, "message does not match the channel schema", validationErr), true, nil
+}
+
+// errorResponseMessage builds a short-circuit response carrying a JSON error body.
+func errorResponseMessage(statusCode int, message string, cause error) *connectors.Message {
+	body := map[string]interface{}{"error": message}
+	var schemaErr *SchemaValidationError
+	if errors.As(cause, &schemaErr) {
+		body["details"] = schemaErr.Reasons
+	} else if cause != nil {
+		body["details"] = []string{cause.Error()}
+	}
+	data, _ := json.Marshal(body)
+	return statusMessage(statusCode, data)
+}
+
+// statusMessage builds a short-circuit response in the same shape as immediateResponseToMessage.
+func statusMessage(statusCode int, body []byte) *connectors.Message {
+	headers := map[string][]string{}
+	if len(body) > 0 {
+		headers["content-type"] = []string{"application/json"}
+	}
+	return &connectors.Message{
+		Value:   body,
+		Headers: headers,
+		Metadata: map[string]interface{}{
+			"status_code": statusCode,
+		},
+	}
+}
+
+func cloneHeaders(headers map[string][]string) map[string][]string {
+	cloned := make(map[string][]string, len(headers)+1)
+	for k, v := range headers {
+		cloned[k] = append([]string(nil), v...)
+	}
+	return cloned
+}
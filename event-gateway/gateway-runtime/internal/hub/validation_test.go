@@ -0,0 +1,351 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hamba/avro/v2"
+
+	bindingcfg "github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/binding"
+	"github.com/wso2/api-platform/event-gateway/gateway-runtime/internal/connectors"
+)
+
+var issueJSONSchema = map[string]interface{}{
+	"type":     "object",
+	"required": []interface{}{"id", "title"},
+	"properties": map[string]interface{}{
+		"id":    map[string]interface{}{"type": "integer"},
+		"title": map[string]interface{}{"type": "string"},
+	},
+}
+
+var issueAvroSchema = map[string]interface{}{
+	"type": "record",
+	"name": "Issue",
+	"fields": []interface{}{
+		map[string]interface{}{"name": "id", "type": "long"},
+		map[string]interface{}{"name": "title", "type": "string"},
+	},
+}
+
+func newSchemaTestHub(t *testing.T, def bindingcfg.MessageDef, driver connectors.BrokerDriver, cloudEventsMode string) *Hub {
+	t.Helper()
+	channelMsg, err := NewChannelMessage(def, "repos_v1_issues____deadletter")
+	if err != nil {
+		t.Fatalf("NewChannelMessage returned error: %v", err)
+	}
+	h := NewHub(nil)
+	h.RegisterBinding(ChannelBinding{
+		Name:            "repos",
+		Mode:            "websub",
+		Context:         "/repos",
+		Version:         "v1",
+		Channels:        map[string]string{"issues": "repos_v1_issues"},
+		ChannelMessages: map[string]*ChannelMessage{"issues": channelMsg},
+		CloudEventsMode: cloudEventsMode,
+		BrokerDriver:    driver,
+	})
+	return h
+}
+
+func TestProcessInbound_AcceptsValidJSONPayload(t *testing.T) {
+	h := newSchemaTestHub(t, bindingcfg.MessageDef{Payload: issueJSONSchema}, nil, "")
+
+	msg := &connectors.Message{Topic: "issues", Value: []byte(`{"id":1,"title":"bug"}`)}
+	out, shortCircuited, err := h.ProcessInbound(context.Background(), "repos", msg)
+	if err != nil {
+		t.Fatalf("ProcessInbound returned error: %v", err)
+	}
+	if shortCircuited || out != msg {
+		t.Fatalf("expected valid message to pass through, got shortCircuited=%v", shortCircuited)
+	}
+}
+
+func TestProcessInbound_RejectsInvalidJSONPayload(t *testing.T) {
+	h := newSchemaTestHub(t, bindingcfg.MessageDef{Payload: issueJSONSchema}, nil, "")
+
+	msg := &connectors.Message{Topic: "issues", Value: []byte(`{"id":"one"}`)}
+	out, shortCircuited, err := h.ProcessInbound(context.Background(), "repos", msg)
+	if err != nil {
+		t.Fatalf("ProcessInbound returned error: %v", err)
+	}
+	if !shortCircuited {
+		t.Fatal("expected invalid message to be short-circuited")
+	}
+	if got := out.Metadata["status_code"]; got != 400 {
+		t.Fatalf("expected status 400, got %v", got)
+	}
+	var body struct {
+		Error   string   `json:"error"`
+		Details []string `json:"details"`
+	}
+	if err := json.Unmarshal(out.Value, &body); err != nil {
+		t.Fatalf("expected JSON error body, got %q: %v", out.Value, err)
+	}
+	if len(body.Details) != 2 {
+		t.Fatalf("expected 2 violations (missing title, wrong id type), got %#v", body.Details)
+	}
+}
+
+func TestProcessInbound_DeadLettersInvalidPayload(t *testing.T) {
+	driver := &recordingBrokerDriver{}
+	h := newSchemaTestHub(t, bindingcfg.MessageDef{Payload: issueJSONSchema, OnInvalidMessage: OnInvalidMessageDeadLetter}, driver, "")
+
+	msg := &connectors.Message{
+		Topic:   "issues",
+		Value:   []byte(`not json`),
+		Headers: map[string][]string{"x-request-id": {"abc"}},
+	}
+	out, shortCircuited, err := h.ProcessInbound(context.Background(), "repos", msg)
+	if err != nil {
+		t.Fatalf("ProcessInbound returned error: %v", err)
+	}
+	if !shortCircuited || out.Metadata["status_code"] != 202 {
+		t.Fatalf("expected dead-lettered message to be acknowledged with 202, got %v", out.Metadata["status_code"])
+	}
+	if len(driver.published) != 1 {
+		t.Fatalf("expected one dead-letter publish, got %d", len(driver.published))
+	}
+	published := driver.published[0]
+	if published.topic != "repos_v1_issues____deadletter" {
+		t.Fatalf("unexpected dead-letter topic %q", published.topic)
+	}
+	if string(published.msg.Value) != "not json" || published.msg.Headers["x-request-id"][0] != "abc" {
+		t.Fatalf("expected original payload and headers to be dead-lettered, got %#v", published.msg)
+	}
+	if len(published.msg.Headers[validationErrorHeader]) != 1 {
+		t.Fatalf("expected %s header on dead-lettered message", validationErrorHeader)
+	}
+	if _, ok := msg.Headers[validationErrorHeader]; ok {
+		t.Fatal("expected the original message headers to be left untouched")
+	}
+}
+
+func TestAvroValidator(t *testing.T) {
+	channelMsg, err := NewChannelMessage(bindingcfg.MessageDef{
+		SchemaFormat: "application/vnd.apache.avro+json;version=1.9.0",
+		Payload:      issueAvroSchema,
+	}, "")
+	if err != nil {
+		t.Fatalf("NewChannelMessage returned error: %v", err)
+	}
+
+	schema := avro.MustParse(`{"type":"record","name":"Issue","fields":[{"name":"id","type":"long"},{"name":"title","type":"string"}]}`)
+	valid, err := avro.Marshal(schema, map[string]any{"id": int64(7), "title": "bug"})
+	if err != nil {
+		t.Fatalf("failed to encode Avro datum: %v", err)
+	}
+
+	if err := channelMsg.Validator.Validate(valid); err != nil {
+		t.Fatalf("expected valid Avro datum to pass, got %v", err)
+	}
+	if err := channelMsg.Validator.Validate(valid[:len(valid)-1]); err == nil {
+		t.Fatal("expected truncated Avro datum to fail")
+	}
+	if err := channelMsg.Validator.Validate(append(append([]byte(nil), valid...), 0x01)); err == nil {
+		t.Fatal("expected Avro datum with trailing bytes to fail")
+	}
+}
+
+func TestNewChannelMessage_RejectsUnsupportedConfig(t *testing.T) {
+	if _, err := NewChannelMessage(bindingcfg.MessageDef{Payload: issueJSONSchema, OnInvalidMessage: "drop"}, ""); err == nil {
+		t.Fatal("expected unsupported onInvalidMessage action to fail")
+	}
+	if _, err := NewChannelMessage(bindingcfg.MessageDef{Payload: issueJSONSchema, SchemaFormat: "application/raml+yaml"}, ""); err == nil {
+		t.Fatal("expected unsupported schema format to fail")
+	}
+	if _, err := NewChannelMessage(bindingcfg.MessageDef{}, ""); err == nil {
+		t.Fatal("expected empty payload schema to fail")
+	}
+}
+
+func TestProcessInbound_UnwrapsStructuredCloudEvent(t *testing.T) {
+	h := newSchemaTestHub(t, bindingcfg.MessageDef{Payload: issueJSONSchema}, nil, "")
+
+	msg := &connectors.Message{
+		Topic: "issues",
+		Headers: map[string][]string{
+			"Content-Type": {"application/cloudevents+json; charset=utf-8"},
+		},
+		Value: []byte(`{"specversion":"1.0","id":"evt-1","source":"/github","type":"issue.opened",` +
+			`"datacontenttype":"application/json","priority":3,"data":{"id":1,"title":"bug"}}`),
+	}
+	out, shortCircuited, err := h.ProcessInbound(context.Background(), "repos", msg)
+	if err != nil || shortCircuited {
+		t.Fatalf("expected structured CloudEvent to pass, got shortCircuited=%v err=%v", shortCircuited, err)
+	}
+	if string(out.Value) != `{"id":1,"title":"bug"}` {
+		t.Fatalf("expected event data as payload, got %q", out.Value)
+	}
+	for name, want := range map[string]string{
+		"ce-id":          "evt-1",
+		"ce-source":      "/github",
+		"ce-type":        "issue.opened",
+		"ce-specversion": "1.0",
+		"ce-priority":    "3",
+		"content-type":   "application/json",
+	} {
+		if got := out.Headers[name]; len(got) != 1 || got[0] != want {
+			t.Fatalf("expected header %s=%q, got %#v", name, want, got)
+		}
+	}
+	if _, ok := out.Headers["Content-Type"]; ok {
+		t.Fatal("expected structured content-type header to be replaced")
+	}
+	attrs, ok := out.Metadata[cloudEventMetadataKey].(map[string]string)
+	if !ok || attrs["id"] != "evt-1" {
+		t.Fatalf("expected CloudEvent attributes in metadata, got %#v", out.Metadata[cloudEventMetadataKey])
+	}
+}
+
+func TestProcessInbound_RejectsIncompleteBinaryCloudEvent(t *testing.T) {
+	h := newSchemaTestHub(t, bindingcfg.MessageDef{Payload: issueJSONSchema}, nil, "")
+
+	msg := &connectors.Message{
+		Topic: "issues",
+		Headers: map[string][]string{
+			"Ce-Specversion": {"1.0"},
+			"Ce-Source":      {"/github"},
+			"Ce-Type":        {"issue.opened"},
+		},
+		Value: []byte(`{"id":1,"title":"bug"}`),
+	}
+	out, shortCircuited, err := h.ProcessInbound(context.Background(), "repos", msg)
+	if err != nil {
+		t.Fatalf("ProcessInbound returned error: %v", err)
+	}
+	if !shortCircuited || out.Metadata["status_code"] != 400 {
+		t.Fatalf("expected CloudEvent without id to be rejected with 400, got %#v", out)
+	}
+}
+
+func TestCloudEvents_AssignsAttributesAndEmitsStructured(t *testing.T) {
+	h := newSchemaTestHub(t, bindingcfg.MessageDef{Payload: issueJSONSchema}, nil, CloudEventsModeStructured)
+
+	msg := &connectors.Message{
+		Topic:   "issues",
+		Headers: map[string][]string{"Content-Type": {"application/json"}},
+		Value:   []byte(`{"id":1,"title":"bug"}`),
+	}
+	in, shortCircuited, err := h.ProcessInbound(context.Background(), "repos", msg)
+	if err != nil || shortCircuited {
+		t.Fatalf("expected plain message to pass, got shortCircuited=%v err=%v", shortCircuited, err)
+	}
+	if got := in.Headers["ce-source"]; len(got) != 1 || got[0] != "/repos/v1" {
+		t.Fatalf("expected ce-source derived from the API base path, got %#v", got)
+	}
+	if got := in.Headers["ce-type"]; len(got) != 1 || got[0] != "issues" {
+		t.Fatalf("expected ce-type derived from the channel, got %#v", got)
+	}
+	if len(in.Headers["ce-id"]) != 1 || len(in.Headers["ce-time"]) != 1 {
+		t.Fatalf("expected ce-id and ce-time to be assigned, got %#v", in.Headers)
+	}
+
+	delivery := &connectors.Message{Topic: "repos_v1_issues", Headers: in.Headers, Value: in.Value}
+	out, shortCircuited, err := h.ProcessOutbound(context.Background(), "repos", delivery)
+	if err != nil || shortCircuited {
+		t.Fatalf("ProcessOutbound failed: shortCircuited=%v err=%v", shortCircuited, err)
+	}
+	if got := out.Headers["content-type"]; len(got) != 1 || got[0] != cloudEventsJSONContentType {
+		t.Fatalf("expected structured content type, got %#v", got)
+	}
+	for name := range out.Headers {
+		if strings.HasPrefix(name, cloudEventsHeaderPrefix) {
+			t.Fatalf("expected ce-* headers to be folded into the envelope, found %s", name)
+		}
+	}
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(out.Value, &envelope); err != nil {
+		t.Fatalf("expected JSON envelope, got %q: %v", out.Value, err)
+	}
+	if string(envelope["data"]) != `{"id":1,"title":"bug"}` || string(envelope["type"]) != `"issues"` {
+		t.Fatalf("unexpected envelope: %s", out.Value)
+	}
+	if string(envelope["datacontenttype"]) != `"application/json"` {
+		t.Fatalf("expected datacontenttype in envelope, got %s", envelope["datacontenttype"])
+	}
+}
+
+func TestEncodeCloudEvent_UsesDataBase64ForBinaryData(t *testing.T) {
+	msg := &connectors.Message{
+		Headers: map[string][]string{
+			"ce-specversion": {"1.0"},
+			"ce-id":          {"evt-2"},
+			"ce-source":      {"/sensors"},
+			"ce-type":        {"reading"},
+			"content-type":   {"application/octet-stream"},
+		},
+		Value: []byte{0x00, 0xff, 0x10},
+	}
+	if err := encodeCloudEvent(msg, CloudEventsModeStructured); err != nil {
+		t.Fatalf("encodeCloudEvent returned error: %v", err)
+	}
+
+	decoded := &connectors.Message{Headers: msg.Headers, Value: msg.Value}
+	isCloudEvent, err := decodeCloudEvent(decoded)
+	if err != nil || !isCloudEvent {
+		t.Fatalf("expected encoded envelope to decode, got isCloudEvent=%v err=%v", isCloudEvent, err)
+	}
+	if string(decoded.Value) != string([]byte{0x00, 0xff, 0x10}) {
+		t.Fatalf("expected binary data to round-trip, got %v", decoded.Value)
+	}
+	if got := decoded.Headers["content-type"]; len(got) != 1 || got[0] != "application/octet-stream" {
+		t.Fatalf("expected datacontenttype to round-trip, got %#v", got)
+	}
+}
+
+type publishedMessage struct {
+	topic string
+	msg   *connectors.Message
+}
+
+type recordingBrokerDriver struct {
+	published []publishedMessage
+}
+
+func (d *recordingBrokerDriver) Publish(_ context.Context, topic string, msg *connectors.Message) error {
+	d.published = append(d.published, publishedMessage{topic: topic, msg: msg})
+	return nil
+}
+
+func (d *recordingBrokerDriver) Subscribe(string, []string, connectors.MessageHandler) (connectors.Receiver, error) {
+	return nil, nil
+}
+
+func (d *recordingBrokerDriver) SubscribeManual(string, []string, connectors.MessageHandler) (connectors.Receiver, error) {
+	return nil, nil
+}
+
+func (d *recordingBrokerDriver) Replay(context.Context, string, connectors.MessageHandler) error {
+	return nil
+}
+
+func (d *recordingBrokerDriver) TopicExists(context.Context, string) (bool, error) { return true, nil }
+
+func (d *recordingBrokerDriver) EnsureTopics(context.Context, []string) error { return nil }
+
+func (d *recordingBrokerDriver) EnsureCompactedTopic(context.Context, string) error { return nil }
+
+func (d *recordingBrokerDriver) DeleteTopics(context.Context, []string) error { return nil }
+
+func (d *recordingBrokerDriver) Close() error { return nil }
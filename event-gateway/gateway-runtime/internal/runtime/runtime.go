@@ -166,11 +166,13 @@ func (r *Runtime) LoadChannels(channelsPath string) error {
 		r.brokerDrivers = append(r.brokerDrivers, brokerDriver)
 
 		receiverType := resolveReceiverType(b)
-		var mux *http.ServeMux
+		var mux connectors.RouteMux
 		switch receiverType {
 		case "websub":
 			mux = websubMux
 			hasWebSub = true
+		case "mqtt":
+			// MQTT receivers listen on their own TCP port and do not use an HTTP mux.
 		default:
 			mux = wsMux
 			hasWS = true
@@ -222,12 +224,33 @@ func (r *Runtime) LoadChannels(channelsPath string) error {
 		}
 		internalSubTopic := r.webSubSubscriptionSyncTopic(wsb.Name, wsb.Version)
 
+		// Compile channel payload schemas.
+		channelMessages, deadLetterTopics, err := buildChannelMessages(wsb)
+		if err != nil {
+			return fmt.Errorf("failed to build message schemas for WebSubApi %q: %w", wsb.Name, err)
+		}
+		cloudEventsMode, err := resolveCloudEventsMode(wsb.CloudEvents.Mode)
+		if err != nil {
+			return fmt.Errorf("invalid CloudEvents config for WebSubApi %q: %w", wsb.Name, err)
+		}
+
 		// Build policy chains for the API.
 		subKey, unsubKey, inKey, outKey, chChainKeys, err := r.buildWebSubApiPolicyChains(wsb, vhost)
 		if err != nil {
 			return fmt.Errorf("failed to build chains for WebSubApi %q: %w", wsb.Name, err)
 		}
 
+		// Create broker-driver.
+		brokerDriverType := "kafka"
+		if wsb.BrokerDriver.Type != "" {
+			brokerDriverType = wsb.BrokerDriver.Type
+		}
+		brokerDriver, err := r.registry.CreateBrokerDriver(brokerDriverType, wsb.BrokerDriver.Config)
+		if err != nil {
+			return fmt.Errorf("failed to create broker-driver for WebSubApi %q: %w", wsb.Name, err)
+		}
+		r.brokerDrivers = append(r.brokerDrivers, brokerDriver)
+
 		r.hub.RegisterBinding(hub.ChannelBinding{
 			APIID:               wsb.APIID,
 			Name:                wsb.Name,
@@ -241,19 +264,11 @@ func (r *Runtime) LoadChannels(channelsPath string) error {
 			OutboundChainKey:    outKey,
 			Channels:            channels,
 			ChannelChainKeys:    chChainKeys,
+			ChannelMessages:     channelMessages,
+			CloudEventsMode:     cloudEventsMode,
+			BrokerDriver:        brokerDriver,
 		})
 
-		// Create broker-driver.
-		brokerDriverType := "kafka"
-		if wsb.BrokerDriver.Type != "" {
-			brokerDriverType = wsb.BrokerDriver.Type
-		}
-		brokerDriver, err := r.registry.CreateBrokerDriver(brokerDriverType, wsb.BrokerDriver.Config)
-		if err != nil {
-			return fmt.Errorf("failed to create broker-driver for WebSubApi %q: %w", wsb.Name, err)
-		}
-		r.brokerDrivers = append(r.brokerDrivers, brokerDriver)
-
 		hasWebSub = true
 
 		ch := connectors.ChannelInfo{
@@ -264,6 +279,7 @@ func (r *Runtime) LoadChannels(channelsPath string) error {
 			Vhost:            vhost,
 			Channels:         channels,
 			InternalSubTopic: internalSubTopic,
+			DeadLetterTopics: deadLetterTopics,
 		}
 
 		ep, err := r.registry.CreateReceiver("websub", connectors.ReceiverConfig{
@@ -550,6 +566,7 @@ func (r *Runtime) buildPolicyChains(b binding.Binding) (subscribeKey, inboundKey
 
 	case "protocol-mediation":
 		channelPath := path.Join(b.Context, b.Receiver.Path)
+		subscribeKey = binding.GenerateRouteKey("SUBSCRIBE", channelPath, vhost)
 		inboundKey = binding.GenerateRouteKey("PUBLISH", channelPath, vhost)
 		outboundKey = binding.GenerateRouteKey("DELIVER", channelPath, vhost)
 
@@ -630,6 +647,46 @@ func (r *Runtime) buildWebSubApiPolicyChains(wsb binding.WebSubApiBinding, vhost
 	return subscribeKey, unsubscribeKey, inboundKey, outboundKey, channelChainKeys, nil
 }
 
+// buildChannelMessages compiles the payload schemas of a WebSubApi's channels. It also
+// returns the dead-letter topics of channels that route invalid messages there.
+func buildChannelMessages(wsb binding.WebSubApiBinding) (map[string]*hub.ChannelMessage, map[string]string, error) {
+	var channelMessages map[string]*hub.ChannelMessage
+	var deadLetterTopics map[string]string
+	for _, ch := range wsb.Channels {
+		if ch.Message == nil {
+			continue
+		}
+		deadLetterTopic := ""
+		if ch.Message.OnInvalidMessage == hub.OnInvalidMessageDeadLetter {
+			deadLetterTopic = binding.WebSubApiDeadLetterTopic(wsb.Name, wsb.Version, ch.Name)
+		}
+		channelMessage, err := hub.NewChannelMessage(*ch.Message, deadLetterTopic)
+		if err != nil {
+			return nil, nil, fmt.Errorf("channel %q: %w", ch.Name, err)
+		}
+		if channelMessages == nil {
+			channelMessages = make(map[string]*hub.ChannelMessage)
+		}
+		channelMessages[ch.Name] = channelMessage
+		if deadLetterTopic != "" {
+			if deadLetterTopics == nil {
+				deadLetterTopics = make(map[string]string)
+			}
+			deadLetterTopics[ch.Name] = deadLetterTopic
+		}
+	}
+	return channelMessages, deadLetterTopics, nil
+}
+
+// resolveCloudEventsMode validates the CloudEvents emission mode of a WebSubApi.
+func resolveCloudEventsMode(mode string) (string, error) {
+	switch mode {
+	case "", hub.CloudEventsModeBinary, hub.CloudEventsModeStructured:
+		return mode, nil
+	}
+	return "", fmt.Errorf("unsupported CloudEvents mode %q", mode)
+}
+
 func (r *Runtime) buildChain(routeKey string, policies []binding.PolicyRef) error {
 	if routeKey == "" {
 		return nil
@@ -637,11 +694,21 @@ func (r *Runtime) buildChain(routeKey string, policies []binding.PolicyRef) erro
 
 	specs := make([]engine.PolicySpec, len(policies))
 	for i, p := range policies {
+		var timeout time.Duration
+		if p.Timeout != "" {
+			d, err := time.ParseDuration(p.Timeout)
+			if err != nil {
+				return fmt.Errorf("policy %s in chain %s: invalid timeout %q: %w", p.Name, routeKey, p.Timeout, err)
+			}
+			timeout = d
+		}
 		specs[i] = engine.PolicySpec{
 			Name:       p.Name,
 			Version:    version.MajorVersion(p.Version),
 			Enabled:    true,
 			Parameters: p.Params,
+			Timeout:    timeout,
+			OnError:    p.OnError,
 		}
 	}
 
@@ -705,6 +772,18 @@ func (r *Runtime) AddWebSubApiBinding(wsb binding.WebSubApiBinding) error {
 	}
 	internalSubTopic := r.webSubSubscriptionSyncTopic(wsb.Name, wsb.Version)
 
+	// Compile channel payload schemas.
+	channelMessages, deadLetterTopics, err := buildChannelMessages(wsb)
+	if err != nil {
+		r.mu.Unlock()
+		return fmt.Errorf("failed to build message schemas for WebSubApi %q: %w", wsb.Name, err)
+	}
+	cloudEventsMode, err := resolveCloudEventsMode(wsb.CloudEvents.Mode)
+	if err != nil {
+		r.mu.Unlock()
+		return fmt.Errorf("invalid CloudEvents config for WebSubApi %q: %w", wsb.Name, err)
+	}
+
 	// Build policy chains for the API.
 	subKey, unsubKey, inKey, outKey, chChainKeys, err := r.buildWebSubApiPolicyChains(wsb, vhost)
 	if err != nil {
@@ -712,6 +791,18 @@ func (r *Runtime) AddWebSubApiBinding(wsb binding.WebSubApiBinding) error {
 		return fmt.Errorf("failed to build chains for WebSubApi %q: %w", wsb.Name, err)
 	}
 
+	// Create broker-driver.
+	brokerDriverType := "kafka"
+	if wsb.BrokerDriver.Type != "" {
+		brokerDriverType = wsb.BrokerDriver.Type
+	}
+	brokerDriver, err := r.registry.CreateBrokerDriver(brokerDriverType, wsb.BrokerDriver.Config)
+	if err != nil {
+		r.mu.Unlock()
+		return fmt.Errorf("failed to create broker-driver for WebSubApi %q: %w", wsb.Name, err)
+	}
+	r.activeBrokerDrivers[wsb.Name] = brokerDriver
+
 	r.hub.RegisterBinding(hub.ChannelBinding{
 		APIID:               wsb.APIID,
 		Name:                wsb.Name,
@@ -725,29 +816,23 @@ func (r *Runtime) AddWebSubApiBinding(wsb binding.WebSubApiBinding) error {
 		OutboundChainKey:    outKey,
 		Channels:            channels,
 		ChannelChainKeys:    chChainKeys,
+		ChannelMessages:     channelMessages,
+		CloudEventsMode:     cloudEventsMode,
+		BrokerDriver:        brokerDriver,
 	})
 
-	// Create broker-driver.
-	brokerDriverType := "kafka"
-	if wsb.BrokerDriver.Type != "" {
-		brokerDriverType = wsb.BrokerDriver.Type
-	}
-	brokerDriver, err := r.registry.CreateBrokerDriver(brokerDriverType, wsb.BrokerDriver.Config)
-	if err != nil {
-		r.mu.Unlock()
-		return fmt.Errorf("failed to create broker-driver for WebSubApi %q: %w", wsb.Name, err)
-	}
-	r.activeBrokerDrivers[wsb.Name] = brokerDriver
-
 	// Track the mux paths so RemoveWebSubApiBinding can deregister them.
 	basePath := binding.WebSubApiBasePath(wsb.Context, wsb.Version)
 	r.bindingPaths[wsb.Name] = []string{basePath + "/hub", basePath + "/webhook-receiver"}
 
 	// Track all Kafka topics for cleanup on removal.
-	allTopics := make([]string, 0, len(channels)+1)
+	allTopics := make([]string, 0, len(channels)+len(deadLetterTopics)+1)
 	for _, kafkaTopic := range channels {
 		allTopics = append(allTopics, kafkaTopic)
 	}
+	for _, deadLetterTopic := range deadLetterTopics {
+		allTopics = append(allTopics, deadLetterTopic)
+	}
 	allTopics = append(allTopics, internalSubTopic)
 	r.bindingTopics[wsb.Name] = allTopics
 
@@ -759,6 +844,7 @@ func (r *Runtime) AddWebSubApiBinding(wsb binding.WebSubApiBinding) error {
 		Vhost:            vhost,
 		Channels:         channels,
 		InternalSubTopic: internalSubTopic,
+		DeadLetterTopics: deadLetterTopics,
 	}
 
 	receiver, err := r.registry.CreateReceiver("websub", connectors.ReceiverConfig{
@@ -65,12 +65,27 @@ type EventChannelResource struct {
 	Receiver     ReceiverEntry     `json:"receiver"`
 	BrokerDriver BrokerDriverEntry `json:"brokerDriver"`
 	Policies     PoliciesEntry     `json:"policies"`
+	CloudEvents  CloudEventsEntry  `json:"cloudEvents"`
 }
 
 // ChannelEntry represents one channel in the EventChannelConfig.
 type ChannelEntry struct {
 	Name     string        `json:"name"`
 	Policies PoliciesEntry `json:"policies"`
+	Message  *MessageEntry `json:"message,omitempty"`
+}
+
+// MessageEntry carries the payload schema of a channel.
+type MessageEntry struct {
+	ContentType      string                 `json:"contentType"`
+	SchemaFormat     string                 `json:"schemaFormat"`
+	Payload          map[string]interface{} `json:"payload"`
+	OnInvalidMessage string                 `json:"onInvalidMessage"`
+}
+
+// CloudEventsEntry specifies the CloudEvents emission mode.
+type CloudEventsEntry struct {
+	Mode string `json:"mode"`
 }
 
 // ReceiverEntry specifies the receiver type.
@@ -97,6 +112,8 @@ type PolicyEntry struct {
 	Name    string                 `json:"name"`
 	Version string                 `json:"version"`
 	Params  map[string]interface{} `json:"params"`
+	Timeout string                 `json:"timeout,omitempty"`
+	OnError string                 `json:"onError,omitempty"`
 }
 
 // Handler processes EventChannelConfig xDS responses and manages bindings.
@@ -219,6 +236,14 @@ func (h *Handler) toWebSubApiBinding(ecr EventChannelResource) binding.WebSubApi
 				Outbound:    mapPolicyEntries(ch.Policies.Outbound),
 			},
 		}
+		if ch.Message != nil {
+			channels[i].Message = &binding.MessageDef{
+				ContentType:      ch.Message.ContentType,
+				SchemaFormat:     ch.Message.SchemaFormat,
+				Payload:          ch.Message.Payload,
+				OnInvalidMessage: ch.Message.OnInvalidMessage,
+			}
+		}
 	}
 
 	subscribe := mapPolicyEntries(ecr.Policies.Subscribe)
@@ -243,6 +268,9 @@ func (h *Handler) toWebSubApiBinding(ecr EventChannelResource) binding.WebSubApi
 			Inbound:     inbound,
 			Outbound:    outbound,
 		},
+		CloudEvents: binding.CloudEventsSpec{
+			Mode: ecr.CloudEvents.Mode,
+		},
 	}
 }
 
@@ -253,7 +281,7 @@ func mapPolicyEntries(entries []PolicyEntry) []binding.PolicyRef {
 	}
 	refs := make([]binding.PolicyRef, len(entries))
 	for i, p := range entries {
-		refs[i] = binding.PolicyRef{Name: p.Name, Version: p.Version, Params: p.Params}
+		refs[i] = binding.PolicyRef{Name: p.Name, Version: p.Version, Params: p.Params, Timeout: p.Timeout, OnError: p.OnError}
 	}
 	return refs
 }
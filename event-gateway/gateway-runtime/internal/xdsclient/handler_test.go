@@ -152,6 +152,46 @@ func TestHandlerHandleResources_UpdatesOnlyChangedBinding(t *testing.T) {
 	}
 }
 
+func TestToWebSubApiBinding_MapsMessageSchemaAndCloudEvents(t *testing.T) {
+	handler := NewHandler(&recordingBindingManager{}, KafkaConfig{Brokers: []string{"kafka:29092"}})
+
+	wsb := handler.toWebSubApiBinding(EventChannelResource{
+		UUID:    "api-1",
+		Name:    "githubser",
+		Context: "/proj1/githubser",
+		Version: "v1.0",
+		Channels: []ChannelEntry{
+			{
+				Name: "issues",
+				Message: &MessageEntry{
+					ContentType:      "application/json",
+					Payload:          map[string]interface{}{"type": "object"},
+					OnInvalidMessage: "dead-letter",
+				},
+			},
+			{Name: "pulls"},
+		},
+		CloudEvents: CloudEventsEntry{Mode: "structured"},
+	})
+
+	if wsb.CloudEvents.Mode != "structured" {
+		t.Fatalf("expected structured CloudEvents mode, got %q", wsb.CloudEvents.Mode)
+	}
+	if len(wsb.Channels) != 2 {
+		t.Fatalf("expected 2 channels, got %d", len(wsb.Channels))
+	}
+	msg := wsb.Channels[0].Message
+	if msg == nil {
+		t.Fatal("expected message definition on issues channel")
+	}
+	if msg.OnInvalidMessage != "dead-letter" || msg.ContentType != "application/json" || msg.Payload["type"] != "object" {
+		t.Fatalf("unexpected message definition: %#v", msg)
+	}
+	if wsb.Channels[1].Message != nil {
+		t.Fatalf("expected no message definition on pulls channel, got %#v", wsb.Channels[1].Message)
+	}
+}
+
 type recordingBindingManager struct {
 	added   []string
 	removed []string
@@ -1216,3 +1216,112 @@ policies:
 	assert.Equal(t, "py-policy", policies[1].Name)
 	assert.Equal(t, "python", policies[1].Runtime)
 }
+
+func TestDetectRuntime_WasmDirectory(t *testing.T) {
+	tmpDir := t.TempDir()
+	testutils.WriteFile(t, filepath.Join(tmpDir, "policy.wasm"), "\x00asm\x01\x00\x00\x00")
+	testutils.WriteFile(t, filepath.Join(tmpDir, "policy-definition.yaml"), "name: test\nversion: v1.0.0\n")
+
+	runtime, err := DetectRuntime(tmpDir)
+
+	require.NoError(t, err)
+	assert.Equal(t, "wasm", runtime)
+}
+
+func TestDetectRuntime_WasmModuleFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	modulePath := filepath.Join(tmpDir, "policy.wasm")
+	testutils.WriteFile(t, modulePath, "\x00asm\x01\x00\x00\x00")
+
+	runtime, err := DetectRuntime(modulePath)
+
+	require.NoError(t, err)
+	assert.Equal(t, "wasm", runtime)
+}
+
+func TestDetectRuntime_GoTakesPrecedenceOverWasm(t *testing.T) {
+	// A Go policy may keep a compiled module around; go.mod still wins
+	tmpDir := t.TempDir()
+	testutils.WriteFile(t, filepath.Join(tmpDir, "go.mod"), "module test\n\ngo 1.23")
+	testutils.WriteFile(t, filepath.Join(tmpDir, "policy.wasm"), "\x00asm\x01\x00\x00\x00")
+
+	runtime, err := DetectRuntime(tmpDir)
+
+	require.NoError(t, err)
+	assert.Equal(t, "go", runtime)
+}
+
+func TestDiscoverPoliciesFromBuildFile_WasmAutoDetect(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	policyDir := filepath.Join(tmpDir, "policies", "my-wasm-policy")
+	testutils.CreateDir(t, policyDir)
+	testutils.WriteFile(t, filepath.Join(policyDir, "policy-definition.yaml"), "name: my-wasm-policy\nversion: v1.0.0\n")
+	testutils.WriteFile(t, filepath.Join(policyDir, "header_guard.wasm"), "\x00asm\x01\x00\x00\x00")
+
+	manifestContent := `version: v1
+policies:
+  - name: my-wasm-policy
+    filePath: ./policies/my-wasm-policy
+`
+	manifestPath := filepath.Join(tmpDir, "build-manifest.yaml")
+	testutils.WriteFile(t, manifestPath, manifestContent)
+
+	policies, err := DiscoverPoliciesFromBuildFile(manifestPath, "")
+
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, "my-wasm-policy", policies[0].Name)
+	assert.Equal(t, "v1.0.0", policies[0].Version)
+	assert.Equal(t, "wasm", policies[0].Runtime)
+	assert.Equal(t, filepath.Join(policyDir, "header_guard.wasm"), policies[0].WasmModulePath)
+	assert.Equal(t, filepath.Join(policyDir, "policy-definition.yaml"), policies[0].YAMLPath)
+}
+
+func TestDiscoverPoliciesFromBuildFile_WasmModuleFilePath(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	policyDir := filepath.Join(tmpDir, "policies", "my-wasm-policy")
+	testutils.CreateDir(t, policyDir)
+	testutils.WriteFile(t, filepath.Join(policyDir, "policy-definition.yaml"), "name: my-wasm-policy\nversion: v1.0.0\n")
+	testutils.WriteFile(t, filepath.Join(policyDir, "a.wasm"), "\x00asm\x01\x00\x00\x00")
+	testutils.WriteFile(t, filepath.Join(policyDir, "b.wasm"), "\x00asm\x01\x00\x00\x00")
+
+	manifestContent := `version: v1
+policies:
+  - name: my-wasm-policy
+    filePath: ./policies/my-wasm-policy/b.wasm
+`
+	manifestPath := filepath.Join(tmpDir, "build-manifest.yaml")
+	testutils.WriteFile(t, manifestPath, manifestContent)
+
+	policies, err := DiscoverPoliciesFromBuildFile(manifestPath, "")
+
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, "wasm", policies[0].Runtime)
+	assert.Equal(t, filepath.Join(policyDir, "b.wasm"), policies[0].WasmModulePath)
+}
+
+func TestDiscoverPoliciesFromBuildFile_WasmAmbiguousModule(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	policyDir := filepath.Join(tmpDir, "policies", "my-wasm-policy")
+	testutils.CreateDir(t, policyDir)
+	testutils.WriteFile(t, filepath.Join(policyDir, "policy-definition.yaml"), "name: my-wasm-policy\nversion: v1.0.0\n")
+	testutils.WriteFile(t, filepath.Join(policyDir, "a.wasm"), "\x00asm\x01\x00\x00\x00")
+	testutils.WriteFile(t, filepath.Join(policyDir, "b.wasm"), "\x00asm\x01\x00\x00\x00")
+
+	manifestContent := `version: v1
+policies:
+  - name: my-wasm-policy
+    filePath: ./policies/my-wasm-policy
+`
+	manifestPath := filepath.Join(tmpDir, "build-manifest.yaml")
+	testutils.WriteFile(t, manifestPath, manifestContent)
+
+	_, err := DiscoverPoliciesFromBuildFile(manifestPath, "")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected exactly one .wasm module")
+}
@@ -226,6 +226,12 @@ func DiscoverPoliciesFromBuildFile(buildFilePath string, baseDir string) ([]*typ
 					return nil, err
 				}
 				discovered = append(discovered, policy)
+			} else if runtime == "wasm" {
+				policy, err := discoverWasmPolicy(entry, baseDir)
+				if err != nil {
+					return nil, err
+				}
+				discovered = append(discovered, policy)
 			} else {
 				policy, err := discoverGoPolicy(entry, baseDir)
 				if err != nil {
@@ -241,10 +247,17 @@ func DiscoverPoliciesFromBuildFile(buildFilePath string, baseDir string) ([]*typ
 }
 
 // DetectRuntime auto-detects the policy runtime by examining the directory contents.
-// Presence of go.mod → "go"; presence of .py files (and no go.mod) → "python".
+// Presence of go.mod → "go"; presence of .py files (and no go.mod) → "python";
+// a .wasm file (or a directory holding one, and no go.mod) → "wasm".
 // Also recognises the industry-standard src layout where pyproject.toml exists at
 // the root but .py files live under src/<package>/.
 func DetectRuntime(policyDir string) (string, error) {
+	if filepath.Ext(policyDir) == wasmModuleExt {
+		if info, err := os.Stat(policyDir); err == nil && !info.IsDir() {
+			return "wasm", nil
+		}
+	}
+
 	goMod := filepath.Join(policyDir, "go.mod")
 	if _, err := os.Stat(goMod); err == nil {
 		return "go", nil
@@ -267,6 +280,13 @@ func DetectRuntime(policyDir string) (string, error) {
 		return "python", nil
 	}
 
+	// Check for a prebuilt WebAssembly module
+	for _, e := range entries {
+		if !e.IsDir() && filepath.Ext(e.Name()) == wasmModuleExt {
+			return "wasm", nil
+		}
+	}
+
 	return "go", nil
 }
 
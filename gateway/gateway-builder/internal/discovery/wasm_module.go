@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package discovery
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/errors"
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/types"
+)
+
+// wasmModuleExt is the file extension of prebuilt WebAssembly policy modules
+const wasmModuleExt = ".wasm"
+
+// discoverWasmPolicy discovers a WebAssembly policy from a local filePath entry.
+// The entry points either at the .wasm module itself or at a directory holding
+// exactly one .wasm module; policy-definition.yaml must sit next to the module.
+func discoverWasmPolicy(entry types.BuildEntry, baseDir string) (*types.DiscoveredPolicy, error) {
+	policyPath := filepath.Join(baseDir, entry.FilePath)
+
+	modulePath, err := findWasmModule(policyPath)
+	if err != nil {
+		return nil, errors.NewDiscoveryError(
+			fmt.Sprintf("invalid WebAssembly policy for %s at %s", entry.Name, policyPath),
+			err,
+		)
+	}
+	policyDir := filepath.Dir(modulePath)
+
+	slog.Info("Resolved WebAssembly policy entry via filePath",
+		"name", entry.Name,
+		"filePath", entry.FilePath,
+		"module", modulePath)
+
+	policyYAMLPath := filepath.Join(policyDir, types.PolicyDefinitionFile)
+	definition, err := ParsePolicyYAML(policyYAMLPath)
+	if err != nil {
+		return nil, errors.NewDiscoveryError(
+			fmt.Sprintf("failed to parse %s for %s at %s", types.PolicyDefinitionFile, entry.Name, policyDir),
+			err,
+		)
+	}
+
+	if entry.Name != definition.Name {
+		return nil, errors.NewDiscoveryError(
+			fmt.Sprintf("policy name mismatch: build file declares '%s' but %s has '%s' at %s",
+				entry.Name, types.PolicyDefinitionFile, definition.Name, policyDir),
+			nil,
+		)
+	}
+
+	if definition.Version == "" {
+		return nil, errors.NewDiscoveryError(
+			fmt.Sprintf("policy version cannot be found in definition for %s", entry.Name),
+			nil,
+		)
+	}
+
+	discovered := &types.DiscoveredPolicy{
+		Name:             definition.Name,
+		Version:          definition.Version,
+		Path:             policyDir,
+		YAMLPath:         policyYAMLPath,
+		SourceFiles:      []string{modulePath},
+		SystemParameters: ExtractDefaultValues(definition.SystemParameters),
+		Definition:       definition,
+		IsFilePathEntry:  true,
+		Runtime:          "wasm",
+		WasmModulePath:   modulePath,
+	}
+
+	slog.Info("Discovered WebAssembly policy",
+		"name", discovered.Name,
+		"version", discovered.Version,
+		"module", modulePath,
+		"phase", "discovery")
+
+	return discovered, nil
+}
+
+// findWasmModule resolves the module of a WebAssembly policy: the path itself when
+// it is a .wasm file, otherwise the only .wasm file directly inside the directory.
+func findWasmModule(policyPath string) (string, error) {
+	info, err := os.Stat(policyPath)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		if filepath.Ext(policyPath) != wasmModuleExt {
+			return "", fmt.Errorf("%s is not a %s module", policyPath, wasmModuleExt)
+		}
+		return policyPath, nil
+	}
+
+	entries, err := os.ReadDir(policyPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read policy directory %s: %w", policyPath, err)
+	}
+	var modules []string
+	for _, e := range entries {
+		if !e.IsDir() && filepath.Ext(e.Name()) == wasmModuleExt {
+			modules = append(modules, e.Name())
+		}
+	}
+	switch len(modules) {
+	case 0:
+		return "", fmt.Errorf("no %s module found in %s", wasmModuleExt, policyPath)
+	case 1:
+		return filepath.Join(policyPath, modules[0]), nil
+	default:
+		return "", fmt.Errorf("expected exactly one %s module in %s, found %s",
+			wasmModuleExt, policyPath, strings.Join(modules, ", "))
+	}
+}
@@ -26,11 +26,15 @@ import (
 	"strings"
 
 	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/errors"
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/fsutil"
 	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/types"
 )
 
 const BuilderVersion = "v1.0.0"
 
+// wasmPoliciesDir is the directory under cmd/policy-engine holding embedded WebAssembly modules
+const wasmPoliciesDir = "wasm_policies"
+
 // GenerateCode orchestrates all code generation tasks
 func GenerateCode(srcDir string, policies []*types.DiscoveredPolicy, outputDir string) error {
 	slog.Debug("Starting code generation",
@@ -39,12 +43,15 @@ func GenerateCode(srcDir string, policies []*types.DiscoveredPolicy, outputDir s
 		"policyCount", len(policies),
 		"phase", "generation")
 
-	// Separate Go and Python policies
+	// Separate Go, Python and WebAssembly policies
 	var goPolicies []*types.DiscoveredPolicy
 	var pythonPolicies []*types.DiscoveredPolicy
+	var wasmPolicies []*types.DiscoveredPolicy
 
 	for _, p := range policies {
-		if p.Runtime == "python" {
+		if p.Runtime == "wasm" {
+			wasmPolicies = append(wasmPolicies, p)
+		} else if p.Runtime == "python" {
 			pythonPolicies = append(pythonPolicies, p)
 		} else {
 			goPolicies = append(goPolicies, p)
@@ -54,6 +61,7 @@ func GenerateCode(srcDir string, policies []*types.DiscoveredPolicy, outputDir s
 	slog.Info("Generating code for policies",
 		"goPolicies", len(goPolicies),
 		"pythonPolicies", len(pythonPolicies),
+		"wasmPolicies", len(wasmPolicies),
 		"phase", "generation")
 
 	// Always create Python executor base (even if no Python policies)
@@ -66,7 +74,14 @@ func GenerateCode(srcDir string, policies []*types.DiscoveredPolicy, outputDir s
 	mainPkgDir := filepath.Join(srcDir, "cmd", "policy-engine")
 	slog.Debug("Code generation target", "mainPkgDir", mainPkgDir, "phase", "generation")
 
-	// Generate plugin_registry.go (includes both Go and Python)
+	// Copy WebAssembly modules next to plugin_registry.go, which embeds them
+	if len(wasmPolicies) > 0 {
+		if err := copyWasmModules(mainPkgDir, wasmPolicies); err != nil {
+			return errors.NewGenerationError("failed to copy WebAssembly modules", err)
+		}
+	}
+
+	// Generate plugin_registry.go (includes Go, Python and WebAssembly)
 	registryCode, err := GeneratePluginRegistry(policies, srcDir)
 	if err != nil {
 		return errors.NewGenerationError("failed to generate plugin registry", err)
@@ -121,6 +136,28 @@ func GenerateCode(srcDir string, policies []*types.DiscoveredPolicy, outputDir s
 	return nil
 }
 
+// copyWasmModules copies each WebAssembly policy module into cmd/policy-engine/wasm_policies
+// under the name plugin_registry.go embeds it by
+func copyWasmModules(mainPkgDir string, wasmPolicies []*types.DiscoveredPolicy) error {
+	modulesDir := filepath.Join(mainPkgDir, wasmPoliciesDir)
+	if err := os.RemoveAll(modulesDir); err != nil {
+		return fmt.Errorf("failed to clean %s: %w", modulesDir, err)
+	}
+	if err := os.MkdirAll(modulesDir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", modulesDir, err)
+	}
+
+	for _, p := range wasmPolicies {
+		dest := filepath.Join(mainPkgDir, filepath.FromSlash(wasmModuleFile(generateImportAlias(p.Name, p.Version))))
+		if err := fsutil.CopyFile(p.WasmModulePath, dest); err != nil {
+			return fmt.Errorf("failed to copy WebAssembly module for %s: %w", p.Name, err)
+		}
+		slog.Debug("Copied WebAssembly policy module", "name", p.Name, "dest", dest)
+	}
+
+	return nil
+}
+
 // generatePythonExecutorBase generates the base Python executor files
 // This is always called to ensure Docker builds don't fail
 func generatePythonExecutorBase(srcDir string, outputDir string) error {
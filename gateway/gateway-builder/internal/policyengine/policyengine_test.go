@@ -221,6 +221,32 @@ func TestGeneratePluginRegistry_MultiplePolicies(t *testing.T) {
 	assert.Contains(t, result, "jwt_auth__0_1_0")
 }
 
+func TestGeneratePluginRegistry_WasmPolicy(t *testing.T) {
+	policies := []*types.DiscoveredPolicy{
+		{
+			Name:           "header-guard",
+			Version:        "v1.0.0",
+			Runtime:        "wasm",
+			WasmModulePath: "/policies/header-guard/header_guard.wasm",
+		},
+	}
+
+	result, err := GeneratePluginRegistry(policies, "/src")
+	require.NoError(t, err)
+	assert.Contains(t, result, `_ "embed"`)
+	assert.Contains(t, result, "policy-engine/internal/wasmbridge")
+	assert.Contains(t, result, "//go:embed wasm_policies/header_guard__1_0_0.wasm\nvar wasmModule_header_guard__1_0_0 []byte")
+	assert.Contains(t, result, "Module:        wasmModule_header_guard__1_0_0,")
+	assert.NotContains(t, result, "pythonbridge")
+}
+
+func TestGeneratePluginRegistry_NoWasmPolicies(t *testing.T) {
+	result, err := GeneratePluginRegistry([]*types.DiscoveredPolicy{}, "/src")
+	require.NoError(t, err)
+	assert.NotContains(t, result, `"embed"`)
+	assert.NotContains(t, result, "wasmbridge")
+}
+
 func TestGenerateBuildInfo_EmptyPolicies(t *testing.T) {
 	policies := []*types.DiscoveredPolicy{}
 
@@ -409,6 +435,45 @@ func TestGenerateCode_Success(t *testing.T) {
 	assert.Contains(t, string(buildInfoContent), "ratelimit")
 }
 
+func TestGenerateCode_WasmPolicy(t *testing.T) {
+	rootDir := t.TempDir()
+	tmpDir := filepath.Join(rootDir, "gateway", "gateway-runtime", "policy-engine")
+
+	mainPkgDir := filepath.Join(tmpDir, "cmd", "policy-engine")
+	testutils.CreateDir(t, mainPkgDir)
+	testutils.WritePolicyEngineGoMod(t, tmpDir)
+
+	pythonExecDir := filepath.Join(rootDir, "gateway", "gateway-runtime", "python-executor")
+	testutils.CreateDir(t, pythonExecDir)
+
+	// A module left over from a previous build must not be embedded again
+	testutils.WriteFile(t, filepath.Join(mainPkgDir, "wasm_policies", "stale__1_0_0.wasm"), "stale")
+
+	modulePath := filepath.Join(t.TempDir(), "header_guard.wasm")
+	testutils.WriteFile(t, modulePath, "\x00asm\x01\x00\x00\x00")
+
+	policies := []*types.DiscoveredPolicy{
+		{
+			Name:           "header-guard",
+			Version:        "v1.0.0",
+			Runtime:        "wasm",
+			WasmModulePath: modulePath,
+		},
+	}
+
+	err := GenerateCode(tmpDir, policies, t.TempDir())
+	require.NoError(t, err)
+
+	embedded, err := os.ReadFile(filepath.Join(mainPkgDir, "wasm_policies", "header_guard__1_0_0.wasm"))
+	require.NoError(t, err)
+	assert.Equal(t, "\x00asm\x01\x00\x00\x00", string(embedded))
+	assert.NoFileExists(t, filepath.Join(mainPkgDir, "wasm_policies", "stale__1_0_0.wasm"))
+
+	registryContent, err := os.ReadFile(filepath.Join(mainPkgDir, "plugin_registry.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(registryContent), "//go:embed wasm_policies/header_guard__1_0_0.wasm")
+}
+
 func TestGenerateCode_EmptyPolicies(t *testing.T) {
 	rootDir := t.TempDir()
 	tmpDir := filepath.Join(rootDir, "gateway", "gateway-runtime", "policy-engine")
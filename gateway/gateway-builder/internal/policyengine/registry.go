@@ -36,7 +36,8 @@ type PolicyImport struct {
 	ImportPath       string
 	ImportAlias      string
 	SystemParameters map[string]interface{} // from policy-definition.yaml
-	Runtime          string                 // "go" or "python"
+	Runtime          string                 // "go", "python" or "wasm"
+	ModuleFile       string                 // embedded module path relative to cmd/policy-engine (wasm)
 }
 
 // GeneratePluginRegistry generates the plugin_registry.go file
@@ -45,12 +46,23 @@ func GeneratePluginRegistry(policies []*types.DiscoveredPolicy, srcDir string) (
 		"policyCount", len(policies),
 		"phase", "generation")
 
-	// Separate Go and Python policies
+	// Separate Go, Python and WebAssembly policies
 	var goPolicies []PolicyImport
 	var pythonPolicies []PolicyImport
+	var wasmPolicies []PolicyImport
 
 	for _, p := range policies {
-		if p.Runtime == "python" {
+		if p.Runtime == "wasm" {
+			importAlias := generateImportAlias(p.Name, p.Version)
+			wasmPolicies = append(wasmPolicies, PolicyImport{
+				Name:             p.Name,
+				Version:          p.Version,
+				ImportAlias:      importAlias,
+				SystemParameters: p.SystemParameters,
+				Runtime:          "wasm",
+				ModuleFile:       wasmModuleFile(importAlias),
+			})
+		} else if p.Runtime == "python" {
 			pythonPolicies = append(pythonPolicies, PolicyImport{
 				Name:             p.Name,
 				Version:          p.Version,
@@ -90,6 +102,7 @@ func GeneratePluginRegistry(policies []*types.DiscoveredPolicy, srcDir string) (
 	slog.Debug("Executing plugin registry template",
 		"goPolicyCount", len(goPolicies),
 		"pythonPolicyCount", len(pythonPolicies),
+		"wasmPolicyCount", len(wasmPolicies),
 		"phase", "generation")
 
 	// Execute template
@@ -97,13 +110,17 @@ func GeneratePluginRegistry(policies []*types.DiscoveredPolicy, srcDir string) (
 	data := struct {
 		GoPolicies        []PolicyImport
 		PythonPolicies    []PolicyImport
+		WasmPolicies      []PolicyImport
 		HasGoPolicies     bool
 		HasPythonPolicies bool
+		HasWasmPolicies   bool
 	}{
 		GoPolicies:        goPolicies,
 		PythonPolicies:    pythonPolicies,
+		WasmPolicies:      wasmPolicies,
 		HasGoPolicies:     len(goPolicies) > 0,
 		HasPythonPolicies: len(pythonPolicies) > 0,
+		HasWasmPolicies:   len(wasmPolicies) > 0,
 	}
 
 	if err := tmpl.Execute(&buf, data); err != nil {
@@ -120,6 +137,12 @@ func generateImportPath(policy *types.DiscoveredPolicy) string {
 	return policy.GoModulePath
 }
 
+// wasmModuleFile returns where a WebAssembly policy's module is copied, relative to
+// the policy engine main package, so plugin_registry.go can embed it
+func wasmModuleFile(importAlias string) string {
+	return wasmPoliciesDir + "/" + importAlias + ".wasm"
+}
+
 // generateImportAlias creates a valid Go identifier for import alias
 func generateImportAlias(name, version string) string {
 	// Sanitize name and version to create a valid Go identifier
@@ -548,3 +548,131 @@ func TestSanitizeForGoIdent(t *testing.T) {
 		})
 	}
 }
+
+// ==== ValidateWasmModule tests ====
+
+type testWasmImport struct {
+	module, name string
+}
+
+type testWasmExport struct {
+	name string
+	kind byte
+}
+
+// buildTestWasmModule encodes a wasm binary holding only import and export sections
+func buildTestWasmModule(imports []testWasmImport, exports []testWasmExport) []byte {
+	name := func(s string) []byte { return append([]byte{byte(len(s))}, s...) }
+	section := func(id byte, body []byte) []byte {
+		return append([]byte{id, byte(len(body))}, body...)
+	}
+
+	module := []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}
+	if len(imports) > 0 {
+		body := []byte{byte(len(imports))}
+		for _, imp := range imports {
+			body = append(body, name(imp.module)...)
+			body = append(body, name(imp.name)...)
+			body = append(body, wasmExternFunc, 0x00)
+		}
+		module = append(module, section(wasmSectionImport, body)...)
+	}
+	if len(exports) > 0 {
+		body := []byte{byte(len(exports))}
+		for _, exp := range exports {
+			body = append(body, name(exp.name)...)
+			body = append(body, exp.kind, 0x00)
+		}
+		module = append(module, section(wasmSectionExport, body)...)
+	}
+	return module
+}
+
+func writeTestWasmPolicy(t *testing.T, module []byte) *types.DiscoveredPolicy {
+	t.Helper()
+	modulePath := filepath.Join(t.TempDir(), "policy.wasm")
+	testutils.WriteFile(t, modulePath, string(module))
+	return &types.DiscoveredPolicy{
+		Name:           "wasm-policy",
+		Version:        "v1.0.0",
+		Runtime:        "wasm",
+		WasmModulePath: modulePath,
+	}
+}
+
+var validTestWasmExports = []testWasmExport{
+	{name: "memory", kind: wasmExternMemory},
+	{name: "policy_alloc", kind: wasmExternFunc},
+	{name: "on_request_headers", kind: wasmExternFunc},
+}
+
+func TestValidateWasmModule_Valid(t *testing.T) {
+	p := writeTestWasmPolicy(t, buildTestWasmModule(
+		[]testWasmImport{
+			{module: "wasi_snapshot_preview1", name: "fd_write"},
+			{module: "api_platform", name: "log"},
+		},
+		validTestWasmExports,
+	))
+
+	errs := ValidateWasmModule(p)
+
+	assert.Empty(t, errs)
+}
+
+func TestValidateWasmModule_NotWasm(t *testing.T) {
+	p := writeTestWasmPolicy(t, []byte("#!/bin/sh\necho not a module\n"))
+
+	errs := ValidateWasmModule(p)
+
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Message, "missing wasm magic number")
+	assert.Equal(t, p.WasmModulePath, errs[0].FilePath)
+}
+
+func TestValidateWasmModule_Truncated(t *testing.T) {
+	module := buildTestWasmModule(nil, validTestWasmExports)
+	p := writeTestWasmPolicy(t, module[:len(module)-3])
+
+	errs := ValidateWasmModule(p)
+
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Message, "unexpected end of module")
+}
+
+func TestValidateWasmModule_MissingExports(t *testing.T) {
+	p := writeTestWasmPolicy(t, buildTestWasmModule(nil, []testWasmExport{
+		{name: "memory", kind: wasmExternMemory},
+		{name: "on_request_headers", kind: wasmExternMemory},
+	}))
+
+	errs := ValidateWasmModule(p)
+
+	require.Len(t, errs, 2)
+	assert.Contains(t, errs[0].Message, `"policy_alloc"`)
+	assert.Contains(t, errs[1].Message, "at least one of on_request_headers")
+}
+
+func TestValidateWasmModule_UnsupportedImport(t *testing.T) {
+	p := writeTestWasmPolicy(t, buildTestWasmModule(
+		[]testWasmImport{{module: "env", name: "socket_connect"}},
+		validTestWasmExports,
+	))
+
+	errs := ValidateWasmModule(p)
+
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Message, "imports env.socket_connect")
+}
+
+func TestValidatePolicies_WasmPolicy(t *testing.T) {
+	p := writeTestWasmPolicy(t, buildTestWasmModule(nil, validTestWasmExports[:2]))
+	p.Definition = &policy.PolicyDefinition{Name: p.Name, Version: p.Version}
+
+	result, err := ValidatePolicies([]*types.DiscoveredPolicy{p})
+
+	require.Error(t, err)
+	assert.False(t, result.Valid)
+	require.Len(t, result.Errors, 1)
+	assert.Contains(t, result.Errors[0].Message, "at least one of")
+}
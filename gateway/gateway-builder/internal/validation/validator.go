@@ -63,14 +63,21 @@ func ValidatePolicies(policies []*types.DiscoveredPolicy) (*types.ValidationResu
 			"runtime", policy.Runtime,
 			"phase", "validation")
 
-		// YAML schema validation (applies to both Go and Python)
+		// YAML schema validation (applies to all runtimes)
 		yamlErrors := ValidateYAMLSchema(policy)
 		result.Errors = append(result.Errors, yamlErrors...)
 		if len(yamlErrors) > 0 {
 			result.Valid = false
 		}
 
-		if policy.Runtime == "python" {
+		if policy.Runtime == "wasm" {
+			// WebAssembly-specific validations
+			wasmErrors := ValidateWasmModule(policy)
+			result.Errors = append(result.Errors, wasmErrors...)
+			if len(wasmErrors) > 0 {
+				result.Valid = false
+			}
+		} else if policy.Runtime == "python" {
 			// Python-specific validations
 			structErrors := ValidatePythonDirectoryStructure(policy)
 			result.Errors = append(result.Errors, structErrors...)
@@ -0,0 +1,298 @@
+/*
+ * Copyright (c) 2025, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package validation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/wso2/api-platform/gateway/gateway-builder/pkg/types"
+)
+
+// Host ABI expected by the policy engine's WebAssembly runtime
+const (
+	wasmHostModule = "api_platform"
+	wasmWASIModule = "wasi_snapshot_preview1"
+	wasmAllocFunc  = "policy_alloc"
+	wasmMemory     = "memory"
+)
+
+// wasmPhaseExports are the exports through which a module handles a processing phase
+var wasmPhaseExports = []string{
+	"on_request_headers",
+	"on_request_body",
+	"on_response_headers",
+	"on_response_body",
+}
+
+var wasmMagic = []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}
+
+const (
+	wasmSectionImport = 2
+	wasmSectionExport = 7
+
+	wasmExternFunc   = 0
+	wasmExternTable  = 1
+	wasmExternMemory = 2
+	wasmExternGlobal = 3
+	wasmExternTag    = 4
+)
+
+// wasmModuleInfo holds the parts of a module's binary relevant to the host ABI
+type wasmModuleInfo struct {
+	imports []wasmImport
+	exports map[string]byte // export name -> extern kind
+}
+
+type wasmImport struct {
+	module string
+	name   string
+}
+
+// ValidateWasmModule validates that a WebAssembly policy module can be loaded by the
+// policy engine: it must be a wasm binary that exports memory, policy_alloc and at
+// least one phase handler, and import only WASI and the api_platform host module.
+func ValidateWasmModule(policy *types.DiscoveredPolicy) []types.ValidationError {
+	var errs []types.ValidationError
+	addErr := func(msg string) {
+		errs = append(errs, types.ValidationError{
+			PolicyName:    policy.Name,
+			PolicyVersion: policy.Version,
+			FilePath:      policy.WasmModulePath,
+			Message:       msg,
+		})
+	}
+
+	data, err := os.ReadFile(policy.WasmModulePath)
+	if err != nil {
+		addErr(fmt.Sprintf("failed to read WebAssembly module: %v", err))
+		return errs
+	}
+
+	info, err := parseWasmModule(data)
+	if err != nil {
+		addErr(fmt.Sprintf("invalid WebAssembly module: %v", err))
+		return errs
+	}
+
+	if kind, ok := info.exports[wasmMemory]; !ok || kind != wasmExternMemory {
+		addErr(fmt.Sprintf("WebAssembly module must export its linear memory as %q", wasmMemory))
+	}
+	if kind, ok := info.exports[wasmAllocFunc]; !ok || kind != wasmExternFunc {
+		addErr(fmt.Sprintf("WebAssembly module must export function %q", wasmAllocFunc))
+	}
+
+	hasPhase := false
+	for _, name := range wasmPhaseExports {
+		if kind, ok := info.exports[name]; ok && kind == wasmExternFunc {
+			hasPhase = true
+			break
+		}
+	}
+	if !hasPhase {
+		addErr(fmt.Sprintf("WebAssembly module must export at least one of %s",
+			strings.Join(wasmPhaseExports, ", ")))
+	}
+
+	for _, imp := range info.imports {
+		if imp.module != wasmHostModule && imp.module != wasmWASIModule {
+			addErr(fmt.Sprintf("WebAssembly module imports %s.%s; only %s and %s imports are available",
+				imp.module, imp.name, wasmWASIModule, wasmHostModule))
+		}
+	}
+
+	return errs
+}
+
+// parseWasmModule reads the import and export sections of a wasm binary. Other
+// sections are skipped; validating them is left to the runtime's compiler.
+func parseWasmModule(data []byte) (*wasmModuleInfo, error) {
+	if !bytes.HasPrefix(data, wasmMagic) {
+		return nil, fmt.Errorf("missing wasm magic number or unsupported binary version")
+	}
+
+	info := &wasmModuleInfo{exports: make(map[string]byte)}
+	r := &wasmReader{data: data, pos: len(wasmMagic)}
+	for !r.done() {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.u32()
+		if err != nil {
+			return nil, fmt.Errorf("section %d: %w", id, err)
+		}
+		body, err := r.bytes(int(size))
+		if err != nil {
+			return nil, fmt.Errorf("section %d: %w", id, err)
+		}
+
+		switch id {
+		case wasmSectionImport:
+			if info.imports, err = parseWasmImports(&wasmReader{data: body}); err != nil {
+				return nil, fmt.Errorf("import section: %w", err)
+			}
+		case wasmSectionExport:
+			if err := parseWasmExports(&wasmReader{data: body}, info.exports); err != nil {
+				return nil, fmt.Errorf("export section: %w", err)
+			}
+		}
+	}
+	return info, nil
+}
+
+func parseWasmImports(r *wasmReader) ([]wasmImport, error) {
+	count, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	imports := make([]wasmImport, 0, count)
+	for i := uint32(0); i < count; i++ {
+		module, err := r.name()
+		if err != nil {
+			return nil, err
+		}
+		name, err := r.name()
+		if err != nil {
+			return nil, err
+		}
+		if err := r.skipImportDesc(); err != nil {
+			return nil, fmt.Errorf("import %s.%s: %w", module, name, err)
+		}
+		imports = append(imports, wasmImport{module: module, name: name})
+	}
+	return imports, nil
+}
+
+func parseWasmExports(r *wasmReader, exports map[string]byte) error {
+	count, err := r.u32()
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < count; i++ {
+		name, err := r.name()
+		if err != nil {
+			return err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+		exports[name] = kind
+	}
+	return nil
+}
+
+// wasmReader decodes the primitive encodings of the wasm binary format
+type wasmReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *wasmReader) done() bool {
+	return r.pos >= len(r.data)
+}
+
+func (r *wasmReader) byte() (byte, error) {
+	if r.done() {
+		return 0, fmt.Errorf("unexpected end of module")
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *wasmReader) bytes(n int) ([]byte, error) {
+	if n < 0 || n > len(r.data)-r.pos {
+		return nil, fmt.Errorf("unexpected end of module")
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+// u32 reads an unsigned LEB128 value
+func (r *wasmReader) u32() (uint32, error) {
+	v, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 || n > 5 || v > 0xFFFFFFFF {
+		return 0, fmt.Errorf("malformed LEB128 value at offset %d", r.pos)
+	}
+	r.pos += n
+	return uint32(v), nil
+}
+
+func (r *wasmReader) name() (string, error) {
+	size, err := r.u32()
+	if err != nil {
+		return "", err
+	}
+	b, err := r.bytes(int(size))
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (r *wasmReader) skipLimits() error {
+	flags, err := r.byte()
+	if err != nil {
+		return err
+	}
+	if _, err := r.u32(); err != nil {
+		return err
+	}
+	if flags&0x01 != 0 {
+		if _, err := r.u32(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *wasmReader) skipImportDesc() error {
+	kind, err := r.byte()
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case wasmExternFunc:
+		_, err = r.u32()
+	case wasmExternTable:
+		if _, err = r.byte(); err == nil {
+			err = r.skipLimits()
+		}
+	case wasmExternMemory:
+		err = r.skipLimits()
+	case wasmExternGlobal:
+		_, err = r.bytes(2)
+	case wasmExternTag:
+		if _, err = r.byte(); err == nil {
+			_, err = r.u32()
+		}
+	default:
+		err = fmt.Errorf("unknown import kind 0x%02x", kind)
+	}
+	return err
+}
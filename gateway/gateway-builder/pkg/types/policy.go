@@ -38,9 +38,10 @@ type DiscoveredPolicy struct {
 	GoModuleVersion  string // Resolved version for gomodule entries (e.g., "v0.1.0")
 	IsFilePathEntry  bool   // True if from filePath manifest entry (needs replace directive)
 
-	// Runtime is auto-detected: "go" or "python"
+	// Runtime is auto-detected: "go", "python" or "wasm"
 	Runtime         string
 	PythonSourceDir string // Path to Python source directory (local filePath and extracted pip policies)
+	WasmModulePath  string // Path to the .wasm module (wasm policies)
 
 	// Pip package fields (set only for pipPackage policies)
 	IsPipPackage         bool   // True if from pipPackage manifest entry
@@ -33,6 +33,7 @@ import (
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/controlplane"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/immutable"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/logger"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/mcpaggregator"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/policyxds"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/service/restapi"
@@ -302,6 +303,7 @@ func main() {
 		configStore,
 		db,
 		&cfg.Router,
+		&cfg.Controller.MCP.Aggregator,
 		policyDefinitions,
 		log,
 		cfg.Controller.Server.SkipInvalidDeploymentsOnStartup,
@@ -332,6 +334,22 @@ func main() {
 			// Set the SDS secret manager in snapshot manager so secrets are included in snapshots
 			snapshotManager.SetSDSSecretManager(sdsSecretManager)
 		}
+
+		// Publish the custom CA bundle to the policy engine so outbound policy HTTP
+		// calls trust the same upstream certificates as the router. The ID and type
+		// must match the constants in the policy engine's httpclient package.
+		publishTrustedCertificates := func(customCerts []byte) {
+			resource := &storage.LazyResource{
+				ID:           "upstream_ca_bundle",
+				ResourceType: "TrustedCertificates",
+				Resource:     map[string]interface{}{"pem": string(customCerts)},
+			}
+			if err := lazyResourceXDSManager.StoreResource(resource, ""); err != nil {
+				log.Warn("Failed to publish trusted certificates to policy engine", slog.Any("error", err))
+			}
+		}
+		translator.GetCertStore().SetLoadListener(publishTrustedCertificates)
+		publishTrustedCertificates(translator.GetCertStore().GetCustomCertificates())
 	}
 
 	// Generate initial xDS snapshot
@@ -463,7 +481,8 @@ func main() {
 	validator.SetPolicyValidator(policyValidator)
 
 	apiSvc := utils.NewAPIDeploymentService(configStore, db, snapshotManager, validator, &cfg.Router, eventHubInstance, gatewayID, secretsService)
-	mcpSvc := utils.NewMCPDeploymentService(configStore, db, snapshotManager, policyManager, policyValidator, eventHubInstance, gatewayID, secretsService)
+	mcpSvc := utils.NewMCPDeploymentService(configStore, db, snapshotManager, policyManager, policyValidator, eventHubInstance, gatewayID, secretsService).
+		WithMCPAggregator(&cfg.Controller.MCP.Aggregator)
 	llmSvc := utils.NewLLMDeploymentService(configStore, db, snapshotManager, lazyResourceXDSManager, templateDefinitions,
 		apiSvc, &cfg.Router, policyVersionResolver, policyValidator)
 
@@ -496,7 +515,7 @@ func main() {
 		&http.Client{Timeout: 10 * time.Second}, config.NewParser(), validator, log,
 		eventHubInstance, secretsService,
 	)
-	igw := immutable.NewImmutableGW(cfg.ImmutableGateway, restAPIService, llmSvc, mcpSvc)
+	igw := immutable.NewImmutableGW(cfg.ImmutableGateway, db, restAPIService, llmSvc, mcpSvc)
 
 	// Initialize Gin router
 	if os.Getenv("GIN_MODE") == "" {
@@ -573,6 +592,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Track the artifact directory or git repository for new revisions
+	// (returns immediately unless a sync mode other than static is configured).
+	artifactSyncCtx, artifactSyncCancel := context.WithCancel(context.Background())
+	defer artifactSyncCancel()
+	go igw.Run(artifactSyncCtx, log)
+
 	// Ensure initial lazy resource snapshot includes default templates loaded from files.
 	// At this point, the API server initialization has already persisted/published OOB templates.
 	if lazyResourceStore.Count() > 0 {
@@ -610,7 +635,7 @@ func main() {
 	// Start controller admin server for debug endpoints if enabled.
 	var controllerAdminServer *adminserver.Server
 	if cfg.Controller.AdminServer.Enabled {
-		controllerAdminServer = adminserver.NewServer(&cfg.Controller.AdminServer, apiServer, log)
+		controllerAdminServer = adminserver.NewServer(&cfg.Controller.AdminServer, apiServer, igw, log)
 		go func() {
 			if err := controllerAdminServer.Start(); err != nil {
 				log.Error("Controller admin server failed", slog.Any("error", err))
@@ -640,6 +665,16 @@ func main() {
 		metrics.StartMemoryMetricsUpdater(metricsCtx, 15*time.Second)
 	}
 
+	// Start the MCP aggregator that serves VirtualMcp proxies if enabled
+	var mcpAggregatorServer *mcpaggregator.Server
+	if cfg.Controller.MCP.Aggregator.Enabled {
+		mcpAggregatorServer = mcpaggregator.NewServer(&cfg.Controller.MCP.Aggregator, db, secretsService, cpClient, log)
+		if err := mcpAggregatorServer.Start(); err != nil {
+			log.Error("MCP aggregator server failed", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}
+
 	// Start REST API server
 	log.Info("Starting REST API server", slog.Int("port", cfg.Controller.Server.APIPort))
 
@@ -685,6 +720,9 @@ func main() {
 
 	log.Info("Shutting down Gateway-Controller")
 
+	// Stop tracking immutable gateway artifacts before the services it deploys through shut down
+	artifactSyncCancel()
+
 	// Graceful shutdown with timeout
 	ctx, cancel = context.WithTimeout(context.Background(), cfg.Controller.Server.ShutdownTimeout)
 	defer cancel()
@@ -727,6 +765,12 @@ func main() {
 		}
 	}
 
+	if mcpAggregatorServer != nil {
+		if err := mcpAggregatorServer.Stop(ctx); err != nil {
+			log.Error("Failed to stop MCP aggregator server", slog.Any("error", err))
+		}
+	}
+
 	if controllerAdminServer != nil {
 		if err := controllerAdminServer.Stop(ctx); err != nil {
 			log.Error("Failed to stop controller admin server", slog.Any("error", err))
@@ -17,6 +17,7 @@ func hydrateStoredConfigsFromDatabaseOnStartup(
 	configStore *storage.ConfigStore,
 	db storage.Storage,
 	routerConfig *config.RouterConfig,
+	mcpAggregator *config.MCPAggregatorConfig,
 	policyDefinitions map[string]models.PolicyDefinition,
 	log *slog.Logger,
 	skipInvalidDeployments bool,
@@ -30,7 +31,9 @@ func hydrateStoredConfigsFromDatabaseOnStartup(
 		"stored MCP proxy configuration",
 		log,
 		skipInvalidDeployments,
-		utils.HydrateStoredMCPConfig,
+		func(cfg *models.StoredConfig) error {
+			return utils.HydrateStoredMCPConfig(cfg, mcpAggregator)
+		},
 	); err != nil {
 		return err
 	}
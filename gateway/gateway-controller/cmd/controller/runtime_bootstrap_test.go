@@ -206,6 +206,7 @@ func TestHydrateStoredConfigsFromDatabaseOnStartup_FailsFastByDefault(t *testing
 		nil,
 		nil,
 		nil,
+		nil,
 		newDiscardLogger(),
 		false,
 	)
@@ -230,6 +231,7 @@ func TestHydrateStoredConfigsFromDatabaseOnStartup_SkipsInvalidConfigsWhenEnable
 		nil,
 		nil,
 		nil,
+		nil,
 		newDiscardLogger(),
 		true,
 	)
@@ -22,20 +22,28 @@ type apiServer interface {
 	GetXDSSyncStatusResponse() adminapi.XDSSyncStatusResponse
 }
 
+// artifactSyncStatusProvider reports the artifact revision applied by an immutable gateway.
+type artifactSyncStatusProvider interface {
+	ArtifactSyncStatus() adminapi.ArtifactSyncStatusResponse
+}
+
 // Server is the controller admin HTTP server for debug endpoints.
 type Server struct {
-	cfg       *config.AdminServerConfig
-	apiServer apiServer
-	httpSrv   *http.Server
-	logger    *slog.Logger
+	cfg          *config.AdminServerConfig
+	apiServer    apiServer
+	artifactSync artifactSyncStatusProvider
+	httpSrv      *http.Server
+	logger       *slog.Logger
 }
 
-// NewServer creates a new admin HTTP server.
-func NewServer(cfg *config.AdminServerConfig, apiServer apiServer, logger *slog.Logger) *Server {
+// NewServer creates a new admin HTTP server. artifactSync may be nil, in which case the artifact
+// sync status reports immutable mode as disabled.
+func NewServer(cfg *config.AdminServerConfig, apiServer apiServer, artifactSync artifactSyncStatusProvider, logger *slog.Logger) *Server {
 	s := &Server{
-		cfg:       cfg,
-		apiServer: apiServer,
-		logger:    logger,
+		cfg:          cfg,
+		apiServer:    apiServer,
+		artifactSync: artifactSync,
+		logger:       logger,
 	}
 
 	// Share a single mux so both registrations populate the same router.
@@ -112,6 +120,18 @@ func (s *Server) GetXDSSyncStatus(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
+// GetArtifactSyncStatus implements adminapi.ServerInterface.
+func (s *Server) GetArtifactSyncStatus(w http.ResponseWriter, r *http.Request) {
+	resp := adminapi.ArtifactSyncStatusResponse{Status: "disabled"}
+	if s.artifactSync != nil {
+		resp = s.artifactSync.ArtifactSyncStatus()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
 // GetHealth implements adminapi.ServerInterface.
 func (s *Server) GetHealth(w http.ResponseWriter, r *http.Request) {
 	resp := map[string]string{
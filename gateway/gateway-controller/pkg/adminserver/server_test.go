@@ -35,7 +35,7 @@ func TestAdminServer_ConfigDumpHandler(t *testing.T) {
 	stub := &stubAPIServer{
 		configDump: adminapi.ConfigDumpResponse{Status: &status},
 	}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/config_dump", nil)
 	req.RemoteAddr = "127.0.0.1:12345"
@@ -61,7 +61,7 @@ func TestAdminServer_XDSSyncStatusHandler(t *testing.T) {
 			Timestamp:          &now,
 		},
 	}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/xds_sync_status", nil)
 	req.RemoteAddr = "127.0.0.1:12345"
@@ -76,9 +76,55 @@ func TestAdminServer_XDSSyncStatusHandler(t *testing.T) {
 	assert.Equal(t, "12", *body.PolicyChainVersion)
 }
 
+type stubArtifactSync struct {
+	status adminapi.ArtifactSyncStatusResponse
+}
+
+func (s *stubArtifactSync) ArtifactSyncStatus() adminapi.ArtifactSyncStatusResponse {
+	return s.status
+}
+
+func TestAdminServer_ArtifactSyncStatusHandler(t *testing.T) {
+	revision := "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+	sync := &stubArtifactSync{
+		status: adminapi.ArtifactSyncStatusResponse{Enabled: true, Status: "synced", Revision: &revision},
+	}
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, &stubAPIServer{}, sync, slog.Default())
+
+	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/artifact_sync_status", nil)
+	req.RemoteAddr = "127.0.0.1:12345"
+	rr := httptest.NewRecorder()
+
+	s.httpSrv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var body adminapi.ArtifactSyncStatusResponse
+	assert.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+	assert.True(t, body.Enabled)
+	assert.Equal(t, "synced", body.Status)
+	assert.NotNil(t, body.Revision)
+	assert.Equal(t, revision, *body.Revision)
+}
+
+func TestAdminServer_ArtifactSyncStatusHandler_NoProvider(t *testing.T) {
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, &stubAPIServer{}, nil, slog.Default())
+
+	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/artifact_sync_status", nil)
+	req.RemoteAddr = "127.0.0.1:12345"
+	rr := httptest.NewRecorder()
+
+	s.httpSrv.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+
+	var body adminapi.ArtifactSyncStatusResponse
+	assert.NoError(t, json.NewDecoder(rr.Body).Decode(&body))
+	assert.False(t, body.Enabled)
+	assert.Equal(t, "disabled", body.Status)
+}
+
 func TestAdminServer_IPAllowlist(t *testing.T) {
 	stub := &stubAPIServer{}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"127.0.0.1"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"127.0.0.1"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/xds_sync_status", nil)
 	req.RemoteAddr = "192.168.1.10:12345"
@@ -90,7 +136,7 @@ func TestAdminServer_IPAllowlist(t *testing.T) {
 
 func TestAdminServer_MethodNotAllowed(t *testing.T) {
 	stub := &stubAPIServer{}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodPost, AdminAPIBasePath+"/config_dump", nil)
 	req.RemoteAddr = "127.0.0.1:12345"
@@ -102,7 +148,7 @@ func TestAdminServer_MethodNotAllowed(t *testing.T) {
 
 func TestAdminServer_HealthHandler(t *testing.T) {
 	stub := &stubAPIServer{}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/health", nil)
 	req.RemoteAddr = "127.0.0.1:12345"
@@ -119,7 +165,7 @@ func TestAdminServer_HealthHandler(t *testing.T) {
 
 func TestAdminServer_HealthHandler_MethodNotAllowed(t *testing.T) {
 	stub := &stubAPIServer{}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodPost, AdminAPIBasePath+"/health", nil)
 	req.RemoteAddr = "127.0.0.1:12345"
@@ -132,7 +178,7 @@ func TestAdminServer_HealthHandler_MethodNotAllowed(t *testing.T) {
 func TestAdminServer_HealthHandler_NoIPWhitelist(t *testing.T) {
 	stub := &stubAPIServer{}
 	// Restrict IPs to only 127.0.0.1 — health should still be accessible from other IPs
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"127.0.0.1"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"127.0.0.1"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/health", nil)
 	req.RemoteAddr = "192.168.1.10:12345"
@@ -154,7 +200,7 @@ func TestIsIPAllowed(t *testing.T) {
 
 func TestAdminServer_LegacyHealthHandler_NoIPWhitelist(t *testing.T) {
 	stub := &stubAPIServer{}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"127.0.0.1"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"127.0.0.1"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodGet, "/health", nil)
 	req.RemoteAddr = "192.168.1.10:12345"
@@ -171,7 +217,7 @@ func TestAdminServer_LegacyConfigDump(t *testing.T) {
 	stub := &stubAPIServer{
 		configDump: adminapi.ConfigDumpResponse{Status: &status},
 	}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodGet, "/config_dump", nil)
 	req.RemoteAddr = "127.0.0.1:12345"
@@ -199,7 +245,7 @@ func TestAdminServer_LegacyXDSSyncStatus(t *testing.T) {
 			Timestamp:          &now,
 		},
 	}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodGet, "/xds_sync_status", nil)
 	req.RemoteAddr = "127.0.0.1:12345"
@@ -212,7 +258,7 @@ func TestAdminServer_LegacyXDSSyncStatus(t *testing.T) {
 
 func TestAdminServer_VersionedPathsHaveNoDeprecationHeader(t *testing.T) {
 	stub := &stubAPIServer{}
-	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, slog.Default())
+	s := NewServer(&config.AdminServerConfig{Port: 9092, AllowedIPs: []string{"*"}}, stub, nil, slog.Default())
 
 	req := httptest.NewRequest(http.MethodGet, AdminAPIBasePath+"/health", nil)
 	req.RemoteAddr = "127.0.0.1:12345"
@@ -28,6 +28,40 @@ const (
 	Undeployed ConfigDumpAPIMetadataStatus = "undeployed"
 )
 
+// ArtifactSyncStatusResponse defines model for ArtifactSyncStatusResponse.
+type ArtifactSyncStatusResponse struct {
+	// AppliedAt When the applied revision was applied
+	AppliedAt *time.Time `json:"appliedAt,omitempty" yaml:"appliedAt,omitempty"`
+
+	// ArtifactCount Number of artifacts in the applied revision
+	ArtifactCount *int `json:"artifactCount,omitempty" yaml:"artifactCount,omitempty"`
+
+	// Enabled Whether immutable gateway mode is enabled
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// LastAttemptAt When the latest sync attempt finished
+	LastAttemptAt *time.Time `json:"lastAttemptAt,omitempty" yaml:"lastAttemptAt,omitempty"`
+
+	// LastAttemptRevision Revision of the latest sync attempt
+	LastAttemptRevision *string `json:"lastAttemptRevision,omitempty" yaml:"lastAttemptRevision,omitempty"`
+
+	// LastError Error of the latest sync attempt, when it failed
+	LastError *string `json:"lastError,omitempty" yaml:"lastError,omitempty"`
+
+	// Mode Artifact sync mode (static, directory or git)
+	Mode *string `json:"mode,omitempty" yaml:"mode,omitempty"`
+
+	// Revision Applied revision (git commit SHA, or a content digest of the artifacts directory)
+	Revision *string `json:"revision,omitempty" yaml:"revision,omitempty"`
+
+	// Source Artifacts directory or git repository URL the gateway tracks
+	Source *string `json:"source,omitempty" yaml:"source,omitempty"`
+
+	// Status disabled when immutable mode is off, synced when the latest revision is applied,
+	// failed when the latest attempt failed and the last applied revision keeps serving
+	Status string `json:"status" yaml:"status"`
+}
+
 // CertificateResponse defines model for CertificateResponse.
 type CertificateResponse struct {
 	Count    *int       `json:"count,omitempty" yaml:"count,omitempty"`
@@ -109,6 +143,9 @@ type XDSSyncStatusResponse struct {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get immutable gateway artifact sync status
+	// (GET /artifact_sync_status)
+	GetArtifactSyncStatus(w http.ResponseWriter, r *http.Request)
 	// Dump current configuration state
 	// (GET /config_dump)
 	GetConfigDump(w http.ResponseWriter, r *http.Request)
@@ -129,6 +166,20 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetArtifactSyncStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetArtifactSyncStatus(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetArtifactSyncStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetConfigDump operation middleware
 func (siw *ServerInterfaceWrapper) GetConfigDump(w http.ResponseWriter, r *http.Request) {
 
@@ -291,6 +342,7 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("GET "+options.BaseURL+"/artifact_sync_status", wrapper.GetArtifactSyncStatus)
 	m.HandleFunc("GET "+options.BaseURL+"/config_dump", wrapper.GetConfigDump)
 	m.HandleFunc("GET "+options.BaseURL+"/health", wrapper.GetHealth)
 	m.HandleFunc("GET "+options.BaseURL+"/xds_sync_status", wrapper.GetXDSSyncStatus)
@@ -301,27 +353,34 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/8xYUW/bNhD+KwS3hxZwLaPFHqK3LBnSYCsWNAE2YA4CWjxbbCWSI09pjcD/fTiKkiyL",
-	"cZJiHfZUlTwe77777js6D7wwtTUaNHqeP3BflFCL8HkGDtVaFQLhI3hrtAdats5Y2oFgVJhGI33g1gLP",
-	"udIIG3B8N+NK7q17dEpvwrL3DbjklhY1pDcMnq6xPbU2rhbIcy4FwhtUNfDZ9IRHgY1POvPN6hMUmNjb",
-	"9Y5Ma7Kb8TOj12pz3tT29OryEqGmcxJ84ZRFZTTP+enVJVMINVOaFcGcyaa2zHWozSagkVHjROvggQsp",
-	"FX2L6mrPEF0DiYhaXHsYmkbJFAI1oJACBRn/6GDNc/5DNhQ7i5XORgl+6A49jcWHPf9jPLodtjaOBXBG",
-	"uEzhcCAQ5Ck+v7wSbGW2LzszUAJ0U/P8L25BS9oc/PEZXwtVhY9G96u3CXeNlS+L+jiij7eYsCr8SxTz",
-	"L6pmoOtwrXBObOn/xdDZL/CckIOEb2sqVagDvy/i96FHqpvyqAo/xQYNiuo0AjSVoLC9F/jP25jx06bH",
-	"zK72cjw0SVX5iBgRVTyK2j6fx1+lv/NbXTyfCn+eX1/TgSco2JlNGvrr+TWjG1mnKUzpomokyKdaO7Bh",
-	"e1eUQum7e3A+Ct7Y/2+EN7LWmAVjFo2ZbVaV8iVIttoyLIGuQ2eqCtzzWuwX54x7vLtq8F5s0mPn0brt",
-	"ZtzB341yIElHot2sd3abiOM9iArLxwMZLhuD055j7TZ7teRlWNgu+esUO0aEGnu66baYWQcoW0+sKKH4",
-	"TMr3jSIWeXMdIjz2VIgUTWL9HzPlGzpvmjotKb02caajaF8V7TOG/3H9+9sw/q4qgXQBuwFRt9Nr9HyQ",
-	"tdKZhFWzCeY0NS8EwhexZWd9CvOlXuqbEjww0NIapdEz4YB5cPcgmdEhZwkyyJdkgty2u45Z45C9krAW",
-	"TYU5O1mcvH09X2pKUmFF0U5vZCEwConPeF8RvpifzBeUhbGghVU85+/mi/k76n2BZSh11mrCXdCE/IFv",
-	"AKe1/AjoFNxDrFVtK0BgReMcaAx8h46oieBaBVJ6ky/1G3ZaVayb1gHE0RPLdyaVESRakT4S1kqrkYGD",
-	"jfIIDiTbH5K0fb319MaLfSi07NUw4EhMD5ddSkITcNBVTmrRdkVA5+1i0TEmdoOwtqKblNHZJ98Sv5Xx",
-	"54v8MJR3E4qd9ejuw9JK9m7Gf/oXAxrLbSKWS43gtKg6agIdCN3lm7oWbstzTvn0TBjHHHhBvBUbT9rb",
-	"loXfkoOsFbRjjGuc9vvaF8sZebaJPCv22+6mVL7vOaY80wZZ/BHB0LDLK/alVAiV8khvfnSqCKRi3jAs",
-	"BS71uSk+gwuk+bVZgdOA4LsQrDMr8KwQmjkQRckUztOUakfB96TTwZBKlO9iAhFBEmfSQRnfj+dLsmTd",
-	"a+ZumH9P1q5jxmgM0COlHwXOyKYYJkGyrimER6PsewKdnpmp1h26oMObMo2ph1dZRO7/2MgXgI+Fm+ID",
-	"nQ3OaH0y+00hSOXvoTK2Jkz2Rxz9YHMVz3mJaPMsq8i6NB5zGnaZsCoL5tn9Yn7Cd7ND722LZnvtecx7",
-	"JNSboSrpa277LA/vi+MkagApQ5Sifrrz7g8iHUC7290/AQAA//+yEzQ3rxEAAA==",
+	"H4sIAAAAAAAC/8xYUW/juBH+KwO2DznAawd76EP85ibFbdC7NtikuAJ1ENDk2OJFIlVylJxwyH8vSJGS",
+	"ZdGOU3SLe9qNOBxyvvlm5qN/Y8JUtdGoybHlb8yJAise/ruypLZc0H2rxT1xatxXdLXRDv1qbU2NlhQG",
+	"W17XpUK5Iv+HRCesqkkZzZbs5wI1UIEQbcDii3LKaHjlLn1kM7Y1tuLElkxywk+kKmQzRm2NbMkcWaV3",
+	"7G3GeLzUtWl05rC/NdUGLZgtJEMHKn/84F1pwh1a7x4135Qos1FQgRZUVTXkbWDHCV95C5WRCMpB2tq7",
+	"3RhTItfebckdrYiwqukkRCUndASu1QJ4Zw9bpZUrPgDR3mFfU6yTI9OKh+rIycd8/8VaY6cew+cT7mbw",
+	"6sNUBFuuRkAN7j2WU8+JiJ27gPeFI05KzEAqi4KMbcFY2Cn6LufWHoVhdcjJi50iEKaqFMH9l9XMu+Ug",
+	"jCbUBFLtfFgxyIFi/S2yxzvTWHEiLjeJAizWxqnw5R9ffwynJb6R5eLZZc8JRTo9RyoXqBkT0DM4Mdds",
+	"t7OAbTLZy2APjOprdbbWXQYnxj1lu2WuZVx1lDYPDp8RawcO7YvSu7WeBhQS9+9GWV+P/2JDfcU4H/sd",
+	"ZvMLCvIQXKPHVAlOeLxZidQ7pvWv5N73AVnlXIM2u6R5hfkFQ6stdbvOK9whf9OlpgtxuvaWQ8Hordrd",
+	"NFW9uru9Jawy3Lu7BUVY+e4ogjnIpqrBJtRmE9C8UWM5xULiUir/f17e7RmSbTBzIyVHMDSNytc/Epec",
+	"uDf+o8UtW7I/LIYBtYjTaTEK8Ke06X0sftrzP8YjrcDWWAjgjHCZwmGRUxp456VXYl2a9mN7Bkqgbipf",
+	"BjVq6RcHf34wpI7a6P7rY8ZdU8uP3fo0oqf0gAr/eoq5D2Uz0HU4llvLW/+3GCr7A54z7SDjuzalEurA",
+	"74f4fegxjCdHSrgpNmSIl6sI0LQFheW9i/+5JTzT9JTZ3V6Mhya5LJ9oRp4qjnhVn8/jX6V78hPmfCr8",
+	"8+beC8/3KJjMJgX968191AupspUWZSNRvlfagQ3tkyi40k8vaPPK4cdu5HXGEIwhGkPdbMqg2WDThgko",
+	"jCZryhLteSUWtNTx6qrQOb7Lj52jeTsYp9Fu1jvLjdMvyEsqjl/kmOLo9kG3DBdrVoQP7ZplBdKIUGNP",
+	"D2kpia7OE4gCxfOZkjiHcOTNe++anqJZrP/PTPkvKm8auv+k9NbEmU68UxWdjGE/3//9cxh/dyUnfwA8",
+	"IK+66TWSD7JSeiFx0+yCuZ+aP0SBet2HMF/rtX4o0CGglrVRmhxwi0H1oQTTqUeJMrQvCdy77VYt1MYS",
+	"XEjc8qakJVxdXn3+bt4JRUWlv+30RAgX81diM9ZnhF3Or+aXPgpTo+a1Ykv2/fxy/r2vfU5FSPUiyfnQ",
+	"qJ4Gau+Qcu8naqx2o3fAoG2T2N20wHXmzZiUsWlImAojt9c682yaw21EqX8j+N07Fc28iHejB8JIWCe/",
+	"OQUe5DsHja9owWgMut0BmWDadlj7agia71Z6xJGmPwsw31m6CgqAfb68TOyKlROOFsHN4hfXFUnX8t8b",
+	"CCd+hAhUHmflurEWNQ0ZCRDFVL4FHV1V3LZdJLnE5DbOGPGd803zvnWEFXv0rhbdDHkKM+QETazCF4y1",
+	"XdUlEoKI1/T+U/Iz5RMnltK75Vp/glVZQlJ3oehGktwlk9JwP+Riu5G4VVqNDCzulCO0KGFfVPnlLr7U",
+	"tz3P0vTMc2GYw9+SAxnBmct9j+4+LN2If5uxP/0PLzQez5m73GpCq3mZWhn6DQcE9PH0TBjfOfDiGO26",
+	"AXhWYypGYzjyLFFd7Lfph0K5vkf7t742BPHR6RvC7R28FoqwVOE3AUdWiUAqcAao4LTWN0Y8ow2k+Wuz",
+	"QauR0KUr1NZs0IHgGixyUYCiI+2lkw7fkk4HoiaTvh8mEIFKobQHafwy1iPZlCX1+5Ghkpgxkg1e1PbS",
+	"wRrZiEE5ZPOaQ3gkfb4l0HmNdaJt7+HtI42hj3r477CQ/SQ5ct0cH/ze4Mx/n2hFI7jv8i9YmroKo2xP",
+	"EvkHvi3ZkhVE9XKxKL11YRwtvTha8Fotgvni5XJ+xd5mh967El3slecp75FQn4as5I957KM8PC+Ok9gD",
+	"fGeIrahXgyz9gBZt2dvj238GACmWbBKTGAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1200,7 +1200,7 @@ func createTestMCPStoredConfig(t *testing.T, id, handle, displayName, version, c
 		UpdatedAt:    time.Now(),
 	}
 
-	require.NoError(t, utils.HydrateStoredMCPConfig(cfg))
+	require.NoError(t, utils.HydrateStoredMCPConfig(cfg, nil))
 	return cfg
 }
 
@@ -51,13 +51,20 @@ func convertAPIPolicy(p api.Policy, attachedTo policy.Level, resolvedVersion str
 		paramsMap["attachedTo"] = string(attachedTo)
 	}
 
-	return policyenginev1.PolicyInstance{
+	instance := policyenginev1.PolicyInstance{
 		Name:               p.Name,
 		Version:            resolvedVersion,
 		Enabled:            true, // Default to enabled
 		ExecutionCondition: p.ExecutionCondition,
 		Parameters:         paramsMap,
 	}
+	if p.Timeout != nil {
+		instance.Timeout = *p.Timeout
+	}
+	if p.OnError != nil {
+		instance.OnError = string(*p.OnError)
+	}
+	return instance
 }
 
 // CreateMCPProxy implements ServerInterface.CreateMCPProxy
@@ -112,6 +112,12 @@ const (
 	LLMProviderConfigDataUpstreamHostRewriteManual LLMProviderConfigDataUpstreamHostRewrite = "manual"
 )
 
+// Defines values for LLMProviderConfigDataUpstreamProtocol.
+const (
+	LLMProviderConfigDataUpstreamProtocolHttp1 LLMProviderConfigDataUpstreamProtocol = "http1"
+	LLMProviderConfigDataUpstreamProtocolHttp2 LLMProviderConfigDataUpstreamProtocol = "http2"
+)
+
 // Defines values for LLMProviderConfigurationApiVersion.
 const (
 	LLMProviderConfigurationApiVersionGatewayApiPlatformWso2Comv1alpha1 LLMProviderConfigurationApiVersion = "gateway.api-platform.wso2.com/v1alpha1"
@@ -200,6 +206,12 @@ const (
 	MCPProxyConfigDataUpstreamHostRewriteManual MCPProxyConfigDataUpstreamHostRewrite = "manual"
 )
 
+// Defines values for MCPProxyConfigDataUpstreamProtocol.
+const (
+	MCPProxyConfigDataUpstreamProtocolHttp1 MCPProxyConfigDataUpstreamProtocol = "http1"
+	MCPProxyConfigDataUpstreamProtocolHttp2 MCPProxyConfigDataUpstreamProtocol = "http2"
+)
+
 // Defines values for MCPProxyConfigurationApiVersion.
 const (
 	MCPProxyConfigurationApiVersionGatewayApiPlatformWso2Comv1alpha1 MCPProxyConfigurationApiVersion = "gateway.api-platform.wso2.com/v1alpha1"
@@ -207,7 +219,8 @@ const (
 
 // Defines values for MCPProxyConfigurationKind.
 const (
-	MCPProxyConfigurationKindMcp MCPProxyConfigurationKind = "Mcp"
+	MCPProxyConfigurationKindMcp        MCPProxyConfigurationKind = "Mcp"
+	MCPProxyConfigurationKindVirtualMcp MCPProxyConfigurationKind = "VirtualMcp"
 )
 
 // Defines values for MCPProxyConfigurationRequestApiVersion.
@@ -217,7 +230,30 @@ const (
 
 // Defines values for MCPProxyConfigurationRequestKind.
 const (
-	MCPProxyConfigurationRequestKindMcp MCPProxyConfigurationRequestKind = "Mcp"
+	MCPProxyConfigurationRequestKindMcp        MCPProxyConfigurationRequestKind = "Mcp"
+	MCPProxyConfigurationRequestKindVirtualMcp MCPProxyConfigurationRequestKind = "VirtualMcp"
+)
+
+// Defines values for MCPRestOperationMethod.
+const (
+	MCPRestOperationMethodDELETE MCPRestOperationMethod = "DELETE"
+	MCPRestOperationMethodGET    MCPRestOperationMethod = "GET"
+	MCPRestOperationMethodHEAD   MCPRestOperationMethod = "HEAD"
+	MCPRestOperationMethodPATCH  MCPRestOperationMethod = "PATCH"
+	MCPRestOperationMethodPOST   MCPRestOperationMethod = "POST"
+	MCPRestOperationMethodPUT    MCPRestOperationMethod = "PUT"
+)
+
+// Defines values for MCPRestParameterIn.
+const (
+	MCPRestParameterInHeader MCPRestParameterIn = "header"
+	MCPRestParameterInPath   MCPRestParameterIn = "path"
+	MCPRestParameterInQuery  MCPRestParameterIn = "query"
+)
+
+// Defines values for MCPUpstreamAuthType.
+const (
+	MCPUpstreamAuthTypeApiKey MCPUpstreamAuthType = "api-key"
 )
 
 // Defines values for OperationMethod.
@@ -231,6 +267,13 @@ const (
 	OperationMethodPUT     OperationMethod = "PUT"
 )
 
+// Defines values for PolicyOnError.
+const (
+	FailClosed PolicyOnError = "fail-closed"
+	FailOpen   PolicyOnError = "fail-open"
+	Skip       PolicyOnError = "skip"
+)
+
 // Defines values for ResourceStatusState.
 const (
 	ResourceStatusStateDeployed   ResourceStatusState = "deployed"
@@ -367,6 +410,12 @@ const (
 	Manual UpstreamHostRewrite = "manual"
 )
 
+// Defines values for UpstreamProtocol.
+const (
+	Http1 UpstreamProtocol = "http1"
+	Http2 UpstreamProtocol = "http2"
+)
+
 // Defines values for UpstreamAuthAuthType.
 const (
 	UpstreamAuthAuthTypeApiKey UpstreamAuthAuthType = "api-key"
@@ -392,6 +441,18 @@ const (
 	WebSubAPIRequestKindWebSubApi WebSubAPIRequestKind = "WebSubApi"
 )
 
+// Defines values for WebSubChannelMessageOnInvalidMessage.
+const (
+	WebSubChannelMessageOnInvalidMessageDeadLetter WebSubChannelMessageOnInvalidMessage = "dead-letter"
+	WebSubChannelMessageOnInvalidMessageReject     WebSubChannelMessageOnInvalidMessage = "reject"
+)
+
+// Defines values for WebSubCloudEventsMode.
+const (
+	WebSubCloudEventsModeBinary     WebSubCloudEventsMode = "binary"
+	WebSubCloudEventsModeStructured WebSubCloudEventsMode = "structured"
+)
+
 // Defines values for WebhookAPIDataDeploymentState.
 const (
 	WebhookAPIDataDeploymentStateDeployed   WebhookAPIDataDeploymentState = "deployed"
@@ -748,6 +809,9 @@ type LLMProviderConfigDataUpstreamAuthType string
 // LLMProviderConfigDataUpstreamHostRewrite Controls how the Host header is handled when routing to the upstream. `auto` delegates host rewriting to Envoy, which rewrites the Host header using the upstream cluster host. `manual` disables automatic rewriting and expects explicit configuration.
 type LLMProviderConfigDataUpstreamHostRewrite string
 
+// LLMProviderConfigDataUpstreamProtocol HTTP protocol spoken to the upstream. `http2` is required for gRPC backends; over an http URL it is sent as cleartext HTTP/2 (h2c) with prior knowledge.
+type LLMProviderConfigDataUpstreamProtocol string
+
 // LLMProviderConfigDataUpstream0 defines model for .
 type LLMProviderConfigDataUpstream0 = interface{}
 
@@ -765,6 +829,9 @@ type LLMProviderConfigData_Upstream struct {
 	// HostRewrite Controls how the Host header is handled when routing to the upstream. `auto` delegates host rewriting to Envoy, which rewrites the Host header using the upstream cluster host. `manual` disables automatic rewriting and expects explicit configuration.
 	HostRewrite *LLMProviderConfigDataUpstreamHostRewrite `json:"hostRewrite,omitempty" yaml:"hostRewrite,omitempty"`
 
+	// Protocol HTTP protocol spoken to the upstream. `http2` is required for gRPC backends; over an http URL it is sent as cleartext HTTP/2 (h2c) with prior knowledge.
+	Protocol *LLMProviderConfigDataUpstreamProtocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+
 	// Ref Reference to a predefined upstreamDefinition
 	Ref *string `json:"ref,omitempty" yaml:"ref,omitempty"`
 
@@ -1006,8 +1073,11 @@ type MCPProxyConfigData struct {
 	SpecVersion *string    `json:"specVersion,omitempty" yaml:"specVersion,omitempty"`
 	Tools       *[]MCPTool `json:"tools,omitempty" yaml:"tools,omitempty"`
 
-	// Upstream The backend MCP server url and auth configurations
-	Upstream MCPProxyConfigData_Upstream `json:"upstream" yaml:"upstream"`
+	// Upstream The backend MCP server url and auth configurations. Required for the `Mcp` kind and omitted for the `VirtualMcp` kind, which uses `upstreams` instead.
+	Upstream MCPProxyConfigData_Upstream `json:"upstream,omitempty,omitzero" yaml:"upstream,omitempty"`
+
+	// Upstreams The upstream MCP servers aggregated by a `VirtualMcp` proxy. Tools and prompts are listed in upstream order; when two upstreams expose the same name, the first one wins.
+	Upstreams *[]MCPVirtualUpstream `json:"upstreams,omitempty" yaml:"upstreams,omitempty"`
 
 	// Version MCP Proxy version
 	Version string `json:"version" yaml:"version"`
@@ -1025,6 +1095,9 @@ type MCPProxyConfigDataUpstreamAuthType string
 // MCPProxyConfigDataUpstreamHostRewrite Controls how the Host header is handled when routing to the upstream. `auto` delegates host rewriting to Envoy, which rewrites the Host header using the upstream cluster host. `manual` disables automatic rewriting and expects explicit configuration.
 type MCPProxyConfigDataUpstreamHostRewrite string
 
+// MCPProxyConfigDataUpstreamProtocol HTTP protocol spoken to the upstream. `http2` is required for gRPC backends; over an http URL it is sent as cleartext HTTP/2 (h2c) with prior knowledge.
+type MCPProxyConfigDataUpstreamProtocol string
+
 // MCPProxyConfigDataUpstream0 defines model for .
 type MCPProxyConfigDataUpstream0 = interface{}
 
@@ -1042,6 +1115,9 @@ type MCPProxyConfigData_Upstream struct {
 	// HostRewrite Controls how the Host header is handled when routing to the upstream. `auto` delegates host rewriting to Envoy, which rewrites the Host header using the upstream cluster host. `manual` disables automatic rewriting and expects explicit configuration.
 	HostRewrite *MCPProxyConfigDataUpstreamHostRewrite `json:"hostRewrite,omitempty" yaml:"hostRewrite,omitempty"`
 
+	// Protocol HTTP protocol spoken to the upstream. `http2` is required for gRPC backends; over an http URL it is sent as cleartext HTTP/2 (h2c) with prior knowledge.
+	Protocol *MCPProxyConfigDataUpstreamProtocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+
 	// Ref Reference to a predefined upstreamDefinition
 	Ref *string `json:"ref,omitempty" yaml:"ref,omitempty"`
 
@@ -1055,7 +1131,7 @@ type MCPProxyConfiguration struct {
 	// ApiVersion MCP Proxy specification version
 	ApiVersion MCPProxyConfigurationApiVersion `json:"apiVersion" yaml:"apiVersion"`
 
-	// Kind MCP Proxy type
+	// Kind MCP Proxy type. `Mcp` fronts a single upstream MCP server. `VirtualMcp` exposes the tools, resources and prompts of several upstream MCP servers as one MCP server.
 	Kind     MCPProxyConfigurationKind `json:"kind" yaml:"kind"`
 	Metadata Metadata                  `json:"metadata" yaml:"metadata"`
 	Spec     MCPProxyConfigData        `json:"spec" yaml:"spec"`
@@ -1067,7 +1143,7 @@ type MCPProxyConfiguration struct {
 // MCPProxyConfigurationApiVersion MCP Proxy specification version
 type MCPProxyConfigurationApiVersion string
 
-// MCPProxyConfigurationKind MCP Proxy type
+// MCPProxyConfigurationKind MCP Proxy type. `Mcp` fronts a single upstream MCP server. `VirtualMcp` exposes the tools, resources and prompts of several upstream MCP servers as one MCP server.
 type MCPProxyConfigurationKind string
 
 // MCPProxyConfigurationRequest defines model for MCPProxyConfigurationRequest.
@@ -1075,7 +1151,7 @@ type MCPProxyConfigurationRequest struct {
 	// ApiVersion MCP Proxy specification version
 	ApiVersion MCPProxyConfigurationRequestApiVersion `json:"apiVersion" yaml:"apiVersion"`
 
-	// Kind MCP Proxy type
+	// Kind MCP Proxy type. `Mcp` fronts a single upstream MCP server. `VirtualMcp` exposes the tools, resources and prompts of several upstream MCP servers as one MCP server.
 	Kind     MCPProxyConfigurationRequestKind `json:"kind" yaml:"kind"`
 	Metadata Metadata                         `json:"metadata" yaml:"metadata"`
 	Spec     MCPProxyConfigData               `json:"spec" yaml:"spec"`
@@ -1084,7 +1160,7 @@ type MCPProxyConfigurationRequest struct {
 // MCPProxyConfigurationRequestApiVersion MCP Proxy specification version
 type MCPProxyConfigurationRequestApiVersion string
 
-// MCPProxyConfigurationRequestKind MCP Proxy type
+// MCPProxyConfigurationRequestKind MCP Proxy type. `Mcp` fronts a single upstream MCP server. `VirtualMcp` exposes the tools, resources and prompts of several upstream MCP servers as one MCP server.
 type MCPProxyConfigurationRequestKind string
 
 // MCPResource defines model for MCPResource.
@@ -1108,6 +1184,42 @@ type MCPResource struct {
 	Uri string `json:"uri" yaml:"uri"`
 }
 
+// MCPRestOperation A RestApi operation exposed as an MCP tool
+type MCPRestOperation struct {
+	// Description Description of the tool shown to agents
+	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// InputSchema JSON Schema of the tool arguments. Derived from the path and parameters when omitted.
+	InputSchema *string                `json:"inputSchema,omitempty" yaml:"inputSchema,omitempty"`
+	Method      MCPRestOperationMethod `json:"method" yaml:"method"`
+
+	// Name Name of the tool
+	Name string `json:"name" yaml:"name"`
+
+	// Parameters Tool arguments sent as path, query or header parameters. The `body` argument, when present, is sent as the JSON request body.
+	Parameters *[]MCPRestParameter `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+
+	// Path Path of the operation relative to the API context, with optional {param} placeholders
+	Path string `json:"path" yaml:"path"`
+
+	// Title Human-readable name of the tool
+	Title *string `json:"title,omitempty" yaml:"title,omitempty"`
+}
+
+// MCPRestOperationMethod defines model for MCPRestOperation.Method.
+type MCPRestOperationMethod string
+
+// MCPRestParameter defines model for MCPRestParameter.
+type MCPRestParameter struct {
+	In MCPRestParameterIn `json:"in" yaml:"in"`
+
+	// Name Name of the tool argument and of the REST parameter
+	Name string `json:"name" yaml:"name"`
+}
+
+// MCPRestParameterIn defines model for MCPRestParameter.In.
+type MCPRestParameterIn string
+
 // MCPTool defines model for MCPTool.
 type MCPTool struct {
 	// Description Human-readable description of functionality
@@ -1126,6 +1238,55 @@ type MCPTool struct {
 	Title *string `json:"title,omitempty" yaml:"title,omitempty"`
 }
 
+// MCPUpstreamAuth defines model for MCPUpstreamAuth.
+type MCPUpstreamAuth struct {
+	Header *string             `json:"header,omitempty" yaml:"header,omitempty"`
+	Type   MCPUpstreamAuthType `json:"type" yaml:"type"`
+	Value  *string             `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// MCPUpstreamAuthType defines model for MCPUpstreamAuth.Type.
+type MCPUpstreamAuthType string
+
+// MCPVirtualCapability defines model for MCPVirtualCapability.
+type MCPVirtualCapability struct {
+	// As Name to expose the tool or prompt under in the virtual MCP proxy
+	As *string `json:"as,omitempty" yaml:"as,omitempty"`
+
+	// Name Name of the tool or prompt on the upstream MCP server
+	Name string `json:"name" yaml:"name"`
+}
+
+// MCPVirtualUpstream An upstream of a virtual MCP proxy. Either `url` points at an MCP server, or `restApi` names a RestApi deployed on this gateway whose operations are exposed as MCP tools.
+type MCPVirtualUpstream struct {
+	// Auth Credentials sent to the upstream. For a `restApi` upstream the client's own `Authorization` header is forwarded when this is omitted. Setting it replaces the client's credentials with this one for every caller of the virtual MCP proxy, so the RestApi can no longer tell callers apart; only set it when that is intended.
+	Auth *MCPUpstreamAuth `json:"auth,omitempty" yaml:"auth,omitempty"`
+
+	// Name Name of the upstream, unique within the virtual MCP proxy
+	Name string `json:"name" yaml:"name"`
+
+	// Operations Operations of the RestApi to expose as tools, typically generated from its OpenAPI definition with `ap gateway mcp generate --from-openapi`, to override the tools of the RestApi. When omitted, every operation of the RestApi is exposed, with an input schema typed from the OpenAPI definition stored for the API in the control plane, or derived from its path parameters when it has none.
+	Operations *[]MCPRestOperation `json:"operations,omitempty" yaml:"operations,omitempty"`
+
+	// Prompts Prompts to expose from this upstream. All prompts are exposed when omitted.
+	Prompts *[]MCPVirtualCapability `json:"prompts,omitempty" yaml:"prompts,omitempty"`
+
+	// Resources URIs of the resources to expose from this upstream. All resources are exposed when omitted.
+	Resources *[]string `json:"resources,omitempty" yaml:"resources,omitempty"`
+
+	// RestApi Handle of a RestApi deployed on this gateway. Its operations are exposed as MCP tools and tool calls are sent as REST requests through the router, so the API's own policies apply.
+	RestApi *string `json:"restApi,omitempty" yaml:"restApi,omitempty"`
+
+	// ToolPrefix Prefix added to the names of the tools and prompts of this upstream, e.g. `github_`. Names renamed with `as` are exposed without the prefix.
+	ToolPrefix *string `json:"toolPrefix,omitempty" yaml:"toolPrefix,omitempty"`
+
+	// Tools Tools to expose from this upstream. All tools are exposed when omitted.
+	Tools *[]MCPVirtualCapability `json:"tools,omitempty" yaml:"tools,omitempty"`
+
+	// Url Base URL of the upstream MCP server. Requests are sent to its /mcp endpoint.
+	Url *string `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
 // Metadata defines model for Metadata.
 type Metadata struct {
 	// Annotations Annotations are arbitrary non-identifying metadata. Use domain-prefixed keys.
@@ -1143,11 +1304,17 @@ type Operation struct {
 	// Method HTTP method
 	Method OperationMethod `json:"method" yaml:"method"`
 
+	// Mirror Copies requests to a secondary upstream without affecting clients. Mirrored requests are sent fire-and-forget with the same path as the primary request and a Host header suffixed with -shadow; their responses are discarded. Specify exactly one of url or ref.
+	Mirror *TrafficMirror `json:"mirror,omitempty" yaml:"mirror,omitempty"`
+
 	// Path Route path with optional {param} placeholders
 	Path string `json:"path" yaml:"path"`
 
 	// Policies List of policies applied only to this operation (overrides or adds to API-level policies)
 	Policies *[]Policy `json:"policies,omitempty" yaml:"policies,omitempty"`
+
+	// TrafficSplit Splits an operation's production traffic between upstream definitions. Requests matching a rule go to that rule's upstream; the remaining requests are distributed across the weighted upstreams, or go to the API's main upstream when no weights are given. Each request is sent with the base path and timeout of the upstream definition that serves it. Sandbox traffic is not split.
+	TrafficSplit *TrafficSplit `json:"trafficSplit,omitempty" yaml:"trafficSplit,omitempty"`
 }
 
 // OperationMethod HTTP method
@@ -1161,13 +1328,22 @@ type Policy struct {
 	// Name Name of the policy
 	Name string `json:"name" yaml:"name"`
 
+	// OnError How the chain continues when the policy panics, exceeds its timeout or has its circuit breaker open. fail-closed (default) rejects the request, fail-open continues without the policy's changes, skip continues and reports the policy as skipped.
+	OnError *PolicyOnError `json:"onError,omitempty" yaml:"onError,omitempty"`
+
 	// Params Arbitrary parameters for the policy (free-form key/value structure)
 	Params *map[string]interface{} `json:"params,omitempty" yaml:"params,omitempty"`
 
+	// Timeout Maximum time a single invocation of the policy may take, as a Go duration (e.g. 250ms, 2s). No per-policy limit when omitted.
+	Timeout *string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
 	// Version Version of the policy. Only major-only version is allowed (e.g., v0, v1). Full semantic version (e.g., v1.0.0) is not accepted and will be rejected. The Gateway Controller resolves the major version to the single matching full version installed in the gateway image.
 	Version string `json:"version" yaml:"version"`
 }
 
+// PolicyOnError How the chain continues when the policy panics, exceeds its timeout or has its circuit breaker open. fail-closed (default) rejects the request, fail-open continues without the policy's changes, skip continues and reports the policy as skipped.
+type PolicyOnError string
+
 // ResourceStatus Server-managed lifecycle information for a resource
 type ResourceStatus struct {
 	// CreatedAt Timestamp when the resource was first created (UTC)
@@ -1475,11 +1651,74 @@ type SubscriptionUpdateRequest struct {
 // SubscriptionUpdateRequestStatus defines model for SubscriptionUpdateRequest.Status.
 type SubscriptionUpdateRequestStatus string
 
+// TrafficMirror Copies requests to a secondary upstream without affecting clients. Mirrored requests are sent fire-and-forget with the same path as the primary request and a Host header suffixed with -shadow; their responses are discarded. Specify exactly one of url or ref.
+type TrafficMirror struct {
+	// Headers Only requests carrying all of these headers are mirrored
+	Headers *[]TrafficMirrorHeader `json:"headers,omitempty" yaml:"headers,omitempty"`
+
+	// Percentage Percentage of matching requests to mirror (default 100)
+	Percentage *float32 `json:"percentage,omitempty" yaml:"percentage,omitempty"`
+
+	// Ref Reference to a predefined upstreamDefinition to mirror to
+	Ref *string `json:"ref,omitempty" yaml:"ref,omitempty"`
+
+	// Url Backend URL of the mirror upstream (scheme, host and port only)
+	Url *string `json:"url,omitempty" yaml:"url,omitempty"`
+}
+
+// TrafficMirrorHeader defines model for TrafficMirrorHeader.
+type TrafficMirrorHeader struct {
+	// Name Request header name
+	Name string `json:"name" yaml:"name"`
+
+	// Value Exact header value to match. Any value matches when omitted.
+	Value *string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// TrafficSplit Splits an operation's production traffic between upstream definitions. Requests matching a rule go to that rule's upstream; the remaining requests are distributed across the weighted upstreams, or go to the API's main upstream when no weights are given. Each request is sent with the base path and timeout of the upstream definition that serves it. Sandbox traffic is not split.
+type TrafficSplit struct {
+	// Rules Header and cookie rules. Rules with more conditions, then more exact header values, take precedence; remaining ties are evaluated in order.
+	Rules *[]TrafficSplitRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+
+	// Weights Relative weights of the upstream definitions that share requests not matched by a rule
+	Weights *[]TrafficSplitWeight `json:"weights,omitempty" yaml:"weights,omitempty"`
+}
+
+// TrafficSplitMatch defines model for TrafficSplitMatch.
+type TrafficSplitMatch struct {
+	// Name Request header or cookie name
+	Name string `json:"name" yaml:"name"`
+
+	// Value Exact value to match. Any value matches when omitted.
+	Value *string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// TrafficSplitRule Routes requests carrying all of the given headers and cookies to an upstream definition
+type TrafficSplitRule struct {
+	Cookies *[]TrafficSplitMatch `json:"cookies,omitempty" yaml:"cookies,omitempty"`
+	Headers *[]TrafficSplitMatch `json:"headers,omitempty" yaml:"headers,omitempty"`
+
+	// Upstream Name of the upstreamDefinition that serves matching requests
+	Upstream string `json:"upstream" yaml:"upstream"`
+}
+
+// TrafficSplitWeight defines model for TrafficSplitWeight.
+type TrafficSplitWeight struct {
+	// Upstream Name of the upstreamDefinition
+	Upstream string `json:"upstream" yaml:"upstream"`
+
+	// Weight Relative weight of this upstream definition
+	Weight int `json:"weight" yaml:"weight"`
+}
+
 // Upstream Upstream backend configuration (single target or reference)
 type Upstream struct {
 	// HostRewrite Controls how the Host header is handled when routing to the upstream. `auto` delegates host rewriting to Envoy, which rewrites the Host header using the upstream cluster host. `manual` disables automatic rewriting and expects explicit configuration.
 	HostRewrite *UpstreamHostRewrite `json:"hostRewrite,omitempty" yaml:"hostRewrite,omitempty"`
 
+	// Protocol HTTP protocol spoken to the upstream. `http2` is required for gRPC backends; over an http URL it is sent as cleartext HTTP/2 (h2c) with prior knowledge.
+	Protocol *UpstreamProtocol `json:"protocol,omitempty" yaml:"protocol,omitempty"`
+
 	// Ref Reference to a predefined upstreamDefinition
 	Ref *string `json:"ref,omitempty" yaml:"ref,omitempty"`
 
@@ -1491,6 +1730,9 @@ type Upstream struct {
 // UpstreamHostRewrite Controls how the Host header is handled when routing to the upstream. `auto` delegates host rewriting to Envoy, which rewrites the Host header using the upstream cluster host. `manual` disables automatic rewriting and expects explicit configuration.
 type UpstreamHostRewrite string
 
+// UpstreamProtocol HTTP protocol spoken to the upstream. `http2` is required for gRPC backends; over an http URL it is sent as cleartext HTTP/2 (h2c) with prior knowledge.
+type UpstreamProtocol string
+
 // Upstream0 defines model for .
 type Upstream0 = interface{}
 
@@ -1514,6 +1756,9 @@ type UpstreamDefinition struct {
 	// BasePath Base path prefix for all endpoints in this upstream (e.g., /api/v2). All requests to this upstream will have this path prepended.
 	BasePath *string `json:"basePath,omitempty" yaml:"basePath,omitempty"`
 
+	// Mirror Copies requests to a secondary upstream without affecting clients. Mirrored requests are sent fire-and-forget with the same path as the primary request and a Host header suffixed with -shadow; their responses are discarded. Specify exactly one of url or ref.
+	Mirror *TrafficMirror `json:"mirror,omitempty" yaml:"mirror,omitempty"`
+
 	// Name Unique identifier for this upstream definition
 	Name string `json:"name" yaml:"name"`
 
@@ -1597,6 +1842,24 @@ type WebSubAllChannelPolicies struct {
 	OnUnsubscription *[]Policy `json:"on_unsubscription,omitempty" yaml:"on_unsubscription,omitempty"`
 }
 
+// WebSubChannelMessage Message definition for a channel, following the AsyncAPI message object.
+type WebSubChannelMessage struct {
+	// ContentType Content type of the message payload
+	ContentType *string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+
+	// OnInvalidMessage Action taken when a published message does not match the payload schema - 'reject' (default) returns an error to the publisher, 'dead-letter' accepts the message and routes it to the channel's dead-letter topic.
+	OnInvalidMessage *WebSubChannelMessageOnInvalidMessage `json:"onInvalidMessage,omitempty" yaml:"onInvalidMessage,omitempty"`
+
+	// Payload Schema of the message payload, either a JSON Schema or an Apache Avro schema depending on schemaFormat
+	Payload map[string]interface{} `json:"payload" yaml:"payload"`
+
+	// SchemaFormat AsyncAPI schema format of the payload. JSON Schema is assumed when omitted. Apache Avro schemas use application/vnd.apache.avro+json.
+	SchemaFormat *string `json:"schemaFormat,omitempty" yaml:"schemaFormat,omitempty"`
+}
+
+// WebSubChannelMessageOnInvalidMessage Action taken when a published message does not match the payload schema - 'reject' (default) returns an error to the publisher, 'dead-letter' accepts the message and routes it to the channel's dead-letter topic.
+type WebSubChannelMessageOnInvalidMessage string
+
 // WebSubChannelPolicies Policies applied to a specific channel, organized by event type.
 type WebSubChannelPolicies struct {
 	// OnMessageDelivery Policies applied when delivering a message for this channel
@@ -1612,14 +1875,29 @@ type WebSubChannelPolicies struct {
 	OnUnsubscription *[]Policy `json:"on_unsubscription,omitempty" yaml:"on_unsubscription,omitempty"`
 }
 
+// WebSubCloudEvents CloudEvents 1.0 settings. Published events are always accepted in structured and binary content modes; this setting controls how events are emitted to subscribers.
+type WebSubCloudEvents struct {
+	// Mode Content mode used when delivering events to subscribers. When set, messages published without CloudEvents attributes are assigned an id, source, type and time. Events are delivered in binary mode when omitted.
+	Mode *WebSubCloudEventsMode `json:"mode,omitempty" yaml:"mode,omitempty"`
+}
+
+// WebSubCloudEventsMode Content mode used when delivering events to subscribers. When set, messages published without CloudEvents attributes are assigned an id, source, type and time. Events are delivered in binary mode when omitted.
+type WebSubCloudEventsMode string
+
 // WebhookAPIData defines model for WebhookAPIData.
 type WebhookAPIData struct {
 	// AllChannelPolicies Policies applied to all channels, organized by event type.
 	AllChannelPolicies *WebSubAllChannelPolicies `json:"allChannelPolicies,omitempty" yaml:"allChannelPolicies,omitempty"`
 
+	// ChannelMessages Per-channel message definitions keyed by channel name. Each key must also be present in channelPolicies. Messages published to a channel with a definition are validated against its payload schema.
+	ChannelMessages *map[string]WebSubChannelMessage `json:"channelMessages,omitempty" yaml:"channelMessages,omitempty"`
+
 	// ChannelPolicies Per-channel policy configuration keyed by channel name. Each key is a channel name and defines policies applied only to that channel.
 	ChannelPolicies *map[string]WebSubChannelPolicies `json:"channelPolicies,omitempty" yaml:"channelPolicies,omitempty"`
 
+	// CloudEvents CloudEvents 1.0 settings. Published events are always accepted in structured and binary content modes; this setting controls how events are emitted to subscribers.
+	CloudEvents *WebSubCloudEvents `json:"cloudEvents,omitempty" yaml:"cloudEvents,omitempty"`
+
 	// Context Base path for all API routes (must start with /, no trailing slash)
 	Context string `json:"context" yaml:"context"`
 
@@ -1930,6 +2208,13 @@ func (t LLMProviderConfigData_Upstream) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.Protocol != nil {
+		object["protocol"], err = json.Marshal(t.Protocol)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'protocol': %w", err)
+		}
+	}
+
 	if t.Ref != nil {
 		object["ref"], err = json.Marshal(t.Ref)
 		if err != nil {
@@ -1972,6 +2257,13 @@ func (t *LLMProviderConfigData_Upstream) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["protocol"]; found {
+		err = json.Unmarshal(raw, &t.Protocol)
+		if err != nil {
+			return fmt.Errorf("error reading 'protocol': %w", err)
+		}
+	}
+
 	if raw, found := object["ref"]; found {
 		err = json.Unmarshal(raw, &t.Ref)
 		if err != nil {
@@ -2068,6 +2360,13 @@ func (t MCPProxyConfigData_Upstream) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.Protocol != nil {
+		object["protocol"], err = json.Marshal(t.Protocol)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'protocol': %w", err)
+		}
+	}
+
 	if t.Ref != nil {
 		object["ref"], err = json.Marshal(t.Ref)
 		if err != nil {
@@ -2110,6 +2409,13 @@ func (t *MCPProxyConfigData_Upstream) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["protocol"]; found {
+		err = json.Unmarshal(raw, &t.Protocol)
+		if err != nil {
+			return fmt.Errorf("error reading 'protocol': %w", err)
+		}
+	}
+
 	if raw, found := object["ref"]; found {
 		err = json.Unmarshal(raw, &t.Ref)
 		if err != nil {
@@ -2199,6 +2505,13 @@ func (t Upstream) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.Protocol != nil {
+		object["protocol"], err = json.Marshal(t.Protocol)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'protocol': %w", err)
+		}
+	}
+
 	if t.Ref != nil {
 		object["ref"], err = json.Marshal(t.Ref)
 		if err != nil {
@@ -2234,6 +2547,13 @@ func (t *Upstream) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["protocol"]; found {
+		err = json.Unmarshal(raw, &t.Protocol)
+		if err != nil {
+			return fmt.Errorf("error reading 'protocol': %w", err)
+		}
+	}
+
 	if raw, found := object["ref"]; found {
 		err = json.Unmarshal(raw, &t.Ref)
 		if err != nil {
@@ -4486,243 +4806,289 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3fbNrboX8HhnbWO3Yqy/EprzzprlmO7qSZxovEjPXci3xYiIYsNRbIAaFvN+L/f",
-	"hRcJkiBFyZQsueqHJhHx2AD2Gxt7f7OccByFAQoosY6/WcQZoTHkfz3pdU/DYOjdnUEK2Q8RDiOEqYf4",
-	"ZycMKHqk7K8uIg72IuqFgXVsvYUEgQjSERiGGEDfBye9LsBhTBEBW+OYUEAoxBQ8eHQEdlogCAHF0PO9",
-	"4A4QH5LRdhvcEAT+do8w8cIA0BCg8QC5gI4QUD96Af8nn2gLte/aLbCDEXS94M72PUJ3ku4YkdC/R4SN",
-	"k21yv9vubLetloUe4TjykXVsmcewWtYYPn5AwR0dWcd7nU7LGnuB+vduy4ogpQiz5f+/fn/nC7T/PLH/",
-	"3bGPfu337X5/5/a7L+z3279ZLYtOIjYRodgL7qynluWiyA8nYxTQKwopEjs6hLFPrWP5EblWK7fNZ4h4",
-	"GLkg7c22lSJgg/9Wnf4bbMmRtkGIwX/HQfKlDX4ZoQAQRNm26F9afF/ZmXkEYDQO75ELhjgcizPE7LCG",
-	"Q88Bg5gCh2NIjCGDqsV7fUUT0gIwcEEU+p7jIQIgRiDCiCDMxwoxiEKKAupBH2CUroAfRRCPreMv+sJT",
-	"4Kxb/ay0JsVN9Ujkw8lHOEZFFP05HsPAZicNB75YawDHSGLnAIGbyw/2EHsocP0JsEEY+BPgI3bEpAWC",
-	"eDzgfyERdBBpgdEkGqGAtAADFBMnxEjugBtSwkggfEDudgbPLgWagQ8eoQyALIbtVmJYil79vv1rv98G",
-	"t98bMYvRKz8ZUtwDPnE4BD9fX/dA2nBHEKrVsjyKxrzf3zAaWsfW/9lJWcWO5BM7n1RHNt3YC7qi024C",
-	"DMQYTthHhQzlkJz0uraP7pGvIU4U+R4j/JAzkhRMEAc+IgSE9whjz3VRUBfiHhubQ5SHkMSDBKyeD6s2",
-	"TW8KIh8GHH8IgPfQ8zlOMSSnI4/Is00O/ov1LvQZxl55/j3CDKETsAvnl4cwjgjFCI6LgKV7p9pkSdNq",
-	"5dj3GHrBtK26UdOxzYGBOwgf63d5alkY/REzHsVWzee7TZYUDn5HDtXXdIaGXuBNQVaMYsK3N1mlm3YT",
-	"AiXkfaAPqDdGYZ5F1UbsmwJYpgNR4qEA8BUaw4B6TiKuwqFiqxk2wCSQlSHu+37f/b7fb7M/jER9PwoJ",
-	"NezRaUxoOAb3HqYx9AFvteOGbOOJREc1vxkVpg4nRxMMHIdu7HD8l/Igsy4YeW35r7YTjq1S/tXu9+0S",
-	"7qWh3EygyX5GuOQ3+/nw1cPvXCtdKqXY00qUKY3EM9zbRDgnve57NCnuzhmi0PMJwzgYKImsb8I3djpd",
-	"1zq2dF2HbYkt0RFGHh+a/SX6dXdv/+DwzQ8/HnXgwHHRcNZ/s/VhBClyT5hGs9fZe2N3DuzO7vVu53i/",
-	"c9zp/Dtt8pZP6449ti0ZIW5dTEAvxbr3clGRhxFhAwex77esQLQdT+wUQ22xASSMscM++qEDffYDhTQm",
-	"bD6HeveIS6kMZch9yu/wTeD9ESMQxQPfc4DnMk1m6CGsETmgI0j5P76iCVOkICGh47EVcjaVQcqyYyhQ",
-	"hDqXPEDvUMBQBbnquAUr5KfHFK+h95inzkaOtQCgds55GK+9MSIUjiPwwBRPtU8cWEjAnVpCBtASXBmG",
-	"eAy5dgwpshmjrwDmrWHDuoUziwnC4GEUpoDoIGZ3T2Lns3ROrm9qXJlvxBaDgiHuvecitwXGMWWNs5qj",
-	"iQyqVccCoBrV5ME8Z5+g4OvJiW0x2gLekJlqKGmwnT+qH+zOLjuqDjunqqNiw7GFWccUx8gIIOPF0L9E",
-	"QxMBnsvPAKMhwihwEOie5XczA53jh7HLaGvMmIF99OMPbw5NRxgYz46ZAwQOkU7rhbODMQ3tFHu4xaRh",
-	"RAt4Y3meLYZtLoBEWK8RxHCMKMLZDTWxMO2c3+xnjnm/IME69tHt91t28tft78xSVnLFggbDf9dZGl8l",
-	"553MmFRHtK3ZbIqxqm9Zc019LYIg+XABBP57DgRtOsm2mYi9D79K1hFxWZuZOGlXLcIDIZUF00+g0pma",
-	"zlN0Kkp2sVxOn7KOXhhcoj9iRDjhaQK5VGqZRJJRBHxSam/kQy+wmTaRHNo99GPBbNTBCKkUMBC9MGj3",
-	"g+4QpGyH2y1Civg+M4c5unoBoQi67DgkljP7FYIAPYAwQO1+cC3Fneo2gmSEXDBAwxAjQGiI4R1qA9XM",
-	"gQFr5QUABhMgGEU/2Bp7gTeOx2D/DXBGEEOHWd3SJcQhYwuRsAd3yZL8Scq6+4FyRLT7QYaoHvl/9gMJ",
-	"97ikjXxI2cycK8iP4g8mMXX6evN8PtoG3SEYhHQEZMduwL0EyTDSUaLOIf2dwq+IMEnuIJexu3ZRSu7u",
-	"2Z0f55CSCSiVa3Cl/WRgsln8VA0NeqkaQkdHNYG+nv1OAqYXUHSHMLcTA69EqwDsk2E8ySUIcsLAJeI4",
-	"pW9jFMaY/enCCfvjAaGvvEEY0BHJOZlEk2rWwYFrpYs38YEmZBonMkYCHvJdplYm1i7DI06mvAeGDqON",
-	"KMZRSBDhDixJoHeQogeYEgsBHiUgfAgA22wOgZoXQ+erF9zlaaiuLPUIiRGuUL6I8OCGmDJznSnMkr0m",
-	"HIhTTEoQ3A8HI4+TNsjK2n7ABiNMrZIjKjYEHQdFFLl8sCCkGU6HMGL7GISqF0ZsBYov5tXmlGG46F70",
-	"MC19DMlX5J6U8OoL/tXgGuBskW291BuSA2z3g54EGgwmYtskILwfV6lTnhhhZEvma2KCXP3/7rvvvnuc",
-	"/PnDj0f19aCu0dRR55TdWgik61lXmtSRmLX9pWg8TzVENInCgKCcjE4l78Z8LjOfx4gQeIeEQ5Jjc0qk",
-	"JHYcRMgw9v0J19nG0Au84E5Qyb/ikELr+EgbVnao0oGqPHjSP6JDpZ3ndAALNGGGOE8jl6pVQtB/sIYJ",
-	"J2cmno71RyZhl2rEmutKbsc0WZTorWrZ5UrpB49QHdtN28z/Wstlmm54wbM+y3JaFg0p9E/DODAJfPZN",
-	"XsHISwPO4zIKRHFLy6n+EilttkQ5L6DfjFrfRlVbM1WtClfuQ6cgI3Le9CpmIw3VqaxmSfR/EzF807Ae",
-	"+v6noXX8pQ6h5y3ap9ssHJJL3z61rFO2PUPPgRRVsxwnbVif72ijJyM3xITeTqjpxlIwoQH7yN3svg80",
-	"yMHQ81GGIe3t7R4eGRn9LKyucoqaPM+0V4bQDiM8H02QEBWIwSDSAdo1Ldcr96ZrWuLWzU33bDvhX9ps",
-	"GV56eNhBPx50OjbaOxrYB7vugQ1/2H1jHxy8eXN4eHDQ6XQ6s9gl2t4A0QacfQRbDIyhhwnlgABvCAZx",
-	"4Oa9sqcf/+diAk5PWp/Yn5/wHQy8P0VUxOn/3FwZjYSUU+T8XgIrAfdzCNEgjDzVIzOxBnUc+SFkNgKz",
-	"Bq/OrkDMCXw6vzGr+0xxVIp+2SGMJ7bDr+NsBxpHDunJkE7bbqSJL/bvmpsupOmuvfcGdN4cd3443ntT",
-	"W5hq7EBJn4QZIIxDnJUtFZyCxIK8KlcoGy0So6bQ+w1HDo3Zl7Le4kp65xc2CpyQ4db/tg87Rzo+bJHt",
-	"NjiFAXDCgEIvAOPYp17kZ5CGZF1WNvvv7fm77kdwen553f2pe3pyfc5/7QcX3e7Z/16fnp58/eXu5KH7",
-	"9uSu+8+T9x86N+++H1++p79fnHTenV798e6qO9g/+9f529OHm5OL85vH0z9P/vn27uPnftBut/sBH+38",
-	"45lhhhlc/4I7Za5rtGW1wYUMGYpFQ+jgkJC8SMitPkc0cwT+tH+tdSudpVq+QpM2cM7wvVwecHIgZTfN",
-	"yGVqoucK8pVta0ZZfE46chBMYruUS/7s3Y1kzAufFOifM4SkB4DosA459HX1L8EUGtG+zh8phty2Tj0q",
-	"xW33Mt+yi//n1aePPSg8yRgR4UfCYISgi7DAVhoqmSocRjT8iqRGn9mev7VjBmjbC6KYXrNGRi7nS823",
-	"CMsv3IlGQzD0AlebSpNdmo4fwQnjQ0yz58BaLeuPGOFJD2Io4zBG4u8Z/pt2q97/BMyWvn+mQ/jw4eKE",
-	"8/TTMKA49A14/+igqCQiSW6+asCWz1YOheR2xJBgHLqoLi1chjFF52pEIymw0YqhX8Ypkzsy3w8ffoW+",
-	"zwNIgwn/ay6KUv46NcSFjVyykzKqrrCFiqlqt4D+2HZCQu0BJMi1MaTI98bcJivgHMOF+nZAAgY7mynR",
-	"WnoEVt2LwTRcR8BVuRUcBoNxSEehm12SOql359dWy+p9uuJ/3LD/n51/OL8+Z/88uT792WpZn3rX3U8f",
-	"mez/+fzkzGpZ32lQlMcN8htm4dNxXU8okz0NMHELX+Qw4IpvreSsAy+4kzHX8sKaJL514ZX2iAjdnLQB",
-	"v6bwKEH+kIe/gMx4oROreN/CFkZy57SYbGcEKT9xH6kgvuoT42O0ku1OdqDsyITXGlfFu8M8r5iCilne",
-	"8tTKBsyr8O6dQlx3A+Hz2YD2MEIB9GaMYN8qDWHf/sf6BLF/+HAB1NnOHM2+ViHsmZVKfpXO8svVpz3w",
-	"KULBSTdptZCA8+lB3oXQbn6nx6Unv86UnliwJSO7ETeDoetyEVsMEd+uK15TIWVgkBSNI99o+VzLL4lO",
-	"FRMtuFvf9syOJ0RX2CI9hrueu00Lw67X8CRm8u92nvjk0gXNG6hcnPqzFrYrdjW5t2Yk6QV3bXAVR1GI",
-	"KWHcIHAhdoGM7+Vh9i1mTcvI5hZDjwfPd520FZFW2TBkyg+4/OnU5sLDgwHl0/JZcewj0ga/yL6CxMUN",
-	"s3iwoTxbPhpSe8yg9eEA+eq10Xd6APG24Y61LZBAxhfr3Pdwv4LYtvr97/r99n9Sorvd+sdxhgRvv3Va",
-	"b3aftBbb/+j329vfy19uv+21nqZbh2XRyAk1ZMKRswKwliTVLhjqoXrZCImPuZUXy6mlVm+GSyTuMUVs",
-	"GXda5ykD3yNsj2EA75ALfG+InInjIxFzQdqgF0axz71q4m0ZN5q5gc+48afAnwiFyuCPuc1HYX9W9GnJ",
-	"sIy2HmPQfiDhHkOfnftd6EcjyFTVr17gMn7qj3VOjih0pdoir3B5iJPAQBVRKq4WI+QY9Rnd2vmiqapf",
-	"hE56qzQzgzrGjkVrzzRZrTmzG/x6jXa+8T+77hPfLGHwpBaKrkUpxWaHnQWhhevuorhLmXzKnjPcOBaK",
-	"p7RLjy3GR0MsnW4pNbEjEvdpwpg+tt4iiBEG5Ks9CWNsqwaM22PfOrZGlEbkeGcnyxR27nczVongsRnv",
-	"g+nif+/guvPD8d7u8e7+v61WokFUtfHcMoQQk+U0Eek1Lh/x6amC2s0Rjhtk3yC7AdlNsR2fy5SWRMFl",
-	"xyrcmtyjlwgupXjXxK+MJl4bJwt6jkDSUmD55xQ2HZczAGSR3HBblGJ9lYC7UO009J9J5HLzN68qaMci",
-	"F6xBJCeaohJcazr2zNqA6rxRBCp443Wqtxl4pGSPCWPQ8CNlb9L/m/M+Jz7itOGvVHmKU8dw4qR9MvMn",
-	"EYEyjuiUWUSjaTMk4Vgloz2mvkU7aWubBpU8UKI8IvSC8WUDeJxfVwAkUGC+3jwSYMrG8DbV+zKr+sBV",
-	"gzxqzKECKNwrSxZRRLAq4jRekMzhEslY7xn7LMHIarus6OHIIfA8qzBg7nzDZJF1vjEEF7yAUeQFd2QG",
-	"aZGy5NwQJlKYB7YcRcw+RIW5W1NWLVaX3fDrDb+eTQNO+Nk6aMAJsOUacEIBZZqwRiIvoRFnpNoCdeIc",
-	"D12cAH2l4ssUhi++qCe23L2aOu7HcqNzqZ+kDm9NVQNWUsAluzEf1pEi2qkRZ7uEn4LchfuXp1JwHye1",
-	"U5BtblSXeKP6OHn916kRX+ay84KlnrzHySy3Rq/+ijZx69ZiQI+TnuYGnusaNJJHsLkD/SvegUaaj3aK",
-	"cJrzljPXfePZNFvKgg2WmseCSjO2ce7OxFaEXHZlwj+mbPHLbZbZlF+eLfHyLreUZ17alaBe416O9Tq7",
-	"We+iHifrchH1ODHb4I8Tk+H9OFm+tZ1R9Js1tDVVoBjUKW9BpwCYDaya8oyNvwgEijKB749BZIqoKrmP",
-	"rxZXnlu20gyMhYWqa97SJKNpHLK60DUFFss74G9ToORfTXBenPZ63ANhOAp8x2OCSUVuI19qqElbrjGJ",
-	"pzTpzXWia+aeO+tjFp+ypPlcpQ6oJpnvvVxV73SrDE8p6AjhzAjC0pI9ktEGYegjKJ4JeNRHFbs2yto2",
-	"vPl0ME0x8KYjzevpldtcBlP26c5sT7MMOdmEl8v4qne2vQq0ExWDGtOzzL97giBmcHNk8sic9qQxLpsA",
-	"Gfeu+SrQPcITOhK+rvXwMaTLetU+hnSZCp0Kl5Tn+uG9RLR2CqM5Mffzk24LqqrvX0wliGGw2d2VF6c9",
-	"ZS4ZcwZEyClVAdnmlCqA+hvlQ7vzxt79MZMX05BvIPRngvs6FO9KqpKELzbAPM8XrkcIDKDzFQUuxxxO",
-	"eRjEWKQnY8pWPht3lXMmRT7TvpblyP1Le1zGTrSby2u9Rj6XBHOnS8qZfS7G7hufS95uv3Ais8me6hH2",
-	"2InsxNtRtNwzGkfWbs/Is4Tzf7nNcG72zwzf1ViolfBJ1krndGns6fGOBsLxfqez1Chr0z49w19TibYN",
-	"+mv+Muc+k5MnlUDr4OhJoeUdUuDY4WZmFqe9NBePwchpysWj62+zWfyJyTfF9hx7Y3QtXSQlI1x0L87V",
-	"nte0XZmqpBuXydW9KRmF92fV7OwzUxp4OirLmGRqfqNXwVXT7G1ZMfZmsdTL151P24a9qgwmSh+eDQd+",
-	"LvVCsPUP48ARO+RRo0uUZ8wQT9rNGTrS9/NDkRISPUbIYTI+fULfhL+D8UZj/aeYVkCYnH81qGIQQCiO",
-	"HRpj1LBbhcFuznlbNy9DloD1QzFiisbkcpIgCEKalssyp0r4ZnKiZNJxpKNw3R7igUcxxBMQhIGtMrKw",
-	"HVb8TaQ2F0aELap1qMS92bIt1QIjwiFbo83VkM7ukXt0uD+03f0f39g/wDcHNoRHe/buj2+O4N6Pe0d7",
-	"qGOZ4m64sfGc9X/gA/Clf0UTW2SQjKCHhbM2FHmseOr4wAUE+TJn8UmvS9rgPZoQwKMtgpAmCaVEQEVu",
-	"N1Bw7+Ew4N7LYytNV8sfPzHlwJK2qJXVAozLrqS4EQxcH5l41sw1XOr6BdO6aiU5RAzM7Pq6B+TH1kxZ",
-	"RWQuEZVcJKMqiP7GzCyGoLswpjLUKluL6xvnd08g8qGDRqHvCsan+SkHYfiV7Hzz3CcrHznV/m7dAlnK",
-	"3F35hDrqsPhumtCgLK0OekROzGA/DQNBpcaUsCozlMwNxAPaHNUD+iAZJvFxi/myiM2NjbbiVl9gTEeM",
-	"iTnMfLkF//U/gJml892SGOZzQrNMnCeHzUnCe7WUNcklAZ8bbA0xQjbPpP4VTXYEv0qE3bYpQ02px+pz",
-	"NopI5cJhxjsYw99DbHMMTEqYJjFgyrtz32mB+93tNvgp9n1A8tFJqtVuu9PubIu89TTNwcMYqsqwjtHv",
-	"XHyLchvvZNJ/+QLWR1irijpCAjig1VvlufS94M5n36jDTCowZDCl5VcJhb6f+qtUXQFvDO9Q3i/Fkyzl",
-	"46b+NmviJROF5BwvhgivEr+Llp1MxIXqfD13ATNTVatEZX6ARKV5lGUNtm6uT7eNBa5yroR6KSx1p8Ss",
-	"gPmQ0PSSeisce5TXJmON05uPBoGtl/oVEuLdBWmVA+lC3kJ/xNBniJkoTQw3tucro0aoMdVL6bUWRlGI",
-	"aR6o5m6NNFfQXMeosrw2il5PZmKjJ73uTG5R1mHjZ8372/jGRJ7Z52ZGZLPXraxcddYBJ6sN20wzskUN",
-	"UL028JdUq5QKn8pUwNUyLZuBdax0yYoWhiGEZpcd56ZOq0RZNTW8zcR+JftHELVFwIuW+o2/Zkj8puqz",
-	"1iutChXZ8jjtdD9V9gMhe0V2JaxlTDUOqJso6RAuUyrDiP/6dPv0lDdPch5OVZ61kF2BtAfe7x6GbRfd",
-	"7xCOl2SngDuMWXkO2kncn8vyhJex47l94Tlm0qD3e0ONG2pcEWqc6X7ipNddi5sJXhA3eyehSO42WxRf",
-	"0eHS7iZOet261xLafYS8oSi9lsjl8q3KA1vqxMkk0a7vzqmXD9bkvelpLyWzzpnn5l81bdEVcjCiVXFv",
-	"swZsEj5iBvJeSOgdRlf/+gB4IAc7voF4DkjIQ4jdfFzV3sEzo7oEEEt/NnamFtYzLqyht2NJ+G9e5+Zr",
-	"Fq6TLUJDZkWhwMGTiOYBJXG0j8m+g/fpf+mWSPmBTCtnXB1cwiGehn9MEDeJgy3gDXXz1QscP3Z5qcUN",
-	"ei4KPWfM9qGf/+LiKq4UTzIoluq07eS0NZmVY801ECWrY5p2XKk8GRqcTeOQpL4OSocENXGX5F7AyJPJ",
-	"gJCc1tLUj4IUbCowwojeQkHmhbuQqP1lQLVPV9c7vZtrsCN4BUmcJG3wG5uuzdHoN+V9xojGOEDu3wFB",
-	"CJRTlXiqwafeEfYekBYAGISuh/JVSl8P4U2xsHftzmGmGCC3noswmszkXN9ptDw7eZbSWpGMXoRmEsmd",
-	"2eTpvRM/orDHlDtxDuJL5p2RCi8RxR66N70CeneeUh+3rRMSlJqEF9wBF0n9KkOVr5aIyqTXhrYWLI9W",
-	"mK4Y8XcpGq+GwvY8KWD2odbD1IKzdKPRrYZGZ5ZOy7rp+iTvdL1APJzlziQwhhNwD/Hk75q9Kk13ptEh",
-	"zV51wQhhZL4aa05HnVIRtl5tVCktddl3aCysLduVxgzJBm3wU4jZP2Ls0Yl4l5iKWbHFbL/UvTkvaXyP",
-	"8ITvcvKGxyP070xD5pIeQBVSIXd9MAGeC2gIwgEPc2NdUrHOZ2rXDTnKccTn1r99Kj0uM4cv7GdXhKMk",
-	"EWBJOWzSBh9DKkrGxr4PsnjOy5f5YCsIwW/8oug3EOJ+8Ft66/SbfPRUEaKRv/8uaAHzRyxcwTECkGTD",
-	"EMCOOlERKWhli1IXWXh1BEAj4NfLG3AVD5LVCbOwtD4ojLxuiWtfL92rBU90z3hJQlgsbeocDfcGbyCy",
-	"d/f2D+zDNz/8aB/BgWO7aNhhP7FfTNvE4/iEiDLCkn7OwMTfDp+h+16IKfR3rq6vttsgiU3m8WDhVxSI",
-	"inSAaHtiCkJuWQOPh9Kd8rwDCJtAeevJaDvZJgOPIoqWCDwKoD+hnkMAxdD56gV321Wz6kdWNbO+jAZm",
-	"JxqdqwfiJ6fX3c/nmgROfuh+TP56ef750/vzM6MWq8PY86FxPfp6QeTDANzcdM/Ew01IGY8de5TzmoGX",
-	"RDim5lbbmjIvz79oCl2Hf8Qou4uirCWbmWN9oIrBgy1Fan8H0vsNCRhBMuK+1LwDfCCS2tpw4Ozu7T9O",
-	"/pxKvYL2THBPI+qawtUgKHUqqP0sWZ+6vBb70xSgGSpM4UbyrFnLLMs8/XRxcX552j35YDp4Xmd6cu3l",
-	"i1KKQtJ79v7u9d7+8eHR8eFRfTnBkPJjoc7lu9B3GySkjFabfDaMHkafgn/FIYWXCDqjzDwiRDYZRvzT",
-	"kE9khENKffSBUVZSmz4t7N7pdIyvjPRuN4FHdVP2wmMy++cwxlbLOoMTq2VdhIGIek7XJb9PuVtU231b",
-	"A40awX820Hw0wHo+jw7Kgc+RQAEVMipRPUzOkke9PtLIE6y7RIeqJJkaldmN5FAL9+tid010rlbc5g2r",
-	"zJ+5cM3X5X2NnOK6Hkgd/jLjCZRTXKICT1dMG9YZF6cPmkaeg3PMxQXq4NWiFMjG1cItdREm7s/DQF52",
-	"/Z1XDO1J95fNQ6HC1CfAHQePHqH5MyLbUw3FJvjNFF7z3CMyTX+jhdPl3gPIL0kWmWx2py3pPqEQ3yHK",
-	"jEuMhgijwOH2ZRgg6VfLPhz2rdun1rdcpvShdft0m/cijEKmLTxgL58KC8Y0LKTBko9pCBiFD9yf8XNI",
-	"qCrB7xFp+co3FTLJjHpbo0IK2+A3NvZvwEU+YkRERIYazKGQHc6D+3DSAg8jzxnJL/Ldjj5jTFR9bjU4",
-	"cPyYUIT5kG3w2xgGMfR/A65H4MBHBLCpx5B6jjYfs6TE21/C/vQ9x8tl2ZJ3TCpdoNgaMbaRSLmuVMzP",
-	"L0+OLRCCCCP+8hi5CfRn/CVy2bN8Hn9ZCMjxMHJogj03lx84rfFXiSppGIc2VTll5ogIh64t+x0fdjqd",
-	"HRh5O/d7uhEgnqDPgODmVIxwdRM0Vi1GOw7DYcYcozTMyxBu9jEoY1RhLGx2P4QuGEAfBg5ngIhSXokg",
-	"T5kDSFDPGLWYZvcXT6eTJP8ocKPQCygR3liPpNDJd3TyjLfb4MT3VTQCSR6IJs35m7oRvEeyxL2cLEKB",
-	"i9x2NlQyQZtnPurX53d1StCSPU1s1cTenT9BXEmsnzyladaOQo9r2VxLQFbhZVcUKjg5ySHIA/LuRjK5",
-	"ZxZByrN7GvnBW40RbHG+KhIEYsqFdEscpROOEREJBhWabU/jEfYu5xJT2UPLEosxpPrkvxvWqHvopAQC",
-	"u51OBqQfO/y4vTHjCOqwxb8MtnkhlYa5fPPYC7pic3enPFyW7zLTg76tYBzXKSIV37aFhRSObEMSzFc0",
-	"WfT3h0HA5ikMeio+cL1Mju8m+oMg+751SPoW/7PTGZO+lT3tQ5J/ge5+vyVz/G//Y2tM/kP+M/7PaPtv",
-	"9YTBZ+h7Lp//HOPQkIOY3yUVF/ITv2KiI0jBEHq+uBCSI2UdihFy2uoNivGikxB4Nz00FDHwgGqtz3Aq",
-	"s4sWSqVwcnJ43gzGbuWv9fblFzS4igczvSZMumzeE+bDFuTWlL1huvPoKB7Y6J4tvviAaQSDgGf90J8e",
-	"Xd28VRl3ji2PkBjlHhZlGkSx7/+akCtbmvYsKjN9+buodx79OR6Ac97MWt5LNcPuPOOZWgFLG4xO+Usc",
-	"81/lCVR6mJmZ9TNeWtTKL2gwCsOvJ71uo++g5Fp8/1TgXq80Z0svn6uFmYW+DxTStlTmIPEMn+MY39B2",
-	"QTcIg1+lFPvVRb53j/CkxnzcSpftuR2sRKEwUKVrZoAwcKDvMzVQqJVCpxiNoWMT7y6wZa/nJ4tp6QvB",
-	"yEGeMVjUvJAUep5DW3QWOi7PTBIPfI+MEAb3HgQP4vALa4E0xshO1Y5mlqQ7ueovx/E9npBBHQMRxyLR",
-	"Q4EuTWOb2djNQBsHz4I36T5QJsZiYS7XtOajwIS3KqhfkA4Tw1iCsooEtggQGyQYHTaFf3gAnRWllSa3",
-	"soQydKlXdNUZBVcNE6XY76ml9C99qLIce9OnMIyf22yEbXXSMtVV1tD+iiaChlUrpuK1wTl0Ruwbz06V",
-	"+Sbe+HEvLanKrQap6tY2Zc8qLWfReJ3OBwTpiF9xzVKos7RO5/pU6eThSTPWzmjxXl/RRD7mXJcyGgzq",
-	"ZT9nzad1WEh1jpmLVopMIs8vV2nQDcS1NbjXiiiQHVn2IEmoJ+bPJTqQ6TKmDidHE/iV5PJU6FooK5mt",
-	"cVCyve1+3y7ZXAIDdxA+zgya7GeES36znw9fPk8k20TjbU2dqgqK3xoHUBHuPGFuctXiOerqigsdHrDB",
-	"fk0hHVEaCXeHFwxDlQUHCo+s9BX8cvVpj5OnuvwG16LISP726PzqmrdjG8wdbTIfbK5YiIqqL44r0xuK",
-	"i0mZi9gy5Dy84F48zm4Fsqauh077SHhLeEW2yLOOrf12p70vc93wndlxGGJzR4PYqjtETZdhKtyfma8C",
-	"na4/XAG9M3BijFFAGWcKoZtmUdQaiVDSdj+4HiGCst0ZT07qlzBFWWQU/vn6uneVuYSTvnD5uDbJMtR1",
-	"5WXMqb6iNIcOX91ep5OkNxJBOVqYy87vRPAmkmSXrlJatHkyIXkchcx3RJnNfmpZhw2Cw13xVUB0A0aw",
-	"0FcZHLhzXFBMPB5DZskIQLVDdrJ7SeEdYXSpLV1DQEaOjzanKmb42Tj0edokC7pjHssk8xIhbN3yZLem",
-	"ojY3Eb86giBAD3kcA1u98wsgbqe2VdiBIhSeFFRv7BGFiO4kgGPPgb4/4YpWGPMXV0zxUvEFapQCRgl4",
-	"tAVbSZn/t6E7qXF8mqNUA886tmz239vzd92P4PT88rr7U/f05Pqc/9oPLrrds/+9Pj09+frL3clD9+3J",
-	"XfefJ+8/dG7efT++fE9/vzjpvDu9+uPdVXewf/av87enDzcnF+c3j6d/nvzz7d3Hz/2g3W73Az7a+ccz",
-	"wwypB3Q8scV5247wrs2K/2KTkiuMLBvnNwUFOtxdBB1Wob+Os3EkMUM+FhrGvs/tq4PlEiT3Q2WQVl69",
-	"riJvyFCmkyGIBvnCUysrk3YwYtNyQ9bIMC54lA4z1LB3d4dE1lsOaTgUrEyXMom7cOj5iEyIeNmWYyUF",
-	"JnCJckzg2YIlnzsruc/Urih1uMWSZELkq7OrJEFqBoMrY/NrPJFrWTSk0H87oSZvmnigyGtyqL2VQOXE",
-	"RDLT3t7u4dGR8fY+r7dV0au2/DzBrhyVJOgokbBJCWqgDp6mkJ+Uj8wJgNnvAGaZjCKCrOwcwUD4/pT/",
-	"4zlyU0yclZtahZDjL4XXlWfK6NNBpSGQS8vEMxx20I8HnY6N9o4G9sGue2DDH3bf2AcHb94cHh4cdEQc",
-	"C7PTVN42dRvoWnnZpMu7vNFy2yiZi5jhmZdRFf5gZBdyyxbMLGYk4gSoosw9WB4J6wAFIQXDMA7clWQk",
-	"JspthoH4/jipzm1TNI78SuOP2wQfPlwkJb9B0gdgdOcRinBq7UmG0EpC3/wJk7WizUAUhG0b7TZR2pzP",
-	"cJ0ANYVp/MRH5knMZZfyyrOiwr5iC3/ECE9SvpD1NCyLITiFl2j7xhf+M8pw/UhrXTAYtr7ObUO5oWtG",
-	"l9U2eUtgTkmONVDbBNQ+zUJ8ZTbviavUaiMMBUv3RMN2eXchHmyo986c8Yu0A+iR/cgdjyrIQT6SzkxW",
-	"JEnxWtWEGbMawPUO0zBTalBmniPtTODYb2jgpVqqRjIzEJERCVTBjdUwWbOPWNJ4ChnYuS0gO1qiYA+D",
-	"oe85FNgpafJLNQLHsjob9DGC7kS8TVpNZiSIrooZNMmPypWB2nZFUMKyCiZGiYFg5i+VMl8+L+DxPo7+",
-	"ykBV39PYpsF24M5wbw2sgwTQevq/+RyMSvcyNP8ZwFm2DWAGbT2sgWDxXKFlNgPeIVpO7oMJ8CgB3bMi",
-	"nb9DJs3+7aTrzk3o6na2bCtWkthnVwwaVnpmoVIKPZ9sCLMGYTKyKKcJt2HzITbemPHMwzBIH12bAcpa",
-	"6KarLhcuXCILV9RCifQvZJt0VsM2MfoXV9w22fC1Kbd99bjKIu2RGXyS87oiWyrirAVkUFELCF24BUIM",
-	"ePDYVHflDG7KzB5OcVUmm/lMn2WrJjjaK6N8wJ1p+rT586eWe78jmb8W8JoVDjkQ0ieic4GQC1WNs/Wb",
-	"tShP8+xJn3TyqcGic58NQ8RCyKDYHBmRZzwj2e3lHNp7Jod2hsBn9VBnUs8vICtsPa/2GjmzS33YDYdu",
-	"lbmxC97rlAFK7zXPNhMChiEYOjIaXhqbsrxcS6sTkEQDJvkwWkAr4c25OeTnrcqEZ+O/azi7F+/kNpbp",
-	"aUybLBm9WjXxAvB/Ty4+MMH3z6tPH1Uw0gu5yHN0PgV25R4XDzpkDeiNr3yarzzhBXlfeeAmsfjr7Dd/",
-	"NuszaKXzOsfn8InXtLyLJnduD1JByEuQCr3BjnL65Qo7w0vAnsM1vhoe8dVzhK+j/7sB6p7B213byT2D",
-	"c/s1UO6c8nwRmk4NulsB1/aaebS5I1tPrtqsLTGPT3tmV/a6keNfwPS4kU7j3A6/iMt7Niayuu7uDV+b",
-	"26O9MEthR2ZDmeLNVskBWEtThN5UnpdzSp/0uu/ZpPUYn0jsa2J6mdTOCrj1V0zE9tR9uKkOZkNf1XqD",
-	"qhCm75kJmRvQIpwwIPG40iH5DgUIp34BCdBcxFVwEAr8aYS67hSY7KMEcK1VDbE3fMsaUzDKxlxqAG8e",
-	"iHKCUbi2jlG7K8HeXsYhuuXGYhJBiCG/meSfxL0D0yC2V98DWsHpmuW8UzSenW8w8t4jfkVd6TG9RPfh",
-	"V66bSdDb4FPgIID5724LeBQ4MABBCPwwuGNGqcwWQUP96icpwE5Mj3jZWM2z8OWw6sJFMdtTLU0OP2+u",
-	"qrFVZmBKwrtVln0jPOlJrZiOxs7Nqc1wJcZsGG4Nhiur/rFtW23VssAelqJTVjumFCTirlolTJFVhwNC",
-	"kcxAENPQlhoekyFhgGq4q14lazKEfi6eNS1Ku80WGWpCt82PuNTwz9k125VygslzXh8Wu1Fv53XeraRu",
-	"u4ORsuLLM9VcJm0yTsjnuCXSIV+/Xpts8OsQIMnRNewiMY+74sIEh3TjJnl9WnvC716CaT96NZOasIYv",
-	"9HyAwzjr44HHCciG/r/cw4HHycu8GnicrOSTgZV4MMDO5LW9FlC0PMNbgcfJiz8UePTWJOeNZEM5Pvw4",
-	"WfgLgceJ+XkAY3H13wakAd951p2+Gci+D5jhOcDjZKFvAXJo2mQ0TunQZfrF42R1ngAUyLcK6k3w/7zB",
-	"/4+TVxj5z0m2MWaWUylnj/5/nMwY+v84eW64Ih8h/8LeVh/WI/NNAu5MQf5ccrxshH8ZCC9kNT5O1i22",
-	"v1n6rRXh/zipFd7/OGkitn/VqXMe6dy4ujKNwF40jn/laUoL4heoHedxsmF9f7YofqFp1g7hXxOB+Kpt",
-	"hFy4fmIWLTNWfyYWsYnSXzuuVcUwFq3SPz9MvwZT0zy/kwYC9B8n06Pz10q7WK+o/LXQAmqE5D+fuJoK",
-	"xq9BQlnf3PPvugUNTY3BXxeNYRN7v4m9fxYT20QmNR543yh/rdRdVjbgvhlOvViO/LwQ+8fJJr5+w1RT",
-	"pvpqguub1g5fJqz+NTEgcyD9IhnQJop+E0W/aox0o6g2G0L/Qlpq86HzNZwI+bj516WelkXKr6OE2ITJ",
-	"b8LkX7XyPSVGvnGuPHaietHxF6e9XuPB8SGWcdPmu5F0zvpR8RenvWxUfDGf/oVo1dN5cfMx8Skgy42J",
-	"T+ctj4lH9whP6IiN9Trj4hcdmX5oikwfO1FvxuB0ieEvGJyu0dhKx6ZneIHigAkZLy40XZ1QPjK95CZK",
-	"NV9QlLgRX5pRhKYMvdTbnRKyKKJQcjqbeqh1w7xTmnlFod4a2TXGG3Lq0QyR3glW1g301sB/Vmm1dM1J",
-	"tdN2P6t4pKLfZovT9ZAVjgE3Q10vFDw5jReLBK+GYNl2UQLNesSBL4S2q6PAkx2qDgJXzZ5VvTRPuetC",
-	"r/OI78bVkynE9jJB4WtCXwzXM4juNqxY14wBT2CoFwK+EFEpHPVLJb2/mG3QeUHbYFOP9DXwqwrW0bTW",
-	"jxGhNoy8KS7RS0ToSa+7RIeomrG+O/Sk1y13hF4iyF/D89Wc9LqLc4YyMJbrBmUzljtAsVi57Xs8xcXr",
-	"rCbarEmm6KGWX1MiqsmTWdOZujCHZ0JDK+3u1ChdsTb2E0frhfk65aQ1XZ3qjBejzcjRm9FfCoMt1ZuZ",
-	"EEMRJ9SOb9yXdd2XbLdekeMyJaKmyDyjwNR2Wia0X9dlmQL+LDNMshuzr1KX0jxWZU28lWVw1/NXqpN4",
-	"MXdlJQDLtk4UMGvirGyenqtclQnVVjsqZatn+SmHIVYEuz5kWk8qN6BZVJPRy/gh14NyGB7rWOw2q/HW",
-	"dEIqCOr5IJuVfWbn44KJ6hUq7J1lKuwbn+Ir4D3ljGCh+vjcuSVqsynWf7aEEtOYVJJVQr6I5xC9Cj1g",
-	"TZJMrI80r0ox8XzSemZuiTISAtcy04NHAAT7e/ZgQhHAMHCT94YocEJXuPhH6BG6yPHG0G+BCKOh94hc",
-	"4Zb4DUZe9OtvbXBDUEJA79FE5JedgDDQyUqyagS8wAnHjAGpB9RiNDryCH+PXeKDm+mdyjQaN2W9WHet",
-	"ZJMAY5MA4zUx2Kr8Eo0y1wq1ZQXTSjTKBwV4L8IFZ0s6MQ2sTfaJDUdbeY5WYBKNKojLTi/RGCNaOZYj",
-	"PB4vwnI2+SY2+SaWyzrZBq3Nq+FSfsZ0xPT9vysY2/JVxMZyOlQa7xFG914YE2XFK+UABgy1Ih86ykQX",
-	"G9OAjV+RSOL1GOazJ5p4VTJik3Fik3HitSncZUkmGncgEORgRMvvOS7VrQJMPMbQ9wGhIWZYJnq3wSWi",
-	"MQ6I/EHjk8JLGsa0HzBuBB0a87XzZpyjC88zQU6MPToBUYyjkCAibluLlyZXEuAFUp2You59g9yD5P7F",
-	"RHu7y8Ovm4Cde4i9P5EL7HwZtYR1rXRoLUnOWGG6PPX6iF5+93DFUJdIFUMiIgocPIl4RTIKmMIkFBb5",
-	"tXsGxjGh3PXF1YF2P2CfpRVKtO4xYSoR5cqOx5alvrHNTyrCDtAwxAhECBOPUBQ4yITtwpEoVr6gEF4x",
-	"+AKeI1UO3JAXXuovIv+H8JxzABN8ukroUHjWxVsFoWKLcPnP8gXDsXUnFVWm/UQ+pMMQj9sPJNxrO+F4",
-	"534X+tEI7lot66sXsMNJjmWMKHQh5TuiXmNACgeQIDuChDyEmFMbiZBTRMZeSOgdRlf/+gDG0AuA6gqS",
-	"rq3M445j60y16OmDJwGGciNOqHVs7XX23tidXbtzeL3bOd7vHHc6/2ZqnWuEsWVJW7O87xM/u2dggDhj",
-	"gdjCJjLxCtF1NW5D3sLU7LXB2COcwEMMPKnjDD3ku2SF2fxLhYFL5pleknbPVjL2G9g6jxaKadWVDlGU",
-	"/wzZpGleU+O/ewiPIVuor7ITMOEldzeJBVf0zASXR8Qd+QhiV3bhx9APAmYEOuE9whMwRs4IBh4ZC1mX",
-	"yB7W13PROArZiQBbjMBLsoIgDGx+diig/UDCgKXud9A5MIkxEXiribGi1mYkf1NsM9gKQiBxZXulae5g",
-	"RgEWhNQWBklWhMm9CBHhNgvffF2IJfHpljyNrM2V2jmpkGBz/SqNn/r8fOruXFXPvyq0nkhYRukxRmVh",
-	"4k2QeavapiKy/i1nPilRZ3TPRMeUzXQdsx+YlEtnxBQJqWIOkIhYYRSK3DboCvNNNSZ8FwAN+4EcnzMT",
-	"MXcLQHDY6cid4/46MYzy0XEj1XOAxEET8b9DtJLyZ6AQ9WCiTMWT9hf0X6OOlyzJInG0j8m+g/fpf62f",
-	"6qdQ363gIKkhrZHH+pjVS/VnrQvTRdUKluZlaobv1vHpF3xVqU9c5pRkf33MMhxGoSTiNxXdM40sIxy6",
-	"bXfQZhTezvAETzjZM1yL/5YdwMBQnhqK2qu4YieZqxxdZRfKLodOCKTknxmPRz9IXR5OjDFTGStcHy2A",
-	"AjjwZYH/cAwpkx/encDcfkBDNg/CIiTVjXGapJ20wSff1dxtnJkyewIOfATuPSj9LrocNMkksfK/pl9l",
-	"VqEr5UKp0E0qW2y8KrOK1t3jg8MX8KqsREDBVK+KQKeNkF8nIT/Ni6KCIJrzoMSDBC7GXoIaz3X0PoD3",
-	"AfAeej6XIXUe7VxpA/T4nIu8icpNVvtOqrDK1b3wMcC6+IwqiUevMDugI0iBi4ZegAjgd7C+N/aoMNYh",
-	"Z5qA8pvNoYw/0scgZe9A8ke5KM0jN41KBPMiLyDywFQyucJBqDudFxROL+Y/X+2XDQWiafgxZpGx73xj",
-	"f3RrZkopEnXdnCkGKs2ZkgaLTID2zDj9A4MjvLAM6RNfugbycT1SeywSLyuSfPD7F5FCgsfHGPCvOvvH",
-	"y2FdZ0V4/Utl4Pi48m91S7CJ+46Wn4WjCEu9fBxLxfDFa1WFRwRPK0tZyoOzoSyzLbpEVWaKeZppWjdN",
-	"7Umv2wLaZk5NUHuVAWimLLXdM7ClJU3tnrG5RGnF7ZIkqTDyOAVXBq+bOyZLmm+AivSsJ6fX3c/nVsvq",
-	"fkz+enn++dP787NFJGmtS9vzGPdrYtcvw6SXWzngAkvbAP5SuXZelqKxvgRDfWWM9Nqi5a9smwM7KzXW",
-	"KaEpySL2wiTdzjf9n3PZ7fOY7LXUyixkCzbbX8pizwARrJ/5vgqWe32jffl413lZ/v9S9voaobXBeF8R",
-	"u312k30p+L1YHevFTPba6PxSlvoa0ZTRbG9Yj3lAAxIPahSX+QUNruLBcsvLpHPWN91Fn+o6M78gSEcI",
-	"a20XV2lGg2e5BWe0icvrzjyInbDRPUPfTeWZ5ivPJDi8irVnNAJb6SeyGUag2J+G4AurQJNMXLMGTXra",
-	"i5HxyfjNBFgahluqN0YjjiKGpHu/qUZT11Wj0cQrKkqjU1Vz1J9Tf+qXpkkRs67XRl/AsxLwaKsurVKj",
-	"ZHq6tjUoUWMEul59mvQ4XqxCzRQQlm3jpOCsiS9sMQReWakm3aNqx1fSrpFqNemi1oRq60rvRrSQaaT1",
-	"Ml64daEmhtdZrHab1pZr+t9SKOo53xYkHs2FbBZJaK9U4e8sW+HfVLN5FRypijUsXJWfv6qNBk+dNzLJ",
-	"kpoob5PlYMYqN+uuNqxJgRvtJNa5xk3Wyf08knturZtywlrTcjc66TdL+aYUu2usxmzK3mzK3jyP7b6M",
-	"R3XLjcUkgghDzHeMfUpTNWyvWWGeBUmESh1sBUv0LIZ3L4NHz1aUxwjRphrPhtGmBL82lSUK3GHROu6y",
-	"y/W8fqaU5NBZIlPa1OvZ1OtZOea6UWifW1VoNbTZ5qoJTXGPrFZBob+C+pyc66uQVpvKQZvKQa/bODDX",
-	"EVqUhGCTyzo+nOfxbicxHVnHX24ZKQtYTQzxQ+hAH8gbLD5xy4qxbx1bI0qj450dnzUYhYQeH3WOOkzw",
-	"7IwTKHfuO+0jq8jHzkLnK8I77+MBwgHPnJ+GXucnkLkqbXZ8OPR9hCtmuk22rZBZ7PLmLE2mL64c1LME",
-	"krJD00uFIvymwS5Oez0cPnpIG+3itAfYj5Pq4cRHZZVdf7gCDsJM8Dg8FSwb/efr694ViCNCMYJjcI+w",
-	"+CzC7uV0p2mv2eH/8OGCwSqStF6jceSzYTIEr63M3Pp5k9aaa94pHifTxp92SqbB06pXcixDysSn26f/",
-	"HwAA///AaWWJS/cBAA==",
+	"H4sIAAAAAAAC/+y9/VfjtrYA+q/o5p21Cm0cAvPRDl133cUAneZ0mMkBpr3vNryOYitEZxzblWRIOof/",
+	"/S19WrZlx4EEEprzw+kQ29LW1v7W1t5fW348SeIIRYy2Dr+2qD9GEyj+edTvHcfRCF+fQAb5DwmJE0QY",
+	"RuKxH0cMTRn/Z4CoT3DCcBy1DltvIUUggWwMRjEBMAzBUb8HSJwyRMHOJKUMUAYJA7eYjcFeG0QxYATi",
+	"EEfXgIaQjnc74BNF4B83iFAcR4DFAE2GKABsjID+EUfiTzHRDupcd9pgjyAY4OjaCzFle+Zzgmgc3iDK",
+	"x8m/crPf6e52Wu0WmsJJEqLWYcs9RqvdmsDpexRds3Hr8KDbbbcmONJ/77dbCWQMEb78/28w2Psden8d",
+	"ef/X9d78MRh4g8He1be/89+v/tFqt9gs4RNRRnB03bprtwKUhPFsgiJ2wSBDEqMjmIasdageoqDVLqD5",
+	"BFFMUACyrzlaGQIe+EZ/9A3YUSPtgpiAb9LIPOmA38YoAhQxjhb7SVvgle8ZpoCgSXyDAjAi8UTuIeGb",
+	"NRphHwxTBnxBISmBHKq2+OoLmtE2gFEAkjjEPkYUQIJAQhBFRIwVE5DEDEUMwxAQlK1AbEWUTlqHv9sL",
+	"z4BrXdl7Zb1SRiqmSQhnH+AElUn053QCI4/vNByGcq0RnCBFnUMEPp2/90YEoygIZ8ADcRTOQIj4FtM2",
+	"iNLJUPyDJtBHtA3Gs2SMItoGHFBC/ZgghYEgZpSzQHyLgt0cnZ1LMgPvMWUcgDyF7ddSWEZeg4H3x2DQ",
+	"AVffOSmL86vYGVrGgZg4HoGfLy/7IHtxTzJqq93CDE3Ed/8gaNQ6bP0/e5mo2FNyYu+j/pBPN8FRT360",
+	"b4CBhMAZf6iJoRqSo37PC9ENCi3CSZIQc8aPhSDJwARpFCJKQXyDCMFBgKKmEPf52AKiIoQ0HRqw+iGs",
+	"Q5r9KkhCGAn6oQDeQBwKmuJEzsaYqr01G/97610ccoq9wOENIq0rC+zS/hUhTBPKCIKTMmAZ7vQ7edZs",
+	"tQviewJxNA9Vn/R0HDkwCobxtPknd+0WQX+mXEbxVYv5rsyS4uG/kc/sNZ2gEY7wHGIlKKUCvWaVQfaZ",
+	"VCix+AaGgOEJiosiqjFhfyqB5doQrR5KAF+gCYwY9o26ikdarObEANdArRxz3wwGwXeDQYf/x8nUN+OY",
+	"MgeOjlPK4gm4wYSlMATirb0g5oinihz1/G5SmDucGk0KcBIHqS/oX+mD3Lpggjvqr44fT1qV8qszGHgV",
+	"0ssiuYVAU9854VLPvIfD14y+C2/ZWimjnrYxpiwWz0lvF+Mc9Xu/oFkZOyeIQRxSTnEw0hrZRsJXvju9",
+	"oHXYsm0djhJPkSNMsBia/yP5Y//gxctXr7//4U0XDv0AjRb9m6+PIMhQcMRah62D7sFrr/vS6+5f7ncP",
+	"X3QPu93/y155K6YNJpijJafEW2cz0M+o7he1qAQTRPnAURqG7VYk353MvIxCPYkAGqfE5w/D2Ich/4FB",
+	"llI+n8/wDWrdFTlD4amI4U8R/jNFIEmHIfYBDlDE8AgjYjE5YGPIxB9f0IwbUpDS2Md8hUJM5YiyahtK",
+	"HKH3pQjQOxRxUkGB3m4pCsXuccNrhKdF7lzKtpYAtPa5COMlniDK4CQBt9zw1HgSwEIKrvUScoBW0Moo",
+	"JhMorGPIkMcFfQ0wbx0I65X2LKWIgNtxnAFig5jHnqLOB9mcwt60pLJAxA6HghPuDQ5Q0AaTlPGX85aj",
+	"iw3qTccSoBbXFME85Y+E1AHM7NgO5y2AR9xVQ+aF3eJWfe919/lWdfk+1W0VH44vrHXISIqcAHJZDMNz",
+	"NHIx4Kl6DAgaIYIiH4HeSRGbOej8ME4DzlsTLgy8Nz98//qVawsj595xd4DCEbJ5vbR3MGWxl1GP8Jgs",
+	"imgDPFH72ebUFgBIpfeaQAIniCGSR6hLhFn7/PpFbptflDRY13tz9d2OZ/65+61byyqpWLJgxO+2SBOr",
+	"FLKTO5N6i3Ytn00LVv0s767pp2UQlBwugSB+L4BgTafEdrtF0E38RYmOROja3MTmvXoVHkmtLIW+gcoW",
+	"arZMsbnIYLFaTx/zD3EcnaM/U0QF41kKuVJruVSSUwV81GZvEkIcedyaMJt2A8NUChu9MeJnHHEQcRx1",
+	"BlFvBDKxI/wWqUXCkLvDglxxRBmCAd8OReXcf4UgQrcgjlBnEF2OUe6zMaRjFIAhGsUEAcpiAq9RB+jX",
+	"fBjxt3AEYDQDUlAMop0JjvAknYAXr4E/hgT6DBGqQkICMr4QBXt0bZYUzjLRPYjU0mlnEOWYair+593S",
+	"+EBo2iSEjM8spIJ6KP8zbeX56/XD5WgH9EZgGLMxUB/2IhElMMOoQIneh+x3Br8gyjW5jwIu7jplLbl/",
+	"4HV/uIeWNKDUriFQ/pNDyObpU7/osEv1EDY56gns9bzoGjBxxNA1IsJPjHCFVQH4I8d4SkpQ5MdRQOV2",
+	"qtjGOE4J/28AZ/w/twh9ES/EERvTQpBJvlIvOgRw7WzxLjmwDJ0mmIyzAEZhwM1K4+1yOhJsKr4g0Oe8",
+	"kaQkiSmiIoClGPQaMnQLM2ahADMK4lseUVUQ6HkJ9L/g6LrIQ011KaY0RaTG+KJiaUlMGAylwazEq5FA",
+	"gmMyhuDL4FYtf0RBXtcOIj4YhRMzohZD0PdRwlAgBotilpN0iCCOxyjWXxHEV6DlYtFszgRGgG7kF66l",
+	"TyD9goKjCll9Jp46QgNCLHLUK7vBbGBnEPUV0GA4k2hTgIjvhEmdycSEIE8JX5cQFOb/t99+++109tf3",
+	"P7xpbgf1nK6O3qc8aiFQoWfbaNJb4rb2H8XiuWugomkSRxQVdHSmebfuc5X7PEGUwmskA5KCmjMmpanv",
+	"I0pHaRjOhM02gTjC0bXkkn+lMYOtwzfWsOqDOhuoLoInNzUHlbWf8wEs8YQb4iKPnOu3DEP/yV80kpy7",
+	"eDbVv3Epu8wizgDW6Jini4zdqpddbZTysKpN7S40i382CplmCC9F1hdZTrvFYgbD4ziNXAqfP1NHMOrQ",
+	"QMi4nAFRRmk1158jbc1WGOcl8lvQ6tuaahtmqtXRyk3sl3REIZpeJ2yUozpX1DwS/39KOL1ZVA/D8OOo",
+	"dfh7E0YverR3V3k4lJS+umu3jjl6RtiHDNWLHD97sbncsUY3Iy9JCL2dMUSrhNCQPxRh9jAEFuRghEOU",
+	"E0gHB/uv3jgF/SKirnaKhjLPhavyLrjh+eCChOpEDA6RDdC+a7m4OppuWYk7nz71TnaN/LJmsydovXrV",
+	"RT+87HY9dPBm6L3cD1568Pv9197Ll69fv3r18mW32+0u4pdYuAHyHXDyAexwMEaYUCYA4VHQYRoFxajs",
+	"8Yf/PpuB46P2R/7fj+QaRvgvmRVx/N+fLpxOQiYpCnEvSZVAxDmkapBOnv4iN7EFdZqEMeQ+AvcGL04u",
+	"QCoYfL68cZv73HDUhn7VJkxmni+O4zwfOkeO2dGIzUM3stQX/7sh0qU23fcOXoPu68Pu94cHrxsrU0sc",
+	"aO1jhAEiJCZ53VIjKWgq2at2heqlVVLUHH7/JIjDEvaVore8kv7pmYciP+a09b+dV903Nj3s8OjcMYz4",
+	"STuDOAKTNGQ4CXNEQ/MhK4//7+3pu94HcHx6ftn7qXd8dHkqfh1EZ73eyf9eHh8fffnt+ui29/bouvfP",
+	"o1/edz+9+25y/gv799lR993xxZ/vLnrDFyf/On17fPvp6Oz00/T4r6N/vr3+8Osg6nQ6g0iMdvrhxDHD",
+	"AqF/KZ1yxzXWsjrgTKUMpfJF6JOY0qJKoJ06prlH4k/nj0an0nmuFSt0WQOnnN6r9YFgB1p10swjGTDE",
+	"gWRf9W7DLItfzYcCBJfarpSSP+Prscp5EZMC+3GOkewEEBvWkYC+qf0lJlmO9XU6ZQQK3zqLqJTRjnPP",
+	"8ov/58XHD30oI8kEURlHImCMYICIpFYWa50qA0Ys/oKURZ9Dzz86KQe0g6MkZZf8JaeUC5XlW4blNxFE",
+	"YzEY4SiwprJ0l2XjJ3DG5VCr3ZLAttqtP1NEZn1IoMrDGMt/5+Rv9lk9/g2YbRt/rk14//7sSMj04zhi",
+	"JA4ddD/lgUN3RpJCvn6BL5+NZayRUiEJSRyCSRygprxwHqcMneoRnazARyunfjmn1OgWyYd/wDAUCaTR",
+	"TPyzkEWpfp2HWjFyBSZVVl0JhVqoZtOF4cTzY8q8IaQo8AhkKMQT4ZOVaI7TQnM/wIDB92ZOtpadgdX0",
+	"YFB/ruGqRYWAweEcsnEc5Jekd+rd6WWr3ep/vBD/+cT//+T0/enlKf/z6PL451a79bF/2fv4gev+n0+P",
+	"Tlrt1retqxL05YWLE2YxGQwCLI3JvgWYPIUvSxhwIVCrJOuQB7VkzrU6sKYmti6j0pjK1M0ZP+TD4mQB",
+	"hSOR/gJy48V+qvN9SyhMFOasnGx/DJnY8RDpJL76HRNjtA26DQaqtkxGrUldvjssyoo5pJiXLXftfMK8",
+	"Tu/ea7WXnz6fT2iPExRBvGAG+05lCvvu/2xOEvv792dA7+3C2ewblcKeW6mSV9ksv118PAAfExQd9cxb",
+	"K0k4n5/kXUrtFmd6QnuK40wViQU7KrMbCTcYBoFQseUU8d2m6jVTUg4BydCEn6c58HypnhibKqVWcreN",
+	"9hzGDdOVUGTncDcLt1lp2M1ePEq5/ru6T35y5YLum6hcnvpXK21XYtWcW3OW5AfO4CJNkpgwyqVBFEDC",
+	"b1KI/F7+Pr95kQ7lD7TNyeMWh4GfvUWVVzaKufEDzn869oTywDBiYloxK0lDRDvgN/WtZHF5wiwvbOjI",
+	"VohGzJtwaEM4RKG+bfStnUC86zhj7UgiUPnFtvR99aKG2XYGg28Hg85/Mqa72vmfwxwLXn3ttl/v31lv",
+	"7P7PYNDZ/U79cvX1oH033zusykY23JBLR84rwEaa1DpgaEbqVSOYGHO7qJYzT63ZDOdInmPK3DIRtC5y",
+	"BrlBxJvACF6jAIR4hPyZHyKZc0E7oB8naSiiavJumXCahYPPpfHHKJxJg8oRj7kqZmH/qvmzpdIyOnaO",
+	"QYenKXHy2bvZh2EyhtxU/YKjgMvTcGJLcsRgoMwWdYTLv/UkBeqMUnm0mCDfac/Y3s7vlqn6u7RJr7Rl",
+	"5jDH7tq599+d5l7nfkPY7KW9r+K/veBOIGsSBzkPxbaitGGzx/eCstJxd1ndZUI+E885aZxKw1P5pYct",
+	"LkdjooJuGTfxLZLnadKZPmy9RZAgAugXbxanxNMvcGlPwtZha8xYQg/39vJCYe9mP+eVSBmbiz64Dv4P",
+	"Xl7ySOf+4f4LfnSoLYi6d3BQRRBysoIloqLG1SPe3dVwuzvDcUvsW2J3ELsrt+PXKqPFGLh8W2VYU0T0",
+	"jOLShndD+spZ4o1psmTnSCKtBFY8zmCzaTkHQJ7IHadFGdXXKbgz/Z5F/gupXOH+Fk0Fa1vUgi2I1ERz",
+	"TIJLy8Ze2BrQH28NgRrZeJnZbQ4ZqcSjEQwWfWTiTcV/C9FnEyPOXvyD6UhxFhg2Qdo7t3ySGSiThM2Z",
+	"Rb40bwaTjlUx2jSLLXrmXc81qJKBiuQRZWdcLjvAE/K6BiBJAvf7WmQCzEGMeKceL4uaDzhwkMY9TABN",
+	"e1XFIsoEVseczgOSe4REct57zj8zFFnvl5UjHAUCvs8qHJR7v2HyxHq/MaQUPINJgqNruoC2yERyYQgX",
+	"K9wHtgJHLD5EjbvbUFet1pbdyuutvF7MAjbybBMsYANstQWsX6m0hC0WeQqLOKfVVmgTF2To6hToM1Vf",
+	"rjR8+URfsRXh1SxwP1GILpR+UjZ8a64ZsJYKzmDjflRHy2SnR1zsEL5+mvL5y10luNNZ4xJk2xPVRzxR",
+	"nc6e/3FqIpb52HXBskjedLbIqdGzP6I1Yd1GAmg661th4HsdgyZqC7ZnoH/HM9DEitHOUU73POUsfL6N",
+	"bLo95enMNqtL7rHk0pxvXDgz8TQjVx2ZiIeZWPz9Ki9sqg/PHvHwrrCUBx7aVZDe0qMcm7V3i55FTWeb",
+	"chA1nbl98OnM5XhPZ4/vbecM/eU62pYpUE7qVKegcwDMJ1bNucYmbgQCzZkgDCcgcWVUVZzH16srHFSt",
+	"NAdjaaH6mLeyyGiWh6wPdF2JxeoM+OscKMVTF5xnx/2+iEA4toJci5xgWlPbKFQWqnlXWEzyKk12cm1s",
+	"zfwEuTHLV1nMX9oG1JPc775c3dcZqhxXKdgYkdwI0tNSX5jRhnEcIiivCWAWohqsjfO+jXh9PpiuHHjX",
+	"lhbt9Fo0V8Fkv7Xo1SxHTTYZ5XKNtCCuImtH5aDO8iz3x55kiAXCHLk6Msd95YyrV4DKe7diFegGkRkb",
+	"y1jXZsQYsmU96xhDtkxNTqVDylN7854iWzuD0V2Y++FFtyVXNY8vZhrEMdji4cqz4752l1wDctOi0gTk",
+	"yKk0AO07yq+87mtv/4dcXcyyZIrjcCG4L2N5r6SuSPhqE8yLcoGXFxtC/wuKAkE5gvMISIksT8aNrTy/",
+	"0g44V1LSyO7PZ37yWZir4qN4ghmzH6vQiXmrDW7H2B/zAAoFn/Xi6Wddr1AGJabedexxTHn0C048XTbN",
+	"S2JR9UxfgZp6fL6/EInlLxY6XWUgxlYh8mzBFMDra4KuoboXBfNAC5emA/j2USmwJAsIecUtHBl1MQPH",
+	"JEDkR1U19zY2Dyi/+xmLooiq7lkkyoyyMVK3y+MIgVseCho0ro5/dtxXsFpEUV/Y/6aOP6TwcPFFVY3j",
+	"v3XEbOIn+4W65BsTM5tv3iwcKHN+vg2UFYMtZ37ijrNkxp838RPPhKjK4ZacmZgPtuSMEKOuf7/Kqdvf",
+	"r+Sw2SIyvdcyyo2/ZaunLGH4cM8C4fBFt/uoqfEuPD0gyFZLtodft/u+6L4vFJnL1M4mROcyaPkHHWX+",
+	"jEjMoxsQUBxdh04ro5O3KqQtQFX5hTikbWC2KWdl8O41HOUwrLBdqLAbrIkGNs4kzWUz57Ejnz5a7NDh",
+	"PS8rdmg7BouFkkwsYU5QY4In6FLF3ipGOOudnQq6aB4U4TapHbXQROAageK/6mbnj7k1I+qctZzVy+4f",
+	"TdFwNYyntFspwYuEgKrXXawHSHBdaRxJByzrc1WuvwH4C0cJto7GJTeKEv8wErzEebJcgXHBkCQfBNAx",
+	"L9bMYgCvOTO4cCVKuchaC+7SMboQgz2uCap2wAki2ARdTJc9IUWy0g/CIVHuWaeC68dxsGiJC1HYIidU",
+	"5Ff3iMEqnFvCG7G3cfylEEw5+GF+1rdZtoPjcsgDFEVMt3ZoA1HaxqrMkw0k6+B/HsbB7LP5vC2xKqJW",
+	"/C+cjccXJLZO5c0B/uVinh2n074GwF0nhI3LCxSlbhRKMxInKIQM3yBd+YbnVCnDol3oxPVVrPoOJCH0",
+	"0TgOA47HXJRyGMdf6N5XHNy1inlTHXfbigrR83O1xFHU0KjUiyJdhZEauZChs6QkcGSTvorMCnrIah8V",
+	"ShyJV+5J6Nl5gQibyAfnpxeXGc3lcI6DpsjAlb4dJ/0FtePPlYF/DvMojXxJNJjNHiTZZF840R8iQT73",
+	"0CweXsIRg5ua2q04ZTUQGs1YD6ocBFBGUp+lBC35JEOQi7PMfFOSyJs29qZUUMqmnE4qw/YYJnCIBRGW",
+	"gIW0ghlZbEfkBJJjoo+NRAqgjg3pznXcMCinmVEEiT/+w5eFvHLxn9dzNVVDYZEBFkuQHL6ACyiCkphi",
+	"FhOM6KLALXAmVoxBlo0uO0DKz9tLKO2AUyzOUj+nJPwMRKCXAsi0QSbXKAKBn4k04D7rLp7GojOn+AJL",
+	"mJruGbdjvs9WP1JIkG31aZNPxV7dWQeNg2Bz4u7HBAXyXEuZC0olawx1wE8xAdBap8Edf80PMYrYN7IJ",
+	"yOfcPfDP2m6RbXduIQlQIG0UgQ5MjQUILhATzScwAwQJVU/zw/sWmMJAEENwZ5PLIhEGAD4Mw6y7RGlT",
+	"24DKpekN8mHE07XDOLpGBDAUhmoIfkQFCftRxmYpYhwuBTgUZ+o4YigKkDggaMQ4GmltXeuTL6IRR19j",
+	"Nk6HhW4WLysO7a6yf/7hXX27cGNf46qYzlkaWZmAglRSZ5t7l9jPd0+SZj9mVJaM6vesJqty4z7DxHDC",
+	"xE/Mp8Dz+LcyqyXBn9t8Sp0lnIUmCnCp82BFSG1FCZmlWVgF1gcfgbIzYQSEBgKSY/iSbN/FsQjKYvvI",
+	"iT9V+6irKCYhjJAQDoHtC3Gk5Hu2KT8IMzCGFESyEdaCNnmuiXLNAWkpr4s/sHZVLRlTi/OPwjB3wKRl",
+	"VNF7W/B0yFKQ885hi630erQYA2iyhuzdRquYWxJRiUKHgQqjQObHzFcDHdBjtIkWEDY5/5eQTvI97dgJ",
+	"G105dVxikji9Hkv8iHQHI/OO+j0lpHPH8LPiIVLjdqIcoL7sDuqgLv47gEEg228zFVeithlRiizmNq4N",
+	"+JEX+Cyl3x+fO+CDGIAgPlCgRQn9nN9SzMZxylTKDQeiuD41XlmcVp+plz32JjSnlvhYXCOi4l9dl5c+",
+	"nb8vKqFcJPhcU4+hKxYLWbXHpTOKAmH95GtQq+i7RCY/JuDR965dPl1Gxu5rxFkh34L1E0Uxy5SXuyLp",
+	"V1euUt4ANKOIVUMyxIxAMuMy2NOFj7k9oqO9soOgPOv1JGXp/lj57sj1If6ExHyNnjg46u6/Cd68ejHy",
+	"ghc/vPa+h69fehC+OfD2f3j9Bh78cPDmAHVbrutt4kz4Iet/LwYQS+dN2GSjlgRiInMiY1kunq+fsyhF",
+	"oWoNdtTv0Q7v/kSBuNQUxczUbZf3lgrYQNENJnEkkgQPW1lXqFa7xYRp3lIpH638uY1z2bVe9lhKXkcE",
+	"d2HZ1pRKc2FdV6leh364vOwD9bC9eGQzq+HbJMY5wYTEc688Xcp8uDOsa6i743iiwLQ0X1Yfn1vja2Y1",
+	"ZUAlIi+SELN5o1za7xYJbn78sKpgNpoiP+XrPo4jKRiczZ50zXdlr4qrqr7+AobADGOyV+V8eV4SWqOj",
+	"BeTv3C/lctOHDAVX4L/+GzCSovuFJB3z+bE79KaL/5dZLb6VRvkYYrlUHKWIZg3E5SQggRH2aVuUY0eB",
+	"bGjJ8ARxM4KH3qH8ycfETzEDQ4LgF+5kJijqiCYAnh8K9Z6lrBLEN4oqQSTw1Jav8q9sWGxzRYDDPd0x",
+	"jK5FxtEXnFgvc1FMkMxRsuCHVLyYoCB/1mqB1mrLv/jsrXaLv54XIPZT9/HFgvW/j4xCtXwdk2AtAd8Z",
+	"EYQ80YXyC5rtSSVkopa7rureal9cTTGnou0vfyE7+MaR7riVJywwgTPRFbfNsQfBuzjreyYyrcDBq+6E",
+	"tsEBb07yIQYJIp76VJQYyZtyBQvzVbc7cZJqZa7br/n7o7oKOs8AAhP475h4Qrqp70VFdJUupvLCbrpt",
+	"cLO/2wE/8VbrtHgvVb+13+l2uruyYynLqq9zwtK9NSXpokAeML1TbrqqfciDK1yxhjcqOiOAM9MoQ18h",
+	"fwKZz/MyAO8blMEeUcZDLCbTTUcC8ITXPBkU0/wcN2b/sWjJfZcELWRvOe72ViRvWX0pBEFD29QopN5n",
+	"SUaudnayO74RR3oYcAupbvAjBwA7ny6PHb2LyvlIzZoX2ZlNiwIWQsoyj3ZHJ9iKl7Oc9yUC26zpF6QU",
+	"X0dZf1uVPLyD/uRxNRZndjynjd37ebyUOYt8V15okMK6CNTy7gtY+WT32kb1/XLJ687NbOyo31sot5J/",
+	"sE3WLCbtqZiSO3HPTcju1D373b1/ZNlt+Sy+c/UWt7o9voP54PHvmaOjfBBdo1aY/FYd29ahdm9q3nAM",
+	"Ib2G/Difmrxl/CfXi1e5W78GfxQxT55YWE0/RB0bk3ypH1tfTT1xFxMmOPHUdnoZPnXdW6l7ZV19YvXK",
+	"cg5oe83ZEAF3WOJE/Hp3dXdX9JgLaZITKHMISnV1aWeI/40J7AToZo8KuqR7Jdrhwgr7aM/kUD5WOm2V",
+	"OL53Qm1BmCwxhXbLjVtuXBNuXCjJmR9ZbUJ6M4dTvJqBpVkuN2/Gh4+WPHzU7zXNG7YShlUKcWXecKGL",
+	"W10HsMq4Yq59YvMIY7NOYJUZftpJay+z85YLRRfIJ4jV3XheNC+WihFzkPdjyq4JuvjXeyCugPHtG8pC",
+	"cJTexiQo3qg9ePnA+7wSiEcvGHaiF9Z3LmxJVcNMalXR5hZrFk/BjjpWR5FPZgkrAkrT5AWhL3zygv2X",
+	"7YlUb0h3sYSiwrU0AfE8+uOKeJk02AZ4ZLuvOPLDlIeu8ZY8V0aeC9Z5tvd/dZezLrRMchiWerc9s9uW",
+	"ziqI5gaEkrcxXRjXJk+OBxezOBSrb4LRoUA14ZJC7SP5OA+C2a1HMz9KWnBZN5ec5C0N5GPh5H0SPpeD",
+	"1D5eXO71P12CPSkrqAmSdMBnPl1HkNFnHX0miKUkQsGPgCIEqrlKFukRU+9Jf8++w4ARLcSMnw/jzfGw",
+	"973uq8v97uELXRRCeM9lGF1ucuHbeby8OHtW8lqZjZ6EZ4zmziF5/tcmjij9MR1OvAfzmXkX5MJzxAhG",
+	"N676T+9OM+4TvrVhQWVJ8NOYACn7KseVz5aJqrTXlrdWrI/WmK848/OKJOthsD1MC7hjqM0otRQs3Vp0",
+	"62HRubXTY510fVRnujiSJRNFMEmkTNxAMvvR8leV684tOmT5qwEYI4LcR2PLs1E5ks6t2G2x7F0auc5F",
+	"YwZD5ZvKYgZCW9q675Xrrrp+rzIfTb0gL8tQ5KcEs5ksp5SpWYli696LdXeFY9lU/8GU/QiI0vRZPovC",
+	"+nAGsEirjocMqk8ytS5mapxeXJCIripmhgDtaIxoidns9LdOwpfw2VOFu3R2IdMH2ZQn4shMKZFQkqdz",
+	"INrtgJ0oBp85wOgziMkg+pydOn3edV2myqVoFM+/S1bA/TMWLuBE3JvJpSGAPb2jMnm1lTv4d4jw+gyA",
+	"pYDfrGLsRTo0q5NuoRUDKemQXkVo38rf2LGSJ3onMu+OoyQfDvLfjA6GryHy9g9evPRevf7+B+8NHPpe",
+	"gEZd/hP/xYUmkSMqVZQTluxxDiaR2X+CbvoxYTDcu7i82O0Ac0WWs65ozuTxbQp4fTAzKHWWNBhikWp5",
+	"LCrOIuIC5S1W2ZjqnRw8minaYm4YwXDGsE8BI9D/gqPr3bpZ7S2rm9lexhJmpxaf69KgR8eXvV9PLQ1s",
+	"fuh9MP88P/314y+nJ04r1oaxH0Lneuz1igtZ4NOn3omAXVw2k3l8XNYMscmAzdwt92KsMUXnHdf9OchT",
+	"k3JYFFQiZpZ3xUxS4o5mtR/1pTJIecLpWMRSiwHwoWxn5sGhv3/wYjr7ay73St5zwT2PqRsqV4eitLmg",
+	"cUFKe2ozbaNOPxcFUpgjjdRe8zfzIvP449nZ6flx7+i9a+PRNMFkxpOqHIJ2/8B7sX958OLw1ZvDV2+a",
+	"6wlOlB9gcch3cRgskZFyVq157Bg9Tj5G/0pjBs8R9Me5eWSCrxlG/umoJD0mMWMhes8561iTiPlsv9vt",
+	"ms8sisl99imSufOmYBSO+N2HOCX8xBLOWu3WWRzJrPhsXer5nLNFje6rBmS0FPrnA92PB/iXD+ODauAL",
+	"LFAihZxJ1IyS8+zR7Bvl5EnRXWFD1bJMDYfUskMj2m9K3Q3Jud5wu29aZXHPZWi+qexbyi5u6oY0kS8L",
+	"7kA1xxkTeL5humSbcXX2YKu9FMlxLynQhK5WZUAu3Szc0Qdh8vyc38oSaPwRpDwMqMJfnkiFirOYgAgc",
+	"TDFlxT2iu3MdxWXImzmy5qFb5Jo+f1GxhOLjOMGIWnfhYx44QfxeGySz7OqzvnMFRyN1q1XWGOEVnMXQ",
+	"KMgGMVeiR5ggD0YBv690jZguQaJqZ8sKd+paFsETPqEaQxYvBz/HlOlyKDQdyevDYhCPjmEQ34q4GiZZ",
+	"armYO8DUF2VTOuBCRFpnAE2hzwSliOtyvD4692nQyBXfMAmNJYKMQgMiBT4kRFx35v0tZUoKRQpcCchE",
+	"oaZpXCm3WT+blsuljDJEfBQxeJ3vHiFaFBQOg8ybHEBzucjebgmjuYcH9rvdnA+13xXZI/yiWNYFQf6V",
+	"WaYyNiiLPIxcnWFHiKDIR5K+EoJEZRAUGAI7ySqFZDCx2AZEX3r2bg6czOq+yi8+sW/zq7ENZe8I5KO2",
+	"LHMuu1kQ5vAl1e39DIrDVw1v79cz5c+mEleeCt0XPvUBv+KKUv+KqefDCJKZl1K3vqlIKzvlDKJHFe+I",
+	"jeAU0wFH0Uz9Jn5A5UqUGQDu+6tNb4dfFq4DF7QL/1nU9zQp3N9QkF2Qz9qUIHaLkFWwKqtEQ63aDYYh",
+	"oCgyD65jeR0PMvH3N1l1ih/VbSDVhjkv6wLMlzlMxbVAn8RUyrRbhK/HzCJyWQNfT6Kri/AhLUHLERvF",
+	"6mM5/jW+4ddmT6E/NgJSl6k0InVoWu5yGjZ3cQslLAKLz/gyRc4cBZh1wAWMgmE8NThUKSeU49wlJTmG",
+	"XEm1koQ4EH4cf8Gmfv85/4+EdxITlN2dpiLiH8lfUYkO+WP4hasI5KOAS5EfrY1guu0M4i9DdQQjGjgs",
+	"UBXIJjsOp0vqqh1xsaQqy6n3rBrpVGF9zCE2NMTRLBlLNa/gGLsP6L8JAJr5vvZ3Z3zye0qgmOh9rpRF",
+	"C4uh9ZA/ghDcJR1orRUgGTazBAwrSPvKKZbKhyzyi8aRkPJ+OmjYsmyWN2haWTLQVcrtxC2BSraJU/VX",
+	"0VNhew1E87ZYMUyJ8u+7pIWA1iJlrkQplXkCgXPGVw0sNePjV6HMQOXCXXV1SP3EdCPKdwnbUYexDApH",
+	"QNre0ibkVlYcIXVKn4OJhK2ru3b+R06tV3dXJZs95rHHW4KLLdVgKszIotsjruZTMFb1LmxPA1N1jqZu",
+	"aKtmN+USj5/52J9BgELReYhKE5IIKNQHp9FNPNP9kuQTREszplS8busMP0wpQ0QM2QGfJzBKYfiZmxo8",
+	"tZwCPvUEMuxb83FBIwvainp9/HZYoVtbvtiFQo0c2+nyJyRmsR+HeZRyS3i/1XbdptEfAJoIR72MM/7x",
+	"wWe7w6Jwy6/P+8eaduiPooIhl5T8bWG9Y2ZX5vZDBIlov8dn3TsAO+MDf1caFwnBMQFfovg2RME1yi9Z",
+	"gy6gcC75wQ5MY+fkBBPkM8MwfJUsFtSGjBFWcIGUD8ItXk99J7wQfidu7+bgXs5IfZ1guL7Vg+sWY22H",
+	"YzNTwUQWs+VkVb5Sk7akOXOFMQzAEIYw8kUESZZfpSXdzW3xvvPa11tjpctyaPJYOAxNxTgq01lsYa8K",
+	"kag93tXFGTMXPv+6KEoyhjdI/q4nS2Tl1fxdM0M2yyqDtVhN7/kqrTWZefoVb//+LRkr7lhZpXGaNOG7",
+	"VK/X96jT2U2asaXOowW60s4Cx0Serqr76c6NceyUgxhtSQF+PEFUll3U1Lk7T7R4+81CHNWGjLSvHGu0",
+	"MyOqIk8/dB9iz5DQacHU9NVz18PJNrrOILqsqrGkHhREzMiOQFkWb6kLbYR8x6DH8gEfSYunfCmmNhi0",
+	"XtFBS/yXV1YatPK7/YoWq8oF3+0MBh3+393/2ZnQ/9D/TP4z3v1HMx3yKwxxIOY3dcXySxE5fOWF/MR/",
+	"ln7ACOJQJuKpkXLwinRAffffmWBKqYmH1lzJQxw8oN+2ZziWg8sSjZRBomIre9J/EyU0uZRWvzbDy29o",
+	"eJEOF6riYj7Z1nEpposr1FTVjlB1VdENX3y5cMQYRhEKCyUfLj691a2IDluY0hQVCjrkXkjSMPzDsCtf",
+	"mlWOIjd9dT2Kd5j9nA7B6Y3qJPRYFUIc2HlAeZASlS7xVsDfYpv/LqUnss3MzWzv8aPdFvgNDcdx/OWo",
+	"31tq/Qm1ljA8lrTXr6zD2i/WX+XeJO+foIi2rYsIy0CwoDHZpLBkG8TRH0qL/RGgEPNs+AbziXiGel+e",
+	"e6hB1MGvPKvmaf68aDo3A6VZKW2K8QT6Hi8Z56mvllEA1loIQT7Czkt67oVk0ItogvzY6qCWDkNMx/wc",
+	"AUNwKze/tBbIUoK8zOxYzpLs5ILmy5Fn6tk2qPN4RR4adOVRe9w1Xw60afQgeNPIgligf7UwV1taigPP",
+	"qqxB9cA+BpPlKBW4bdWKWofjjugs8rlU04QmJ+y4THWGIuZuLnksHwpONsfQasAEzrhnlDNFrQSnvX9T",
+	"d0QpjnqRINrcWnWEThYkLYXojpTPAHloTu2h5pLAgBTEyDqTkqwkodTtPjzwjZzhm1z9XpaSSJzOInV8",
+	"n2fDNvgmQDDwZLWLb1QpVZpDhyjXK09YsGmqozbnGwqs7wGLE+x3LFVjLdq8ldc65g1HuV65DQvV6813",
+	"dCzsaBsg2QwJ5jqPiVsA4CiBPievGxJrnAYiQsMJL47Ubz9Jr9tB7bnnZTWsqVYNLb13DaeCr5MDC1MA",
+	"KU0nxYYLDkipyLGyafQmCjpQvNeBNyT+jtNsp5Ki5SjipR+VGfPfAYEj5nW/b1DrSO7T1TwRsKASNuZV",
+	"JgmeTBWb2JgCZR117CpAXKLOtGHTKogMob+m6nKZqKxRjmGcBsohKeuo7CHY73RNbLsD+kZBSJ9H9v4I",
+	"b+GMZtWwcZQVIQ/UBSF+6gmUYhRXDemPcqVqbF1JX57CWYMjdc+Txdm2ElpmPD5mtbblT7moKrOamqow",
+	"vGyJRRFra5KnlnLUCZA2niBTaT8KJ7qcMowADtpABl3aUu3rpJwOOM0WqmCS+FMIE2CX0huUipPvCMdd",
+	"Izuv4cwLzYJTtjtUPvpxejQNYlfl73iec840q+3DMn+Kgp1358h79DT7T0o2H+Wl86Vc1y9xz19lWH1B",
+	"MxkEhCGNecUu1RqY75GfX1cHnJUpJWeyy25p1txi35W3wUnlGuJIXBenBSOr49L7fnk/HoxEe5OqsagK",
+	"+efD2PPQyK2K3DPBBgIZiMoh3d1IINOfudGQl2QNlmp9cGeFc6qP5/S5HDejlEG6UwoNt3mmHiMQy6T/",
+	"ENLCocreLYLcCCxUYKs9uBoM9qx2hIOBNxjsXX37O//96h/VleknKGIXWa117QlYkb2GBdg98I38DQW2",
+	"eR8T8E1Wb/2bTFzyHbOftLMGf9ximMTGKRd4JFmSZvFYpC2+4u2h2uoMSxMIUVxItPGRxEz2tQQEZSvo",
+	"LK9M/CJ1/jjUj13kr1js9v5Ho3UlKKvrmBSaZSjfQtZXtltRiDBzsRmFOmhyz8mPMF0GirioY7p+irf2",
+	"ZGsxareVLFsJqojw3OHUaJK+SjnFBX8Gd9RfPFTaqkRvZzDwKpBLZb7twqDRfJ5uDi71zHs4fMXuStDZ",
+	"kry+HKgJpmp56xxA1/0QnqjJn8C+zkeR+fkin46K5aoh+IG1PIzA0SjWQRgoz0tVJP+3i48Hgj31lSBw",
+	"ieCkrOlEQ0j+HkewOAZTjdvyAorqWiPlcVXTF5ltpBqFtxydYM744EiIW0mshsda3c4beZaherm2Dlsv",
+	"Ot3OC7l7Y4GZPZ8TtjgGkKi6Rs78QV0EhQeXJTldvr8A9sfATwlBEb8Uw62OrLeM9ZK8YN8ZRJfiakvu",
+	"c0iUdT2SzWxU6z+ej3WRy6xRJ9Wq5KBJ3O8FKlXi2F5RVllcrO6g27Wia63Dr7lIgoiNaQqZe1pgzZO7",
+	"qCxIyJ3BkUP2XZsfpS8NHHFQXgdEL+IMC0Nd1xbJHBtxdW4ygWSmAbU22c/jksFrfhDWspZuESBnx6kn",
+	"uIqHZT0Si4T+31swmIgbnqpaO4+iifZylLnyPoXNCkGEbos0Bnb6p2cq+rSrg3maUUSrJPtlTDUhBrMI",
+	"TlSLZO13ESQMLx2Y1aOUKErCYy241dbF79/GwazB9lnHmBZ4rcOWx//39vRd7wM4Pj2/7P3UOz66PBW/",
+	"DqKzXu/kfy+Pj4++/HZ9dNt7e3Td++fRL++7n959Nzn/hf377Kj77vjiz3cXveGLk3+dvj2+/XR0dvpp",
+	"evzX0T/fXn/4dRB1Op1BJEY7/XDimCE7n5zMPLnfng+zaOAC9C+RZBIM8mJcnOOX+HB/FXxYR/42zaaJ",
+	"ogxVQonXMhKhj5ePy5DCb8sRrSTutZQNOc70cwyxRLlw187rpD2CdCDdLTDORLYxd/QIvr5GsheYgDQe",
+	"SVFmaxlzmDfCIaIzKut9sbheCJyjghB4sGIpdhQwZy5WApENt1ySCoVdnFyYtlE5Cq6tWNKgcFi7xWIG",
+	"w7czhmhV2bYhf6hxq4AqqAkz08HB/qs3b5y5dUW7rY5freUXGXbtuMSQoyLCZWpQB3eI5i1ip0LkbovG",
+	"f+dRE1vIaCbI607R8VLEWFT85CF6U06c15tZF0qRTVNA7ol2+mxQWQzU0nLZhq+66IeX3a6HDt4MvZf7",
+	"wUsPfr//2nv58vXrV69evuzKLFPMx1XdLHSuTtAq6iZb3xWdlqulsrmspLDwMuqSE53iQqFsxcJiQSY2",
+	"QJV17svHY2EboCjmmcRpFKylIHFx7nIESBhOvITENzhAxGNokoS1zp/wCd6/PwP6G2C+AQRdY8r0iYMl",
+	"ENomMT2ccV0r3xnOZETX6be9f3/WVzNcGqDmCI2fxMh8XA0TUDGD8u3RjwmKjnpaLPyZInGuoasD5yIN",
+	"jyUQ/FJ9rhfOuqcL6nB7Sxud/TlQ3+QgsNrRdZPLeru8FTBnLMdf0GgCGk+LMF+Vz3sUaLPaCUPJ0z3K",
+	"HumzD1nGRleBFIJfFmNFU/4jn8iUPVWlI3OTlVlS1vBzUcaiDnCzzXTMlDmUuSJNezM4CZc08KN6qk42",
+	"czCRkwh0G+L1cFnzl3GzbEd17WJXQvbmERV7HI1C7DPgZawpDtVEaSB5VhgSBIOZrNi0nsJIMl2dMFim",
+	"PKo2Bhr7FVGFyCq5GBUOglu+1Op8dflPHJD79h1A5T7YYtPhO4hgON4A78AA2sz+d++D0+h+DMt/AXAe",
+	"2wdwg7YZ3kC0eqnQdrsB7xCrZndeDJ9R0Dsp8/k75LLs3856wb0ZXZ/OVqFiLZl9ccNgyUbPIlzKIA7p",
+	"ljEbMCZni2qeCJbsPqTOEzPRjw1GWSlKN0B5D9111BXAlWtkGYpaKZP+jXyT7nr4Js744pr7Jlu5Nue0",
+	"r5lUWaU/skBM8r6hyLbOOGsDlVTUBtIWFrUVRfLY3HDlAmHKHA7nhCoNMh8Ys2w3BMe6A1xMuHNNn73+",
+	"8KkV7veU8M/m38srhwIIWQGHe4FQSFVN8zXirCxP9+zmm2zyucmi994bToillEGJHJWR59wj9dnTBbQP",
+	"XAHtHIMvGqHONeRcQa+sZlHtDQpmV8awl5y6VRXGLkWvzSMdvRZV82LAKYRAX2XDK2eTylZlbat7qskG",
+	"NEWu2oCDjSKmcN8WN4ko1VeC8vnfDYLdqw9yO5uXL82arBi93jTBEfh/j87ec8Un7nKO9H3RJwmRF/h8",
+	"Duw6PC4vhEiBu42Vz42VG1lQjJVHgcnF3+S4+YNFn8MqvW9w/B4x8Yaed9nlLuAgU4S8Gosn7QYvKdiX",
+	"axwMrwD7HqHx9YiIr18gfBPj30vg7gWi3Y2D3AsEt58D595Tn6/C0mnAd2sQ2t6wiPZwZpHp8n2J+8S0",
+	"Fw5lbxo7/g1cj08qaFzA8JOEvBcTIusb7t7KtXtHtFfmKeypWmVzotm6OAB/05WhN1fmFYLSR/3eL3zS",
+	"ZoJPNihwCb1cwzsN3OYbJhI9TS9u6o3Z8le93cCJJyzgzEXMS7Ai/Dii6aQ2IPkORYhkcQEF0L2YqxQg",
+	"lPSzFO661mDyhwrAjTY1JG4EypZmYFSN+agJvEUgqhlG09omZu2uhXh7moDoTpDKSSQjil5k8pE8d+AW",
+	"xO76R0BrJN1yJe8ci2fvK0zwL0gcUddGTM/RTfxF2GYK9A74GPkIEPF70AaYAR+KRoZhHPFbvkNVLYLF",
+	"9tFP1gTPdYmXj7V8Ef44orpd1zhM77cw1fgqczCZ9G61LW54sp1aMxuN75vfWOAqitkK3AYCNyaGctbb",
+	"tCyJh0exKesDUxoSVZFPFUyRXSVxRBlSFQhSFnvKwpPFeFGDcNWzFE2O1M/Vi6ZVWbf51uvLsG2LIz5q",
+	"+ufilu1aBcHUPm+OiN2at/cN3q2lbbtHkPbiqyvVnJt3ckHIh4QlsiGfv11rEPw8FIjZuiWHSNzjrrky",
+	"ITHbhkmen9Vu5N1TCO0pbljUhL/4RNcHBIyLXh6YzkA+9f/pLg5MZ09za2A6W8srA2txYWA6k3T3nG4L",
+	"aF5e4K7AdPbkFwWmeENq3igxVJDD09nKbwhMZ+7rAdPZIncDsoTvoujO7gzk7wcscB1gOlvpXYACmS4z",
+	"G6dy6Cr7YjpbnysAJfatg3qb/H/f5P/p7Blm/k9nyxRmBZNy8ez/6WzB1P/p7KHpimKE4g17Tz/YjMo3",
+	"BtyFkvyF5njaDP8qEJ7Ia5zONi23f7n82yjDfzprlN4/nS0jt3/dufM+2nnp5so8BnvSPP615ykriV+S",
+	"dlqkySXb+4tl8UtLs3EK/4YoxGftIxTS9aezEn7unkDs1DDoNkt/46RWncBYtUn/8DT9BkLNivzOlpCg",
+	"P53Nz87fKOtis7LyN8IKaJCS/3DmWlYyfgMWysfmHn7WLXlobg7+plgM29z7be79g4TYNjNp6Yn3S5Wv",
+	"tbbL2ibcL0dSr1YiPyzFfjrb5tdvhWomVJ9Ncv2yrcOnSat/TgLInUi/SgG0zaLfZtGvmyDdGqrLTaF/",
+	"Iit1+anzDYIIxbz552WeVmXKb6KG2KbJb9Pkn7XxPSdHfulSeeInzbLjz477/aUnx8dE5U27z0ayOZtn",
+	"xZ8d9/NZ8eV6+mfyrb4ti5efE58B8rg58dm81Tnx6AaRGRvzsZ5nXvyqM9NfuTLTJ37SXzA5XVH4Eyan",
+	"Wzy21rnpOVmgJaBh49WlpusdKmamV5xE6ddXlCXupJflGEJzhn7U050KtiiTkNmdbT/UpmneGc88o1Rv",
+	"i+2WJhsK5tECmd6GKpsmelvgP6i1WrZm0+20M8gbHpnq9/jibDtkjXPA3VA3SwU3u/FkmeD1EDy2X2Sg",
+	"2Yw88JXwdn0WuMFQfRK4fu1B3UuLnLsp/Hof9b1082QOsz1NUviG8Ben9RyhB0s2rBvmgBsYmqWAr0RV",
+	"ykD9o7Le38w36D6hb7DtR/oc5FWN6Fi21U8QZfxsZE5I9BxRdtTvPWJAVM/YPBzKw8iVgdBzBMVteLGa",
+	"o35vdcFQDsbjhkH5jNUBUCJX7oWYsmfbTXS5Lpnmh0ZxTUWorkhmw2DqygKehofWOtxpcboWbfwnQdYr",
+	"i3WqSRuGOtXbK4p0qtGXY7+UBnvUaKZhhjJNaIxvw5dNw5ccW88ocJkx0bLYPGfANA5aGt5vGrLMAH+Q",
+	"G6bEjTtWaWtpkauyIdHKKribxSv1TjxZuLIWgMf2TjQwGxKsXD4/14UqDdfWByrVWw+KU/I8FMWwm8Om",
+	"zbTyEiyLejZ6mjjkZnAOp2ObioPlWrwNg5AagmYxyOXqPnfwccVM9QwN9u5jGuzbmOIzkD3VgmCl9vi9",
+	"a0s0FlP8+8UKSswTUqaqhLoRLyB6FnbAhhSZ2BxtXldi4uGs9cDaElUsBC4lXQNMAQQvDrzhjCFAYBSY",
+	"+4Yo8uNAhvjHaAoD5OMJDNsgIWiEpyiQYYnPMMHJH5874BNFhoF+QTNZX3YG4shmKyWqEcCRH0+4ANIX",
+	"qOVobIypuI9dEYNb6J7KPB53Vb3YdKtkWwBjWwDjOQnYuvoSSxWuNWbLGpaVWKoclOA9iRRcrOjEPLC2",
+	"1Se2Em3tJVpJSCzVQHzs8hJLE0RrJ3JkxONJRM623sS23sTjik6OoI25NVwpz7iNmN3/D6Rge3wTcWk1",
+	"HWqd94SgGxynVHvx2jiAESetJIQ+CmzELMHHrykk8Xwc88ULTTwrHbGtOLGtOPHcDO6qIhNLDyBQ5BPE",
+	"qs85zvWpAjQRY37qQVlMOJXJrzvgHLGURFT9YMlJGSWNUzaIuDSCPkthqF8TEl1GninyU4LZDCQpSWKK",
+	"qDxtLR+aXCiAV8h1coqm5w0KB+b8xcV7+49HX58ivu8xwX+hAHjFNmpGdK11ai01e6wpXe16c0KvPnu4",
+	"4KRLlYmhCBFFPpklXG5CBgiiTBos6mnvBExSykToS5gDnUHEHysvlFqfp5SbREwYO5gvSz/jyDcdYYdo",
+	"FBMEEkQopgxFPnJRuwwkypWvKIVXDr6C60i1Ay8pCq/sF/GFipzzf2b0dGH4UEbW5V0FsWtyTPyrusFw",
+	"2LpWhiq3fpIQslFMJh3eRJu339y72YdhMob7rXbrC4745phtmSAGA8gERvRtDMjgEFLkJZDS25gIbqMJ",
+	"8svE2I8puybo4l/vwQTiCOhPgfm0nbvccdg60W/07cFNgqFCxBFrHbYOugevve6+1311ud89fNE97Hb/",
+	"r9UWuZAOGNst5WtWf3sn9u4BFCD3WBK29IlcskJ+uh6nIW9h5vZ6YIKpYPCYAKxsnBFGYUDXWMw/VRq4",
+	"Ep7ZIWnvZC1zv4Fny2hpmNYd6VDN+Q/QTZblNTf/u4/IBPKFhro6AVdeCrsmF1zzM1dcmMoz8jEkgfpE",
+	"bMMgimJAkB/zW7NggvwxjDCdSF1ndA//FgdoksR8R4AnR+BUD0EUR57YOxSxQaRgIMr2e9l96VJjMvHW",
+	"UmNlq83J/q7cZrATxUDRyu5a89zLBRVYFDNPOiR5FaZwESMqfBaBfFuJmfz0ltqNvM+V+TmZkuBz/SF/",
+	"XECez8XORf3868LrRsNyTk8JqkoTXwabt+t9Kqr63wrhkzF1zvY0NmaASjbmIHIZl/6YGxLKxBwiHF0r",
+	"DkVBB/Sk+6ZfpgILgMWDSI0PmJm7DSB41e0qzGFqhtExOuGkYh8oGnQx/zvEajl/AQ5RcqDSxFP+Fwyf",
+	"o41nltSiafKC0Bc+ecH+a/NMP036QY0EyRxpiz02x61+1HjWpghdVG9gWVGm5cjdJjH9Uqwqi4lLfor5",
+	"P6d5gcM5lCbipKJ3YrFlQuKgEww7nMM7OZmAZZA9J7XEb/kBHALlbklZezVH7DR3lGOb7NLYFdBJhWT+",
+	"zEU8BlEW8vBTQlDE6kIfbYAiOAxVg/94AhnXH/haUu4gYjGfBxGZkhqkJCvSTjvgYxhY4TYhTLk/AYch",
+	"AjcYqriLrQddOkmu/O8ZV1lU6Sq9UKl0TWeLbVRlUdW6f/jy1RNEVdYioWBuVEWS01bJb5KSnxdF0UkQ",
+	"y4ugpEMDFxcvUYPrOvY3QHwD4A3EodAhTS7tXFgD9MWcqzyJKkzW+EyqtMr1PfBxwLr6iiomoleaHbAx",
+	"5MGnEY4QBeIMNsQTzKSzDoXQBEycbI5U/pE9Bq26B1LcylVZHoVpdCGYJ7kBUQSmVsiVNkKf6Tyhcnqy",
+	"+Pl632woMc2SL2OWBfveV/6fXsNKKWWmblozxcGlBVfS4ZFJ0B6Yp//SEQgvLUPFxB/dAvmwGaU9VkmX",
+	"NUU+xPmLLCEh8mMc9Fdf/ePpqK67JrL+qSpwfFj7u7oV1NQ7WSptN63CUYalWT2OR6Xw1VtVpUsEd2vL",
+	"WTqCs+Usty/6iKbMHPc092rTMrVH/V4bWMicW6D2IgfQQlVqeydgxyqa2jvhc8nWirsVRVJhggUH1yav",
+	"uz80S7rfADXlWY+OL3u/nrbard4H88/z018//nJ6sooirU15+z7O/Yb49Y/h0itUDoXCshAgbio3rstS",
+	"dtYfwVFfGye9sWr5O/vmPLfNxsUmFTSlecJemabb+2r/eS+//T4ueyOzMg/Zit32p/LYc0BEm+e+r4Pn",
+	"3txpf3y66z6t/H8qf32DyNrhvK+J3764y/4o9L1aG+vJXPbG5PxUnvoG8ZTTbV+yHXOLhjQdNmgu8xsa",
+	"XqTDx20vk83Z3HWX39T3mfkNQTZGxHp3dZ1mLHget+GMNXF135lbiQkP3XDy3XaeWX7nGUPD69h7xmKw",
+	"tb4imxMEWvxZBL6yDjRm4oY9aMz7K4qjmPGXk2DpGO5RozEWc5QpJMP9thtN01CNxRPPqCmNzVXL4/6C",
+	"+dO8NU1GmE2jNvYCHlSAx1p1ZZcardOztW1Aixon0M3602Tb8WQdauaA8Ng+TgbOhsTCVsPgtZ1qMhzV",
+	"B77Me0vpVpMtakO4tqn2XooVMo+1niYKtyncxOk6T9XBsq3lhvG3DIpmwbcVqUd3I5tVMtozNfi7j23w",
+	"b7vZPAuJVCcaVm7K37+rjQVPkzsyZknLaG+Tl2DOLjebbjZsSIMbayc2ucdNPsj9MJZ7aK+basba0HY3",
+	"Nusvl/NdJXY32IzZtr3Ztr15mNh9mojqTpDKSSQTxgT46lFWqmF3wxrzrEgj1Npga9iiZzWy+zFk9GJN",
+	"eZwQbbvxbAVtxvAb01miJB1WbeM+drue5y+UTA2dRxRK23492349aydctwbtQ7sKrYc1u7xuQnPCI+vV",
+	"UOjvYD6bfX0W2mrbOWjbOeh5OwfuPkKr0hB8ctXHR8g88dlRysatw9+vOCtLWF0C8X3swxCoEywxcbuV",
+	"krB12Bozlhzu7YX8hXFM2eGb7psuVzx7EwPl3k2386ZVlmMnsf8Fkb1f0iEikaicn6VeFydQtSo9vn0k",
+	"DkNEama6MmgrVRY7/3SSFdOXRw76WgLNxKHrpsJdu8lgZ8f9PomnGFmjnR33Af9xVj+cfKi9ssv3F8BH",
+	"hCseX5SC5aP/fHnZvwBpQhlBcMLzI+VjmXavpjvOvloc/vfvz0BfF2m9RJMk5MPkGN5amfvth03aaK77",
+	"TjGdzRt/Olt88KzrlRrLUTLx7uru/x8AFyXXLFsiAgA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -51,8 +51,10 @@ type CertStore struct {
 	certsDir       string
 	systemCertPath string
 	combinedCerts  []byte
+	customCerts    []byte
 	db             storage.Storage
-	mu             sync.RWMutex // Protects combinedCerts from concurrent access
+	mu             sync.RWMutex // Protects combinedCerts, customCerts and onLoad from concurrent access
+	onLoad         func(customCerts []byte)
 }
 
 // NewCertStore creates a new certificate store
@@ -83,10 +85,10 @@ func (cs *CertStore) LoadCertificates() ([]byte, error) {
 	}
 
 	// Load custom certificates from database (primary and only source for custom certs)
-	dbCerts, count, err := cs.loadDatabaseCertificates()
-	if err != nil {
+	dbCerts, count, dbErr := cs.loadDatabaseCertificates()
+	if dbErr != nil {
 		cs.logger.Warn("Failed to load certificates from database",
-			slog.Any("error", err))
+			slog.Any("error", dbErr))
 	} else if count > 0 {
 		certBuffer.Write(dbCerts)
 		loadedCount += count
@@ -120,8 +122,18 @@ func (cs *CertStore) LoadCertificates() ([]byte, error) {
 
 	cs.mu.Lock()
 	cs.combinedCerts = certBuffer.Bytes()
+	// Keep the previous custom certificates when the database could not be read
+	var onLoad func([]byte)
+	if dbErr == nil {
+		cs.customCerts = dbCerts
+		onLoad = cs.onLoad
+	}
 	cs.mu.Unlock()
 
+	if onLoad != nil {
+		onLoad(dbCerts)
+	}
+
 	cs.logger.Info("Certificate trust store initialized",
 		slog.Int("custom_certs", loadedCount),
 		slog.Int("total_bytes", len(certBuffer.Bytes())))
@@ -303,6 +315,21 @@ func (cs *CertStore) GetCombinedCertificates() []byte {
 	return result
 }
 
+// GetCustomCertificates returns the custom (non-system) certificates of the last load
+func (cs *CertStore) GetCustomCertificates() []byte {
+	cs.mu.RLock()
+	defer cs.mu.RUnlock()
+	return bytes.Clone(cs.customCerts)
+}
+
+// SetLoadListener registers fn to be called with the custom certificates each time the
+// trust store is (re)loaded, so that components other than the router can trust them
+func (cs *CertStore) SetLoadListener(fn func(customCerts []byte)) {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	cs.onLoad = fn
+}
+
 // GetCertsDir returns the custom certificates directory path
 func (cs *CertStore) GetCertsDir() string {
 	return cs.certsDir
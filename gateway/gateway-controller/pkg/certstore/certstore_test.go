@@ -104,6 +104,14 @@ func TestCertStore_GetCombinedCertificates_BeforeLoad(t *testing.T) {
 	assert.Nil(t, cs.GetCombinedCertificates())
 }
 
+func TestCertStore_GetCustomCertificates_BeforeLoad(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	cs := NewCertStore(logger, nil, "", "")
+
+	// Should return nil before LoadCertificates is called
+	assert.Nil(t, cs.GetCustomCertificates())
+}
+
 func TestCertStore_ValidateCertificateData(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
 	cs := NewCertStore(logger, nil, "", "")
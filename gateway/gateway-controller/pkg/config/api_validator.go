@@ -19,13 +19,16 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/hamba/avro/v2"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 // APIValidator validates API configurations using rule-based validation
@@ -414,6 +417,249 @@ func (v *APIValidator) validateRestData(spec *api.APIConfigData) []ValidationErr
 	// Validate operations
 	errors = append(errors, v.validateOperations(spec.Operations)...)
 
+	// Validate traffic mirrors on upstream definitions and operations
+	errors = append(errors, v.validateMirrors(spec)...)
+
+	// Validate traffic splits on operations
+	for i, op := range spec.Operations {
+		errors = append(errors, v.validateTrafficSplit(fmt.Sprintf("spec.operations[%d].trafficSplit", i), op.TrafficSplit, spec.UpstreamDefinitions)...)
+	}
+
+	return errors
+}
+
+// validateMirrors validates the traffic mirror settings of upstream definitions and operations
+func (v *APIValidator) validateMirrors(spec *api.APIConfigData) []ValidationError {
+	var errors []ValidationError
+
+	if spec.UpstreamDefinitions != nil {
+		for i, def := range *spec.UpstreamDefinitions {
+			field := fmt.Sprintf("spec.upstreamDefinitions[%d].mirror", i)
+			errors = append(errors, v.validateMirror(field, def.Mirror, spec.UpstreamDefinitions)...)
+			if def.Mirror != nil && def.Mirror.Ref != nil && strings.TrimSpace(*def.Mirror.Ref) == def.Name {
+				errors = append(errors, ValidationError{
+					Field:   field + ".ref",
+					Message: "Upstream definition cannot mirror to itself",
+				})
+			}
+		}
+	}
+
+	for i, op := range spec.Operations {
+		errors = append(errors, v.validateMirror(fmt.Sprintf("spec.operations[%d].mirror", i), op.Mirror, spec.UpstreamDefinitions)...)
+	}
+
+	return errors
+}
+
+// validateMirror validates a single traffic mirror configuration
+func (v *APIValidator) validateMirror(field string, mirror *api.TrafficMirror, upstreamDefinitions *[]api.UpstreamDefinition) []ValidationError {
+	var errors []ValidationError
+	if mirror == nil {
+		return errors
+	}
+
+	hasURL := mirror.Url != nil && strings.TrimSpace(*mirror.Url) != ""
+	hasRef := mirror.Ref != nil && strings.TrimSpace(*mirror.Ref) != ""
+	switch {
+	case hasURL && hasRef:
+		errors = append(errors, ValidationError{
+			Field:   field,
+			Message: "Specify exactly one of 'url' or 'ref'",
+		})
+	case !hasURL && !hasRef:
+		errors = append(errors, ValidationError{
+			Field:   field,
+			Message: "Must specify either 'url' or 'ref'",
+		})
+	case hasURL:
+		parsedURL, err := url.Parse(strings.TrimSpace(*mirror.Url))
+		if err != nil {
+			errors = append(errors, ValidationError{
+				Field:   field + ".url",
+				Message: fmt.Sprintf("Invalid URL format: %v", err),
+			})
+			break
+		}
+		if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+			errors = append(errors, ValidationError{
+				Field:   field + ".url",
+				Message: "Mirror URL must use http or https scheme",
+			})
+		}
+		if parsedURL.Host == "" {
+			errors = append(errors, ValidationError{
+				Field:   field + ".url",
+				Message: "Mirror URL must include a host",
+			})
+		}
+		if parsedURL.Path != "" && parsedURL.Path != "/" {
+			errors = append(errors, ValidationError{
+				Field:   field + ".url",
+				Message: "Mirror URL must not include a path; mirrored requests use the primary request path",
+			})
+		}
+	case hasRef:
+		refName := strings.TrimSpace(*mirror.Ref)
+		found := false
+		if upstreamDefinitions != nil {
+			for _, def := range *upstreamDefinitions {
+				if def.Name == refName {
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			errors = append(errors, ValidationError{
+				Field:   field + ".ref",
+				Message: fmt.Sprintf("Referenced upstream definition '%s' not found in upstreamDefinitions", refName),
+			})
+		}
+	}
+
+	if mirror.Percentage != nil && (*mirror.Percentage < 0 || *mirror.Percentage > 100) {
+		errors = append(errors, ValidationError{
+			Field:   field + ".percentage",
+			Message: "Mirror percentage must be between 0 and 100",
+		})
+	}
+
+	if mirror.Headers != nil {
+		for j, header := range *mirror.Headers {
+			if strings.TrimSpace(header.Name) == "" {
+				errors = append(errors, ValidationError{
+					Field:   fmt.Sprintf("%s.headers[%d].name", field, j),
+					Message: "Header name is required",
+				})
+			} else if strings.HasPrefix(header.Name, ":") {
+				errors = append(errors, ValidationError{
+					Field:   fmt.Sprintf("%s.headers[%d].name", field, j),
+					Message: "Pseudo-headers cannot be used to filter mirrored requests",
+				})
+			}
+		}
+	}
+
+	return errors
+}
+
+// validateTrafficSplit validates the traffic split of a single operation
+func (v *APIValidator) validateTrafficSplit(field string, split *api.TrafficSplit, upstreamDefinitions *[]api.UpstreamDefinition) []ValidationError {
+	var errors []ValidationError
+	if split == nil {
+		return errors
+	}
+
+	definitionExists := func(name string) bool {
+		if upstreamDefinitions == nil {
+			return false
+		}
+		for _, def := range *upstreamDefinitions {
+			if def.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	hasRules := split.Rules != nil && len(*split.Rules) > 0
+	hasWeights := split.Weights != nil && len(*split.Weights) > 0
+	if !hasRules && !hasWeights {
+		errors = append(errors, ValidationError{
+			Field:   field,
+			Message: "Traffic split must specify at least one rule or weight",
+		})
+		return errors
+	}
+
+	if hasRules {
+		for j, rule := range *split.Rules {
+			ruleField := fmt.Sprintf("%s.rules[%d]", field, j)
+			if !definitionExists(strings.TrimSpace(rule.Upstream)) {
+				errors = append(errors, ValidationError{
+					Field:   ruleField + ".upstream",
+					Message: fmt.Sprintf("Referenced upstream definition '%s' not found in upstreamDefinitions", rule.Upstream),
+				})
+			}
+			conditions := 0
+			if rule.Headers != nil {
+				for k, header := range *rule.Headers {
+					conditions++
+					if strings.TrimSpace(header.Name) == "" {
+						errors = append(errors, ValidationError{
+							Field:   fmt.Sprintf("%s.headers[%d].name", ruleField, k),
+							Message: "Header name is required",
+						})
+					} else if strings.HasPrefix(header.Name, ":") {
+						errors = append(errors, ValidationError{
+							Field:   fmt.Sprintf("%s.headers[%d].name", ruleField, k),
+							Message: "Pseudo-headers cannot be used in traffic split rules",
+						})
+					}
+				}
+			}
+			if rule.Cookies != nil {
+				for k, cookie := range *rule.Cookies {
+					conditions++
+					if strings.TrimSpace(cookie.Name) == "" || strings.ContainsAny(cookie.Name, "=; \t") {
+						errors = append(errors, ValidationError{
+							Field:   fmt.Sprintf("%s.cookies[%d].name", ruleField, k),
+							Message: "Cookie name is required and must not contain '=', ';' or whitespace",
+						})
+					}
+					if cookie.Value != nil && strings.ContainsAny(*cookie.Value, "; ") {
+						errors = append(errors, ValidationError{
+							Field:   fmt.Sprintf("%s.cookies[%d].value", ruleField, k),
+							Message: "Cookie value must not contain ';' or spaces",
+						})
+					}
+				}
+			}
+			if conditions == 0 {
+				errors = append(errors, ValidationError{
+					Field:   ruleField,
+					Message: "Traffic split rule must match at least one header or cookie",
+				})
+			}
+		}
+	}
+
+	if hasWeights {
+		total := 0
+		seen := make(map[string]bool)
+		for j, weight := range *split.Weights {
+			weightField := fmt.Sprintf("%s.weights[%d]", field, j)
+			name := strings.TrimSpace(weight.Upstream)
+			if !definitionExists(name) {
+				errors = append(errors, ValidationError{
+					Field:   weightField + ".upstream",
+					Message: fmt.Sprintf("Referenced upstream definition '%s' not found in upstreamDefinitions", weight.Upstream),
+				})
+			} else if seen[name] {
+				errors = append(errors, ValidationError{
+					Field:   weightField + ".upstream",
+					Message: fmt.Sprintf("Upstream definition '%s' is weighted more than once", name),
+				})
+			}
+			seen[name] = true
+			if weight.Weight < 0 || weight.Weight > 100 {
+				errors = append(errors, ValidationError{
+					Field:   weightField + ".weight",
+					Message: "Weight must be between 0 and 100",
+				})
+				continue
+			}
+			total += weight.Weight
+		}
+		if total == 0 {
+			errors = append(errors, ValidationError{
+				Field:   field + ".weights",
+				Message: "At least one weight must be greater than 0",
+			})
+		}
+	}
+
 	return errors
 }
 
@@ -462,9 +708,109 @@ func (v *APIValidator) validateAsyncData(spec *api.WebhookAPIData) []ValidationE
 	}
 	errors = append(errors, v.validateChannelPolicies(channelPolicies)...)
 
+	// Validate channel message definitions
+	if spec.ChannelMessages != nil {
+		errors = append(errors, v.validateChannelMessages(*spec.ChannelMessages, channelPolicies)...)
+	}
+
+	// Validate CloudEvents settings
+	if spec.CloudEvents != nil && spec.CloudEvents.Mode != nil {
+		switch *spec.CloudEvents.Mode {
+		case api.WebSubCloudEventsModeBinary, api.WebSubCloudEventsModeStructured:
+		default:
+			errors = append(errors, ValidationError{
+				Field:   "spec.cloudEvents.mode",
+				Message: "CloudEvents mode must be 'binary' or 'structured'",
+			})
+		}
+	}
+
 	return errors
 }
 
+// validateChannelMessages validates the channelMessages map configuration.
+// Every message must belong to a declared channel and carry a compilable payload schema.
+func (v *APIValidator) validateChannelMessages(channelMessages map[string]api.WebSubChannelMessage, channelPolicies map[string]api.WebSubChannelPolicies) []ValidationError {
+	var errors []ValidationError
+
+	for chName, msg := range channelMessages {
+		field := fmt.Sprintf("spec.channelMessages.%s", chName)
+
+		if _, ok := channelPolicies[chName]; !ok {
+			errors = append(errors, ValidationError{
+				Field:   field,
+				Message: "Channel is not declared in channelPolicies",
+			})
+		}
+
+		if msg.OnInvalidMessage != nil {
+			switch *msg.OnInvalidMessage {
+			case api.WebSubChannelMessageOnInvalidMessageReject, api.WebSubChannelMessageOnInvalidMessageDeadLetter:
+			default:
+				errors = append(errors, ValidationError{
+					Field:   field + ".onInvalidMessage",
+					Message: "onInvalidMessage must be 'reject' or 'dead-letter'",
+				})
+			}
+		}
+
+		if len(msg.Payload) == 0 {
+			errors = append(errors, ValidationError{
+				Field:   field + ".payload",
+				Message: "Payload schema is required",
+			})
+			continue
+		}
+
+		schemaFormat := ""
+		if msg.SchemaFormat != nil {
+			schemaFormat = *msg.SchemaFormat
+		}
+		switch {
+		case isAvroSchemaFormat(schemaFormat):
+			data, err := json.Marshal(msg.Payload)
+			if err == nil {
+				_, err = avro.ParseBytes(data)
+			}
+			if err != nil {
+				errors = append(errors, ValidationError{
+					Field:   field + ".payload",
+					Message: fmt.Sprintf("Invalid Avro schema: %v", err),
+				})
+			}
+		case isJSONSchemaFormat(schemaFormat):
+			if _, err := gojsonschema.NewSchema(gojsonschema.NewGoLoader(msg.Payload)); err != nil {
+				errors = append(errors, ValidationError{
+					Field:   field + ".payload",
+					Message: fmt.Sprintf("Invalid JSON schema: %v", err),
+				})
+			}
+		default:
+			errors = append(errors, ValidationError{
+				Field:   field + ".schemaFormat",
+				Message: fmt.Sprintf("Unsupported schema format %q (expected a JSON Schema or Apache Avro schema format)", schemaFormat),
+			})
+		}
+	}
+
+	return errors
+}
+
+// isAvroSchemaFormat reports whether an AsyncAPI schemaFormat denotes an Apache Avro schema.
+func isAvroSchemaFormat(schemaFormat string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(schemaFormat)), "application/vnd.apache.avro")
+}
+
+// isJSONSchemaFormat reports whether an AsyncAPI schemaFormat denotes a JSON Schema.
+// AsyncAPI schema objects are a JSON Schema superset, so they are accepted as well.
+func isJSONSchemaFormat(schemaFormat string) bool {
+	format := strings.ToLower(strings.TrimSpace(schemaFormat))
+	return format == "" ||
+		strings.HasPrefix(format, "application/schema+json") ||
+		strings.HasPrefix(format, "application/schema+yaml") ||
+		strings.HasPrefix(format, "application/vnd.aai.asyncapi")
+}
+
 // validateChannelPolicies validates the channelPolicies map configuration
 func (v *APIValidator) validateChannelPolicies(channelPolicies map[string]api.WebSubChannelPolicies) []ValidationError {
 	var errors []ValidationError
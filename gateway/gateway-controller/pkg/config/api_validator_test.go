@@ -379,6 +379,145 @@ func TestAPIValidator_ValidateSandboxUpstream(t *testing.T) {
 	}
 }
 
+func TestAPIValidator_ValidateMirrors(t *testing.T) {
+	v := NewAPIValidator()
+	pct := func(f float32) *float32 { return &f }
+
+	tests := []struct {
+		name      string
+		mirror    *api.TrafficMirror
+		wantError bool
+		errField  string
+	}{
+		{name: "Valid URL mirror", mirror: &api.TrafficMirror{Url: stringPtr("http://backend-v2:8080"), Percentage: pct(10)}},
+		{name: "Valid ref mirror", mirror: &api.TrafficMirror{Ref: stringPtr("candidate")}},
+		{name: "Valid header filter", mirror: &api.TrafficMirror{Url: stringPtr("http://backend-v2:8080"), Headers: &[]api.TrafficMirrorHeader{{Name: "x-canary"}}}},
+		{name: "Both URL and ref", mirror: &api.TrafficMirror{Url: stringPtr("http://x"), Ref: stringPtr("candidate")}, wantError: true, errField: "spec.operations[0].mirror"},
+		{name: "Neither URL nor ref", mirror: &api.TrafficMirror{}, wantError: true, errField: "spec.operations[0].mirror"},
+		{name: "URL with path", mirror: &api.TrafficMirror{Url: stringPtr("http://backend-v2:8080/v2")}, wantError: true, errField: "spec.operations[0].mirror.url"},
+		{name: "Unknown ref", mirror: &api.TrafficMirror{Ref: stringPtr("missing")}, wantError: true, errField: "spec.operations[0].mirror.ref"},
+		{name: "Percentage out of range", mirror: &api.TrafficMirror{Url: stringPtr("http://backend-v2:8080"), Percentage: pct(150)}, wantError: true, errField: "spec.operations[0].mirror.percentage"},
+		{name: "Pseudo-header filter", mirror: &api.TrafficMirror{Url: stringPtr("http://backend-v2:8080"), Headers: &[]api.TrafficMirrorHeader{{Name: ":path"}}}, wantError: true, errField: "spec.operations[0].mirror.headers[0].name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := createValidRestAPIConfig()
+			config.Spec.UpstreamDefinitions = &[]api.UpstreamDefinition{{
+				Name: "candidate",
+				Upstreams: []struct {
+					Url    string `json:"url" yaml:"url"`
+					Weight *int   `json:"weight,omitempty" yaml:"weight,omitempty"`
+				}{{Url: "http://backend-v2:8080"}},
+			}}
+			config.Spec.Operations[0].Mirror = tt.mirror
+
+			errors := v.Validate(config)
+			if !tt.wantError {
+				for _, e := range errors {
+					if strings.Contains(e.Field, "mirror") {
+						t.Errorf("unexpected mirror error: %v", e)
+					}
+				}
+				return
+			}
+			hasExpectedError := false
+			for _, e := range errors {
+				if e.Field == tt.errField {
+					hasExpectedError = true
+					break
+				}
+			}
+			if !hasExpectedError {
+				t.Errorf("expected error for field %s, got: %v", tt.errField, errors)
+			}
+		})
+	}
+
+	t.Run("Upstream definition mirroring to itself", func(t *testing.T) {
+		config := createValidRestAPIConfig()
+		config.Spec.UpstreamDefinitions = &[]api.UpstreamDefinition{{
+			Name: "candidate",
+			Upstreams: []struct {
+				Url    string `json:"url" yaml:"url"`
+				Weight *int   `json:"weight,omitempty" yaml:"weight,omitempty"`
+			}{{Url: "http://backend-v2:8080"}},
+			Mirror: &api.TrafficMirror{Ref: stringPtr("candidate")},
+		}}
+
+		errors := v.Validate(config)
+		found := false
+		for _, e := range errors {
+			if e.Field == "spec.upstreamDefinitions[0].mirror.ref" {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected self-mirror error, got: %v", errors)
+		}
+	})
+}
+
+func TestAPIValidator_ValidateTrafficSplit(t *testing.T) {
+	v := NewAPIValidator()
+
+	tests := []struct {
+		name      string
+		split     *api.TrafficSplit
+		wantError bool
+		errField  string
+	}{
+		{name: "Valid weights", split: &api.TrafficSplit{Weights: &[]api.TrafficSplitWeight{{Upstream: "stable", Weight: 95}, {Upstream: "canary", Weight: 5}}}},
+		{name: "Valid rules", split: &api.TrafficSplit{Rules: &[]api.TrafficSplitRule{{Upstream: "canary", Headers: &[]api.TrafficSplitMatch{{Name: "x-canary", Value: stringPtr("true")}}, Cookies: &[]api.TrafficSplitMatch{{Name: "beta"}}}}}},
+		{name: "Empty split", split: &api.TrafficSplit{}, wantError: true, errField: "spec.operations[0].trafficSplit"},
+		{name: "Unknown weighted upstream", split: &api.TrafficSplit{Weights: &[]api.TrafficSplitWeight{{Upstream: "missing", Weight: 5}}}, wantError: true, errField: "spec.operations[0].trafficSplit.weights[0].upstream"},
+		{name: "Duplicate weighted upstream", split: &api.TrafficSplit{Weights: &[]api.TrafficSplitWeight{{Upstream: "canary", Weight: 5}, {Upstream: "canary", Weight: 5}}}, wantError: true, errField: "spec.operations[0].trafficSplit.weights[1].upstream"},
+		{name: "Weight out of range", split: &api.TrafficSplit{Weights: &[]api.TrafficSplitWeight{{Upstream: "canary", Weight: 150}}}, wantError: true, errField: "spec.operations[0].trafficSplit.weights[0].weight"},
+		{name: "All weights zero", split: &api.TrafficSplit{Weights: &[]api.TrafficSplitWeight{{Upstream: "canary", Weight: 0}}}, wantError: true, errField: "spec.operations[0].trafficSplit.weights"},
+		{name: "Rule without conditions", split: &api.TrafficSplit{Rules: &[]api.TrafficSplitRule{{Upstream: "canary"}}}, wantError: true, errField: "spec.operations[0].trafficSplit.rules[0]"},
+		{name: "Unknown rule upstream", split: &api.TrafficSplit{Rules: &[]api.TrafficSplitRule{{Upstream: "missing", Headers: &[]api.TrafficSplitMatch{{Name: "x-canary"}}}}}, wantError: true, errField: "spec.operations[0].trafficSplit.rules[0].upstream"},
+		{name: "Pseudo-header rule", split: &api.TrafficSplit{Rules: &[]api.TrafficSplitRule{{Upstream: "canary", Headers: &[]api.TrafficSplitMatch{{Name: ":authority"}}}}}, wantError: true, errField: "spec.operations[0].trafficSplit.rules[0].headers[0].name"},
+		{name: "Invalid cookie name", split: &api.TrafficSplit{Rules: &[]api.TrafficSplitRule{{Upstream: "canary", Cookies: &[]api.TrafficSplitMatch{{Name: "a=b"}}}}}, wantError: true, errField: "spec.operations[0].trafficSplit.rules[0].cookies[0].name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := createValidRestAPIConfig()
+			definition := func(name string) api.UpstreamDefinition {
+				return api.UpstreamDefinition{
+					Name: name,
+					Upstreams: []struct {
+						Url    string `json:"url" yaml:"url"`
+						Weight *int   `json:"weight,omitempty" yaml:"weight,omitempty"`
+					}{{Url: "http://" + name + ":8080"}},
+				}
+			}
+			config.Spec.UpstreamDefinitions = &[]api.UpstreamDefinition{definition("stable"), definition("canary")}
+			config.Spec.Operations[0].TrafficSplit = tt.split
+
+			errors := v.Validate(config)
+			if !tt.wantError {
+				for _, e := range errors {
+					if strings.Contains(e.Field, "trafficSplit") {
+						t.Errorf("unexpected traffic split error: %v", e)
+					}
+				}
+				return
+			}
+			hasExpectedError := false
+			for _, e := range errors {
+				if e.Field == tt.errField {
+					hasExpectedError = true
+					break
+				}
+			}
+			if !hasExpectedError {
+				t.Errorf("expected error for field %s, got: %v", tt.errField, errors)
+			}
+		})
+	}
+}
+
 func TestAPIValidator_ValidateOperations(t *testing.T) {
 	v := NewAPIValidator()
 
@@ -572,6 +711,150 @@ func TestAPIValidator_ValidateChannels(t *testing.T) {
 	}
 }
 
+func TestAPIValidator_ValidateChannelMessages(t *testing.T) {
+	v := NewAPIValidator()
+
+	avroFormat := "application/vnd.apache.avro+json;version=1.9.0"
+	unknownFormat := "application/raml+yaml;version=1.0"
+	deadLetter := api.WebSubChannelMessageOnInvalidMessageDeadLetter
+	invalidAction := api.WebSubChannelMessageOnInvalidMessage("drop")
+
+	tests := []struct {
+		name      string
+		messages  map[string]api.WebSubChannelMessage
+		wantError bool
+		errField  string
+	}{
+		{
+			name: "Valid JSON schema",
+			messages: map[string]api.WebSubChannelMessage{
+				"channel1": {
+					Payload: map[string]interface{}{
+						"type":     "object",
+						"required": []interface{}{"id"},
+					},
+					OnInvalidMessage: &deadLetter,
+				},
+			},
+		},
+		{
+			name: "Valid Avro schema",
+			messages: map[string]api.WebSubChannelMessage{
+				"channel1": {
+					SchemaFormat: &avroFormat,
+					Payload: map[string]interface{}{
+						"type": "record",
+						"name": "Issue",
+						"fields": []interface{}{
+							map[string]interface{}{"name": "id", "type": "long"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Undeclared channel",
+			messages: map[string]api.WebSubChannelMessage{
+				"unknown": {Payload: map[string]interface{}{"type": "object"}},
+			},
+			wantError: true,
+			errField:  "spec.channelMessages.unknown",
+		},
+		{
+			name: "Missing payload",
+			messages: map[string]api.WebSubChannelMessage{
+				"channel1": {},
+			},
+			wantError: true,
+			errField:  "spec.channelMessages.channel1.payload",
+		},
+		{
+			name: "Invalid JSON schema",
+			messages: map[string]api.WebSubChannelMessage{
+				"channel1": {Payload: map[string]interface{}{"type": 42}},
+			},
+			wantError: true,
+			errField:  "spec.channelMessages.channel1.payload",
+		},
+		{
+			name: "Invalid Avro schema",
+			messages: map[string]api.WebSubChannelMessage{
+				"channel1": {
+					SchemaFormat: &avroFormat,
+					Payload:      map[string]interface{}{"type": "record"},
+				},
+			},
+			wantError: true,
+			errField:  "spec.channelMessages.channel1.payload",
+		},
+		{
+			name: "Unsupported schema format",
+			messages: map[string]api.WebSubChannelMessage{
+				"channel1": {
+					SchemaFormat: &unknownFormat,
+					Payload:      map[string]interface{}{"type": "object"},
+				},
+			},
+			wantError: true,
+			errField:  "spec.channelMessages.channel1.schemaFormat",
+		},
+		{
+			name: "Invalid onInvalidMessage action",
+			messages: map[string]api.WebSubChannelMessage{
+				"channel1": {
+					Payload:          map[string]interface{}{"type": "object"},
+					OnInvalidMessage: &invalidAction,
+				},
+			},
+			wantError: true,
+			errField:  "spec.channelMessages.channel1.onInvalidMessage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := createValidWebSubAPIConfig()
+			config.Spec.ChannelMessages = &tt.messages
+
+			errors := v.Validate(config)
+			if !tt.wantError {
+				if len(errors) != 0 {
+					t.Errorf("expected no errors, got: %v", errors)
+				}
+				return
+			}
+			hasExpectedError := false
+			for _, e := range errors {
+				if e.Field == tt.errField {
+					hasExpectedError = true
+					break
+				}
+			}
+			if !hasExpectedError {
+				t.Errorf("expected error for field %s, got: %v", tt.errField, errors)
+			}
+		})
+	}
+}
+
+func TestAPIValidator_ValidateCloudEventsMode(t *testing.T) {
+	v := NewAPIValidator()
+
+	config := createValidWebSubAPIConfig()
+	mode := api.WebSubCloudEventsModeStructured
+	config.Spec.CloudEvents = &api.WebSubCloudEvents{Mode: &mode}
+	if errors := v.Validate(config); len(errors) != 0 {
+		t.Errorf("expected no errors for structured mode, got: %v", errors)
+	}
+
+	invalid := api.WebSubCloudEventsMode("batch")
+	config.Spec.CloudEvents = &api.WebSubCloudEvents{Mode: &invalid}
+	errors := v.Validate(config)
+	if len(errors) != 1 || errors[0].Field != "spec.cloudEvents.mode" {
+		t.Errorf("expected a spec.cloudEvents.mode error, got: %v", errors)
+	}
+}
+
 func TestAPIValidator_ValidateAsyncDisplayName(t *testing.T) {
 	v := NewAPIValidator()
 
@@ -19,8 +19,12 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"net/url"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -77,8 +81,38 @@ type SubscriptionsConfig struct {
 // When enabled, the gateway loads all API artifacts from the filesystem on startup
 // and rejects all mutating management API operations (POST, PUT, DELETE) at runtime.
 type ImmutableGatewayConfig struct {
-	Enabled      bool   `koanf:"enabled"`
-	ArtifactsDir string `koanf:"artifacts_dir"`
+	Enabled      bool                       `koanf:"enabled"`
+	ArtifactsDir string                     `koanf:"artifacts_dir"`
+	Sync         ImmutableGatewaySyncConfig `koanf:"sync"`
+}
+
+// Immutable gateway sync modes
+const (
+	// ImmutableSyncModeStatic loads the artifacts once at startup
+	ImmutableSyncModeStatic = "static"
+	// ImmutableSyncModeDirectory watches the artifacts directory and applies changes
+	ImmutableSyncModeDirectory = "directory"
+	// ImmutableSyncModeGit pulls a git repository into the artifacts directory and applies new commits
+	ImmutableSyncModeGit = "git"
+)
+
+// ImmutableGatewaySyncConfig controls how an immutable gateway tracks its artifacts after startup.
+// In the directory and git modes the desired state is re-read on every change (or poll) and applied
+// as a whole; a revision that fails to apply is rolled back and the last good state keeps serving.
+type ImmutableGatewaySyncConfig struct {
+	Mode         string                    `koanf:"mode"`
+	PollInterval time.Duration             `koanf:"poll_interval"`
+	Git          ImmutableGatewayGitConfig `koanf:"git"`
+}
+
+// ImmutableGatewayGitConfig describes the git repository tracked in git sync mode. The repository is
+// cloned into the artifacts directory; credentials come from the git configuration of the container
+// (SSH keys, credential helpers) or the URL.
+type ImmutableGatewayGitConfig struct {
+	URL    string `koanf:"url"`
+	Branch string `koanf:"branch"`
+	// Path is the directory within the repository holding the artifacts
+	Path string `koanf:"path"`
 }
 
 // AnalyticsPublishersConfig holds configuration for all analytics publishers
@@ -122,6 +156,7 @@ type Controller struct {
 	PolicyServer PolicyServerConfig `koanf:"policy_server"`
 	Policies     PoliciesConfig     `koanf:"policies"`
 	LLM          LLMConfig          `koanf:"llm"`
+	MCP          MCPConfig          `koanf:"mcp"`
 	Auth         AuthConfig         `koanf:"auth"`
 	Metrics      MetricsConfig      `koanf:"metrics"`
 	Encryption   EncryptionConfig   `koanf:"encryption"`
@@ -247,6 +282,33 @@ type LLMConfig struct {
 	TemplateDefinitionsPath string `koanf:"template_definitions_path"`
 }
 
+// MCPConfig holds MCP proxy configuration
+type MCPConfig struct {
+	Aggregator MCPAggregatorConfig `koanf:"aggregator"`
+}
+
+// MCPAggregatorConfig holds the configuration of the MCP aggregator server, which serves
+// VirtualMcp proxies by fanning requests out to their upstream MCP servers. The router
+// forwards VirtualMcp traffic to it, so it must be reachable from the router but should
+// not be exposed to clients directly. Requests without the router token are rejected.
+type MCPAggregatorConfig struct {
+	Enabled bool `koanf:"enabled"`
+	Port    int  `koanf:"port"`
+	// Host is the address the router uses to reach the gateway controller
+	Host string `koanf:"host"`
+	// UpstreamTimeout bounds each request to an upstream MCP server
+	UpstreamTimeout time.Duration `koanf:"upstream_timeout"`
+	// SessionIdleTimeout is how long an idle client session and its upstream sessions are kept
+	SessionIdleTimeout time.Duration `koanf:"session_idle_timeout"`
+	// RouterURL is the router listener the aggregator calls the RestApis exposed as MCP tools
+	// through, so that the policies of those APIs apply
+	RouterURL string `koanf:"router_url"`
+	// RouterToken is the shared secret the router adds to the requests it forwards to the
+	// aggregator. A random token is generated when it is empty, which only works while every
+	// router reaches the controller that configured its routes; set it when running replicas.
+	RouterToken string `koanf:"router_token"`
+}
+
 // StorageConfig holds storage-related configuration
 type StorageConfig struct {
 	Type     string         `koanf:"type"`     // "sqlite", "postgres", or "memory"
@@ -501,8 +563,28 @@ func LoadConfig(configPath string) (*Config, error) {
 			return "controller.controlplane.sync_batch_size"
 		case "immutable_gateway_enabled":
 			return "immutable_gateway.enabled"
+		case "immutable_gateway_artifacts_dir":
+			return "immutable_gateway.artifacts_dir"
+		case "immutable_gateway_sync_mode":
+			return "immutable_gateway.sync.mode"
+		case "immutable_gateway_sync_poll_interval":
+			return "immutable_gateway.sync.poll_interval"
+		case "immutable_gateway_sync_git_url":
+			return "immutable_gateway.sync.git.url"
+		case "immutable_gateway_sync_git_branch":
+			return "immutable_gateway.sync.git.branch"
+		case "immutable_gateway_sync_git_path":
+			return "immutable_gateway.sync.git.path"
 		case "controller_controlplane_gateway_name":
 			return "controller.controlplane.gateway_name"
+		case "controller_mcp_aggregator_upstream_timeout":
+			return "controller.mcp.aggregator.upstream_timeout"
+		case "controller_mcp_aggregator_session_idle_timeout":
+			return "controller.mcp.aggregator.session_idle_timeout"
+		case "controller_mcp_aggregator_router_url":
+			return "controller.mcp.aggregator.router_url"
+		case "controller_mcp_aggregator_router_token":
+			return "controller.mcp.aggregator.router_token"
 		default:
 			// For other env vars, use standard mapping (underscore to dot)
 			// Step 1: Convert double underscore "__" into a temporary placeholder
@@ -568,6 +650,16 @@ func defaultConfig() *Config {
 			LLM: LLMConfig{
 				TemplateDefinitionsPath: "./default-llm-provider-templates",
 			},
+			MCP: MCPConfig{
+				Aggregator: MCPAggregatorConfig{
+					Enabled:            false,
+					Port:               9095,
+					Host:               "gateway-controller",
+					UpstreamTimeout:    30 * time.Second,
+					SessionIdleTimeout: 30 * time.Minute,
+					RouterURL:          "http://gateway-runtime:8080",
+				},
+			},
 			Storage: StorageConfig{
 				Type: "sqlite",
 				SQLite: SQLiteConfig{
@@ -790,6 +882,13 @@ func defaultConfig() *Config {
 		ImmutableGateway: ImmutableGatewayConfig{
 			Enabled:      false,
 			ArtifactsDir: "/etc/api-platform-gateway/immutable_gateway/artifacts",
+			Sync: ImmutableGatewaySyncConfig{
+				Mode:         ImmutableSyncModeStatic,
+				PollInterval: 30 * time.Second,
+				Git: ImmutableGatewayGitConfig{
+					Branch: "main",
+				},
+			},
 		},
 	}
 }
@@ -1056,6 +1155,98 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.validateImmutableGatewayConfig(); err != nil {
+		return err
+	}
+
+	if err := c.validateMCPAggregatorConfig(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMCPAggregatorConfig validates the MCP aggregator server settings
+func (c *Config) validateMCPAggregatorConfig() error {
+	agg := &c.Controller.MCP.Aggregator
+	if !agg.Enabled {
+		return nil
+	}
+	if agg.Port < 1 || agg.Port > 65535 {
+		return fmt.Errorf("controller.mcp.aggregator.port must be between 1 and 65535, got: %d", agg.Port)
+	}
+	if strings.TrimSpace(agg.Host) == "" {
+		return fmt.Errorf("controller.mcp.aggregator.host is required when controller.mcp.aggregator.enabled is true")
+	}
+	if agg.UpstreamTimeout <= 0 {
+		return fmt.Errorf("controller.mcp.aggregator.upstream_timeout must be positive, got: %s", agg.UpstreamTimeout)
+	}
+	if agg.SessionIdleTimeout <= 0 {
+		return fmt.Errorf("controller.mcp.aggregator.session_idle_timeout must be positive, got: %s", agg.SessionIdleTimeout)
+	}
+	routerURL, err := url.Parse(agg.RouterURL)
+	if err != nil || (routerURL.Scheme != "http" && routerURL.Scheme != "https") || routerURL.Host == "" {
+		return fmt.Errorf("controller.mcp.aggregator.router_url must be an http or https URL, got: %q", agg.RouterURL)
+	}
+	if agg.RouterToken == "" {
+		token := make([]byte, 32)
+		if _, err := rand.Read(token); err != nil {
+			return fmt.Errorf("failed to generate controller.mcp.aggregator.router_token: %w", err)
+		}
+		agg.RouterToken = hex.EncodeToString(token)
+	}
+	return nil
+}
+
+// validateImmutableGatewayConfig validates the artifact sync settings of immutable gateway mode
+func (c *Config) validateImmutableGatewayConfig() error {
+	ig := &c.ImmutableGateway
+	if !ig.Enabled {
+		return nil
+	}
+	if strings.TrimSpace(ig.ArtifactsDir) == "" {
+		return fmt.Errorf("immutable_gateway.artifacts_dir is required when immutable_gateway.enabled is true")
+	}
+
+	sync := &ig.Sync
+	if sync.Mode == "" {
+		sync.Mode = ImmutableSyncModeStatic
+	}
+	switch sync.Mode {
+	case ImmutableSyncModeStatic:
+		return nil
+	case ImmutableSyncModeDirectory, ImmutableSyncModeGit:
+	default:
+		return fmt.Errorf("immutable_gateway.sync.mode must be one of: static, directory, git, got: %s", sync.Mode)
+	}
+	if sync.PollInterval <= 0 {
+		return fmt.Errorf("immutable_gateway.sync.poll_interval must be positive, got: %s", sync.PollInterval)
+	}
+	if sync.Mode != ImmutableSyncModeGit {
+		return nil
+	}
+
+	if strings.TrimSpace(sync.Git.URL) == "" {
+		return fmt.Errorf("immutable_gateway.sync.git.url is required when immutable_gateway.sync.mode is git")
+	}
+	if strings.HasPrefix(strings.TrimSpace(sync.Git.URL), "-") {
+		return fmt.Errorf("immutable_gateway.sync.git.url is invalid: %s", sync.Git.URL)
+	}
+	if sync.Git.Branch == "" {
+		sync.Git.Branch = "main"
+	}
+	if strings.HasPrefix(sync.Git.Branch, "-") {
+		return fmt.Errorf("immutable_gateway.sync.git.branch is invalid: %s", sync.Git.Branch)
+	}
+	if sync.Git.Path != "" {
+		cleaned := filepath.ToSlash(filepath.Clean(sync.Git.Path))
+		if filepath.IsAbs(sync.Git.Path) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return fmt.Errorf("immutable_gateway.sync.git.path must be a relative path within the repository, got: %s", sync.Git.Path)
+		}
+	}
+	if _, err := exec.LookPath("git"); err != nil {
+		return fmt.Errorf("immutable_gateway.sync.mode git requires the git executable on PATH: %w", err)
+	}
 	return nil
 }
 
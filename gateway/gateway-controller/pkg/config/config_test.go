@@ -637,6 +637,109 @@ func TestConfig_ValidateEventGatewayConfig(t *testing.T) {
 	}
 }
 
+func TestConfig_ValidateImmutableGatewayConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(sync *ImmutableGatewaySyncConfig)
+		errContains string
+	}{
+		{name: "Static mode", mutate: func(sync *ImmutableGatewaySyncConfig) {}},
+		{name: "Directory mode", mutate: func(sync *ImmutableGatewaySyncConfig) { sync.Mode = ImmutableSyncModeDirectory }},
+		{name: "Git mode", mutate: func(sync *ImmutableGatewaySyncConfig) {
+			sync.Mode = ImmutableSyncModeGit
+			sync.Git.URL = "https://example.com/org/gateway-artifacts.git"
+			sync.Git.Path = "prod/apis"
+		}},
+		{name: "Unknown mode", mutate: func(sync *ImmutableGatewaySyncConfig) { sync.Mode = "s3" }, errContains: "sync.mode must be one of"},
+		{name: "Non-positive poll interval", mutate: func(sync *ImmutableGatewaySyncConfig) {
+			sync.Mode = ImmutableSyncModeDirectory
+			sync.PollInterval = 0
+		}, errContains: "poll_interval must be positive"},
+		{name: "Git mode without URL", mutate: func(sync *ImmutableGatewaySyncConfig) { sync.Mode = ImmutableSyncModeGit }, errContains: "git.url is required"},
+		{name: "Git path escaping the repository", mutate: func(sync *ImmutableGatewaySyncConfig) {
+			sync.Mode = ImmutableSyncModeGit
+			sync.Git.URL = "https://example.com/org/gateway-artifacts.git"
+			sync.Git.Path = "../etc"
+		}, errContains: "git.path must be a relative path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.ImmutableGateway = defaultConfig().ImmutableGateway
+			cfg.ImmutableGateway.Enabled = true
+			tt.mutate(&cfg.ImmutableGateway.Sync)
+			err := cfg.Validate()
+			if tt.errContains != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateImmutableGatewayConfig_GitNotOnPath(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	cfg := validConfig()
+	cfg.ImmutableGateway = defaultConfig().ImmutableGateway
+	cfg.ImmutableGateway.Enabled = true
+	cfg.ImmutableGateway.Sync.Mode = ImmutableSyncModeGit
+	cfg.ImmutableGateway.Sync.Git.URL = "https://example.com/org/gateway-artifacts.git"
+
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "requires the git executable")
+}
+
+func TestConfig_ValidateMCPAggregatorConfig(t *testing.T) {
+	tests := []struct {
+		name        string
+		mutate      func(agg *MCPAggregatorConfig)
+		errContains string
+	}{
+		{name: "Defaults", mutate: func(agg *MCPAggregatorConfig) {}},
+		{name: "Disabled with invalid port", mutate: func(agg *MCPAggregatorConfig) {
+			agg.Enabled = false
+			agg.Port = 0
+		}},
+		{name: "Invalid port", mutate: func(agg *MCPAggregatorConfig) { agg.Port = 70000 }, errContains: "aggregator.port must be between"},
+		{name: "Missing host", mutate: func(agg *MCPAggregatorConfig) { agg.Host = " " }, errContains: "aggregator.host is required"},
+		{name: "Non-positive upstream timeout", mutate: func(agg *MCPAggregatorConfig) { agg.UpstreamTimeout = 0 }, errContains: "upstream_timeout must be positive"},
+		{name: "Invalid router URL", mutate: func(agg *MCPAggregatorConfig) { agg.RouterURL = "gateway-runtime:8080" }, errContains: "router_url must be an http or https URL"},
+		{name: "Configured router token", mutate: func(agg *MCPAggregatorConfig) { agg.RouterToken = "shared-secret" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.Controller.MCP = defaultConfig().Controller.MCP
+			cfg.Controller.MCP.Aggregator.Enabled = true
+			tt.mutate(&cfg.Controller.MCP.Aggregator)
+			err := cfg.Validate()
+			if tt.errContains != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateMCPAggregatorConfig_GeneratesRouterToken(t *testing.T) {
+	cfg := validConfig()
+	cfg.Controller.MCP = defaultConfig().Controller.MCP
+	cfg.Controller.MCP.Aggregator.Enabled = true
+	assert.NoError(t, cfg.Validate())
+	assert.Len(t, cfg.Controller.MCP.Aggregator.RouterToken, 64)
+
+	cfg.Controller.MCP.Aggregator.RouterToken = "shared-secret"
+	assert.NoError(t, cfg.Validate())
+	assert.Equal(t, "shared-secret", cfg.Controller.MCP.Aggregator.RouterToken)
+}
+
 func TestConfig_ValidateControlPlaneConfig(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -19,8 +19,10 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"reflect"
 	"regexp"
 	"slices"
 	"strings"
@@ -37,6 +39,8 @@ type MCPValidator struct {
 	urlFriendlyNameRegex *regexp.Regexp
 	// supported MCP specification version
 	supportedSpecVersions []string
+	// upstreamNameRegex matches the names of VirtualMcp upstreams
+	upstreamNameRegex *regexp.Regexp
 	// policyValidator validates policies referenced in the MCP configuration
 	policyValidator *PolicyValidator
 }
@@ -46,6 +50,7 @@ func NewMCPValidator() *MCPValidator {
 	return &MCPValidator{
 		versionRegex:          regexp.MustCompile(`^v?\d+(\.\d+)?(\.\d+)?$`),
 		urlFriendlyNameRegex:  regexp.MustCompile(`^[a-zA-Z0-9\-_\. ]+$`),
+		upstreamNameRegex:     regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,63}$`),
 		supportedSpecVersions: []string{constants.SPEC_VERSION_2025_JUNE, constants.SPEC_VERSION_2025_NOVEMBER}}
 }
 
@@ -87,17 +92,17 @@ func (v *MCPValidator) validateMCPConfiguration(config *api.MCPProxyConfiguratio
 	}
 
 	// Validate kind
-	if config.Kind != "Mcp" {
+	if config.Kind != api.MCPProxyConfigurationKindMcp && config.Kind != api.MCPProxyConfigurationKindVirtualMcp {
 		errors = append(errors, ValidationError{
 			Field:   "kind",
-			Message: "Unsupported configuration kind (only 'Mcp' is supported)",
+			Message: "Unsupported configuration kind (only 'Mcp' and 'VirtualMcp' are supported)",
 		})
 	}
 
 	errors = append(errors, ValidateMetadata(&config.Metadata)...)
 
 	// Validate data section
-	errors = append(errors, v.validateSpec(&config.Spec)...)
+	errors = append(errors, v.validateSpec(config.Kind, &config.Spec)...)
 
 	// Validate policies if a policy validator is configured
 	if v.policyValidator != nil {
@@ -108,7 +113,7 @@ func (v *MCPValidator) validateMCPConfiguration(config *api.MCPProxyConfiguratio
 }
 
 // validateSpec validates the spec section of the configuration
-func (v *MCPValidator) validateSpec(spec *api.MCPProxyConfigData) []ValidationError {
+func (v *MCPValidator) validateSpec(kind api.MCPProxyConfigurationKind, spec *api.MCPProxyConfigData) []ValidationError {
 	var errors []ValidationError
 
 	// Validate displayName
@@ -149,12 +154,234 @@ func (v *MCPValidator) validateSpec(spec *api.MCPProxyConfigData) []ValidationEr
 	// Validate context
 	errors = append(errors, v.validateContextAndVhost(spec.Context, spec.Vhost)...)
 
-	// Validate upstream
-	errors = append(errors, v.validateUpstream("spec.upstream", &spec.Upstream)...)
+	// Validate upstream: a VirtualMcp proxy aggregates spec.upstreams instead of fronting spec.upstream
+	if kind == api.MCPProxyConfigurationKindVirtualMcp {
+		if !reflect.ValueOf(spec.Upstream).IsZero() {
+			errors = append(errors, ValidationError{
+				Field:   "spec.upstream",
+				Message: "Upstream is not supported for kind 'VirtualMcp', use upstreams",
+			})
+		}
+		errors = append(errors, v.validateVirtualUpstreams(spec.Upstreams)...)
+	} else {
+		if spec.Upstreams != nil {
+			errors = append(errors, ValidationError{
+				Field:   "spec.upstreams",
+				Message: "Upstreams are only supported for kind 'VirtualMcp'",
+			})
+		}
+		errors = append(errors, v.validateUpstream("spec.upstream", &spec.Upstream)...)
+	}
+
+	return errors
+}
+
+// validateVirtualUpstreams validates the upstream MCP servers of a VirtualMcp proxy
+func (v *MCPValidator) validateVirtualUpstreams(upstreams *[]api.MCPVirtualUpstream) []ValidationError {
+	var errors []ValidationError
+
+	if upstreams == nil || len(*upstreams) == 0 {
+		return []ValidationError{{
+			Field:   "spec.upstreams",
+			Message: "At least one upstream is required",
+		}}
+	}
+
+	names := make(map[string]bool, len(*upstreams))
+	for i, upstream := range *upstreams {
+		fieldPrefix := fmt.Sprintf("spec.upstreams[%d]", i)
+
+		if !v.upstreamNameRegex.MatchString(upstream.Name) {
+			errors = append(errors, ValidationError{
+				Field:   fieldPrefix + ".name",
+				Message: "Upstream name must be 1-64 characters of letters, numbers, hyphens and underscores",
+			})
+		} else if names[upstream.Name] {
+			errors = append(errors, ValidationError{
+				Field:   fieldPrefix + ".name",
+				Message: fmt.Sprintf("Duplicate upstream name '%s'", upstream.Name),
+			})
+		}
+		names[upstream.Name] = true
+
+		hasURL := upstream.Url != nil && *upstream.Url != ""
+		hasRestAPI := upstream.RestApi != nil && *upstream.RestApi != ""
+		switch {
+		case hasURL && hasRestAPI:
+			errors = append(errors, ValidationError{
+				Field:   fieldPrefix,
+				Message: "Only one of url and restApi can be specified",
+			})
+			continue
+		case !hasURL && !hasRestAPI:
+			errors = append(errors, ValidationError{
+				Field:   fieldPrefix,
+				Message: "Either url or restApi is required",
+			})
+			continue
+		}
+
+		if hasRestAPI {
+			errors = append(errors, v.validateRestUpstream(fieldPrefix, upstream)...)
+			continue
+		}
+
+		if upstream.Operations != nil {
+			errors = append(errors, ValidationError{
+				Field:   fieldPrefix + ".operations",
+				Message: "Operations can only be specified for restApi upstreams",
+			})
+		}
+
+		// Reuse the single-upstream URL and auth rules
+		single := &api.MCPProxyConfigData_Upstream{Url: upstream.Url}
+		if upstream.Auth != nil {
+			single.Auth = &struct {
+				Header *string                                `json:"header,omitempty" yaml:"header,omitempty"`
+				Type   api.MCPProxyConfigDataUpstreamAuthType `json:"type" yaml:"type"`
+				Value  *string                                `json:"value,omitempty" yaml:"value,omitempty"`
+			}{
+				Header: upstream.Auth.Header,
+				Type:   api.MCPProxyConfigDataUpstreamAuthType(upstream.Auth.Type),
+				Value:  upstream.Auth.Value,
+			}
+		}
+		errors = append(errors, v.validateUpstream(fieldPrefix, single)...)
+
+		errors = append(errors, validateVirtualCapabilities(fieldPrefix+".tools", upstream.Tools)...)
+		errors = append(errors, validateVirtualCapabilities(fieldPrefix+".prompts", upstream.Prompts)...)
+		if upstream.Resources != nil {
+			for j, uri := range *upstream.Resources {
+				if strings.TrimSpace(uri) == "" {
+					errors = append(errors, ValidationError{
+						Field:   fmt.Sprintf("%s.resources[%d]", fieldPrefix, j),
+						Message: "Resource URI must not be empty",
+					})
+				}
+			}
+		}
+	}
 
 	return errors
 }
 
+// validateRestUpstream validates a VirtualMcp upstream that exposes a deployed RestApi as tools
+func (v *MCPValidator) validateRestUpstream(fieldPrefix string, upstream api.MCPVirtualUpstream) []ValidationError {
+	var errors []ValidationError
+
+	if !v.urlFriendlyNameRegex.MatchString(*upstream.RestApi) {
+		errors = append(errors, ValidationError{
+			Field:   fieldPrefix + ".restApi",
+			Message: "RestApi handle contains invalid characters",
+		})
+	}
+	if upstream.Prompts != nil {
+		errors = append(errors, ValidationError{
+			Field:   fieldPrefix + ".prompts",
+			Message: "Prompts cannot be specified for restApi upstreams",
+		})
+	}
+	if upstream.Resources != nil {
+		errors = append(errors, ValidationError{
+			Field:   fieldPrefix + ".resources",
+			Message: "Resources cannot be specified for restApi upstreams",
+		})
+	}
+	if upstream.Auth != nil {
+		errors = append(errors, validateUpstreamAuth(fieldPrefix+".auth", string(upstream.Auth.Type),
+			upstream.Auth.Header, upstream.Auth.Value)...)
+	}
+	errors = append(errors, validateVirtualCapabilities(fieldPrefix+".tools", upstream.Tools)...)
+
+	if upstream.Operations == nil {
+		return errors
+	}
+	names := make(map[string]bool, len(*upstream.Operations))
+	for i, operation := range *upstream.Operations {
+		opPrefix := fmt.Sprintf("%s.operations[%d]", fieldPrefix, i)
+		if operation.Name == "" || len(operation.Name) > 128 {
+			errors = append(errors, ValidationError{
+				Field:   opPrefix + ".name",
+				Message: "Name must be 1-128 characters",
+			})
+		} else if names[operation.Name] {
+			errors = append(errors, ValidationError{
+				Field:   opPrefix + ".name",
+				Message: fmt.Sprintf("Duplicate operation name '%s'", operation.Name),
+			})
+		}
+		names[operation.Name] = true
+
+		switch operation.Method {
+		case api.MCPRestOperationMethodGET, api.MCPRestOperationMethodPOST, api.MCPRestOperationMethodPUT,
+			api.MCPRestOperationMethodDELETE, api.MCPRestOperationMethodPATCH, api.MCPRestOperationMethodHEAD:
+		default:
+			errors = append(errors, ValidationError{
+				Field:   opPrefix + ".method",
+				Message: "Method must be one of GET, POST, PUT, DELETE, PATCH or HEAD",
+			})
+		}
+		if !strings.HasPrefix(operation.Path, "/") {
+			errors = append(errors, ValidationError{
+				Field:   opPrefix + ".path",
+				Message: "Path must start with /",
+			})
+		}
+		if operation.Parameters != nil {
+			for j, param := range *operation.Parameters {
+				if param.Name == "" {
+					errors = append(errors, ValidationError{
+						Field:   fmt.Sprintf("%s.parameters[%d].name", opPrefix, j),
+						Message: "Parameter name is required",
+					})
+				}
+				switch param.In {
+				case api.MCPRestParameterInPath, api.MCPRestParameterInQuery, api.MCPRestParameterInHeader:
+				default:
+					errors = append(errors, ValidationError{
+						Field:   fmt.Sprintf("%s.parameters[%d].in", opPrefix, j),
+						Message: "Parameter location must be one of path, query or header",
+					})
+				}
+			}
+		}
+		if operation.InputSchema != nil {
+			var schema map[string]any
+			if err := json.Unmarshal([]byte(*operation.InputSchema), &schema); err != nil {
+				errors = append(errors, ValidationError{
+					Field:   opPrefix + ".inputSchema",
+					Message: fmt.Sprintf("Input schema must be a JSON object: %v", err),
+				})
+			}
+		}
+	}
+
+	return errors
+}
+
+// validateVirtualCapabilities validates the tools or prompts curated from one upstream
+func validateVirtualCapabilities(fieldPrefix string, capabilities *[]api.MCPVirtualCapability) []ValidationError {
+	var errors []ValidationError
+	if capabilities == nil {
+		return nil
+	}
+	for i, capability := range *capabilities {
+		if capability.Name == "" || len(capability.Name) > 256 {
+			errors = append(errors, ValidationError{
+				Field:   fmt.Sprintf("%s[%d].name", fieldPrefix, i),
+				Message: "Name must be 1-256 characters",
+			})
+		}
+		if capability.As != nil && (*capability.As == "" || len(*capability.As) > 256) {
+			errors = append(errors, ValidationError{
+				Field:   fmt.Sprintf("%s[%d].as", fieldPrefix, i),
+				Message: "Exposed name must be 1-256 characters",
+			})
+		}
+	}
+	return errors
+}
+
 // validateContext validates the context path
 func (v *MCPValidator) validateContextAndVhost(context, vhost *string) []ValidationError {
 	var errors []ValidationError
@@ -240,38 +467,46 @@ func (v *MCPValidator) validateUpstream(fieldPrefix string, upstream *api.MCPPro
 
 	// Validate auth if present
 	if upstream.Auth != nil {
-		auth := upstream.Auth
-		// Validate 'type'
-		if auth.Type == "" {
-			errors = append(errors, ValidationError{
-				Field:   fmt.Sprintf("%s.auth.type", fieldPrefix),
-				Message: "Auth type is required",
-			})
-		}
+		errors = append(errors, validateUpstreamAuth(fieldPrefix+".auth", string(upstream.Auth.Type),
+			upstream.Auth.Header, upstream.Auth.Value)...)
+	}
 
-		if auth.Header == nil || *auth.Header == "" {
-			errors = append(errors, ValidationError{
-				Field:   fmt.Sprintf("%s.auth.header", fieldPrefix),
-				Message: "Auth header is required",
-			})
-		}
-		if auth.Value == nil || *auth.Value == "" {
+	return errors
+}
+
+// validateUpstreamAuth validates the credentials sent to an upstream
+func validateUpstreamAuth(fieldPrefix, authType string, header, value *string) []ValidationError {
+	var errors []ValidationError
+
+	if authType == "" {
+		errors = append(errors, ValidationError{
+			Field:   fieldPrefix + ".type",
+			Message: "Auth type is required",
+		})
+	}
+
+	if header == nil || *header == "" {
+		errors = append(errors, ValidationError{
+			Field:   fieldPrefix + ".header",
+			Message: "Auth header is required",
+		})
+	}
+	if value == nil || *value == "" {
+		errors = append(errors, ValidationError{
+			Field:   fieldPrefix + ".value",
+			Message: "Auth value is required",
+		})
+	}
+
+	if authType == "bearer" {
+		// For Bearer token, value should start with "Bearer or "bearer "
+		if value != nil &&
+			!strings.HasPrefix(*value, "Bearer ") && !strings.HasPrefix(*value, "bearer ") {
 			errors = append(errors, ValidationError{
-				Field:   fmt.Sprintf("%s.auth.value", fieldPrefix),
-				Message: "Auth value is required",
+				Field:   fieldPrefix + ".value",
+				Message: "Bearer token value must start with 'Bearer ' or 'bearer '",
 			})
 		}
-
-		if auth.Type == api.MCPProxyConfigDataUpstreamAuthType("bearer") {
-			// For Bearer token, value should start with "Bearer or "bearer "
-			if auth.Value != nil &&
-				!strings.HasPrefix(*auth.Value, "Bearer ") && !strings.HasPrefix(*auth.Value, "bearer ") {
-				errors = append(errors, ValidationError{
-					Field:   fmt.Sprintf("%s.auth.value", fieldPrefix),
-					Message: "Bearer token value must start with 'Bearer ' or 'bearer '",
-				})
-			}
-		}
 	}
 
 	return errors
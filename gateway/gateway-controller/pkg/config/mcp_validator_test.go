@@ -159,6 +159,11 @@ func TestMCPValidator_ValidateKind(t *testing.T) {
 			kind:      "Mcp",
 			wantError: false,
 		},
+		{
+			name:      "Valid kind VirtualMcp",
+			kind:      "VirtualMcp",
+			wantError: false,
+		},
 		{
 			name:      "Invalid kind",
 			kind:      "InvalidKind",
@@ -656,3 +661,225 @@ func TestMCPValidator_ValidateUpstreamAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestMCPValidator_ValidateVirtualUpstreams(t *testing.T) {
+	v := NewMCPValidator()
+
+	validUpstream := func(name string) api.MCPVirtualUpstream {
+		return api.MCPVirtualUpstream{Name: name, Url: stringPtr("http://" + name + ":3000")}
+	}
+
+	tests := []struct {
+		name      string
+		kind      api.MCPProxyConfigurationKind
+		upstream  api.MCPProxyConfigData_Upstream
+		upstreams *[]api.MCPVirtualUpstream
+		errFields []string
+	}{
+		{
+			name:      "Valid upstreams",
+			kind:      "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{validUpstream("github"), validUpstream("jira")},
+		},
+		{
+			name: "Valid curated upstream",
+			kind: "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{
+				Name:       "github",
+				Url:        stringPtr("https://github-mcp.example.com"),
+				ToolPrefix: stringPtr("github_"),
+				Tools:      &[]api.MCPVirtualCapability{{Name: "search", As: stringPtr("search_code")}},
+				Prompts:    &[]api.MCPVirtualCapability{{Name: "review"}},
+				Resources:  &[]string{"repo://readme"},
+				Auth: &api.MCPUpstreamAuth{
+					Type:   api.MCPUpstreamAuthTypeApiKey,
+					Header: stringPtr("Authorization"),
+					Value:  stringPtr("Bearer token"),
+				},
+			}},
+		},
+		{
+			name:      "Missing upstreams",
+			kind:      "VirtualMcp",
+			errFields: []string{"spec.upstreams"},
+		},
+		{
+			name:      "Empty upstreams",
+			kind:      "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{},
+			errFields: []string{"spec.upstreams"},
+		},
+		{
+			name:      "Single upstream on VirtualMcp",
+			kind:      "VirtualMcp",
+			upstream:  api.MCPProxyConfigData_Upstream{Url: stringPtr("http://backend:8080")},
+			upstreams: &[]api.MCPVirtualUpstream{validUpstream("github")},
+			errFields: []string{"spec.upstream"},
+		},
+		{
+			name:      "Upstreams on Mcp",
+			kind:      "Mcp",
+			upstream:  api.MCPProxyConfigData_Upstream{Url: stringPtr("http://backend:8080")},
+			upstreams: &[]api.MCPVirtualUpstream{validUpstream("github")},
+			errFields: []string{"spec.upstreams"},
+		},
+		{
+			name:      "Missing upstream on Mcp",
+			kind:      "Mcp",
+			errFields: []string{"spec.upstream.url"},
+		},
+		{
+			name:      "Duplicate upstream names",
+			kind:      "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{validUpstream("github"), validUpstream("github")},
+			errFields: []string{"spec.upstreams[1].name"},
+		},
+		{
+			name:      "Invalid upstream name",
+			kind:      "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{Name: "-github", Url: stringPtr("http://github:3000")}},
+			errFields: []string{"spec.upstreams[0].name"},
+		},
+		{
+			name:      "Invalid upstream URL",
+			kind:      "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{Name: "github", Url: stringPtr("ftp://github:21")}},
+			errFields: []string{"spec.upstreams[0].url"},
+		},
+		{
+			name: "Upstream auth without header",
+			kind: "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{
+				Name: "github",
+				Url:  stringPtr("http://github:3000"),
+				Auth: &api.MCPUpstreamAuth{Type: api.MCPUpstreamAuthTypeApiKey, Value: stringPtr("secret")},
+			}},
+			errFields: []string{"spec.upstreams[0].auth.header"},
+		},
+		{
+			name: "Empty exposed tool name",
+			kind: "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{
+				Name:  "github",
+				Url:   stringPtr("http://github:3000"),
+				Tools: &[]api.MCPVirtualCapability{{Name: "search", As: stringPtr("")}},
+			}},
+			errFields: []string{"spec.upstreams[0].tools[0].as"},
+		},
+		{
+			name: "Empty resource URI",
+			kind: "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{
+				Name:      "github",
+				Url:       stringPtr("http://github:3000"),
+				Resources: &[]string{" "},
+			}},
+			errFields: []string{"spec.upstreams[0].resources[0]"},
+		},
+		{
+			name: "RestApi upstream with operations",
+			kind: "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{
+				Name:    "books",
+				RestApi: stringPtr("books-api-v1.0"),
+				Operations: &[]api.MCPRestOperation{{
+					Name:        "get_book",
+					Method:      api.MCPRestOperationMethodGET,
+					Path:        "/books/{id}",
+					Parameters:  &[]api.MCPRestParameter{{Name: "id", In: api.MCPRestParameterInPath}},
+					InputSchema: stringPtr(`{"type":"object","properties":{"id":{"type":"string"}}}`),
+				}},
+			}},
+		},
+		{
+			name:      "Both url and restApi",
+			kind:      "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{Name: "books", Url: stringPtr("http://books:3000"), RestApi: stringPtr("books-api-v1.0")}},
+			errFields: []string{"spec.upstreams[0]"},
+		},
+		{
+			name:      "Neither url nor restApi",
+			kind:      "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{Name: "books"}},
+			errFields: []string{"spec.upstreams[0]"},
+		},
+		{
+			name:      "RestApi upstream without operations",
+			kind:      "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{Name: "books", RestApi: stringPtr("books-api-v1.0")}},
+		},
+		{
+			name: "Operations on an MCP server upstream",
+			kind: "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{
+				Name:       "github",
+				Url:        stringPtr("http://github:3000"),
+				Operations: &[]api.MCPRestOperation{{Name: "search", Method: api.MCPRestOperationMethodGET, Path: "/search"}},
+			}},
+			errFields: []string{"spec.upstreams[0].operations"},
+		},
+		{
+			name: "Invalid RestApi operations",
+			kind: "VirtualMcp",
+			upstreams: &[]api.MCPVirtualUpstream{{
+				Name:    "books",
+				RestApi: stringPtr("books-api-v1.0"),
+				Prompts: &[]api.MCPVirtualCapability{{Name: "summarize"}},
+				Operations: &[]api.MCPRestOperation{
+					{Name: "list_books", Method: "TRACE", Path: "/books"},
+					{Name: "list_books", Method: api.MCPRestOperationMethodGET, Path: "books"},
+					{
+						Name:        "get_book",
+						Method:      api.MCPRestOperationMethodGET,
+						Path:        "/books/{id}",
+						Parameters:  &[]api.MCPRestParameter{{Name: "id", In: "cookie"}},
+						InputSchema: stringPtr("{"),
+					},
+				},
+			}},
+			errFields: []string{
+				"spec.upstreams[0].prompts",
+				"spec.upstreams[0].operations[0].method",
+				"spec.upstreams[0].operations[1].name",
+				"spec.upstreams[0].operations[1].path",
+				"spec.upstreams[0].operations[2].parameters[0].in",
+				"spec.upstreams[0].operations[2].inputSchema",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			specVersion := constants.SPEC_VERSION_2025_JUNE
+			config := &api.MCPProxyConfiguration{
+				ApiVersion: api.MCPProxyConfigurationApiVersionGatewayApiPlatformWso2Comv1alpha1,
+				Kind:       tt.kind,
+				Metadata:   api.Metadata{Name: "test"},
+				Spec: api.MCPProxyConfigData{
+					DisplayName: "Test",
+					Version:     "v1.0",
+					Context:     stringPtr("/test"),
+					SpecVersion: &specVersion,
+					Upstream:    tt.upstream,
+					Upstreams:   tt.upstreams,
+				},
+			}
+
+			errors := v.Validate(config)
+			var fields []string
+			for _, e := range errors {
+				if strings.HasPrefix(e.Field, "spec.upstream") {
+					fields = append(fields, e.Field)
+				}
+			}
+			if len(fields) != len(tt.errFields) {
+				t.Fatalf("expected upstream errors %v, got %v", tt.errFields, errors)
+			}
+			for i, field := range tt.errFields {
+				if fields[i] != field {
+					t.Errorf("expected error on %s, got %s", field, fields[i])
+				}
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	versionutil "github.com/wso2/api-platform/common/version"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
@@ -141,6 +142,36 @@ func (pv *PolicyValidator) validatePolicy(policy api.Policy, fieldPath string) [
 
 	}
 
+	errors = append(errors, validatePolicyFaultSettings(policy, fieldPath)...)
+
+	return errors
+}
+
+// validatePolicyFaultSettings validates the optional per-policy timeout and onError mode
+func validatePolicyFaultSettings(policy api.Policy, fieldPath string) []ValidationError {
+	var errors []ValidationError
+
+	if policy.Timeout != nil {
+		d, err := time.ParseDuration(*policy.Timeout)
+		if err != nil || d <= 0 {
+			errors = append(errors, ValidationError{
+				Field:   fieldPath + ".timeout",
+				Message: fmt.Sprintf("Invalid timeout '%s': must be a positive duration such as 500ms or 2s", *policy.Timeout),
+			})
+		}
+	}
+
+	if policy.OnError != nil {
+		switch *policy.OnError {
+		case api.FailClosed, api.FailOpen, api.Skip:
+		default:
+			errors = append(errors, ValidationError{
+				Field:   fieldPath + ".onError",
+				Message: fmt.Sprintf("Invalid onError '%s': must be one of fail-closed, fail-open, skip", *policy.OnError),
+			})
+		}
+	}
+
 	return errors
 }
 
@@ -980,3 +980,57 @@ func stringContains(s, substr string) bool {
 	}
 	return false
 }
+
+func TestPolicyValidator_FaultSettings(t *testing.T) {
+	policyDefs := map[string]models.PolicyDefinition{
+		"allow-all|v1.0.0": {
+			Name:    "allow-all",
+			Version: "v1.0.0",
+		},
+	}
+	validator := NewPolicyValidator(policyDefs)
+
+	strPtr := func(s string) *string { return &s }
+	onErrorPtr := func(m api.PolicyOnError) *api.PolicyOnError { return &m }
+
+	tests := []struct {
+		name       string
+		policy     api.Policy
+		wantFields []string
+	}{
+		{
+			name:   "valid timeout and onError",
+			policy: api.Policy{Name: "allow-all", Version: "v1", Timeout: strPtr("250ms"), OnError: onErrorPtr(api.FailOpen)},
+		},
+		{
+			name:       "unparsable timeout",
+			policy:     api.Policy{Name: "allow-all", Version: "v1", Timeout: strPtr("soon")},
+			wantFields: []string{"spec.policies[0].timeout"},
+		},
+		{
+			name:       "non-positive timeout",
+			policy:     api.Policy{Name: "allow-all", Version: "v1", Timeout: strPtr("0s")},
+			wantFields: []string{"spec.policies[0].timeout"},
+		},
+		{
+			name:       "unknown onError",
+			policy:     api.Policy{Name: "allow-all", Version: "v1", OnError: onErrorPtr("retry")},
+			wantFields: []string{"spec.policies[0].onError"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errors := validator.validatePolicy(tt.policy, "spec.policies[0]")
+			fields := make([]string, 0, len(errors))
+			for _, e := range errors {
+				fields = append(fields, e.Field)
+			}
+			if len(tt.wantFields) == 0 {
+				assert.Empty(t, errors)
+			} else {
+				assert.Equal(t, tt.wantFields, fields)
+			}
+		})
+	}
+}
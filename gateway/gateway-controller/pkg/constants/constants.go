@@ -94,6 +94,12 @@ const (
 	MCP_PRM_RESOURCE_PATH      = "/.well-known/oauth-protected-resource"
 	SPEC_VERSION_2025_JUNE     = "2025-06-18"
 	SPEC_VERSION_2025_NOVEMBER = "2025-11-25"
+	VIRTUAL_MCP_PATH_PREFIX    = "/virtual-mcp"
+	// MCP_AGGREGATOR_TOKEN_HEADER carries the router token on requests forwarded to the MCP aggregator
+	MCP_AGGREGATOR_TOKEN_HEADER = "X-MCP-Aggregator-Token"
+	// MCP_AGGREGATOR_CLUSTER_NAME is the router cluster of the MCP aggregator. Only this cluster
+	// adds the router token, so other APIs pointing at the aggregator are still rejected.
+	MCP_AGGREGATOR_CLUSTER_NAME = "MCP_AGGREGATOR_CLUSTER"
 
 	// Router constants
 	BASE_PATH = "/"
@@ -113,6 +119,15 @@ const (
 	// Cluster names follow the format: upstream_<definition_name>
 	UpstreamDefinitionClusterPrefix = "upstream_"
 
+	// MirrorClusterPrefix is the prefix used for the dedicated clusters traffic mirrors send to
+	// Cluster names follow the format: mirror_<kind>_<api_id>_<scheme>_<host>
+	MirrorClusterPrefix = "mirror_"
+
+	// MirrorRouteStatPrefix is the route stat prefix for routes that mirror traffic, so the
+	// primary's response codes can be compared with the mirror cluster's per API
+	// Stat prefixes follow the format: mirror_primary_<kind>_<api_id>
+	MirrorRouteStatPrefix = "mirror_primary_"
+
 	WEBSUB_PATH                    = "/hub"
 	WEBSUB_HUB_INTERNAL_HTTP_PORT  = 8083
 	WEBSUB_HUB_INTERNAL_HTTPS_PORT = 8446
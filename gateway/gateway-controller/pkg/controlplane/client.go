@@ -234,7 +234,7 @@ func NewClient(
 		eventHubInstance,
 		gatewayID,
 		secretResolver,
-	)
+	).WithMCPAggregator(&systemConfig.Controller.MCP.Aggregator)
 
 	// Initialize API utils service with the proper base URL using the method
 	client.apiUtilsService = utils.NewAPIUtilsService(utils.PlatformAPIConfig{
@@ -554,6 +554,15 @@ func (c *Client) isOnPrem() bool {
 	return c.IsOnPrem()
 }
 
+// FetchOpenAPIDefinition returns the OpenAPI definition the control plane stores for an API
+// deployed from it, or nil when the API has none or no control plane is configured
+func (c *Client) FetchOpenAPIDefinition(apiID string) ([]byte, error) {
+	if c.config.Token == "" {
+		return nil, nil
+	}
+	return c.apiUtilsService.FetchOpenAPIDefinition(apiID)
+}
+
 // discoverGatewayPath fetches the gateway websocket base path from the control plane well-known endpoint.
 func (c *Client) discoverGatewayPath() (string, error) {
 	wellKnownURL := fmt.Sprintf("https://%s/internal/gateway/.well-known", c.config.Host)
@@ -23,6 +23,7 @@ import (
 
 	"github.com/wso2/api-platform/common/eventhub"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/templateengine"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/utils"
@@ -62,7 +63,11 @@ func (l *EventListener) handleMCPProxyCreateOrUpdate(event eventhub.Event) {
 			slog.String("kind", storedConfig.Kind))
 		return
 	}
-	if err := utils.HydrateStoredMCPConfig(storedConfig); err != nil {
+	var aggregator *config.MCPAggregatorConfig
+	if l.systemConfig != nil {
+		aggregator = &l.systemConfig.Controller.MCP.Aggregator
+	}
+	if err := utils.HydrateStoredMCPConfig(storedConfig, aggregator); err != nil {
 		l.logger.Error("Failed to hydrate MCP proxy configuration from source",
 			slog.String("proxy_id", entityID),
 			slog.Any("error", err))
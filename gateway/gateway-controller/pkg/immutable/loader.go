@@ -19,6 +19,9 @@
 package immutable
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -27,29 +30,41 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/service/restapi"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/utils"
 )
 
-// ImmutableGW manages immutable gateway mode: artifact loading on startup and
+// ImmutableGW manages immutable gateway mode: artifact loading on startup,
+// optional tracking of a watched directory or git repository afterwards, and
 // read-only enforcement on the management API at runtime.
 // When cfg.Enabled is false, all methods are no-ops.
 type ImmutableGW struct {
-	cfg            config.ImmutableGatewayConfig
-	restAPIService *restapi.RestAPIService
-	llmService     *utils.LLMDeploymentService
-	mcpService     *utils.MCPDeploymentService
-	parser         *config.Parser
+	cfg      config.ImmutableGatewayConfig
+	db       storage.Storage
+	deployer artifactDeployer
+	source   artifactSource
+	parser   *config.Parser
+
+	// syncMu serialises startup loading and sync runs
+	syncMu sync.Mutex
+	// mu guards applied and status, which the admin server reads
+	mu      sync.RWMutex
+	applied map[artifactKey]*artifact
+	status  syncStatus
 }
 
 // NewImmutableGW creates an ImmutableGW. All methods are no-ops when cfg.Enabled is false.
 func NewImmutableGW(
 	cfg config.ImmutableGatewayConfig,
+	db storage.Storage,
 	restAPIService *restapi.RestAPIService,
 	llmService *utils.LLMDeploymentService,
 	mcpService *utils.MCPDeploymentService,
@@ -57,16 +72,67 @@ func NewImmutableGW(
 	if cfg.Enabled && restAPIService == nil {
 		panic("ImmutableGW requires non-nil RestAPIService when immutable mode is enabled")
 	}
+	if cfg.Enabled && cfg.Sync.Mode != "" && cfg.Sync.Mode != config.ImmutableSyncModeStatic && db == nil {
+		panic("ImmutableGW requires non-nil storage when artifact sync is enabled")
+	}
 	return &ImmutableGW{
-		cfg:            cfg,
-		restAPIService: restAPIService,
-		llmService:     llmService,
-		mcpService:     mcpService,
-		parser:         config.NewParser(),
+		cfg: cfg,
+		db:  db,
+		deployer: &serviceDeployer{
+			restAPIService: restAPIService,
+			llmService:     llmService,
+			mcpService:     mcpService,
+		},
+		source:  newArtifactSource(cfg),
+		parser:  config.NewParser(),
+		applied: make(map[artifactKey]*artifact),
 	}
 }
 
-// LoadArtifacts walks the configured artifacts directory and applies all YAML resources
+// artifactKey identifies an artifact across revisions
+type artifactKey struct {
+	kind   string
+	handle string
+}
+
+func (k artifactKey) String() string {
+	return k.kind + "/" + k.handle
+}
+
+// artifact is a resource file read from the artifacts directory
+type artifact struct {
+	path        string
+	data        []byte
+	kind        string
+	handle      string
+	contentType string
+	digest      string
+}
+
+func (a *artifact) key() artifactKey {
+	return artifactKey{kind: a.kind, handle: a.handle}
+}
+
+// kindOrder returns the dependency pass of a kind:
+//
+//	0: LlmProviderTemplate — no dependencies
+//	1: LlmProvider         — depends on LlmProviderTemplate
+//	2: RestApi, WebSubApi, LlmProxy, Mcp — LlmProxy depends on LlmProvider
+//
+// Unsupported kinds return -1.
+func kindOrder(kind string) int {
+	switch kind {
+	case string(api.LLMProviderTemplateKindLlmProviderTemplate):
+		return 0
+	case models.KindLlmProvider:
+		return 1
+	case models.KindRestApi, models.KindWebSubApi, models.KindLlmProxy, models.KindMcp:
+		return 2
+	}
+	return -1
+}
+
+// LoadArtifacts reads the configured artifact source and applies all YAML resources
 // via the service layer in dependency order. It is a no-op when immutable mode is disabled.
 // All artifacts are attempted; any failures are collected and returned as a single joined
 // error so the caller sees the full set of problems on startup.
@@ -74,31 +140,76 @@ func (g *ImmutableGW) LoadArtifacts(log *slog.Logger) error {
 	if !g.cfg.Enabled {
 		return nil
 	}
+	g.syncMu.Lock()
+	defer g.syncMu.Unlock()
 
-	log.Info("Scanning artifacts directory for immutable gateway", slog.String("dir", g.cfg.ArtifactsDir))
+	log.Info("Scanning artifacts for immutable gateway",
+		slog.String("mode", g.syncMode()), slog.String("source", g.source.Location()))
 
-	type artifact struct {
-		path        string
-		data        []byte
-		kind        string
-		contentType string
+	revision, artifacts, err := g.readRevision(context.Background())
+	if err != nil {
+		g.recordAttempt(revision, err)
+		return err
 	}
 
-	// Dependency order:
-	//   pass1: LlmProviderTemplate — no dependencies
-	//   pass2: LlmProvider         — depends on LlmProviderTemplate
-	//   pass3: RestApi, WebSubApi, LlmProxy, Mcp — LlmProxy depends on LlmProvider
-	var pass1, pass2, pass3 []artifact
+	counts := make(map[int]int)
+	for _, a := range artifacts {
+		counts[kindOrder(a.kind)]++
+	}
+	log.Info("Discovered artifacts", slog.Int("total", len(artifacts)),
+		slog.String("revision", revision),
+		slog.Int("llm_provider_templates", counts[0]),
+		slog.Int("llm_providers", counts[1]))
 
-	if err := filepath.WalkDir(g.cfg.ArtifactsDir, func(path string, d fs.DirEntry, walkErr error) error {
+	var errs []error
+	loaded := make([]*artifact, 0, len(artifacts))
+	for _, a := range artifacts {
+		log.Info("Applying artifact", slog.String("path", a.path), slog.String("kind", a.kind))
+		if err := g.deployer.create(a, log); err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply %s %s: %w", a.kind, a.path, err))
+			continue
+		}
+		loaded = append(loaded, a)
+	}
+	if len(errs) > 0 {
+		err := errors.Join(errs...)
+		// Remember what was deployed so the next revision is diffed against it
+		g.mu.Lock()
+		for _, a := range loaded {
+			g.applied[a.key()] = a
+		}
+		g.mu.Unlock()
+		g.recordFailure(revision, err)
+		return err
+	}
+
+	g.commit(revision, loaded)
+	log.Info("All immutable gateway artifacts loaded", slog.Int("count", len(artifacts)))
+	return nil
+}
+
+// scanArtifacts walks dir and parses every YAML and JSON resource in it. Hidden files and
+// directories (such as .git, or the ..data links of a mounted ConfigMap) are skipped. The
+// artifacts are returned in dependency order; a missing kind or name, an unsupported kind or
+// two artifacts with the same kind and name fail the scan.
+func (g *ImmutableGW) scanArtifacts(dir string) ([]*artifact, error) {
+	passes := make([][]*artifact, 3)
+	seen := make(map[artifactKey]string)
+
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, walkErr error) error {
 		if walkErr != nil {
 			return fmt.Errorf("error accessing path %s: %w", path, walkErr)
 		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
 		if d.IsDir() {
 			return nil
 		}
-		ext := strings.ToLower(filepath.Ext(path))
-		if ext != ".yaml" && ext != ".yml" && ext != ".json" {
+		if !isArtifactFile(path) {
 			return nil
 		}
 
@@ -108,12 +219,15 @@ func (g *ImmutableGW) LoadArtifacts(log *slog.Logger) error {
 		}
 
 		contentType := "application/x-yaml"
-		if ext == ".json" {
+		if strings.EqualFold(filepath.Ext(path), ".json") {
 			contentType = "application/json"
 		}
 
 		var envelope struct {
-			Kind string `yaml:"kind" json:"kind"`
+			Kind     string `yaml:"kind" json:"kind"`
+			Metadata struct {
+				Name string `yaml:"name" json:"name"`
+			} `yaml:"metadata" json:"metadata"`
 		}
 		if err := g.parser.Parse(data, contentType, &envelope); err != nil {
 			return fmt.Errorf("failed to parse kind from %s: %w", path, err)
@@ -121,92 +235,202 @@ func (g *ImmutableGW) LoadArtifacts(log *slog.Logger) error {
 		if envelope.Kind == "" {
 			return fmt.Errorf("artifact %s has no 'kind' field", path)
 		}
-
-		a := artifact{path: path, data: data, kind: envelope.Kind, contentType: contentType}
-		switch envelope.Kind {
-		case string(api.LLMProviderTemplateKindLlmProviderTemplate):
-			pass1 = append(pass1, a)
-		case models.KindLlmProvider:
-			pass2 = append(pass2, a)
-		case models.KindRestApi, models.KindWebSubApi, models.KindLlmProxy, models.KindMcp:
-			pass3 = append(pass3, a)
-		default:
+		// A VirtualMcp proxy is deployed and stored like any other MCP proxy
+		if envelope.Kind == string(api.MCPProxyConfigurationKindVirtualMcp) {
+			envelope.Kind = models.KindMcp
+		}
+		order := kindOrder(envelope.Kind)
+		if order < 0 {
 			return fmt.Errorf("artifact %s has unsupported kind %q", path, envelope.Kind)
 		}
+		if envelope.Metadata.Name == "" {
+			return fmt.Errorf("artifact %s has no 'metadata.name' field", path)
+		}
+
+		sum := sha256.Sum256(data)
+		a := &artifact{
+			path:        path,
+			data:        data,
+			kind:        envelope.Kind,
+			handle:      envelope.Metadata.Name,
+			contentType: contentType,
+			digest:      hex.EncodeToString(sum[:]),
+		}
+		if other, ok := seen[a.key()]; ok {
+			return fmt.Errorf("artifacts %s and %s both define %s", other, path, a.key())
+		}
+		seen[a.key()] = path
+		passes[order] = append(passes[order], a)
 		return nil
 	}); err != nil {
-		return err
+		return nil, err
 	}
 
-	total := len(pass1) + len(pass2) + len(pass3)
-	log.Info("Discovered artifacts", slog.Int("total", total),
-		slog.Int("llm_provider_templates", len(pass1)),
-		slog.Int("llm_providers", len(pass2)))
+	return append(append(passes[0], passes[1]...), passes[2]...), nil
+}
 
-	var errs []error
-	for _, a := range append(append(pass1, pass2...), pass3...) {
-		if err := g.applyArtifact(a.path, a.kind, a.contentType, a.data, log); err != nil {
-			errs = append(errs, err)
+// contentRevision derives a revision for sources that carry none of their own from the
+// names and contents of the artifact files in dir, using the same rules as scanArtifacts.
+// It does not parse the files, so a directory that fails to scan still has a stable revision.
+func contentRevision(dir string) (string, error) {
+	h := sha256.New()
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return fmt.Errorf("error accessing path %s: %w", path, walkErr)
 		}
+		if path != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || !isArtifactFile(path) {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read artifact %s: %w", path, err)
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(data)
+		fmt.Fprintf(h, "%s\x00%x\n", filepath.ToSlash(rel), sum)
+		return nil
+	}); err != nil {
+		return "", err
 	}
-	if len(errs) > 0 {
-		return errors.Join(errs...)
-	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
 
-	log.Info("All immutable gateway artifacts loaded", slog.Int("count", total))
-	return nil
+func isArtifactFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml" || ext == ".json"
 }
 
-func (g *ImmutableGW) applyArtifact(path, kind, contentType string, data []byte, log *slog.Logger) error {
-	log.Info("Applying artifact", slog.String("path", path), slog.String("kind", kind))
+// artifactDeployer applies artifacts through the deployment services
+type artifactDeployer interface {
+	create(a *artifact, log *slog.Logger) error
+	update(a *artifact, log *slog.Logger) error
+	delete(kind, handle string, log *slog.Logger) error
+}
 
-	switch kind {
+// serviceDeployer is the artifactDeployer backed by the REST/LLM/MCP deployment services
+type serviceDeployer struct {
+	restAPIService *restapi.RestAPIService
+	llmService     *utils.LLMDeploymentService
+	mcpService     *utils.MCPDeploymentService
+}
+
+func (d *serviceDeployer) create(a *artifact, log *slog.Logger) error {
+	var err error
+	switch a.kind {
 	case string(api.LLMProviderTemplateKindLlmProviderTemplate):
-		if _, err := g.llmService.CreateLLMProviderTemplate(utils.LLMTemplateParams{
-			Spec:        data,
-			ContentType: contentType,
+		_, err = d.llmService.CreateLLMProviderTemplate(utils.LLMTemplateParams{
+			Spec:        a.data,
+			ContentType: a.contentType,
 			Logger:      log,
-		}); err != nil {
-			return fmt.Errorf("failed to apply %s %s: %w", kind, path, err)
-		}
+		})
 	case models.KindRestApi, models.KindWebSubApi:
-		if _, err := g.restAPIService.Create(restapi.CreateParams{
-			Body:        data,
-			ContentType: contentType,
-			Kind:        kind,
+		_, err = d.restAPIService.Create(restapi.CreateParams{
+			Body:        a.data,
+			ContentType: a.contentType,
+			Kind:        a.kind,
 			Logger:      log,
-		}); err != nil {
-			return fmt.Errorf("failed to apply %s %s: %w", kind, path, err)
-		}
+		})
 	case models.KindLlmProvider:
-		if _, err := g.llmService.CreateLLMProvider(utils.LLMDeploymentParams{
-			Data:        data,
-			ContentType: contentType,
+		_, err = d.llmService.CreateLLMProvider(utils.LLMDeploymentParams{
+			Data:        a.data,
+			ContentType: a.contentType,
 			Origin:      models.OriginGatewayAPI,
 			Logger:      log,
-		}); err != nil {
-			return fmt.Errorf("failed to apply %s %s: %w", kind, path, err)
-		}
+		})
 	case models.KindLlmProxy:
-		if _, err := g.llmService.CreateLLMProxy(utils.LLMDeploymentParams{
-			Data:        data,
-			ContentType: contentType,
+		_, err = d.llmService.CreateLLMProxy(utils.LLMDeploymentParams{
+			Data:        a.data,
+			ContentType: a.contentType,
 			Origin:      models.OriginGatewayAPI,
 			Logger:      log,
-		}); err != nil {
-			return fmt.Errorf("failed to apply %s %s: %w", kind, path, err)
-		}
+		})
 	case models.KindMcp:
-		if _, err := g.mcpService.CreateMCPProxy(utils.MCPDeploymentParams{
-			Data:        data,
-			ContentType: contentType,
+		_, err = d.mcpService.CreateMCPProxy(utils.MCPDeploymentParams{
+			Data:        a.data,
+			ContentType: a.contentType,
 			Origin:      models.OriginGatewayAPI,
 			Logger:      log,
-		}); err != nil {
-			return fmt.Errorf("failed to apply %s %s: %w", kind, path, err)
-		}
+		})
+	default:
+		err = fmt.Errorf("unsupported kind %q", a.kind)
 	}
-	return nil
+	return err
+}
+
+func (d *serviceDeployer) update(a *artifact, log *slog.Logger) error {
+	var err error
+	switch a.kind {
+	case string(api.LLMProviderTemplateKindLlmProviderTemplate):
+		_, err = d.llmService.UpdateLLMProviderTemplate(a.handle, utils.LLMTemplateParams{
+			Spec:        a.data,
+			ContentType: a.contentType,
+			Logger:      log,
+		})
+	case models.KindRestApi, models.KindWebSubApi:
+		_, err = d.restAPIService.Update(restapi.UpdateParams{
+			Handle:      a.handle,
+			Body:        a.data,
+			ContentType: a.contentType,
+			Kind:        a.kind,
+			Logger:      log,
+		})
+	case models.KindLlmProvider:
+		_, err = d.llmService.UpdateLLMProvider(a.handle, utils.LLMDeploymentParams{
+			Data:        a.data,
+			ContentType: a.contentType,
+			Origin:      models.OriginGatewayAPI,
+			Logger:      log,
+		})
+	case models.KindLlmProxy:
+		_, err = d.llmService.UpdateLLMProxy(a.handle, utils.LLMDeploymentParams{
+			Data:        a.data,
+			ContentType: a.contentType,
+			Origin:      models.OriginGatewayAPI,
+			Logger:      log,
+		})
+	case models.KindMcp:
+		_, err = d.mcpService.UpdateMCPProxy(a.handle, utils.MCPDeploymentParams{
+			Data:        a.data,
+			ContentType: a.contentType,
+			Origin:      models.OriginGatewayAPI,
+			Logger:      log,
+		}, log)
+	default:
+		err = fmt.Errorf("unsupported kind %q", a.kind)
+	}
+	return err
+}
+
+func (d *serviceDeployer) delete(kind, handle string, log *slog.Logger) error {
+	var err error
+	switch kind {
+	case string(api.LLMProviderTemplateKindLlmProviderTemplate):
+		_, err = d.llmService.DeleteLLMProviderTemplate(handle, "", log)
+	case models.KindRestApi, models.KindWebSubApi:
+		_, err = d.restAPIService.Delete(restapi.DeleteParams{
+			Handle: handle,
+			Kind:   kind,
+			Logger: log,
+		})
+	case models.KindLlmProvider:
+		_, err = d.llmService.DeleteLLMProvider(handle, "", log)
+	case models.KindLlmProxy:
+		_, err = d.llmService.DeleteLLMProxy(handle, "", log)
+	case models.KindMcp:
+		_, err = d.mcpService.DeleteMCPProxy(handle, "", log)
+	default:
+		err = fmt.Errorf("unsupported kind %q", kind)
+	}
+	return err
 }
 
 // Middleware returns a Gin handler that rejects POST, PUT, and DELETE with 405
@@ -228,3 +452,14 @@ func (g *ImmutableGW) Middleware() gin.HandlerFunc {
 		}
 	}
 }
+
+// syncMode returns the configured sync mode, defaulting to static
+func (g *ImmutableGW) syncMode() string {
+	if g.cfg.Sync.Mode == "" {
+		return config.ImmutableSyncModeStatic
+	}
+	return g.cfg.Sync.Mode
+}
+
+// now is replaced in tests
+var now = time.Now
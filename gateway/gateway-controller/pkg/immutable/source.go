@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package immutable
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+)
+
+// watchDebounce coalesces bursts of file system events, such as an editor saving
+// several files or a ConfigMap update swapping its data directory, into one sync
+const watchDebounce = 500 * time.Millisecond
+
+// artifactSource provides the directory the artifacts are read from
+type artifactSource interface {
+	// Location describes the source for logs and the admin API
+	Location() string
+	// Dir is the directory holding the artifacts after Fetch
+	Dir() string
+	// Fetch brings Dir up to date and returns the revision it holds. An empty revision
+	// means the source has no revisions of its own and the content digest is used instead.
+	Fetch(ctx context.Context) (string, error)
+	// Watch returns a channel signalled when the source may have changed, or nil when
+	// the source can only be polled. The channel is closed when ctx is done.
+	Watch(ctx context.Context, log *slog.Logger) <-chan struct{}
+}
+
+func newArtifactSource(cfg config.ImmutableGatewayConfig) artifactSource {
+	if cfg.Sync.Mode == config.ImmutableSyncModeGit {
+		return &gitSource{dir: cfg.ArtifactsDir, git: cfg.Sync.Git}
+	}
+	return &directorySource{dir: cfg.ArtifactsDir}
+}
+
+// directorySource reads artifacts from a local directory, such as a mounted ConfigMap
+type directorySource struct {
+	dir string
+}
+
+func (s *directorySource) Location() string { return s.dir }
+
+func (s *directorySource) Dir() string { return s.dir }
+
+func (s *directorySource) Fetch(context.Context) (string, error) {
+	if _, err := os.Stat(s.dir); err != nil {
+		return "", fmt.Errorf("failed to read artifacts directory %s: %w", s.dir, err)
+	}
+	return "", nil
+}
+
+func (s *directorySource) Watch(ctx context.Context, log *slog.Logger) <-chan struct{} {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.Warn("Failed to watch artifacts directory, falling back to polling",
+			slog.String("dir", s.dir), slog.Any("error", err))
+		return nil
+	}
+	if err := addWatchTree(watcher, s.dir); err != nil {
+		log.Warn("Failed to watch artifacts directory, falling back to polling",
+			slog.String("dir", s.dir), slog.Any("error", err))
+		watcher.Close()
+		return nil
+	}
+
+	changed := make(chan struct{}, 1)
+	go func() {
+		defer close(changed)
+		defer watcher.Close()
+
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Has(fsnotify.Create) {
+					// Watch directories created after startup as well
+					if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+						if err := addWatchTree(watcher, event.Name); err != nil {
+							log.Warn("Failed to watch new artifacts directory",
+								slog.String("dir", event.Name), slog.Any("error", err))
+						}
+					}
+				}
+				debounce = time.After(watchDebounce)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Warn("Artifacts directory watch error", slog.Any("error", err))
+			case <-debounce:
+				debounce = nil
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return changed
+}
+
+// addWatchTree adds dir and every directory below it to the watcher. Hidden directories
+// are watched too, since a ConfigMap volume publishes updates by swapping them.
+func addWatchTree(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return fs.SkipDir
+			}
+			return watcher.Add(path)
+		}
+		return nil
+	})
+}
+
+// gitSource keeps a shallow checkout of a branch of a git repository in the artifacts
+// directory. It shells out to the git CLI so that the credential helpers, SSH keys and
+// proxy settings configured for the gateway user apply unchanged.
+type gitSource struct {
+	dir string
+	git config.ImmutableGatewayGitConfig
+}
+
+func (s *gitSource) Location() string {
+	location := s.git.URL
+	if u, err := url.Parse(s.git.URL); err == nil && u.User != nil {
+		location = u.Redacted()
+	}
+	location += "#" + s.git.Branch
+	if s.git.Path != "" {
+		location += ":" + s.git.Path
+	}
+	return location
+}
+
+func (s *gitSource) Dir() string {
+	return filepath.Join(s.dir, s.git.Path)
+}
+
+func (s *gitSource) Fetch(ctx context.Context) (string, error) {
+	if _, err := os.Stat(filepath.Join(s.dir, ".git")); errors.Is(err, fs.ErrNotExist) {
+		if err := s.clone(ctx); err != nil {
+			return "", err
+		}
+	} else if err != nil {
+		return "", fmt.Errorf("failed to read git checkout %s: %w", s.dir, err)
+	} else {
+		if _, err := s.run(ctx, s.dir, "remote", "set-url", "origin", s.git.URL); err != nil {
+			return "", err
+		}
+		if _, err := s.run(ctx, s.dir, "fetch", "--depth", "1", "origin", s.git.Branch); err != nil {
+			return "", err
+		}
+		if _, err := s.run(ctx, s.dir, "checkout", "--force", "--detach", "FETCH_HEAD"); err != nil {
+			return "", err
+		}
+		if _, err := s.run(ctx, s.dir, "clean", "-fdx"); err != nil {
+			return "", err
+		}
+	}
+
+	revision, err := s.run(ctx, s.dir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(s.Dir()); err != nil {
+		return revision, fmt.Errorf("path %q not found in revision %s of %s", s.git.Path, revision, s.Location())
+	}
+	return revision, nil
+}
+
+func (s *gitSource) Watch(context.Context, *slog.Logger) <-chan struct{} {
+	return nil
+}
+
+// clone checks the branch out into the artifacts directory, which must be empty or absent
+func (s *gitSource) clone(ctx context.Context) error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to read artifacts directory %s: %w", s.dir, err)
+	}
+	if len(entries) > 0 {
+		return fmt.Errorf("artifacts directory %s is not empty and is not a git checkout", s.dir)
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create artifacts directory %s: %w", s.dir, err)
+	}
+	_, err = s.run(ctx, "", "clone", "--depth", "1", "--single-branch", "--branch", s.git.Branch,
+		"--", s.git.URL, s.dir)
+	return err
+}
+
+// run executes a git command and returns its trimmed standard output
+func (s *gitSource) run(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		// Keep credentials embedded in the repository URL out of logs and the admin API
+		output := strings.ReplaceAll(strings.TrimSpace(stderr.String()), s.git.URL, s.Location())
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, output)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
@@ -0,0 +1,384 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package immutable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	adminapi "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/admin"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+// syncedKinds are the stored configuration kinds an immutable gateway manages
+var syncedKinds = []string{
+	models.KindLlmProvider,
+	models.KindRestApi,
+	models.KindWebSubApi,
+	models.KindLlmProxy,
+	models.KindMcp,
+}
+
+type changeOp int
+
+const (
+	opCreate changeOp = iota
+	opUpdate
+	opDelete
+)
+
+func (o changeOp) String() string {
+	switch o {
+	case opCreate:
+		return "create"
+	case opUpdate:
+		return "update"
+	default:
+		return "delete"
+	}
+}
+
+// change is one step of a sync plan. artifact is the desired artifact for creates and
+// updates, and previous is the applied artifact an update or delete replaces, if known.
+type change struct {
+	op       changeOp
+	key      artifactKey
+	artifact *artifact
+	previous *artifact
+}
+
+// syncStatus records the applied revision and the outcome of the latest sync attempt
+type syncStatus struct {
+	revision            string
+	appliedAt           time.Time
+	artifactCount       int
+	lastAttemptRevision string
+	lastAttemptAt       time.Time
+	lastError           string
+	// failedRevision is the revision that last failed validation or deployment.
+	// It is not retried until the source moves to another revision.
+	failedRevision string
+}
+
+// Run tracks the artifact source until ctx is done, applying every new revision it finds.
+// It polls every sync.poll_interval and, in directory mode, also syncs on file system changes.
+// It returns immediately when immutable mode is disabled or the sync mode is static.
+func (g *ImmutableGW) Run(ctx context.Context, log *slog.Logger) {
+	if !g.cfg.Enabled || g.syncMode() == config.ImmutableSyncModeStatic {
+		return
+	}
+
+	log.Info("Tracking immutable gateway artifacts",
+		slog.String("mode", g.syncMode()),
+		slog.String("source", g.source.Location()),
+		slog.Duration("poll_interval", g.cfg.Sync.PollInterval))
+
+	ticker := time.NewTicker(g.cfg.Sync.PollInterval)
+	defer ticker.Stop()
+	changed := g.source.Watch(ctx, log)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case _, ok := <-changed:
+			if !ok {
+				changed = nil
+				continue
+			}
+		}
+		if err := g.Sync(ctx, log); err != nil && ctx.Err() == nil {
+			log.Error("Failed to sync immutable gateway artifacts, keeping the last applied revision",
+				slog.String("revision", g.appliedRevision()), slog.Any("error", err))
+		}
+	}
+}
+
+// Sync fetches the artifact source and converges the gateway on it. The changes are planned
+// against the configurations in storage and applied through the deployment services, deletes
+// first in reverse dependency order and then creates and updates in dependency order. When a
+// revision fails to parse, the gateway is left untouched; when a change fails to apply, the
+// changes already made are reverted so the gateway keeps serving the last applied revision.
+func (g *ImmutableGW) Sync(ctx context.Context, log *slog.Logger) error {
+	g.syncMu.Lock()
+	defer g.syncMu.Unlock()
+
+	g.mu.RLock()
+	applied, failed := g.status.revision, g.status.failedRevision
+	g.mu.RUnlock()
+
+	revision, artifacts, err := g.readRevision(ctx)
+	if err != nil {
+		if revision != "" && revision == failed {
+			return nil
+		}
+		g.recordAttempt(revision, err)
+		return err
+	}
+	if revision == applied {
+		// The source may have been reverted to the applied revision after a failure
+		g.recordAttempt(revision, nil)
+		return nil
+	}
+	if revision == failed {
+		return nil
+	}
+
+	changes, err := g.planChanges(artifacts)
+	if err != nil {
+		g.recordAttempt(revision, err)
+		return err
+	}
+
+	log.Info("Applying immutable gateway artifact revision",
+		slog.String("revision", revision), slog.Int("changes", len(changes)))
+
+	for i, c := range changes {
+		if err := g.applyChange(c, log); err != nil {
+			err = fmt.Errorf("failed to %s %s: %w", c.op, c.key, err)
+			if rollbackErr := g.rollback(changes[:i], log); rollbackErr != nil {
+				err = errors.Join(err, fmt.Errorf("rollback incomplete: %w", rollbackErr))
+			}
+			g.recordFailure(revision, err)
+			return err
+		}
+		log.Info("Applied artifact change", slog.String("op", c.op.String()), slog.String("artifact", c.key.String()))
+	}
+
+	g.commit(revision, artifacts)
+	log.Info("Immutable gateway artifact revision applied", slog.String("revision", revision))
+	return nil
+}
+
+// readRevision fetches the source and scans its artifacts. Artifacts that fail to parse
+// mark the revision as failed, so it is not retried until the source changes.
+func (g *ImmutableGW) readRevision(ctx context.Context) (string, []*artifact, error) {
+	revision, err := g.source.Fetch(ctx)
+	if err != nil {
+		return revision, nil, fmt.Errorf("failed to fetch artifacts from %s: %w", g.source.Location(), err)
+	}
+	if revision == "" {
+		if revision, err = contentRevision(g.source.Dir()); err != nil {
+			return "", nil, err
+		}
+	}
+	artifacts, err := g.scanArtifacts(g.source.Dir())
+	if err != nil {
+		g.mu.Lock()
+		g.status.failedRevision = revision
+		g.mu.Unlock()
+		return revision, nil, err
+	}
+	return revision, artifacts, nil
+}
+
+// planChanges diffs the desired artifacts against the configurations in storage. Only
+// configurations created through the gateway API are considered, so resources deployed by
+// the control plane are left alone, and LLM provider templates are only deleted when a
+// previous revision created them.
+func (g *ImmutableGW) planChanges(artifacts []*artifact) ([]change, error) {
+	current := make(map[artifactKey]bool)
+	for _, kind := range syncedKinds {
+		configs, err := g.db.GetAllConfigsByKind(kind)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s configurations: %w", kind, err)
+		}
+		for _, cfg := range configs {
+			if cfg.Origin == models.OriginGatewayAPI {
+				current[artifactKey{kind: cfg.Kind, handle: cfg.Handle}] = true
+			}
+		}
+	}
+	templates, err := g.db.GetAllLLMProviderTemplates()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list LLM provider templates: %w", err)
+	}
+	g.mu.RLock()
+	applied := make(map[artifactKey]*artifact, len(g.applied))
+	for k, a := range g.applied {
+		applied[k] = a
+	}
+	g.mu.RUnlock()
+	for _, t := range templates {
+		key := artifactKey{kind: string(api.LLMProviderTemplateKindLlmProviderTemplate), handle: t.GetHandle()}
+		if _, ok := applied[key]; ok {
+			current[key] = true
+		}
+	}
+
+	desired := make(map[artifactKey]bool, len(artifacts))
+	var upserts []change
+	for _, a := range artifacts {
+		desired[a.key()] = true
+		previous := applied[a.key()]
+		switch {
+		case !current[a.key()]:
+			upserts = append(upserts, change{op: opCreate, key: a.key(), artifact: a})
+		case previous == nil || previous.digest != a.digest:
+			upserts = append(upserts, change{op: opUpdate, key: a.key(), artifact: a, previous: previous})
+		}
+	}
+
+	var stale []artifactKey
+	for key := range current {
+		if !desired[key] {
+			stale = append(stale, key)
+		}
+	}
+	// Delete dependents before the providers and templates they reference
+	sort.Slice(stale, func(i, j int) bool {
+		if oi, oj := kindOrder(stale[i].kind), kindOrder(stale[j].kind); oi != oj {
+			return oi > oj
+		}
+		return stale[i].String() < stale[j].String()
+	})
+	changes := make([]change, 0, len(stale)+len(upserts))
+	for _, key := range stale {
+		changes = append(changes, change{op: opDelete, key: key, previous: applied[key]})
+	}
+	return append(changes, upserts...), nil
+}
+
+func (g *ImmutableGW) applyChange(c change, log *slog.Logger) error {
+	switch c.op {
+	case opCreate:
+		return g.deployer.create(c.artifact, log)
+	case opUpdate:
+		return g.deployer.update(c.artifact, log)
+	default:
+		return g.deployer.delete(c.key.kind, c.key.handle, log)
+	}
+}
+
+// rollback reverts applied changes in reverse order. A change whose previous artifact is
+// unknown, such as a configuration present in storage before the gateway applied anything,
+// cannot be restored and is reported in the returned error.
+func (g *ImmutableGW) rollback(applied []change, log *slog.Logger) error {
+	var errs []error
+	for i := len(applied) - 1; i >= 0; i-- {
+		c := applied[i]
+		var err error
+		switch {
+		case c.op == opCreate:
+			err = g.deployer.delete(c.key.kind, c.key.handle, log)
+		case c.previous == nil:
+			err = fmt.Errorf("previous content unknown")
+		case c.op == opUpdate:
+			err = g.deployer.update(c.previous, log)
+		default:
+			err = g.deployer.create(c.previous, log)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to revert %s of %s: %w", c.op, c.key, err))
+			continue
+		}
+		log.Info("Reverted artifact change", slog.String("op", c.op.String()), slog.String("artifact", c.key.String()))
+	}
+	return errors.Join(errs...)
+}
+
+// commit records artifacts as the applied revision
+func (g *ImmutableGW) commit(revision string, artifacts []*artifact) {
+	applied := make(map[artifactKey]*artifact, len(artifacts))
+	for _, a := range artifacts {
+		applied[a.key()] = a
+	}
+	at := now()
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.applied = applied
+	g.status.revision = revision
+	g.status.appliedAt = at
+	g.status.artifactCount = len(artifacts)
+	g.status.lastAttemptRevision = revision
+	g.status.lastAttemptAt = at
+	g.status.lastError = ""
+	g.status.failedRevision = ""
+}
+
+// recordAttempt records the outcome of a sync attempt that applied nothing
+func (g *ImmutableGW) recordAttempt(revision string, err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.status.lastAttemptRevision = revision
+	g.status.lastAttemptAt = now()
+	g.status.lastError = ""
+	if err != nil {
+		g.status.lastError = err.Error()
+	}
+}
+
+// recordFailure records a revision that failed to apply, so it is not retried
+func (g *ImmutableGW) recordFailure(revision string, err error) {
+	g.recordAttempt(revision, err)
+	g.mu.Lock()
+	g.status.failedRevision = revision
+	g.mu.Unlock()
+}
+
+func (g *ImmutableGW) appliedRevision() string {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.status.revision
+}
+
+// ArtifactSyncStatus reports the applied artifact revision and the outcome of the latest
+// sync attempt for the admin server.
+func (g *ImmutableGW) ArtifactSyncStatus() adminapi.ArtifactSyncStatusResponse {
+	if !g.cfg.Enabled {
+		return adminapi.ArtifactSyncStatusResponse{Status: "disabled"}
+	}
+
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	mode, source := g.syncMode(), g.source.Location()
+	resp := adminapi.ArtifactSyncStatusResponse{
+		Enabled: true,
+		Mode:    &mode,
+		Source:  &source,
+		Status:  "synced",
+	}
+	if g.status.revision != "" {
+		revision, appliedAt, count := g.status.revision, g.status.appliedAt, g.status.artifactCount
+		resp.Revision = &revision
+		resp.AppliedAt = &appliedAt
+		resp.ArtifactCount = &count
+	}
+	if !g.status.lastAttemptAt.IsZero() {
+		revision, at := g.status.lastAttemptRevision, g.status.lastAttemptAt
+		if revision != "" {
+			resp.LastAttemptRevision = &revision
+		}
+		resp.LastAttemptAt = &at
+	}
+	if g.status.lastError != "" {
+		lastError := g.status.lastError
+		resp.LastError = &lastError
+		resp.Status = "failed"
+	}
+	return resp
+}
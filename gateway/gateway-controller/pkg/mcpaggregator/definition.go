@@ -0,0 +1,340 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcpaggregator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi2"
+	"github.com/getkin/kin-openapi/openapi2conv"
+	"github.com/getkin/kin-openapi/openapi3"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"gopkg.in/yaml.v3"
+)
+
+// DefinitionFetcher fetches the OpenAPI definition the control plane stores for a RestApi
+// deployed from it. It returns nil when the API has no stored definition.
+type DefinitionFetcher interface {
+	FetchOpenAPIDefinition(apiID string) ([]byte, error)
+}
+
+// definitionCache keeps the parsed OpenAPI definitions of RestApis, so that the control
+// plane is only asked again once the RestApi is redeployed
+type definitionCache struct {
+	fetcher DefinitionFetcher
+	mu      sync.Mutex
+	entries map[string]cachedDefinition
+}
+
+type cachedDefinition struct {
+	deploymentID string
+	updatedAt    time.Time
+	// doc is nil when the RestApi has no stored definition
+	doc *openapi3.T
+}
+
+func newDefinitionCache(fetcher DefinitionFetcher) *definitionCache {
+	return &definitionCache{fetcher: fetcher, entries: make(map[string]cachedDefinition)}
+}
+
+// get returns the OpenAPI definition of a deployed RestApi, or nil when it has none. Only
+// RestApis deployed from the control plane have a stored definition.
+func (c *definitionCache) get(cfg *models.StoredConfig) (*openapi3.T, error) {
+	if c.fetcher == nil || cfg.Origin != models.OriginControlPlane {
+		return nil, nil
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[cfg.UUID]
+	c.mu.Unlock()
+	if ok && entry.deploymentID == cfg.DeploymentID && entry.updatedAt.Equal(cfg.UpdatedAt) {
+		return entry.doc, nil
+	}
+
+	data, err := c.fetcher.FetchOpenAPIDefinition(cfg.UUID)
+	if err != nil {
+		return nil, err
+	}
+	var doc *openapi3.T
+	if data != nil {
+		if doc, err = parseDefinition(data); err != nil {
+			return nil, err
+		}
+	}
+
+	c.mu.Lock()
+	c.entries[cfg.UUID] = cachedDefinition{deploymentID: cfg.DeploymentID, updatedAt: cfg.UpdatedAt, doc: doc}
+	c.mu.Unlock()
+	return doc, nil
+}
+
+// parseDefinition parses a YAML or JSON OpenAPI 3.x or Swagger 2.0 definition, with its
+// references resolved. Swagger 2.0 definitions are converted to OpenAPI 3.
+func parseDefinition(data []byte) (*openapi3.T, error) {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI definition: %w", err)
+	}
+	root, ok := normalizeYAML(raw).(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("OpenAPI definition is not an object")
+	}
+	content, err := json.Marshal(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode OpenAPI definition: %w", err)
+	}
+
+	if root["swagger"] != nil && root["openapi"] == nil {
+		var doc2 openapi2.T
+		if err := json.Unmarshal(content, &doc2); err != nil {
+			return nil, fmt.Errorf("failed to parse Swagger definition: %w", err)
+		}
+		return openapi2conv.ToV3(&doc2)
+	}
+	return openapi3.NewLoader().LoadFromData(content)
+}
+
+// normalizeYAML converts YAML mappings with non-string keys, such as unquoted response
+// codes, to string keyed maps so that the definition can be encoded as JSON
+func normalizeYAML(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeYAML(item)
+		}
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = normalizeYAML(item)
+		}
+		return m
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeYAML(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// typedOperation is an operation of a RestApi as its OpenAPI definition describes it
+type typedOperation struct {
+	summary      string
+	description  string
+	params       []*openapi3.Parameter
+	body         *openapi3.SchemaRef
+	bodyRequired bool
+}
+
+// findTypedOperation looks up an operation of a RestApi in its definition. Paths match
+// whatever their placeholders are named, and the path parameters of the definition are
+// renamed after the placeholders of the RestApi's path.
+func findTypedOperation(doc *openapi3.T, method, path string) *typedOperation {
+	if doc == nil || doc.Paths == nil {
+		return nil
+	}
+	template, item := path, doc.Paths.Value(path)
+	if item == nil {
+		if item = doc.Paths.Find(path); item == nil {
+			return nil
+		}
+		for key, candidate := range doc.Paths.Map() {
+			if candidate == item {
+				template = key
+				break
+			}
+		}
+	}
+	op := item.GetOperation(method)
+	if op == nil {
+		return nil
+	}
+
+	renamed := map[string]string{}
+	names := pathParamRegex.FindAllStringSubmatch(path, -1)
+	for i, match := range pathParamRegex.FindAllStringSubmatch(template, -1) {
+		if i < len(names) && match[1] != names[i][1] {
+			renamed[match[1]] = names[i][1]
+		}
+	}
+	param := func(p *openapi3.Parameter) *openapi3.Parameter {
+		if name, ok := renamed[p.Name]; ok && p.In == openapi3.ParameterInPath {
+			copied := *p
+			copied.Name = name
+			return &copied
+		}
+		return p
+	}
+
+	typed := &typedOperation{summary: op.Summary, description: op.Description}
+	// Parameters of the operation override the ones of its path with the same name and location
+	overridden := make(map[string]bool, len(op.Parameters))
+	for _, ref := range op.Parameters {
+		if ref != nil && ref.Value != nil {
+			overridden[ref.Value.In+":"+ref.Value.Name] = true
+		}
+	}
+	for _, ref := range item.Parameters {
+		if ref != nil && ref.Value != nil && !overridden[ref.Value.In+":"+ref.Value.Name] {
+			typed.params = append(typed.params, param(ref.Value))
+		}
+	}
+	for _, ref := range op.Parameters {
+		if ref != nil && ref.Value != nil {
+			typed.params = append(typed.params, param(ref.Value))
+		}
+	}
+
+	if op.RequestBody != nil && op.RequestBody.Value != nil {
+		for contentType, media := range op.RequestBody.Value.Content {
+			if media != nil && isJSONMediaType(contentType) {
+				typed.body = media.Schema
+				typed.bodyRequired = op.RequestBody.Value.Required
+				break
+			}
+		}
+	}
+	return typed
+}
+
+func isJSONMediaType(contentType string) bool {
+	mediaType := strings.TrimSpace(strings.Split(contentType, ";")[0])
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// restParameters returns the path, query and header parameters of the operation. Path
+// parameters without a placeholder in the path are left out.
+func (t *typedOperation) restParameters(path string) []api.MCPRestParameter {
+	placeholders := map[string]bool{}
+	for _, match := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+		placeholders[match[1]] = true
+	}
+	var params []api.MCPRestParameter
+	for _, p := range t.params {
+		switch p.In {
+		case openapi3.ParameterInPath:
+			if placeholders[p.Name] {
+				params = append(params, api.MCPRestParameter{Name: p.Name, In: api.MCPRestParameterInPath})
+			}
+		case openapi3.ParameterInQuery:
+			params = append(params, api.MCPRestParameter{Name: p.Name, In: api.MCPRestParameterInQuery})
+		case openapi3.ParameterInHeader:
+			params = append(params, api.MCPRestParameter{Name: p.Name, In: api.MCPRestParameterInHeader})
+		}
+	}
+	return params
+}
+
+// inputSchema builds the input schema of a tool with the types the definition gives its
+// parameters and body
+func (t *typedOperation) inputSchema(tool restTool) json.RawMessage {
+	typedParams := make(map[string]*openapi3.Parameter, len(t.params))
+	for _, p := range t.params {
+		typedParams[p.In+":"+p.Name] = p
+	}
+
+	properties := make(map[string]any, len(tool.params)+1)
+	required := []string{}
+	for _, p := range tool.params {
+		typed := typedParams[string(p.In)+":"+p.Name]
+		if typed == nil || typed.Schema == nil {
+			properties[p.Name] = map[string]any{
+				"type":        "string",
+				"description": fmt.Sprintf("%s parameter %s", p.In, p.Name),
+			}
+		} else {
+			property := jsonSchema(typed.Schema, map[*openapi3.Schema]bool{})
+			if typed.Description != "" {
+				property["description"] = typed.Description
+			}
+			properties[p.Name] = property
+		}
+		if p.In == api.MCPRestParameterInPath || (typed != nil && typed.Required) {
+			required = append(required, p.Name)
+		}
+	}
+	if t.body != nil {
+		body := jsonSchema(t.body, map[*openapi3.Schema]bool{})
+		if _, ok := body["description"]; !ok {
+			body["description"] = "JSON request body"
+		}
+		properties[bodyArgument] = body
+		if t.bodyRequired {
+			required = append(required, bodyArgument)
+		}
+	}
+
+	schema := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	data, _ := json.Marshal(schema)
+	return data
+}
+
+// jsonSchema renders an OpenAPI schema as a JSON Schema with its references expanded. A
+// schema that refers back to itself is left open where it recurses.
+func jsonSchema(ref *openapi3.SchemaRef, visiting map[*openapi3.Schema]bool) map[string]any {
+	if ref == nil || ref.Value == nil || visiting[ref.Value] {
+		return map[string]any{}
+	}
+	schema := ref.Value
+	visiting[schema] = true
+	defer delete(visiting, schema)
+
+	out := map[string]any{}
+	data, err := json.Marshal(schema)
+	if err != nil || json.Unmarshal(data, &out) != nil {
+		return map[string]any{}
+	}
+	if len(schema.Properties) > 0 {
+		properties := make(map[string]any, len(schema.Properties))
+		for name, property := range schema.Properties {
+			properties[name] = jsonSchema(property, visiting)
+		}
+		out["properties"] = properties
+	}
+	if schema.Items != nil {
+		out["items"] = jsonSchema(schema.Items, visiting)
+	}
+	if schema.AdditionalProperties.Schema != nil {
+		out["additionalProperties"] = jsonSchema(schema.AdditionalProperties.Schema, visiting)
+	}
+	if schema.Not != nil {
+		out["not"] = jsonSchema(schema.Not, visiting)
+	}
+	for key, refs := range map[string]openapi3.SchemaRefs{"allOf": schema.AllOf, "anyOf": schema.AnyOf, "oneOf": schema.OneOf} {
+		if len(refs) == 0 {
+			continue
+		}
+		items := make([]any, 0, len(refs))
+		for _, item := range refs {
+			items = append(items, jsonSchema(item, visiting))
+		}
+		out[key] = items
+	}
+	return out
+}
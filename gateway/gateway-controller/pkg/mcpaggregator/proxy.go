@@ -0,0 +1,548 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcpaggregator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+)
+
+// maxListPages bounds the pages read from one upstream for a single listing
+const maxListPages = 100
+
+// virtualProxy is the rendered form of a VirtualMcp proxy
+type virtualProxy struct {
+	handle      string
+	displayName string
+	version     string
+	specVersion string
+	upstreams   []*upstream
+}
+
+// upstream is an upstream MCP server or RestApi of a virtual proxy and the capabilities
+// curated from it
+type upstream struct {
+	name       string
+	endpoint   string
+	authHeader string
+	authValue  string
+	prefix     string
+	// restAPI is the handle of the RestApi backing the upstream, and rest its tools, which
+	// is nil when the RestApi is not available
+	restAPI    string
+	operations *[]api.MCPRestOperation
+	rest       *restBackend
+	// tools and prompts map the curated names to the names they are exposed under, or are
+	// nil when everything is exposed
+	tools   map[string]string
+	prompts map[string]string
+	// resources holds the curated resource URIs, or is nil when every resource is exposed
+	resources map[string]bool
+}
+
+func newVirtualProxy(handle string, cfg *api.MCPProxyConfiguration) *virtualProxy {
+	proxy := &virtualProxy{
+		handle:      handle,
+		displayName: cfg.Spec.DisplayName,
+		version:     cfg.Spec.Version,
+	}
+	if cfg.Spec.SpecVersion != nil {
+		proxy.specVersion = *cfg.Spec.SpecVersion
+	}
+	if cfg.Spec.Upstreams == nil {
+		return proxy
+	}
+	for _, u := range *cfg.Spec.Upstreams {
+		up := &upstream{
+			name:    u.Name,
+			tools:   capabilityNames(u.Tools),
+			prompts: capabilityNames(u.Prompts),
+		}
+		if u.Url != nil {
+			up.endpoint = strings.TrimSuffix(*u.Url, "/") + constants.MCP_RESOURCE_PATH
+		}
+		if u.RestApi != nil {
+			up.restAPI = *u.RestApi
+			up.operations = u.Operations
+		}
+		if u.Auth != nil && u.Auth.Header != nil && u.Auth.Value != nil {
+			up.authHeader = *u.Auth.Header
+			up.authValue = *u.Auth.Value
+		}
+		if u.ToolPrefix != nil {
+			up.prefix = *u.ToolPrefix
+		}
+		if u.Resources != nil {
+			up.resources = make(map[string]bool, len(*u.Resources))
+			for _, uri := range *u.Resources {
+				up.resources[uri] = true
+			}
+		}
+		proxy.upstreams = append(proxy.upstreams, up)
+	}
+	return proxy
+}
+
+func capabilityNames(capabilities *[]api.MCPVirtualCapability) map[string]string {
+	if capabilities == nil {
+		return nil
+	}
+	names := make(map[string]string, len(*capabilities))
+	for _, c := range *capabilities {
+		exposed := ""
+		if c.As != nil {
+			exposed = *c.As
+		}
+		names[c.Name] = exposed
+	}
+	return names
+}
+
+func (p *virtualProxy) upstream(name string) *upstream {
+	for _, up := range p.upstreams {
+		if up.name == name {
+			return up
+		}
+	}
+	return nil
+}
+
+// exposedName returns the name a tool or prompt of the upstream is exposed under, and
+// false when it is not curated
+func (u *upstream) exposedName(curated map[string]string, name string) (string, bool) {
+	if curated != nil {
+		as, ok := curated[name]
+		if !ok {
+			return "", false
+		}
+		if as != "" {
+			return as, true
+		}
+	}
+	return u.prefix + name, true
+}
+
+// listing describes how the results of an MCP list method are merged
+type listing struct {
+	method string
+	// field is the result field holding the listed items
+	field string
+	// routePrefix keys the routes recorded for the listed items
+	routePrefix string
+	// expose returns the key an item is exposed under and rewrites the item for the
+	// virtual view, or returns false when the item is not exposed
+	expose func(up *upstream, item map[string]json.RawMessage) (key string, original string, ok bool)
+}
+
+var (
+	toolListing = listing{
+		method:      "tools/list",
+		field:       "tools",
+		routePrefix: "tool:",
+		expose: func(up *upstream, item map[string]json.RawMessage) (string, string, bool) {
+			return renameItem(item, up.tools, up)
+		},
+	}
+	promptListing = listing{
+		method:      "prompts/list",
+		field:       "prompts",
+		routePrefix: "prompt:",
+		expose: func(up *upstream, item map[string]json.RawMessage) (string, string, bool) {
+			return renameItem(item, up.prompts, up)
+		},
+	}
+	resourceListing = listing{
+		method:      "resources/list",
+		field:       "resources",
+		routePrefix: "resource:",
+		expose: func(up *upstream, item map[string]json.RawMessage) (string, string, bool) {
+			var uri string
+			if err := json.Unmarshal(item["uri"], &uri); err != nil || uri == "" {
+				return "", "", false
+			}
+			if up.resources != nil && !up.resources[uri] {
+				return "", "", false
+			}
+			return uri, uri, true
+		},
+	}
+	resourceTemplateListing = listing{
+		method:      "resources/templates/list",
+		field:       "resourceTemplates",
+		routePrefix: "template:",
+		expose: func(up *upstream, item map[string]json.RawMessage) (string, string, bool) {
+			// Templates would expose resources beyond the curated URIs
+			if up.resources != nil {
+				return "", "", false
+			}
+			var uriTemplate string
+			if err := json.Unmarshal(item["uriTemplate"], &uriTemplate); err != nil || uriTemplate == "" {
+				return "", "", false
+			}
+			return uriTemplate, uriTemplate, true
+		},
+	}
+)
+
+// renameItem exposes a tool or prompt under its virtual name
+func renameItem(item map[string]json.RawMessage, curated map[string]string, up *upstream) (string, string, bool) {
+	var name string
+	if err := json.Unmarshal(item["name"], &name); err != nil || name == "" {
+		return "", "", false
+	}
+	exposed, ok := up.exposedName(curated, name)
+	if !ok {
+		return "", "", false
+	}
+	data, err := json.Marshal(exposed)
+	if err != nil {
+		return "", "", false
+	}
+	item["name"] = data
+	return exposed, name, true
+}
+
+// dispatch handles a JSON-RPC request of a virtual MCP session
+func (s *Server) dispatch(ctx context.Context, proxy *virtualProxy, sess *session, req *rpcMessage) (json.RawMessage, *rpcError) {
+	switch req.Method {
+	case "ping":
+		return json.RawMessage("{}"), nil
+	case "tools/list":
+		return s.list(ctx, proxy, sess, toolListing)
+	case "prompts/list":
+		return s.list(ctx, proxy, sess, promptListing)
+	case "resources/list":
+		return s.list(ctx, proxy, sess, resourceListing)
+	case "resources/templates/list":
+		return s.list(ctx, proxy, sess, resourceTemplateListing)
+	case "tools/call":
+		return s.forwardNamed(ctx, proxy, sess, req, toolListing)
+	case "prompts/get":
+		return s.forwardNamed(ctx, proxy, sess, req, promptListing)
+	case "resources/read":
+		return s.forwardResourceRead(ctx, proxy, sess, req)
+	default:
+		return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("Method not found: %s", req.Method)}
+	}
+}
+
+// list fans a list method out to every upstream and merges the results. When several
+// upstreams expose the same name, the upstream listed first in the proxy wins.
+func (s *Server) list(ctx context.Context, proxy *virtualProxy, sess *session, l listing) (json.RawMessage, *rpcError) {
+	items, routes, rpcErr := s.collect(ctx, proxy, sess, l)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	sess.setRoutes(l.routePrefix, routes)
+
+	data, err := json.Marshal(map[string]any{l.field: items})
+	if err != nil {
+		return nil, &rpcError{Code: codeInternalError, Message: "Failed to build list result"}
+	}
+	return data, nil
+}
+
+func (s *Server) collect(ctx context.Context, proxy *virtualProxy, sess *session, l listing) ([]map[string]json.RawMessage, map[string]route, *rpcError) {
+	type upstreamItems struct {
+		items []map[string]json.RawMessage
+		err   error
+	}
+	results := make([]upstreamItems, len(proxy.upstreams))
+
+	var wg sync.WaitGroup
+	for i, up := range proxy.upstreams {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i].items, results[i].err = s.listUpstream(ctx, sess, up, l)
+		}()
+	}
+	wg.Wait()
+
+	var items []map[string]json.RawMessage
+	routes := make(map[string]route)
+	failed := 0
+	for i, up := range proxy.upstreams {
+		if err := results[i].err; err != nil {
+			var rpcErr *rpcError
+			if errors.As(err, &rpcErr) && rpcErr.Code == codeMethodNotFound {
+				// The upstream does not offer this capability
+				continue
+			}
+			failed++
+			s.log.Warn("Failed to list from upstream MCP server, leaving it out of the virtual view",
+				slog.String("handle", proxy.handle),
+				slog.String("upstream", up.name),
+				slog.String("method", l.method),
+				slog.Any("error", err))
+			continue
+		}
+		for _, item := range results[i].items {
+			key, original, ok := l.expose(up, item)
+			if !ok {
+				continue
+			}
+			if _, exists := routes[l.routePrefix+key]; exists {
+				s.log.Debug("Skipping name already exposed by another upstream",
+					slog.String("handle", proxy.handle),
+					slog.String("upstream", up.name),
+					slog.String("name", key))
+				continue
+			}
+			routes[l.routePrefix+key] = route{upstream: up.name, name: original}
+			items = append(items, item)
+		}
+	}
+	if failed > 0 && failed == len(proxy.upstreams) {
+		return nil, nil, &rpcError{Code: codeInternalError, Message: "No upstream MCP server is available"}
+	}
+	if items == nil {
+		items = []map[string]json.RawMessage{}
+	}
+	return items, routes, nil
+}
+
+// listUpstream reads every page of a list method from one upstream
+func (s *Server) listUpstream(ctx context.Context, sess *session, up *upstream, l listing) ([]map[string]json.RawMessage, error) {
+	if up.restAPI != "" {
+		// RestApis only offer tools, which are listed without calling the API
+		if l.method != toolListing.method {
+			return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("Method not found: %s", l.method)}
+		}
+		if up.rest == nil {
+			return nil, fmt.Errorf("RestApi '%s' is unavailable", up.restAPI)
+		}
+		return up.rest.toolItems()
+	}
+
+	var items []map[string]json.RawMessage
+	cursor := ""
+	for page := 0; page < maxListPages; page++ {
+		var params json.RawMessage
+		if cursor != "" {
+			data, err := json.Marshal(map[string]string{"cursor": cursor})
+			if err != nil {
+				return nil, err
+			}
+			params = data
+		}
+		result, err := s.send(ctx, sess, up, l.method, params)
+		if err != nil {
+			return nil, err
+		}
+		var pageResult map[string]json.RawMessage
+		if err := json.Unmarshal(result, &pageResult); err != nil {
+			return nil, fmt.Errorf("invalid %s result: %w", l.method, err)
+		}
+		var pageItems []map[string]json.RawMessage
+		if raw, ok := pageResult[l.field]; ok {
+			if err := json.Unmarshal(raw, &pageItems); err != nil {
+				return nil, fmt.Errorf("invalid %s result: %w", l.method, err)
+			}
+		}
+		items = append(items, pageItems...)
+
+		cursor = ""
+		if raw, ok := pageResult["nextCursor"]; ok {
+			_ = json.Unmarshal(raw, &cursor)
+		}
+		if cursor == "" {
+			return items, nil
+		}
+	}
+	return items, nil
+}
+
+// forwardNamed routes tools/call and prompts/get to the upstream owning the named tool or
+// prompt, under its upstream name
+func (s *Server) forwardNamed(ctx context.Context, proxy *virtualProxy, sess *session, req *rpcMessage, l listing) (json.RawMessage, *rpcError) {
+	var params map[string]json.RawMessage
+	if err := json.Unmarshal(req.Params, &params); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "Invalid params"}
+	}
+	var name string
+	if err := json.Unmarshal(params["name"], &name); err != nil || name == "" {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "Missing name"}
+	}
+
+	r, up, rpcErr := s.resolve(ctx, proxy, sess, l, name)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if up == nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("Unknown %s: %s", strings.TrimSuffix(l.field, "s"), name)}
+	}
+
+	if up.restAPI != "" {
+		return s.callRESTTool(ctx, up, r.name, params["arguments"])
+	}
+
+	original, err := json.Marshal(r.name)
+	if err != nil {
+		return nil, &rpcError{Code: codeInternalError, Message: "Failed to build upstream request"}
+	}
+	params["name"] = original
+	forwarded, err := json.Marshal(params)
+	if err != nil {
+		return nil, &rpcError{Code: codeInternalError, Message: "Failed to build upstream request"}
+	}
+	return s.forward(ctx, sess, up, req.Method, forwarded)
+}
+
+// forwardResourceRead routes resources/read to the upstream owning the resource, or the
+// resource template the URI expands
+func (s *Server) forwardResourceRead(ctx context.Context, proxy *virtualProxy, sess *session, req *rpcMessage) (json.RawMessage, *rpcError) {
+	var params struct {
+		URI string `json:"uri"`
+	}
+	if err := json.Unmarshal(req.Params, &params); err != nil || params.URI == "" {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "Missing uri"}
+	}
+
+	_, up, rpcErr := s.resolve(ctx, proxy, sess, resourceListing, params.URI)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if up == nil {
+		up = s.matchTemplate(proxy, sess, params.URI)
+	}
+	if up == nil {
+		if _, rpcErr := s.list(ctx, proxy, sess, resourceTemplateListing); rpcErr != nil {
+			return nil, rpcErr
+		}
+		up = s.matchTemplate(proxy, sess, params.URI)
+	}
+	if up == nil {
+		return nil, &rpcError{Code: codeResourceNotFound, Message: "Resource not found"}
+	}
+	return s.forward(ctx, sess, up, req.Method, req.Params)
+}
+
+// resolve finds the upstream owning an exposed name, listing the upstreams again when the
+// session has not listed it yet or the upstream has since been removed from the proxy
+func (s *Server) resolve(ctx context.Context, proxy *virtualProxy, sess *session, l listing, name string) (route, *upstream, *rpcError) {
+	if r, ok := sess.route(l.routePrefix + name); ok {
+		if up := proxy.upstream(r.upstream); up != nil {
+			return r, up, nil
+		}
+	}
+	if _, rpcErr := s.list(ctx, proxy, sess, l); rpcErr != nil {
+		return route{}, nil, rpcErr
+	}
+	if r, ok := sess.route(l.routePrefix + name); ok {
+		return r, proxy.upstream(r.upstream), nil
+	}
+	return route{}, nil, nil
+}
+
+// matchTemplate returns the upstream of the listed resource template with the longest
+// literal prefix matching the URI
+func (s *Server) matchTemplate(proxy *virtualProxy, sess *session, uri string) *upstream {
+	var best *upstream
+	bestLen := -1
+	for key, r := range sess.routesWithPrefix(resourceTemplateListing.routePrefix) {
+		literal := strings.TrimPrefix(key, resourceTemplateListing.routePrefix)
+		if i := strings.Index(literal, "{"); i >= 0 {
+			literal = literal[:i]
+		}
+		if strings.HasPrefix(uri, literal) && len(literal) > bestLen {
+			if up := proxy.upstream(r.upstream); up != nil {
+				best, bestLen = up, len(literal)
+			}
+		}
+	}
+	return best
+}
+
+// forward sends a request to an upstream, passing JSON-RPC errors of the upstream through
+func (s *Server) forward(ctx context.Context, sess *session, up *upstream, method string, params json.RawMessage) (json.RawMessage, *rpcError) {
+	result, err := s.send(ctx, sess, up, method, params)
+	if err != nil {
+		var rpcErr *rpcError
+		if errors.As(err, &rpcErr) {
+			return nil, rpcErr
+		}
+		s.log.Warn("Failed to forward request to upstream MCP server",
+			slog.String("handle", sess.handle),
+			slog.String("upstream", up.name),
+			slog.String("method", method),
+			slog.Any("error", err))
+		return nil, &rpcError{Code: codeInternalError, Message: fmt.Sprintf("Upstream MCP server '%s' is unavailable", up.name)}
+	}
+	return result, nil
+}
+
+// send sends a request on the session's upstream session, initializing the upstream
+// session first when needed and again when the upstream has expired it
+func (s *Server) send(ctx context.Context, sess *session, up *upstream, method string, params json.RawMessage) (json.RawMessage, error) {
+	us, err := s.upstreamSession(ctx, sess, up)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.upstreams.request(ctx, us, method, params)
+	if !errors.Is(err, errUpstreamSessionExpired) {
+		return result, err
+	}
+
+	sess.setUpstreamSession(up.name, nil)
+	if us, err = s.upstreamSession(ctx, sess, up); err != nil {
+		return nil, err
+	}
+	result, err = s.upstreams.request(ctx, us, method, params)
+	if errors.Is(err, errUpstreamSessionExpired) {
+		return nil, fmt.Errorf("upstream %s rejected a new session", up.name)
+	}
+	return result, err
+}
+
+func (s *Server) upstreamSession(ctx context.Context, sess *session, up *upstream) (*upstreamSession, error) {
+	lock := sess.initLock(up.name)
+	lock.Lock()
+	defer lock.Unlock()
+
+	if us := sess.upstreamSession(up.name); us != nil {
+		if us.upstream.endpoint == up.endpoint {
+			if us.upstream != up {
+				// Pick up changed credentials without opening a new session
+				us = &upstreamSession{upstream: up, id: us.id, protocolVersion: us.protocolVersion}
+				sess.setUpstreamSession(up.name, us)
+			}
+			return us, nil
+		}
+		// The upstream has moved, so the old session is of no use
+		go func(old *upstreamSession) {
+			_ = s.upstreams.closeSession(context.Background(), old)
+		}(us)
+	}
+
+	us, err := s.upstreams.initialize(ctx, up, sess.protocolVersion)
+	if err != nil {
+		return nil, err
+	}
+	sess.setUpstreamSession(up.name, us)
+	return us, nil
+}
@@ -0,0 +1,429 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcpaggregator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/xds"
+)
+
+// bodyArgument is the tool argument sent as the JSON body of the REST request
+const bodyArgument = "body"
+
+// callerAuthorizationKey is the context key of the Authorization header the client sent
+type callerAuthorizationKey struct{}
+
+// withCallerAuthorization records the client's Authorization header for the tool calls of
+// RestApi upstreams made while serving its request
+func withCallerAuthorization(ctx context.Context, authorization string) context.Context {
+	if authorization == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, callerAuthorizationKey{}, authorization)
+}
+
+func callerAuthorization(ctx context.Context) string {
+	authorization, _ := ctx.Value(callerAuthorizationKey{}).(string)
+	return authorization
+}
+
+var (
+	pathParamRegex   = regexp.MustCompile(`\{([^{}]+)\}`)
+	toolNameSanitize = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+)
+
+// restBackend exposes the operations of a deployed RestApi as tools. Tool calls are sent
+// through the router, so the policies of the RestApi apply to them.
+type restBackend struct {
+	// baseURL is the router URL followed by the resolved context of the RestApi
+	baseURL string
+	// host is the virtual host the RestApi is served on, or empty for the default one
+	host  string
+	tools []restTool
+}
+
+// restTool is one operation of a RestApi exposed as a tool
+type restTool struct {
+	name        string
+	title       string
+	description string
+	method      string
+	path        string
+	params      []api.MCPRestParameter
+	inputSchema json.RawMessage
+	readOnly    bool
+}
+
+// loadRestBackend reads the deployed RestApi with the given handle and builds the tools
+// of the upstream from the curated operations, or from every operation of the API
+func (s *Server) loadRestBackend(handle string, operations *[]api.MCPRestOperation) (*restBackend, error) {
+	cfg, err := s.db.GetConfigByKindAndHandle(string(models.KindRestApi), handle)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, fmt.Errorf("RestApi '%s' not found", handle)
+		}
+		return nil, err
+	}
+	if cfg.DesiredState == models.StateUndeployed {
+		return nil, fmt.Errorf("RestApi '%s' is not deployed", handle)
+	}
+	restAPI, ok := cfg.Configuration.(api.RestAPI)
+	if !ok {
+		return nil, fmt.Errorf("configuration '%s' is not a RestApi", handle)
+	}
+
+	// Curated operations carry their own schemas, so the definition is only needed without them
+	var definition *openapi3.T
+	if operations == nil || len(*operations) == 0 {
+		if definition, err = s.definitions.get(cfg); err != nil {
+			s.log.Warn("Failed to get the OpenAPI definition of RestApi, deriving its tool schemas",
+				slog.String("restApi", handle),
+				slog.Any("error", err))
+		}
+	}
+	return newRestBackend(s.cfg.RouterURL, &restAPI, operations, definition)
+}
+
+// newRestBackend builds the tools of a RestApi. Without curated operations, every operation
+// of the API becomes a tool, typed from the API's OpenAPI definition when there is one.
+func newRestBackend(routerURL string, restAPI *api.RestAPI, operations *[]api.MCPRestOperation, definition *openapi3.T) (*restBackend, error) {
+	backend := &restBackend{
+		baseURL: strings.TrimSuffix(routerURL, "/") + xds.ConstructFullPath(restAPI.Spec.Context, restAPI.Spec.Version, ""),
+	}
+	if restAPI.Spec.Vhosts != nil {
+		backend.host = restAPI.Spec.Vhosts.Main
+	}
+
+	if operations == nil || len(*operations) == 0 {
+		for _, op := range restAPI.Spec.Operations {
+			method := strings.ToUpper(string(op.Method))
+			typed := findTypedOperation(definition, method, op.Path)
+			if typed == nil {
+				tool := newRestTool(deriveToolName(method, op.Path), method, op.Path, nil)
+				tool.description = fmt.Sprintf("%s %s of %s", method, op.Path, restAPI.Spec.DisplayName)
+				backend.tools = append(backend.tools, tool)
+				continue
+			}
+			tool := newRestTool(deriveToolName(method, op.Path), method, op.Path, typed.restParameters(op.Path))
+			tool.title = typed.summary
+			tool.description = typed.description
+			if tool.description == "" {
+				tool.description = fmt.Sprintf("%s %s of %s", method, op.Path, restAPI.Spec.DisplayName)
+			}
+			tool.inputSchema = typed.inputSchema(tool)
+			backend.tools = append(backend.tools, tool)
+		}
+		return backend, nil
+	}
+
+	for _, op := range *operations {
+		var params []api.MCPRestParameter
+		if op.Parameters != nil {
+			params = *op.Parameters
+		}
+		tool := newRestTool(op.Name, string(op.Method), op.Path, params)
+		if op.Title != nil {
+			tool.title = *op.Title
+		}
+		if op.Description != nil {
+			tool.description = *op.Description
+		}
+		if op.InputSchema != nil {
+			if !json.Valid([]byte(*op.InputSchema)) {
+				return nil, fmt.Errorf("invalid input schema for operation '%s'", op.Name)
+			}
+			tool.inputSchema = json.RawMessage(*op.InputSchema)
+		}
+		backend.tools = append(backend.tools, tool)
+	}
+	return backend, nil
+}
+
+// newRestTool builds a tool for an operation. Path placeholders that are not declared as
+// parameters become path parameters, and the input schema is derived from the parameters.
+func newRestTool(name, method, path string, params []api.MCPRestParameter) restTool {
+	declared := make(map[string]bool, len(params))
+	for _, p := range params {
+		declared[p.Name] = true
+	}
+	for _, match := range pathParamRegex.FindAllStringSubmatch(path, -1) {
+		if !declared[match[1]] {
+			params = append(params, api.MCPRestParameter{Name: match[1], In: api.MCPRestParameterInPath})
+			declared[match[1]] = true
+		}
+	}
+
+	tool := restTool{
+		name:     name,
+		method:   method,
+		path:     path,
+		params:   params,
+		readOnly: method == http.MethodGet || method == http.MethodHead,
+	}
+	tool.inputSchema = deriveInputSchema(tool)
+	return tool
+}
+
+// deriveToolName names an operation after its method and path, e.g. GET /books/{id}
+// becomes get_books_by_id
+func deriveToolName(method, path string) string {
+	parts := []string{strings.ToLower(method)}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		if match := pathParamRegex.FindStringSubmatch(segment); match != nil && match[0] == segment {
+			segment = "by_" + match[1]
+		}
+		segment = strings.Trim(toolNameSanitize.ReplaceAllString(segment, "_"), "_")
+		if segment != "" {
+			parts = append(parts, segment)
+		}
+	}
+	return strings.Join(parts, "_")
+}
+
+// deriveInputSchema types every parameter as a string and the body as an object. It is used
+// when neither a curated input schema nor the API's OpenAPI definition types the operation.
+func deriveInputSchema(tool restTool) json.RawMessage {
+	properties := make(map[string]any, len(tool.params)+1)
+	required := []string{}
+	for _, p := range tool.params {
+		properties[p.Name] = map[string]any{
+			"type":        "string",
+			"description": fmt.Sprintf("%s parameter %s", p.In, p.Name),
+		}
+		if p.In == api.MCPRestParameterInPath {
+			required = append(required, p.Name)
+		}
+	}
+	switch tool.method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		properties[bodyArgument] = map[string]any{
+			"type":        "object",
+			"description": "JSON request body",
+		}
+	}
+	schema := map[string]any{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	data, _ := json.Marshal(schema)
+	return data
+}
+
+func (b *restBackend) tool(name string) *restTool {
+	for i := range b.tools {
+		if b.tools[i].name == name {
+			return &b.tools[i]
+		}
+	}
+	return nil
+}
+
+// toolItems returns the tools in the form of a tools/list result
+func (b *restBackend) toolItems() ([]map[string]json.RawMessage, error) {
+	items := make([]map[string]json.RawMessage, 0, len(b.tools))
+	for _, tool := range b.tools {
+		descriptor := map[string]any{
+			"name":        tool.name,
+			"inputSchema": tool.inputSchema,
+		}
+		if tool.title != "" {
+			descriptor["title"] = tool.title
+		}
+		if tool.description != "" {
+			descriptor["description"] = tool.description
+		}
+		if tool.readOnly {
+			descriptor["annotations"] = map[string]bool{"readOnlyHint": true}
+		}
+		data, err := json.Marshal(descriptor)
+		if err != nil {
+			return nil, err
+		}
+		var item map[string]json.RawMessage
+		if err := json.Unmarshal(data, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// callRESTTool sends a tools/call of a RestApi upstream as a REST request through the
+// router and returns the response as MCP content. Responses with an error status are
+// returned as tool results with isError set, so that agents can see what went wrong.
+func (s *Server) callRESTTool(ctx context.Context, up *upstream, name string, rawArgs json.RawMessage) (json.RawMessage, *rpcError) {
+	if up.rest == nil {
+		return nil, &rpcError{Code: codeInternalError, Message: fmt.Sprintf("RestApi '%s' is unavailable", up.restAPI)}
+	}
+	tool := up.rest.tool(name)
+	if tool == nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("Unknown tool: %s", name)}
+	}
+
+	args := map[string]json.RawMessage{}
+	if len(rawArgs) > 0 && string(rawArgs) != "null" {
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "Tool arguments must be an object"}
+		}
+	}
+
+	req, rpcErr := up.rest.buildRequest(ctx, tool, args)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	// The RestApi sees the client's own credentials, so its policies authorize each caller.
+	// A credential configured on the upstream replaces them and is shared by every caller of
+	// the virtual MCP proxy.
+	if up.authHeader != "" {
+		req.Header.Set(up.authHeader, up.authValue)
+	} else if authorization := callerAuthorization(ctx); authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := s.upstreams.httpClient.Do(req)
+	if err != nil {
+		s.log.Warn("Failed to call RestApi operation",
+			slog.String("upstream", up.name),
+			slog.String("restApi", up.restAPI),
+			slog.String("tool", name),
+			slog.Any("error", err))
+		return nil, &rpcError{Code: codeInternalError, Message: fmt.Sprintf("RestApi '%s' is unavailable", up.restAPI)}
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMessageSize))
+	if err != nil {
+		return nil, &rpcError{Code: codeInternalError, Message: fmt.Sprintf("Failed to read the response of RestApi '%s'", up.restAPI)}
+	}
+	return toolResult(resp.StatusCode, body)
+}
+
+// buildRequest maps the tool arguments onto the path, query, headers and body of the
+// operation
+func (b *restBackend) buildRequest(ctx context.Context, tool *restTool, args map[string]json.RawMessage) (*http.Request, *rpcError) {
+	path := tool.path
+	query := url.Values{}
+	header := http.Header{}
+	for _, p := range tool.params {
+		raw, ok := args[p.Name]
+		if !ok || string(raw) == "null" {
+			if p.In == api.MCPRestParameterInPath {
+				return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("Missing argument '%s'", p.Name)}
+			}
+			continue
+		}
+		values := argumentValues(raw)
+		switch p.In {
+		case api.MCPRestParameterInPath:
+			if len(values) != 1 {
+				return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("Argument '%s' must be a single value", p.Name)}
+			}
+			path = strings.ReplaceAll(path, "{"+p.Name+"}", url.PathEscape(values[0]))
+		case api.MCPRestParameterInQuery:
+			for _, v := range values {
+				query.Add(p.Name, v)
+			}
+		case api.MCPRestParameterInHeader:
+			header.Set(p.Name, strings.Join(values, ","))
+		}
+	}
+
+	target := b.baseURL + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if raw, ok := args[bodyArgument]; ok && string(raw) != "null" {
+		body = bytes.NewReader(raw)
+	}
+	req, err := http.NewRequestWithContext(ctx, tool.method, target, body)
+	if err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("Invalid request for tool '%s': %v", tool.name, err)}
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json, */*")
+	if b.host != "" {
+		req.Host = b.host
+	}
+	return req, nil
+}
+
+// argumentValues renders a tool argument as parameter values. Arrays give one value per
+// element and strings are used as they are.
+func argumentValues(raw json.RawMessage) []string {
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		items = []json.RawMessage{raw}
+	}
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		var s string
+		if err := json.Unmarshal(item, &s); err == nil {
+			values = append(values, s)
+			continue
+		}
+		values = append(values, string(item))
+	}
+	return values
+}
+
+// toolResult wraps a REST response as a tools/call result. JSON object bodies are also
+// returned as structured content.
+func toolResult(status int, body []byte) (json.RawMessage, *rpcError) {
+	text := string(body)
+	if text == "" {
+		text = fmt.Sprintf("%d %s", status, http.StatusText(status))
+	}
+	result := map[string]any{
+		"content": []map[string]string{{"type": "text", "text": text}},
+		"isError": status >= http.StatusBadRequest,
+	}
+	var structured map[string]json.RawMessage
+	if err := json.Unmarshal(body, &structured); err == nil {
+		result["structuredContent"] = structured
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, &rpcError{Code: codeInternalError, Message: "Failed to build tool result"}
+	}
+	return data, nil
+}
@@ -0,0 +1,382 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package mcpaggregator serves VirtualMcp proxies. The router applies the proxy's policies
+// and forwards its MCP endpoint here, where the tools, resources and prompts of the upstream
+// MCP servers are merged into one MCP server and each request is routed to the upstream
+// that owns the tool, prompt or resource it names. Upstreams backed by a deployed RestApi
+// expose its operations as tools, whose calls are sent as REST requests through the router.
+package mcpaggregator
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/templateengine"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/templateengine/funcs"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/version"
+)
+
+const (
+	sessionIDHeader       = "Mcp-Session-Id"
+	protocolVersionHeader = "MCP-Protocol-Version"
+
+	// maxMessageSize limits the JSON-RPC messages read from clients and upstreams
+	maxMessageSize = 16 << 20
+)
+
+// Server is the MCP aggregator HTTP server
+type Server struct {
+	cfg            *config.MCPAggregatorConfig
+	db             storage.Storage
+	secretResolver funcs.SecretResolver
+	definitions    *definitionCache
+	upstreams      *upstreamClient
+	sessions       *sessionStore
+	httpServer     *http.Server
+	cancel         context.CancelFunc
+	log            *slog.Logger
+}
+
+// NewServer creates a new MCP aggregator server. The definitions fetcher types the tools of
+// RestApis deployed from the control plane; it is optional.
+func NewServer(cfg *config.MCPAggregatorConfig, db storage.Storage, secretResolver funcs.SecretResolver, definitions DefinitionFetcher, log *slog.Logger) *Server {
+	s := &Server{
+		cfg:            cfg,
+		db:             db,
+		secretResolver: secretResolver,
+		definitions:    newDefinitionCache(definitions),
+		upstreams:      newUpstreamClient(cfg.UpstreamTimeout),
+		sessions:       newSessionStore(cfg.SessionIdleTimeout),
+		log:            log,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(constants.VIRTUAL_MCP_PATH_PREFIX+"/{handle}"+constants.MCP_RESOURCE_PATH, s.handleMCP)
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write([]byte("OK")); err != nil {
+			log.Warn("Failed to write MCP aggregator health response", slog.Any("error", err))
+		}
+	})
+
+	s.httpServer = &http.Server{
+		Addr:              fmt.Sprintf(":%d", cfg.Port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return s
+}
+
+// Start starts the MCP aggregator HTTP server
+func (s *Server) Start() error {
+	s.log.Info("Starting MCP aggregator server", slog.Int("port", s.cfg.Port))
+
+	ln, err := net.Listen("tcp", s.httpServer.Addr)
+	if err != nil {
+		return fmt.Errorf("MCP aggregator server failed to bind: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go s.evictIdleSessions(ctx)
+
+	go func() {
+		if err := s.httpServer.Serve(ln); err != nil && err != http.ErrServerClosed {
+			s.log.Error("MCP aggregator server failed", slog.Any("error", err))
+		}
+	}()
+
+	return nil
+}
+
+// Stop gracefully stops the MCP aggregator HTTP server
+func (s *Server) Stop(ctx context.Context) error {
+	s.log.Info("Stopping MCP aggregator server")
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return s.httpServer.Shutdown(ctx)
+}
+
+// evictIdleSessions ends the sessions that have been idle for longer than the session idle
+// timeout, along with the sessions they hold on the upstream MCP servers
+func (s *Server) evictIdleSessions(ctx context.Context) {
+	interval := min(max(s.cfg.SessionIdleTimeout/2, time.Second), time.Minute)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, sess := range s.sessions.evictIdle(time.Now()) {
+				s.log.Debug("Evicting idle virtual MCP session", slog.String("handle", sess.handle))
+				s.closeUpstreamSessions(ctx, sess)
+			}
+		}
+	}
+}
+
+func (s *Server) handleMCP(w http.ResponseWriter, r *http.Request) {
+	// Only the router, which applies the proxy's policies, may call the aggregator
+	token := r.Header.Get(constants.MCP_AGGREGATOR_TOKEN_HEADER)
+	if s.cfg.RouterToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.RouterToken)) != 1 {
+		writeError(w, http.StatusUnauthorized, nil, codeInvalidRequest, "Requests must be forwarded by the gateway router")
+		return
+	}
+	handle := r.PathValue("handle")
+
+	switch r.Method {
+	case http.MethodPost:
+		s.handlePost(w, r, handle)
+	case http.MethodDelete:
+		s.handleDelete(w, r, handle)
+	default:
+		// The aggregator does not push server-initiated messages, so there is no SSE stream to open
+		w.Header().Set("Allow", "POST, DELETE")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handlePost(w http.ResponseWriter, r *http.Request, handle string) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxMessageSize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, nil, codeParseError, "Failed to read request body")
+		return
+	}
+	var req rpcMessage
+	if err := json.Unmarshal(body, &req); err != nil {
+		writeError(w, http.StatusBadRequest, nil, codeParseError, "Request body must be a single JSON-RPC message")
+		return
+	}
+	if req.JSONRPC != jsonRPCVersion || req.Method == "" {
+		if req.isResponse() {
+			// Responses to server-initiated requests are not expected, since none are sent
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		writeError(w, http.StatusBadRequest, req.ID, codeInvalidRequest, "Invalid JSON-RPC request")
+		return
+	}
+
+	proxy, err := s.loadProxy(handle)
+	if err != nil {
+		if errors.Is(err, errProxyNotFound) {
+			writeError(w, http.StatusNotFound, req.ID, codeInvalidRequest, fmt.Sprintf("Virtual MCP proxy '%s' not found", handle))
+			return
+		}
+		s.log.Error("Failed to load virtual MCP proxy", slog.String("handle", handle), slog.Any("error", err))
+		writeError(w, http.StatusInternalServerError, req.ID, codeInternalError, "Failed to load virtual MCP proxy")
+		return
+	}
+
+	if req.Method == "initialize" {
+		s.handleInitialize(w, proxy, &req)
+		return
+	}
+
+	sessionID := r.Header.Get(sessionIDHeader)
+	if sessionID == "" {
+		writeError(w, http.StatusBadRequest, req.ID, codeInvalidRequest, "Mcp-Session-Id header is required")
+		return
+	}
+	// A session the aggregator does not know has ended, or was started by another replica or
+	// before a restart. The 404 tells the client to start a new session with initialize.
+	sess := s.sessions.get(sessionID, time.Now())
+	if sess == nil {
+		writeError(w, http.StatusNotFound, req.ID, codeInvalidRequest, "Session not found")
+		return
+	}
+	if sess.handle != handle {
+		writeError(w, http.StatusNotFound, req.ID, codeInvalidRequest, "Session does not belong to this virtual MCP proxy")
+		return
+	}
+
+	if req.isNotification() {
+		// Notifications such as notifications/initialized concern the aggregator's own session
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	ctx := withCallerAuthorization(r.Context(), r.Header.Get("Authorization"))
+	result, rpcErr := s.dispatch(ctx, proxy, sess, &req)
+	if rpcErr != nil {
+		writeJSON(w, http.StatusOK, rpcMessage{JSONRPC: jsonRPCVersion, ID: req.ID, Error: rpcErr})
+		return
+	}
+	writeJSON(w, http.StatusOK, rpcMessage{JSONRPC: jsonRPCVersion, ID: req.ID, Result: result})
+}
+
+func (s *Server) handleInitialize(w http.ResponseWriter, proxy *virtualProxy, req *rpcMessage) {
+	var params struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			writeError(w, http.StatusOK, req.ID, codeInvalidParams, "Invalid initialize params")
+			return
+		}
+	}
+	protocolVersion := negotiateProtocolVersion(params.ProtocolVersion, proxy.specVersion)
+	sess := s.sessions.create(proxy.handle, protocolVersion, time.Now())
+
+	result := map[string]any{
+		"protocolVersion": protocolVersion,
+		"capabilities": map[string]any{
+			"tools":     map[string]any{"listChanged": false},
+			"prompts":   map[string]any{"listChanged": false},
+			"resources": map[string]any{"listChanged": false, "subscribe": false},
+		},
+		"serverInfo": map[string]any{
+			"name":    proxy.handle,
+			"title":   proxy.displayName,
+			"version": proxy.version,
+		},
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, req.ID, codeInternalError, "Failed to build initialize result")
+		return
+	}
+	w.Header().Set(sessionIDHeader, sess.id)
+	writeJSON(w, http.StatusOK, rpcMessage{JSONRPC: jsonRPCVersion, ID: req.ID, Result: data})
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request, handle string) {
+	sessionID := r.Header.Get(sessionIDHeader)
+	if sessionID == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	sess := s.sessions.remove(sessionID)
+	if sess == nil || sess.handle != handle {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	s.closeUpstreamSessions(r.Context(), sess)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// closeUpstreamSessions ends the sessions a virtual MCP session holds on the upstream MCP servers
+func (s *Server) closeUpstreamSessions(ctx context.Context, sess *session) {
+	for _, us := range sess.upstreamSessions() {
+		if err := s.upstreams.closeSession(ctx, us); err != nil {
+			s.log.Debug("Failed to end upstream MCP session",
+				slog.String("handle", sess.handle),
+				slog.String("upstream", us.upstream.name),
+				slog.Any("error", err))
+		}
+	}
+}
+
+var errProxyNotFound = errors.New("virtual MCP proxy not found")
+
+// loadProxy reads the deployed VirtualMcp proxy with the given handle and renders the
+// template expressions, such as secret references, in its upstream credentials
+func (s *Server) loadProxy(handle string) (*virtualProxy, error) {
+	cfg, err := s.db.GetConfigByKindAndHandle(string(models.KindMcp), handle)
+	if err != nil {
+		if storage.IsNotFoundError(err) {
+			return nil, errProxyNotFound
+		}
+		return nil, err
+	}
+	if cfg.DesiredState == models.StateUndeployed {
+		return nil, errProxyNotFound
+	}
+	source, ok := cfg.SourceConfiguration.(api.MCPProxyConfiguration)
+	if !ok || source.Kind != api.MCPProxyConfigurationKindVirtualMcp {
+		return nil, errProxyNotFound
+	}
+
+	rendered := &models.StoredConfig{
+		UUID:          cfg.UUID,
+		Kind:          cfg.Kind,
+		Handle:        cfg.Handle,
+		Configuration: source,
+	}
+	if err := templateengine.RenderSpec(rendered, s.secretResolver, s.log); err != nil {
+		return nil, err
+	}
+	source, ok = rendered.Configuration.(api.MCPProxyConfiguration)
+	if !ok {
+		return nil, fmt.Errorf("unexpected rendered configuration type %T", rendered.Configuration)
+	}
+	proxy := newVirtualProxy(handle, &source)
+	for _, up := range proxy.upstreams {
+		if up.restAPI == "" {
+			continue
+		}
+		// An unavailable RestApi is left out of the virtual view, like an unavailable MCP server
+		if up.rest, err = s.loadRestBackend(up.restAPI, up.operations); err != nil {
+			s.log.Warn("Failed to load RestApi of virtual MCP proxy",
+				slog.String("handle", handle),
+				slog.String("upstream", up.name),
+				slog.String("restApi", up.restAPI),
+				slog.Any("error", err))
+		}
+	}
+	return proxy, nil
+}
+
+// negotiateProtocolVersion returns the version requested by the client when the aggregator
+// supports it, and otherwise the version the proxy is configured with
+func negotiateProtocolVersion(requested, specVersion string) string {
+	switch requested {
+	case "2024-11-05", "2025-03-26", constants.SPEC_VERSION_2025_JUNE, constants.SPEC_VERSION_2025_NOVEMBER:
+		return requested
+	}
+	if specVersion != "" {
+		return specVersion
+	}
+	return constants.SPEC_VERSION_2025_JUNE
+}
+
+func writeJSON(w http.ResponseWriter, status int, msg rpcMessage) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(msg)
+}
+
+func writeError(w http.ResponseWriter, status int, id json.RawMessage, code int, message string) {
+	writeJSON(w, status, rpcMessage{JSONRPC: jsonRPCVersion, ID: id, Error: &rpcError{Code: code, Message: message}})
+}
+
+// clientInfo identifies the aggregator to the upstream MCP servers
+func clientInfo() map[string]string {
+	return map[string]string{
+		"name":    "api-platform-mcp-aggregator",
+		"version": strings.TrimPrefix(version.Version, "v"),
+	}
+}
@@ -0,0 +1,982 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcpaggregator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/storage"
+)
+
+// fakeUpstream is a minimal Streamable HTTP MCP server
+type fakeUpstream struct {
+	t         *testing.T
+	server    *httptest.Server
+	tools     []string
+	prompts   []string
+	resources []string
+	templates []string
+	pageSize  int
+	sse       bool
+
+	mu       sync.Mutex
+	sessions map[string]bool
+	calls    []string
+	headers  []http.Header
+	nextID   int
+}
+
+func newFakeUpstream(t *testing.T) *fakeUpstream {
+	f := &fakeUpstream{t: t, sessions: make(map[string]bool)}
+	f.server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func (f *fakeUpstream) serve(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/mcp" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.headers = append(f.headers, r.Header.Clone())
+
+	sessionID := r.Header.Get(sessionIDHeader)
+	if r.Method == http.MethodDelete {
+		delete(f.sessions, sessionID)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	var req rpcMessage
+	require.NoError(f.t, json.NewDecoder(r.Body).Decode(&req))
+	if req.Method != "initialize" && !f.sessions[sessionID] {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if req.isNotification() {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+	f.calls = append(f.calls, req.Method)
+
+	var params struct {
+		Name   string `json:"name"`
+		URI    string `json:"uri"`
+		Cursor string `json:"cursor"`
+	}
+	if len(req.Params) > 0 {
+		require.NoError(f.t, json.Unmarshal(req.Params, &params))
+	}
+
+	var result any
+	switch req.Method {
+	case "initialize":
+		f.nextID++
+		sessionID = fmt.Sprintf("upstream-session-%d", f.nextID)
+		f.sessions[sessionID] = true
+		w.Header().Set(sessionIDHeader, sessionID)
+		result = map[string]any{"protocolVersion": "2025-06-18", "capabilities": map[string]any{}}
+	case "tools/list":
+		result = f.page("tools", f.tools, params.Cursor, func(name string) any {
+			return map[string]any{"name": name, "inputSchema": map[string]any{"type": "object"}}
+		})
+	case "prompts/list":
+		if f.prompts == nil {
+			f.writeMessage(w, rpcMessage{JSONRPC: jsonRPCVersion, ID: req.ID, Error: &rpcError{Code: codeMethodNotFound, Message: "Method not found"}})
+			return
+		}
+		result = f.page("prompts", f.prompts, params.Cursor, func(name string) any {
+			return map[string]any{"name": name}
+		})
+	case "resources/list":
+		result = f.page("resources", f.resources, params.Cursor, func(uri string) any {
+			return map[string]any{"uri": uri, "name": uri}
+		})
+	case "resources/templates/list":
+		result = f.page("resourceTemplates", f.templates, params.Cursor, func(uri string) any {
+			return map[string]any{"uriTemplate": uri, "name": uri}
+		})
+	case "tools/call", "prompts/get":
+		f.calls[len(f.calls)-1] += " " + params.Name
+		result = map[string]any{"content": []any{map[string]any{"type": "text", "text": params.Name}}}
+	case "resources/read":
+		f.calls[len(f.calls)-1] += " " + params.URI
+		result = map[string]any{"contents": []any{map[string]any{"uri": params.URI, "text": "content"}}}
+	default:
+		f.writeMessage(w, rpcMessage{JSONRPC: jsonRPCVersion, ID: req.ID, Error: &rpcError{Code: codeMethodNotFound, Message: "Method not found"}})
+		return
+	}
+	data, err := json.Marshal(result)
+	require.NoError(f.t, err)
+	f.writeMessage(w, rpcMessage{JSONRPC: jsonRPCVersion, ID: req.ID, Result: data})
+}
+
+// page returns one page of a listing, using the index of the next item as the cursor
+func (f *fakeUpstream) page(field string, names []string, cursor string, item func(string) any) map[string]any {
+	start := 0
+	if cursor != "" {
+		_, err := fmt.Sscanf(cursor, "%d", &start)
+		require.NoError(f.t, err)
+	}
+	end := len(names)
+	if f.pageSize > 0 && start+f.pageSize < end {
+		end = start + f.pageSize
+	}
+	items := []any{}
+	for _, name := range names[start:end] {
+		items = append(items, item(name))
+	}
+	result := map[string]any{field: items}
+	if end < len(names) {
+		result["nextCursor"] = fmt.Sprintf("%d", end)
+	}
+	return result
+}
+
+func (f *fakeUpstream) writeMessage(w http.ResponseWriter, msg rpcMessage) {
+	data, err := json.Marshal(msg)
+	require.NoError(f.t, err)
+	if f.sse {
+		w.Header().Set("Content-Type", "text/event-stream")
+		// A notification sent on the stream ahead of the response is skipped
+		fmt.Fprintf(w, "event: message\ndata: {\"jsonrpc\":\"2.0\",\"method\":\"notifications/progress\"}\n\n")
+		fmt.Fprintf(w, "event: message\ndata: %s\n\n", data)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func (f *fakeUpstream) callLog() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var calls []string
+	for _, call := range f.calls {
+		if call != "initialize" {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+func (f *fakeUpstream) sessionCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.sessions)
+}
+
+func (f *fakeUpstream) expireSessions() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.sessions = make(map[string]bool)
+}
+
+// fakeStorage serves the stored VirtualMcp proxies. Only the methods used by the
+// aggregator are implemented.
+type fakeStorage struct {
+	storage.Storage
+	configs map[string]*models.StoredConfig
+}
+
+func (s *fakeStorage) GetConfigByKindAndHandle(kind, handle string) (*models.StoredConfig, error) {
+	cfg, ok := s.configs[handle]
+	if !ok || cfg.Kind != kind {
+		return nil, storage.ErrNotFound
+	}
+	return cfg, nil
+}
+
+func virtualProxyConfig(handle string, upstreams ...api.MCPVirtualUpstream) *models.StoredConfig {
+	return &models.StoredConfig{
+		UUID:   handle + "-id",
+		Kind:   string(models.KindMcp),
+		Handle: handle,
+		SourceConfiguration: api.MCPProxyConfiguration{
+			ApiVersion: api.MCPProxyConfigurationApiVersionGatewayApiPlatformWso2Comv1alpha1,
+			Kind:       api.MCPProxyConfigurationKindVirtualMcp,
+			Metadata:   api.Metadata{Name: handle},
+			Spec: api.MCPProxyConfigData{
+				DisplayName: "Agent Tools",
+				Version:     "v1.0",
+				Upstreams:   &upstreams,
+			},
+		},
+	}
+}
+
+// testClient is an MCP client of a virtual proxy served by the aggregator
+type testClient struct {
+	t         *testing.T
+	url       string
+	sessionID string
+	nextID    int
+	// noToken leaves out the router token, as a client bypassing the router would
+	noToken bool
+	// authorization is sent as the client's Authorization header
+	authorization string
+}
+
+// testRouterToken is the token the test clients send, as the router would
+const testRouterToken = "router-secret"
+
+func newTestAggregator(t *testing.T, configs ...*models.StoredConfig) (*Server, string) {
+	db := &fakeStorage{configs: make(map[string]*models.StoredConfig)}
+	for _, cfg := range configs {
+		db.configs[cfg.Handle] = cfg
+	}
+	cfg := &config.MCPAggregatorConfig{
+		Enabled:            true,
+		Port:               9095,
+		Host:               "localhost",
+		UpstreamTimeout:    5 * time.Second,
+		SessionIdleTimeout: time.Minute,
+		RouterToken:        testRouterToken,
+	}
+	s := NewServer(cfg, db, nil, nil, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	server := httptest.NewServer(s.httpServer.Handler)
+	t.Cleanup(server.Close)
+	return s, server.URL
+}
+
+func connect(t *testing.T, baseURL, handle string) *testClient {
+	c := &testClient{t: t, url: baseURL + "/virtual-mcp/" + handle + "/mcp"}
+	resp, msg := c.post("initialize", map[string]any{"protocolVersion": "2025-06-18"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Nil(t, msg.Error)
+	c.sessionID = resp.Header.Get(sessionIDHeader)
+	require.NotEmpty(t, c.sessionID)
+	return c
+}
+
+func (c *testClient) post(method string, params any) (*http.Response, rpcMessage) {
+	c.nextID++
+	msg := map[string]any{"jsonrpc": "2.0", "id": c.nextID, "method": method}
+	if params != nil {
+		msg["params"] = params
+	}
+	body, err := json.Marshal(msg)
+	require.NoError(c.t, err)
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	require.NoError(c.t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if !c.noToken {
+		req.Header.Set(constants.MCP_AGGREGATOR_TOKEN_HEADER, testRouterToken)
+	}
+	if c.authorization != "" {
+		req.Header.Set("Authorization", c.authorization)
+	}
+	if c.sessionID != "" {
+		req.Header.Set(sessionIDHeader, c.sessionID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(c.t, err)
+	defer resp.Body.Close()
+	var reply rpcMessage
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusNotFound {
+		require.NoError(c.t, json.NewDecoder(resp.Body).Decode(&reply))
+	}
+	return resp, reply
+}
+
+// call sends a request and returns its result, failing the test on errors
+func (c *testClient) call(method string, params any) map[string]json.RawMessage {
+	resp, msg := c.post(method, params)
+	require.Equal(c.t, http.StatusOK, resp.StatusCode)
+	require.Nil(c.t, msg.Error, "unexpected error for %s", method)
+	var result map[string]json.RawMessage
+	require.NoError(c.t, json.Unmarshal(msg.Result, &result))
+	return result
+}
+
+func (c *testClient) names(method, field, key string) []string {
+	var items []map[string]any
+	require.NoError(c.t, json.Unmarshal(c.call(method, nil)[field], &items))
+	var names []string
+	for _, item := range items {
+		names = append(names, item[key].(string))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestServer_MergesAndRoutesTools(t *testing.T) {
+	github := newFakeUpstream(t)
+	github.tools = []string{"search", "create_issue", "delete_repo"}
+	github.pageSize = 2
+	jira := newFakeUpstream(t)
+	jira.tools = []string{"search", "create_ticket"}
+	jira.sse = true
+
+	_, baseURL := newTestAggregator(t, virtualProxyConfig("agent-tools",
+		api.MCPVirtualUpstream{
+			Name:       "github",
+			Url:        ptr(github.server.URL),
+			ToolPrefix: ptr("github_"),
+			Tools: &[]api.MCPVirtualCapability{
+				{Name: "search"},
+				{Name: "create_issue", As: ptr("open_issue")},
+			},
+			Auth: &api.MCPUpstreamAuth{Type: api.MCPUpstreamAuthTypeApiKey, Header: ptr("Authorization"), Value: ptr("Bearer gh-token")},
+		},
+		api.MCPVirtualUpstream{
+			Name: "jira",
+			Url:  ptr(jira.server.URL + "/"),
+			Auth: &api.MCPUpstreamAuth{Type: api.MCPUpstreamAuthTypeApiKey, Header: ptr("X-API-Key"), Value: ptr("jira-key")},
+		},
+	))
+	client := connect(t, baseURL, "agent-tools")
+
+	assert.Equal(t, []string{"create_ticket", "github_search", "open_issue", "search"},
+		client.names("tools/list", "tools", "name"))
+
+	client.call("tools/call", map[string]any{"name": "open_issue", "arguments": map[string]any{}})
+	client.call("tools/call", map[string]any{"name": "github_search"})
+	client.call("tools/call", map[string]any{"name": "search"})
+
+	assert.Equal(t, []string{"tools/list", "tools/list", "tools/call create_issue", "tools/call search"}, github.callLog())
+	assert.Equal(t, []string{"tools/list", "tools/call search"}, jira.callLog())
+
+	// Each upstream receives its own credentials
+	for _, h := range github.headers {
+		assert.Equal(t, "Bearer gh-token", h.Get("Authorization"))
+		assert.Empty(t, h.Get("X-API-Key"))
+	}
+	for _, h := range jira.headers {
+		assert.Equal(t, "jira-key", h.Get("X-API-Key"))
+		assert.Empty(t, h.Get("Authorization"))
+	}
+
+	// Tools left out of the curated list cannot be called
+	resp, msg := client.post("tools/call", map[string]any{"name": "github_delete_repo"})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotNil(t, msg.Error)
+	assert.Equal(t, codeInvalidParams, msg.Error.Code)
+}
+
+func TestServer_FirstUpstreamWinsNameCollisions(t *testing.T) {
+	first := newFakeUpstream(t)
+	first.tools = []string{"search"}
+	second := newFakeUpstream(t)
+	second.tools = []string{"search", "fetch"}
+
+	_, baseURL := newTestAggregator(t, virtualProxyConfig("agent-tools",
+		api.MCPVirtualUpstream{Name: "first", Url: ptr(first.server.URL)},
+		api.MCPVirtualUpstream{Name: "second", Url: ptr(second.server.URL)},
+	))
+	client := connect(t, baseURL, "agent-tools")
+
+	assert.Equal(t, []string{"fetch", "search"}, client.names("tools/list", "tools", "name"))
+	client.call("tools/call", map[string]any{"name": "search"})
+	assert.Equal(t, []string{"tools/list", "tools/call search"}, first.callLog())
+	assert.Equal(t, []string{"tools/list"}, second.callLog())
+}
+
+func TestServer_CallsWithoutListingResolveRoutes(t *testing.T) {
+	up := newFakeUpstream(t)
+	up.tools = []string{"search"}
+
+	_, baseURL := newTestAggregator(t, virtualProxyConfig("agent-tools",
+		api.MCPVirtualUpstream{Name: "docs", Url: ptr(up.server.URL), ToolPrefix: ptr("docs_")},
+	))
+	client := connect(t, baseURL, "agent-tools")
+
+	client.call("tools/call", map[string]any{"name": "docs_search"})
+	assert.Equal(t, []string{"tools/list", "tools/call search"}, up.callLog())
+}
+
+func TestServer_MergesPromptsAndResources(t *testing.T) {
+	docs := newFakeUpstream(t)
+	docs.prompts = []string{"summarize", "translate"}
+	docs.resources = []string{"file:///docs/a.md", "file:///docs/b.md"}
+	docs.templates = []string{"file:///docs/{path}"}
+	wiki := newFakeUpstream(t)
+	wiki.resources = []string{"wiki://home"}
+	wiki.templates = []string{"wiki://pages/{name}"}
+
+	_, baseURL := newTestAggregator(t, virtualProxyConfig("agent-tools",
+		api.MCPVirtualUpstream{
+			Name:       "docs",
+			Url:        ptr(docs.server.URL),
+			ToolPrefix: ptr("docs_"),
+			Prompts:    &[]api.MCPVirtualCapability{{Name: "summarize"}},
+			Resources:  &[]string{"file:///docs/a.md"},
+		},
+		// Upstreams without prompts support are left out of prompts/list
+		api.MCPVirtualUpstream{Name: "wiki", Url: ptr(wiki.server.URL)},
+	))
+	client := connect(t, baseURL, "agent-tools")
+
+	assert.Equal(t, []string{"docs_summarize"}, client.names("prompts/list", "prompts", "name"))
+	assert.Equal(t, []string{"file:///docs/a.md", "wiki://home"}, client.names("resources/list", "resources", "uri"))
+	assert.Equal(t, []string{"wiki://pages/{name}"}, client.names("resources/templates/list", "resourceTemplates", "uriTemplate"))
+
+	client.call("prompts/get", map[string]any{"name": "docs_summarize"})
+	client.call("resources/read", map[string]any{"uri": "file:///docs/a.md"})
+	client.call("resources/read", map[string]any{"uri": "wiki://pages/intro"})
+
+	assert.Contains(t, docs.callLog(), "prompts/get summarize")
+	assert.Contains(t, docs.callLog(), "resources/read file:///docs/a.md")
+	assert.Contains(t, wiki.callLog(), "resources/read wiki://pages/intro")
+
+	// Resources left out of the curated list cannot be read
+	_, msg := client.post("resources/read", map[string]any{"uri": "file:///docs/b.md"})
+	require.NotNil(t, msg.Error)
+	assert.Equal(t, codeResourceNotFound, msg.Error.Code)
+}
+
+func TestServer_ReinitializesExpiredUpstreamSessions(t *testing.T) {
+	up := newFakeUpstream(t)
+	up.tools = []string{"search"}
+
+	_, baseURL := newTestAggregator(t, virtualProxyConfig("agent-tools",
+		api.MCPVirtualUpstream{Name: "docs", Url: ptr(up.server.URL)},
+	))
+	client := connect(t, baseURL, "agent-tools")
+
+	client.call("tools/list", nil)
+	up.expireSessions()
+	client.call("tools/call", map[string]any{"name": "search"})
+	assert.Equal(t, []string{"tools/list", "tools/call search"}, up.callLog())
+	assert.Equal(t, 1, up.sessionCount())
+}
+
+func TestServer_RejectsUnknownSessions(t *testing.T) {
+	up := newFakeUpstream(t)
+	up.tools = []string{"search"}
+
+	s, baseURL := newTestAggregator(t,
+		virtualProxyConfig("agent-tools", api.MCPVirtualUpstream{Name: "docs", Url: ptr(up.server.URL)}),
+		virtualProxyConfig("other-tools", api.MCPVirtualUpstream{Name: "docs", Url: ptr(up.server.URL)}),
+	)
+	// A session started on another replica, or made up by the client
+	client := &testClient{t: t, url: baseURL + "/virtual-mcp/agent-tools/mcp", sessionID: "unknown-session"}
+	resp, msg := client.post("tools/list", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.NotNil(t, msg.Error)
+	assert.Empty(t, up.callLog())
+	assert.Empty(t, s.sessions.evictIdle(time.Now().Add(time.Hour)), "unknown sessions must not be stored")
+
+	// A session of another proxy is not accepted either
+	other := connect(t, baseURL, "other-tools")
+	client.sessionID = other.sessionID
+	resp, _ = client.post("tools/list", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestServer_DeleteEndsUpstreamSessions(t *testing.T) {
+	up := newFakeUpstream(t)
+	up.tools = []string{"search"}
+
+	_, baseURL := newTestAggregator(t, virtualProxyConfig("agent-tools",
+		api.MCPVirtualUpstream{Name: "docs", Url: ptr(up.server.URL)},
+	))
+	client := connect(t, baseURL, "agent-tools")
+	client.call("tools/list", nil)
+	require.Equal(t, 1, up.sessionCount())
+
+	req, err := http.NewRequest(http.MethodDelete, client.url, nil)
+	require.NoError(t, err)
+	req.Header.Set(constants.MCP_AGGREGATOR_TOKEN_HEADER, testRouterToken)
+	req.Header.Set(sessionIDHeader, client.sessionID)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, 0, up.sessionCount())
+}
+
+func TestServer_EvictsIdleSessions(t *testing.T) {
+	up := newFakeUpstream(t)
+	up.tools = []string{"search"}
+
+	s, baseURL := newTestAggregator(t, virtualProxyConfig("agent-tools",
+		api.MCPVirtualUpstream{Name: "docs", Url: ptr(up.server.URL)},
+	))
+	client := connect(t, baseURL, "agent-tools")
+	client.call("tools/list", nil)
+
+	evicted := s.sessions.evictIdle(time.Now().Add(2 * time.Minute))
+	require.Len(t, evicted, 1)
+	assert.Equal(t, client.sessionID, evicted[0].id)
+	assert.Empty(t, s.sessions.evictIdle(time.Now().Add(2*time.Minute)))
+}
+
+func TestServer_RejectsInvalidRequests(t *testing.T) {
+	plain := &models.StoredConfig{
+		UUID:   "plain-id",
+		Kind:   string(models.KindMcp),
+		Handle: "plain",
+		SourceConfiguration: api.MCPProxyConfiguration{
+			Kind:     api.MCPProxyConfigurationKindMcp,
+			Metadata: api.Metadata{Name: "plain"},
+		},
+	}
+	up := newFakeUpstream(t)
+	_, baseURL := newTestAggregator(t,
+		virtualProxyConfig("agent-tools", api.MCPVirtualUpstream{Name: "docs", Url: ptr(up.server.URL)}),
+		plain,
+	)
+
+	t.Run("missing router token", func(t *testing.T) {
+		client := &testClient{t: t, url: baseURL + "/virtual-mcp/agent-tools/mcp", noToken: true}
+		resp, _ := client.post("initialize", nil)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("wrong router token", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodPost, baseURL+"/virtual-mcp/agent-tools/mcp",
+			strings.NewReader(`{"jsonrpc": "2.0", "id": 1, "method": "initialize"}`))
+		require.NoError(t, err)
+		req.Header.Set(constants.MCP_AGGREGATOR_TOKEN_HEADER, "guessed")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("unknown proxy", func(t *testing.T) {
+		client := &testClient{t: t, url: baseURL + "/virtual-mcp/missing/mcp"}
+		resp, _ := client.post("initialize", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("proxy of another kind", func(t *testing.T) {
+		client := &testClient{t: t, url: baseURL + "/virtual-mcp/plain/mcp"}
+		resp, _ := client.post("initialize", nil)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("missing session", func(t *testing.T) {
+		client := &testClient{t: t, url: baseURL + "/virtual-mcp/agent-tools/mcp"}
+		resp, msg := client.post("tools/list", nil)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.NotNil(t, msg.Error)
+		assert.True(t, strings.Contains(msg.Error.Message, "Mcp-Session-Id"))
+	})
+
+	t.Run("unknown method", func(t *testing.T) {
+		client := connect(t, baseURL, "agent-tools")
+		_, msg := client.post("completion/complete", map[string]any{})
+		require.NotNil(t, msg.Error)
+		assert.Equal(t, codeMethodNotFound, msg.Error.Code)
+	})
+
+	t.Run("stream not offered", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, baseURL+"/virtual-mcp/agent-tools/mcp", nil)
+		require.NoError(t, err)
+		req.Header.Set(constants.MCP_AGGREGATOR_TOKEN_HEADER, testRouterToken)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+func restAPIConfig(handle, context string, operations ...api.Operation) *models.StoredConfig {
+	return &models.StoredConfig{
+		UUID:   handle + "-id",
+		Kind:   string(models.KindRestApi),
+		Handle: handle,
+		Configuration: api.RestAPI{
+			Kind:     api.RestAPIKindRestApi,
+			Metadata: api.Metadata{Name: handle},
+			Spec: api.APIConfigData{
+				DisplayName: "Books",
+				Version:     "v1.0",
+				Context:     context,
+				Operations:  operations,
+			},
+		},
+	}
+}
+
+// fakeRouter records the REST requests the aggregator sends through the router
+type fakeRouter struct {
+	server   *httptest.Server
+	mu       sync.Mutex
+	requests []string
+	bodies   []string
+	headers  []http.Header
+}
+
+func newFakeRouter(t *testing.T) *fakeRouter {
+	f := &fakeRouter{}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		f.mu.Lock()
+		f.requests = append(f.requests, r.Method+" "+r.URL.RequestURI())
+		f.bodies = append(f.bodies, string(body))
+		f.headers = append(f.headers, r.Header.Clone())
+		f.mu.Unlock()
+
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			http.Error(w, "book not found", http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"42","title":"Dune"}`))
+	}))
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func TestServer_ExposesRestApiOperationsAsTools(t *testing.T) {
+	router := newFakeRouter(t)
+	s, baseURL := newTestAggregator(t,
+		restAPIConfig("books-api", "/books/$version",
+			api.Operation{Method: "GET", Path: "/books/{id}"},
+			api.Operation{Method: "POST", Path: "/books"},
+		),
+		virtualProxyConfig("agent-tools", api.MCPVirtualUpstream{
+			Name:       "books",
+			RestApi:    ptr("books-api"),
+			ToolPrefix: ptr("books_"),
+			Auth:       &api.MCPUpstreamAuth{Type: api.MCPUpstreamAuthTypeApiKey, Header: ptr("X-API-Key"), Value: ptr("books-key")},
+			// Without input schemas, the schemas are derived from the path and method
+			Operations: &[]api.MCPRestOperation{
+				{Name: "get_book", Method: api.MCPRestOperationMethodGET, Path: "/books/{id}"},
+				{Name: "add_book", Method: api.MCPRestOperationMethodPOST, Path: "/books"},
+			},
+		}),
+	)
+	s.cfg.RouterURL = router.server.URL
+	client := connect(t, baseURL, "agent-tools")
+
+	var tools []map[string]any
+	require.NoError(t, json.Unmarshal(client.call("tools/list", nil)["tools"], &tools))
+	require.Len(t, tools, 2)
+	assert.Equal(t, "books_get_book", tools[0]["name"])
+	assert.Equal(t, map[string]any{"readOnlyHint": true}, tools[0]["annotations"])
+	assert.Equal(t, []any{"id"}, tools[0]["inputSchema"].(map[string]any)["required"])
+	assert.Equal(t, "books_add_book", tools[1]["name"])
+	assert.Contains(t, tools[1]["inputSchema"].(map[string]any)["properties"], "body")
+
+	result := client.call("tools/call", map[string]any{"name": "books_get_book", "arguments": map[string]any{"id": "42"}})
+	assert.JSONEq(t, `false`, string(result["isError"]))
+	assert.JSONEq(t, `[{"type":"text","text":"{\"id\":\"42\",\"title\":\"Dune\"}"}]`, string(result["content"]))
+	assert.JSONEq(t, `{"id":"42","title":"Dune"}`, string(result["structuredContent"]))
+
+	client.call("tools/call", map[string]any{"name": "books_add_book", "arguments": map[string]any{"body": map[string]any{"title": "Dune"}}})
+
+	assert.Equal(t, []string{"GET /books/v1.0/books/42", "POST /books/v1.0/books"}, router.requests)
+	assert.JSONEq(t, `{"title":"Dune"}`, router.bodies[1])
+	assert.Equal(t, "application/json", router.headers[1].Get("Content-Type"))
+	for _, h := range router.headers {
+		assert.Equal(t, "books-key", h.Get("X-API-Key"))
+	}
+
+	// Path parameters are required
+	resp, msg := client.post("tools/call", map[string]any{"name": "books_get_book", "arguments": map[string]any{}})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	require.NotNil(t, msg.Error)
+	assert.Equal(t, codeInvalidParams, msg.Error.Code)
+}
+
+func TestServer_ExposesEveryRestApiOperationWithoutCuratedOperations(t *testing.T) {
+	router := newFakeRouter(t)
+	s, baseURL := newTestAggregator(t,
+		restAPIConfig("books-api", "/books",
+			api.Operation{Method: "GET", Path: "/books/{id}"},
+			api.Operation{Method: "POST", Path: "/books"},
+		),
+		virtualProxyConfig("agent-tools", api.MCPVirtualUpstream{Name: "books", RestApi: ptr("books-api"), ToolPrefix: ptr("books_")}),
+	)
+	s.cfg.RouterURL = router.server.URL
+	client := connect(t, baseURL, "agent-tools")
+
+	var tools []map[string]any
+	require.NoError(t, json.Unmarshal(client.call("tools/list", nil)["tools"], &tools))
+	require.Len(t, tools, 2)
+	assert.Equal(t, "books_get_books_by_id", tools[0]["name"])
+	assert.Equal(t, "GET /books/{id} of Books", tools[0]["description"])
+	assert.Equal(t, []any{"id"}, tools[0]["inputSchema"].(map[string]any)["required"])
+	assert.Equal(t, "books_post_books", tools[1]["name"])
+	assert.Contains(t, tools[1]["inputSchema"].(map[string]any)["properties"], "body")
+
+	client.call("tools/call", map[string]any{"name": "books_get_books_by_id", "arguments": map[string]any{"id": "42"}})
+	client.call("tools/call", map[string]any{"name": "books_post_books", "arguments": map[string]any{"body": map[string]any{"title": "Dune"}}})
+	assert.Equal(t, []string{"GET /books/books/42", "POST /books/books"}, router.requests)
+}
+
+// fakeDefinitions serves the OpenAPI definitions the control plane stores for RestApis
+type fakeDefinitions struct {
+	mu          sync.Mutex
+	definitions map[string]string
+	fetches     int
+}
+
+func (f *fakeDefinitions) FetchOpenAPIDefinition(apiID string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fetches++
+	definition, ok := f.definitions[apiID]
+	if !ok {
+		return nil, nil
+	}
+	return []byte(definition), nil
+}
+
+const booksDefinition = `
+openapi: 3.0.3
+info:
+  title: Books
+  version: v1.0
+paths:
+  /books/{bookId}:
+    parameters:
+      - name: bookId
+        in: path
+        required: true
+        schema:
+          type: integer
+    get:
+      summary: Get a book
+      description: Returns the book with the given ID.
+      parameters:
+        - name: fields
+          in: query
+          description: Fields to return
+          schema:
+            type: array
+            items:
+              type: string
+      responses:
+        200:
+          description: The book
+  /books:
+    post:
+      summary: Add a book
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Book'
+      responses:
+        201:
+          description: Created
+components:
+  schemas:
+    Book:
+      type: object
+      required: [title]
+      properties:
+        title:
+          type: string
+        related:
+          type: array
+          items:
+            $ref: '#/components/schemas/Book'
+`
+
+func TestServer_TypesRestApiToolsFromStoredDefinition(t *testing.T) {
+	router := newFakeRouter(t)
+	deployed := restAPIConfig("books-api", "/books",
+		api.Operation{Method: "GET", Path: "/books/{id}"},
+		api.Operation{Method: "POST", Path: "/books"},
+		api.Operation{Method: "DELETE", Path: "/books/{id}"},
+	)
+	deployed.Origin = models.OriginControlPlane
+	deployed.DeploymentID = "deployment-1"
+	gatewayOnly := restAPIConfig("authors-api", "/authors", api.Operation{Method: "GET", Path: "/authors"})
+	definitions := &fakeDefinitions{definitions: map[string]string{
+		"books-api-id":   booksDefinition,
+		"authors-api-id": booksDefinition,
+	}}
+
+	s, baseURL := newTestAggregator(t, deployed, gatewayOnly, virtualProxyConfig("agent-tools",
+		api.MCPVirtualUpstream{Name: "books", RestApi: ptr("books-api")},
+		api.MCPVirtualUpstream{Name: "authors", RestApi: ptr("authors-api")},
+	))
+	s.definitions = newDefinitionCache(definitions)
+	s.cfg.RouterURL = router.server.URL
+	client := connect(t, baseURL, "agent-tools")
+
+	var tools []map[string]any
+	require.NoError(t, json.Unmarshal(client.call("tools/list", nil)["tools"], &tools))
+	byName := map[string]map[string]any{}
+	for _, tool := range tools {
+		byName[tool["name"].(string)] = tool
+	}
+	require.Len(t, byName, 4)
+
+	// Parameters are typed from the definition, even when it names them differently
+	getBook := byName["get_books_by_id"]
+	assert.Equal(t, "Get a book", getBook["title"])
+	assert.Equal(t, "Returns the book with the given ID.", getBook["description"])
+	assert.JSONEq(t, `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer"},
+			"fields": {"type": "array", "items": {"type": "string"}, "description": "Fields to return"}
+		},
+		"required": ["id"]
+	}`, mustJSON(t, getBook["inputSchema"]))
+
+	// The body is typed from the definition, with its references expanded
+	body := byName["post_books"]["inputSchema"].(map[string]any)["properties"].(map[string]any)["body"].(map[string]any)
+	assert.Equal(t, []any{"title"}, body["required"])
+	assert.Equal(t, "string", body["properties"].(map[string]any)["title"].(map[string]any)["type"])
+	assert.Equal(t, map[string]any{}, body["properties"].(map[string]any)["related"].(map[string]any)["items"])
+	assert.Equal(t, []any{"body"}, byName["post_books"]["inputSchema"].(map[string]any)["required"])
+
+	// Operations missing from the definition, and RestApis created on the gateway, are derived
+	assert.Equal(t, "DELETE /books/{id} of Books", byName["delete_books_by_id"]["description"])
+	assert.Equal(t, "GET /authors of Books", byName["get_authors"]["description"])
+
+	client.call("tools/call", map[string]any{"name": "get_books_by_id", "arguments": map[string]any{"id": "42", "fields": []string{"title"}}})
+	assert.Equal(t, []string{"GET /books/books/42?fields=title"}, router.requests)
+
+	// The definition is fetched once per deployment of the RestApi
+	connect(t, baseURL, "agent-tools").call("tools/list", nil)
+	assert.Equal(t, 1, definitions.fetches)
+	deployed.DeploymentID = "deployment-2"
+	connect(t, baseURL, "agent-tools").call("tools/list", nil)
+	assert.Equal(t, 2, definitions.fetches)
+}
+
+func mustJSON(t *testing.T, v any) string {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestServer_CallsCuratedRestApiOperations(t *testing.T) {
+	router := newFakeRouter(t)
+	s, baseURL := newTestAggregator(t,
+		restAPIConfig("books-api", "/books", api.Operation{Method: "GET", Path: "/books/{id}"}),
+		virtualProxyConfig("agent-tools", api.MCPVirtualUpstream{
+			Name:    "books",
+			RestApi: ptr("books-api"),
+			Operations: &[]api.MCPRestOperation{{
+				Name:        "get_book",
+				Description: ptr("Get a book by its ID"),
+				Method:      api.MCPRestOperationMethodGET,
+				Path:        "/books/{id}",
+				Parameters: &[]api.MCPRestParameter{
+					{Name: "id", In: api.MCPRestParameterInPath},
+					{Name: "fields", In: api.MCPRestParameterInQuery},
+					{Name: "X-Trace", In: api.MCPRestParameterInHeader},
+				},
+				InputSchema: ptr(`{"type":"object","properties":{"id":{"type":"string"},"fields":{"type":"array"}},"required":["id"]}`),
+			}},
+		}),
+	)
+	s.cfg.RouterURL = router.server.URL + "/"
+	client := connect(t, baseURL, "agent-tools")
+
+	var tools []map[string]any
+	require.NoError(t, json.Unmarshal(client.call("tools/list", nil)["tools"], &tools))
+	require.Len(t, tools, 1)
+	assert.Equal(t, "get_book", tools[0]["name"])
+	assert.Equal(t, "Get a book by its ID", tools[0]["description"])
+	assert.Equal(t, "array", tools[0]["inputSchema"].(map[string]any)["properties"].(map[string]any)["fields"].(map[string]any)["type"])
+
+	client.call("tools/call", map[string]any{"name": "get_book", "arguments": map[string]any{
+		"id": "a b", "fields": []string{"title", "author"}, "X-Trace": "t-1",
+	}})
+	result := client.call("tools/call", map[string]any{"name": "get_book", "arguments": map[string]any{"id": "missing"}})
+
+	assert.Equal(t, []string{"GET /books/books/a%20b?fields=title&fields=author", "GET /books/books/missing"}, router.requests)
+	assert.Equal(t, "t-1", router.headers[0].Get("X-Trace"))
+	// Error responses are returned as tool errors with the response body
+	assert.JSONEq(t, `true`, string(result["isError"]))
+	assert.Contains(t, string(result["content"]), "book not found")
+	assert.NotContains(t, result, "structuredContent")
+}
+
+func TestServer_ForwardsCallerCredentialsToRestApis(t *testing.T) {
+	router := newFakeRouter(t)
+	operations := &[]api.MCPRestOperation{{Name: "get_book", Method: api.MCPRestOperationMethodGET, Path: "/books/{id}"}}
+	s, baseURL := newTestAggregator(t,
+		restAPIConfig("books-api", "/books", api.Operation{Method: "GET", Path: "/books/{id}"}),
+		virtualProxyConfig("agent-tools", api.MCPVirtualUpstream{Name: "books", RestApi: ptr("books-api"), Operations: operations}),
+		virtualProxyConfig("shared-tools", api.MCPVirtualUpstream{
+			Name:       "books",
+			RestApi:    ptr("books-api"),
+			Operations: operations,
+			Auth:       &api.MCPUpstreamAuth{Type: api.MCPUpstreamAuthTypeApiKey, Header: ptr("Authorization"), Value: ptr("Bearer service")},
+		}),
+	)
+	s.cfg.RouterURL = router.server.URL
+
+	alice := connect(t, baseURL, "agent-tools")
+	alice.authorization = "Bearer alice"
+	alice.call("tools/call", map[string]any{"name": "get_book", "arguments": map[string]any{"id": "1"}})
+	anonymous := connect(t, baseURL, "agent-tools")
+	anonymous.call("tools/call", map[string]any{"name": "get_book", "arguments": map[string]any{"id": "2"}})
+	// A credential configured on the upstream replaces the caller's
+	shared := connect(t, baseURL, "shared-tools")
+	shared.authorization = "Bearer alice"
+	shared.call("tools/call", map[string]any{"name": "get_book", "arguments": map[string]any{"id": "3"}})
+
+	require.Len(t, router.headers, 3)
+	assert.Equal(t, "Bearer alice", router.headers[0].Get("Authorization"))
+	assert.Empty(t, router.headers[1].Get("Authorization"))
+	assert.Equal(t, "Bearer service", router.headers[2].Get("Authorization"))
+	for _, h := range router.headers {
+		assert.Empty(t, h.Get(constants.MCP_AGGREGATOR_TOKEN_HEADER))
+	}
+}
+
+func TestServer_LeavesOutUnavailableRestApis(t *testing.T) {
+	up := newFakeUpstream(t)
+	up.tools = []string{"search"}
+	undeployed := restAPIConfig("books-api", "/books", api.Operation{Method: "GET", Path: "/books"})
+	undeployed.DesiredState = models.StateUndeployed
+
+	_, baseURL := newTestAggregator(t, undeployed, virtualProxyConfig("agent-tools",
+		api.MCPVirtualUpstream{
+			Name:       "books",
+			RestApi:    ptr("books-api"),
+			Operations: &[]api.MCPRestOperation{{Name: "list_books", Method: api.MCPRestOperationMethodGET, Path: "/books"}},
+		},
+		api.MCPVirtualUpstream{Name: "docs", Url: ptr(up.server.URL)},
+	))
+	client := connect(t, baseURL, "agent-tools")
+
+	assert.Equal(t, []string{"search"}, client.names("tools/list", "tools", "name"))
+	// RestApis offer no prompts, so listing them does not fail
+	assert.Empty(t, client.names("prompts/list", "prompts", "name"))
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcpaggregator
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// session is a client session of a virtual MCP proxy. It holds one session on each
+// upstream MCP server, initialized the first time the upstream is called.
+type session struct {
+	id              string
+	handle          string
+	protocolVersion string
+
+	mu       sync.Mutex
+	lastUsed time.Time
+	upstream map[string]*upstreamSession
+	// initMu serializes the initialization of the session on each upstream so that
+	// concurrent requests do not open several sessions on the same upstream
+	initMu map[string]*sync.Mutex
+	// routes maps the exposed tools, prompts and resources to the upstreams owning them,
+	// as of the last time they were listed
+	routes map[string]route
+}
+
+// route locates an exposed tool, prompt or resource on its upstream
+type route struct {
+	upstream string
+	name     string
+}
+
+// upstreamSession is the session a virtual MCP session holds on one upstream MCP server
+type upstreamSession struct {
+	upstream        *upstream
+	id              string
+	protocolVersion string
+}
+
+func (s *session) touch(now time.Time) {
+	s.mu.Lock()
+	s.lastUsed = now
+	s.mu.Unlock()
+}
+
+func (s *session) upstreamSession(name string) *upstreamSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.upstream[name]
+}
+
+func (s *session) setUpstreamSession(name string, us *upstreamSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if us == nil {
+		delete(s.upstream, name)
+		return
+	}
+	s.upstream[name] = us
+}
+
+func (s *session) initLock(name string) *sync.Mutex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	lock, ok := s.initMu[name]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.initMu[name] = lock
+	}
+	return lock
+}
+
+func (s *session) route(key string) (route, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.routes[key]
+	return r, ok
+}
+
+// setRoutes replaces the routes of one listing, identified by the key prefix
+func (s *session) setRoutes(prefix string, routes map[string]route) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.routes {
+		if strings.HasPrefix(key, prefix) {
+			delete(s.routes, key)
+		}
+	}
+	for key, r := range routes {
+		s.routes[key] = r
+	}
+}
+
+// routesWithPrefix returns the routes whose keys start with prefix
+func (s *session) routesWithPrefix(prefix string) map[string]route {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	routes := make(map[string]route)
+	for key, r := range s.routes {
+		if strings.HasPrefix(key, prefix) {
+			routes[key] = r
+		}
+	}
+	return routes
+}
+
+func (s *session) upstreamSessions() []*upstreamSession {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sessions := make([]*upstreamSession, 0, len(s.upstream))
+	for _, us := range s.upstream {
+		sessions = append(sessions, us)
+	}
+	return sessions
+}
+
+// sessionStore keeps the virtual MCP sessions of this controller in memory
+type sessionStore struct {
+	idleTimeout time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*session
+}
+
+func newSessionStore(idleTimeout time.Duration) *sessionStore {
+	return &sessionStore{
+		idleTimeout: idleTimeout,
+		sessions:    make(map[string]*session),
+	}
+}
+
+func (s *sessionStore) create(handle, protocolVersion string, now time.Time) *session {
+	sess := newSession(uuid.NewString(), handle, protocolVersion, now)
+	s.mu.Lock()
+	s.sessions[sess.id] = sess
+	s.mu.Unlock()
+	return sess
+}
+
+// get returns the session with the given ID and marks it as used, or nil when it is unknown
+func (s *sessionStore) get(id string, now time.Time) *session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.sessions[id]
+	if !ok {
+		return nil
+	}
+	sess.touch(now)
+	return sess
+}
+
+func (s *sessionStore) remove(id string) *session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess := s.sessions[id]
+	delete(s.sessions, id)
+	return sess
+}
+
+// evictIdle removes and returns the sessions idle for longer than the idle timeout
+func (s *sessionStore) evictIdle(now time.Time) []*session {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var evicted []*session
+	for id, sess := range s.sessions {
+		sess.mu.Lock()
+		idle := now.Sub(sess.lastUsed) > s.idleTimeout
+		sess.mu.Unlock()
+		if idle {
+			delete(s.sessions, id)
+			evicted = append(evicted, sess)
+		}
+	}
+	return evicted
+}
+
+func newSession(id, handle, protocolVersion string, now time.Time) *session {
+	return &session{
+		id:              id,
+		handle:          handle,
+		protocolVersion: protocolVersion,
+		lastUsed:        now,
+		upstream:        make(map[string]*upstreamSession),
+		initMu:          make(map[string]*sync.Mutex),
+		routes:          make(map[string]route),
+	}
+}
@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package mcpaggregator
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// errUpstreamSessionExpired is returned when an upstream no longer knows the session
+// the aggregator holds on it, so that the session can be initialized again
+var errUpstreamSessionExpired = errors.New("upstream MCP session expired")
+
+// upstreamClient speaks the MCP Streamable HTTP transport to the upstream MCP servers
+type upstreamClient struct {
+	httpClient *http.Client
+	nextID     atomic.Int64
+}
+
+func newUpstreamClient(timeout time.Duration) *upstreamClient {
+	return &upstreamClient{httpClient: &http.Client{Timeout: timeout}}
+}
+
+// initialize opens a session on the upstream MCP server
+func (c *upstreamClient) initialize(ctx context.Context, up *upstream, protocolVersion string) (*upstreamSession, error) {
+	params := map[string]any{
+		"protocolVersion": protocolVersion,
+		"capabilities":    map[string]any{},
+		"clientInfo":      clientInfo(),
+	}
+	us := &upstreamSession{upstream: up, protocolVersion: protocolVersion}
+	result, header, err := c.call(ctx, us, "initialize", params)
+	if err != nil {
+		return nil, err
+	}
+	var initResult struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if err := json.Unmarshal(result, &initResult); err != nil {
+		return nil, fmt.Errorf("invalid initialize result from upstream %s: %w", up.name, err)
+	}
+	if initResult.ProtocolVersion != "" {
+		us.protocolVersion = initResult.ProtocolVersion
+	}
+	us.id = header.Get(sessionIDHeader)
+
+	if err := c.notify(ctx, us, "notifications/initialized"); err != nil {
+		return nil, err
+	}
+	return us, nil
+}
+
+// request sends a request on an upstream session and returns its result
+func (c *upstreamClient) request(ctx context.Context, us *upstreamSession, method string, params json.RawMessage) (json.RawMessage, error) {
+	result, _, err := c.call(ctx, us, method, params)
+	return result, err
+}
+
+// closeSession ends the session on the upstream MCP server
+func (c *upstreamClient) closeSession(ctx context.Context, us *upstreamSession) error {
+	if us.id == "" {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, us.upstream.endpoint, nil)
+	if err != nil {
+		return err
+	}
+	c.setHeaders(req, us)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func (c *upstreamClient) notify(ctx context.Context, us *upstreamSession, method string) error {
+	body, err := json.Marshal(rpcMessage{JSONRPC: jsonRPCVersion, Method: method})
+	if err != nil {
+		return err
+	}
+	resp, err := c.post(ctx, us, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("upstream %s rejected %s with status %d", us.upstream.name, method, resp.StatusCode)
+	}
+	return nil
+}
+
+// call sends a JSON-RPC request and waits for its response, which the upstream may
+// return as a JSON body or as an event on an SSE stream
+func (c *upstreamClient) call(ctx context.Context, us *upstreamSession, method string, params any) (json.RawMessage, http.Header, error) {
+	id := json.RawMessage(strconv.FormatInt(c.nextID.Add(1), 10))
+	msg := rpcMessage{JSONRPC: jsonRPCVersion, ID: id, Method: method}
+	if params != nil {
+		raw, ok := params.(json.RawMessage)
+		if !ok {
+			var err error
+			if raw, err = json.Marshal(params); err != nil {
+				return nil, nil, err
+			}
+		}
+		msg.Params = raw
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.post(ctx, us, body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("upstream %s unreachable: %w", us.upstream.name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound && us.id != "" {
+		return nil, nil, errUpstreamSessionExpired
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("upstream %s returned status %d for %s", us.upstream.name, resp.StatusCode, method)
+	}
+
+	reply, err := readResponse(resp, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid response from upstream %s for %s: %w", us.upstream.name, method, err)
+	}
+	if reply.Error != nil {
+		return nil, resp.Header, reply.Error
+	}
+	return reply.Result, resp.Header, nil
+}
+
+func (c *upstreamClient) post(ctx context.Context, us *upstreamSession, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, us.upstream.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	c.setHeaders(req, us)
+	return c.httpClient.Do(req)
+}
+
+func (c *upstreamClient) setHeaders(req *http.Request, us *upstreamSession) {
+	if us.id != "" {
+		req.Header.Set(sessionIDHeader, us.id)
+	}
+	if us.protocolVersion != "" {
+		req.Header.Set(protocolVersionHeader, us.protocolVersion)
+	}
+	if us.upstream.authHeader != "" {
+		req.Header.Set(us.upstream.authHeader, us.upstream.authValue)
+	}
+}
+
+// readResponse reads the response to the request with the given ID from a JSON body or an
+// SSE stream. Requests and notifications the upstream sends on the stream are skipped.
+func readResponse(resp *http.Response, id json.RawMessage) (*rpcMessage, error) {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/event-stream" {
+		var msg rpcMessage
+		if err := json.NewDecoder(io.LimitReader(resp.Body, maxMessageSize)).Decode(&msg); err != nil {
+			return nil, err
+		}
+		return &msg, nil
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxMessageSize)
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" {
+			if value, ok := strings.CutPrefix(line, "data:"); ok {
+				if data.Len() > 0 {
+					data.WriteByte('\n')
+				}
+				data.WriteString(strings.TrimPrefix(value, " "))
+			}
+			continue
+		}
+		// A blank line ends the event
+		if data.Len() == 0 {
+			continue
+		}
+		var msg rpcMessage
+		err := json.Unmarshal([]byte(data.String()), &msg)
+		data.Reset()
+		if err == nil && msg.isResponse() && bytes.Equal(msg.ID, id) {
+			return &msg, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if data.Len() > 0 {
+		var msg rpcMessage
+		if err := json.Unmarshal([]byte(data.String()), &msg); err == nil && bytes.Equal(msg.ID, id) {
+			return &msg, nil
+		}
+	}
+	return nil, errors.New("stream ended without a response")
+}
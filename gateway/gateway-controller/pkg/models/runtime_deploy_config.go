@@ -59,6 +59,8 @@ type Route struct {
 	AutoHostRewrite bool
 	Timeout         *RouteTimeout
 	Upstream        RouteUpstream
+	Mirror          *RouteMirror       // nil = no traffic mirroring
+	TrafficSplit    *RouteTrafficSplit // nil = all traffic goes to Upstream
 }
 
 // RouteTimeout holds parsed timeout values for a route.
@@ -73,6 +75,46 @@ type RouteUpstream struct {
 	DefaultCluster   string // default cluster name when UseClusterHeader is true
 }
 
+// RouteMirror copies a route's requests to a secondary upstream cluster.
+type RouteMirror struct {
+	ClusterKey string   // key into UpstreamClusters map
+	Percentage *float32 // nil = mirror every matching request
+	Headers    []MirrorHeader
+}
+
+// MirrorHeader restricts mirroring to requests carrying a header, optionally with an exact value.
+type MirrorHeader struct {
+	Name  string
+	Value *string // nil = any value
+}
+
+// RouteTrafficSplit splits a route's traffic between upstream clusters. Requests matching a
+// rule go to the rule's cluster; the rest are shared by weight, or go to the route's Upstream
+// when there are no weights.
+type RouteTrafficSplit struct {
+	Rules   []TrafficSplitRule
+	Weights []WeightedCluster
+}
+
+// TrafficSplitRule sends requests carrying all of its headers and cookies to a cluster.
+type TrafficSplitRule struct {
+	ClusterKey string // key into UpstreamClusters map
+	Headers    []TrafficSplitMatch
+	Cookies    []TrafficSplitMatch
+}
+
+// TrafficSplitMatch matches a header or cookie by name, optionally with an exact value.
+type TrafficSplitMatch struct {
+	Name  string
+	Value *string // nil = any value
+}
+
+// WeightedCluster is a cluster's relative share of a route's traffic.
+type WeightedCluster struct {
+	ClusterKey string // key into UpstreamClusters map
+	Weight     uint32
+}
+
 // PolicyChain is an ordered list of policies for a route.
 type PolicyChain struct {
 	Policies []Policy
@@ -84,6 +126,8 @@ type Policy struct {
 	Version            string
 	Params             map[string]interface{}
 	ExecutionCondition *string
+	Timeout            string
+	OnError            string
 }
 
 // UpstreamCluster represents an Envoy cluster with its endpoints.
@@ -91,6 +135,7 @@ type UpstreamCluster struct {
 	BasePath  string
 	Endpoints []Endpoint
 	TLS       *UpstreamTLS
+	HTTP2     bool // speak HTTP/2 to the endpoints (h2c when TLS is off), e.g. for gRPC backends
 }
 
 // Endpoint is a single upstream host:port target.
@@ -246,11 +246,18 @@ func ConvertAPIPolicyToModel(p api.Policy, attachedTo policyv1alpha.Level, resol
 		paramsMap["attachedTo"] = string(attachedTo)
 	}
 
-	return policyenginev1.PolicyInstance{
+	instance := policyenginev1.PolicyInstance{
 		Name:               p.Name,
 		Version:            resolvedVersion,
 		Enabled:            true,
 		ExecutionCondition: p.ExecutionCondition,
 		Parameters:         paramsMap,
 	}
+	if p.Timeout != nil {
+		instance.Timeout = *p.Timeout
+	}
+	if p.OnError != nil {
+		instance.OnError = string(*p.OnError)
+	}
+	return instance
 }
@@ -73,6 +73,10 @@ func (t *Translator) buildEventChannelResource(uuid string, webSubCfg *api.WebSu
 	if spec.ChannelPolicies != nil {
 		channelPolicies = *spec.ChannelPolicies
 	}
+	var channelMessages map[string]api.WebSubChannelMessage
+	if spec.ChannelMessages != nil {
+		channelMessages = *spec.ChannelMessages
+	}
 	channels := make([]map[string]interface{}, 0, len(channelPolicies))
 	sortedKeys := make([]string, 0, len(channelPolicies))
 	for chName := range channelPolicies {
@@ -90,6 +94,9 @@ func (t *Translator) buildEventChannelResource(uuid string, webSubCfg *api.WebSu
 				"outbound":    buildPolicyList(chPolicies.OnMessageDelivery),
 			},
 		}
+		if msg, ok := channelMessages[chName]; ok {
+			chEntry["message"] = buildChannelMessage(msg)
+		}
 		channels = append(channels, chEntry)
 	}
 
@@ -123,9 +130,32 @@ func (t *Translator) buildEventChannelResource(uuid string, webSubCfg *api.WebSu
 		},
 	}
 
+	if spec.CloudEvents != nil && spec.CloudEvents.Mode != nil {
+		data["cloudEvents"] = map[string]interface{}{
+			"mode": string(*spec.CloudEvents.Mode),
+		}
+	}
+
 	return toAnyResource(data, EventChannelConfigTypeURL)
 }
 
+func buildChannelMessage(msg api.WebSubChannelMessage) map[string]interface{} {
+	entry := map[string]interface{}{
+		"payload":          msg.Payload,
+		"onInvalidMessage": string(api.WebSubChannelMessageOnInvalidMessageReject),
+	}
+	if msg.ContentType != nil {
+		entry["contentType"] = *msg.ContentType
+	}
+	if msg.SchemaFormat != nil {
+		entry["schemaFormat"] = *msg.SchemaFormat
+	}
+	if msg.OnInvalidMessage != nil {
+		entry["onInvalidMessage"] = string(*msg.OnInvalidMessage)
+	}
+	return entry
+}
+
 func buildPolicyList(policies *[]api.Policy) []interface{} {
 	if policies == nil || len(*policies) == 0 {
 		return []interface{}{}
@@ -140,6 +170,12 @@ func buildPolicyList(policies *[]api.Policy) []interface{} {
 		if p.Params != nil {
 			pol["params"] = *p.Params
 		}
+		if p.Timeout != nil {
+			pol["timeout"] = *p.Timeout
+		}
+		if p.OnError != nil {
+			pol["onError"] = string(*p.OnError)
+		}
 		result = append(result, pol)
 	}
 	return result
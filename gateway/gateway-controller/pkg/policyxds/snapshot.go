@@ -268,6 +268,12 @@ func (t *Translator) createPolicyChainResource(routeKey string, chain *models.Po
 		if p.ExecutionCondition != nil {
 			pol["executionCondition"] = *p.ExecutionCondition
 		}
+		if p.Timeout != "" {
+			pol["timeout"] = p.Timeout
+		}
+		if p.OnError != "" {
+			pol["onError"] = p.OnError
+		}
 		policies = append(policies, pol)
 	}
 
@@ -268,6 +268,7 @@ type UpdateParams struct {
 	Body          []byte
 	ContentType   string
 	CorrelationID string
+	Kind          string // "RestApi" (default) or "WebSubApi"
 	Logger        *slog.Logger
 }
 
@@ -275,6 +276,10 @@ type UpdateParams struct {
 func (s *RestAPIService) Update(params UpdateParams) (*UpdateResult, error) {
 	log := params.Logger
 
+	if params.Kind == models.KindWebSubApi {
+		return s.updateWebSubAPI(params)
+	}
+
 	// Parse configuration
 	var apiConfig api.RestAPI
 	if err := s.parser.Parse(params.Body, params.ContentType, &apiConfig); err != nil {
@@ -367,10 +372,33 @@ func (s *RestAPIService) Update(params UpdateParams) (*UpdateResult, error) {
 	return &UpdateResult{Config: existing}, nil
 }
 
+// updateWebSubAPI redeploys an existing WebSub API configuration under its current ID.
+func (s *RestAPIService) updateWebSubAPI(params UpdateParams) (*UpdateResult, error) {
+	existing, err := s.db.GetConfigByKindAndHandle(models.KindWebSubApi, params.Handle)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	result, err := s.deploymentService.DeployAPIConfiguration(utils.APIDeploymentParams{
+		Data:          params.Body,
+		ContentType:   params.ContentType,
+		Kind:          models.KindWebSubApi,
+		APIID:         existing.UUID,
+		Origin:        models.OriginGatewayAPI,
+		CorrelationID: params.CorrelationID,
+		Logger:        params.Logger,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateResult{Config: result.StoredConfig}, nil
+}
+
 // DeleteParams holds parameters for the Delete operation.
 type DeleteParams struct {
 	Handle        string
 	CorrelationID string
+	Kind          string // "RestApi" (default) or "WebSubApi"
 	Logger        *slog.Logger
 }
 
@@ -378,7 +406,12 @@ type DeleteParams struct {
 func (s *RestAPIService) Delete(params DeleteParams) (*DeleteResult, error) {
 	log := params.Logger
 
-	cfg, err := s.db.GetConfigByKindAndHandle(models.KindRestApi, params.Handle)
+	kind := params.Kind
+	if kind == "" {
+		kind = models.KindRestApi
+	}
+
+	cfg, err := s.db.GetConfigByKindAndHandle(kind, params.Handle)
 	if err != nil {
 		return nil, ErrNotFound
 	}
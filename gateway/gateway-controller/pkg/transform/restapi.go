@@ -29,6 +29,7 @@ import (
 	versionutil "github.com/wso2/api-platform/common/version"
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/utils"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/xds"
@@ -161,6 +162,24 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 		for _, vhost := range vhosts {
 			routeKey := xds.GenerateRouteName(string(op.Method), apiData.Context, apiData.Version, op.Path, vhost)
 
+			routeUpstream := &apiData.Upstream.Main
+			if vhost == effectiveSandboxVHost && hasSandbox {
+				routeUpstream = apiData.Upstream.Sandbox
+			}
+			mirror, err := addRouteMirror(rdc, op.Mirror, routeUpstream, apiData.UpstreamDefinitions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve mirror for %s %s: %w", op.Method, op.Path, err)
+			}
+
+			// Only production traffic is split
+			var trafficSplit *models.RouteTrafficSplit
+			if vhost == effectiveMainVHost {
+				trafficSplit, err = buildRouteTrafficSplit(rdc, op.TrafficSplit, apiData.UpstreamDefinitions)
+				if err != nil {
+					return nil, fmt.Errorf("failed to resolve traffic split for %s %s: %w", op.Method, op.Path, err)
+				}
+			}
+
 			// Build route
 			rdc.Routes[routeKey] = &models.Route{
 				Method:          string(op.Method),
@@ -173,6 +192,8 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 					UseClusterHeader: useClusterHeader,
 					DefaultCluster:   defaultCluster,
 				},
+				Mirror:       mirror,
+				TrafficSplit: trafficSplit,
 			}
 
 			// Build policy chain: API-level + operation-level + system policies
@@ -188,13 +209,16 @@ func (t *RestAPITransformer) Transform(cfg *models.StoredConfig) (*models.Runtim
 			if len(def.Upstreams) == 0 || def.Upstreams[0].Url == "" {
 				continue
 			}
-			defClusterKey := "upstream_" + cfg.Kind + "_" + cfg.UUID + "_" + SanitizeUpstreamDefinitionName(def.Name)
+			defClusterKey := upstreamDefinitionClusterKey(rdc, def.Name)
 			parsedURL, err := url.Parse(def.Upstreams[0].Url)
 			if err != nil {
 				return nil, fmt.Errorf("invalid URL in upstream definition '%s': %w", def.Name, err)
 			}
 			port := ResolvePort(parsedURL)
 			basePath := parsedURL.Path
+			if def.BasePath != nil && *def.BasePath != "" {
+				basePath = *def.BasePath
+			}
 			if basePath == "" {
 				basePath = "/"
 			}
@@ -331,7 +355,8 @@ func (t *RestAPITransformer) addUpstreamCluster(
 			Host: parsedURL.Hostname(),
 			Port: port,
 		}},
-		TLS: &models.UpstreamTLS{Enabled: parsedURL.Scheme == "https"},
+		TLS:   &models.UpstreamTLS{Enabled: parsedURL.Scheme == "https"},
+		HTTP2: up.Protocol != nil && *up.Protocol == api.Http2,
 	}
 
 	return &upstreamClusterResult{
@@ -341,6 +366,132 @@ func (t *RestAPITransformer) addUpstreamCluster(
 	}, nil
 }
 
+// addRouteMirror resolves the traffic mirror that applies to a route and adds its dedicated
+// cluster to the RuntimeDeployConfig. The operation's own mirror takes precedence over the
+// mirror of the upstream definition the route's upstream refers to. Returns nil when the
+// route is not mirrored.
+func addRouteMirror(
+	rdc *models.RuntimeDeployConfig,
+	opMirror *api.TrafficMirror,
+	up *api.Upstream,
+	upstreamDefinitions *[]api.UpstreamDefinition,
+) (*models.RouteMirror, error) {
+	mirror := opMirror
+	if mirror == nil && up != nil && up.Ref != nil && upstreamDefinitions != nil {
+		for _, def := range *upstreamDefinitions {
+			if def.Name == strings.TrimSpace(*up.Ref) {
+				mirror = def.Mirror
+				break
+			}
+		}
+	}
+	if mirror == nil {
+		return nil, nil
+	}
+
+	rawURL, err := resolveUpstreamURL("mirror", &api.Upstream{Url: mirror.Url, Ref: mirror.Ref}, upstreamDefinitions)
+	if err != nil {
+		return nil, err
+	}
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mirror upstream URL: %w", err)
+	}
+	if parsedURL.Host == "" || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return nil, fmt.Errorf("invalid mirror upstream URL: must include host and http/https scheme")
+	}
+
+	// Matches the mirror cluster name used by pkg/xds/mirror.go
+	clusterKey := constants.MirrorClusterPrefix + rdc.Metadata.Kind + "_" + rdc.Metadata.UUID + "_" +
+		strings.TrimPrefix(sanitizeEnvoyClusterName(parsedURL.Host, parsedURL.Scheme), "cluster_")
+	rdc.UpstreamClusters[clusterKey] = &models.UpstreamCluster{
+		BasePath: "/",
+		Endpoints: []models.Endpoint{{
+			Host: parsedURL.Hostname(),
+			Port: ResolvePort(parsedURL),
+		}},
+		TLS: &models.UpstreamTLS{Enabled: parsedURL.Scheme == "https"},
+		// Mirrored requests are copies of the primary request, so they use the same protocol.
+		HTTP2: up != nil && up.Protocol != nil && *up.Protocol == api.Http2,
+	}
+
+	routeMirror := &models.RouteMirror{
+		ClusterKey: clusterKey,
+		Percentage: mirror.Percentage,
+	}
+	if mirror.Headers != nil {
+		for _, h := range *mirror.Headers {
+			routeMirror.Headers = append(routeMirror.Headers, models.MirrorHeader{Name: h.Name, Value: h.Value})
+		}
+	}
+	return routeMirror, nil
+}
+
+// buildRouteTrafficSplit converts an operation's traffic split to reference the upstream
+// definition clusters that Transform adds. Returns nil when the operation has no split.
+func buildRouteTrafficSplit(
+	rdc *models.RuntimeDeployConfig,
+	split *api.TrafficSplit,
+	upstreamDefinitions *[]api.UpstreamDefinition,
+) (*models.RouteTrafficSplit, error) {
+	if split == nil {
+		return nil, nil
+	}
+
+	clusterKey := func(name string) (string, error) {
+		name = strings.TrimSpace(name)
+		if upstreamDefinitions != nil {
+			for _, def := range *upstreamDefinitions {
+				if def.Name == name {
+					return upstreamDefinitionClusterKey(rdc, name), nil
+				}
+			}
+		}
+		return "", fmt.Errorf("upstream definition '%s' not found", name)
+	}
+
+	routeSplit := &models.RouteTrafficSplit{}
+	if split.Rules != nil {
+		for _, rule := range *split.Rules {
+			key, err := clusterKey(rule.Upstream)
+			if err != nil {
+				return nil, err
+			}
+			splitRule := models.TrafficSplitRule{ClusterKey: key}
+			if rule.Headers != nil {
+				for _, h := range *rule.Headers {
+					splitRule.Headers = append(splitRule.Headers, models.TrafficSplitMatch{Name: h.Name, Value: h.Value})
+				}
+			}
+			if rule.Cookies != nil {
+				for _, c := range *rule.Cookies {
+					splitRule.Cookies = append(splitRule.Cookies, models.TrafficSplitMatch{Name: c.Name, Value: c.Value})
+				}
+			}
+			routeSplit.Rules = append(routeSplit.Rules, splitRule)
+		}
+	}
+	if split.Weights != nil {
+		for _, w := range *split.Weights {
+			if w.Weight <= 0 {
+				continue
+			}
+			key, err := clusterKey(w.Upstream)
+			if err != nil {
+				return nil, err
+			}
+			routeSplit.Weights = append(routeSplit.Weights, models.WeightedCluster{ClusterKey: key, Weight: uint32(w.Weight)})
+		}
+	}
+	return routeSplit, nil
+}
+
+// upstreamDefinitionClusterKey returns the cluster key of an upstream definition, matching
+// the upstream_<kind>_<apiId>_<name> cluster name used by pkg/xds/translator.go.
+func upstreamDefinitionClusterKey(rdc *models.RuntimeDeployConfig, name string) string {
+	return constants.UpstreamDefinitionClusterPrefix + rdc.Metadata.Kind + "_" + rdc.Metadata.UUID + "_" + SanitizeUpstreamDefinitionName(name)
+}
+
 // sanitizeEnvoyClusterName computes the Envoy cluster name from a URL host and scheme,
 // matching the sanitizeClusterName logic in pkg/xds/translator.go.
 func sanitizeEnvoyClusterName(host, scheme string) string {
@@ -405,13 +556,20 @@ func convertAPIPolicyToSDK(p api.Policy, attachedTo policyv1alpha.Level, resolve
 		paramsMap["attachedTo"] = string(attachedTo)
 	}
 
-	return policyenginev1.PolicyInstance{
+	instance := policyenginev1.PolicyInstance{
 		Name:               p.Name,
 		Version:            resolvedVersion,
 		Enabled:            true,
 		ExecutionCondition: p.ExecutionCondition,
 		Parameters:         paramsMap,
 	}
+	if p.Timeout != nil {
+		instance.Timeout = *p.Timeout
+	}
+	if p.OnError != nil {
+		instance.OnError = string(*p.OnError)
+	}
+	return instance
 }
 
 // sdkChainToModel converts a slice of SDK PolicyInstance to a models.PolicyChain.
@@ -425,6 +583,8 @@ func sdkChainToModel(instances []policyenginev1.PolicyInstance) *models.PolicyCh
 			Version:            inst.Version,
 			Params:             inst.Parameters,
 			ExecutionCondition: inst.ExecutionCondition,
+			Timeout:            inst.Timeout,
+			OnError:            inst.OnError,
 		})
 	}
 	return chain
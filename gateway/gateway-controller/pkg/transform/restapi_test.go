@@ -224,6 +224,100 @@ func TestRestAPITransformer_EmptyVersionUsesResolvedVersionInChain(t *testing.T)
 
 // TestSanitizeUpstreamDefinitionName verifies that dots and colons are replaced
 // for Envoy cluster name compatibility.
+// TestRestAPITransformer_OperationMirror verifies that an operation mirror is carried on
+// the route and its dedicated cluster is added to the upstream clusters.
+func TestRestAPITransformer_OperationMirror(t *testing.T) {
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, nil)
+	cfg := makeRestAPIStoredConfig(nil, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	pct := float32(5)
+	restAPI.Spec.Operations[0].Mirror = &api.TrafficMirror{
+		Url:        ptrStr("https://backend-v2:8443"),
+		Percentage: &pct,
+		Headers:    &[]api.TrafficMirrorHeader{{Name: "x-canary"}},
+	}
+	cfg.Configuration = restAPI
+
+	rdc, err := transformer.Transform(cfg)
+	require.NoError(t, err)
+
+	r := rdc.Routes["GET|/test/hello|main.local"]
+	require.NotNil(t, r)
+	require.NotNil(t, r.Mirror)
+	assert.Equal(t, "mirror_RestApi_test-api_https_backend-v2_8443", r.Mirror.ClusterKey)
+	assert.Equal(t, &pct, r.Mirror.Percentage)
+	assert.Equal(t, []models.MirrorHeader{{Name: "x-canary"}}, r.Mirror.Headers)
+
+	mirrorCluster, ok := rdc.UpstreamClusters[r.Mirror.ClusterKey]
+	require.True(t, ok)
+	assert.Equal(t, []models.Endpoint{{Host: "backend-v2", Port: 8443}}, mirrorCluster.Endpoints)
+	assert.True(t, mirrorCluster.TLS.Enabled)
+}
+
+// TestRestAPITransformer_UpstreamProtocolHTTP2 verifies that protocol http2 marks the
+// main upstream cluster, and the mirror cluster of its routes, for HTTP/2 as gRPC
+// backends require.
+func TestRestAPITransformer_UpstreamProtocolHTTP2(t *testing.T) {
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, nil)
+	cfg := makeRestAPIStoredConfig(nil, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	protocol := api.Http2
+	restAPI.Spec.Upstream.Main.Protocol = &protocol
+	restAPI.Spec.Operations[0].Mirror = &api.TrafficMirror{Url: ptrStr("http://shadow:50051")}
+	cfg.Configuration = restAPI
+
+	rdc, err := transformer.Transform(cfg)
+	require.NoError(t, err)
+
+	r := rdc.Routes["GET|/test/hello|main.local"]
+	require.NotNil(t, r)
+	mainCluster, ok := rdc.UpstreamClusters[r.Upstream.ClusterKey]
+	require.True(t, ok)
+	assert.True(t, mainCluster.HTTP2)
+	require.NotNil(t, r.Mirror)
+	mirrorCluster, ok := rdc.UpstreamClusters[r.Mirror.ClusterKey]
+	require.True(t, ok)
+	assert.True(t, mirrorCluster.HTTP2)
+}
+
+func TestRestAPITransformer_OperationTrafficSplit(t *testing.T) {
+	transformer := NewRestAPITransformer(testRouterCfg(), &config.Config{}, nil)
+	cfg := makeRestAPIStoredConfig(nil, nil)
+	restAPI := cfg.Configuration.(api.RestAPI)
+	restAPI.Spec.UpstreamDefinitions = &[]api.UpstreamDefinition{{
+		Name:     "canary",
+		BasePath: ptrStr("/v2"),
+		Upstreams: []struct {
+			Url    string `json:"url" yaml:"url"`
+			Weight *int   `json:"weight,omitempty" yaml:"weight,omitempty"`
+		}{{Url: "http://backend-v2:8080"}},
+	}}
+	restAPI.Spec.Operations[0].TrafficSplit = &api.TrafficSplit{
+		Rules: &[]api.TrafficSplitRule{{
+			Upstream: "canary",
+			Cookies:  &[]api.TrafficSplitMatch{{Name: "beta", Value: ptrStr("1")}},
+		}},
+		Weights: &[]api.TrafficSplitWeight{{Upstream: "canary", Weight: 5}},
+	}
+	cfg.Configuration = restAPI
+
+	rdc, err := transformer.Transform(cfg)
+	require.NoError(t, err)
+
+	r := rdc.Routes["GET|/test/hello|main.local"]
+	require.NotNil(t, r)
+	require.NotNil(t, r.TrafficSplit)
+	assert.Equal(t, []models.TrafficSplitRule{{
+		ClusterKey: "upstream_RestApi_test-api_canary",
+		Cookies:    []models.TrafficSplitMatch{{Name: "beta", Value: ptrStr("1")}},
+	}}, r.TrafficSplit.Rules)
+	assert.Equal(t, []models.WeightedCluster{{ClusterKey: "upstream_RestApi_test-api_canary", Weight: 5}}, r.TrafficSplit.Weights)
+
+	canary, ok := rdc.UpstreamClusters["upstream_RestApi_test-api_canary"]
+	require.True(t, ok)
+	assert.Equal(t, "/v2", canary.BasePath)
+}
+
 func TestSanitizeUpstreamDefinitionName(t *testing.T) {
 	tests := []struct {
 		input    string
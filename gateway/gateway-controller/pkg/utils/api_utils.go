@@ -163,6 +163,38 @@ func (s *APIUtilsService) FetchAPIDefinition(apiID string) ([]byte, error) {
 	return bodyBytes, nil
 }
 
+// FetchOpenAPIDefinition downloads the OpenAPI definition the control plane stores for an API, in
+// the YAML or JSON it was imported in. It returns nil when the API has no stored definition.
+func (s *APIUtilsService) FetchOpenAPIDefinition(apiID string) ([]byte, error) {
+	definitionURL := s.getBaseURL() + "/apis/" + apiID + "/definition"
+
+	req, err := http.NewRequest("GET", definitionURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Add("api-key", s.config.Token)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch OpenAPI definition: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("OpenAPI definition request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return bodyBytes, nil
+}
+
 // FetchLLMProviderDefinition downloads the LLM provider definition as a zip file from the control plane
 func (s *APIUtilsService) FetchLLMProviderDefinition(providerID string) ([]byte, error) {
 	// Construct the LLM provider URL by appending the resource path
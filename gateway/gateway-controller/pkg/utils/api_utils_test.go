@@ -140,6 +140,56 @@ func TestAPIUtilsService_FetchAPIDefinition(t *testing.T) {
 	})
 }
 
+func TestAPIUtilsService_FetchOpenAPIDefinition(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	t.Run("Successful fetch", func(t *testing.T) {
+		expectedData := []byte("openapi: 3.0.3")
+		server := newHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/apis/test-api-123/definition", r.URL.Path)
+			assert.Equal(t, "test-token", r.Header.Get("api-key"))
+			w.WriteHeader(http.StatusOK)
+			w.Write(expectedData)
+		}))
+		defer server.Close()
+
+		svc := NewAPIUtilsService(PlatformAPIConfig{BaseURL: server.URL, Token: "test-token"}, logger)
+
+		result, err := svc.FetchOpenAPIDefinition("test-api-123")
+		assert.NoError(t, err)
+		assert.Equal(t, expectedData, result)
+	})
+
+	t.Run("API without a stored definition", func(t *testing.T) {
+		server := newHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("API has no stored definition"))
+		}))
+		defer server.Close()
+
+		svc := NewAPIUtilsService(PlatformAPIConfig{BaseURL: server.URL, Token: "test-token"}, logger)
+
+		result, err := svc.FetchOpenAPIDefinition("test-api-123")
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Server returns error", func(t *testing.T) {
+		server := newHTTPTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("API is not associated with this gateway"))
+		}))
+		defer server.Close()
+
+		svc := NewAPIUtilsService(PlatformAPIConfig{BaseURL: server.URL, Token: "test-token"}, logger)
+
+		result, err := svc.FetchOpenAPIDefinition("test-api-123")
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "403")
+	})
+}
+
 func TestAPIUtilsService_ExtractYAMLFromZip(t *testing.T) {
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 	cfg := PlatformAPIConfig{BaseURL: "http://localhost"}
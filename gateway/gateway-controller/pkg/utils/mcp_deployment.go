@@ -67,6 +67,7 @@ type MCPDeploymentService struct {
 	eventHub        eventhub.EventHub
 	gatewayID       string
 	secretResolver  funcs.SecretResolver
+	aggregator      *config.MCPAggregatorConfig
 }
 
 // NewMCPDeploymentService creates a new MCP deployment service
@@ -99,16 +100,24 @@ func NewMCPDeploymentService(
 	}
 }
 
+// WithMCPAggregator sets the MCP aggregator that VirtualMcp proxies are routed to
+func (s *MCPDeploymentService) WithMCPAggregator(aggregator *config.MCPAggregatorConfig) *MCPDeploymentService {
+	s.aggregator = aggregator
+	s.transformer = NewMCPTransformer().WithAggregator(aggregator)
+	return s
+}
+
 // HydrateStoredMCPConfig rebuilds the derived RestAPI form for a stored MCP
-// configuration from its canonical source document.
-func HydrateStoredMCPConfig(cfg *models.StoredConfig) error {
+// configuration from its canonical source document. The aggregator is only
+// needed for VirtualMcp proxies.
+func HydrateStoredMCPConfig(cfg *models.StoredConfig, aggregator *config.MCPAggregatorConfig) error {
 	if cfg == nil {
 		return nil
 	}
 
 	if source, ok := cfg.SourceConfiguration.(api.MCPProxyConfiguration); ok {
 		var restAPI api.RestAPI
-		if _, err := NewMCPTransformer().Transform(&source, &restAPI); err != nil {
+		if _, err := NewMCPTransformer().WithAggregator(aggregator).Transform(&source, &restAPI); err != nil {
 			return fmt.Errorf("failed to transform stored MCP proxy %s: %w", cfg.UUID, err)
 		}
 		cfg.Configuration = restAPI
@@ -152,7 +161,7 @@ func isMCPNotFoundError(err error) bool {
 }
 
 func (s *MCPDeploymentService) hydrateStoredMCPConfig(cfg *models.StoredConfig) {
-	if err := HydrateStoredMCPConfig(cfg); err != nil {
+	if err := HydrateStoredMCPConfig(cfg, s.aggregator); err != nil {
 		configID := ""
 		if cfg != nil {
 			configID = cfg.UUID
@@ -23,16 +23,35 @@ import (
 	"strings"
 
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
 )
 
 type MCPTransformer struct {
+	// aggregator locates the controller's MCP aggregator, which VirtualMcp proxies are routed to
+	aggregator *config.MCPAggregatorConfig
 }
 
 func NewMCPTransformer() *MCPTransformer {
 	return &MCPTransformer{}
 }
 
+// WithAggregator sets the MCP aggregator that VirtualMcp proxies are routed to
+func (t *MCPTransformer) WithAggregator(aggregator *config.MCPAggregatorConfig) *MCPTransformer {
+	t.aggregator = aggregator
+	return t
+}
+
+// VirtualMCPUpstreamURL returns the aggregator URL the router forwards a VirtualMcp proxy to.
+// The aggregator serves the MCP endpoint of each virtual proxy below its handle.
+func VirtualMCPUpstreamURL(aggregator *config.MCPAggregatorConfig, handle string) (string, error) {
+	if aggregator == nil || !aggregator.Enabled {
+		return "", fmt.Errorf("the MCP aggregator must be enabled to deploy VirtualMcp proxies")
+	}
+	return fmt.Sprintf("http://%s:%d%s/%s", aggregator.Host, aggregator.Port,
+		constants.VIRTUAL_MCP_PATH_PREFIX, handle), nil
+}
+
 // protocolVersionComparator compares two MCP protocol version strings in YYYY-MM-DD format
 // Returns true if current is equal to or newer than base
 func protocolVersionComparator(base, current string) bool {
@@ -114,8 +133,26 @@ func (t *MCPTransformer) Transform(input any, output *api.RestAPI) (*api.RestAPI
 		apiData.Context = *mcpConfig.Spec.Context
 	}
 
-	apiData.Upstream.Main = api.Upstream{
-		Url: mcpConfig.Spec.Upstream.Url,
+	// A VirtualMcp proxy is served by the aggregator, which calls each upstream MCP server
+	// with its own credentials. The router adds its token on the aggregator's cluster, so it
+	// never appears in the policies.
+	var upstream *api.MCPProxyConfigData_Upstream
+	if mcpConfig.Kind == api.MCPProxyConfigurationKindVirtualMcp {
+		aggregatorURL, err := VirtualMCPUpstreamURL(t.aggregator, mcpConfig.Metadata.Name)
+		if err != nil {
+			return nil, err
+		}
+		apiData.Upstream.Main = api.Upstream{
+			Url: &aggregatorURL,
+		}
+	} else {
+		if mcpConfig.Spec.Upstream.Url == nil {
+			return nil, fmt.Errorf("upstream is required for MCP proxy %s", mcpConfig.Metadata.Name)
+		}
+		upstream = &mcpConfig.Spec.Upstream
+		apiData.Upstream.Main = api.Upstream{
+			Url: upstream.Url,
+		}
 	}
 
 	// Process policies
@@ -141,8 +178,7 @@ func (t *MCPTransformer) Transform(input any, output *api.RestAPI) (*api.RestAPI
 	apiData.Operations = addMCPSpecificOperations(mcpConfig, optionsRequired)
 
 	// Set upstream auth if present
-	upstream := mcpConfig.Spec.Upstream
-	if upstream.Auth != nil {
+	if upstream != nil && upstream.Auth != nil {
 		params, err := GetParamsOfPolicy(constants.SET_HEADERS_POLICY_PARAMS, *upstream.Auth.Header, *upstream.Auth.Value)
 		if err != nil {
 			return nil, fmt.Errorf("failed to build upstream auth params: %w", err)
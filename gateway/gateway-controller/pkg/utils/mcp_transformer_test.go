@@ -19,9 +19,12 @@
 package utils
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
 	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
 )
 
@@ -226,6 +229,67 @@ func TestMCPTransformer_Transform_WithPoliciesAndUpstreamAuth(t *testing.T) {
 	}
 }
 
+func TestMCPTransformer_Transform_VirtualMcp(t *testing.T) {
+	context := "/agent-tools"
+	authHeader := "Authorization"
+	authValue := "Bearer gh-token"
+	policies := []api.Policy{{Name: "mcp-acl-list", Version: "v1"}}
+	upstreams := []api.MCPVirtualUpstream{
+		{
+			Name: "github",
+			Url:  stringPtr("http://github-mcp:3000"),
+			Auth: &api.MCPUpstreamAuth{Type: api.MCPUpstreamAuthTypeApiKey, Header: &authHeader, Value: &authValue},
+		},
+		{Name: "jira", Url: stringPtr("http://jira-mcp:3000")},
+	}
+
+	latest := LATEST_SUPPORTED_MCP_SPEC_VERSION
+	in := &api.MCPProxyConfiguration{
+		Kind:     api.MCPProxyConfigurationKindVirtualMcp,
+		Metadata: api.Metadata{Name: "agent-tools"},
+		Spec: api.MCPProxyConfigData{
+			DisplayName: "Agent Tools",
+			Version:     "v1.0",
+			Context:     &context,
+			Upstreams:   &upstreams,
+			SpecVersion: &latest,
+			Policies:    &policies,
+		},
+	}
+
+	aggregator := &config.MCPAggregatorConfig{Enabled: true, Host: "gateway-controller", Port: 9095, RouterToken: "router-secret"}
+	var out api.RestAPI
+	res, err := NewMCPTransformer().WithAggregator(aggregator).Transform(in, &out)
+	if err != nil {
+		t.Fatalf("Transform returned an error: %v", err)
+	}
+
+	apiData := res.Spec
+	if apiData.Upstream.Main.Url == nil || *apiData.Upstream.Main.Url != "http://gateway-controller:9095/virtual-mcp/agent-tools" {
+		t.Fatalf("Expected upstream to be the aggregator, got %v", apiData.Upstream.Main.Url)
+	}
+	// The aggregator authenticates to each upstream itself, and the router token is added by the
+	// aggregator's cluster, so only the proxy policies remain and none of them carries the token
+	if apiData.Policies == nil || len(*apiData.Policies) != 1 || (*apiData.Policies)[0].Name != "mcp-acl-list" {
+		t.Fatalf("Expected only the proxy policies, got %+v", apiData.Policies)
+	}
+	if policies := fmt.Sprint(*apiData.Policies); strings.Contains(policies, "router-secret") {
+		t.Errorf("Expected the router token to stay out of the policies, got %s", policies)
+	}
+	if len(apiData.Operations) != 4 {
+		t.Errorf("Expected 4 MCP operations, got %d", len(apiData.Operations))
+	}
+
+	// Without an enabled aggregator a VirtualMcp proxy cannot be routed
+	if _, err := NewMCPTransformer().Transform(in, &api.RestAPI{}); err == nil {
+		t.Error("Expected error without an aggregator")
+	}
+	aggregator.Enabled = false
+	if _, err := NewMCPTransformer().WithAggregator(aggregator).Transform(in, &api.RestAPI{}); err == nil {
+		t.Error("Expected error with the aggregator disabled")
+	}
+}
+
 func TestNewMCPTransformer(t *testing.T) {
 	tr := NewMCPTransformer()
 	if tr == nil {
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"fmt"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	credentialinjector "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/credential_injector/v3"
+	upstreamcodec "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/upstream_codec/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	generic "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/generic/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	upstreamhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// isVirtualMCP reports whether a stored config is a VirtualMcp proxy, which the router
+// forwards to the MCP aggregator
+func isVirtualMCP(cfg *models.StoredConfig) bool {
+	source, ok := cfg.SourceConfiguration.(api.MCPProxyConfiguration)
+	return ok && source.Kind == api.MCPProxyConfigurationKindVirtualMcp
+}
+
+// mcpAggregatorTokenSecret returns the SDS secret holding the router token. The token is only
+// delivered as a secret, which Envoy redacts from config dumps, and never as part of the
+// routes, clusters or policies.
+func (t *Translator) mcpAggregatorTokenSecret() *tlsv3.Secret {
+	return &tlsv3.Secret{
+		Name: SecretNameMCPAggregatorToken,
+		Type: &tlsv3.Secret_GenericSecret{
+			GenericSecret: &tlsv3.GenericSecret{
+				Secret: &core.DataSource{
+					Specifier: &core.DataSource_InlineString{
+						InlineString: t.config.Controller.MCP.Aggregator.RouterToken,
+					},
+				},
+			},
+		},
+	}
+}
+
+// injectMCPAggregatorToken adds an upstream credential injector to the MCP aggregator cluster,
+// which sets the router token header from the SDS secret on every request sent to it
+func injectMCPAggregatorToken(c *cluster.Cluster) error {
+	credential, err := anypb.New(&generic.Generic{
+		Credential: &tlsv3.SdsSecretConfig{
+			Name: SecretNameMCPAggregatorToken,
+			SdsConfig: &core.ConfigSource{
+				ResourceApiVersion: core.ApiVersion_V3,
+				ConfigSourceSpecifier: &core.ConfigSource_Ads{
+					Ads: &core.AggregatedConfigSource{},
+				},
+			},
+		},
+		Header: constants.MCP_AGGREGATOR_TOKEN_HEADER,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal MCP aggregator token credential: %w", err)
+	}
+	injector, err := anypb.New(&credentialinjector.CredentialInjector{
+		// A token sent by the client is replaced, never passed on
+		Overwrite: true,
+		Credential: &core.TypedExtensionConfig{
+			Name:        "envoy.http.injected_credentials.generic",
+			TypedConfig: credential,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal MCP aggregator credential injector: %w", err)
+	}
+	codec, err := anypb.New(&upstreamcodec.UpstreamCodec{})
+	if err != nil {
+		return fmt.Errorf("failed to marshal upstream codec: %w", err)
+	}
+
+	options := &upstreamhttp.HttpProtocolOptions{
+		UpstreamProtocolOptions: &upstreamhttp.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &upstreamhttp.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &upstreamhttp.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{
+					HttpProtocolOptions: &core.Http1ProtocolOptions{},
+				},
+			},
+		},
+		HttpFilters: []*hcm.HttpFilter{
+			{
+				Name:       "envoy.filters.http.credential_injector",
+				ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: injector},
+			},
+			{
+				Name:       "envoy.filters.http.upstream_codec",
+				ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: codec},
+			},
+		},
+	}
+	optionsAny, err := anypb.New(options)
+	if err != nil {
+		return fmt.Errorf("failed to marshal MCP aggregator protocol options: %w", err)
+	}
+	c.TypedExtensionProtocolOptions = map[string]*anypb.Any{
+		"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": optionsAny,
+	}
+	return nil
+}
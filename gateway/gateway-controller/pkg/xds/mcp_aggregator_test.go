@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"strings"
+	"testing"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	credentialinjector "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/credential_injector/v3"
+	generic "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/generic/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	upstreamhttp "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+const aggregatorURL = "http://gateway-controller:9095/virtual-mcp/agent-tools"
+
+func aggregatorTestConfig() *config.Config {
+	cfg := testConfig()
+	cfg.Controller.MCP.Aggregator = config.MCPAggregatorConfig{
+		Enabled:     true,
+		Host:        "gateway-controller",
+		Port:        9095,
+		RouterToken: "router-secret",
+	}
+	return cfg
+}
+
+func aggregatorRestAPI(name, context string) api.RestAPI {
+	return api.RestAPI{
+		Kind:     api.RestAPIKindRestApi,
+		Metadata: api.Metadata{Name: name},
+		Spec: api.APIConfigData{
+			DisplayName: name,
+			Context:     context,
+			Version:     "v1.0",
+			Upstream: struct {
+				Main    api.Upstream  `json:"main" yaml:"main"`
+				Sandbox *api.Upstream `json:"sandbox,omitempty" yaml:"sandbox,omitempty"`
+			}{Main: api.Upstream{Url: strPtr(aggregatorURL)}},
+			Operations: []api.Operation{{Method: "POST", Path: "/mcp"}},
+		},
+	}
+}
+
+func TestTranslator_VirtualMcpCarriesRouterTokenOnlyAsSecret(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, aggregatorTestConfig())
+
+	virtualMCP := &models.StoredConfig{
+		UUID:          "virtual-mcp-1",
+		Kind:          string(models.KindMcp),
+		Configuration: aggregatorRestAPI("agent-tools", "/agent-tools"),
+		SourceConfiguration: api.MCPProxyConfiguration{
+			Kind:     api.MCPProxyConfigurationKindVirtualMcp,
+			Metadata: api.Metadata{Name: "agent-tools"},
+		},
+	}
+	// A RestApi pointing at the aggregator itself must not get the token
+	restAPI := &models.StoredConfig{
+		UUID:          "rest-api-1",
+		Kind:          string(api.RestAPIKindRestApi),
+		Configuration: aggregatorRestAPI("bypass", "/bypass"),
+	}
+
+	resources, err := translator.TranslateConfigs([]*models.StoredConfig{virtualMCP, restAPI}, "test-correlation")
+	require.NoError(t, err)
+
+	clusters := map[string]*cluster.Cluster{}
+	for _, r := range resources[resource.ClusterType] {
+		c := r.(*cluster.Cluster)
+		clusters[c.Name] = c
+	}
+	aggregatorCluster := clusters[constants.MCP_AGGREGATOR_CLUSTER_NAME]
+	require.NotNil(t, aggregatorCluster, "expected a dedicated MCP aggregator cluster")
+
+	var options upstreamhttp.HttpProtocolOptions
+	require.NoError(t, aggregatorCluster.TypedExtensionProtocolOptions["envoy.extensions.upstreams.http.v3.HttpProtocolOptions"].UnmarshalTo(&options))
+	require.Len(t, options.HttpFilters, 2)
+	assert.Equal(t, "envoy.filters.http.upstream_codec", options.HttpFilters[1].Name)
+	var injector credentialinjector.CredentialInjector
+	require.NoError(t, options.HttpFilters[0].GetTypedConfig().UnmarshalTo(&injector))
+	assert.True(t, injector.Overwrite)
+	var credential generic.Generic
+	require.NoError(t, injector.Credential.TypedConfig.UnmarshalTo(&credential))
+	assert.Equal(t, constants.MCP_AGGREGATOR_TOKEN_HEADER, credential.Header)
+	assert.Equal(t, SecretNameMCPAggregatorToken, credential.Credential.Name)
+
+	// Only the VirtualMcp routes use the aggregator cluster
+	var virtualRoutes, bypassRoutes int
+	for _, r := range resources[resource.RouteType] {
+		for _, vh := range r.(*route.RouteConfiguration).VirtualHosts {
+			for _, rt := range vh.Routes {
+				if strings.Contains(rt.Name, "/agent-tools/") {
+					assert.Equal(t, constants.MCP_AGGREGATOR_CLUSTER_NAME, rt.GetRoute().GetCluster())
+					virtualRoutes++
+				}
+				if strings.Contains(rt.Name, "/bypass/") {
+					assert.NotEqual(t, constants.MCP_AGGREGATOR_CLUSTER_NAME, rt.GetRoute().GetCluster())
+					assert.Empty(t, clusters[rt.GetRoute().GetCluster()].TypedExtensionProtocolOptions)
+					bypassRoutes++
+				}
+			}
+		}
+	}
+
+	assert.NotZero(t, virtualRoutes)
+	assert.NotZero(t, bypassRoutes)
+
+	// The token is delivered as a generic secret and nowhere else
+	require.Len(t, resources[resource.SecretType], 1)
+	secret := resources[resource.SecretType][0].(*tlsv3.Secret)
+	assert.Equal(t, SecretNameMCPAggregatorToken, secret.Name)
+	assert.Equal(t, "router-secret", secret.GetGenericSecret().GetSecret().GetInlineString())
+	for _, typ := range []resource.Type{resource.ClusterType, resource.RouteType, resource.ListenerType} {
+		for _, r := range resources[typ] {
+			assert.NotContains(t, prototext.Format(r.(proto.Message)), "router-secret")
+		}
+	}
+}
+
+func TestTranslator_NoRouterTokenSecretWithAggregatorDisabled(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	resources, err := translator.TranslateConfigs([]*models.StoredConfig{}, "test-correlation")
+	require.NoError(t, err)
+	assert.Empty(t, resources[resource.SecretType])
+}
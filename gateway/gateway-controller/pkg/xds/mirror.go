@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"google.golang.org/protobuf/proto"
+)
+
+// resolvedMirror is a traffic mirror resolved to its dedicated cluster.
+type resolvedMirror struct {
+	clusterName string
+	statPrefix  string
+	percentage  *float32
+	headers     []models.MirrorHeader
+}
+
+// mirrorResolver resolves the traffic mirrors of one API, creating each mirror cluster once.
+type mirrorResolver struct {
+	t           *Translator
+	apiKind     string
+	apiID       string
+	definitions *[]api.UpstreamDefinition
+	clusters    map[string]*cluster.Cluster
+}
+
+func (t *Translator) newMirrorResolver(apiKind, apiID string, definitions *[]api.UpstreamDefinition) *mirrorResolver {
+	return &mirrorResolver{
+		t:           t,
+		apiKind:     apiKind,
+		apiID:       apiID,
+		definitions: definitions,
+		clusters:    make(map[string]*cluster.Cluster),
+	}
+}
+
+// forRoute returns the mirror that applies to a route: the operation's own mirror when set,
+// otherwise the mirror of the upstream definition the route's upstream refers to.
+func (m *mirrorResolver) forRoute(opMirror *api.TrafficMirror, up *api.Upstream) (*resolvedMirror, error) {
+	if opMirror != nil {
+		return m.resolve(opMirror, upstreamUsesHTTP2(up))
+	}
+	if up == nil || up.Ref == nil || strings.TrimSpace(*up.Ref) == "" {
+		return nil, nil
+	}
+	def, err := resolveUpstreamDefinition(strings.TrimSpace(*up.Ref), m.definitions)
+	if err != nil {
+		return nil, err
+	}
+	if def.Mirror == nil {
+		return nil, nil
+	}
+	return m.resolve(def.Mirror, upstreamUsesHTTP2(up))
+}
+
+// resolve resolves a mirror's target upstream and registers its dedicated cluster. Mirrored
+// requests are copies of the primary request, so http2 follows the route's upstream.
+func (m *mirrorResolver) resolve(mirror *api.TrafficMirror, http2 bool) (*resolvedMirror, error) {
+	var rawURL string
+	var connectTimeout *time.Duration
+	if mirror.Url != nil && strings.TrimSpace(*mirror.Url) != "" {
+		rawURL = strings.TrimSpace(*mirror.Url)
+	} else if mirror.Ref != nil && strings.TrimSpace(*mirror.Ref) != "" {
+		refName := strings.TrimSpace(*mirror.Ref)
+		def, err := resolveUpstreamDefinition(refName, m.definitions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve mirror upstream ref: %w", err)
+		}
+		if len(def.Upstreams) == 0 || def.Upstreams[0].Url == "" {
+			return nil, fmt.Errorf("upstream definition '%s' has no URLs configured", refName)
+		}
+		rawURL = def.Upstreams[0].Url
+		timeout, err := resolveTimeoutFromDefinition(def)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeout in upstream definition '%s': %w", refName, err)
+		}
+		if timeout != nil {
+			connectTimeout = timeout.Connect
+		}
+	} else {
+		return nil, fmt.Errorf("mirror has no url or ref configured")
+	}
+
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mirror upstream URL: %w", err)
+	}
+	if parsedURL.Host == "" || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return nil, fmt.Errorf("invalid mirror upstream URL: must include host and http/https scheme")
+	}
+
+	// Mirror clusters are scoped by API so Envoy's cluster stats give the mirror's
+	// response codes per API, even when several APIs mirror to the same backend.
+	clusterName := constants.MirrorClusterPrefix + m.apiKind + "_" + m.apiID + "_" +
+		strings.TrimPrefix(m.t.sanitizeClusterName(parsedURL.Host, parsedURL.Scheme), "cluster_")
+	if _, exists := m.clusters[clusterName]; !exists {
+		c := m.t.createCluster(clusterName, parsedURL, nil, connectTimeout)
+		if http2 {
+			enableUpstreamHTTP2(c)
+		}
+		m.clusters[clusterName] = c
+	}
+
+	resolved := &resolvedMirror{
+		clusterName: clusterName,
+		statPrefix:  constants.MirrorRouteStatPrefix + m.apiKind + "_" + m.apiID,
+		percentage:  mirror.Percentage,
+	}
+	if mirror.Headers != nil {
+		for _, h := range *mirror.Headers {
+			resolved.headers = append(resolved.headers, models.MirrorHeader{Name: h.Name, Value: h.Value})
+		}
+	}
+	return resolved, nil
+}
+
+// mirrorFromRuntimeConfig converts a RuntimeDeployConfig route mirror, whose cluster the
+// RuntimeDeployConfig already declares, into a resolvedMirror.
+func mirrorFromRuntimeConfig(rdc *models.RuntimeDeployConfig, mirror *models.RouteMirror) *resolvedMirror {
+	if mirror == nil {
+		return nil
+	}
+	return &resolvedMirror{
+		clusterName: mirror.ClusterKey,
+		statPrefix:  constants.MirrorRouteStatPrefix + rdc.Metadata.Kind + "_" + rdc.Metadata.UUID,
+		percentage:  mirror.Percentage,
+		headers:     mirror.Headers,
+	}
+}
+
+// clusterList returns the mirror clusters created so far.
+func (m *mirrorResolver) clusterList() []*cluster.Cluster {
+	list := make([]*cluster.Cluster, 0, len(m.clusters))
+	for _, c := range m.clusters {
+		list = append(list, c)
+	}
+	return list
+}
+
+// applyMirror attaches a request mirror policy to a route. Envoy mirror policies cannot
+// filter on headers, so a mirror with a header filter is emitted as a copy of the route
+// that also matches those headers; the route sorter places it ahead of the original,
+// which keeps serving requests without the headers unmirrored.
+func applyMirror(r *route.Route, mirror *resolvedMirror) []*route.Route {
+	if mirror == nil {
+		return []*route.Route{r}
+	}
+
+	target := r
+	if len(mirror.headers) > 0 {
+		target = proto.Clone(r).(*route.Route)
+		for _, h := range mirror.headers {
+			target.Match.Headers = append(target.Match.Headers, headerMatcher(h.Name, h.Value))
+		}
+	}
+
+	policy := &route.RouteAction_RequestMirrorPolicy{
+		Cluster: mirror.clusterName,
+	}
+	if mirror.percentage != nil && *mirror.percentage < 100 {
+		policy.RuntimeFraction = &corev3.RuntimeFractionalPercent{
+			DefaultValue: &typev3.FractionalPercent{
+				Numerator:   uint32(math.Round(float64(*mirror.percentage) * 10000)),
+				Denominator: typev3.FractionalPercent_MILLION,
+			},
+		}
+	}
+	target.GetRoute().RequestMirrorPolicies = append(target.GetRoute().RequestMirrorPolicies, policy)
+	target.StatPrefix = mirror.statPrefix
+
+	if target == r {
+		return []*route.Route{r}
+	}
+	return []*route.Route{target, r}
+}
+
+// headerMatcher matches a request header by name, and by exact value when given.
+func headerMatcher(name string, value *string) *route.HeaderMatcher {
+	if value == nil {
+		return &route.HeaderMatcher{
+			Name:                 name,
+			HeaderMatchSpecifier: &route.HeaderMatcher_PresentMatch{PresentMatch: true},
+		}
+	}
+	return &route.HeaderMatcher{
+		Name: name,
+		HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+			StringMatch: &matcher.StringMatcher{
+				MatchPattern: &matcher.StringMatcher_Exact{Exact: *value},
+			},
+		},
+	}
+}
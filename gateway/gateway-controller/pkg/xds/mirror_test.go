@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+func mirrorTestConfig(ops []api.Operation, defs *[]api.UpstreamDefinition, mainRef bool) *models.StoredConfig {
+	apiData := api.APIConfigData{
+		DisplayName:         "Mirror API",
+		Context:             "/mirror",
+		Version:             "v1.0",
+		Operations:          ops,
+		UpstreamDefinitions: defs,
+	}
+	if mainRef {
+		apiData.Upstream.Main = api.Upstream{Ref: strPtr("primary")}
+	} else {
+		apiData.Upstream.Main = api.Upstream{Url: strPtr("http://backend-v1:8080/api")}
+	}
+	return &models.StoredConfig{
+		UUID: "api-1",
+		Kind: string(api.RestAPIKindRestApi),
+		Configuration: api.RestAPI{
+			Kind:     api.RestAPIKindRestApi,
+			Metadata: api.Metadata{Name: "mirror-api"},
+			Spec:     apiData,
+		},
+	}
+}
+
+func TestTranslator_Mirror_OperationMirror(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	pct := float32(25)
+	cfg := mirrorTestConfig([]api.Operation{
+		{Method: "GET", Path: "/books", Mirror: &api.TrafficMirror{Url: strPtr("http://backend-v2:9090"), Percentage: &pct}},
+		{Method: "POST", Path: "/books"},
+	}, nil, false)
+
+	routes, clusters, err := translator.translateAPIConfig(cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	var mirrorCluster string
+	for _, c := range clusters {
+		if c.Name == "mirror_RestApi_api-1_http_backend-v2_9090" {
+			mirrorCluster = c.Name
+		}
+	}
+	require.NotEmpty(t, mirrorCluster, "dedicated mirror cluster should be created")
+
+	policies := routes[0].GetRoute().GetRequestMirrorPolicies()
+	require.Len(t, policies, 1)
+	assert.Equal(t, mirrorCluster, policies[0].GetCluster())
+	assert.Equal(t, uint32(250000), policies[0].GetRuntimeFraction().GetDefaultValue().GetNumerator())
+	assert.Equal(t, typev3.FractionalPercent_MILLION, policies[0].GetRuntimeFraction().GetDefaultValue().GetDenominator())
+	assert.Equal(t, "mirror_primary_RestApi_api-1", routes[0].GetStatPrefix())
+
+	assert.Empty(t, routes[1].GetRoute().GetRequestMirrorPolicies())
+	assert.Empty(t, routes[1].GetStatPrefix())
+}
+
+func TestTranslator_Mirror_InheritedFromUpstreamDefinition(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	defs := &[]api.UpstreamDefinition{
+		{
+			Name: "primary",
+			Upstreams: []struct {
+				Url    string `json:"url" yaml:"url"`
+				Weight *int   `json:"weight,omitempty" yaml:"weight,omitempty"`
+			}{{Url: "http://backend-v1:8080"}},
+			Mirror: &api.TrafficMirror{Ref: strPtr("candidate")},
+		},
+		{
+			Name: "candidate",
+			Upstreams: []struct {
+				Url    string `json:"url" yaml:"url"`
+				Weight *int   `json:"weight,omitempty" yaml:"weight,omitempty"`
+			}{{Url: "https://backend-v2:8443"}},
+		},
+	}
+	override := &api.TrafficMirror{Url: strPtr("http://shadow:7000")}
+	cfg := mirrorTestConfig([]api.Operation{
+		{Method: "GET", Path: "/books"},
+		{Method: "PUT", Path: "/books", Mirror: override},
+	}, defs, true)
+
+	routes, _, err := translator.translateAPIConfig(cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	inherited := routes[0].GetRoute().GetRequestMirrorPolicies()
+	require.Len(t, inherited, 1)
+	assert.Equal(t, "mirror_RestApi_api-1_https_backend-v2_8443", inherited[0].GetCluster())
+	assert.Nil(t, inherited[0].GetRuntimeFraction(), "no percentage mirrors every request")
+
+	overridden := routes[1].GetRoute().GetRequestMirrorPolicies()
+	require.Len(t, overridden, 1)
+	assert.Equal(t, "mirror_RestApi_api-1_http_shadow_7000", overridden[0].GetCluster())
+}
+
+func TestTranslator_Mirror_HeaderFilter(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	cfg := mirrorTestConfig([]api.Operation{
+		{Method: "GET", Path: "/books", Mirror: &api.TrafficMirror{
+			Url: strPtr("http://backend-v2:9090"),
+			Headers: &[]api.TrafficMirrorHeader{
+				{Name: "x-canary", Value: strPtr("true")},
+				{Name: "x-tenant"},
+			},
+		}},
+	}, nil, false)
+
+	routes, _, err := translator.translateAPIConfig(cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 2, "header-filtered mirror adds a mirrored copy of the route")
+
+	mirrored, plain := routes[0], routes[1]
+	assert.Equal(t, plain.GetName(), mirrored.GetName())
+	require.Len(t, mirrored.GetRoute().GetRequestMirrorPolicies(), 1)
+	assert.Empty(t, plain.GetRoute().GetRequestMirrorPolicies())
+	assert.Len(t, mirrored.GetMatch().GetHeaders(), len(plain.GetMatch().GetHeaders())+2)
+
+	headers := mirrored.GetMatch().GetHeaders()
+	assert.Equal(t, "x-canary", headers[len(headers)-2].GetName())
+	assert.Equal(t, "true", headers[len(headers)-2].GetStringMatch().GetExact())
+	assert.Equal(t, "x-tenant", headers[len(headers)-1].GetName())
+	assert.True(t, headers[len(headers)-1].GetPresentMatch())
+
+	// The mirrored copy must be evaluated before the plain route
+	sorted := SortRoutesByPriority([]*route.Route{plain, mirrored})
+	assert.Same(t, mirrored, sorted[0])
+}
+
+func TestTranslator_Mirror_UnknownRef(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	cfg := mirrorTestConfig([]api.Operation{
+		{Method: "GET", Path: "/books", Mirror: &api.TrafficMirror{Ref: strPtr("missing")}},
+	}, nil, false)
+
+	_, _, err := translator.translateAPIConfig(cfg, nil)
+	assert.Error(t, err)
+}
@@ -32,6 +32,8 @@ import (
 const (
 	// SecretNameUpstreamCA is the name of the SDS secret for upstream CA certificates
 	SecretNameUpstreamCA = "upstream_ca_bundle"
+	// SecretNameMCPAggregatorToken is the name of the SDS secret holding the MCP aggregator router token
+	SecretNameMCPAggregatorToken = "mcp_aggregator_router_token"
 )
 
 // SDSSecretManager manages SDS secrets for TLS certificates
@@ -24,7 +24,6 @@ import (
 	"log/slog"
 	"time"
 
-	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	xdslog "github.com/envoyproxy/go-control-plane/pkg/log"
 	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
@@ -137,7 +136,7 @@ func (sm *SnapshotManager) UpdateSnapshot(ctx context.Context, correlationID str
 		if err != nil {
 			log.Warn("Failed to get SDS secret, continuing without it", slog.Any("error", err))
 		} else {
-			resources[resource.SecretType] = []types.Resource{secret}
+			resources[resource.SecretType] = append(resources[resource.SecretType], secret)
 			log.Debug("Added SDS secret to snapshot", slog.String("secret_name", SecretNameUpstreamCA))
 		}
 	}
@@ -0,0 +1,274 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// splitTarget is an upstream definition cluster that a traffic split sends requests to.
+type splitTarget struct {
+	clusterName  string
+	upstreamPath string
+}
+
+// resolvedSplitRule sends requests carrying all of its headers and cookies to a target.
+type resolvedSplitRule struct {
+	target  splitTarget
+	headers []models.TrafficSplitMatch
+	cookies []models.TrafficSplitMatch
+}
+
+// weightedSplitTarget is a target's relative share of the requests no rule matched.
+type weightedSplitTarget struct {
+	target splitTarget
+	weight uint32
+}
+
+// resolvedTrafficSplit is a route's traffic split resolved to upstream definition clusters.
+type resolvedTrafficSplit struct {
+	rules   []resolvedSplitRule
+	weights []weightedSplitTarget
+}
+
+// resolveTrafficSplit resolves an operation's traffic split against the API's upstream
+// definitions, whose clusters translateAPIConfig creates.
+func resolveTrafficSplit(apiKind, apiID string, split *api.TrafficSplit, definitions *[]api.UpstreamDefinition) (*resolvedTrafficSplit, error) {
+	if split == nil {
+		return nil, nil
+	}
+
+	resolveTarget := func(name string) (splitTarget, error) {
+		def, err := resolveUpstreamDefinition(strings.TrimSpace(name), definitions)
+		if err != nil {
+			return splitTarget{}, fmt.Errorf("failed to resolve traffic split upstream: %w", err)
+		}
+		upstreamPath, err := upstreamDefinitionPath(def)
+		if err != nil {
+			return splitTarget{}, err
+		}
+		return splitTarget{
+			clusterName:  constants.UpstreamDefinitionClusterPrefix + apiKind + "_" + apiID + "_" + sanitizeUpstreamDefinitionName(def.Name),
+			upstreamPath: upstreamPath,
+		}, nil
+	}
+
+	resolved := &resolvedTrafficSplit{}
+	if split.Rules != nil {
+		for _, rule := range *split.Rules {
+			target, err := resolveTarget(rule.Upstream)
+			if err != nil {
+				return nil, err
+			}
+			resolvedRule := resolvedSplitRule{target: target}
+			if rule.Headers != nil {
+				for _, h := range *rule.Headers {
+					resolvedRule.headers = append(resolvedRule.headers, models.TrafficSplitMatch{Name: h.Name, Value: h.Value})
+				}
+			}
+			if rule.Cookies != nil {
+				for _, c := range *rule.Cookies {
+					resolvedRule.cookies = append(resolvedRule.cookies, models.TrafficSplitMatch{Name: c.Name, Value: c.Value})
+				}
+			}
+			resolved.rules = append(resolved.rules, resolvedRule)
+		}
+	}
+	if split.Weights != nil {
+		for _, w := range *split.Weights {
+			if w.Weight <= 0 {
+				continue
+			}
+			target, err := resolveTarget(w.Upstream)
+			if err != nil {
+				return nil, err
+			}
+			resolved.weights = append(resolved.weights, weightedSplitTarget{target: target, weight: uint32(w.Weight)})
+		}
+	}
+	return resolved, nil
+}
+
+// upstreamDefinitionPath returns the path prepended to requests sent to an upstream
+// definition: its basePath, or the path of its first URL when no basePath is set.
+func upstreamDefinitionPath(def *api.UpstreamDefinition) (string, error) {
+	if def.BasePath != nil && *def.BasePath != "" {
+		return *def.BasePath, nil
+	}
+	if len(def.Upstreams) == 0 || def.Upstreams[0].Url == "" {
+		return "", fmt.Errorf("upstream definition '%s' has no URLs configured", def.Name)
+	}
+	parsedURL, err := url.Parse(def.Upstreams[0].Url)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL in upstream definition '%s': %w", def.Name, err)
+	}
+	return parsedURL.Path, nil
+}
+
+// trafficSplitFromRuntimeConfig converts a RuntimeDeployConfig route traffic split, whose
+// clusters the RuntimeDeployConfig already declares, into a resolvedTrafficSplit.
+func trafficSplitFromRuntimeConfig(rdc *models.RuntimeDeployConfig, split *models.RouteTrafficSplit) *resolvedTrafficSplit {
+	if split == nil {
+		return nil
+	}
+
+	target := func(clusterKey string) splitTarget {
+		st := splitTarget{clusterName: clusterKey}
+		if uc, ok := rdc.UpstreamClusters[clusterKey]; ok {
+			st.upstreamPath = uc.BasePath
+		}
+		return st
+	}
+
+	resolved := &resolvedTrafficSplit{}
+	for _, rule := range split.Rules {
+		resolved.rules = append(resolved.rules, resolvedSplitRule{
+			target:  target(rule.ClusterKey),
+			headers: rule.Headers,
+			cookies: rule.Cookies,
+		})
+	}
+	for _, w := range split.Weights {
+		if w.Weight == 0 {
+			continue
+		}
+		resolved.weights = append(resolved.weights, weightedSplitTarget{target: target(w.ClusterKey), weight: w.Weight})
+	}
+	return resolved
+}
+
+// applyTrafficSplit expands a route into the routes that implement its traffic split.
+// rewrite builds the route's path rewrite for a target's upstream path.
+//
+// Each rule becomes a copy of the route that also matches the rule's headers and cookies;
+// the route sorter places these ahead of the route itself. The route then serves the
+// remaining requests, spreading them across the weighted targets with Envoy weighted
+// clusters. Weighted clusters share the route's path rewrite, so when the weighted targets
+// have different upstream paths the route is sliced into one route per upstream path, each
+// taking its share through a runtime fraction. Envoy draws one random value per request
+// for all routes, so the cumulative fractions give every slice its exact share.
+func applyTrafficSplit(r *route.Route, split *resolvedTrafficSplit, rewrite func(upstreamPath string) *matcher.RegexMatchAndSubstitute) []*route.Route {
+	if split == nil {
+		return []*route.Route{r}
+	}
+
+	routes := make([]*route.Route, 0, len(split.rules)+1)
+	for _, rule := range split.rules {
+		ruleRoute := proto.Clone(r).(*route.Route)
+		for _, h := range rule.headers {
+			ruleRoute.Match.Headers = append(ruleRoute.Match.Headers, headerMatcher(h.Name, h.Value))
+		}
+		for _, c := range rule.cookies {
+			ruleRoute.Match.Headers = append(ruleRoute.Match.Headers, cookieMatcher(c))
+		}
+		setSplitTargets(ruleRoute, []weightedSplitTarget{{target: rule.target}}, rewrite)
+		routes = append(routes, ruleRoute)
+	}
+
+	if len(split.weights) == 0 {
+		return append(routes, r)
+	}
+
+	// Group the weighted targets by upstream path, keeping their order
+	var slices [][]weightedSplitTarget
+	sliceIndex := make(map[string]int)
+	var total uint64
+	for _, w := range split.weights {
+		total += uint64(w.weight)
+		idx, ok := sliceIndex[w.target.upstreamPath]
+		if !ok {
+			idx = len(slices)
+			sliceIndex[w.target.upstreamPath] = idx
+			slices = append(slices, nil)
+		}
+		slices[idx] = append(slices[idx], w)
+	}
+
+	var cumulative uint64
+	for i, targets := range slices {
+		sliceRoute := r
+		if i < len(slices)-1 {
+			sliceRoute = proto.Clone(r).(*route.Route)
+			for _, w := range targets {
+				cumulative += uint64(w.weight)
+			}
+			sliceRoute.Match.RuntimeFraction = &corev3.RuntimeFractionalPercent{
+				DefaultValue: &typev3.FractionalPercent{
+					Numerator:   uint32(cumulative * 1_000_000 / total),
+					Denominator: typev3.FractionalPercent_MILLION,
+				},
+			}
+		}
+		setSplitTargets(sliceRoute, targets, rewrite)
+		routes = append(routes, sliceRoute)
+	}
+	return routes
+}
+
+// setSplitTargets points a route at one target, or at several weighted targets that share
+// an upstream path, rewriting the path for that upstream path.
+func setSplitTargets(r *route.Route, targets []weightedSplitTarget, rewrite func(upstreamPath string) *matcher.RegexMatchAndSubstitute) {
+	action := r.GetRoute()
+	if len(targets) == 1 {
+		action.ClusterSpecifier = &route.RouteAction_Cluster{Cluster: targets[0].target.clusterName}
+	} else {
+		weighted := &route.WeightedCluster{}
+		for _, w := range targets {
+			weighted.Clusters = append(weighted.Clusters, &route.WeightedCluster_ClusterWeight{
+				Name:   w.target.clusterName,
+				Weight: wrapperspb.UInt32(w.weight),
+			})
+		}
+		action.ClusterSpecifier = &route.RouteAction_WeightedClusters{WeightedClusters: weighted}
+	}
+	action.RegexRewrite = rewrite(targets[0].target.upstreamPath)
+}
+
+// cookieMatcher matches a cookie in the Cookie header by name, and by exact value when given.
+func cookieMatcher(c models.TrafficSplitMatch) *route.HeaderMatcher {
+	valuePattern := ".*"
+	if c.Value != nil {
+		valuePattern = regexp.QuoteMeta(*c.Value) + "(?:;.*)?"
+	}
+	return &route.HeaderMatcher{
+		Name: "cookie",
+		HeaderMatchSpecifier: &route.HeaderMatcher_StringMatch{
+			StringMatch: &matcher.StringMatcher{
+				MatchPattern: &matcher.StringMatcher_SafeRegex{
+					SafeRegex: &matcher.RegexMatcher{
+						Regex: `^(?:.*;\s*)?` + regexp.QuoteMeta(c.Name) + "=" + valuePattern + "$",
+					},
+				},
+			},
+		},
+	}
+}
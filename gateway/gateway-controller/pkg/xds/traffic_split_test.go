@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package xds
+
+import (
+	"testing"
+
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	api "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	"github.com/wso2/api-platform/gateway/gateway-controller/pkg/models"
+)
+
+func splitTestDefinitions() *[]api.UpstreamDefinition {
+	definition := func(name, rawURL string, basePath *string) api.UpstreamDefinition {
+		return api.UpstreamDefinition{
+			Name:     name,
+			BasePath: basePath,
+			Upstreams: []struct {
+				Url    string `json:"url" yaml:"url"`
+				Weight *int   `json:"weight,omitempty" yaml:"weight,omitempty"`
+			}{{Url: rawURL}},
+		}
+	}
+	return &[]api.UpstreamDefinition{
+		definition("primary", "http://backend-v1:8080", nil),
+		definition("stable", "http://backend-v1:8080", strPtr("/api/v1")),
+		definition("canary", "http://backend-v2:8080", strPtr("/api/v2")),
+		definition("stable-b", "http://backend-v1b:8080", strPtr("/api/v1")),
+	}
+}
+
+func TestTranslator_TrafficSplit_Rules(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	cfg := mirrorTestConfig([]api.Operation{
+		{Method: "GET", Path: "/books", TrafficSplit: &api.TrafficSplit{
+			Rules: &[]api.TrafficSplitRule{
+				{Upstream: "canary", Headers: &[]api.TrafficSplitMatch{{Name: "x-canary", Value: strPtr("true")}}},
+				{Upstream: "canary", Cookies: &[]api.TrafficSplitMatch{{Name: "beta", Value: strPtr("1")}}},
+			},
+		}},
+	}, splitTestDefinitions(), true)
+
+	routes, _, err := translator.translateAPIConfig(cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 3)
+
+	headerRule := routes[0]
+	assert.Equal(t, "upstream_RestApi_api-1_canary", headerRule.GetRoute().GetCluster())
+	assert.Equal(t, "/api/v2\\1", headerRule.GetRoute().GetRegexRewrite().GetSubstitution())
+	require.Len(t, headerRule.GetMatch().GetHeaders(), 2)
+	assert.Equal(t, "true", headerRule.GetMatch().GetHeaders()[1].GetStringMatch().GetExact())
+
+	cookieRule := routes[1]
+	assert.Equal(t, "upstream_RestApi_api-1_canary", cookieRule.GetRoute().GetCluster())
+	cookieHeader := cookieRule.GetMatch().GetHeaders()[1]
+	assert.Equal(t, "cookie", cookieHeader.GetName())
+	assert.Regexp(t, cookieHeader.GetStringMatch().GetSafeRegex().GetRegex(), "a=b; beta=1; c=d")
+	assert.NotRegexp(t, cookieHeader.GetStringMatch().GetSafeRegex().GetRegex(), "beta=10")
+
+	// Requests matching no rule keep the default dynamic routing
+	assert.Equal(t, "x-target-upstream", routes[2].GetRoute().GetClusterHeader())
+	assert.Len(t, routes[2].GetMatch().GetHeaders(), 1)
+
+	// All routes share the operation's route name, and hence its policy chain
+	for _, r := range routes {
+		assert.Equal(t, routes[0].GetName(), r.GetName())
+	}
+}
+
+func TestTranslator_TrafficSplit_WeightedClusters(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	cfg := mirrorTestConfig([]api.Operation{
+		{Method: "GET", Path: "/books", TrafficSplit: &api.TrafficSplit{
+			Weights: &[]api.TrafficSplitWeight{
+				{Upstream: "stable", Weight: 90},
+				{Upstream: "stable-b", Weight: 10},
+			},
+		}},
+	}, splitTestDefinitions(), false)
+
+	routes, _, err := translator.translateAPIConfig(cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+
+	weighted := routes[0].GetRoute().GetWeightedClusters().GetClusters()
+	require.Len(t, weighted, 2)
+	assert.Equal(t, "upstream_RestApi_api-1_stable", weighted[0].GetName())
+	assert.Equal(t, uint32(90), weighted[0].GetWeight().GetValue())
+	assert.Equal(t, "upstream_RestApi_api-1_stable-b", weighted[1].GetName())
+	assert.Equal(t, uint32(10), weighted[1].GetWeight().GetValue())
+	assert.Equal(t, "/api/v1\\1", routes[0].GetRoute().GetRegexRewrite().GetSubstitution())
+	assert.Nil(t, routes[0].GetMatch().GetRuntimeFraction())
+}
+
+func TestTranslator_TrafficSplit_DifferentBasePaths(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	cfg := mirrorTestConfig([]api.Operation{
+		{Method: "GET", Path: "/books", TrafficSplit: &api.TrafficSplit{
+			Weights: &[]api.TrafficSplitWeight{
+				{Upstream: "canary", Weight: 5},
+				{Upstream: "stable", Weight: 60},
+				{Upstream: "stable-b", Weight: 35},
+				{Upstream: "canary", Weight: 0},
+			},
+		}},
+	}, splitTestDefinitions(), false)
+
+	routes, _, err := translator.translateAPIConfig(cfg, nil)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	// The canary slice takes the first 5% of the random range
+	canary := routes[0]
+	assert.Equal(t, "upstream_RestApi_api-1_canary", canary.GetRoute().GetCluster())
+	assert.Equal(t, "/api/v2\\1", canary.GetRoute().GetRegexRewrite().GetSubstitution())
+	assert.Equal(t, uint32(50000), canary.GetMatch().GetRuntimeFraction().GetDefaultValue().GetNumerator())
+
+	// The last slice catches the rest
+	stable := routes[1]
+	assert.Nil(t, stable.GetMatch().GetRuntimeFraction())
+	assert.Len(t, stable.GetRoute().GetWeightedClusters().GetClusters(), 2)
+	assert.Equal(t, "/api/v1\\1", stable.GetRoute().GetRegexRewrite().GetSubstitution())
+
+	sorted := SortRoutesByPriority([]*route.Route{canary, stable})
+	assert.Same(t, canary, sorted[0], "slices must keep their order after sorting")
+}
+
+func TestTranslator_TrafficSplit_UnknownUpstream(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	cfg := mirrorTestConfig([]api.Operation{
+		{Method: "GET", Path: "/books", TrafficSplit: &api.TrafficSplit{
+			Weights: &[]api.TrafficSplitWeight{{Upstream: "missing", Weight: 10}},
+		}},
+	}, splitTestDefinitions(), false)
+
+	_, _, err := translator.translateAPIConfig(cfg, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing")
+}
+
+func TestTranslator_TrafficSplit_RuntimeConfig(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	rdc := &models.RuntimeDeployConfig{
+		Metadata: models.Metadata{UUID: "api-1", Kind: "RestApi", Version: "v1.0"},
+		Context:  "/split/v1.0",
+		Routes: map[string]*models.Route{
+			"GET|/split/v1.0/books|localhost": {
+				Method:        "GET",
+				Path:          "/split/v1.0/books",
+				OperationPath: "/books",
+				Vhost:         "localhost",
+				Upstream:      models.RouteUpstream{ClusterKey: "upstream_main_backend_80"},
+				TrafficSplit: &models.RouteTrafficSplit{
+					Rules: []models.TrafficSplitRule{{
+						ClusterKey: "upstream_RestApi_api-1_canary",
+						Headers:    []models.TrafficSplitMatch{{Name: "x-canary"}},
+					}},
+				},
+			},
+		},
+		UpstreamClusters: map[string]*models.UpstreamCluster{
+			"upstream_main_backend_80":      {BasePath: "/", Endpoints: []models.Endpoint{{Host: "backend", Port: 80}}},
+			"upstream_RestApi_api-1_canary": {BasePath: "/v2", Endpoints: []models.Endpoint{{Host: "canary", Port: 80}}},
+		},
+	}
+
+	routes, _, err := translator.translateRuntimeConfig(rdc)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+	assert.Equal(t, "upstream_RestApi_api-1_canary", routes[0].GetRoute().GetCluster())
+	assert.Equal(t, "/v2\\1", routes[0].GetRoute().GetRegexRewrite().GetSubstitution())
+	assert.True(t, routes[0].GetMatch().GetHeaders()[1].GetPresentMatch())
+	assert.Equal(t, "upstream_main_backend_80", routes[1].GetRoute().GetCluster())
+	assert.Equal(t, "\\1", routes[1].GetRoute().GetRegexRewrite().GetSubstitution())
+}
@@ -202,13 +202,22 @@ func (t *Translator) translateRuntimeConfig(rdc *models.RuntimeDeployConfig) ([]
 		var connectTimeout *time.Duration
 		// Use global default; per-cluster timeout comes from the route's Timeout field
 		c := t.createCluster(clusterName, parsedURL, nil, connectTimeout)
+		if uc.HTTP2 {
+			enableUpstreamHTTP2(c)
+		}
 		clusters = append(clusters, c)
 	}
 
 	// Build routes from Routes map
 	for routeKey, rdcRoute := range rdc.Routes {
 		r := t.createRouteFromRDC(routeKey, rdcRoute, rdc)
-		routes = append(routes, r)
+		rewrite := func(upstreamPath string) *matcher.RegexMatchAndSubstitute {
+			return operationPathRewrite(rdc.Context, rdc.Metadata.Version, rdcRoute.OperationPath, upstreamPath)
+		}
+		mirror := mirrorFromRuntimeConfig(rdc, rdcRoute.Mirror)
+		for _, splitRoute := range applyTrafficSplit(r, trafficSplitFromRuntimeConfig(rdc, rdcRoute.TrafficSplit), rewrite) {
+			routes = append(routes, applyMirror(splitRoute, mirror)...)
+		}
 	}
 
 	return routes, clusters, nil
@@ -320,10 +329,6 @@ func (t *Translator) createRouteFromRDC(routeKey string, rdcRoute *models.Route,
 	if uc, ok := rdc.UpstreamClusters[rdcRoute.Upstream.ClusterKey]; ok {
 		upstreamPath = uc.BasePath
 	}
-	upstreamIsRoot := upstreamPath == "/" || upstreamPath == ""
-	if upstreamIsRoot {
-		upstreamPath = ""
-	}
 
 	// Derive context prefix from fullPath minus operationPath
 	// fullPath = contextWithVersion + operationPath
@@ -334,36 +339,7 @@ func (t *Translator) createRouteFromRDC(routeKey string, rdcRoute *models.Route,
 		contextWithVersion = strings.TrimSuffix(fullPath, operationPath) + pathWithoutWildcard
 	}
 
-	escapedContext := regexp.QuoteMeta(contextWithVersion)
-	if isRootPath {
-		// Root path ("/") matches both /ctx and /ctx/. Using a non-capturing pattern
-		// avoids an empty capture group when the trailing slash is absent, which would
-		// produce an empty rewritten path. Always normalize to upstreamPath+"/".
-		r.GetRoute().RegexRewrite = &matcher.RegexMatchAndSubstitute{
-			Pattern: &matcher.RegexMatcher{
-				Regex: "^" + escapedContext + "/?$",
-			},
-			Substitution: upstreamPath + "/",
-		}
-	} else if isWildcardPath && upstreamIsRoot {
-		// Special case: upstream path is "/" with a wildcard operation path.
-		// The trailing slash is consumed into the pattern so \\1 never captures an empty
-		// string — upstream always receives at least "/".
-		// /context → /, /context/ → /, /context/foo → /foo
-		r.GetRoute().RegexRewrite = &matcher.RegexMatchAndSubstitute{
-			Pattern: &matcher.RegexMatcher{
-				Regex: "^" + escapedContext + "/?(.*)$",
-			},
-			Substitution: "/\\1",
-		}
-	} else {
-		r.GetRoute().RegexRewrite = &matcher.RegexMatchAndSubstitute{
-			Pattern: &matcher.RegexMatcher{
-				Regex: "^" + escapedContext + "(.*)$",
-			},
-			Substitution: upstreamPath + "\\1",
-		}
-	}
+	r.GetRoute().RegexRewrite = upstreamPathRewrite(contextWithVersion, isRootPath, isWildcardPath, upstreamPath)
 
 	return r
 }
@@ -635,6 +611,11 @@ func (t *Translator) TranslateConfigs(
 		}
 	}
 
+	// The router token of the MCP aggregator is delivered as a secret, never inside a route or cluster
+	if t.config.Controller.MCP.Aggregator.Enabled {
+		resources[resource.SecretType] = append(resources[resource.SecretType], t.mcpAggregatorTokenSecret())
+	}
+
 	resources[resource.ListenerType] = listeners
 	// Add route configuration for RDS (Route Discovery Service)
 	// This allows sharing route config between HTTP and HTTPS listeners
@@ -781,7 +762,22 @@ func (t *Translator) translateAPIConfig(cfg *models.StoredConfig, allConfigs []*
 		mainUpstreamClusterConnectTimeout = mainTimeout.Connect
 	}
 
+	// A VirtualMcp proxy is routed to the MCP aggregator through a cluster of its own, which
+	// adds the router token the aggregator requires
+	virtualMCP := isVirtualMCP(cfg)
+	if virtualMCP {
+		mainClusterName = constants.MCP_AGGREGATOR_CLUSTER_NAME
+	}
+
 	mainCluster := t.createCluster(mainClusterName, parsedMainURL, nil, mainUpstreamClusterConnectTimeout)
+	if upstreamUsesHTTP2(&apiData.Upstream.Main) {
+		enableUpstreamHTTP2(mainCluster)
+	}
+	if virtualMCP {
+		if err := injectMCPAggregatorToken(mainCluster); err != nil {
+			return nil, nil, err
+		}
+	}
 	clusters = append(clusters, mainCluster)
 
 	// Create routes for each operation (default to main cluster)
@@ -821,6 +817,9 @@ func (t *Translator) translateAPIConfig(cfg *models.StoredConfig, allConfigs []*
 		}
 	}
 
+	// Traffic mirrors resolve to dedicated per-API clusters
+	mirrors := t.newMirrorResolver(cfg.Kind, cfg.UUID, apiData.UpstreamDefinitions)
+
 	for _, op := range apiData.Operations {
 		// Determine if dynamic cluster selection should be used
 		// When upstreamDefinitions exist, use cluster_header routing so policies can select the upstream
@@ -833,7 +832,20 @@ func (t *Translator) translateAPIConfig(cfg *models.StoredConfig, allConfigs []*
 
 		r := t.createRoute(cfg.UUID, apiData.DisplayName, apiData.Version, apiData.Context, string(op.Method), op.Path,
 			mainClusterName, parsedMainURL.Path, effectiveMainVHost, cfg.Kind, templateHandle, providerName, apiData.Upstream.Main.HostRewrite, apiProjectID, mainTimeout, useClusterHeader, defaultCluster, upstreamDefPaths)
-		mainRoutesList = append(mainRoutesList, r)
+		mirror, err := mirrors.forRoute(op.Mirror, &apiData.Upstream.Main)
+		if err != nil {
+			return nil, nil, err
+		}
+		split, err := resolveTrafficSplit(cfg.Kind, cfg.UUID, op.TrafficSplit, apiData.UpstreamDefinitions)
+		if err != nil {
+			return nil, nil, err
+		}
+		rewrite := func(upstreamPath string) *matcher.RegexMatchAndSubstitute {
+			return operationPathRewrite(apiData.Context, apiData.Version, op.Path, upstreamPath)
+		}
+		for _, splitRoute := range applyTrafficSplit(r, split, rewrite) {
+			mainRoutesList = append(mainRoutesList, applyMirror(splitRoute, mirror)...)
+		}
 	}
 	routesList = append(routesList, mainRoutesList...)
 
@@ -851,6 +863,9 @@ func (t *Translator) translateAPIConfig(cfg *models.StoredConfig, allConfigs []*
 		}
 
 		sandboxCluster := t.createCluster(sbClusterName, parsedSbURL, nil, sbUpstreamClusterConnectTimeout)
+		if upstreamUsesHTTP2(apiData.Upstream.Sandbox) {
+			enableUpstreamHTTP2(sandboxCluster)
+		}
 		clusters = append(clusters, sandboxCluster)
 
 		// Create sandbox routes for each operation
@@ -860,7 +875,11 @@ func (t *Translator) translateAPIConfig(cfg *models.StoredConfig, allConfigs []*
 			// Sandbox routes don't support dynamic cluster selection
 			r := t.createRoute(cfg.UUID, apiData.DisplayName, apiData.Version, apiData.Context, string(op.Method), op.Path,
 				sbClusterName, parsedSbURL.Path, effectiveSandboxVHost, cfg.Kind, templateHandle, providerName, apiData.Upstream.Sandbox.HostRewrite, apiProjectID, sbTimeout, false, "", nil)
-			sbRoutesList = append(sbRoutesList, r)
+			mirror, err := mirrors.forRoute(op.Mirror, apiData.Upstream.Sandbox)
+			if err != nil {
+				return nil, nil, err
+			}
+			sbRoutesList = append(sbRoutesList, applyMirror(r, mirror)...)
 		}
 		routesList = append(routesList, sbRoutesList...)
 	}
@@ -917,6 +936,8 @@ func (t *Translator) translateAPIConfig(cfg *models.StoredConfig, allConfigs []*
 		}
 	}
 
+	clusters = append(clusters, mirrors.clusterList()...)
+
 	return routesList, clusters, nil
 }
 
@@ -1866,10 +1887,15 @@ func (t *Translator) createRoute(apiId, apiName, apiVersion, context, method, pa
 	// Use RegexRewrite to strip the context (with version substituted if present) and prepend upstream path
 	// Pattern captures everything after the context
 	// Escape special regex characters (e.g., dots in version like v1.0)
-	upstreamIsRoot := upstreamPath == "/" || upstreamPath == ""
-	if upstreamIsRoot {
-		upstreamPath = ""
-	}
+	r.GetRoute().RegexRewrite = operationPathRewrite(context, apiVersion, path, upstreamPath)
+
+	return r
+}
+
+// operationPathRewrite builds the upstream path rewrite for an operation of an API.
+func operationPathRewrite(context, apiVersion, path, upstreamPath string) *matcher.RegexMatchAndSubstitute {
+	isWildcardPath := strings.HasSuffix(path, "/*")
+
 	// For wildcard routes, construct the regex to match everything after the prefix
 	var contextWithVersion string
 	if isWildcardPath {
@@ -1879,38 +1905,48 @@ func (t *Translator) createRoute(apiId, apiName, apiVersion, context, method, pa
 	} else {
 		contextWithVersion = ConstructFullPath(context, apiVersion, "")
 	}
+	return upstreamPathRewrite(contextWithVersion, path == "/", isWildcardPath, upstreamPath)
+}
+
+// upstreamPathRewrite builds the regex rewrite that strips the API context (with version
+// substituted, and for wildcard operations the path up to the /*) and prepends the
+// upstream path.
+func upstreamPathRewrite(contextWithVersion string, isRootPath, isWildcardPath bool, upstreamPath string) *matcher.RegexMatchAndSubstitute {
+	upstreamIsRoot := upstreamPath == "/" || upstreamPath == ""
+	if upstreamIsRoot {
+		upstreamPath = ""
+	}
+
 	escapedContext := regexp.QuoteMeta(contextWithVersion)
 	if isRootPath {
 		// Root path ("/") matches both /ctx and /ctx/. Using a non-capturing pattern
 		// avoids an empty capture group when the trailing slash is absent, which would
 		// produce an empty rewritten path. Always normalize to upstreamPath+"/".
-		r.GetRoute().RegexRewrite = &matcher.RegexMatchAndSubstitute{
+		return &matcher.RegexMatchAndSubstitute{
 			Pattern: &matcher.RegexMatcher{
 				Regex: "^" + escapedContext + "/?$",
 			},
 			Substitution: upstreamPath + "/",
 		}
-	} else if isWildcardPath && upstreamIsRoot {
+	}
+	if isWildcardPath && upstreamIsRoot {
 		// Special case: upstream path is "/" or "" with a wildcard operation path.
 		// The trailing slash is consumed into the pattern so \\1 never captures an empty
 		// string — upstream always receives at least "/".
 		// /context → /, /context/ → /, /context/foo → /foo
-		r.GetRoute().RegexRewrite = &matcher.RegexMatchAndSubstitute{
+		return &matcher.RegexMatchAndSubstitute{
 			Pattern: &matcher.RegexMatcher{
 				Regex: "^" + escapedContext + "/?(.*)$",
 			},
 			Substitution: "/\\1",
 		}
-	} else {
-		r.GetRoute().RegexRewrite = &matcher.RegexMatchAndSubstitute{
-			Pattern: &matcher.RegexMatcher{
-				Regex: "^" + escapedContext + "(.*)$",
-			},
-			Substitution: upstreamPath + "\\1",
-		}
 	}
-
-	return r
+	return &matcher.RegexMatchAndSubstitute{
+		Pattern: &matcher.RegexMatcher{
+			Regex: "^" + escapedContext + "(.*)$",
+		},
+		Substitution: upstreamPath + "\\1",
+	}
 }
 
 // createRoutePerTopic creates a route for an operation
@@ -2009,6 +2045,17 @@ func (t *Translator) createCluster(
 	return c
 }
 
+// upstreamUsesHTTP2 reports whether an upstream asks for HTTP/2 (protocol: http2).
+func upstreamUsesHTTP2(up *api.Upstream) bool {
+	return up != nil && up.Protocol != nil && *up.Protocol == api.Http2
+}
+
+// enableUpstreamHTTP2 makes Envoy speak HTTP/2 to the cluster's endpoints. Without TLS this is
+// cleartext HTTP/2 with prior knowledge (h2c), which is what gRPC backends expect.
+func enableUpstreamHTTP2(c *cluster.Cluster) {
+	c.Http2ProtocolOptions = &core.Http2ProtocolOptions{}
+}
+
 // createPolicyEngineCluster creates an Envoy cluster for the policy engine ext_proc service
 func (t *Translator) createPolicyEngineCluster() *cluster.Cluster {
 	policyEngine := t.routerConfig.PolicyEngine
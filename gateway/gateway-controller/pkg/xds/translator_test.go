@@ -1466,6 +1466,29 @@ func TestTranslator_CreateCluster(t *testing.T) {
 	}
 }
 
+func TestTranslator_RuntimeConfig_HTTP2Upstream(t *testing.T) {
+	translator := NewTranslator(createTestLogger(), testRouterConfig(), nil, testConfig())
+
+	rdc := &models.RuntimeDeployConfig{
+		Metadata: models.Metadata{UUID: "api-1", Kind: "RestApi", Version: "v1.0"},
+		UpstreamClusters: map[string]*models.UpstreamCluster{
+			"upstream_main_grpc_50051": {BasePath: "/", Endpoints: []models.Endpoint{{Host: "grpc", Port: 50051}}, HTTP2: true},
+			"upstream_main_rest_80":    {BasePath: "/", Endpoints: []models.Endpoint{{Host: "rest", Port: 80}}},
+		},
+	}
+
+	_, clusters, err := translator.translateRuntimeConfig(rdc)
+	require.NoError(t, err)
+	require.Len(t, clusters, 2)
+	for _, c := range clusters {
+		if c.Name == "upstream_main_grpc_50051" {
+			assert.NotNil(t, c.Http2ProtocolOptions)
+		} else {
+			assert.Nil(t, c.Http2ProtocolOptions)
+		}
+	}
+}
+
 func parseURL(rawURL string) (*url.URL, error) {
 	return url.Parse(rawURL)
 }
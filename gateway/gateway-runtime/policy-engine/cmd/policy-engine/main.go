@@ -37,13 +37,16 @@ import (
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/httpclient"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/pkg/cel"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/pythonbridge"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/statestore"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/tracing"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/utils"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/wasmbridge"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/xdsclient"
 )
 
@@ -146,6 +149,20 @@ func main() {
 	}
 	slog.InfoContext(ctx, "Config set in registry for ${config} CEL resolution")
 
+	// Initialize the state store shared by policies
+	stateStore, err := statestore.New(ctx, cfg.PolicyEngine.StateStore)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to initialize policy state store", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := statestore.Close(stateStore); err != nil {
+			slog.ErrorContext(ctx, "Error closing policy state store", "error", err)
+		}
+	}()
+	reg.SetStateStore(stateStore)
+	slog.InfoContext(ctx, "Policy state store initialized", "type", cfg.PolicyEngine.StateStore.Type)
+
 	// Initialize CEL evaluator
 	celEvaluator, err := cel.NewCELEvaluator()
 	if err != nil {
@@ -159,8 +176,23 @@ func main() {
 		serviceName = "policy-engine"
 	}
 
+	// Initialize the outbound HTTP client shared by policies
+	policyHTTPClient, err := httpclient.New(cfg.PolicyEngine.HTTPClient, otel.Tracer(serviceName))
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to initialize policy HTTP client", "error", err)
+		os.Exit(1)
+	}
+	defer policyHTTPClient.Close()
+	reg.SetHTTPClientProvider(policyHTTPClient)
+
 	// Initialize chain executor
 	chainExecutor := executor.NewChainExecutor(reg, celEvaluator, otel.Tracer(serviceName))
+	chainExecutor.SetCircuitBreakerConfig(executor.CircuitBreakerConfig{
+		Enabled:          cfg.PolicyEngine.PolicyCircuitBreaker.Enabled,
+		FailureThreshold: cfg.PolicyEngine.PolicyCircuitBreaker.FailureThreshold,
+		OpenDuration:     cfg.PolicyEngine.PolicyCircuitBreaker.OpenDuration,
+	})
+	k.OnChainsReplaced(chainExecutor.PruneCircuitBreakers)
 
 	// Policy registration happens automatically via Builder-generated plugin_registry.go
 	slog.InfoContext(ctx, "Policies registered via Builder-generated code")
@@ -168,6 +200,9 @@ func main() {
 	// Initialize Python executor bridge from configuration
 	pythonbridge.Init(cfg.PolicyEngine.PythonExecutor)
 
+	// Initialize WebAssembly policy runtime limits from configuration
+	wasmbridge.Init(cfg.PolicyEngine.WasmRuntime)
+
 	// Initialize configuration source based on mode
 	var xdsClient *xdsclient.Client
 	var xdsSyncStatusProvider admin.XDSSyncStatusProvider = noOpXDSSyncStatusProvider{}
@@ -202,6 +237,12 @@ func main() {
 
 	// Create and start ext_proc gRPC server
 	extprocServer := kernel.NewExternalProcessorServer(k, chainExecutor, cfg.TracingConfig, cfg.PolicyEngine.TracingServiceName)
+	if d := cfg.PolicyEngine.BodyDecompression; d != (config.BodyDecompressionConfig{}) {
+		extprocServer.SetDecompressionConfig(kernel.DecompressionConfig{
+			MaxDecompressedBytes:      d.MaxDecompressedBytes,
+			RejectUnsupportedEncoding: d.UnsupportedEncoding == "reject",
+		})
+	}
 
 	// Create listener based on mode (same pattern as gateway-controller)
 	var lis net.Listener
@@ -114,13 +114,18 @@ func dumpRouteMetadata(k *kernel.Kernel) RouteMetadataDump {
 func dumpPolicySpecs(specs []policy.PolicySpec) []PolicySpec {
 	result := make([]PolicySpec, 0, len(specs))
 	for _, spec := range specs {
-		result = append(result, PolicySpec{
+		dumped := PolicySpec{
 			Name:               spec.Name,
 			Version:            spec.Version,
 			Enabled:            spec.Enabled,
 			ExecutionCondition: spec.ExecutionCondition,
 			Parameters:         spec.Parameters.Raw,
-		})
+			OnError:            string(spec.OnError),
+		}
+		if spec.Timeout > 0 {
+			dumped.Timeout = spec.Timeout.String()
+		}
+		result = append(result, dumped)
 	}
 	return result
 }
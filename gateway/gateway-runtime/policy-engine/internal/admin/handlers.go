@@ -80,14 +80,23 @@ func (h *ConfigDumpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Redact resolved secret values so plaintext secrets never appear in the dump.
-	// json.Marshal JSON-escapes special characters (e.g. `"` → `\"`), so a
-	// raw string match would miss secrets that contain those characters.
-	// Build a combined set: each raw secret plus its JSON-escaped form (the
-	// content that json.Marshal would emit inside a JSON string literal).
+	redacted := redactSensitiveJSON(jsonBytes, rawSecrets)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(redacted))
+}
+
+// redactSensitiveJSON replaces every resolved secret value in a marshalled JSON document.
+// json.Marshal JSON-escapes special characters (e.g. `"` → `\"`), so a
+// raw string match would miss secrets that contain those characters.
+// Build a combined set: each raw secret plus its JSON-escaped form (the
+// content that json.Marshal would emit inside a JSON string literal).
+func redactSensitiveJSON(jsonBytes []byte, rawSecrets []string) string {
 	sensitiveValues := make([]string, 0, len(rawSecrets)*2)
 	for _, secret := range rawSecrets {
 		sensitiveValues = append(sensitiveValues, secret)
-		if escapedBytes, err2 := json.Marshal(secret); err2 == nil && len(escapedBytes) >= 2 {
+		if escapedBytes, err := json.Marshal(secret); err == nil && len(escapedBytes) >= 2 {
 			// escapedBytes is `"<content>"` — strip the surrounding quotes.
 			escaped := string(escapedBytes[1 : len(escapedBytes)-1])
 			if escaped != secret {
@@ -95,11 +104,7 @@ func (h *ConfigDumpHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 	}
-	redacted := redact.Redact(string(jsonBytes), sensitiveValues)
-
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte(redacted))
+	return redact.Redact(string(jsonBytes), sensitiveValues)
 }
 
 func (h *ConfigDumpHandler) getPolicyChainVersion() string {
@@ -0,0 +1,161 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/debugcapture"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
+)
+
+const (
+	policyTracePath = "/debug/policy_trace"
+
+	// maxPolicyTraceRequestBytes bounds the body of a POST /debug/policy_trace request.
+	maxPolicyTraceRequestBytes = 64 * 1024
+)
+
+// PolicyTraceHandler serves the policy decision debug capture API:
+//
+//	GET    /debug/policy_trace            list capture sessions
+//	POST   /debug/policy_trace            start a capture session
+//	GET    /debug/policy_trace/{id}       fetch a session and the requests it captured
+//	POST   /debug/policy_trace/{id}/stop  stop capturing, keeping the capture
+//	DELETE /debug/policy_trace/{id}       delete a session and its capture
+//
+// Every response is redacted with the kernel's sensitive-value list; credential header values are
+// already masked by the recorder.
+type PolicyTraceHandler struct {
+	kernel *kernel.Kernel
+}
+
+// NewPolicyTraceHandler creates a new policy trace handler
+func NewPolicyTraceHandler(k *kernel.Kernel) *PolicyTraceHandler {
+	return &PolicyTraceHandler{kernel: k}
+}
+
+// ServeHTTP implements http.Handler
+func (h *PolicyTraceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rest := strings.Trim(strings.TrimPrefix(r.URL.Path, policyTracePath), "/")
+	if rest == "" {
+		switch r.Method {
+		case http.MethodGet:
+			h.writeJSON(w, http.StatusOK, PolicyTraceListResponse{Sessions: h.kernel.DebugCapture().List()})
+		case http.MethodPost:
+			h.start(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+	}
+
+	id, action, _ := strings.Cut(rest, "/")
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		capture, err := h.kernel.DebugCapture().Get(id)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		h.writeJSON(w, http.StatusOK, capture)
+	case action == "" && r.Method == http.MethodDelete:
+		if err := h.kernel.DebugCapture().Delete(id); err != nil {
+			h.writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case action == "stop" && r.Method == http.MethodPost:
+		info, err := h.kernel.DebugCapture().Stop(id)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+		h.writeJSON(w, http.StatusOK, info)
+	case action == "" || action == "stop":
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (h *PolicyTraceHandler) start(w http.ResponseWriter, r *http.Request) {
+	var req PolicyTraceRequest
+	dec := json.NewDecoder(io.LimitReader(r.Body, maxPolicyTraceRequestBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	cfg := debugcapture.SessionConfig{
+		RouteKey:     req.RouteKey,
+		Header:       req.Header,
+		SampleRate:   req.SampleRate,
+		MaxRequests:  req.MaxRequests,
+		MaxBodyBytes: req.MaxBodyBytes,
+	}
+	if req.Duration != "" {
+		d, err := time.ParseDuration(req.Duration)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Invalid duration: %v", err), http.StatusBadRequest)
+			return
+		}
+		cfg.Duration = d
+	}
+
+	info, err := h.kernel.DebugCapture().Start(cfg)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, info)
+}
+
+func (h *PolicyTraceHandler) writeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, debugcapture.ErrSessionNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, debugcapture.ErrTooManySessions):
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+	default:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+	}
+}
+
+func (h *PolicyTraceHandler) writeJSON(w http.ResponseWriter, status int, v any) {
+	jsonBytes, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, "Failed to marshal policy trace", http.StatusInternalServerError)
+		return
+	}
+
+	// Captured headers, bodies and parameters can carry resolved secrets.
+	redacted := redactSensitiveJSON(jsonBytes, h.kernel.GetSensitiveValues())
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(redacted))
+}
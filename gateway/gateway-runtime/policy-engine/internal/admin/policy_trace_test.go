@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/debugcapture"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+func servePolicyTrace(h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestPolicyTraceHandler_Lifecycle(t *testing.T) {
+	k := kernel.NewKernel()
+	k.SetSensitiveValues([]string{`s3cr"et`})
+	handler := NewPolicyTraceHandler(k)
+
+	rec := servePolicyTrace(handler, http.MethodPost, "/debug/policy_trace",
+		`{"route_key":"route-a","header":{"name":"X-Debug"},"duration":"2m","max_body_bytes":1024}`)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	var session debugcapture.SessionInfo
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &session))
+	assert.Equal(t, debugcapture.StatusActive, session.Status)
+	assert.Equal(t, "x-debug", session.Header.Name)
+
+	specs := []policy.PolicySpec{{Name: "auth", Version: "v1", Enabled: true,
+		Parameters: policy.PolicyParameters{Raw: map[string]interface{}{"key": `s3cr"et`}}}}
+	hdrs := policy.NewHeaders(map[string][]string{"x-debug": {"1"}, "authorization": {"Bearer s3cr\"et"}})
+	trace := k.DebugCapture().Begin("route-a", "req-1", hdrs, specs)
+	require.NotNil(t, trace)
+	trace.RecordRequestHeaders(trace.Input("GET", "/", "", 0, hdrs, nil, false, false), nil, nil)
+
+	rec = servePolicyTrace(handler, http.MethodGet, "/debug/policy_trace/"+session.ID, "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	assert.NotContains(t, rec.Body.String(), "s3cr")
+	assert.Contains(t, rec.Body.String(), "***REDACTED***")
+	var capture debugcapture.Capture
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &capture))
+	require.Len(t, capture.Requests, 1)
+	assert.Equal(t, "req-1", capture.Requests[0].RequestID)
+
+	rec = servePolicyTrace(handler, http.MethodGet, "/debug/policy_trace", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	var list PolicyTraceListResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+	require.Len(t, list.Sessions, 1)
+	assert.Equal(t, 1, list.Sessions[0].CapturedRequests)
+
+	rec = servePolicyTrace(handler, http.MethodPost, "/debug/policy_trace/"+session.ID+"/stop", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &session))
+	assert.Equal(t, debugcapture.StatusStopped, session.Status)
+
+	rec = servePolicyTrace(handler, http.MethodDelete, "/debug/policy_trace/"+session.ID, "")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	rec = servePolicyTrace(handler, http.MethodGet, "/debug/policy_trace/"+session.ID, "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestPolicyTraceHandler_Errors(t *testing.T) {
+	handler := NewPolicyTraceHandler(kernel.NewKernel())
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		want   int
+	}{
+		{"malformed body", http.MethodPost, "/debug/policy_trace", `{`, http.StatusBadRequest},
+		{"unknown field", http.MethodPost, "/debug/policy_trace", `{"route":"a"}`, http.StatusBadRequest},
+		{"no selector", http.MethodPost, "/debug/policy_trace", `{}`, http.StatusBadRequest},
+		{"bad duration", http.MethodPost, "/debug/policy_trace", `{"route_key":"a","duration":"soon"}`, http.StatusBadRequest},
+		{"duration too long", http.MethodPost, "/debug/policy_trace", `{"route_key":"a","duration":"2h"}`, http.StatusBadRequest},
+		{"collection method", http.MethodDelete, "/debug/policy_trace", ``, http.StatusMethodNotAllowed},
+		{"session method", http.MethodPut, "/debug/policy_trace/abc", ``, http.StatusMethodNotAllowed},
+		{"unknown session", http.MethodGet, "/debug/policy_trace/abc", ``, http.StatusNotFound},
+		{"unknown action", http.MethodPost, "/debug/policy_trace/abc/restart", ``, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := servePolicyTrace(handler, tt.method, tt.path, tt.body)
+			assert.Equal(t, tt.want, rec.Code, rec.Body.String())
+		})
+	}
+}
@@ -46,8 +46,11 @@ func NewServer(cfg *config.AdminConfig, k *kernel.Kernel, reg *registry.PolicyRe
 	configDumpHandler := NewConfigDumpHandler(k, reg, xds)
 	xdsSyncHandler := NewXDSSyncStatusHandler(xds)
 	healthHandler := NewHealthHandler(health, pythonHealth)
+	policyTraceHandler := NewPolicyTraceHandler(k)
 	mux.Handle("/config_dump", ipWhitelistMiddleware(cfg.AllowedIPs, configDumpHandler))
 	mux.Handle("/xds_sync_status", ipWhitelistMiddleware(cfg.AllowedIPs, xdsSyncHandler))
+	mux.Handle(policyTracePath, ipWhitelistMiddleware(cfg.AllowedIPs, policyTraceHandler))
+	mux.Handle(policyTracePath+"/", ipWhitelistMiddleware(cfg.AllowedIPs, policyTraceHandler))
 	// Health endpoint is registered without IP whitelist so Docker/k8s health probes can reach it
 	mux.Handle("/health", healthHandler)
 
@@ -105,6 +105,11 @@ func TestServer_StartAndStop(t *testing.T) {
 	syncResp.Body.Close()
 	assert.Equal(t, http.StatusOK, syncResp.StatusCode)
 
+	traceResp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/debug/policy_trace", port))
+	require.NoError(t, err)
+	traceResp.Body.Close()
+	assert.Equal(t, http.StatusOK, traceResp.StatusCode)
+
 	// Stop server
 	stopCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
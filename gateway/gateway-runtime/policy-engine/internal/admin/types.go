@@ -18,7 +18,11 @@
 
 package admin
 
-import "time"
+import (
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/debugcapture"
+)
 
 // ConfigDumpResponse is the top-level response structure for the config_dump endpoint
 type ConfigDumpResponse struct {
@@ -42,6 +46,22 @@ type XDSSyncStatusResponse struct {
 	PolicyChainVersion string    `json:"policy_chain_version"`
 }
 
+// PolicyTraceRequest is the request payload for POST /debug/policy_trace.
+// At least one of RouteKey and Header must be set.
+type PolicyTraceRequest struct {
+	RouteKey     string                    `json:"route_key,omitempty"`
+	Header       *debugcapture.HeaderMatch `json:"header,omitempty"`
+	SampleRate   float64                   `json:"sample_rate,omitempty"`
+	Duration     string                    `json:"duration,omitempty"`
+	MaxRequests  int                       `json:"max_requests,omitempty"`
+	MaxBodyBytes int                       `json:"max_body_bytes,omitempty"`
+}
+
+// PolicyTraceListResponse is the response payload for GET /debug/policy_trace.
+type PolicyTraceListResponse struct {
+	Sessions []debugcapture.SessionInfo `json:"sessions"`
+}
+
 // HealthResponse is the response payload for GET /health.
 type HealthResponse struct {
 	Status    string `json:"status"`
@@ -120,4 +140,6 @@ type PolicySpec struct {
 	Enabled            bool                   `json:"enabled"`
 	ExecutionCondition *string                `json:"execution_condition"`
 	Parameters         map[string]interface{} `json:"parameters"`
+	Timeout            string                 `json:"timeout,omitempty"`
+	OnError            string                 `json:"on_error,omitempty"`
 }
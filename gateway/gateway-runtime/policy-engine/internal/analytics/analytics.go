@@ -23,6 +23,7 @@ import (
 	"log/slog"
 	"maps"
 	"strconv"
+	"strings"
 	"time"
 
 	v3 "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v3"
@@ -230,6 +231,8 @@ func (c *Analytics) prepareAnalyticEvent(logEntry *v3.HTTPAccessLogEntry) *dto.E
 		target.Destination = logEntry.GetRequest().GetAuthority() + logEntry.GetRequest().GetPath()
 		target.ResponseCodeDetail = logEntry.GetResponse().GetResponseCodeDetails()
 	}
+	target.Upstream = upstreamFromCluster(logEntry.GetCommonProperties().GetUpstreamCluster(),
+		extendedAPI.APIType, extendedAPI.APIID)
 
 	// Prepare Application
 	application := &dto.Application{}
@@ -500,3 +503,15 @@ func (c *Analytics) getAnonymousApp() *dto.Application {
 	application.ApplicationOwner = anonymousValue
 	return application
 }
+
+// upstreamFromCluster returns the upstream that served a request, given the Envoy cluster
+// the request was routed to. Upstream definition clusters, which traffic splits and
+// dynamic upstream selection route to, are named upstream_<kind>_<apiId>_<definition>
+// and are reported by definition name; other clusters are reported as is.
+func upstreamFromCluster(clusterName, apiKind, apiID string) string {
+	prefix := UpstreamDefinitionClusterPrefix + apiKind + "_" + apiID + "_"
+	if apiKind != "" && apiID != "" && strings.HasPrefix(clusterName, prefix) {
+		return strings.TrimPrefix(clusterName, prefix)
+	}
+	return clusterName
+}
@@ -343,6 +343,29 @@ func TestPrepareAnalyticEvent_WithFilterMetadata(t *testing.T) {
 	assert.Equal(t, "TestApp", event.Application.ApplicationName)
 }
 
+func TestPrepareAnalyticEvent_WithUpstreamCluster(t *testing.T) {
+	cfg := &config.Config{}
+	analytics := NewAnalytics(cfg)
+
+	logEntry := createLogEntryWithMetadata(map[string]string{
+		APIIDKey:   "api-123",
+		APITypeKey: "RestApi",
+	})
+	logEntry.CommonProperties.UpstreamCluster = "upstream_RestApi_api-123_backend-canary"
+
+	event := analytics.prepareAnalyticEvent(logEntry)
+
+	require.NotNil(t, event)
+	assert.Equal(t, "backend-canary", event.Target.Upstream)
+}
+
+func TestUpstreamFromCluster(t *testing.T) {
+	assert.Equal(t, "canary", upstreamFromCluster("upstream_RestApi_api-1_canary", "RestApi", "api-1"))
+	assert.Equal(t, "cluster_http_backend_8080", upstreamFromCluster("cluster_http_backend_8080", "RestApi", "api-1"))
+	assert.Equal(t, "upstream_RestApi_api-2_canary", upstreamFromCluster("upstream_RestApi_api-2_canary", "RestApi", "api-1"))
+	assert.Equal(t, "", upstreamFromCluster("", "RestApi", "api-1"))
+}
+
 func TestPrepareAnalyticEvent_WithAnonymousApp(t *testing.T) {
 	cfg := &config.Config{}
 	analytics := NewAnalytics(cfg)
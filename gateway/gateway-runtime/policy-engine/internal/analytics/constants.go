@@ -129,6 +129,8 @@ const (
 
 	// DestinationKey is the key for the destination.
 	DestinationKey = Wso2MetadataPrefix + "destination"
+	// UpstreamDefinitionClusterPrefix prefixes the Envoy clusters created for an API's upstream definitions.
+	UpstreamDefinitionClusterPrefix = "upstream_"
 	// DefaultForUnknown is the default value used for unassigned properties.
 	DefaultForUnknown = "UNKNOWN"
 
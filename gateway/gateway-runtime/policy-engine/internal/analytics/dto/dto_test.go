@@ -214,6 +214,14 @@ func TestTarget_GetSetDestination(t *testing.T) {
 	assert.Equal(t, "https://backend.example.com", target.GetDestination())
 }
 
+func TestTarget_GetSetUpstream(t *testing.T) {
+	target := &Target{}
+	assert.Equal(t, "", target.GetUpstream())
+
+	target.SetUpstream("backend-canary")
+	assert.Equal(t, "backend-canary", target.GetUpstream())
+}
+
 // =============================================================================
 // Operation Tests
 // =============================================================================
@@ -23,6 +23,7 @@ type Target struct {
 	ResponseCacheHit   bool   `json:"responseCacheHit"`
 	Destination        string `json:"destination"`
 	ResponseCodeDetail string `json:"responseCodeDetail"`
+	Upstream           string `json:"upstream,omitempty"`
 }
 
 // GetTargetResponseCode returns the target response code.
@@ -54,3 +55,13 @@ func (t *Target) GetDestination() string {
 func (t *Target) SetDestination(destination string) {
 	t.Destination = destination
 }
+
+// GetUpstream returns the upstream that served the request.
+func (t *Target) GetUpstream() string {
+	return t.Upstream
+}
+
+// SetUpstream sets the upstream that served the request.
+func (t *Target) SetUpstream(upstream string) {
+	t.Upstream = upstream
+}
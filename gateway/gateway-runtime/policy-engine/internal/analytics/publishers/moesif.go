@@ -221,6 +221,9 @@ func (m *Moesif) Publish(event *dto.Event) {
 	metadataMap["apiType"] = event.API.APIType
 	metadataMap["apiId"] = event.API.APIID
 	metadataMap["projectId"] = event.API.ProjectID
+	if event.Target != nil && event.Target.Upstream != "" {
+		metadataMap["upstream"] = event.Target.Upstream
+	}
 
 	// AI Metadata
 	if event.API.APIType == "LlmProvider" {
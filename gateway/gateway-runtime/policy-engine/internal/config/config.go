@@ -82,6 +82,16 @@ type PolicyEngine struct {
 	FileConfig FileConfigConfig `koanf:"file_config"`
 	Logging    LoggingConfig    `koanf:"logging"`
 	PythonExecutor PythonExecutorConfig `koanf:"python_executor"`
+	// PolicyCircuitBreaker controls automatic circuit breaking of failing policy instances
+	PolicyCircuitBreaker PolicyCircuitBreakerConfig `koanf:"policy_circuit_breaker"`
+	// StateStore configures the state store shared by policies (rate-limit counters, quotas)
+	StateStore StateStoreConfig `koanf:"state_store"`
+	// WasmRuntime sets the resource limits of WebAssembly policies
+	WasmRuntime WasmRuntimeConfig `koanf:"wasm_runtime"`
+	// BodyDecompression controls how Content-Encoded bodies are decoded for body policies
+	BodyDecompression BodyDecompressionConfig `koanf:"body_decompression"`
+	// HTTPClient configures the outbound HTTP client shared by policies
+	HTTPClient HTTPClientConfig `koanf:"http_client"`
 	// Tracing holds OpenTelemetry exporter configuration
 	TracingServiceName string `koanf:"tracing_service_name"`
 
@@ -136,6 +146,151 @@ type ServerConfig struct {
 	ExtProcPort int `koanf:"extproc_port"`
 }
 
+// PolicyCircuitBreakerConfig holds circuit breaker settings applied to every policy instance.
+// A policy instance (a policy at a position in a route's chain) whose invocations fail
+// FailureThreshold times in a row is not invoked for OpenDuration; its onError mode decides
+// what happens to requests meanwhile.
+type PolicyCircuitBreakerConfig struct {
+	// Enabled turns circuit breaking on
+	Enabled bool `koanf:"enabled"`
+
+	// FailureThreshold is the number of consecutive failures (panics or timeouts) that opens the circuit
+	FailureThreshold int `koanf:"failure_threshold"`
+
+	// OpenDuration is how long the circuit stays open before a trial invocation is allowed
+	OpenDuration time.Duration `koanf:"open_duration"`
+}
+
+// StateStoreConfig selects the backend of the state store handed to policies through
+// PolicyMetadata.StateStore.
+type StateStoreConfig struct {
+	// Type is the backend: "memory" (default when empty, local to this replica) or "redis" (shared across replicas)
+	Type string `koanf:"type"`
+
+	// Redis holds the Redis connection settings, used when Type is "redis"
+	Redis StateStoreRedisConfig `koanf:"redis"`
+}
+
+// StateStoreRedisConfig holds Redis connection settings for the shared state store.
+type StateStoreRedisConfig struct {
+	// Address is the Redis server address (host:port)
+	Address string `koanf:"address"`
+
+	// Username and Password authenticate with Redis ACLs; both may be empty
+	Username string `koanf:"username"`
+	Password string `koanf:"password"`
+
+	// DB is the Redis logical database number
+	DB int `koanf:"db"`
+
+	// KeyPrefix is prepended to every key so several gateways can share one Redis
+	KeyPrefix string `koanf:"key_prefix"`
+
+	// TLS enables TLS for the Redis connection
+	TLS bool `koanf:"tls"`
+
+	// DialTimeout bounds establishing a new connection
+	DialTimeout time.Duration `koanf:"dial_timeout"`
+
+	// OperationTimeout bounds a single read or write; policies pass their own context as well
+	OperationTimeout time.Duration `koanf:"operation_timeout"`
+
+	// PoolSize is the maximum number of connections; 0 uses the client default
+	PoolSize int `koanf:"pool_size"`
+}
+
+// WasmRuntimeConfig holds the limits applied to WebAssembly policy modules, which run in-process.
+type WasmRuntimeConfig struct {
+	// MaxMemoryMB caps the linear memory of each module instance
+	MaxMemoryMB int `koanf:"max_memory_mb"`
+
+	// CallTimeout bounds a single policy call; the module instance is discarded when it is exceeded
+	CallTimeout time.Duration `koanf:"call_timeout"`
+
+	// Fuel is the number of guest function calls allowed per policy call; 0 disables fuel metering,
+	// which otherwise slows down call-heavy modules
+	Fuel int64 `koanf:"fuel"`
+
+	// PoolSize is the number of idle module instances kept per policy
+	PoolSize int `koanf:"pool_size"`
+}
+
+// BodyDecompressionConfig controls how compressed request and response bodies are decoded
+// before body policies run. gzip, br, zstd and deflate are supported.
+type BodyDecompressionConfig struct {
+	// MaxDecompressedBytes caps the decompressed size of a body; larger requests get a 413
+	// and larger upstream responses a 502
+	MaxDecompressedBytes int64 `koanf:"max_decompressed_bytes"`
+
+	// UnsupportedEncoding is what happens when a route with body policies receives a body
+	// in another encoding: "warn" passes it to the policies as received and logs a warning,
+	// "reject" refuses it (415 for requests, 502 for upstream responses)
+	UnsupportedEncoding string `koanf:"unsupported_encoding"`
+}
+
+// HTTPClientConfig configures the HTTP client policies use for outbound calls
+// (PolicyMetadata.HTTPClient).
+type HTTPClientConfig struct {
+	// Timeout bounds a single attempt, including reading the response body
+	Timeout time.Duration `koanf:"timeout"`
+
+	// MaxIdleConns is the size of the idle connection pool shared by all policies
+	MaxIdleConns int `koanf:"max_idle_conns"`
+
+	// MaxIdleConnsPerHost is the number of idle connections kept per target host
+	MaxIdleConnsPerHost int `koanf:"max_idle_conns_per_host"`
+
+	// IdleConnTimeout closes pooled connections left idle for this long
+	IdleConnTimeout time.Duration `koanf:"idle_conn_timeout"`
+
+	TLS            HTTPClientTLSConfig            `koanf:"tls"`
+	Retry          HTTPClientRetryConfig          `koanf:"retry"`
+	CircuitBreaker HTTPClientCircuitBreakerConfig `koanf:"circuit_breaker"`
+}
+
+// HTTPClientTLSConfig holds the trust and client certificate settings of the policy HTTP client.
+type HTTPClientTLSConfig struct {
+	// CAPath is a PEM bundle trusted in addition to the system CAs and the
+	// controller-managed certificates
+	CAPath string `koanf:"ca_path"`
+
+	// CertPath and KeyPath hold the client certificate presented to servers that require mTLS
+	CertPath string `koanf:"cert_path"`
+	KeyPath  string `koanf:"key_path"`
+
+	// TrustControllerCertificates trusts the certificates managed through the gateway
+	// controller's certificates API, as the router does for upstreams
+	TrustControllerCertificates bool `koanf:"trust_controller_certificates"`
+
+	// InsecureSkipVerify disables server certificate verification; for development only
+	InsecureSkipVerify bool `koanf:"insecure_skip_verify"`
+}
+
+// HTTPClientRetryConfig controls retries of idempotent policy HTTP calls.
+type HTTPClientRetryConfig struct {
+	// MaxRetries is the number of retries after the first attempt; 0 disables retries
+	MaxRetries int `koanf:"max_retries"`
+
+	// InitialBackoff is the base delay before the first retry; it doubles per retry with jitter
+	InitialBackoff time.Duration `koanf:"initial_backoff"`
+
+	// MaxBackoff caps the delay between attempts, including delays requested by Retry-After
+	MaxBackoff time.Duration `koanf:"max_backoff"`
+}
+
+// HTTPClientCircuitBreakerConfig controls circuit breaking per target host.
+type HTTPClientCircuitBreakerConfig struct {
+	// Enabled turns circuit breaking on
+	Enabled bool `koanf:"enabled"`
+
+	// FailureThreshold is the number of consecutive failed attempts (connection errors or
+	// 5xx responses) to a host that opens its circuit
+	FailureThreshold int `koanf:"failure_threshold"`
+
+	// OpenDuration is how long calls to the host are rejected before a trial call is let through
+	OpenDuration time.Duration `koanf:"open_duration"`
+}
+
 // PythonExecutorConfig holds configuration for the Python executor bridge.
 // The Policy Engine uses this to connect to the Python executor process.
 type PythonExecutorConfig struct {
@@ -340,6 +495,49 @@ func defaultConfig() *Config {
 				},
 				Timeout: 30 * time.Second,
 			},
+			PolicyCircuitBreaker: PolicyCircuitBreakerConfig{
+				Enabled:          true,
+				FailureThreshold: 5,
+				OpenDuration:     30 * time.Second,
+			},
+			StateStore: StateStoreConfig{
+				Type: "memory",
+				Redis: StateStoreRedisConfig{
+					Address:          "localhost:6379",
+					KeyPrefix:        "policy-engine:",
+					DialTimeout:      5 * time.Second,
+					OperationTimeout: 500 * time.Millisecond,
+				},
+			},
+			WasmRuntime: WasmRuntimeConfig{
+				MaxMemoryMB: 64,
+				CallTimeout: 1 * time.Second,
+				Fuel:        0,
+				PoolSize:    8,
+			},
+			BodyDecompression: BodyDecompressionConfig{
+				MaxDecompressedBytes: 10 << 20,
+				UnsupportedEncoding:  "warn",
+			},
+			HTTPClient: HTTPClientConfig{
+				Timeout:             30 * time.Second,
+				MaxIdleConns:        100,
+				MaxIdleConnsPerHost: 16,
+				IdleConnTimeout:     90 * time.Second,
+				TLS: HTTPClientTLSConfig{
+					TrustControllerCertificates: true,
+				},
+				Retry: HTTPClientRetryConfig{
+					MaxRetries:     2,
+					InitialBackoff: 100 * time.Millisecond,
+					MaxBackoff:     2 * time.Second,
+				},
+				CircuitBreaker: HTTPClientCircuitBreakerConfig{
+					Enabled:          true,
+					FailureThreshold: 5,
+					OpenDuration:     30 * time.Second,
+				},
+			},
 			TracingServiceName: "policy-engine",
 		},
 		Analytics: AnalyticsConfig{
@@ -415,6 +613,84 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("policy_engine.python_executor.timeout must be positive")
 	}
 
+	// Validate policy circuit breaker config
+	if cb := c.PolicyEngine.PolicyCircuitBreaker; cb.Enabled {
+		if cb.FailureThreshold <= 0 {
+			return fmt.Errorf("policy_engine.policy_circuit_breaker.failure_threshold must be positive")
+		}
+		if cb.OpenDuration <= 0 {
+			return fmt.Errorf("policy_engine.policy_circuit_breaker.open_duration must be positive")
+		}
+	}
+
+	// Validate state store config
+	switch ss := c.PolicyEngine.StateStore; ss.Type {
+	case "memory", "":
+	case "redis":
+		if ss.Redis.Address == "" {
+			return fmt.Errorf("policy_engine.state_store.redis.address is required when type = 'redis'")
+		}
+		if ss.Redis.DB < 0 {
+			return fmt.Errorf("policy_engine.state_store.redis.db must not be negative")
+		}
+		if ss.Redis.PoolSize < 0 {
+			return fmt.Errorf("policy_engine.state_store.redis.pool_size must not be negative")
+		}
+		if ss.Redis.DialTimeout <= 0 || ss.Redis.OperationTimeout <= 0 {
+			return fmt.Errorf("policy_engine.state_store.redis.dial_timeout and operation_timeout must be positive")
+		}
+	default:
+		return fmt.Errorf("policy_engine.state_store.type must be 'memory' or 'redis', got: %s", ss.Type)
+	}
+
+	// Validate WebAssembly runtime config
+	if w := c.PolicyEngine.WasmRuntime; w != (WasmRuntimeConfig{}) {
+		if w.MaxMemoryMB <= 0 || w.MaxMemoryMB > 4096 {
+			return fmt.Errorf("policy_engine.wasm_runtime.max_memory_mb must be 1-4096, got: %d", w.MaxMemoryMB)
+		}
+		if w.CallTimeout <= 0 {
+			return fmt.Errorf("policy_engine.wasm_runtime.call_timeout must be positive")
+		}
+		if w.Fuel < 0 {
+			return fmt.Errorf("policy_engine.wasm_runtime.fuel must not be negative")
+		}
+		if w.PoolSize < 0 {
+			return fmt.Errorf("policy_engine.wasm_runtime.pool_size must not be negative")
+		}
+	}
+
+	// Validate body decompression config
+	if d := c.PolicyEngine.BodyDecompression; d != (BodyDecompressionConfig{}) {
+		if d.MaxDecompressedBytes <= 0 {
+			return fmt.Errorf("policy_engine.body_decompression.max_decompressed_bytes must be positive, got: %d", d.MaxDecompressedBytes)
+		}
+		if d.UnsupportedEncoding != "warn" && d.UnsupportedEncoding != "reject" {
+			return fmt.Errorf("policy_engine.body_decompression.unsupported_encoding must be 'warn' or 'reject', got: %s", d.UnsupportedEncoding)
+		}
+	}
+
+	// Validate policy HTTP client config
+	if h := c.PolicyEngine.HTTPClient; h != (HTTPClientConfig{}) {
+		if h.Timeout <= 0 {
+			return fmt.Errorf("policy_engine.http_client.timeout must be positive")
+		}
+		if h.MaxIdleConns < 0 || h.MaxIdleConnsPerHost < 0 || h.IdleConnTimeout < 0 {
+			return fmt.Errorf("policy_engine.http_client connection pool settings must not be negative")
+		}
+		if (h.TLS.CertPath == "") != (h.TLS.KeyPath == "") {
+			return fmt.Errorf("policy_engine.http_client.tls.cert_path and key_path must be set together")
+		}
+		if h.Retry.MaxRetries < 0 || h.Retry.MaxRetries > 10 {
+			return fmt.Errorf("policy_engine.http_client.retry.max_retries must be 0-10, got: %d", h.Retry.MaxRetries)
+		}
+		if h.Retry.MaxRetries > 0 && (h.Retry.InitialBackoff <= 0 || h.Retry.MaxBackoff < h.Retry.InitialBackoff) {
+			return fmt.Errorf("policy_engine.http_client.retry.initial_backoff must be positive and not above max_backoff")
+		}
+		if h.CircuitBreaker.Enabled && (h.CircuitBreaker.FailureThreshold <= 0 || h.CircuitBreaker.OpenDuration <= 0) {
+			return fmt.Errorf("policy_engine.http_client.circuit_breaker.failure_threshold and open_duration must be positive when enabled")
+		}
+	}
+
 	// Validate admin config
 	if c.PolicyEngine.Admin.Enabled {
 		if c.PolicyEngine.Admin.Port <= 0 || c.PolicyEngine.Admin.Port > 65535 {
@@ -312,6 +312,291 @@ func TestValidate_PythonExecutorConfig(t *testing.T) {
 	}
 }
 
+func TestValidate_PolicyCircuitBreakerConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		cb        PolicyCircuitBreakerConfig
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name: "valid",
+			cb:   PolicyCircuitBreakerConfig{Enabled: true, FailureThreshold: 5, OpenDuration: 30 * time.Second},
+		},
+		{
+			name: "disabled ignores other fields",
+			cb:   PolicyCircuitBreakerConfig{Enabled: false},
+		},
+		{
+			name:      "invalid threshold",
+			cb:        PolicyCircuitBreakerConfig{Enabled: true, FailureThreshold: 0, OpenDuration: 30 * time.Second},
+			expectErr: true,
+			errMsg:    "policy_engine.policy_circuit_breaker.failure_threshold must be positive",
+		},
+		{
+			name:      "invalid open duration",
+			cb:        PolicyCircuitBreakerConfig{Enabled: true, FailureThreshold: 5},
+			expectErr: true,
+			errMsg:    "policy_engine.policy_circuit_breaker.open_duration must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.PolicyEngine.PolicyCircuitBreaker = tt.cb
+
+			err := cfg.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate_StateStoreConfig(t *testing.T) {
+	validRedis := StateStoreRedisConfig{
+		Address:          "redis:6379",
+		DialTimeout:      5 * time.Second,
+		OperationTimeout: 500 * time.Millisecond,
+	}
+	tests := []struct {
+		name      string
+		ss        func() StateStoreConfig
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name: "memory",
+			ss:   func() StateStoreConfig { return StateStoreConfig{Type: "memory"} },
+		},
+		{
+			name: "empty type defaults to memory",
+			ss:   func() StateStoreConfig { return StateStoreConfig{} },
+		},
+		{
+			name: "redis",
+			ss:   func() StateStoreConfig { return StateStoreConfig{Type: "redis", Redis: validRedis} },
+		},
+		{
+			name: "redis without address",
+			ss: func() StateStoreConfig {
+				r := validRedis
+				r.Address = ""
+				return StateStoreConfig{Type: "redis", Redis: r}
+			},
+			expectErr: true,
+			errMsg:    "policy_engine.state_store.redis.address is required",
+		},
+		{
+			name: "redis negative db",
+			ss: func() StateStoreConfig {
+				r := validRedis
+				r.DB = -1
+				return StateStoreConfig{Type: "redis", Redis: r}
+			},
+			expectErr: true,
+			errMsg:    "policy_engine.state_store.redis.db must not be negative",
+		},
+		{
+			name: "redis without operation timeout",
+			ss: func() StateStoreConfig {
+				r := validRedis
+				r.OperationTimeout = 0
+				return StateStoreConfig{Type: "redis", Redis: r}
+			},
+			expectErr: true,
+			errMsg:    "operation_timeout must be positive",
+		},
+		{
+			name:      "unknown type",
+			ss:        func() StateStoreConfig { return StateStoreConfig{Type: "etcd"} },
+			expectErr: true,
+			errMsg:    "policy_engine.state_store.type must be 'memory' or 'redis'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.PolicyEngine.StateStore = tt.ss()
+
+			err := cfg.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate_WasmRuntimeConfig(t *testing.T) {
+	valid := WasmRuntimeConfig{MaxMemoryMB: 64, CallTimeout: time.Second, PoolSize: 8}
+	tests := []struct {
+		name      string
+		mutate    func(w *WasmRuntimeConfig)
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name:   "defaults",
+			mutate: func(w *WasmRuntimeConfig) {},
+		},
+		{
+			name:   "unset section is skipped",
+			mutate: func(w *WasmRuntimeConfig) { *w = WasmRuntimeConfig{} },
+		},
+		{
+			name:   "fuel metering enabled",
+			mutate: func(w *WasmRuntimeConfig) { w.Fuel = 100000 },
+		},
+		{
+			name:      "memory above wasm32 limit",
+			mutate:    func(w *WasmRuntimeConfig) { w.MaxMemoryMB = 4097 },
+			expectErr: true,
+			errMsg:    "policy_engine.wasm_runtime.max_memory_mb must be 1-4096",
+		},
+		{
+			name:      "zero call timeout",
+			mutate:    func(w *WasmRuntimeConfig) { w.CallTimeout = 0 },
+			expectErr: true,
+			errMsg:    "policy_engine.wasm_runtime.call_timeout must be positive",
+		},
+		{
+			name:      "negative fuel",
+			mutate:    func(w *WasmRuntimeConfig) { w.Fuel = -1 },
+			expectErr: true,
+			errMsg:    "policy_engine.wasm_runtime.fuel must not be negative",
+		},
+		{
+			name:      "negative pool size",
+			mutate:    func(w *WasmRuntimeConfig) { w.PoolSize = -1 },
+			expectErr: true,
+			errMsg:    "policy_engine.wasm_runtime.pool_size must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.PolicyEngine.WasmRuntime = valid
+			tt.mutate(&cfg.PolicyEngine.WasmRuntime)
+
+			err := cfg.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate_BodyDecompressionConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       BodyDecompressionConfig
+		expectErr bool
+		errMsg    string
+	}{
+		{name: "warn", cfg: BodyDecompressionConfig{MaxDecompressedBytes: 1 << 20, UnsupportedEncoding: "warn"}},
+		{name: "reject", cfg: BodyDecompressionConfig{MaxDecompressedBytes: 1 << 20, UnsupportedEncoding: "reject"}},
+		{name: "unset section is skipped", cfg: BodyDecompressionConfig{}},
+		{
+			name:      "zero limit",
+			cfg:       BodyDecompressionConfig{UnsupportedEncoding: "warn"},
+			expectErr: true,
+			errMsg:    "policy_engine.body_decompression.max_decompressed_bytes must be positive",
+		},
+		{
+			name:      "unknown mode",
+			cfg:       BodyDecompressionConfig{MaxDecompressedBytes: 1 << 20, UnsupportedEncoding: "drop"},
+			expectErr: true,
+			errMsg:    "policy_engine.body_decompression.unsupported_encoding must be 'warn' or 'reject'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.PolicyEngine.BodyDecompression = tt.cfg
+
+			err := cfg.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidate_HTTPClientConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(h *HTTPClientConfig)
+		expectErr bool
+		errMsg    string
+	}{
+		{name: "defaults", mutate: func(h *HTTPClientConfig) {}},
+		{name: "unset section is skipped", mutate: func(h *HTTPClientConfig) { *h = HTTPClientConfig{} }},
+		{name: "retries disabled", mutate: func(h *HTTPClientConfig) { h.Retry = HTTPClientRetryConfig{} }},
+		{
+			name:      "zero timeout",
+			mutate:    func(h *HTTPClientConfig) { h.Timeout = 0 },
+			expectErr: true,
+			errMsg:    "policy_engine.http_client.timeout must be positive",
+		},
+		{
+			name:      "client certificate without key",
+			mutate:    func(h *HTTPClientConfig) { h.TLS.CertPath = "/certs/client.crt" },
+			expectErr: true,
+			errMsg:    "policy_engine.http_client.tls.cert_path and key_path must be set together",
+		},
+		{
+			name:      "too many retries",
+			mutate:    func(h *HTTPClientConfig) { h.Retry.MaxRetries = 11 },
+			expectErr: true,
+			errMsg:    "policy_engine.http_client.retry.max_retries must be 0-10",
+		},
+		{
+			name:      "initial backoff above max",
+			mutate:    func(h *HTTPClientConfig) { h.Retry.InitialBackoff = 10 * time.Second },
+			expectErr: true,
+			errMsg:    "policy_engine.http_client.retry.initial_backoff must be positive and not above max_backoff",
+		},
+		{
+			name:      "breaker without threshold",
+			mutate:    func(h *HTTPClientConfig) { h.CircuitBreaker.FailureThreshold = 0 },
+			expectErr: true,
+			errMsg:    "policy_engine.http_client.circuit_breaker.failure_threshold and open_duration must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			cfg.PolicyEngine.HTTPClient = defaultConfig().PolicyEngine.HTTPClient
+			tt.mutate(&cfg.PolicyEngine.HTTPClient)
+
+			err := cfg.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // TestValidate_UDS_PortConflict tests that UDS mode skips port conflict checks
 func TestValidate_UDS_PortConflict(t *testing.T) {
 	t.Run("UDS mode - admin port conflict with extproc port ignored", func(t *testing.T) {
@@ -62,6 +62,7 @@ const (
 	SpanProcessResponseBody       = "external_processing.process_response_body"
 	SpanPolicyRequestFormat       = "policy.request.%s"
 	SpanPolicyResponseFormat      = "policy.response.%s"
+	SpanPolicyStreamEndFormat     = "policy.stream_end.%s"
 
 	// Tracing Attributes
 	AttrRouteName                 = "route_name"
@@ -80,6 +81,10 @@ const (
 	AttrSkipReasonConditionNotMet = "condition_not_met"
 	AttrPolicyExecutionTimeNS     = "policy.execution_time_ns"
 	AttrPolicyShortCircuit        = "policy.short_circuit"
+	AttrPolicyFailureReason       = "policy.failure_reason"
+	AttrPolicyOnError             = "policy.on_error"
+	AttrSkipReasonPolicyError     = "policy_error"
+	AttrStreamEndReason           = "stream.end_reason"
 
 	// Analytics metadata and property keys shared across packages.
 	GuardrailHitMetadataKey  = "isGuardrailHit"
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package debugcapture
+
+import (
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
+)
+
+// RecordRequestHeaders records a request-headers phase.
+func (t *Trace) RecordRequestHeaders(in *PhaseInput, res *executor.RequestHeaderExecutionResult, err error) {
+	if t == nil {
+		return
+	}
+	p := t.phase(executor.PhaseRequestHeaders, in, err)
+	if res != nil {
+		for _, r := range res.Results {
+			p.Policies = append(p.Policies, t.decision(r.PolicyName, r.PolicyVersion, r.Skipped, r.Error, r.ExecutionTime, r.Action))
+		}
+		finish(&p, res.ShortCircuited, res.TotalExecutionTime)
+	}
+	t.append(p)
+}
+
+// RecordRequestBody records a buffered request-body phase, including the one run
+// inline for requests without a body.
+func (t *Trace) RecordRequestBody(in *PhaseInput, res *executor.RequestExecutionResult, err error) {
+	if t == nil {
+		return
+	}
+	p := t.phase(executor.PhaseRequestBody, in, err)
+	if res != nil {
+		for _, r := range res.Results {
+			p.Policies = append(p.Policies, t.decision(r.PolicyName, r.PolicyVersion, r.Skipped, r.Error, r.ExecutionTime, r.Action))
+		}
+		finish(&p, res.ShortCircuited, res.TotalExecutionTime)
+	}
+	t.append(p)
+}
+
+// RecordRequestBodyChunk records one flushed chunk of a streamed request body.
+func (t *Trace) RecordRequestBodyChunk(in *PhaseInput, res *executor.StreamingRequestExecutionResult, err error) {
+	if t == nil {
+		return
+	}
+	p := t.phase(executor.PhaseRequestBodyChunk, in, err)
+	if res != nil {
+		for _, r := range res.Results {
+			p.Policies = append(p.Policies, t.decision(r.PolicyName, r.PolicyVersion, r.Skipped, r.Error, r.ExecutionTime, r.Action))
+		}
+		finish(&p, false, res.TotalExecutionTime)
+	}
+	t.append(p)
+}
+
+// RecordResponseHeaders records a response-headers phase.
+func (t *Trace) RecordResponseHeaders(in *PhaseInput, res *executor.ResponseHeaderExecutionResult, err error) {
+	if t == nil {
+		return
+	}
+	p := t.phase(executor.PhaseResponseHeaders, in, err)
+	if res != nil {
+		for _, r := range res.Results {
+			p.Policies = append(p.Policies, t.decision(r.PolicyName, r.PolicyVersion, r.Skipped, r.Error, r.ExecutionTime, r.Action))
+		}
+		finish(&p, res.ShortCircuited, res.TotalExecutionTime)
+	}
+	t.append(p)
+}
+
+// RecordResponseBody records a buffered response-body phase, including the one run
+// inline for responses without a body.
+func (t *Trace) RecordResponseBody(in *PhaseInput, res *executor.ResponseExecutionResult, err error) {
+	if t == nil {
+		return
+	}
+	p := t.phase(executor.PhaseResponseBody, in, err)
+	if res != nil {
+		for _, r := range res.Results {
+			p.Policies = append(p.Policies, t.decision(r.PolicyName, r.PolicyVersion, r.Skipped, r.Error, r.ExecutionTime, r.Action))
+		}
+		finish(&p, res.ShortCircuited, res.TotalExecutionTime)
+	}
+	t.append(p)
+}
+
+// RecordResponseBodyChunk records one flushed chunk of a streamed response body.
+func (t *Trace) RecordResponseBodyChunk(in *PhaseInput, res *executor.StreamingResponseExecutionResult, err error) {
+	if t == nil {
+		return
+	}
+	p := t.phase(executor.PhaseResponseBodyChunk, in, err)
+	if res != nil {
+		for _, r := range res.Results {
+			p.Policies = append(p.Policies, t.decision(r.PolicyName, r.PolicyVersion, r.Skipped, r.Error, r.ExecutionTime, r.Action))
+		}
+		finish(&p, false, res.TotalExecutionTime)
+		p.StreamTerminated = res.StreamTerminated
+	}
+	t.append(p)
+}
@@ -0,0 +1,419 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package debugcapture records the decisions of a policy chain for individual
+// requests. An operator starts a capture session through the admin API; while the
+// session is active, sampled requests that match its route key or request header
+// have every policy's input and output recorded per phase.
+package debugcapture
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+const (
+	// DefaultDuration is how long a session captures when no duration is given.
+	DefaultDuration = 5 * time.Minute
+	// MaxDuration bounds how long a session may capture.
+	MaxDuration = 30 * time.Minute
+	// DefaultMaxRequests is the number of requests a session captures when no limit is given.
+	DefaultMaxRequests = 50
+	// MaxRequests bounds the number of requests a session may capture.
+	MaxRequests = 1000
+	// MaxBodyBytes bounds the body bytes recorded per body snapshot.
+	MaxBodyBytes = 64 * 1024
+
+	// maxSessions bounds the number of sessions kept, active or finished.
+	maxSessions = 16
+	// maxPhasesPerRequest bounds the phases recorded for one request; long
+	// streams would otherwise record one phase per flushed chunk.
+	maxPhasesPerRequest = 64
+	// retention is how long a finished session stays retrievable.
+	retention = time.Hour
+)
+
+// Session states reported in SessionInfo.Status.
+const (
+	StatusActive   = "active"
+	StatusExpired  = "expired"
+	StatusStopped  = "stopped"
+	StatusComplete = "complete"
+)
+
+var (
+	// ErrTooManySessions is returned by Start when the session limit is reached.
+	ErrTooManySessions = errors.New("too many debug capture sessions")
+	// ErrSessionNotFound is returned when no session has the given ID.
+	ErrSessionNotFound = errors.New("debug capture session not found")
+)
+
+// HeaderMatch selects requests carrying a header. An empty Value matches any value.
+type HeaderMatch struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+// SessionConfig describes which requests a session captures and for how long.
+type SessionConfig struct {
+	// RouteKey restricts capture to one route. Empty matches every route.
+	RouteKey string
+	// Header restricts capture to requests carrying the header. Nil matches every request.
+	Header *HeaderMatch
+	// SampleRate is the fraction of matching requests captured, in (0, 1]. Zero means 1.
+	SampleRate float64
+	// Duration is how long the session captures. Zero means DefaultDuration.
+	Duration time.Duration
+	// MaxRequests is the number of requests captured before the session completes.
+	// Zero means DefaultMaxRequests.
+	MaxRequests int
+	// MaxBodyBytes is the number of body bytes recorded per body snapshot.
+	// Zero records body sizes only.
+	MaxBodyBytes int
+}
+
+// normalize applies defaults and validates the configuration.
+func (c *SessionConfig) normalize() error {
+	if c.Header != nil {
+		c.Header.Name = strings.ToLower(strings.TrimSpace(c.Header.Name))
+		if c.Header.Name == "" {
+			return errors.New("header.name must not be empty")
+		}
+	}
+	if c.RouteKey == "" && c.Header == nil {
+		return errors.New("a route_key or a header match is required")
+	}
+	if c.SampleRate == 0 {
+		c.SampleRate = 1
+	}
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("sample_rate must be in (0, 1], got %v", c.SampleRate)
+	}
+	if c.Duration == 0 {
+		c.Duration = DefaultDuration
+	}
+	if c.Duration < 0 || c.Duration > MaxDuration {
+		return fmt.Errorf("duration must be positive and at most %s, got %s", MaxDuration, c.Duration)
+	}
+	if c.MaxRequests == 0 {
+		c.MaxRequests = DefaultMaxRequests
+	}
+	if c.MaxRequests < 0 || c.MaxRequests > MaxRequests {
+		return fmt.Errorf("max_requests must be between 1 and %d, got %d", MaxRequests, c.MaxRequests)
+	}
+	if c.MaxBodyBytes < 0 || c.MaxBodyBytes > MaxBodyBytes {
+		return fmt.Errorf("max_body_bytes must be between 0 and %d, got %d", MaxBodyBytes, c.MaxBodyBytes)
+	}
+	return nil
+}
+
+// SessionInfo describes a session in admin API responses.
+type SessionInfo struct {
+	ID               string       `json:"id"`
+	Status           string       `json:"status"`
+	RouteKey         string       `json:"route_key,omitempty"`
+	Header           *HeaderMatch `json:"header,omitempty"`
+	SampleRate       float64      `json:"sample_rate"`
+	MaxRequests      int          `json:"max_requests"`
+	MaxBodyBytes     int          `json:"max_body_bytes"`
+	CreatedAt        time.Time    `json:"created_at"`
+	ExpiresAt        time.Time    `json:"expires_at"`
+	CapturedRequests int          `json:"captured_requests"`
+}
+
+// Capture is a session together with the requests it captured.
+type Capture struct {
+	Session  SessionInfo    `json:"session"`
+	Requests []RequestTrace `json:"requests"`
+}
+
+type session struct {
+	id        string
+	cfg       SessionConfig
+	createdAt time.Time
+	expiresAt time.Time
+	stoppedAt time.Time
+	traces    []*Trace
+}
+
+func (s *session) status(now time.Time) string {
+	switch {
+	case !s.stoppedAt.IsZero():
+		return StatusStopped
+	case len(s.traces) >= s.cfg.MaxRequests:
+		return StatusComplete
+	case !now.Before(s.expiresAt):
+		return StatusExpired
+	default:
+		return StatusActive
+	}
+}
+
+// finishedAt reports when the session stopped capturing, or the zero time if it still captures.
+func (s *session) finishedAt(now time.Time) time.Time {
+	switch s.status(now) {
+	case StatusStopped:
+		return s.stoppedAt
+	case StatusActive:
+		return time.Time{}
+	case StatusComplete:
+		return s.traces[len(s.traces)-1].started
+	}
+	return s.expiresAt
+}
+
+func (s *session) matches(routeKey string, headers *policy.Headers) bool {
+	if s.cfg.RouteKey != "" && s.cfg.RouteKey != routeKey {
+		return false
+	}
+	if s.cfg.Header == nil {
+		return true
+	}
+	for _, v := range headers.Get(s.cfg.Header.Name) {
+		if s.cfg.Header.Value == "" || v == s.cfg.Header.Value {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *session) info(now time.Time) SessionInfo {
+	return SessionInfo{
+		ID:               s.id,
+		Status:           s.status(now),
+		RouteKey:         s.cfg.RouteKey,
+		Header:           s.cfg.Header,
+		SampleRate:       s.cfg.SampleRate,
+		MaxRequests:      s.cfg.MaxRequests,
+		MaxBodyBytes:     s.cfg.MaxBodyBytes,
+		CreatedAt:        s.createdAt,
+		ExpiresAt:        s.expiresAt,
+		CapturedRequests: len(s.traces),
+	}
+}
+
+// Recorder holds capture sessions and hands out a Trace for each captured request.
+// It is safe for concurrent use. When no session is active, Begin costs one atomic load.
+type Recorder struct {
+	active atomic.Int32
+
+	mu       sync.Mutex
+	sessions []*session
+
+	now    func() time.Time
+	sample func() float64
+}
+
+// NewRecorder creates a Recorder with no sessions.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		now:    time.Now,
+		sample: rand.Float64,
+	}
+}
+
+// Start validates cfg and starts a new session.
+func (r *Recorder) Start(cfg SessionConfig) (SessionInfo, error) {
+	if cfg.Header != nil {
+		h := *cfg.Header
+		cfg.Header = &h
+	}
+	if err := cfg.normalize(); err != nil {
+		return SessionInfo{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.evictLocked(now)
+	if len(r.sessions) >= maxSessions {
+		return SessionInfo{}, ErrTooManySessions
+	}
+
+	s := &session{
+		id:        uuid.New().String(),
+		cfg:       cfg,
+		createdAt: now,
+		expiresAt: now.Add(cfg.Duration),
+	}
+	r.sessions = append(r.sessions, s)
+	r.refreshActiveLocked(now)
+	return s.info(now), nil
+}
+
+// Stop ends capture for a session and keeps what it captured retrievable.
+func (r *Recorder) Stop(id string) (SessionInfo, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	s := r.findLocked(id)
+	if s == nil {
+		return SessionInfo{}, ErrSessionNotFound
+	}
+	if s.stoppedAt.IsZero() && s.status(now) == StatusActive {
+		s.stoppedAt = now
+	}
+	r.refreshActiveLocked(now)
+	return s.info(now), nil
+}
+
+// Delete removes a session and everything it captured.
+func (r *Recorder) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, s := range r.sessions {
+		if s.id == id {
+			r.sessions = append(r.sessions[:i], r.sessions[i+1:]...)
+			r.refreshActiveLocked(r.now())
+			return nil
+		}
+	}
+	return ErrSessionNotFound
+}
+
+// List describes every retained session, oldest first.
+func (r *Recorder) List() []SessionInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.evictLocked(now)
+	infos := make([]SessionInfo, 0, len(r.sessions))
+	for _, s := range r.sessions {
+		infos = append(infos, s.info(now))
+	}
+	return infos
+}
+
+// Get returns a snapshot of a session and the requests it captured.
+func (r *Recorder) Get(id string) (*Capture, error) {
+	r.mu.Lock()
+	s := r.findLocked(id)
+	if s == nil {
+		r.mu.Unlock()
+		return nil, ErrSessionNotFound
+	}
+	info := s.info(r.now())
+	traces := append([]*Trace(nil), s.traces...)
+	r.mu.Unlock()
+
+	capture := &Capture{Session: info, Requests: make([]RequestTrace, 0, len(traces))}
+	for _, t := range traces {
+		capture.Requests = append(capture.Requests, t.snapshot())
+	}
+	return capture, nil
+}
+
+// Begin returns a Trace for the request when an active session matches it and
+// samples it, or nil otherwise. A request is captured by at most one session, the
+// oldest one that matches. specs is the request's policy chain.
+func (r *Recorder) Begin(routeKey, requestID string, headers *policy.Headers, specs []policy.PolicySpec) *Trace {
+	if r == nil || r.active.Load() == 0 {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	defer r.refreshActiveLocked(now)
+	for _, s := range r.sessions {
+		if s.status(now) != StatusActive || !s.matches(routeKey, headers) {
+			continue
+		}
+		if s.cfg.SampleRate < 1 && r.sample() >= s.cfg.SampleRate {
+			return nil
+		}
+		t := newTrace(s.cfg.MaxBodyBytes, routeKey, requestID, now, specs)
+		s.traces = append(s.traces, t)
+		return t
+	}
+	return nil
+}
+
+func (r *Recorder) findLocked(id string) *session {
+	for _, s := range r.sessions {
+		if s.id == id {
+			return s
+		}
+	}
+	return nil
+}
+
+// refreshActiveLocked recounts the sessions that still capture so Begin can skip the lock.
+func (r *Recorder) refreshActiveLocked(now time.Time) {
+	var n int32
+	for _, s := range r.sessions {
+		if s.status(now) == StatusActive {
+			n++
+		}
+	}
+	r.active.Store(n)
+}
+
+// evictLocked drops sessions that finished more than the retention period ago.
+func (r *Recorder) evictLocked(now time.Time) {
+	kept := r.sessions[:0]
+	for _, s := range r.sessions {
+		if end := s.finishedAt(now); !end.IsZero() && now.Sub(end) > retention {
+			continue
+		}
+		kept = append(kept, s)
+	}
+	clear(r.sessions[len(kept):])
+	r.sessions = kept
+}
+
+// chainPolicies describes the policy chain of a captured request. Parameters are
+// marshalled up front so the capture never aliases the live chain configuration.
+func chainPolicies(specs []policy.PolicySpec) []ChainPolicy {
+	out := make([]ChainPolicy, 0, len(specs))
+	for _, spec := range specs {
+		cp := ChainPolicy{
+			Name:    spec.Name,
+			Version: spec.Version,
+			Enabled: spec.Enabled,
+			OnError: string(spec.OnError),
+		}
+		if spec.ExecutionCondition != nil {
+			cp.Condition = *spec.ExecutionCondition
+		}
+		if spec.Timeout > 0 {
+			cp.Timeout = spec.Timeout.String()
+		}
+		if len(spec.Parameters.Raw) > 0 {
+			if raw, err := json.Marshal(spec.Parameters.Raw); err == nil {
+				cp.Parameters = raw
+			}
+		}
+		out = append(out, cp)
+	}
+	return out
+}
@@ -0,0 +1,293 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package debugcapture
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+func newTestRecorder(now *time.Time) *Recorder {
+	r := NewRecorder()
+	r.now = func() time.Time { return *now }
+	return r
+}
+
+func headers(kv ...string) *policy.Headers {
+	values := map[string][]string{}
+	for i := 0; i+1 < len(kv); i += 2 {
+		values[kv[i]] = append(values[kv[i]], kv[i+1])
+	}
+	return policy.NewHeaders(values)
+}
+
+func TestSessionConfig_Validation(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  SessionConfig
+	}{
+		{"no selector", SessionConfig{}},
+		{"empty header name", SessionConfig{Header: &HeaderMatch{Name: " "}}},
+		{"sample rate above one", SessionConfig{RouteKey: "r", SampleRate: 1.5}},
+		{"negative sample rate", SessionConfig{RouteKey: "r", SampleRate: -0.1}},
+		{"duration too long", SessionConfig{RouteKey: "r", Duration: MaxDuration + time.Second}},
+		{"too many requests", SessionConfig{RouteKey: "r", MaxRequests: MaxRequests + 1}},
+		{"body limit too large", SessionConfig{RouteKey: "r", MaxBodyBytes: MaxBodyBytes + 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRecorder().Start(tt.cfg)
+			assert.Error(t, err)
+		})
+	}
+
+	info, err := NewRecorder().Start(SessionConfig{Header: &HeaderMatch{Name: "X-Debug"}})
+	require.NoError(t, err)
+	assert.Equal(t, StatusActive, info.Status)
+	assert.Equal(t, "x-debug", info.Header.Name)
+	assert.Equal(t, 1.0, info.SampleRate)
+	assert.Equal(t, DefaultMaxRequests, info.MaxRequests)
+	assert.Equal(t, DefaultDuration, info.ExpiresAt.Sub(info.CreatedAt))
+}
+
+func TestRecorder_BeginMatching(t *testing.T) {
+	now := time.Now()
+	r := newTestRecorder(&now)
+	assert.Nil(t, r.Begin("route-a", "req", headers(), nil), "no session")
+
+	_, err := r.Start(SessionConfig{RouteKey: "route-a", Header: &HeaderMatch{Name: "x-debug", Value: "1"}})
+	require.NoError(t, err)
+
+	assert.Nil(t, r.Begin("route-b", "req", headers("x-debug", "1"), nil), "other route")
+	assert.Nil(t, r.Begin("route-a", "req", headers("x-debug", "2"), nil), "other header value")
+	assert.Nil(t, r.Begin("route-a", "req", headers(), nil), "header missing")
+	assert.NotNil(t, r.Begin("route-a", "req", headers("x-debug", "1"), nil))
+}
+
+func TestRecorder_Sampling(t *testing.T) {
+	now := time.Now()
+	r := newTestRecorder(&now)
+	_, err := r.Start(SessionConfig{RouteKey: "route", SampleRate: 0.5})
+	require.NoError(t, err)
+
+	r.sample = func() float64 { return 0.7 }
+	assert.Nil(t, r.Begin("route", "req", headers(), nil))
+	r.sample = func() float64 { return 0.2 }
+	assert.NotNil(t, r.Begin("route", "req", headers(), nil))
+}
+
+func TestRecorder_SessionLifecycle(t *testing.T) {
+	now := time.Now()
+	r := newTestRecorder(&now)
+
+	full, err := r.Start(SessionConfig{RouteKey: "full", MaxRequests: 1})
+	require.NoError(t, err)
+	require.NotNil(t, r.Begin("full", "req-1", headers(), nil))
+	assert.Nil(t, r.Begin("full", "req-2", headers(), nil))
+
+	expiring, err := r.Start(SessionConfig{RouteKey: "expiring", Duration: time.Minute})
+	require.NoError(t, err)
+	stopped, err := r.Start(SessionConfig{RouteKey: "stopped"})
+	require.NoError(t, err)
+	_, err = r.Stop(stopped.ID)
+	require.NoError(t, err)
+	assert.Nil(t, r.Begin("stopped", "req", headers(), nil))
+
+	now = now.Add(2 * time.Minute)
+	assert.Nil(t, r.Begin("expiring", "req", headers(), nil))
+	assert.Zero(t, r.active.Load(), "no session is still capturing")
+
+	statuses := map[string]string{}
+	for _, info := range r.List() {
+		statuses[info.ID] = info.Status
+	}
+	assert.Equal(t, map[string]string{
+		full.ID:     StatusComplete,
+		expiring.ID: StatusExpired,
+		stopped.ID:  StatusStopped,
+	}, statuses)
+
+	capture, err := r.Get(full.ID)
+	require.NoError(t, err)
+	require.Len(t, capture.Requests, 1)
+	assert.Equal(t, "req-1", capture.Requests[0].RequestID)
+
+	require.NoError(t, r.Delete(full.ID))
+	_, err = r.Get(full.ID)
+	assert.ErrorIs(t, err, ErrSessionNotFound)
+	assert.ErrorIs(t, r.Delete(full.ID), ErrSessionNotFound)
+	_, err = r.Stop(full.ID)
+	assert.ErrorIs(t, err, ErrSessionNotFound)
+
+	// Finished sessions are dropped once the retention period has passed.
+	now = now.Add(retention + time.Minute)
+	assert.Empty(t, r.List())
+}
+
+func TestRecorder_SessionLimit(t *testing.T) {
+	now := time.Now()
+	r := newTestRecorder(&now)
+	for i := 0; i < maxSessions; i++ {
+		_, err := r.Start(SessionConfig{RouteKey: "route", Duration: time.Minute})
+		require.NoError(t, err)
+	}
+	_, err := r.Start(SessionConfig{RouteKey: "route"})
+	assert.ErrorIs(t, err, ErrTooManySessions)
+
+	now = now.Add(time.Minute + retention + time.Second)
+	_, err = r.Start(SessionConfig{RouteKey: "route"})
+	assert.NoError(t, err)
+}
+
+func TestTrace_RecordsDecisions(t *testing.T) {
+	now := time.Now()
+	r := newTestRecorder(&now)
+	session, err := r.Start(SessionConfig{RouteKey: "route", MaxBodyBytes: 4})
+	require.NoError(t, err)
+
+	cond := "request.method == 'POST'"
+	specs := []policy.PolicySpec{
+		{Name: "auth", Version: "v1", Enabled: true, ExecutionCondition: &cond, Parameters: policy.PolicyParameters{Raw: map[string]interface{}{"realm": "x"}}},
+		{Name: "off", Version: "v1", Enabled: false},
+		{Name: "flaky", Version: "v1", Enabled: true, OnError: policy.OnErrorSkip},
+		{Name: "deny", Version: "v1", Enabled: true},
+	}
+	trace := r.Begin("route", "req-1", headers("x-in", "1"), specs)
+	require.NotNil(t, trace)
+
+	in := trace.Input("POST", "/pets", "example.com", 0, headers("x-in", "1"), []byte("hello world"), true, true)
+	trace.RecordRequestBody(in, &executor.RequestExecutionResult{
+		Results: []executor.RequestPolicyResult{
+			{PolicyName: "auth", PolicyVersion: "v1", Skipped: true},
+			{PolicyName: "off", PolicyVersion: "v1", Skipped: true},
+			{PolicyName: "flaky", PolicyVersion: "v1", Skipped: true, Error: errors.New("boom")},
+			{PolicyName: "deny", PolicyVersion: "v1", ExecutionTime: 3 * time.Millisecond, Action: policy.ImmediateResponse{StatusCode: 403, Body: []byte("forbidden")}},
+		},
+		ShortCircuited:     true,
+		TotalExecutionTime: 5 * time.Millisecond,
+	}, nil)
+	trace.RecordResponseHeaders(nil, nil, &executor.PolicyFailureError{
+		PolicyName: "deny", PolicyVersion: "v1", Phase: executor.PhaseResponseHeaders,
+		Reason: executor.FailureReasonPanic, Cause: errors.New("oops"),
+	})
+	trace.Finish("completed", 403, 7*time.Millisecond)
+
+	capture, err := r.Get(session.ID)
+	require.NoError(t, err)
+	require.Len(t, capture.Requests, 1)
+	got := capture.Requests[0]
+	assert.True(t, got.Completed)
+	assert.Equal(t, 403, got.ResponseStatus)
+	assert.Equal(t, cond, got.Chain[0].Condition)
+	assert.JSONEq(t, `{"realm":"x"}`, string(got.Chain[0].Parameters))
+	require.Len(t, got.Phases, 2)
+
+	body := got.Phases[0]
+	assert.Equal(t, executor.PhaseRequestBody, body.Phase)
+	assert.Equal(t, &BodySnapshot{Size: 11, Content: "hell", Truncated: true, EndOfStream: true}, body.Input.Body)
+	assert.Equal(t, int64(5000), body.DurationMicros)
+	assert.True(t, body.ShortCircuited)
+	assert.Equal(t, "deny", body.ShortCircuitedBy)
+	require.Len(t, body.Policies, 4)
+	assert.Equal(t, SkipReasonConditionNotMet, body.Policies[0].SkipReason)
+	assert.Equal(t, SkipReasonDisabled, body.Policies[1].SkipReason)
+	assert.Equal(t, SkipReasonError, body.Policies[2].SkipReason)
+	assert.Equal(t, "boom", body.Policies[2].Error)
+	deny := body.Policies[3]
+	assert.False(t, deny.Skipped)
+	assert.Equal(t, "ImmediateResponse", deny.Action)
+	assert.Equal(t, int64(3000), deny.DurationMicros)
+	require.NotNil(t, deny.ImmediateResponse)
+	assert.Equal(t, 403, deny.ImmediateResponse.StatusCode)
+	assert.Equal(t, "forb", deny.ImmediateResponse.Body.Content)
+
+	failed := got.Phases[1]
+	assert.Contains(t, failed.Error, "oops")
+	require.Len(t, failed.Policies, 1)
+	assert.Equal(t, "deny", failed.Policies[0].Name)
+	assert.Equal(t, "panic: oops", failed.Policies[0].Error)
+}
+
+func TestTrace_MasksCredentialHeaders(t *testing.T) {
+	now := time.Now()
+	r := newTestRecorder(&now)
+	session, err := r.Start(SessionConfig{RouteKey: "route"})
+	require.NoError(t, err)
+	specs := []policy.PolicySpec{
+		{Name: "upstream-auth", Version: "v1", Enabled: true},
+		{Name: "deny", Version: "v1", Enabled: true},
+	}
+	trace := r.Begin("route", "req-1", headers(), specs)
+	require.NotNil(t, trace)
+
+	in := trace.Input("GET", "/pets", "example.com", 0,
+		headers("authorization", "Bearer secret", "cookie", "a=1", "cookie", "b=2", "x-api-key", "k", "accept", "*/*"), nil, false, false)
+	trace.RecordRequestHeaders(in, &executor.RequestHeaderExecutionResult{
+		Results: []executor.RequestHeaderPolicyResult{
+			{PolicyName: "upstream-auth", PolicyVersion: "v1", Action: policy.UpstreamRequestHeaderModifications{
+				HeadersToSet: map[string]string{"Authorization": "Basic c2VjcmV0", "x-trace": "1"},
+			}},
+			{PolicyName: "deny", PolicyVersion: "v1", Action: policy.ImmediateResponse{
+				StatusCode: 401, Headers: map[string]string{"set-cookie": "session=s", "www-authenticate": "Bearer"},
+			}},
+		},
+	}, nil)
+
+	capture, err := r.Get(session.ID)
+	require.NoError(t, err)
+	phase := capture.Requests[0].Phases[0]
+	assert.Equal(t, map[string][]string{
+		"authorization": {maskedValue},
+		"cookie":        {maskedValue, maskedValue},
+		"x-api-key":     {maskedValue},
+		"accept":        {"*/*"},
+	}, phase.Input.Headers)
+	assert.Equal(t, map[string]string{"Authorization": maskedValue, "x-trace": "1"}, phase.Policies[0].Mutations.HeadersToSet)
+	assert.Equal(t, map[string]string{"set-cookie": maskedValue, "www-authenticate": "Bearer"}, phase.Policies[1].ImmediateResponse.Headers)
+}
+
+func TestTrace_NilIsNoOp(t *testing.T) {
+	var trace *Trace
+	assert.Nil(t, trace.Input("GET", "/", "", 0, headers(), nil, false, false))
+	trace.RecordRequestHeaders(nil, &executor.RequestHeaderExecutionResult{}, nil)
+	trace.Finish("completed", 200, time.Second)
+}
+
+func TestTrace_DropsPhasesBeyondLimit(t *testing.T) {
+	now := time.Now()
+	r := newTestRecorder(&now)
+	session, err := r.Start(SessionConfig{RouteKey: "route"})
+	require.NoError(t, err)
+	trace := r.Begin("route", "req", headers(), nil)
+	for i := 0; i < maxPhasesPerRequest+3; i++ {
+		trace.RecordResponseBodyChunk(nil, &executor.StreamingResponseExecutionResult{}, nil)
+	}
+
+	capture, err := r.Get(session.ID)
+	require.NoError(t, err)
+	assert.Len(t, capture.Requests[0].Phases, maxPhasesPerRequest)
+	assert.Equal(t, 3, capture.Requests[0].DroppedPhases)
+}
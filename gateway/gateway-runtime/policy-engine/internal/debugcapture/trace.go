@@ -0,0 +1,412 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package debugcapture
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// Reasons reported in PolicyDecision.SkipReason.
+const (
+	SkipReasonDisabled        = "disabled"
+	SkipReasonConditionNotMet = "condition_not_met"
+	SkipReasonError           = "error"
+)
+
+// maskedValue replaces the values of credential headers in captured traces.
+const maskedValue = "[masked]"
+
+// credentialHeaders carry caller or upstream credentials. Their values are masked in every
+// capture, in addition to the kernel's sensitive-value redaction, which only covers secrets
+// that are part of the deployed configuration.
+var credentialHeaders = map[string]struct{}{
+	"authorization":        {},
+	"proxy-authorization":  {},
+	"cookie":               {},
+	"set-cookie":           {},
+	"x-api-key":            {},
+	"api-key":              {},
+	"apikey":               {},
+	"x-auth-token":         {},
+	"x-access-token":       {},
+	"x-amz-security-token": {},
+}
+
+func isCredentialHeader(name string) bool {
+	_, ok := credentialHeaders[strings.ToLower(name)]
+	return ok
+}
+
+// maskHeaders masks credential header values in place and returns headers.
+func maskHeaders(headers map[string][]string) map[string][]string {
+	for name, values := range headers {
+		if isCredentialHeader(name) {
+			masked := make([]string, len(values))
+			for i := range masked {
+				masked[i] = maskedValue
+			}
+			headers[name] = masked
+		}
+	}
+	return headers
+}
+
+// maskHeaderValues is maskHeaders for single-valued header maps.
+func maskHeaderValues(headers map[string]string) map[string]string {
+	for name := range headers {
+		if isCredentialHeader(name) {
+			headers[name] = maskedValue
+		}
+	}
+	return headers
+}
+
+// ChainPolicy describes one policy of a captured request's chain.
+type ChainPolicy struct {
+	Name       string          `json:"name"`
+	Version    string          `json:"version"`
+	Enabled    bool            `json:"enabled"`
+	Condition  string          `json:"condition,omitempty"`
+	Timeout    string          `json:"timeout,omitempty"`
+	OnError    string          `json:"on_error,omitempty"`
+	Parameters json.RawMessage `json:"parameters,omitempty"`
+}
+
+// RequestTrace is everything captured for one request.
+type RequestTrace struct {
+	RequestID      string        `json:"request_id"`
+	RouteKey       string        `json:"route_key"`
+	StartedAt      time.Time     `json:"started_at"`
+	Completed      bool          `json:"completed"`
+	EndReason      string        `json:"end_reason,omitempty"`
+	ResponseStatus int           `json:"response_status,omitempty"`
+	DurationMicros int64         `json:"duration_us,omitempty"`
+	Chain          []ChainPolicy `json:"chain"`
+	Phases         []PhaseTrace  `json:"phases"`
+	DroppedPhases  int           `json:"dropped_phases,omitempty"`
+}
+
+// PhaseTrace is one execution of the policy chain: its input, each policy's
+// decision and how the phase ended.
+type PhaseTrace struct {
+	Phase            string           `json:"phase"`
+	DurationMicros   int64            `json:"duration_us"`
+	Input            *PhaseInput      `json:"input,omitempty"`
+	Policies         []PolicyDecision `json:"policies"`
+	ShortCircuited   bool             `json:"short_circuited,omitempty"`
+	ShortCircuitedBy string           `json:"short_circuited_by,omitempty"`
+	StreamTerminated bool             `json:"stream_terminated,omitempty"`
+	Error            string           `json:"error,omitempty"`
+}
+
+// PhaseInput is the request or response state the chain received in a phase.
+type PhaseInput struct {
+	Method    string              `json:"method,omitempty"`
+	Path      string              `json:"path,omitempty"`
+	Authority string              `json:"authority,omitempty"`
+	Status    int                 `json:"status,omitempty"`
+	Headers   map[string][]string `json:"headers,omitempty"`
+	Body      *BodySnapshot       `json:"body,omitempty"`
+}
+
+// BodySnapshot records a body or body chunk, truncated to the session's MaxBodyBytes.
+type BodySnapshot struct {
+	Size        int    `json:"size"`
+	Content     string `json:"content,omitempty"`
+	Truncated   bool   `json:"truncated,omitempty"`
+	EndOfStream bool   `json:"end_of_stream,omitempty"`
+}
+
+// PolicyDecision is one policy's outcome in a phase.
+type PolicyDecision struct {
+	Name              string                   `json:"name"`
+	Version           string                   `json:"version"`
+	Skipped           bool                     `json:"skipped,omitempty"`
+	SkipReason        string                   `json:"skip_reason,omitempty"`
+	Error             string                   `json:"error,omitempty"`
+	DurationMicros    int64                    `json:"duration_us"`
+	Action            string                   `json:"action,omitempty"`
+	Mutations         *Mutations               `json:"mutations,omitempty"`
+	ImmediateResponse *ImmediateResponseRecord `json:"immediate_response,omitempty"`
+}
+
+// Mutations are the changes a policy's action asks for. Unset fields are left unchanged.
+type Mutations struct {
+	HeadersToSet            map[string]string   `json:"headers_to_set,omitempty"`
+	HeadersToRemove         []string            `json:"headers_to_remove,omitempty"`
+	Path                    *string             `json:"path,omitempty"`
+	Host                    *string             `json:"host,omitempty"`
+	Method                  *string             `json:"method,omitempty"`
+	UpstreamName            *string             `json:"upstream_name,omitempty"`
+	QueryParametersToAdd    map[string][]string `json:"query_parameters_to_add,omitempty"`
+	QueryParametersToRemove []string            `json:"query_parameters_to_remove,omitempty"`
+	StatusCode              *int                `json:"status_code,omitempty"`
+	Body                    *BodySnapshot       `json:"body,omitempty"`
+}
+
+// ImmediateResponseRecord is the response a short-circuiting policy sends.
+type ImmediateResponseRecord struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       *BodySnapshot     `json:"body,omitempty"`
+}
+
+// Trace records one request. The kernel records phases from the request's stream
+// goroutine while the admin API reads snapshots, so access is serialized. All
+// recording methods are no-ops on a nil Trace.
+type Trace struct {
+	maxBody int
+	started time.Time
+	enabled map[string]bool
+
+	mu   sync.Mutex
+	data RequestTrace
+}
+
+func newTrace(maxBody int, routeKey, requestID string, now time.Time, specs []policy.PolicySpec) *Trace {
+	enabled := make(map[string]bool, len(specs))
+	for _, spec := range specs {
+		key := specKey(spec.Name, spec.Version)
+		if _, ok := enabled[key]; !ok {
+			enabled[key] = spec.Enabled
+		}
+	}
+	return &Trace{
+		maxBody: maxBody,
+		started: now,
+		enabled: enabled,
+		data: RequestTrace{
+			RequestID: requestID,
+			RouteKey:  routeKey,
+			StartedAt: now,
+			Chain:     chainPolicies(specs),
+		},
+	}
+}
+
+func specKey(name, version string) string {
+	return name + ":" + version
+}
+
+// Input snapshots the state a phase is about to run with. It copies the headers, masking
+// credential headers, so it must be taken before the chain runs. Returns nil on a nil Trace.
+func (t *Trace) Input(method, path, authority string, status int, headers *policy.Headers, body []byte, bodyPresent, endOfStream bool) *PhaseInput {
+	if t == nil {
+		return nil
+	}
+	in := &PhaseInput{
+		Method:    method,
+		Path:      path,
+		Authority: authority,
+		Status:    status,
+		Headers:   maskHeaders(headers.GetAll()),
+	}
+	if bodyPresent {
+		in.Body = t.body(body, endOfStream)
+	}
+	return in
+}
+
+// Finish records how the request's stream ended.
+func (t *Trace) Finish(reason string, status int, duration time.Duration) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.data.Completed = true
+	t.data.EndReason = reason
+	t.data.ResponseStatus = status
+	t.data.DurationMicros = duration.Microseconds()
+}
+
+func (t *Trace) snapshot() RequestTrace {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	out := t.data
+	out.Phases = append([]PhaseTrace(nil), t.data.Phases...)
+	return out
+}
+
+func (t *Trace) append(p PhaseTrace) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.data.Phases) >= maxPhasesPerRequest {
+		t.data.DroppedPhases++
+		return
+	}
+	t.data.Phases = append(t.data.Phases, p)
+}
+
+func (t *Trace) body(content []byte, endOfStream bool) *BodySnapshot {
+	b := &BodySnapshot{Size: len(content), EndOfStream: endOfStream}
+	if n := min(len(content), t.maxBody); n > 0 {
+		b.Content = string(content[:n])
+		b.Truncated = n < len(content)
+	}
+	return b
+}
+
+// phase starts a PhaseTrace. A chain error aborts the phase before any result is
+// returned, so the failing policy is the only decision recorded for it.
+func (t *Trace) phase(name string, in *PhaseInput, err error) PhaseTrace {
+	p := PhaseTrace{Phase: name, Input: in, Policies: []PolicyDecision{}}
+	if err == nil {
+		return p
+	}
+	p.Error = err.Error()
+	var failure *executor.PolicyFailureError
+	if errors.As(err, &failure) {
+		p.Policies = append(p.Policies, PolicyDecision{
+			Name:    failure.PolicyName,
+			Version: failure.PolicyVersion,
+			Error:   fmt.Sprintf("%s: %v", failure.Reason, failure.Cause),
+		})
+	}
+	return p
+}
+
+func (t *Trace) decision(name, version string, skipped bool, err error, d time.Duration, action any) PolicyDecision {
+	dec := PolicyDecision{
+		Name:           name,
+		Version:        version,
+		Skipped:        skipped,
+		DurationMicros: d.Microseconds(),
+	}
+	if err != nil {
+		dec.Error = err.Error()
+	}
+	if skipped {
+		switch {
+		case err != nil:
+			dec.SkipReason = SkipReasonError
+		case !t.enabled[specKey(name, version)]:
+			dec.SkipReason = SkipReasonDisabled
+		default:
+			dec.SkipReason = SkipReasonConditionNotMet
+		}
+	}
+	t.describeAction(&dec, action)
+	return dec
+}
+
+// finish sets the phase timing and names the policy that short-circuited it.
+func finish(p *PhaseTrace, shortCircuited bool, total time.Duration) {
+	p.DurationMicros = total.Microseconds()
+	p.ShortCircuited = shortCircuited
+	if !shortCircuited {
+		return
+	}
+	for i := len(p.Policies) - 1; i >= 0; i-- {
+		if p.Policies[i].ImmediateResponse != nil {
+			p.ShortCircuitedBy = p.Policies[i].Name
+			return
+		}
+	}
+}
+
+func (t *Trace) describeAction(dec *PolicyDecision, action any) {
+	switch a := action.(type) {
+	case nil:
+	case policy.ImmediateResponse:
+		dec.Action = "ImmediateResponse"
+		dec.ImmediateResponse = &ImmediateResponseRecord{
+			StatusCode: a.StatusCode,
+			Headers:    maskHeaderValues(maps.Clone(a.Headers)),
+			Body:       t.body(a.Body, true),
+		}
+	case policy.UpstreamRequestHeaderModifications:
+		dec.Action = "UpstreamRequestHeaderModifications"
+		dec.Mutations = nonEmpty(&Mutations{
+			HeadersToSet:            maskHeaderValues(maps.Clone(a.HeadersToSet)),
+			HeadersToRemove:         slices.Clone(a.HeadersToRemove),
+			Path:                    a.Path,
+			Host:                    a.Host,
+			Method:                  a.Method,
+			UpstreamName:            a.UpstreamName,
+			QueryParametersToAdd:    maps.Clone(a.QueryParametersToAdd),
+			QueryParametersToRemove: slices.Clone(a.QueryParametersToRemove),
+		})
+	case policy.UpstreamRequestModifications:
+		dec.Action = "UpstreamRequestModifications"
+		dec.Mutations = nonEmpty(&Mutations{
+			HeadersToSet:            maskHeaderValues(maps.Clone(a.HeadersToSet)),
+			HeadersToRemove:         slices.Clone(a.HeadersToRemove),
+			Path:                    a.Path,
+			Host:                    a.Host,
+			Method:                  a.Method,
+			UpstreamName:            a.UpstreamName,
+			QueryParametersToAdd:    maps.Clone(a.QueryParametersToAdd),
+			QueryParametersToRemove: slices.Clone(a.QueryParametersToRemove),
+			Body:                    t.bodyMutation(a.Body),
+		})
+	case policy.DownstreamResponseHeaderModifications:
+		dec.Action = "DownstreamResponseHeaderModifications"
+		dec.Mutations = nonEmpty(&Mutations{
+			HeadersToSet:    maskHeaderValues(maps.Clone(a.HeadersToSet)),
+			HeadersToRemove: slices.Clone(a.HeadersToRemove),
+		})
+	case policy.DownstreamResponseModifications:
+		dec.Action = "DownstreamResponseModifications"
+		dec.Mutations = nonEmpty(&Mutations{
+			HeadersToSet:    maskHeaderValues(maps.Clone(a.HeadersToSet)),
+			HeadersToRemove: slices.Clone(a.HeadersToRemove),
+			StatusCode:      a.StatusCode,
+			Body:            t.bodyMutation(a.Body),
+		})
+	case policy.ForwardRequestChunk:
+		dec.Action = "ForwardRequestChunk"
+		dec.Mutations = nonEmpty(&Mutations{Body: t.bodyMutation(a.Body)})
+	case policy.ForwardResponseChunk:
+		dec.Action = "ForwardResponseChunk"
+		dec.Mutations = nonEmpty(&Mutations{Body: t.bodyMutation(a.Body)})
+	case policy.TerminateResponseChunk:
+		dec.Action = "TerminateResponseChunk"
+		dec.Mutations = &Mutations{Body: t.body(a.Body, true)}
+	default:
+		dec.Action = fmt.Sprintf("%T", action)
+	}
+}
+
+// bodyMutation snapshots a replacement body; nil means the body passes through unchanged.
+func (t *Trace) bodyMutation(body []byte) *BodySnapshot {
+	if body == nil {
+		return nil
+	}
+	return t.body(body, false)
+}
+
+func nonEmpty(m *Mutations) *Mutations {
+	if len(m.HeadersToSet) == 0 && len(m.HeadersToRemove) == 0 && m.Path == nil && m.Host == nil &&
+		m.Method == nil && m.UpstreamName == nil && len(m.QueryParametersToAdd) == 0 &&
+		len(m.QueryParametersToRemove) == 0 && m.StatusCode == nil && m.Body == nil {
+		return nil
+	}
+	return m
+}
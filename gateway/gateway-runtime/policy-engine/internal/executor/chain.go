@@ -43,6 +43,7 @@ type RequestHeaderPolicyResult struct {
 	PolicyName    string
 	PolicyVersion string
 	Action        policy.RequestHeaderAction
+	Error         error // set when the invocation failed and the policy is not fail-closed
 	ExecutionTime time.Duration
 	Skipped       bool // true if condition evaluated to false
 }
@@ -57,6 +58,8 @@ type RequestHeaderExecutionResult struct {
 
 // ExecuteRequestHeaderPolicies invokes each RequestHeaderPolicy in the chain.
 // Policies that do not implement RequestHeaderPolicy are skipped silently.
+// Consecutive policies whose mode declares RequestHeaderConcurrency run concurrently;
+// see policy.ConcurrencyMode for how their results are merged.
 func (c *ChainExecutor) ExecuteRequestHeaderPolicies(
 	ctx context.Context,
 	policyList []policy.Policy,
@@ -71,132 +74,269 @@ func (c *ChainExecutor) ExecuteRequestHeaderPolicies(
 		ShortCircuited: false,
 	}
 
-	for i, pol := range policyList {
-		spec := specs[i]
-		policyStartTime := time.Now()
-
-		_, span := c.tracer.Start(ctx, fmt.Sprintf(constants.SpanPolicyRequestFormat, spec.Name),
-			trace.WithSpanKind(trace.SpanKindInternal))
-		if span.IsRecording() {
-			span.SetAttributes(
-				attribute.String(constants.AttrPolicyName, spec.Name),
-				attribute.String(constants.AttrPolicyVersion, spec.Version),
-				attribute.Bool(constants.AttrPolicyEnabled, spec.Enabled),
-			)
-		}
+	// Concurrent policies are collected until the next sequential policy or the end of the chain
+	var group []*requestHeaderCall
 
+	for i, pol := range policyList {
 		headerPol, ok := pol.(policy.RequestHeaderPolicy)
 		if !ok {
-			span.End()
 			continue
 		}
-		if pol.Mode().RequestHeaderMode != policy.HeaderModeProcess {
-			span.End()
+		mode := pol.Mode()
+		if mode.RequestHeaderMode != policy.HeaderModeProcess {
 			continue
 		}
 
-		if !spec.Enabled {
-			if span.IsRecording() {
-				span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
+		concurrent := mode.RequestHeaderConcurrency.IsConcurrent()
+		if !concurrent && len(group) > 0 {
+			// The sequential policy's condition must see the group's mutations
+			stop, err := c.runRequestHeaderCalls(ctx, group, reqCtx, result, api, route)
+			group = nil
+			if err != nil {
+				return nil, err
+			}
+			if stop {
+				break
 			}
-			metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", "disabled").Inc()
-			span.End()
-			result.Results = append(result.Results, RequestHeaderPolicyResult{
-				PolicyName:    spec.Name,
-				PolicyVersion: spec.Version,
-				Skipped:       true,
-				ExecutionTime: time.Since(policyStartTime),
-			})
-			continue
 		}
 
-		// Evaluate execution condition if present and if chain has any CEL conditions
-		if hasExecutionConditions && spec.ExecutionCondition != nil && *spec.ExecutionCondition != "" {
-			if c.celEvaluator != nil {
-				conditionMet, err := c.celEvaluator.EvaluateRequestHeaderCondition(*spec.ExecutionCondition, reqCtx)
-				if err != nil {
-					if span.IsRecording() {
-						span.RecordError(err)
-						span.SetStatus(codes.Error, "condition evaluation failed")
-					}
-					span.End()
-					return nil, fmt.Errorf("condition evaluation failed for policy %s:%s: %w", spec.Name, spec.Version, err)
-				}
-				if !conditionMet {
-					if span.IsRecording() {
-						span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
-						span.SetAttributes(attribute.String(constants.AttrSkipReason, constants.AttrSkipReasonConditionNotMet))
-					}
-					metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", "condition_not_met").Inc()
-					span.End()
-					result.Results = append(result.Results, RequestHeaderPolicyResult{
-						PolicyName:    spec.Name,
-						PolicyVersion: spec.Version,
-						Skipped:       true,
-						ExecutionTime: time.Since(policyStartTime),
-					})
-					continue
-				}
-			}
+		call, err := c.prepareRequestHeaderCall(ctx, headerPol, reqCtx, specs[i], i, hasExecutionConditions)
+		if err != nil {
+			endRequestHeaderSpans(group)
+			return nil, err
+		}
+		if concurrent {
+			group = append(group, call)
+			continue
 		}
 
-		params, err := deepCopyParams(spec.Parameters.Raw)
+		stop, err := c.runRequestHeaderCalls(ctx, []*requestHeaderCall{call}, reqCtx, result, api, route)
 		if err != nil {
-			span.End()
-			return nil, fmt.Errorf("failed to clone parameters for policy %s:%s: %w", spec.Name, spec.Version, err)
+			return nil, err
+		}
+		if stop {
+			break
+		}
+	}
+
+	if len(group) > 0 {
+		if _, err := c.runRequestHeaderCalls(ctx, group, reqCtx, result, api, route); err != nil {
+			return nil, err
 		}
+	}
 
-		action := headerPol.OnRequestHeaders(ctx, reqCtx, params)
-		executionTime := time.Since(policyStartTime)
+	result.TotalExecutionTime = time.Since(startTime)
+	return result, nil
+}
 
-		// Apply header mutations to reqCtx so subsequent policies and CEL conditions see the mutated state
-		if mod, ok := action.(policy.UpstreamRequestHeaderModifications); ok {
-			internalHeaders := reqCtx.Headers.UnsafeInternalValues()
-			for k, v := range mod.HeadersToSet {
-				internalHeaders[strings.ToLower(k)] = []string{v}
-			}
-			for _, k := range mod.HeadersToRemove {
-				delete(internalHeaders, strings.ToLower(k))
-			}
-			if mod.Path != nil {
-				reqCtx.Path = *mod.Path
+// requestHeaderCall is one RequestHeaderPolicy invocation, prepared in chain order and run
+// either inline or together with the other policies of a concurrent group.
+type requestHeaderCall struct {
+	index   int
+	spec    policy.PolicySpec
+	pol     policy.RequestHeaderPolicy
+	params  map[string]interface{}
+	span    trace.Span
+	start   time.Time
+	skipped bool // disabled or condition not met; the policy is not invoked
+
+	action   policy.RequestHeaderAction
+	failure  *PolicyFailureError
+	duration time.Duration
+	shared   *policy.SharedContext // the policy's own shared context copy when run concurrently
+}
+
+// prepareRequestHeaderCall starts the policy span and evaluates the enabled flag and execution
+// condition against the current request.
+func (c *ChainExecutor) prepareRequestHeaderCall(
+	ctx context.Context,
+	headerPol policy.RequestHeaderPolicy,
+	reqCtx *policy.RequestHeaderContext,
+	spec policy.PolicySpec,
+	index int,
+	hasExecutionConditions bool,
+) (*requestHeaderCall, error) {
+	call := &requestHeaderCall{index: index, spec: spec, pol: headerPol, start: time.Now()}
+
+	_, call.span = c.tracer.Start(ctx, fmt.Sprintf(constants.SpanPolicyRequestFormat, spec.Name),
+		trace.WithSpanKind(trace.SpanKindInternal))
+	span := call.span
+	if span.IsRecording() {
+		span.SetAttributes(
+			attribute.String(constants.AttrPolicyName, spec.Name),
+			attribute.String(constants.AttrPolicyVersion, spec.Version),
+			attribute.Bool(constants.AttrPolicyEnabled, spec.Enabled),
+		)
+	}
+
+	if !spec.Enabled {
+		if span.IsRecording() {
+			span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
+		}
+		metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", "disabled").Inc()
+		call.skipped = true
+		return call, nil
+	}
+
+	// Evaluate execution condition if present and if chain has any CEL conditions
+	if hasExecutionConditions && spec.ExecutionCondition != nil && *spec.ExecutionCondition != "" {
+		if c.celEvaluator != nil {
+			conditionMet, err := c.celEvaluator.EvaluateRequestHeaderCondition(*spec.ExecutionCondition, reqCtx)
+			if err != nil {
+				if span.IsRecording() {
+					span.RecordError(err)
+					span.SetStatus(codes.Error, "condition evaluation failed")
+				}
+				span.End()
+				return nil, fmt.Errorf("condition evaluation failed for policy %s:%s: %w", spec.Name, spec.Version, err)
 			}
-			if mod.Method != nil {
-				reqCtx.Method = *mod.Method
+			if !conditionMet {
+				if span.IsRecording() {
+					span.SetAttributes(attribute.Bool(constants.AttrPolicySkipped, true))
+					span.SetAttributes(attribute.String(constants.AttrSkipReason, constants.AttrSkipReasonConditionNotMet))
+				}
+				metrics.PolicySkippedTotal.WithLabelValues(spec.Name, "", "", "condition_not_met").Inc()
+				call.skipped = true
+				return call, nil
 			}
 		}
+	}
 
-		metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
-		metrics.PolicyDurationSeconds.WithLabelValues(spec.Name, spec.Version, api, route).Observe(executionTime.Seconds())
+	params, err := deepCopyParams(spec.Parameters.Raw)
+	if err != nil {
+		span.End()
+		return nil, fmt.Errorf("failed to clone parameters for policy %s:%s: %w", spec.Name, spec.Version, err)
+	}
+	call.params = params
+	return call, nil
+}
 
-		if span.IsRecording() {
-			span.SetAttributes(attribute.Int64(constants.AttrPolicyExecutionTimeNS, executionTime.Nanoseconds()))
+// invoke runs the policy against reqCtx.
+func (call *requestHeaderCall) invoke(ctx context.Context, c *ChainExecutor, reqCtx *policy.RequestHeaderContext, route string) {
+	call.action, call.failure = invokePolicy(ctx, c, call.spec, call.index, PhaseRequestHeaders, route, reqCtx, func(ctx context.Context, reqCtx *policy.RequestHeaderContext) policy.RequestHeaderAction {
+		return call.pol.OnRequestHeaders(ctx, reqCtx, call.params)
+	})
+	call.duration = time.Since(call.start)
+}
+
+// runRequestHeaderCalls invokes the prepared calls, concurrently when there is more than one,
+// and records their outcomes in chain order. stop reports that the chain short-circuited.
+func (c *ChainExecutor) runRequestHeaderCalls(
+	ctx context.Context,
+	calls []*requestHeaderCall,
+	reqCtx *policy.RequestHeaderContext,
+	result *RequestHeaderExecutionResult,
+	api, route string,
+) (stop bool, err error) {
+	var snapshot *policy.SharedContext
+	if countRunnable(calls) > 1 {
+		snapshot = runConcurrently(ctx, c, calls, reqCtx, route)
+	} else {
+		for _, call := range calls {
+			if !call.skipped {
+				call.invoke(ctx, c, reqCtx, route)
+			}
 		}
+	}
+
+	for n, call := range calls {
+		if snapshot != nil && call.shared != nil {
+			mergeSharedContext(reqCtx.SharedContext, snapshot, call.shared)
+		}
+		stop, err := c.finishRequestHeaderCall(ctx, call, reqCtx, result, api, route)
+		if err != nil || stop {
+			// The remaining calls of a concurrent group ran, but their results are discarded
+			endRequestHeaderSpans(calls[n+1:])
+			return stop, err
+		}
+	}
+	return false, nil
+}
+
+// finishRequestHeaderCall applies the onError mode to a failed call, or the action of a
+// successful one to reqCtx, and appends the call's result.
+func (c *ChainExecutor) finishRequestHeaderCall(
+	ctx context.Context,
+	call *requestHeaderCall,
+	reqCtx *policy.RequestHeaderContext,
+	result *RequestHeaderExecutionResult,
+	api, route string,
+) (stop bool, err error) {
+	spec, span := call.spec, call.span
+	defer span.End()
 
+	if call.skipped {
 		result.Results = append(result.Results, RequestHeaderPolicyResult{
 			PolicyName:    spec.Name,
 			PolicyVersion: spec.Version,
-			Action:        action,
-			ExecutionTime: executionTime,
+			Skipped:       true,
+			ExecutionTime: time.Since(call.start),
 		})
+		return false, nil
+	}
 
-		if _, ok := action.(policy.ImmediateResponse); ok {
-			if span.IsRecording() {
-				span.SetAttributes(attribute.Bool(constants.AttrPolicyShortCircuit, true))
-			}
-			metrics.ShortCircuitsTotal.WithLabelValues("", spec.Name).Inc()
-			result.ShortCircuited = true
-			result.FinalAction = action
-			span.End()
-			break
+	if call.failure != nil {
+		skipped, err := c.handlePolicyFailure(ctx, span, spec, api, route, call.failure)
+		if err != nil {
+			return false, err
 		}
+		result.Results = append(result.Results, RequestHeaderPolicyResult{
+			PolicyName:    spec.Name,
+			PolicyVersion: spec.Version,
+			Error:         call.failure,
+			ExecutionTime: call.duration,
+			Skipped:       skipped,
+		})
+		return false, nil
+	}
 
-		result.FinalAction = action
-		span.End()
+	// Apply header mutations to reqCtx so subsequent policies and CEL conditions see the mutated state
+	if mod, ok := call.action.(policy.UpstreamRequestHeaderModifications); ok {
+		internalHeaders := reqCtx.Headers.UnsafeInternalValues()
+		for k, v := range mod.HeadersToSet {
+			internalHeaders[strings.ToLower(k)] = []string{v}
+		}
+		for _, k := range mod.HeadersToRemove {
+			delete(internalHeaders, strings.ToLower(k))
+		}
+		if mod.Path != nil {
+			reqCtx.Path = *mod.Path
+		}
+		if mod.Method != nil {
+			reqCtx.Method = *mod.Method
+		}
 	}
 
-	result.TotalExecutionTime = time.Since(startTime)
-	return result, nil
+	metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
+	metrics.PolicyDurationSeconds.WithLabelValues(spec.Name, spec.Version, api, route).Observe(call.duration.Seconds())
+
+	if span.IsRecording() {
+		span.SetAttributes(attribute.Int64(constants.AttrPolicyExecutionTimeNS, call.duration.Nanoseconds()))
+	}
+
+	result.Results = append(result.Results, RequestHeaderPolicyResult{
+		PolicyName:    spec.Name,
+		PolicyVersion: spec.Version,
+		Action:        call.action,
+		ExecutionTime: call.duration,
+	})
+	result.FinalAction = call.action
+
+	if _, ok := call.action.(policy.ImmediateResponse); ok {
+		if span.IsRecording() {
+			span.SetAttributes(attribute.Bool(constants.AttrPolicyShortCircuit, true))
+		}
+		metrics.ShortCircuitsTotal.WithLabelValues("", spec.Name).Inc()
+		result.ShortCircuited = true
+		return true, nil
+	}
+	return false, nil
+}
+
+func endRequestHeaderSpans(calls []*requestHeaderCall) {
+	for _, call := range calls {
+		call.span.End()
+	}
 }
 
 // ─── Request body phase ───────────────────────────────────────────────────────
@@ -206,6 +346,7 @@ type RequestPolicyResult struct {
 	PolicyName    string
 	PolicyVersion string
 	Action        policy.RequestAction
+	Error         error // set when the invocation failed and the policy is not fail-closed
 	ExecutionTime time.Duration
 	Skipped       bool // true if condition evaluated to false
 }
@@ -315,8 +456,25 @@ func (c *ChainExecutor) ExecuteRequestPolicies(ctx context.Context, policyList [
 		}
 
 		slog.Debug("[body] calling OnRequestBody", "policy", spec.Name, "version", spec.Version, "route", route)
-		action := rp.OnRequestBody(ctx, reqCtx, params)
+		action, failure := invokePolicy(ctx, c, spec, i, PhaseRequestBody, route, reqCtx, func(ctx context.Context, reqCtx *policy.RequestContext) policy.RequestAction {
+			return rp.OnRequestBody(ctx, reqCtx, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		if failure != nil {
+			skipped, err := c.handlePolicyFailure(ctx, span, spec, api, route, failure)
+			span.End()
+			if err != nil {
+				return nil, err
+			}
+			result.Results = append(result.Results, RequestPolicyResult{
+				PolicyName:    spec.Name,
+				PolicyVersion: spec.Version,
+				Error:         failure,
+				ExecutionTime: executionTime,
+				Skipped:       skipped,
+			})
+			continue
+		}
 
 		// Record policy execution metrics
 		metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
@@ -371,6 +529,7 @@ type ResponseHeaderPolicyResult struct {
 	PolicyName    string
 	PolicyVersion string
 	Action        policy.ResponseHeaderAction
+	Error         error // set when the invocation failed and the policy is not fail-closed
 	ExecutionTime time.Duration
 	Skipped       bool
 }
@@ -467,8 +626,25 @@ func (c *ChainExecutor) ExecuteResponseHeaderPolicies(
 			return nil, fmt.Errorf("failed to clone parameters for policy %s:%s: %w", spec.Name, spec.Version, err)
 		}
 
-		action := headerPol.OnResponseHeaders(ctx, respCtx, params)
+		action, failure := invokePolicy(ctx, c, spec, i, PhaseResponseHeaders, route, respCtx, func(ctx context.Context, respCtx *policy.ResponseHeaderContext) policy.ResponseHeaderAction {
+			return headerPol.OnResponseHeaders(ctx, respCtx, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		if failure != nil {
+			skipped, err := c.handlePolicyFailure(ctx, span, spec, api, route, failure)
+			span.End()
+			if err != nil {
+				return nil, err
+			}
+			result.Results = append(result.Results, ResponseHeaderPolicyResult{
+				PolicyName:    spec.Name,
+				PolicyVersion: spec.Version,
+				Error:         failure,
+				ExecutionTime: executionTime,
+				Skipped:       skipped,
+			})
+			continue
+		}
 
 		// Apply header mutations to respCtx so subsequent policies and CEL conditions see the mutated state
 		if mod, ok := action.(policy.DownstreamResponseHeaderModifications); ok {
@@ -632,8 +808,25 @@ func (c *ChainExecutor) ExecuteResponsePolicies(ctx context.Context, policyList
 		}
 
 		slog.Debug("[body] calling OnResponseBody", "policy", spec.Name, "version", spec.Version, "route", route)
-		action := rp.OnResponseBody(ctx, respCtx, params)
+		action, failure := invokePolicy(ctx, c, spec, i, PhaseResponseBody, route, respCtx, func(ctx context.Context, respCtx *policy.ResponseContext) policy.ResponseAction {
+			return rp.OnResponseBody(ctx, respCtx, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		if failure != nil {
+			skipped, err := c.handlePolicyFailure(ctx, span, spec, api, route, failure)
+			span.End()
+			if err != nil {
+				return nil, err
+			}
+			result.Results = append(result.Results, ResponsePolicyResult{
+				PolicyName:    spec.Name,
+				PolicyVersion: spec.Version,
+				Error:         failure,
+				ExecutionTime: executionTime,
+				Skipped:       skipped,
+			})
+			continue
+		}
 
 		// Record policy execution metrics
 		metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
@@ -687,6 +880,7 @@ type StreamingRequestPolicyResult struct {
 	PolicyName    string
 	PolicyVersion string
 	Action        policy.StreamingRequestAction
+	Error         error // set when the invocation failed and the policy is not fail-closed
 	ExecutionTime time.Duration
 	Skipped       bool
 }
@@ -789,8 +983,26 @@ func (c *ChainExecutor) ExecuteStreamingRequestPolicies(
 		}
 
 		slog.Debug("[streaming] calling OnRequestBodyChunk", "policy", spec.Name, "version", spec.Version, "route", route, "end_of_stream", currentChunk.EndOfStream)
-		action := streamingPol.OnRequestBodyChunk(ctx, reqCtx, currentChunk, params)
+		callChunk := currentChunk
+		action, failure := invokePolicy(ctx, c, spec, i, PhaseRequestBodyChunk, route, reqCtx, func(ctx context.Context, reqCtx *policy.RequestStreamContext) policy.StreamingRequestAction {
+			return streamingPol.OnRequestBodyChunk(ctx, reqCtx, callChunk, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		if failure != nil {
+			skipped, err := c.handlePolicyFailure(ctx, span, spec, api, route, failure)
+			span.End()
+			if err != nil {
+				return nil, err
+			}
+			result.Results = append(result.Results, StreamingRequestPolicyResult{
+				PolicyName:    spec.Name,
+				PolicyVersion: spec.Version,
+				Error:         failure,
+				ExecutionTime: executionTime,
+				Skipped:       skipped,
+			})
+			continue
+		}
 
 		metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
 		metrics.PolicyDurationSeconds.WithLabelValues(spec.Name, spec.Version, api, route).Observe(executionTime.Seconds())
@@ -830,6 +1042,7 @@ type StreamingResponsePolicyResult struct {
 	PolicyName    string
 	PolicyVersion string
 	Action        policy.StreamingResponseAction
+	Error         error // set when the invocation failed and the policy is not fail-closed
 	ExecutionTime time.Duration
 	Skipped       bool
 }
@@ -937,8 +1150,26 @@ func (c *ChainExecutor) ExecuteStreamingResponsePolicies(
 		}
 
 		slog.Debug("[streaming] calling OnResponseBodyChunk", "policy", spec.Name, "version", spec.Version, "route", route, "end_of_stream", currentChunk.EndOfStream)
-		action := streamingPol.OnResponseBodyChunk(ctx, respCtx, currentChunk, params)
+		callChunk := currentChunk
+		action, failure := invokePolicy(ctx, c, spec, i, PhaseResponseBodyChunk, route, respCtx, func(ctx context.Context, respCtx *policy.ResponseStreamContext) policy.StreamingResponseAction {
+			return streamingPol.OnResponseBodyChunk(ctx, respCtx, callChunk, params)
+		})
 		executionTime := time.Since(policyStartTime)
+		if failure != nil {
+			skipped, err := c.handlePolicyFailure(ctx, span, spec, api, route, failure)
+			span.End()
+			if err != nil {
+				return nil, err
+			}
+			result.Results = append(result.Results, StreamingResponsePolicyResult{
+				PolicyName:    spec.Name,
+				PolicyVersion: spec.Version,
+				Error:         failure,
+				ExecutionTime: executionTime,
+				Skipped:       skipped,
+			})
+			continue
+		}
 
 		metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "executed").Inc()
 		metrics.PolicyDurationSeconds.WithLabelValues(spec.Name, spec.Version, api, route).Observe(executionTime.Seconds())
@@ -1083,6 +1314,7 @@ type ChainExecutor struct {
 	registry     *registry.PolicyRegistry
 	celEvaluator CELEvaluator
 	tracer       trace.Tracer
+	breakers     *circuitBreakers
 }
 
 // CELEvaluator interface for condition evaluation
@@ -1101,9 +1333,22 @@ func NewChainExecutor(reg *registry.PolicyRegistry, celEvaluator CELEvaluator, t
 		registry:     reg,
 		celEvaluator: celEvaluator,
 		tracer:       tracer,
+		breakers:     newCircuitBreakers(DefaultCircuitBreakerConfig()),
 	}
 }
 
+// SetCircuitBreakerConfig replaces the circuit breaker settings. Existing breaker state is
+// discarded, so it should be called before the executor serves traffic.
+func (c *ChainExecutor) SetCircuitBreakerConfig(cfg CircuitBreakerConfig) {
+	c.breakers = newCircuitBreakers(cfg)
+}
+
+// PruneCircuitBreakers drops circuit breaker state for policy instances that are no longer part of
+// chains. It is called with the complete route to chain mapping whenever the chains are rebuilt.
+func (c *ChainExecutor) PruneCircuitBreakers(chains map[string]*registry.PolicyChain) {
+	c.breakers.prune(chains)
+}
+
 // GetCELEvaluator returns the CEL evaluator used for condition evaluation.
 func (c *ChainExecutor) GetCELEvaluator() CELEvaluator {
 	return c.celEvaluator
@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
+)
+
+// CircuitBreakerConfig controls automatic circuit breaking of failing policy instances.
+type CircuitBreakerConfig struct {
+	// Enabled turns circuit breaking on
+	Enabled bool
+
+	// FailureThreshold is the number of consecutive failed invocations that opens the circuit
+	FailureThreshold int
+
+	// OpenDuration is how long an open circuit rejects invocations before a single trial
+	// invocation is let through (half-open)
+	OpenDuration time.Duration
+}
+
+// DefaultCircuitBreakerConfig returns the circuit breaker settings used when none are configured.
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		Enabled:          true,
+		FailureThreshold: 5,
+		OpenDuration:     30 * time.Second,
+	}
+}
+
+// Circuit states reported by the policy_circuit_breaker_state gauge.
+const (
+	circuitClosed   = 0
+	circuitOpen     = 1
+	circuitHalfOpen = 2
+)
+
+// breakerKey identifies a policy instance: a position in a route's chain. Name and version are
+// part of the key so a chain rebuilt with a different policy at that position starts closed.
+type breakerKey struct {
+	route   string
+	name    string
+	version string
+	index   int
+}
+
+type breakerState struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// circuitBreakers tracks consecutive failures per policy instance. State is only allocated for
+// instances that have failed, so healthy chains pay a single read-locked map lookup per invocation.
+type circuitBreakers struct {
+	cfg CircuitBreakerConfig
+	now func() time.Time
+
+	mu     sync.RWMutex
+	states map[breakerKey]*breakerState
+}
+
+func newCircuitBreakers(cfg CircuitBreakerConfig) *circuitBreakers {
+	return &circuitBreakers{
+		cfg:    cfg,
+		now:    time.Now,
+		states: make(map[breakerKey]*breakerState),
+	}
+}
+
+func (b *circuitBreakers) enabled() bool {
+	return b != nil && b.cfg.Enabled && b.cfg.FailureThreshold > 0
+}
+
+func (b *circuitBreakers) lookup(key breakerKey) *breakerState {
+	b.mu.RLock()
+	st := b.states[key]
+	b.mu.RUnlock()
+	return st
+}
+
+// allow reports whether the instance may be invoked. Once the open period has elapsed a single
+// trial invocation is allowed; its outcome closes or re-opens the circuit.
+func (b *circuitBreakers) allow(key breakerKey) bool {
+	if !b.enabled() {
+		return true
+	}
+	st := b.lookup(key)
+	if st == nil {
+		return true
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.openUntil.IsZero() {
+		return true
+	}
+	if b.now().Before(st.openUntil) || st.probing {
+		return false
+	}
+	st.probing = true
+	metrics.PolicyCircuitBreakerState.WithLabelValues(key.name, key.version, key.route).Set(circuitHalfOpen)
+	return true
+}
+
+func (b *circuitBreakers) recordSuccess(key breakerKey) {
+	if !b.enabled() {
+		return
+	}
+	st := b.lookup(key)
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	wasOpen := !st.openUntil.IsZero()
+	st.failures = 0
+	st.openUntil = time.Time{}
+	st.probing = false
+	if wasOpen {
+		metrics.PolicyCircuitBreakerState.WithLabelValues(key.name, key.version, key.route).Set(circuitClosed)
+		slog.Info("[policy] circuit breaker closed", "policy", key.name, "version", key.version, "route", key.route)
+	}
+}
+
+func (b *circuitBreakers) recordFailure(key breakerKey) {
+	if !b.enabled() {
+		return
+	}
+	st := b.lookup(key)
+	if st == nil {
+		b.mu.Lock()
+		if st = b.states[key]; st == nil {
+			st = &breakerState{}
+			b.states[key] = st
+		}
+		b.mu.Unlock()
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.failures++
+	if !st.probing && (!st.openUntil.IsZero() || st.failures < b.cfg.FailureThreshold) {
+		return
+	}
+	st.probing = false
+	st.openUntil = b.now().Add(b.cfg.OpenDuration)
+	metrics.PolicyCircuitBreakerState.WithLabelValues(key.name, key.version, key.route).Set(circuitOpen)
+	metrics.PolicyCircuitBreakerTripsTotal.WithLabelValues(key.name, key.version, key.route).Inc()
+	slog.Warn("[policy] circuit breaker opened",
+		"policy", key.name,
+		"version", key.version,
+		"route", key.route,
+		"consecutive_failures", st.failures,
+		"open_for", b.cfg.OpenDuration,
+	)
+}
+
+// prune drops the state of policy instances that are not in chains: routes that were removed and
+// positions that now hold a different policy or version. State of instances still deployed is kept,
+// so an unrelated configuration push does not close an open circuit.
+func (b *circuitBreakers) prune(chains map[string]*registry.PolicyChain) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for key, st := range b.states {
+		if chain := chains[key.route]; chain != nil && key.index < len(chain.PolicySpecs) {
+			spec := chain.PolicySpecs[key.index]
+			if spec.Name == key.name && spec.Version == key.version {
+				continue
+			}
+		}
+		delete(b.states, key)
+		st.mu.Lock()
+		wasOpen := !st.openUntil.IsZero()
+		st.mu.Unlock()
+		if wasOpen {
+			metrics.PolicyCircuitBreakerState.WithLabelValues(key.name, key.version, key.route).Set(circuitClosed)
+		}
+	}
+}
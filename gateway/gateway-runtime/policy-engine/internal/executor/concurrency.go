@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"sync"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// countRunnable returns how many of the calls invoke their policy.
+func countRunnable(calls []*requestHeaderCall) int {
+	n := 0
+	for _, call := range calls {
+		if !call.skipped {
+			n++
+		}
+	}
+	return n
+}
+
+// runConcurrently invokes the calls of a concurrent group in parallel. Every policy gets a
+// shallow copy of reqCtx with its own SharedContext, so it sees the request as it was before
+// the group and cannot race with its neighbours on the metadata map. The returned snapshot is
+// the shared context as it was before the group, for mergeSharedContext.
+func runConcurrently(
+	ctx context.Context,
+	c *ChainExecutor,
+	calls []*requestHeaderCall,
+	reqCtx *policy.RequestHeaderContext,
+	route string,
+) *policy.SharedContext {
+	var snapshot *policy.SharedContext
+	if reqCtx.SharedContext != nil {
+		snapshot = cloneSharedContext(reqCtx.SharedContext)
+	}
+
+	var wg sync.WaitGroup
+	for _, call := range calls {
+		if call.skipped {
+			continue
+		}
+		callCtx := *reqCtx
+		if snapshot != nil {
+			call.shared = cloneSharedContext(snapshot)
+			callCtx.SharedContext = call.shared
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			call.invoke(ctx, c, &callCtx, route)
+		}()
+	}
+	wg.Wait()
+	return snapshot
+}
+
+// cloneSharedContext copies the shared context and its metadata map; metadata values are shared.
+func cloneSharedContext(shared *policy.SharedContext) *policy.SharedContext {
+	clone := *shared
+	clone.Metadata = maps.Clone(shared.Metadata)
+	if clone.Metadata == nil {
+		clone.Metadata = map[string]interface{}{}
+	}
+	return &clone
+}
+
+// mergeSharedContext applies the metadata and auth context changes a concurrently run policy
+// made to its copy of the shared context (after, compared with before) onto dst.
+func mergeSharedContext(dst, before, after *policy.SharedContext) {
+	for k, v := range after.Metadata {
+		if old, ok := before.Metadata[k]; ok && reflect.DeepEqual(old, v) {
+			continue
+		}
+		if dst.Metadata == nil {
+			dst.Metadata = map[string]interface{}{}
+		}
+		dst.Metadata[k] = v
+	}
+	for k := range before.Metadata {
+		if _, ok := after.Metadata[k]; !ok {
+			delete(dst.Metadata, k)
+		}
+	}
+	if after.AuthContext != before.AuthContext {
+		dst.AuthContext = after.AuthContext
+	}
+}
@@ -0,0 +1,275 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/testutils"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// headerGroupPolicy is a request-header policy whose behaviour is driven by its fields.
+type headerGroupPolicy struct {
+	concurrency policy.ConcurrencyMode
+	delay       time.Duration
+	barrier     *sync.WaitGroup // when set, returns only after every policy sharing it has started
+	barrierWait time.Duration   // how long to wait at the barrier; defaults to 2s
+	setHeaders  map[string]string
+	setMetadata map[string]interface{}
+	delMetadata []string
+	denyStatus  int
+	panics      bool
+	seenHeader  string // header whose value is recorded in seen
+	seen        atomic.Value
+	calls       atomic.Int32
+}
+
+func (p *headerGroupPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode:        policy.HeaderModeProcess,
+		RequestHeaderConcurrency: p.concurrency,
+	}
+}
+
+func (p *headerGroupPolicy) OnRequestHeaders(_ context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	p.calls.Add(1)
+	if p.barrier != nil {
+		p.barrier.Done()
+		done := make(chan struct{})
+		go func() {
+			p.barrier.Wait()
+			close(done)
+		}()
+		wait := p.barrierWait
+		if wait == 0 {
+			wait = 2 * time.Second
+		}
+		select {
+		case <-done:
+		case <-time.After(wait):
+			return policy.ImmediateResponse{StatusCode: 500, Body: []byte("policies did not run concurrently")}
+		}
+	}
+	time.Sleep(p.delay)
+	if p.panics {
+		panic("boom")
+	}
+	if p.seenHeader != "" {
+		p.seen.Store(reqCtx.Headers.Get(p.seenHeader))
+	}
+	for k, v := range p.setMetadata {
+		reqCtx.Metadata[k] = v
+	}
+	for _, k := range p.delMetadata {
+		delete(reqCtx.Metadata, k)
+	}
+	if p.denyStatus != 0 {
+		return policy.ImmediateResponse{StatusCode: p.denyStatus}
+	}
+	if p.setHeaders != nil {
+		return policy.UpstreamRequestHeaderModifications{HeadersToSet: p.setHeaders}
+	}
+	return nil
+}
+
+func newHeaderGroupRequest() *policy.RequestHeaderContext {
+	return &policy.RequestHeaderContext{
+		SharedContext: testutils.NewTestSharedContext(),
+		Headers:       policy.NewHeaders(map[string][]string{"content-type": {"application/json"}}),
+		Path:          "/test",
+		Method:        "GET",
+	}
+}
+
+func executeHeaderGroup(t *testing.T, reqCtx *policy.RequestHeaderContext, specs []policy.PolicySpec, policies ...policy.Policy) (*RequestHeaderExecutionResult, error) {
+	t.Helper()
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	return executor.ExecuteRequestHeaderPolicies(context.Background(), policies, reqCtx, specs, "api", "route", false)
+}
+
+func headerGroupSpecs(names ...string) []policy.PolicySpec {
+	specs := make([]policy.PolicySpec, len(names))
+	for i, name := range names {
+		specs[i] = newPolicySpec(name, "v1.0.0", true, nil)
+	}
+	return specs
+}
+
+func resultNames(result *RequestHeaderExecutionResult) []string {
+	names := make([]string, len(result.Results))
+	for i, r := range result.Results {
+		names[i] = r.PolicyName
+	}
+	return names
+}
+
+func TestExecuteRequestHeaderPolicies_ConcurrentGroupRunsInParallel(t *testing.T) {
+	barrier := &sync.WaitGroup{}
+	barrier.Add(3)
+	policies := []policy.Policy{
+		&headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly, barrier: barrier},
+		&headerGroupPolicy{concurrency: policy.ConcurrencyCommutative, barrier: barrier},
+		&headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly, barrier: barrier},
+	}
+
+	result, err := executeHeaderGroup(t, newHeaderGroupRequest(), headerGroupSpecs("a", "b", "c"), policies...)
+
+	require.NoError(t, err)
+	assert.False(t, result.ShortCircuited, "a policy timed out waiting for the others")
+	assert.Equal(t, []string{"a", "b", "c"}, resultNames(result))
+}
+
+func TestExecuteRequestHeaderPolicies_SequentialByDefault(t *testing.T) {
+	barrier := &sync.WaitGroup{}
+	barrier.Add(2)
+	first := &headerGroupPolicy{barrier: barrier, barrierWait: 50 * time.Millisecond}
+
+	// The first policy is sequential, so the second one cannot start until it has returned
+	result, err := executeHeaderGroup(t, newHeaderGroupRequest(), headerGroupSpecs("a", "b"),
+		first, &headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly, barrier: barrier})
+
+	require.NoError(t, err)
+	assert.True(t, result.ShortCircuited)
+	assert.Equal(t, 500, result.FinalAction.(policy.ImmediateResponse).StatusCode)
+}
+
+func TestExecuteRequestHeaderPolicies_ConcurrentMergeInChainOrder(t *testing.T) {
+	reqCtx := newHeaderGroupRequest()
+	reqCtx.Metadata["stale"] = true
+	slow := &headerGroupPolicy{
+		concurrency: policy.ConcurrencyCommutative,
+		delay:       30 * time.Millisecond,
+		setHeaders:  map[string]string{"X-Order": "first"},
+		setMetadata: map[string]interface{}{"owner": "first", "slow": true},
+	}
+	fast := &headerGroupPolicy{
+		concurrency: policy.ConcurrencyCommutative,
+		setHeaders:  map[string]string{"X-Order": "second", "X-Fast": "yes"},
+		setMetadata: map[string]interface{}{"owner": "second"},
+		delMetadata: []string{"stale"},
+	}
+
+	result, err := executeHeaderGroup(t, reqCtx, headerGroupSpecs("slow", "fast"), slow, fast)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"slow", "fast"}, resultNames(result))
+	assert.Equal(t, []string{"second"}, reqCtx.Headers.Get("x-order"), "later policies in chain order win")
+	assert.Equal(t, []string{"yes"}, reqCtx.Headers.Get("x-fast"))
+	assert.Equal(t, map[string]interface{}{"owner": "second", "slow": true}, reqCtx.Metadata)
+}
+
+func TestExecuteRequestHeaderPolicies_ConcurrentFirstShortCircuitInChainOrder(t *testing.T) {
+	reqCtx := newHeaderGroupRequest()
+	slowDeny := &headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly, delay: 30 * time.Millisecond, denyStatus: 401}
+	fastDeny := &headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly, denyStatus: 403}
+	tagger := &headerGroupPolicy{
+		concurrency: policy.ConcurrencyCommutative,
+		setHeaders:  map[string]string{"x-tag": "1"},
+		setMetadata: map[string]interface{}{"tagged": true},
+	}
+	after := &headerGroupPolicy{}
+
+	result, err := executeHeaderGroup(t, reqCtx, headerGroupSpecs("slow-deny", "fast-deny", "tagger", "after"),
+		slowDeny, fastDeny, tagger, after)
+
+	require.NoError(t, err)
+	assert.True(t, result.ShortCircuited)
+	assert.Equal(t, []string{"slow-deny"}, resultNames(result))
+	assert.Equal(t, 401, result.FinalAction.(policy.ImmediateResponse).StatusCode)
+	assert.Equal(t, int32(1), tagger.calls.Load(), "group members run before the short-circuit is known")
+	assert.Nil(t, reqCtx.Headers.Get("x-tag"), "actions after the short-circuit are discarded")
+	assert.NotContains(t, reqCtx.Metadata, "tagged")
+	assert.Equal(t, int32(0), after.calls.Load())
+}
+
+func TestExecuteRequestHeaderPolicies_ConcurrentSnapshotAndSequentialBoundary(t *testing.T) {
+	reqCtx := newHeaderGroupRequest()
+	setter := &headerGroupPolicy{concurrency: policy.ConcurrencyCommutative, setHeaders: map[string]string{"x-a": "1"}}
+	peer := &headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly, delay: 10 * time.Millisecond, seenHeader: "x-a"}
+	sequential := &headerGroupPolicy{seenHeader: "x-a"}
+
+	_, err := executeHeaderGroup(t, reqCtx, headerGroupSpecs("setter", "peer", "sequential"), setter, peer, sequential)
+
+	require.NoError(t, err)
+	assert.Nil(t, peer.seen.Load().([]string), "group members see the request as it was before the group")
+	assert.Equal(t, []string{"1"}, sequential.seen.Load().([]string), "a sequential policy sees the group's mutations")
+}
+
+func TestExecuteRequestHeaderPolicies_ConcurrentSkippedKeepsChainOrder(t *testing.T) {
+	specs := headerGroupSpecs("a", "b", "c")
+	specs[1].Enabled = false
+	disabled := &headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly}
+
+	result, err := executeHeaderGroup(t, newHeaderGroupRequest(), specs,
+		&headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly}, disabled, &headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, resultNames(result))
+	assert.True(t, result.Results[1].Skipped)
+	assert.Equal(t, int32(0), disabled.calls.Load())
+}
+
+func TestExecuteRequestHeaderPolicies_ConcurrentFailures(t *testing.T) {
+	t.Run("fail-closed stops the chain", func(t *testing.T) {
+		after := &headerGroupPolicy{}
+		specs := headerGroupSpecs("ok", "buggy", "after")
+		reqCtx := newHeaderGroupRequest()
+
+		_, err := executeHeaderGroup(t, reqCtx, specs,
+			&headerGroupPolicy{concurrency: policy.ConcurrencyCommutative, setHeaders: map[string]string{"x-ok": "1"}},
+			&headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly, panics: true},
+			after)
+
+		var failure *PolicyFailureError
+		require.ErrorAs(t, err, &failure)
+		assert.Equal(t, "buggy", failure.PolicyName)
+		assert.Equal(t, FailureReasonPanic, failure.Reason)
+		assert.Equal(t, int32(0), after.calls.Load())
+	})
+
+	t.Run("fail-open continues", func(t *testing.T) {
+		specs := headerGroupSpecs("buggy", "ok")
+		specs[0].OnError = policy.OnErrorFailOpen
+		reqCtx := newHeaderGroupRequest()
+
+		result, err := executeHeaderGroup(t, reqCtx, specs,
+			&headerGroupPolicy{concurrency: policy.ConcurrencyReadOnly, panics: true},
+			&headerGroupPolicy{concurrency: policy.ConcurrencyCommutative, setHeaders: map[string]string{"x-ok": "1"}})
+
+		require.NoError(t, err)
+		require.Len(t, result.Results, 2)
+		assert.Error(t, result.Results[0].Error)
+		assert.Equal(t, []string{"1"}, reqCtx.Headers.Get("x-ok"))
+	})
+}
+
+func TestConcurrencyMode_IsConcurrent(t *testing.T) {
+	assert.True(t, policy.ConcurrencyReadOnly.IsConcurrent())
+	assert.True(t, policy.ConcurrencyCommutative.IsConcurrent())
+	assert.False(t, policy.ConcurrencySequential.IsConcurrent())
+	assert.False(t, policy.ConcurrencyMode("").IsConcurrent())
+}
@@ -0,0 +1,318 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"runtime/debug"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Phase names reported in PolicyFailureError, logs and traces.
+const (
+	PhaseRequestHeaders    = "request_headers"
+	PhaseRequestBody       = "request_body"
+	PhaseResponseHeaders   = "response_headers"
+	PhaseResponseBody      = "response_body"
+	PhaseRequestBodyChunk  = "request_body_chunk"
+	PhaseResponseBodyChunk = "response_body_chunk"
+	PhaseStreamError       = "stream_error"
+	PhaseRequestComplete   = "request_complete"
+)
+
+// FailureReason classifies why a policy invocation failed.
+type FailureReason string
+
+const (
+	// FailureReasonPanic means the policy panicked; the panic was recovered.
+	FailureReasonPanic FailureReason = "panic"
+	// FailureReasonTimeout means the policy did not return within its PolicySpec.Timeout.
+	FailureReasonTimeout FailureReason = "timeout"
+	// FailureReasonCircuitOpen means the policy was not invoked because its circuit breaker is open.
+	FailureReasonCircuitOpen FailureReason = "circuit_open"
+)
+
+// PolicyFailureError describes a failed policy invocation. The Execute* methods return it when the
+// failing policy is configured fail-closed, so the kernel can build the error response from it.
+type PolicyFailureError struct {
+	PolicyName    string
+	PolicyVersion string
+	Phase         string
+	Reason        FailureReason
+	Cause         error
+}
+
+func (e *PolicyFailureError) Error() string {
+	return fmt.Sprintf("policy %s:%s failed in %s (%s): %v", e.PolicyName, e.PolicyVersion, e.Phase, e.Reason, e.Cause)
+}
+
+func (e *PolicyFailureError) Unwrap() error {
+	return e.Cause
+}
+
+// panicError carries a recovered panic value and the stack of the panicking goroutine.
+type panicError struct {
+	value interface{}
+	stack []byte
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.value)
+}
+
+// ValidateFaultSettings parses the fault-isolation settings of a configured policy instance.
+// An empty timeout means no per-policy limit and an empty onError means fail-closed.
+func ValidateFaultSettings(timeout, onError string) (time.Duration, policy.OnErrorMode, error) {
+	var d time.Duration
+	if timeout != "" {
+		var err error
+		d, err = time.ParseDuration(timeout)
+		if err != nil {
+			return 0, "", fmt.Errorf("invalid timeout %q: %w", timeout, err)
+		}
+		if d <= 0 {
+			return 0, "", fmt.Errorf("invalid timeout %q: must be positive", timeout)
+		}
+	}
+	mode := policy.OnErrorMode(onError)
+	if !mode.IsValid() {
+		return 0, "", fmt.Errorf("invalid onError %q: must be %s, %s or %s",
+			onError, policy.OnErrorFailClosed, policy.OnErrorFailOpen, policy.OnErrorSkip)
+	}
+	return d, mode, nil
+}
+
+// invokePolicy runs one policy callback with panic recovery, the spec's time budget and the
+// policy's circuit breaker. A non-nil failure means fn did not produce a usable action.
+//
+// With a time budget the callback runs in its own goroutine against a private copy of pctx (see
+// isolatePolicyContext); shared context changes are merged back only when it returns in time. A
+// timed-out callback keeps running with a cancelled context, but on the copy, so it cannot race
+// with the rest of the chain; its action is discarded. Policies that can block should honour ctx.
+func invokePolicy[C, A any](
+	ctx context.Context,
+	c *ChainExecutor,
+	spec policy.PolicySpec,
+	index int,
+	phase, route string,
+	pctx C,
+	fn func(context.Context, C) A,
+) (A, *PolicyFailureError) {
+	var zero A
+	key := breakerKey{route: route, name: spec.Name, version: spec.Version, index: index}
+	if !c.breakers.allow(key) {
+		return zero, &PolicyFailureError{
+			PolicyName: spec.Name, PolicyVersion: spec.Version, Phase: phase,
+			Reason: FailureReasonCircuitOpen,
+			Cause:  fmt.Errorf("circuit breaker is open"),
+		}
+	}
+
+	action, reason, err := callWithBudget(ctx, spec.Timeout, pctx, fn)
+	if err != nil {
+		c.breakers.recordFailure(key)
+		return zero, &PolicyFailureError{
+			PolicyName: spec.Name, PolicyVersion: spec.Version, Phase: phase,
+			Reason: reason, Cause: err,
+		}
+	}
+	c.breakers.recordSuccess(key)
+	return action, nil
+}
+
+func callWithBudget[C, A any](ctx context.Context, timeout time.Duration, pctx C, fn func(context.Context, C) A) (A, FailureReason, error) {
+	if timeout <= 0 {
+		return callRecovered(ctx, func(ctx context.Context) A { return fn(ctx, pctx) })
+	}
+
+	budgetCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	isolated, commit := isolatePolicyContext(pctx)
+
+	type outcome struct {
+		action A
+		reason FailureReason
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		action, reason, err := callRecovered(budgetCtx, func(ctx context.Context) A { return fn(ctx, isolated) })
+		done <- outcome{action: action, reason: reason, err: err}
+	}()
+
+	select {
+	case o := <-done:
+		if o.err == nil {
+			commit()
+		}
+		return o.action, o.reason, o.err
+	case <-budgetCtx.Done():
+		var zero A
+		return zero, FailureReasonTimeout, fmt.Errorf("no result within %s: %w", timeout, budgetCtx.Err())
+	}
+}
+
+// isolatePolicyContext returns a copy of a policy context that a budgeted callback can use after
+// the chain has moved on: the struct, its header maps and its shared context are copied, bodies
+// are shared because the executor replaces rather than mutates them. commit merges the copy's
+// shared context changes back into pctx and must only be called once the callback has returned.
+func isolatePolicyContext[C any](pctx C) (isolated C, commit func()) {
+	switch p := any(pctx).(type) {
+	case *policy.RequestHeaderContext:
+		cp := *p
+		cp.Headers = cloneHeaders(p.Headers)
+		cp.SharedContext, commit = isolateSharedContext(p.SharedContext)
+		return any(&cp).(C), commit
+	case *policy.RequestContext:
+		cp := *p
+		cp.Headers = cloneHeaders(p.Headers)
+		cp.SharedContext, commit = isolateSharedContext(p.SharedContext)
+		return any(&cp).(C), commit
+	case *policy.ResponseHeaderContext:
+		cp := *p
+		cp.RequestHeaders = cloneHeaders(p.RequestHeaders)
+		cp.ResponseHeaders = cloneHeaders(p.ResponseHeaders)
+		cp.SharedContext, commit = isolateSharedContext(p.SharedContext)
+		return any(&cp).(C), commit
+	case *policy.ResponseContext:
+		cp := *p
+		cp.RequestHeaders = cloneHeaders(p.RequestHeaders)
+		cp.ResponseHeaders = cloneHeaders(p.ResponseHeaders)
+		cp.SharedContext, commit = isolateSharedContext(p.SharedContext)
+		return any(&cp).(C), commit
+	case *policy.RequestStreamContext:
+		cp := *p
+		cp.Headers = cloneHeaders(p.Headers)
+		cp.SharedContext, commit = isolateSharedContext(p.SharedContext)
+		return any(&cp).(C), commit
+	case *policy.ResponseStreamContext:
+		cp := *p
+		cp.RequestHeaders = cloneHeaders(p.RequestHeaders)
+		cp.ResponseHeaders = cloneHeaders(p.ResponseHeaders)
+		cp.SharedContext, commit = isolateSharedContext(p.SharedContext)
+		return any(&cp).(C), commit
+	case *policy.StreamEndContext:
+		cp := *p
+		cp.SharedContext, commit = isolateSharedContext(p.SharedContext)
+		return any(&cp).(C), commit
+	}
+	return pctx, func() {}
+}
+
+// isolateSharedContext copies shared for a budgeted callback; commit applies the copy's changes.
+func isolateSharedContext(shared *policy.SharedContext) (*policy.SharedContext, func()) {
+	if shared == nil {
+		return nil, func() {}
+	}
+	before := cloneSharedContext(shared)
+	after := cloneSharedContext(shared)
+	return after, func() { mergeSharedContext(shared, before, after) }
+}
+
+// cloneHeaders copies the header map so in-place kernel mutations are not visible to the copy.
+func cloneHeaders(h *policy.Headers) *policy.Headers {
+	if h == nil {
+		return nil
+	}
+	return policy.NewHeaders(maps.Clone(h.UnsafeInternalValues()))
+}
+
+func callRecovered[A any](ctx context.Context, fn func(context.Context) A) (action A, reason FailureReason, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			reason = FailureReasonPanic
+			err = &panicError{value: r, stack: debug.Stack()}
+		}
+	}()
+	return fn(ctx), "", nil
+}
+
+// handlePolicyFailure records a failed invocation in logs, metrics and the policy span, and
+// applies the spec's onError mode. It returns the failure for fail-closed policies and nil when
+// the chain should continue without the policy; skipped reports whether it was reported as skipped.
+func (c *ChainExecutor) handlePolicyFailure(
+	ctx context.Context,
+	span trace.Span,
+	spec policy.PolicySpec,
+	api, route string,
+	failure *PolicyFailureError,
+) (skipped bool, err error) {
+	mode := spec.OnError
+	if mode == "" {
+		mode = policy.OnErrorFailClosed
+	}
+
+	logArgs := []any{
+		"policy", spec.Name,
+		"version", spec.Version,
+		"route", route,
+		"phase", failure.Phase,
+		"reason", failure.Reason,
+		"on_error", mode,
+		"error", failure.Cause,
+	}
+	if pe, ok := failure.Cause.(*panicError); ok {
+		metrics.PanicRecoveriesTotal.WithLabelValues("policy").Inc()
+		logArgs = append(logArgs, "stack", string(pe.stack))
+	}
+	if mode == policy.OnErrorFailClosed {
+		slog.ErrorContext(ctx, "[policy] invocation failed", logArgs...)
+	} else {
+		slog.WarnContext(ctx, "[policy] invocation failed; continuing chain", logArgs...)
+	}
+
+	metrics.PolicyErrorsTotal.WithLabelValues(spec.Name, string(failure.Reason)).Inc()
+	metrics.PolicyExecutionsTotal.WithLabelValues(spec.Name, spec.Version, api, route, "failed").Inc()
+
+	if span.IsRecording() {
+		span.SetAttributes(
+			attribute.String(constants.AttrPolicyFailureReason, string(failure.Reason)),
+			attribute.String(constants.AttrPolicyOnError, string(mode)),
+		)
+		span.RecordError(failure.Cause)
+		span.SetStatus(codes.Error, fmt.Sprintf("policy %s", failure.Reason))
+	}
+
+	switch mode {
+	case policy.OnErrorSkip:
+		metrics.PolicySkippedTotal.WithLabelValues(spec.Name, api, route, "error").Inc()
+		if span.IsRecording() {
+			span.SetAttributes(
+				attribute.Bool(constants.AttrPolicySkipped, true),
+				attribute.String(constants.AttrSkipReason, constants.AttrSkipReasonPolicyError),
+			)
+		}
+		return true, nil
+	case policy.OnErrorFailOpen:
+		return false, nil
+	default:
+		return false, failure
+	}
+}
@@ -0,0 +1,331 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/testutils"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// faultyPolicy panics, blocks until its context is cancelled, or succeeds, depending on its fields.
+type faultyPolicy struct {
+	panics bool
+	blocks bool
+	calls  int
+}
+
+func (p *faultyPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{
+		RequestHeaderMode: policy.HeaderModeProcess,
+		RequestBodyMode:   policy.BodyModeBuffer,
+	}
+}
+
+func (p *faultyPolicy) act(ctx context.Context) {
+	p.calls++
+	if p.panics {
+		panic("boom")
+	}
+	if p.blocks {
+		<-ctx.Done()
+	}
+}
+
+func (p *faultyPolicy) OnRequestHeaders(ctx context.Context, _ *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	p.act(ctx)
+	return policy.UpstreamRequestHeaderModifications{}
+}
+
+func (p *faultyPolicy) OnRequestBody(ctx context.Context, _ *policy.RequestContext, _ map[string]interface{}) policy.RequestAction {
+	p.act(ctx)
+	return policy.UpstreamRequestModifications{}
+}
+
+func newFaultSpec(name string, onError policy.OnErrorMode, timeout time.Duration) policy.PolicySpec {
+	spec := newPolicySpec(name, "v1.0.0", true, nil)
+	spec.OnError = onError
+	spec.Timeout = timeout
+	return spec
+}
+
+func TestExecuteRequestPolicies_PanicFailClosed(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	next := &faultyPolicy{}
+	policies := []policy.Policy{&faultyPolicy{panics: true}, next}
+	specs := []policy.PolicySpec{newFaultSpec("buggy", "", 0), newFaultSpec("next", "", 0)}
+
+	result, err := executor.ExecuteRequestPolicies(context.Background(), policies, testutils.NewTestRequestContext(), specs, "api", "route", false)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	var failure *PolicyFailureError
+	require.ErrorAs(t, err, &failure)
+	assert.Equal(t, "buggy", failure.PolicyName)
+	assert.Equal(t, FailureReasonPanic, failure.Reason)
+	assert.Equal(t, PhaseRequestBody, failure.Phase)
+	assert.Equal(t, 0, next.calls, "chain must stop at a fail-closed failure")
+}
+
+func TestExecuteRequestPolicies_PanicFailOpenContinues(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	next := &faultyPolicy{}
+	policies := []policy.Policy{&faultyPolicy{panics: true}, next}
+	specs := []policy.PolicySpec{newFaultSpec("buggy", policy.OnErrorFailOpen, 0), newFaultSpec("next", "", 0)}
+
+	result, err := executor.ExecuteRequestPolicies(context.Background(), policies, testutils.NewTestRequestContext(), specs, "api", "route", false)
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.Error(t, result.Results[0].Error)
+	assert.False(t, result.Results[0].Skipped)
+	assert.Nil(t, result.Results[0].Action)
+	assert.NoError(t, result.Results[1].Error)
+	assert.Equal(t, 1, next.calls)
+}
+
+func TestExecuteRequestHeaderPolicies_PanicSkip(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	policies := []policy.Policy{&faultyPolicy{panics: true}}
+	specs := []policy.PolicySpec{newFaultSpec("buggy", policy.OnErrorSkip, 0)}
+
+	result, err := executor.ExecuteRequestHeaderPolicies(context.Background(), policies, &policy.RequestHeaderContext{
+		SharedContext: testutils.NewTestSharedContext(),
+		Headers:       policy.NewHeaders(map[string][]string{}),
+	}, specs, "api", "route", false)
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.True(t, result.Results[0].Skipped)
+	assert.Error(t, result.Results[0].Error)
+	assert.Nil(t, result.FinalAction)
+}
+
+func TestExecuteRequestPolicies_Timeout(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	policies := []policy.Policy{&faultyPolicy{blocks: true}}
+	specs := []policy.PolicySpec{newFaultSpec("slow", "", 20*time.Millisecond)}
+
+	start := time.Now()
+	_, err := executor.ExecuteRequestPolicies(context.Background(), policies, testutils.NewTestRequestContext(), specs, "api", "route", false)
+
+	var failure *PolicyFailureError
+	require.ErrorAs(t, err, &failure)
+	assert.Equal(t, FailureReasonTimeout, failure.Reason)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
+
+func TestExecuteRequestPolicies_TimeoutNotReachedKeepsAction(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	policies := []policy.Policy{&faultyPolicy{}}
+	specs := []policy.PolicySpec{newFaultSpec("fast", "", time.Second)}
+
+	result, err := executor.ExecuteRequestPolicies(context.Background(), policies, testutils.NewTestRequestContext(), specs, "api", "route", false)
+
+	require.NoError(t, err)
+	require.Len(t, result.Results, 1)
+	assert.NotNil(t, result.Results[0].Action)
+}
+
+func TestCircuitBreaker_OpensAndRecovers(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	executor.SetCircuitBreakerConfig(CircuitBreakerConfig{Enabled: true, FailureThreshold: 2, OpenDuration: time.Minute})
+	now := time.Now()
+	executor.breakers.now = func() time.Time { return now }
+
+	pol := &faultyPolicy{panics: true}
+	policies := []policy.Policy{pol}
+	specs := []policy.PolicySpec{newFaultSpec("flaky", policy.OnErrorSkip, 0)}
+	run := func() RequestPolicyResult {
+		result, err := executor.ExecuteRequestPolicies(context.Background(), policies, testutils.NewTestRequestContext(), specs, "api", "route", false)
+		require.NoError(t, err)
+		require.Len(t, result.Results, 1)
+		return result.Results[0]
+	}
+
+	run()
+	run()
+	assert.Equal(t, 2, pol.calls)
+
+	// Open: the policy is no longer invoked.
+	res := run()
+	assert.Equal(t, 2, pol.calls)
+	assert.True(t, res.Skipped)
+	var failure *PolicyFailureError
+	require.ErrorAs(t, res.Error, &failure)
+	assert.Equal(t, FailureReasonCircuitOpen, failure.Reason)
+
+	// Half-open: a failing trial re-opens the circuit.
+	now = now.Add(2 * time.Minute)
+	run()
+	assert.Equal(t, 3, pol.calls)
+	run()
+	assert.Equal(t, 3, pol.calls)
+
+	// A successful trial closes it again.
+	now = now.Add(2 * time.Minute)
+	pol.panics = false
+	res = run()
+	assert.NoError(t, res.Error)
+	assert.Equal(t, 4, pol.calls)
+	res = run()
+	assert.NoError(t, res.Error)
+	assert.Equal(t, 5, pol.calls)
+}
+
+func TestCircuitBreaker_Disabled(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	executor.SetCircuitBreakerConfig(CircuitBreakerConfig{Enabled: false, FailureThreshold: 1, OpenDuration: time.Minute})
+
+	pol := &faultyPolicy{panics: true}
+	policies := []policy.Policy{pol}
+	specs := []policy.PolicySpec{newFaultSpec("flaky", policy.OnErrorFailOpen, 0)}
+	for i := 0; i < 3; i++ {
+		_, err := executor.ExecuteRequestPolicies(context.Background(), policies, testutils.NewTestRequestContext(), specs, "api", "route", false)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 3, pol.calls)
+}
+
+func TestValidateFaultSettings(t *testing.T) {
+	d, mode, err := ValidateFaultSettings("250ms", "fail-open")
+	require.NoError(t, err)
+	assert.Equal(t, 250*time.Millisecond, d)
+	assert.Equal(t, policy.OnErrorFailOpen, mode)
+
+	d, mode, err = ValidateFaultSettings("", "")
+	require.NoError(t, err)
+	assert.Zero(t, d)
+	assert.Equal(t, policy.OnErrorMode(""), mode)
+
+	_, _, err = ValidateFaultSettings("soon", "")
+	assert.Error(t, err)
+	_, _, err = ValidateFaultSettings("-1s", "")
+	assert.Error(t, err)
+	_, _, err = ValidateFaultSettings("", "ignore")
+	assert.Error(t, err)
+}
+
+// lingeringPolicy ignores its time budget: once ctx is cancelled it keeps using the context it was
+// given, as a policy stuck in a blocking call would when that call finally returns.
+type lingeringPolicy struct {
+	done chan struct{}
+}
+
+func (p *lingeringPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}
+}
+
+func (p *lingeringPolicy) OnRequestHeaders(ctx context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	defer close(p.done)
+	<-ctx.Done()
+	for i := 0; i < 100; i++ {
+		_ = reqCtx.Headers.Get("x-added")
+		reqCtx.Metadata["late"] = i
+	}
+	return policy.UpstreamRequestHeaderModifications{}
+}
+
+// headerSettingPolicy sets a request header and a metadata entry.
+type headerSettingPolicy struct{}
+
+func (p *headerSettingPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}
+}
+
+func (p *headerSettingPolicy) OnRequestHeaders(_ context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	reqCtx.Metadata["seen"] = "yes"
+	return policy.UpstreamRequestHeaderModifications{HeadersToSet: map[string]string{"x-added": "1"}}
+}
+
+func TestExecuteRequestHeaderPolicies_TimedOutPolicyRunsOnCopy(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	slow := &lingeringPolicy{done: make(chan struct{})}
+	policies := []policy.Policy{slow, &headerSettingPolicy{}}
+	specs := []policy.PolicySpec{newFaultSpec("slow", policy.OnErrorFailOpen, 10*time.Millisecond), newFaultSpec("fast", "", 0)}
+	reqCtx := &policy.RequestHeaderContext{
+		SharedContext: testutils.NewTestSharedContext(),
+		Headers:       policy.NewHeaders(map[string][]string{}),
+	}
+
+	result, err := executor.ExecuteRequestHeaderPolicies(context.Background(), policies, reqCtx, specs, "api", "route", false)
+	require.NoError(t, err)
+	require.Len(t, result.Results, 2)
+	assert.Error(t, result.Results[0].Error)
+
+	// The chain mutated reqCtx while the timed-out policy was still running; with -race this
+	// fails if both touched the same maps.
+	for i := 0; i < 100; i++ {
+		reqCtx.Metadata["after"] = i
+		reqCtx.Headers.UnsafeInternalValues()["x-after"] = []string{"1"}
+	}
+	<-slow.done
+
+	assert.Equal(t, []string{"1"}, reqCtx.Headers.Get("x-added"))
+	assert.Equal(t, "yes", reqCtx.Metadata["seen"])
+	assert.NotContains(t, reqCtx.Metadata, "late", "changes of a timed-out policy must be discarded")
+}
+
+func TestExecuteRequestHeaderPolicies_BudgetedPolicyChangesAreMerged(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	policies := []policy.Policy{&headerSettingPolicy{}}
+	specs := []policy.PolicySpec{newFaultSpec("fast", "", time.Second)}
+	reqCtx := &policy.RequestHeaderContext{
+		SharedContext: testutils.NewTestSharedContext(),
+		Headers:       policy.NewHeaders(map[string][]string{}),
+	}
+
+	_, err := executor.ExecuteRequestHeaderPolicies(context.Background(), policies, reqCtx, specs, "api", "route", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, "yes", reqCtx.Metadata["seen"])
+	assert.Equal(t, []string{"1"}, reqCtx.Headers.Get("x-added"))
+}
+
+func TestCircuitBreakers_Prune(t *testing.T) {
+	b := newCircuitBreakers(CircuitBreakerConfig{Enabled: true, FailureThreshold: 1, OpenDuration: time.Minute})
+	kept := breakerKey{route: "r1", name: "a", version: "v1.0.0", index: 0}
+	replaced := breakerKey{route: "r1", name: "b", version: "v1.0.0", index: 1}
+	removed := breakerKey{route: "r2", name: "a", version: "v1.0.0", index: 0}
+	for _, key := range []breakerKey{kept, replaced, removed} {
+		b.recordFailure(key)
+		require.False(t, b.allow(key))
+	}
+
+	b.prune(map[string]*registry.PolicyChain{
+		"r1": {PolicySpecs: []policy.PolicySpec{
+			newPolicySpec("a", "v1.0.0", true, nil),
+			newPolicySpec("c", "v1.0.0", true, nil),
+		}},
+	})
+
+	assert.NotNil(t, b.lookup(kept))
+	assert.False(t, b.allow(kept), "state of an instance still deployed is kept")
+	for _, key := range []breakerKey{replaced, removed} {
+		assert.Nil(t, b.lookup(key))
+		assert.True(t, b.allow(key))
+	}
+}
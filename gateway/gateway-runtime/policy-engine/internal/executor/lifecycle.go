@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ─── Stream lifecycle hooks ───────────────────────────────────────────────────
+
+// StreamEndPolicyResult is the result of notifying a single policy that the stream ended
+type StreamEndPolicyResult struct {
+	PolicyName    string
+	PolicyVersion string
+	Error         error // set when the hook panicked, timed out or its circuit was open
+	ExecutionTime time.Duration
+}
+
+// ExecuteStreamErrorPolicies calls OnStreamError on every enabled StreamErrorPolicy in the chain,
+// in chain order. Hooks cannot affect the response, so a failing hook is recorded and the
+// remaining hooks still run regardless of the policy's onError mode.
+func (c *ChainExecutor) ExecuteStreamErrorPolicies(
+	ctx context.Context,
+	policyList []policy.Policy,
+	endCtx *policy.StreamEndContext,
+	specs []policy.PolicySpec,
+	api, route string,
+) []StreamEndPolicyResult {
+	return c.executeStreamEndHooks(ctx, policyList, specs, endCtx, api, route, PhaseStreamError,
+		func(pol policy.Policy) (func(context.Context, *policy.StreamEndContext, map[string]interface{}), bool) {
+			hook, ok := pol.(policy.StreamErrorPolicy)
+			if !ok {
+				return nil, false
+			}
+			return func(ctx context.Context, endCtx *policy.StreamEndContext, params map[string]interface{}) {
+				hook.OnStreamError(ctx, endCtx, params)
+			}, true
+		})
+}
+
+// ExecuteRequestCompletePolicies calls OnRequestComplete on every enabled RequestCompletePolicy in
+// the chain, in chain order, with the same failure handling as ExecuteStreamErrorPolicies.
+func (c *ChainExecutor) ExecuteRequestCompletePolicies(
+	ctx context.Context,
+	policyList []policy.Policy,
+	endCtx *policy.StreamEndContext,
+	specs []policy.PolicySpec,
+	api, route string,
+) []StreamEndPolicyResult {
+	return c.executeStreamEndHooks(ctx, policyList, specs, endCtx, api, route, PhaseRequestComplete,
+		func(pol policy.Policy) (func(context.Context, *policy.StreamEndContext, map[string]interface{}), bool) {
+			hook, ok := pol.(policy.RequestCompletePolicy)
+			if !ok {
+				return nil, false
+			}
+			return func(ctx context.Context, endCtx *policy.StreamEndContext, params map[string]interface{}) {
+				hook.OnRequestComplete(ctx, endCtx, params)
+			}, true
+		})
+}
+
+func (c *ChainExecutor) executeStreamEndHooks(
+	ctx context.Context,
+	policyList []policy.Policy,
+	specs []policy.PolicySpec,
+	endCtx *policy.StreamEndContext,
+	api, route, phase string,
+	hookFor func(policy.Policy) (func(context.Context, *policy.StreamEndContext, map[string]interface{}), bool),
+) []StreamEndPolicyResult {
+	var results []StreamEndPolicyResult
+
+	for i, pol := range policyList {
+		spec := specs[i]
+		hook, ok := hookFor(pol)
+		if !ok || !spec.Enabled {
+			continue
+		}
+
+		policyStartTime := time.Now()
+		_, span := c.tracer.Start(ctx, fmt.Sprintf(constants.SpanPolicyStreamEndFormat, spec.Name),
+			trace.WithSpanKind(trace.SpanKindInternal))
+		if span.IsRecording() {
+			span.SetAttributes(
+				attribute.String(constants.AttrPolicyName, spec.Name),
+				attribute.String(constants.AttrPolicyVersion, spec.Version),
+				attribute.String(constants.AttrStreamEndReason, string(endCtx.Reason)),
+			)
+		}
+
+		params, err := deepCopyParams(spec.Parameters.Raw)
+		if err != nil {
+			slog.WarnContext(ctx, "[policy] failed to clone parameters for stream end hook",
+				"policy", spec.Name, "version", spec.Version, "route", route, "error", err)
+			span.End()
+			continue
+		}
+
+		_, failure := invokePolicy(ctx, c, spec, i, phase, route, endCtx, func(ctx context.Context, endCtx *policy.StreamEndContext) struct{} {
+			hook(ctx, endCtx, params)
+			return struct{}{}
+		})
+		executionTime := time.Since(policyStartTime)
+		if failure != nil {
+			c.recordHookFailure(ctx, span, spec, route, failure)
+		} else {
+			metrics.PolicyDurationSeconds.WithLabelValues(spec.Name, spec.Version, api, route).Observe(executionTime.Seconds())
+			if span.IsRecording() {
+				span.SetAttributes(attribute.Int64(constants.AttrPolicyExecutionTimeNS, executionTime.Nanoseconds()))
+			}
+		}
+		span.End()
+
+		result := StreamEndPolicyResult{
+			PolicyName:    spec.Name,
+			PolicyVersion: spec.Version,
+			ExecutionTime: executionTime,
+		}
+		if failure != nil {
+			result.Error = failure
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// recordHookFailure logs a failed stream end hook and records it in metrics and the hook span.
+func (c *ChainExecutor) recordHookFailure(ctx context.Context, span trace.Span, spec policy.PolicySpec, route string, failure *PolicyFailureError) {
+	logArgs := []any{
+		"policy", spec.Name,
+		"version", spec.Version,
+		"route", route,
+		"phase", failure.Phase,
+		"reason", failure.Reason,
+		"error", failure.Cause,
+	}
+	if pe, ok := failure.Cause.(*panicError); ok {
+		metrics.PanicRecoveriesTotal.WithLabelValues("policy").Inc()
+		logArgs = append(logArgs, "stack", string(pe.stack))
+	}
+	slog.WarnContext(ctx, "[policy] stream end hook failed", logArgs...)
+
+	metrics.PolicyErrorsTotal.WithLabelValues(spec.Name, string(failure.Reason)).Inc()
+
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String(constants.AttrPolicyFailureReason, string(failure.Reason)))
+		span.RecordError(failure.Cause)
+		span.SetStatus(codes.Error, fmt.Sprintf("policy %s", failure.Reason))
+	}
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package executor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/testutils"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// hookLog collects hook calls across policies so tests can assert ordering. Hooks with a time
+// budget run on their own goroutine, hence the lock.
+type hookLog struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (l *hookLog) add(call string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = append(l.calls, call)
+}
+
+func (l *hookLog) snapshot() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string(nil), l.calls...)
+}
+
+// hookPolicy implements both stream lifecycle hooks and records the calls it receives.
+type hookPolicy struct {
+	name   string
+	log    *hookLog
+	panics bool
+	blocks bool
+	seen   []*policy.StreamEndContext
+}
+
+func (p *hookPolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{}
+}
+
+func (p *hookPolicy) record(ctx context.Context, hook string, endCtx *policy.StreamEndContext) {
+	p.log.add(p.name + ":" + hook)
+	p.seen = append(p.seen, endCtx)
+	if p.panics {
+		panic("cleanup failed")
+	}
+	if p.blocks {
+		<-ctx.Done()
+	}
+}
+
+func (p *hookPolicy) OnStreamError(ctx context.Context, endCtx *policy.StreamEndContext, _ map[string]interface{}) {
+	p.record(ctx, "stream_error", endCtx)
+}
+
+func (p *hookPolicy) OnRequestComplete(ctx context.Context, endCtx *policy.StreamEndContext, _ map[string]interface{}) {
+	p.record(ctx, "request_complete", endCtx)
+}
+
+func newStreamEndContext(reason policy.StreamEndReason, err error) *policy.StreamEndContext {
+	return &policy.StreamEndContext{
+		SharedContext: &policy.SharedContext{RequestID: "req-1", Metadata: map[string]interface{}{"tokens": 10}},
+		Reason:        reason,
+		Err:           err,
+		LastPhase:     "response_body",
+	}
+}
+
+func TestExecuteStreamErrorPolicies_AbortReasons(t *testing.T) {
+	reasons := []struct {
+		reason policy.StreamEndReason
+		err    error
+	}{
+		{policy.StreamEndCancelled, context.Canceled},
+		{policy.StreamEndClosed, nil},
+		{policy.StreamEndReceiveError, errors.New("connection reset")},
+		{policy.StreamEndSendError, errors.New("broken pipe")},
+		{policy.StreamEndProcessingError, errors.New("translation failed")},
+	}
+
+	for _, tt := range reasons {
+		t.Run(string(tt.reason), func(t *testing.T) {
+			executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+			log := &hookLog{}
+			first := &hookPolicy{name: "first", log: log}
+			second := &hookPolicy{name: "second", log: log}
+			policies := []policy.Policy{first, &testutils.NoopPolicy{}, second}
+			specs := []policy.PolicySpec{
+				newPolicySpec("first", "v1.0.0", true, nil),
+				newPolicySpec("noop", "v1.0.0", true, nil),
+				newPolicySpec("second", "v1.0.0", true, nil),
+			}
+			endCtx := newStreamEndContext(tt.reason, tt.err)
+
+			results := executor.ExecuteStreamErrorPolicies(context.Background(), policies, endCtx, specs, "api", "route")
+
+			assert.Equal(t, []string{"first:stream_error", "second:stream_error"}, log.snapshot())
+			require.Len(t, results, 2)
+			assert.Equal(t, "first", results[0].PolicyName)
+			assert.Equal(t, "second", results[1].PolicyName)
+			assert.NoError(t, results[0].Error)
+			require.Len(t, first.seen, 1)
+			assert.Same(t, endCtx, first.seen[0])
+			assert.Equal(t, tt.reason, first.seen[0].Reason)
+			assert.Equal(t, tt.err, first.seen[0].Err)
+			assert.Equal(t, 10, first.seen[0].Metadata["tokens"])
+		})
+	}
+}
+
+func TestExecuteStreamErrorPolicies_SkipsDisabledPolicies(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	log := &hookLog{}
+	policies := []policy.Policy{&hookPolicy{name: "disabled", log: log}, &hookPolicy{name: "enabled", log: log}}
+	specs := []policy.PolicySpec{
+		newPolicySpec("disabled", "v1.0.0", false, nil),
+		newPolicySpec("enabled", "v1.0.0", true, nil),
+	}
+
+	results := executor.ExecuteStreamErrorPolicies(context.Background(), policies,
+		newStreamEndContext(policy.StreamEndCancelled, context.Canceled), specs, "api", "route")
+
+	assert.Equal(t, []string{"enabled:stream_error"}, log.snapshot())
+	assert.Len(t, results, 1)
+}
+
+func TestExecuteStreamErrorPolicies_PanicIsIsolated(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	log := &hookLog{}
+	policies := []policy.Policy{&hookPolicy{name: "buggy", log: log, panics: true}, &hookPolicy{name: "next", log: log}}
+	// fail-closed has no effect on hooks: the stream has already ended.
+	specs := []policy.PolicySpec{
+		newFaultSpec("buggy", policy.OnErrorFailClosed, 0),
+		newFaultSpec("next", "", 0),
+	}
+
+	results := executor.ExecuteStreamErrorPolicies(context.Background(), policies,
+		newStreamEndContext(policy.StreamEndReceiveError, errors.New("reset")), specs, "api", "route")
+
+	assert.Equal(t, []string{"buggy:stream_error", "next:stream_error"}, log.snapshot())
+	require.Len(t, results, 2)
+	var failure *PolicyFailureError
+	require.ErrorAs(t, results[0].Error, &failure)
+	assert.Equal(t, FailureReasonPanic, failure.Reason)
+	assert.Equal(t, PhaseStreamError, failure.Phase)
+	assert.NoError(t, results[1].Error)
+}
+
+func TestExecuteRequestCompletePolicies_TimeoutIsIsolated(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	log := &hookLog{}
+	policies := []policy.Policy{&hookPolicy{name: "slow", log: log, blocks: true}, &hookPolicy{name: "next", log: log}}
+	specs := []policy.PolicySpec{
+		newFaultSpec("slow", "", 20*time.Millisecond),
+		newFaultSpec("next", "", 0),
+	}
+
+	results := executor.ExecuteRequestCompletePolicies(context.Background(), policies,
+		newStreamEndContext(policy.StreamEndCompleted, nil), specs, "api", "route")
+
+	require.Len(t, results, 2)
+	var failure *PolicyFailureError
+	require.ErrorAs(t, results[0].Error, &failure)
+	assert.Equal(t, FailureReasonTimeout, failure.Reason)
+	assert.Equal(t, PhaseRequestComplete, failure.Phase)
+	assert.NoError(t, results[1].Error)
+	assert.Contains(t, log.snapshot(), "next:request_complete")
+}
+
+func TestExecuteRequestCompletePolicies_NoHooks(t *testing.T) {
+	executor := NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	policies := []policy.Policy{&testutils.NoopPolicy{}}
+	specs := []policy.PolicySpec{newPolicySpec("noop", "v1.0.0", true, nil)}
+
+	results := executor.ExecuteRequestCompletePolicies(context.Background(), policies,
+		newStreamEndContext(policy.StreamEndCompleted, nil), specs, "api", "route")
+
+	assert.Empty(t, results)
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package httpclient
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+)
+
+// Circuit states reported by the policy_http_circuit_breaker_state gauge.
+const (
+	circuitClosed   = 0
+	circuitOpen     = 1
+	circuitHalfOpen = 2
+)
+
+type hostState struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// hostBreakers tracks consecutive failed attempts per target host, shared by all policies.
+// State is only allocated for hosts that have failed.
+type hostBreakers struct {
+	cfg config.HTTPClientCircuitBreakerConfig
+	now func() time.Time
+
+	mu     sync.RWMutex
+	states map[string]*hostState
+}
+
+func newHostBreakers(cfg config.HTTPClientCircuitBreakerConfig) *hostBreakers {
+	return &hostBreakers{
+		cfg:    cfg,
+		now:    time.Now,
+		states: make(map[string]*hostState),
+	}
+}
+
+func (b *hostBreakers) enabled() bool {
+	return b.cfg.Enabled && b.cfg.FailureThreshold > 0
+}
+
+func (b *hostBreakers) lookup(host string) *hostState {
+	b.mu.RLock()
+	st := b.states[host]
+	b.mu.RUnlock()
+	return st
+}
+
+// allow reports whether an attempt to host may be sent. Once the open period has elapsed a
+// single trial attempt is allowed; its outcome closes or re-opens the circuit.
+func (b *hostBreakers) allow(host string) bool {
+	if !b.enabled() {
+		return true
+	}
+	st := b.lookup(host)
+	if st == nil {
+		return true
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if st.openUntil.IsZero() {
+		return true
+	}
+	if b.now().Before(st.openUntil) || st.probing {
+		return false
+	}
+	st.probing = true
+	metrics.PolicyHTTPCircuitBreakerState.WithLabelValues(host).Set(circuitHalfOpen)
+	return true
+}
+
+// release ends a trial attempt without an outcome (the caller cancelled it), so that the
+// next attempt can be the trial.
+func (b *hostBreakers) release(host string) {
+	if !b.enabled() {
+		return
+	}
+	if st := b.lookup(host); st != nil {
+		st.mu.Lock()
+		st.probing = false
+		st.mu.Unlock()
+	}
+}
+
+func (b *hostBreakers) recordSuccess(host string) {
+	if !b.enabled() {
+		return
+	}
+	st := b.lookup(host)
+	if st == nil {
+		return
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	wasOpen := !st.openUntil.IsZero()
+	st.failures = 0
+	st.openUntil = time.Time{}
+	st.probing = false
+	if wasOpen {
+		metrics.PolicyHTTPCircuitBreakerState.WithLabelValues(host).Set(circuitClosed)
+		slog.Info("[policy-http] circuit breaker closed", "host", host)
+	}
+}
+
+func (b *hostBreakers) recordFailure(host string) {
+	if !b.enabled() {
+		return
+	}
+	st := b.lookup(host)
+	if st == nil {
+		b.mu.Lock()
+		if st = b.states[host]; st == nil {
+			st = &hostState{}
+			b.states[host] = st
+		}
+		b.mu.Unlock()
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.failures++
+	if !st.probing && (!st.openUntil.IsZero() || st.failures < b.cfg.FailureThreshold) {
+		return
+	}
+	st.probing = false
+	st.openUntil = b.now().Add(b.cfg.OpenDuration)
+	metrics.PolicyHTTPCircuitBreakerState.WithLabelValues(host).Set(circuitOpen)
+	slog.Warn("[policy-http] circuit breaker opened",
+		"host", host,
+		"consecutive_failures", st.failures,
+		"open_for", b.cfg.OpenDuration,
+	)
+}
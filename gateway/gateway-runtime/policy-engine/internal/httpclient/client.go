@@ -0,0 +1,289 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Package httpclient implements the outbound HTTP client policies receive through
+// PolicyMetadata.HTTPClient.
+package httpclient
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// maxDrainBytes bounds how much of a discarded response body is read so that its
+// connection can be reused.
+const maxDrainBytes = 64 << 10
+
+// Client is the outbound HTTP client shared by all policies. Its transport, connection pool
+// and per-host circuit breakers are shared; ForPolicy returns the view handed to one policy.
+type Client struct {
+	cfg       config.HTTPClientConfig
+	transport *http.Transport
+	client    *http.Client
+	tracer    trace.Tracer
+	breakers  *hostBreakers
+
+	// sleep waits between attempts; replaced in tests
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// New creates the shared client. Spans for outbound calls are started with tracer, which
+// may be a no-op tracer; the W3C trace context is propagated either way.
+func New(cfg config.HTTPClientConfig, tracer trace.Tracer) (*Client, error) {
+	tlsConfig, err := newTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.MaxIdleConns = cfg.MaxIdleConns
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+
+	return &Client{
+		cfg:       cfg,
+		transport: transport,
+		client:    &http.Client{Transport: transport, Timeout: cfg.Timeout},
+		tracer:    tracer,
+		breakers:  newHostBreakers(cfg.CircuitBreaker),
+		sleep:     sleepContext,
+	}, nil
+}
+
+// ForPolicy returns the client handed to a policy; its calls are labelled with policyName.
+func (c *Client) ForPolicy(policyName string) policy.PolicyHTTPClient {
+	return &policyClient{client: c, policyName: policyName}
+}
+
+// Close releases idle pooled connections.
+func (c *Client) Close() {
+	c.transport.CloseIdleConnections()
+}
+
+type policyClient struct {
+	client     *Client
+	policyName string
+}
+
+func (p *policyClient) Do(req *http.Request) (*http.Response, error) {
+	return p.client.do(p.policyName, req)
+}
+
+func (c *Client) do(policyName string, req *http.Request) (*http.Response, error) {
+	if req.URL == nil {
+		return nil, errors.New("http: nil Request.URL")
+	}
+	host := req.URL.Host
+	start := time.Now()
+
+	ctx, span := c.tracer.Start(req.Context(), "policy.http "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("server.address", req.URL.Hostname()),
+			attribute.String("url.full", req.URL.Redacted()),
+			attribute.String("policy.name", policyName),
+		),
+	)
+	defer span.End()
+
+	resp, attempts, err := c.send(ctx, policyName, host, req)
+
+	outcome := "error"
+	switch {
+	case errors.Is(err, policy.ErrHTTPCircuitOpen):
+		outcome = "circuit_open"
+	case err == nil:
+		outcome = strconv.Itoa(resp.StatusCode/100) + "xx"
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		if resp.StatusCode >= 500 {
+			span.SetStatus(codes.Error, resp.Status)
+		}
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	if attempts > 1 {
+		span.SetAttributes(attribute.Int("http.request.resend_count", attempts-1))
+	}
+	metrics.PolicyHTTPRequestsTotal.WithLabelValues(policyName, host, req.Method, outcome).Inc()
+	metrics.PolicyHTTPRequestDurationSeconds.WithLabelValues(policyName, host).Observe(time.Since(start).Seconds())
+
+	return resp, err
+}
+
+// send makes the attempts for one call and returns the final response or error and the
+// number of attempts sent.
+func (c *Client) send(ctx context.Context, policyName, host string, req *http.Request) (*http.Response, int, error) {
+	maxRetries := 0
+	if isReplayable(req) {
+		maxRetries = c.cfg.Retry.MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		if !c.breakers.allow(host) {
+			return nil, attempt, fmt.Errorf("%s: %w", host, policy.ErrHTTPCircuitOpen)
+		}
+
+		attemptReq, err := newAttempt(ctx, req, attempt)
+		if err != nil {
+			return nil, attempt, err
+		}
+		resp, err := c.client.Do(attemptReq)
+
+		switch {
+		case err != nil && ctx.Err() != nil:
+			// Cancelled by the caller; says nothing about the host.
+			c.breakers.release(host)
+		case err != nil || resp.StatusCode >= http.StatusInternalServerError:
+			c.breakers.recordFailure(host)
+		default:
+			c.breakers.recordSuccess(host)
+		}
+
+		if attempt >= maxRetries || ctx.Err() != nil || !shouldRetry(resp, err) {
+			return resp, attempt + 1, err
+		}
+
+		delay := c.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.CopyN(io.Discard, resp.Body, maxDrainBytes)
+			_ = resp.Body.Close()
+		}
+		metrics.PolicyHTTPRetriesTotal.WithLabelValues(policyName, host).Inc()
+		if err := c.sleep(ctx, delay); err != nil {
+			return nil, attempt + 1, err
+		}
+	}
+}
+
+// newAttempt prepares the request for one attempt: bound to ctx (which carries the client
+// span), with a fresh body for retries and the W3C trace context headers.
+func newAttempt(ctx context.Context, req *http.Request, attempt int) (*http.Request, error) {
+	attemptReq := req.Clone(ctx)
+	if attempt > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewind request body for retry: %w", err)
+		}
+		attemptReq.Body = body
+	}
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(attemptReq.Header))
+	return attemptReq, nil
+}
+
+// isReplayable reports whether req may be sent more than once: its method is idempotent or
+// it carries an Idempotency-Key, and its body can be recreated.
+func isReplayable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodTrace:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+// shouldRetry reports whether an attempt failed in a way another attempt may fix.
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns the delay before the attempt after attempt: exponential with jitter, or the
+// server's Retry-After (in seconds) when given, capped at MaxBackoff either way.
+func (c *Client) backoff(attempt int, resp *http.Response) time.Duration {
+	maxBackoff := c.cfg.Retry.MaxBackoff
+	if resp != nil {
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs >= 0 {
+			return min(time.Duration(secs)*time.Second, maxBackoff)
+		}
+	}
+	d := c.cfg.Retry.InitialBackoff << attempt
+	if d <= 0 || d > maxBackoff {
+		d = maxBackoff
+	}
+	// Jitter in [d/2, d) spreads out retries from concurrent requests.
+	return d/2 + rand.N(d/2+1)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// newTLSConfig builds the TLS settings of the shared transport.
+func newTLSConfig(cfg config.HTTPClientTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CertPath != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertPath, cfg.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load policy HTTP client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	if cfg.InsecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true
+		return tlsConfig, nil
+	}
+
+	trust, err := newTrustStore(cfg.CAPath, cfg.TrustControllerCertificates)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.TrustControllerCertificates {
+		tlsConfig.RootCAs = trust.base
+		return tlsConfig, nil
+	}
+	// The controller's certificates change at runtime, so the server certificate is verified
+	// against the current trust store in VerifyConnection instead of a fixed RootCAs.
+	tlsConfig.InsecureSkipVerify = true
+	tlsConfig.VerifyConnection = trust.verifyConnection
+	return tlsConfig, nil
+}
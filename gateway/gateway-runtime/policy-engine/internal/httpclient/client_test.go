@@ -0,0 +1,275 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+func init() {
+	metrics.Init()
+}
+
+func testConfig() config.HTTPClientConfig {
+	return config.HTTPClientConfig{
+		Timeout:             5 * time.Second,
+		MaxIdleConns:        10,
+		MaxIdleConnsPerHost: 2,
+		IdleConnTimeout:     time.Minute,
+		Retry: config.HTTPClientRetryConfig{
+			MaxRetries:     2,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     10 * time.Millisecond,
+		},
+		CircuitBreaker: config.HTTPClientCircuitBreakerConfig{
+			Enabled:          true,
+			FailureThreshold: 3,
+			OpenDuration:     time.Minute,
+		},
+	}
+}
+
+func newTestClient(t *testing.T, cfg config.HTTPClientConfig) *Client {
+	t.Helper()
+	c, err := New(cfg, noop.NewTracerProvider().Tracer("test"))
+	require.NoError(t, err)
+	c.sleep = func(context.Context, time.Duration) error { return nil }
+	t.Cleanup(c.Close)
+	return c
+}
+
+// statusSequence serves the given statuses in order, then 200, and counts requests.
+func statusSequence(statuses ...int) (http.HandlerFunc, *atomic.Int32) {
+	var calls atomic.Int32
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		if n <= len(statuses) {
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		_, _ = io.Copy(w, r.Body)
+	}, &calls
+}
+
+func TestDo_PropagatesTraceContext(t *testing.T) {
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer server.Close()
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := newTestClient(t, testConfig()).ForPolicy("interceptor-service").Do(req)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.True(t, strings.HasPrefix(traceparent, "00-4bf92f3577b34da6a3ce929d0e0e4736-"), traceparent)
+	assert.Empty(t, req.Header.Get("traceparent"), "the caller's request must not be modified")
+}
+
+func TestDo_RetriesIdempotentRequests(t *testing.T) {
+	handler, calls := statusSequence(http.StatusServiceUnavailable, http.StatusBadGateway)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := newTestClient(t, testConfig()).ForPolicy("guardrail").Do(req)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestDo_ReturnsLastResponseWhenRetriesExhausted(t *testing.T) {
+	handler, calls := statusSequence(503, 503, 503, 503)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := newTestClient(t, testConfig()).ForPolicy("guardrail").Do(req)
+
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestDo_PostRetriedOnlyWithIdempotencyKey(t *testing.T) {
+	t.Run("without key", func(t *testing.T) {
+		handler, calls := statusSequence(http.StatusServiceUnavailable)
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte(`{"input":"hi"}`)))
+		require.NoError(t, err)
+		resp, err := newTestClient(t, testConfig()).ForPolicy("embeddings").Do(req)
+
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("with key the body is replayed", func(t *testing.T) {
+		handler, calls := statusSequence(http.StatusServiceUnavailable)
+		server := httptest.NewServer(handler)
+		defer server.Close()
+
+		req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte(`{"input":"hi"}`)))
+		require.NoError(t, err)
+		req.Header.Set("Idempotency-Key", "abc")
+		resp, err := newTestClient(t, testConfig()).ForPolicy("embeddings").Do(req)
+
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, `{"input":"hi"}`, string(body))
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
+
+func TestDo_CircuitOpensPerHost(t *testing.T) {
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	healthy := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer healthy.Close()
+
+	cfg := testConfig()
+	cfg.Retry.MaxRetries = 0
+	c := newTestClient(t, cfg)
+	now := time.Now()
+	c.breakers.now = func() time.Time { return now }
+	client := c.ForPolicy("interceptor-service")
+
+	get := func(target string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		return resp, err
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := get(failing.URL)
+		require.NoError(t, err)
+	}
+	_, err := get(failing.URL)
+	assert.ErrorIs(t, err, policy.ErrHTTPCircuitOpen)
+
+	resp, err := get(healthy.URL)
+	require.NoError(t, err, "other hosts are not affected")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// After the open period a trial call is let through.
+	now = now.Add(2 * time.Minute)
+	_, err = get(failing.URL)
+	require.NoError(t, err)
+	_, err = get(failing.URL)
+	assert.ErrorIs(t, err, policy.ErrHTTPCircuitOpen, "a failed trial re-opens the circuit")
+}
+
+func TestDo_HonoursRetryAfter(t *testing.T) {
+	c := newTestClient(t, testConfig())
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"1"}}}
+
+	assert.Equal(t, 10*time.Millisecond, c.backoff(0, resp), "capped at max_backoff")
+
+	c.cfg.Retry.MaxBackoff = 5 * time.Second
+	assert.Equal(t, time.Second, c.backoff(0, resp))
+	d := c.backoff(3, nil)
+	assert.GreaterOrEqual(t, d, 4*time.Millisecond)
+	assert.LessOrEqual(t, d, 8*time.Millisecond)
+}
+
+func TestDo_TrustsControllerCertificates(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+	// httptest certificates are issued for example.com and 127.0.0.1.
+	target, _ := url.Parse(server.URL)
+
+	cfg := testConfig()
+	cfg.Retry.MaxRetries = 0
+	cfg.TLS.TrustControllerCertificates = true
+	client := newTestClient(t, cfg).ForPolicy("interceptor-service")
+
+	get := func() error {
+		req, err := http.NewRequest(http.MethodGet, target.String(), nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		if resp != nil {
+			_ = resp.Body.Close()
+		}
+		return err
+	}
+
+	err := get()
+	require.Error(t, err)
+	var certErr *tls.CertificateVerificationError
+	assert.True(t, errors.As(err, &certErr) || strings.Contains(err.Error(), "certificate"), err.Error())
+
+	store := policy.GetLazyResourceStoreInstance()
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.NoError(t, store.StoreResource(&policy.LazyResource{
+		ID:           ControllerCertificatesResourceID,
+		ResourceType: ControllerCertificatesResourceType,
+		Resource:     map[string]interface{}{"pem": string(certPEM)},
+	}))
+	t.Cleanup(func() {
+		_ = store.RemoveResourceByIDAndType(ControllerCertificatesResourceID, ControllerCertificatesResourceType)
+	})
+
+	assert.NoError(t, get(), "certificates published by the controller are trusted without a restart")
+}
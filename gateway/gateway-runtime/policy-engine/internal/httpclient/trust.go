@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// The gateway controller publishes the certificates managed through its certificates API
+// as a lazy resource of this type and ID; the "pem" field holds the PEM bundle.
+const (
+	ControllerCertificatesResourceType = "TrustedCertificates"
+	ControllerCertificatesResourceID   = "upstream_ca_bundle"
+)
+
+// trustStore holds the CAs the policy HTTP client trusts: the system CAs and the configured
+// CA bundle, plus the controller's certificates when they are trusted.
+type trustStore struct {
+	base *x509.CertPool
+
+	// controllerPEM returns the controller's current PEM bundle; nil when not trusted
+	controllerPEM func() string
+
+	mu      sync.Mutex
+	lastPEM string
+	pool    *x509.CertPool
+}
+
+func newTrustStore(caPath string, trustController bool) (*trustStore, error) {
+	base, err := x509.SystemCertPool()
+	if err != nil {
+		slog.Warn("System CA certificates unavailable for the policy HTTP client", "error", err)
+		base = x509.NewCertPool()
+	}
+	if caPath != "" {
+		pem, err := os.ReadFile(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policy HTTP client CA bundle: %w", err)
+		}
+		if !base.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in policy HTTP client CA bundle %s", caPath)
+		}
+	}
+
+	t := &trustStore{base: base, pool: base}
+	if trustController {
+		t.controllerPEM = controllerCertificates
+	}
+	return t, nil
+}
+
+// controllerCertificates reads the controller's certificate bundle from the lazy resource store.
+func controllerCertificates() string {
+	resource, err := policy.GetLazyResourceStoreInstance().GetResourceByIDAndType(
+		ControllerCertificatesResourceID, ControllerCertificatesResourceType)
+	if err != nil {
+		return ""
+	}
+	pem, _ := resource.Resource["pem"].(string)
+	return pem
+}
+
+// roots returns the current pool, rebuilding it when the controller's bundle has changed.
+func (t *trustStore) roots() *x509.CertPool {
+	if t.controllerPEM == nil {
+		return t.base
+	}
+	pem := t.controllerPEM()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if pem == t.lastPEM {
+		return t.pool
+	}
+	pool := t.base.Clone()
+	if pem != "" && !pool.AppendCertsFromPEM([]byte(pem)) {
+		slog.Warn("No certificates found in the controller's certificate bundle")
+	}
+	t.lastPEM = pem
+	t.pool = pool
+	return pool
+}
+
+// verifyConnection verifies the server certificate chain and host name against the current
+// trust store, as crypto/tls does with RootCAs.
+func (t *trustStore) verifyConnection(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("tls: server presented no certificates")
+	}
+	opts := x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         t.roots(),
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/debugcapture"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// beginDebugCapture starts recording the request's policy decisions when an admin
+// debug capture session selects it. Must run after buildRequestContexts.
+func (ec *PolicyExecutionContext) beginDebugCapture() {
+	if ec.server == nil || ec.server.kernel == nil {
+		return
+	}
+	ec.capture = ec.server.kernel.DebugCapture().Begin(
+		ec.routeKey,
+		ec.requestID,
+		ec.requestHeaderCtx.Headers,
+		ec.policyChain.PolicySpecs,
+	)
+}
+
+// requestCaptureInput snapshots the request state a request phase runs with.
+// Returns nil when the request is not being captured.
+func (ec *PolicyExecutionContext) requestCaptureInput(body *policy.Body) *debugcapture.PhaseInput {
+	if ec.capture == nil {
+		return nil
+	}
+	h := ec.requestHeaderCtx
+	content, present, eos := bodyParts(body)
+	return ec.capture.Input(h.Method, h.Path, h.Authority, 0, h.Headers, content, present, eos)
+}
+
+// requestChunkCaptureInput snapshots the request state and the chunk a streamed
+// request-body phase runs with.
+func (ec *PolicyExecutionContext) requestChunkCaptureInput(chunk *policy.StreamBody) *debugcapture.PhaseInput {
+	if ec.capture == nil {
+		return nil
+	}
+	h := ec.requestHeaderCtx
+	return ec.capture.Input(h.Method, h.Path, h.Authority, 0, h.Headers, chunk.Chunk, true, chunk.EndOfStream)
+}
+
+// responseCaptureInput snapshots the response state a response phase runs with.
+func (ec *PolicyExecutionContext) responseCaptureInput(headers *policy.Headers, status int, body *policy.Body) *debugcapture.PhaseInput {
+	if ec.capture == nil {
+		return nil
+	}
+	h := ec.requestHeaderCtx
+	content, present, eos := bodyParts(body)
+	return ec.capture.Input(h.Method, h.Path, h.Authority, status, headers, content, present, eos)
+}
+
+// responseChunkCaptureInput snapshots the response state and the chunk a streamed
+// response-body phase runs with.
+func (ec *PolicyExecutionContext) responseChunkCaptureInput(chunk *policy.StreamBody) *debugcapture.PhaseInput {
+	if ec.capture == nil {
+		return nil
+	}
+	h := ec.requestHeaderCtx
+	r := ec.responseStreamContext
+	return ec.capture.Input(h.Method, h.Path, h.Authority, r.ResponseStatus, r.ResponseHeaders, chunk.Chunk, true, chunk.EndOfStream)
+}
+
+func bodyParts(body *policy.Body) (content []byte, present, endOfStream bool) {
+	if body == nil || !body.Present {
+		return nil, false, false
+	}
+	return body.Content, true, body.EndOfStream
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"testing"
+
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/debugcapture"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+func TestProcess_DebugCaptureRecordsPolicyDecisions(t *testing.T) {
+	setter := &lifecyclePolicy{headerAction: policy.UpstreamRequestHeaderModifications{
+		HeadersToSet: map[string]string{"x-debug": "on"},
+	}}
+	server := newLifecycleServerWithChain(&registry.PolicyChain{
+		Policies: []policy.Policy{setter, &lifecyclePolicy{}},
+		PolicySpecs: []policy.PolicySpec{
+			{Name: "setter", Version: "v1.0.0", Enabled: true, Parameters: policy.PolicyParameters{Raw: map[string]interface{}{"limit": 10}}},
+			{Name: "off", Version: "v1.0.0", Enabled: false},
+		},
+		RequiresRequestHeader: true,
+	})
+	session, err := server.kernel.DebugCapture().Start(debugcapture.SessionConfig{RouteKey: "lifecycle-route"})
+	require.NoError(t, err)
+
+	stream := newMockStream([]*extprocv3.ProcessingRequest{
+		lifecycleRequestHeaders(),
+		lifecycleResponseHeaders("200", true),
+	})
+	require.NoError(t, server.Process(stream))
+
+	capture, err := server.kernel.DebugCapture().Get(session.ID)
+	require.NoError(t, err)
+	require.Len(t, capture.Requests, 1)
+	trace := capture.Requests[0]
+	assert.Equal(t, "req-lifecycle", trace.RequestID)
+	assert.True(t, trace.Completed)
+	assert.Equal(t, string(policy.StreamEndCompleted), trace.EndReason)
+	assert.Equal(t, 200, trace.ResponseStatus)
+	require.Len(t, trace.Chain, 2)
+	assert.JSONEq(t, `{"limit":10}`, string(trace.Chain[0].Parameters))
+
+	require.NotEmpty(t, trace.Phases)
+	phase := trace.Phases[0]
+	assert.Equal(t, "request_headers", phase.Phase)
+	require.NotNil(t, phase.Input)
+	assert.Equal(t, "/lifecycle", phase.Input.Path)
+	assert.NotContains(t, phase.Input.Headers, "x-debug", "input must be captured before mutations")
+	require.Len(t, phase.Policies, 2)
+	assert.Equal(t, "setter", phase.Policies[0].Name)
+	require.NotNil(t, phase.Policies[0].Mutations)
+	assert.Equal(t, map[string]string{"x-debug": "on"}, phase.Policies[0].Mutations.HeadersToSet)
+	assert.True(t, phase.Policies[1].Skipped)
+	assert.Equal(t, debugcapture.SkipReasonDisabled, phase.Policies[1].SkipReason)
+}
+
+func TestProcess_DebugCaptureIgnoresOtherRoutes(t *testing.T) {
+	server := newLifecycleServer(&lifecyclePolicy{})
+	session, err := server.kernel.DebugCapture().Start(debugcapture.SessionConfig{RouteKey: "other-route"})
+	require.NoError(t, err)
+
+	stream := newMockStream([]*extprocv3.ProcessingRequest{lifecycleRequestHeaders()})
+	require.NoError(t, server.Process(stream))
+
+	capture, err := server.kernel.DebugCapture().Get(session.ID)
+	require.NoError(t, err)
+	assert.Empty(t, capture.Requests)
+}
@@ -19,38 +19,152 @@
 package kernel
 
 import (
+	"bufio"
 	"bytes"
+	"compress/flate"
 	"compress/gzip"
+	"compress/zlib"
+	"errors"
 	"fmt"
 	"io"
 	"runtime"
+	"strings"
 
 	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 )
 
-// decompressBody decompresses body bytes based on the Content-Encoding value.
-// Supported encodings: "gzip", "br" (Brotli). Unknown encodings are returned as-is.
-func decompressBody(body []byte, encoding string) ([]byte, error) {
+// Content-Encoding values the kernel decompresses for body policies and recompresses afterwards.
+const (
+	encodingGzip    = "gzip"
+	encodingBrotli  = "br"
+	encodingZstd    = "zstd"
+	encodingDeflate = "deflate"
+)
+
+// DefaultMaxDecompressedBodyBytes is the default limit on the decompressed size of a
+// request or response body.
+const DefaultMaxDecompressedBodyBytes int64 = 10 << 20
+
+// errDecompressedBodyTooLarge is returned when a body decompresses to more than the
+// configured maximum, which guards against decompression bombs.
+var errDecompressedBodyTooLarge = errors.New("decompressed body exceeds the maximum size")
+
+// zstdEncoder compresses whole bodies; EncodeAll is safe for concurrent use.
+var zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+
+// DecompressionConfig controls how the kernel handles Content-Encoded bodies on routes
+// whose policy chain processes bodies.
+type DecompressionConfig struct {
+	// MaxDecompressedBytes limits the decompressed size of a body (or of all chunks of a
+	// streamed body). Zero or negative disables the limit.
+	MaxDecompressedBytes int64
+
+	// RejectUnsupportedEncoding refuses requests (415) and upstream responses (502) whose
+	// Content-Encoding cannot be decompressed. When false, such bodies reach the policies
+	// as received and a warning is logged.
+	RejectUnsupportedEncoding bool
+}
+
+// DefaultDecompressionConfig returns the decompression settings used when none are configured.
+func DefaultDecompressionConfig() DecompressionConfig {
+	return DecompressionConfig{MaxDecompressedBytes: DefaultMaxDecompressedBodyBytes}
+}
+
+// normalizeContentEncoding lowercases a Content-Encoding header value. "identity" means
+// no encoding and is returned as "".
+func normalizeContentEncoding(value string) string {
+	encoding := strings.ToLower(strings.TrimSpace(value))
+	if encoding == "identity" {
+		return ""
+	}
+	return encoding
+}
+
+// isSupportedEncoding reports whether the kernel can decompress and recompress a body
+// with the given (normalized) Content-Encoding. Stacked encodings such as "gzip, br"
+// are not supported.
+func isSupportedEncoding(encoding string) bool {
+	switch encoding {
+	case encodingGzip, encodingBrotli, encodingZstd, encodingDeflate:
+		return true
+	default:
+		return false
+	}
+}
+
+// newDecoder wraps r in a decoder for the given Content-Encoding. Unknown encodings
+// are passed through.
+func newDecoder(r io.Reader, encoding string) (io.ReadCloser, error) {
 	switch encoding {
-	case "gzip":
-		r, err := gzip.NewReader(bytes.NewReader(body))
+	case encodingGzip:
+		gr, err := gzip.NewReader(r)
 		if err != nil {
 			return nil, fmt.Errorf("gzip reader: %w", err)
 		}
-		defer r.Close()
-		return io.ReadAll(r)
-	case "br":
-		r := brotli.NewReader(bytes.NewReader(body))
-		return io.ReadAll(r)
+		return gr, nil
+	case encodingBrotli:
+		return io.NopCloser(brotli.NewReader(r)), nil
+	case encodingZstd:
+		zr, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, fmt.Errorf("zstd reader: %w", err)
+		}
+		return zr.IOReadCloser(), nil
+	case encodingDeflate:
+		return newDeflateReader(r), nil
 	default:
+		return io.NopCloser(r), nil
+	}
+}
+
+// newDeflateReader decodes the "deflate" Content-Encoding. RFC 9110 defines it as a
+// zlib stream, but some servers send raw DEFLATE, so the zlib header is detected.
+func newDeflateReader(r io.Reader) io.ReadCloser {
+	br := bufio.NewReader(r)
+	if header, err := br.Peek(2); err == nil && isZlibHeader(header) {
+		if zr, err := zlib.NewReader(br); err == nil {
+			return zr
+		}
+	}
+	return flate.NewReader(br)
+}
+
+// isZlibHeader reports whether b starts with a zlib header: compression method 8
+// (DEFLATE) and a header checksum that is a multiple of 31 (RFC 1950).
+func isZlibHeader(b []byte) bool {
+	return b[0]&0x0f == 8 && (uint16(b[0])<<8|uint16(b[1]))%31 == 0
+}
+
+// decompressBody decompresses body bytes based on the Content-Encoding value.
+// Supported encodings: "gzip", "br" (Brotli), "zstd", "deflate". Unknown encodings are
+// returned as-is. maxBytes limits the decompressed size; zero or negative disables it.
+func decompressBody(body []byte, encoding string, maxBytes int64) ([]byte, error) {
+	if !isSupportedEncoding(encoding) {
 		return body, nil
 	}
+	r, err := newDecoder(bytes.NewReader(body), encoding)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	if maxBytes <= 0 {
+		return io.ReadAll(r)
+	}
+	out, err := io.ReadAll(io.LimitReader(r, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(out)) > maxBytes {
+		return nil, errDecompressedBodyTooLarge
+	}
+	return out, nil
 }
 
 // streamDecompressor provides true per-chunk streaming decompression using an io.Pipe
 // and a persistent decoder goroutine. Incoming compressed chunks are written to the
-// pipe; the goroutine owns the stateful gzip/brotli reader and pushes decompressed
-// bytes to an output channel as complete blocks become available.
+// pipe; the goroutine owns the stateful decoder and pushes decompressed bytes to an
+// output channel as complete blocks become available.
 type streamDecompressor struct {
 	pipeWriter *io.PipeWriter
 	outChan    chan []byte
@@ -58,100 +172,117 @@ type streamDecompressor struct {
 }
 
 // newStreamDecompressor starts the background decoder goroutine and returns a
-// streamDecompressor ready to accept chunks via FeedChunk.
-func newStreamDecompressor(encoding string) *streamDecompressor {
+// streamDecompressor ready to accept chunks via FeedChunk. maxBytes limits the total
+// decompressed size of the stream; zero or negative disables it.
+func newStreamDecompressor(encoding string, maxBytes int64) *streamDecompressor {
 	pr, pw := io.Pipe()
 	outChan := make(chan []byte, 64)
 	errChan := make(chan error, 1)
 
 	go func() {
 		defer close(outChan)
-		var r io.Reader
-		switch encoding {
-		case "gzip":
-			gr, err := gzip.NewReader(pr)
-			if err != nil {
-				select {
-				case errChan <- fmt.Errorf("gzip.NewReader: %w", err):
-				default:
-				}
-				_ = pr.CloseWithError(err)
-				return
-			}
-			defer gr.Close()
-			r = gr
-		case "br":
-			r = brotli.NewReader(pr)
-		default:
-			r = pr
-		}
-
-		buf := make([]byte, 32*1024)
-		for {
-			n, err := r.Read(buf)
-			if n > 0 {
-				out := make([]byte, n)
-				copy(out, buf[:n])
-				outChan <- out
-			}
-			if err == io.EOF {
-				return
-			}
-			if err != nil {
-				select {
-				case errChan <- err:
-				default:
-				}
-				return
-			}
+		err := decodeStream(pr, encoding, maxBytes, outChan)
+		// Unblock a FeedChunk still writing to a decoder that stopped reading.
+		_ = pr.CloseWithError(err)
+		if err != nil {
+			errChan <- err
 		}
 	}()
 
 	return &streamDecompressor{pipeWriter: pw, outChan: outChan, errChan: errChan}
 }
 
+// decodeStream decodes everything written to the pipe and pushes it to outChan.
+func decodeStream(pr *io.PipeReader, encoding string, maxBytes int64, outChan chan<- []byte) error {
+	r, err := newDecoder(pr, encoding)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	var total int64
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			total += int64(n)
+			if maxBytes > 0 && total > maxBytes {
+				return errDecompressedBodyTooLarge
+			}
+			out := make([]byte, n)
+			copy(out, buf[:n])
+			outChan <- out
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
 // FeedChunk writes compressed bytes into the decoder and returns whatever decompressed
 // bytes are immediately available.
 //
 // For intermediate chunks the output may be empty — the decoder needs more input before
-// a full DEFLATE/brotli block can be decoded. Callers must tolerate empty output.
+// a full block can be decoded. Callers must tolerate empty output.
 //
 // On endOfStream=true the pipe writer is closed and FeedChunk blocks until all remaining
 // decompressed bytes have been flushed by the goroutine.
 func (sd *streamDecompressor) FeedChunk(chunk []byte, endOfStream bool) ([]byte, error) {
+	var result []byte
 	if len(chunk) > 0 {
-		if _, err := sd.pipeWriter.Write(chunk); err != nil {
-			return nil, fmt.Errorf("stream decompressor write: %w", err)
+		// Write from a separate goroutine and drain output meanwhile: a highly compressible
+		// chunk can decode to more than outChan buffers before the decoder has consumed it.
+		written := make(chan error, 1)
+		go func() {
+			_, err := sd.pipeWriter.Write(chunk)
+			written <- err
+		}()
+		for done := false; !done; {
+			select {
+			case data, ok := <-sd.outChan:
+				if !ok {
+					// The decoder stopped; the write fails once the pipe reader is closed.
+					if err := <-written; err != nil {
+						return result, sd.failure(err)
+					}
+					return result, nil
+				}
+				result = append(result, data...)
+			case err := <-written:
+				if err != nil {
+					return result, sd.failure(err)
+				}
+				done = true
+			}
 		}
 	}
 
 	if endOfStream {
 		_ = sd.pipeWriter.Close()
-		var result []byte
 		for data := range sd.outChan {
 			result = append(result, data...)
 		}
 		select {
 		case err := <-sd.errChan:
-			if err != nil {
-				return result, err
-			}
+			return result, err
 		default:
 		}
 		return result, nil
 	}
 
-	// pw.Write blocks until the goroutine's pr.Read has consumed all our bytes.
+	// pw.Write returns once the goroutine's r.Read has consumed all our bytes.
 	// Yield so the goroutine can finish its r.Read call and push decoded output to
 	// outChan before we do the non-blocking drain below.
 	runtime.Gosched()
 
-	var result []byte
 	for {
 		select {
 		case data, ok := <-sd.outChan:
 			if !ok {
-				return result, nil
+				return result, sd.failure(nil)
 			}
 			result = append(result, data...)
 		default:
@@ -160,6 +291,19 @@ func (sd *streamDecompressor) FeedChunk(chunk []byte, endOfStream bool) ([]byte,
 	}
 }
 
+// failure returns the decoder's error, falling back to the given write error.
+func (sd *streamDecompressor) failure(writeErr error) error {
+	select {
+	case err := <-sd.errChan:
+		return err
+	default:
+	}
+	if writeErr != nil {
+		return fmt.Errorf("stream decompressor write: %w", writeErr)
+	}
+	return nil
+}
+
 // Close releases the decompressor's pipe and drains the goroutine. Call this on
 // error paths where endOfStream will never arrive.
 func (sd *streamDecompressor) Close() {
@@ -170,30 +314,96 @@ func (sd *streamDecompressor) Close() {
 
 // recompressBody re-compresses body bytes using the original Content-Encoding.
 // Used to restore compression after policies have processed the decompressed body.
-// Supported encodings: "gzip", "br" (Brotli). Unknown encodings are returned as-is.
+// Supported encodings: "gzip", "br" (Brotli), "zstd", "deflate" (zlib). Unknown
+// encodings are returned as-is.
 func recompressBody(body []byte, encoding string) ([]byte, error) {
+	if encoding == encodingZstd {
+		return zstdEncoder.EncodeAll(body, nil), nil
+	}
+	var buf bytes.Buffer
+	w, err := newEncoder(&buf, encoding)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil {
+		return body, nil
+	}
+	if _, err := w.Write(body); err != nil {
+		return nil, fmt.Errorf("%s write: %w", encoding, err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("%s close: %w", encoding, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// flushWriteCloser is implemented by every encoder the kernel uses.
+type flushWriteCloser interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// newEncoder returns an encoder for the given Content-Encoding writing to w, or nil for
+// an unknown encoding.
+func newEncoder(w io.Writer, encoding string) (flushWriteCloser, error) {
 	switch encoding {
-	case "gzip":
-		var buf bytes.Buffer
-		w := gzip.NewWriter(&buf)
-		if _, err := w.Write(body); err != nil {
-			return nil, fmt.Errorf("gzip write: %w", err)
-		}
-		if err := w.Close(); err != nil {
-			return nil, fmt.Errorf("gzip close: %w", err)
-		}
-		return buf.Bytes(), nil
-	case "br":
-		var buf bytes.Buffer
-		w := brotli.NewWriter(&buf)
-		if _, err := w.Write(body); err != nil {
-			return nil, fmt.Errorf("brotli write: %w", err)
-		}
-		if err := w.Close(); err != nil {
-			return nil, fmt.Errorf("brotli close: %w", err)
+	case encodingGzip:
+		return gzip.NewWriter(w), nil
+	case encodingBrotli:
+		return brotli.NewWriter(w), nil
+	case encodingZstd:
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, fmt.Errorf("zstd writer: %w", err)
 		}
-		return buf.Bytes(), nil
+		return zw, nil
+	case encodingDeflate:
+		return zlib.NewWriter(w), nil
 	default:
-		return body, nil
+		return nil, nil
+	}
+}
+
+// streamCompressor re-compresses a streamed body chunk by chunk into one compressed
+// stream. Each chunk is flushed so the peer can decode it as soon as it arrives, and
+// the stream is finished with the final chunk. Compressing each chunk as a standalone
+// stream would only be valid for encodings that allow concatenation (gzip, zstd).
+type streamCompressor struct {
+	buf bytes.Buffer
+	w   flushWriteCloser
+}
+
+// newStreamCompressor returns a streamCompressor for a supported encoding.
+func newStreamCompressor(encoding string) (*streamCompressor, error) {
+	sc := &streamCompressor{}
+	w, err := newEncoder(&sc.buf, encoding)
+	if err != nil {
+		return nil, err
+	}
+	if w == nil {
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+	sc.w = w
+	return sc, nil
+}
+
+// CompressChunk compresses a chunk and returns the compressed bytes to forward.
+func (sc *streamCompressor) CompressChunk(chunk []byte, endOfStream bool) ([]byte, error) {
+	if len(chunk) > 0 {
+		if _, err := sc.w.Write(chunk); err != nil {
+			return nil, fmt.Errorf("stream compressor write: %w", err)
+		}
+	}
+	var err error
+	if endOfStream {
+		err = sc.w.Close()
+	} else {
+		err = sc.w.Flush()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("stream compressor flush: %w", err)
 	}
+	out := bytes.Clone(sc.buf.Bytes())
+	sc.buf.Reset()
+	return out, nil
 }
@@ -20,11 +20,14 @@ package kernel
 
 import (
 	"bytes"
+	"compress/flate"
 	"compress/gzip"
+	"compress/zlib"
 	"testing"
 	"time"
 
 	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -47,6 +50,34 @@ func brotliCompress(data []byte) []byte {
 	return buf.Bytes()
 }
 
+// zstdCompress is a test helper that zstd-compresses a byte slice.
+func zstdCompress(data []byte) []byte {
+	var buf bytes.Buffer
+	w, _ := zstd.NewWriter(&buf)
+	_, _ = w.Write(data)
+	_ = w.Close()
+	return buf.Bytes()
+}
+
+// zlibCompress is a test helper that compresses a byte slice as HTTP "deflate" (zlib).
+func zlibCompress(data []byte) []byte {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	_, _ = w.Write(data)
+	_ = w.Close()
+	return buf.Bytes()
+}
+
+// rawDeflateCompress is a test helper that produces a raw DEFLATE stream without the
+// zlib wrapper, as some servers send for "deflate".
+func rawDeflateCompress(data []byte) []byte {
+	var buf bytes.Buffer
+	w, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+	_, _ = w.Write(data)
+	_ = w.Close()
+	return buf.Bytes()
+}
+
 // =============================================================================
 // decompressBody Tests
 // =============================================================================
@@ -55,7 +86,7 @@ func TestDecompressBody_Gzip(t *testing.T) {
 	original := []byte(`{"model":"gpt-4","usage":{"prompt_tokens":10,"completion_tokens":20}}`)
 	compressed := gzipCompress(original)
 
-	result, err := decompressBody(compressed, "gzip")
+	result, err := decompressBody(compressed, "gzip", DefaultMaxDecompressedBodyBytes)
 
 	require.NoError(t, err)
 	assert.Equal(t, original, result)
@@ -65,16 +96,62 @@ func TestDecompressBody_Brotli(t *testing.T) {
 	original := []byte(`{"model":"claude-3","usage":{"input_tokens":5,"output_tokens":15}}`)
 	compressed := brotliCompress(original)
 
-	result, err := decompressBody(compressed, "br")
+	result, err := decompressBody(compressed, "br", DefaultMaxDecompressedBodyBytes)
 
 	require.NoError(t, err)
 	assert.Equal(t, original, result)
 }
 
+func TestDecompressBody_Zstd(t *testing.T) {
+	original := []byte(`{"model":"gpt-4o","usage":{"prompt_tokens":7,"completion_tokens":9}}`)
+
+	result, err := decompressBody(zstdCompress(original), "zstd", DefaultMaxDecompressedBodyBytes)
+
+	require.NoError(t, err)
+	assert.Equal(t, original, result)
+}
+
+func TestDecompressBody_Deflate(t *testing.T) {
+	original := []byte(`{"model":"mistral","usage":{"prompt_tokens":3}}`)
+
+	zlibResult, err := decompressBody(zlibCompress(original), "deflate", DefaultMaxDecompressedBodyBytes)
+	require.NoError(t, err)
+	assert.Equal(t, original, zlibResult)
+
+	rawResult, err := decompressBody(rawDeflateCompress(original), "deflate", DefaultMaxDecompressedBodyBytes)
+	require.NoError(t, err)
+	assert.Equal(t, original, rawResult)
+}
+
+// TestDecompressBody_ExceedsLimit verifies that a small body that expands beyond the
+// maximum (a decompression bomb) is rejected rather than fully inflated.
+func TestDecompressBody_ExceedsLimit(t *testing.T) {
+	bomb := bytes.Repeat([]byte{'a'}, 1<<20)
+
+	for _, tc := range []struct {
+		encoding   string
+		compressed []byte
+	}{
+		{"gzip", gzipCompress(bomb)},
+		{"br", brotliCompress(bomb)},
+		{"zstd", zstdCompress(bomb)},
+		{"deflate", zlibCompress(bomb)},
+	} {
+		t.Run(tc.encoding, func(t *testing.T) {
+			_, err := decompressBody(tc.compressed, tc.encoding, 64*1024)
+			assert.ErrorIs(t, err, errDecompressedBodyTooLarge)
+
+			result, err := decompressBody(tc.compressed, tc.encoding, int64(len(bomb)))
+			require.NoError(t, err)
+			assert.Len(t, result, len(bomb))
+		})
+	}
+}
+
 func TestDecompressBody_UnknownEncoding_PassesThrough(t *testing.T) {
 	original := []byte(`{"model":"gemini-pro"}`)
 
-	result, err := decompressBody(original, "identity")
+	result, err := decompressBody(original, "identity", DefaultMaxDecompressedBodyBytes)
 
 	require.NoError(t, err)
 	assert.Equal(t, original, result)
@@ -83,7 +160,7 @@ func TestDecompressBody_UnknownEncoding_PassesThrough(t *testing.T) {
 func TestDecompressBody_EmptyEncoding_PassesThrough(t *testing.T) {
 	original := []byte(`{"model":"gemini-pro"}`)
 
-	result, err := decompressBody(original, "")
+	result, err := decompressBody(original, "", DefaultMaxDecompressedBodyBytes)
 
 	require.NoError(t, err)
 	assert.Equal(t, original, result)
@@ -92,7 +169,7 @@ func TestDecompressBody_EmptyEncoding_PassesThrough(t *testing.T) {
 func TestDecompressBody_InvalidGzip_ReturnsError(t *testing.T) {
 	garbage := []byte("this is not gzip data")
 
-	_, err := decompressBody(garbage, "gzip")
+	_, err := decompressBody(garbage, "gzip", DefaultMaxDecompressedBodyBytes)
 
 	assert.Error(t, err)
 }
@@ -109,7 +186,7 @@ func TestRecompressBody_Gzip_RoundTrip(t *testing.T) {
 	assert.NotEqual(t, original, compressed)
 
 	// Decompress and verify we get the original back
-	restored, err := decompressBody(compressed, "gzip")
+	restored, err := decompressBody(compressed, "gzip", DefaultMaxDecompressedBodyBytes)
 	require.NoError(t, err)
 	assert.Equal(t, original, restored)
 }
@@ -122,11 +199,25 @@ func TestRecompressBody_Brotli_RoundTrip(t *testing.T) {
 	assert.NotEqual(t, original, compressed)
 
 	// Decompress and verify we get the original back
-	restored, err := decompressBody(compressed, "br")
+	restored, err := decompressBody(compressed, "br", DefaultMaxDecompressedBodyBytes)
 	require.NoError(t, err)
 	assert.Equal(t, original, restored)
 }
 
+func TestRecompressBody_ZstdAndDeflate_RoundTrip(t *testing.T) {
+	original := []byte(`{"model":"gpt-4o","usage":{"prompt_tokens":10}}`)
+
+	for _, encoding := range []string{"zstd", "deflate"} {
+		compressed, err := recompressBody(original, encoding)
+		require.NoError(t, err)
+		assert.NotEqual(t, original, compressed)
+
+		restored, err := decompressBody(compressed, encoding, DefaultMaxDecompressedBodyBytes)
+		require.NoError(t, err)
+		assert.Equal(t, original, restored, encoding)
+	}
+}
+
 func TestRecompressBody_UnknownEncoding_PassesThrough(t *testing.T) {
 	original := []byte(`{"model":"gemini-pro"}`)
 
@@ -155,7 +246,7 @@ func TestStreamDecompressor_Gzip_AllInOneChunk(t *testing.T) {
 	original := []byte(`{"model":"claude","usage":{"input_tokens":10,"output_tokens":20}}`)
 	compressed := gzipCompress(original)
 
-	sd := newStreamDecompressor("gzip")
+	sd := newStreamDecompressor("gzip", DefaultMaxDecompressedBodyBytes)
 	result, err := sd.FeedChunk(compressed, true)
 
 	require.NoError(t, err)
@@ -169,7 +260,7 @@ func TestStreamDecompressor_Gzip_MultipleChunks(t *testing.T) {
 	original := []byte(`{"model":"claude","usage":{"input_tokens":10,"output_tokens":20}}`)
 	compressed := gzipCompress(original)
 
-	sd := newStreamDecompressor("gzip")
+	sd := newStreamDecompressor("gzip", DefaultMaxDecompressedBodyBytes)
 
 	half := len(compressed) / 2
 	chunk1, err := sd.FeedChunk(compressed[:half], false)
@@ -189,7 +280,7 @@ func TestStreamDecompressor_Brotli_AllInOneChunk(t *testing.T) {
 	original := []byte(`{"model":"claude","usage":{"input_tokens":5,"output_tokens":15}}`)
 	compressed := brotliCompress(original)
 
-	sd := newStreamDecompressor("br")
+	sd := newStreamDecompressor("br", DefaultMaxDecompressedBodyBytes)
 	result, err := sd.FeedChunk(compressed, true)
 
 	require.NoError(t, err)
@@ -201,7 +292,7 @@ func TestStreamDecompressor_Brotli_MultipleChunks(t *testing.T) {
 	original := []byte(`{"model":"claude","usage":{"input_tokens":5,"output_tokens":15}}`)
 	compressed := brotliCompress(original)
 
-	sd := newStreamDecompressor("br")
+	sd := newStreamDecompressor("br", DefaultMaxDecompressedBodyBytes)
 
 	half := len(compressed) / 2
 	chunk1, err := sd.FeedChunk(compressed[:half], false)
@@ -213,11 +304,78 @@ func TestStreamDecompressor_Brotli_MultipleChunks(t *testing.T) {
 	assert.Equal(t, original, append(chunk1, chunk2...))
 }
 
+// TestStreamDecompressor_ZstdAndDeflate_MultipleChunks verifies the new encodings keep
+// decoder state across chunks.
+func TestStreamDecompressor_ZstdAndDeflate_MultipleChunks(t *testing.T) {
+	original := bytes.Repeat([]byte(`{"delta":"streamed token"}`), 50)
+
+	for encoding, compressed := range map[string][]byte{
+		"zstd":    zstdCompress(original),
+		"deflate": zlibCompress(original),
+	} {
+		sd := newStreamDecompressor(encoding, DefaultMaxDecompressedBodyBytes)
+
+		third := len(compressed) / 3
+		var out []byte
+		for i, part := range [][]byte{compressed[:third], compressed[third : 2*third], compressed[2*third:]} {
+			chunk, err := sd.FeedChunk(part, i == 2)
+			require.NoError(t, err, encoding)
+			out = append(out, chunk...)
+		}
+		assert.Equal(t, original, out, encoding)
+	}
+}
+
+// TestStreamDecompressor_ExceedsLimit verifies that the limit applies to the total
+// decompressed size across chunks.
+func TestStreamDecompressor_ExceedsLimit(t *testing.T) {
+	compressed := gzipCompress(bytes.Repeat([]byte{'a'}, 1<<20))
+	sd := newStreamDecompressor("gzip", 64*1024)
+
+	var err error
+	half := len(compressed) / 2
+	if _, err = sd.FeedChunk(compressed[:half], false); err == nil {
+		_, err = sd.FeedChunk(compressed[half:], true)
+	}
+
+	assert.ErrorIs(t, err, errDecompressedBodyTooLarge)
+	sd.Close()
+}
+
+// TestStreamDecompressor_HighlyCompressibleChunk verifies that a single chunk whose
+// output is much larger than the pipe can hold does not deadlock the decoder.
+func TestStreamDecompressor_HighlyCompressibleChunk(t *testing.T) {
+	original := bytes.Repeat([]byte("x"), 4<<20)
+	compressed := zstdCompress(original)
+
+	done := make(chan struct{})
+	var result []byte
+	var err error
+	go func() {
+		sd := newStreamDecompressor("zstd", 8<<20)
+		result, err = sd.FeedChunk(compressed, false)
+		if err == nil {
+			var tail []byte
+			tail, err = sd.FeedChunk(nil, true)
+			result = append(result, tail...)
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		require.NoError(t, err)
+		assert.Equal(t, len(original), len(result))
+	case <-time.After(5 * time.Second):
+		t.Fatal("FeedChunk deadlocked on a highly compressible chunk")
+	}
+}
+
 // TestStreamDecompressor_EmptyNonEOSChunk verifies that feeding an empty
 // non-EOS chunk returns empty output without error — this happens when the
 // decoder needs more input before a full DEFLATE block can be produced.
 func TestStreamDecompressor_EmptyNonEOSChunk(t *testing.T) {
-	sd := newStreamDecompressor("gzip")
+	sd := newStreamDecompressor("gzip", DefaultMaxDecompressedBodyBytes)
 
 	result, err := sd.FeedChunk(nil, false)
 
@@ -232,7 +390,7 @@ func TestStreamDecompressor_EmptyNonEOSChunk(t *testing.T) {
 func TestStreamDecompressor_UnknownEncoding_Passthrough(t *testing.T) {
 	original := []byte(`plain text, not compressed`)
 
-	sd := newStreamDecompressor("identity")
+	sd := newStreamDecompressor("identity", DefaultMaxDecompressedBodyBytes)
 	result, err := sd.FeedChunk(original, true)
 
 	require.NoError(t, err)
@@ -242,7 +400,7 @@ func TestStreamDecompressor_UnknownEncoding_Passthrough(t *testing.T) {
 // TestStreamDecompressor_Close_DoesNotHang verifies that Close() terminates
 // the background goroutine promptly even when no data has been fed.
 func TestStreamDecompressor_Close_DoesNotHang(t *testing.T) {
-	sd := newStreamDecompressor("gzip")
+	sd := newStreamDecompressor("gzip", DefaultMaxDecompressedBodyBytes)
 
 	done := make(chan struct{})
 	go func() {
@@ -266,7 +424,7 @@ func TestStreamDecompressor_RoundTrip(t *testing.T) {
 	compressed := gzipCompress(original)
 
 	// Decompress (as processStreamingResponseBody does)
-	sd := newStreamDecompressor("gzip")
+	sd := newStreamDecompressor("gzip", DefaultMaxDecompressedBodyBytes)
 	decompressed, err := sd.FeedChunk(compressed, true)
 	require.NoError(t, err)
 	assert.Equal(t, original, decompressed)
@@ -276,7 +434,58 @@ func TestStreamDecompressor_RoundTrip(t *testing.T) {
 	require.NoError(t, err)
 
 	// Must be valid gzip that decodes back to original
-	final, err := decompressBody(recompressed, "gzip")
+	final, err := decompressBody(recompressed, "gzip", DefaultMaxDecompressedBodyBytes)
 	require.NoError(t, err)
 	assert.Equal(t, original, final)
 }
+
+// =============================================================================
+// streamCompressor Tests
+// =============================================================================
+
+// TestStreamCompressor_ChunksFormOneStream verifies that the chunks produced by the
+// compressor concatenate into a single valid stream for every supported encoding,
+// and that each non-final chunk is flushed so it can be decoded on arrival.
+func TestStreamCompressor_ChunksFormOneStream(t *testing.T) {
+	parts := [][]byte{
+		[]byte(`data: {"delta":"Hello"}` + "\n\n"),
+		[]byte(`data: {"delta":" world"}` + "\n\n"),
+		[]byte(`data: [DONE]` + "\n\n"),
+	}
+
+	for _, encoding := range []string{"gzip", "br", "zstd", "deflate"} {
+		t.Run(encoding, func(t *testing.T) {
+			sc, err := newStreamCompressor(encoding)
+			require.NoError(t, err)
+			sd := newStreamDecompressor(encoding, DefaultMaxDecompressedBodyBytes)
+
+			var wire, decoded, expected []byte
+			for i, part := range parts {
+				eos := i == len(parts)-1
+				out, err := sc.CompressChunk(part, eos)
+				require.NoError(t, err)
+				assert.NotEmpty(t, out)
+				wire = append(wire, out...)
+
+				chunk, err := sd.FeedChunk(out, eos)
+				require.NoError(t, err)
+				decoded = append(decoded, chunk...)
+				expected = append(expected, part...)
+				if !eos {
+					assert.Equal(t, expected, decoded, "flushed chunk must be decodable on arrival")
+				}
+			}
+
+			assert.Equal(t, expected, decoded)
+			whole, err := decompressBody(wire, encoding, DefaultMaxDecompressedBodyBytes)
+			require.NoError(t, err)
+			assert.Equal(t, expected, whole)
+		})
+	}
+}
+
+func TestStreamCompressor_UnsupportedEncoding(t *testing.T) {
+	_, err := newStreamCompressor("compress")
+
+	assert.Error(t, err)
+}
@@ -20,15 +20,18 @@ package kernel
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	extprocconfigv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
 	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/google/uuid"
 
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/debugcapture"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
 	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
@@ -112,6 +115,9 @@ type PolicyExecutionContext struct {
 	// requestStreamDecomp performs per-chunk decompression for compressed streaming
 	// request bodies. Nil when the request is not Content-Encoded.
 	requestStreamDecomp *streamDecompressor
+	// requestStreamComp re-compresses the chunks forwarded upstream into one stream.
+	// Created on the first chunk of a Content-Encoded streaming request.
+	requestStreamComp *streamCompressor
 
 	// isStreamingResponse is set to true during response headers processing when
 	// streaming indicators are detected AND the policy chain supports streaming.
@@ -121,6 +127,9 @@ type PolicyExecutionContext struct {
 	// responseStreamDecomp performs per-chunk decompression for compressed streaming
 	// response bodies. Nil when the response is not Content-Encoded.
 	responseStreamDecomp *streamDecompressor
+	// responseStreamComp re-compresses the chunks forwarded downstream into one stream.
+	// Created on the first chunk of a Content-Encoded streaming response.
+	responseStreamComp *streamCompressor
 	// streamTerminated is set when a policy returns TerminateStream=true. Any
 	// subsequent upstream chunks that Envoy delivers after we have already sent
 	// EndOfStream downstream are silently suppressed — the downstream connection
@@ -132,6 +141,20 @@ type PolicyExecutionContext struct {
 
 	// phase tracks the current ext_proc processing phase and is read by getModeOverride.
 	phase processingPhase
+
+	// Stream lifecycle state, reported to StreamErrorPolicy and RequestCompletePolicy
+	// hooks when the stream ends (see stream_lifecycle.go).
+	startTime         time.Time
+	lastPhase         string
+	exchangeComplete  bool
+	immediateResponse bool
+	finalStatus       int
+	requestBodyBytes  int64
+	responseBodyBytes int64
+
+	// capture records this request's policy decisions when an admin debug capture
+	// session selected it; nil otherwise (see debug_capture.go).
+	capture *debugcapture.Trace
 }
 
 // newPolicyExecutionContext creates a new execution context for a request
@@ -146,6 +169,7 @@ func newPolicyExecutionContext(
 		policyChain:       chain,
 		analyticsMetadata: make(map[string]interface{}),
 		dynamicMetadata:   make(map[string]map[string]interface{}),
+		startTime:         time.Now(),
 	}
 }
 
@@ -165,13 +189,28 @@ func (ec *PolicyExecutionContext) handlePolicyError(
 		"error", err,
 	)
 
+	status := typev3.StatusCode_InternalServerError
 	errorBody := fmt.Sprintf(`{"error":"Internal Server Error","error_id":"%s"}`, errorID)
 
+	// A fail-closed policy that panicked, timed out or has its circuit open: name the policy and
+	// the reason so the failure can be traced without the logs. An open circuit is reported as
+	// 503 since the policy is deliberately unavailable for a while.
+	var failure *executor.PolicyFailureError
+	if errors.As(err, &failure) {
+		message := "Internal Server Error"
+		if failure.Reason == executor.FailureReasonCircuitOpen {
+			status = typev3.StatusCode_ServiceUnavailable
+			message = "Service Unavailable"
+		}
+		errorBody = fmt.Sprintf(`{"error":"%s","error_id":"%s","policy":%q,"reason":"%s"}`,
+			message, errorID, failure.PolicyName, failure.Reason)
+	}
+
 	return &extprocv3.ProcessingResponse{
 		Response: &extprocv3.ProcessingResponse_ImmediateResponse{
 			ImmediateResponse: &extprocv3.ImmediateResponse{
 				Status: &typev3.HttpStatus{
-					Code: typev3.StatusCode_InternalServerError,
+					Code: status,
 				},
 				Headers: buildHeaderValueOptions(map[string]string{
 					"content-type": "application/json",
@@ -183,6 +222,86 @@ func (ec *PolicyExecutionContext) handlePolicyError(
 	}
 }
 
+// checkContentEncoding handles a Content-Encoding the kernel cannot decompress on a
+// phase whose body policies would otherwise see the encoded bytes. It clears the
+// encoding so the body is passed through as received and, when unsupported encodings
+// are rejected, returns the immediate response to send. status and message describe
+// that response; direction is "request" or "response".
+func (ec *PolicyExecutionContext) checkContentEncoding(
+	ctx context.Context,
+	encoding *string,
+	requiresBody bool,
+	direction string,
+	status typev3.StatusCode,
+	message string,
+) *extprocv3.ProcessingResponse {
+	if *encoding == "" || isSupportedEncoding(*encoding) || !requiresBody {
+		return nil
+	}
+	unsupported := *encoding
+	*encoding = ""
+	if ec.server.decompression.RejectUnsupportedEncoding {
+		return ec.contentEncodingErrorResponse(ctx, status, message, direction, unsupported,
+			fmt.Errorf("unsupported content encoding %q", unsupported))
+	}
+	slog.WarnContext(ctx, "Unsupported Content-Encoding; body policies will see the encoded bytes",
+		"request_id", ec.requestID,
+		"route_key", ec.routeKey,
+		"direction", direction,
+		"encoding", unsupported,
+	)
+	return nil
+}
+
+// contentEncodingErrorResponse builds the immediate response sent when a body cannot be
+// decoded for the policies: its Content-Encoding is not supported or it decompresses to
+// more than the configured maximum.
+func (ec *PolicyExecutionContext) contentEncodingErrorResponse(
+	ctx context.Context,
+	status typev3.StatusCode,
+	message string,
+	direction string,
+	encoding string,
+	err error,
+) *extprocv3.ProcessingResponse {
+	errorID := uuid.New().String()
+
+	slog.WarnContext(ctx, "Rejected body that cannot be decoded for policies",
+		"error_id", errorID,
+		"request_id", ec.requestID,
+		"route_key", ec.routeKey,
+		"direction", direction,
+		"encoding", encoding,
+		"error", err,
+	)
+
+	return &extprocv3.ProcessingResponse{
+		Response: &extprocv3.ProcessingResponse_ImmediateResponse{
+			ImmediateResponse: &extprocv3.ImmediateResponse{
+				Status: &typev3.HttpStatus{
+					Code: status,
+				},
+				Headers: buildHeaderValueOptions(map[string]string{
+					"content-type": "application/json",
+					"x-error-id":   errorID,
+				}),
+				Body: []byte(fmt.Sprintf(`{"error":"%s","error_id":"%s"}`, message, errorID)),
+			},
+		},
+	}
+}
+
+// decompressedTooLargeResponse rejects a body that decompresses to more than the configured
+// maximum: 413 for a request, 502 for an upstream response.
+func (ec *PolicyExecutionContext) decompressedTooLargeResponse(ctx context.Context, direction, encoding string) *extprocv3.ProcessingResponse {
+	if direction == "request" {
+		return ec.contentEncodingErrorResponse(ctx, typev3.StatusCode_PayloadTooLarge, "Payload Too Large",
+			direction, encoding, errDecompressedBodyTooLarge)
+	}
+	return ec.contentEncodingErrorResponse(ctx, typev3.StatusCode_BadGateway, "Bad Gateway",
+		direction, encoding, errDecompressedBodyTooLarge)
+}
+
 // getModeOverride returns the ProcessingMode override for this execution context.
 // Response body is always set to BUFFERED here (never FULL_DUPLEX_STREAMED).
 // The upgrade to streaming happens at response-headers phase via
@@ -262,6 +381,11 @@ func (ec *PolicyExecutionContext) processRequestHeaders(
 	ctx context.Context,
 ) (*extprocv3.ProcessingResponse, error) {
 	ec.phase = phaseRequestHeaders
+	if resp := ec.checkContentEncoding(ctx, &ec.requestContentEncoding, ec.policyChain.RequiresRequestBody,
+		"request", typev3.StatusCode_UnsupportedMediaType, "Unsupported Media Type"); resp != nil {
+		return resp, nil
+	}
+	captureIn := ec.requestCaptureInput(nil)
 	execResult, err := ec.server.executor.ExecuteRequestHeaderPolicies(
 		ctx,
 		ec.policyChain.Policies,
@@ -271,6 +395,7 @@ func (ec *PolicyExecutionContext) processRequestHeaders(
 		ec.routeKey,
 		ec.policyChain.HasExecutionConditions,
 	)
+	ec.capture.RecordRequestHeaders(captureIn, execResult, err)
 	if err != nil {
 		return ec.handlePolicyError(ctx, err, "request_headers"), nil
 	}
@@ -347,6 +472,7 @@ func (ec *PolicyExecutionContext) processRequestBodyForEmptyRequest(
 		"method", ec.requestHeaderCtx.Method,
 	)
 
+	captureIn := ec.requestCaptureInput(ec.requestBodyCtx.Body)
 	bodyResult, err := ec.server.executor.ExecuteRequestPolicies(
 		ctx,
 		ec.policyChain.Policies,
@@ -356,6 +482,7 @@ func (ec *PolicyExecutionContext) processRequestBodyForEmptyRequest(
 		ec.routeKey,
 		ec.policyChain.HasExecutionConditions,
 	)
+	ec.capture.RecordRequestBody(captureIn, bodyResult, err)
 	if err != nil {
 		return ec.handlePolicyError(ctx, err, "request_body_no_body"), nil
 	}
@@ -378,6 +505,7 @@ func (ec *PolicyExecutionContext) processResponseBodyForEmptyResponse(
 		"status", ec.responseHeaderCtx.ResponseStatus,
 	)
 
+	captureIn := ec.responseCaptureInput(ec.responseBodyCtx.ResponseHeaders, ec.responseBodyCtx.ResponseStatus, ec.responseBodyCtx.ResponseBody)
 	bodyResult, err := ec.server.executor.ExecuteResponsePolicies(
 		ctx,
 		ec.policyChain.Policies,
@@ -387,6 +515,7 @@ func (ec *PolicyExecutionContext) processResponseBodyForEmptyResponse(
 		ec.routeKey,
 		ec.policyChain.HasExecutionConditions,
 	)
+	ec.capture.RecordResponseBody(captureIn, bodyResult, err)
 	if err != nil {
 		return ec.handlePolicyError(ctx, err, "response_body_no_body"), nil
 	}
@@ -408,7 +537,10 @@ func (ec *PolicyExecutionContext) processRequestBody(
 		// Decompress body if Content-Encoding was set, so policies receive plain bytes.
 		bodyContent := body.Body
 		if ec.requestContentEncoding != "" {
-			decompressed, err := decompressBody(body.Body, ec.requestContentEncoding)
+			decompressed, err := decompressBody(body.Body, ec.requestContentEncoding, ec.server.decompression.MaxDecompressedBytes)
+			if errors.Is(err, errDecompressedBodyTooLarge) {
+				return ec.decompressedTooLargeResponse(ctx, "request", ec.requestContentEncoding), nil
+			}
 			if err != nil {
 				slog.Warn("Failed to decompress request body, passing raw bytes to policies",
 					"request_id", ec.requestID,
@@ -429,6 +561,7 @@ func (ec *PolicyExecutionContext) processRequestBody(
 			Present:     true,
 		}
 
+		captureIn := ec.requestCaptureInput(ec.requestBodyCtx.Body)
 		execResult, err := ec.server.executor.ExecuteRequestPolicies(
 			ctx,
 			ec.policyChain.Policies,
@@ -438,6 +571,7 @@ func (ec *PolicyExecutionContext) processRequestBody(
 			ec.routeKey,
 			ec.policyChain.HasExecutionConditions,
 		)
+		ec.capture.RecordRequestBody(captureIn, execResult, err)
 		if err != nil {
 			return ec.handlePolicyError(ctx, err, "request_body"), nil
 		}
@@ -467,9 +601,14 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 	// handle their own internal state across chunks.
 	if ec.requestContentEncoding != "" {
 		if ec.requestStreamDecomp == nil {
-			ec.requestStreamDecomp = newStreamDecompressor(ec.requestContentEncoding)
+			ec.requestStreamDecomp = newStreamDecompressor(ec.requestContentEncoding, ec.server.decompression.MaxDecompressedBytes)
 		}
 		decompressed, err := ec.requestStreamDecomp.FeedChunk(chunk.Chunk, chunk.EndOfStream)
+		if errors.Is(err, errDecompressedBodyTooLarge) {
+			ec.requestStreamDecomp.Close()
+			ec.requestStreamDecomp = nil
+			return ec.decompressedTooLargeResponse(ctx, "request", ec.requestContentEncoding), nil
+		}
 		if err != nil {
 			slog.Warn("[streaming] per-chunk request decompression error; disabling decompression",
 				"request_id", ec.requestID,
@@ -514,6 +653,7 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 			}
 		}
 
+		captureIn := ec.requestChunkCaptureInput(chunk)
 		execResult, err := ec.server.executor.ExecuteStreamingRequestPolicies(
 			ctx,
 			ec.policyChain.Policies,
@@ -524,6 +664,7 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 			ec.routeKey,
 			ec.policyChain.HasExecutionConditions,
 		)
+		ec.capture.RecordRequestBodyChunk(captureIn, execResult, err)
 		if err != nil {
 			return ec.handlePolicyError(ctx, err, "request_body_streaming"), nil
 		}
@@ -598,6 +739,7 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 		}
 	}
 
+	captureIn := ec.requestChunkCaptureInput(flushChunk)
 	execResult, err := ec.server.executor.ExecuteStreamingRequestPolicies(
 		ctx,
 		ec.policyChain.Policies,
@@ -608,6 +750,7 @@ func (ec *PolicyExecutionContext) processStreamingRequestBody(
 		ec.routeKey,
 		ec.policyChain.HasExecutionConditions,
 	)
+	ec.capture.RecordRequestBodyChunk(captureIn, execResult, err)
 	if err != nil {
 		ec.requestStreamAccumulator = nil
 		return ec.handlePolicyError(ctx, err, "request_body_streaming"), nil
@@ -625,6 +768,10 @@ func (ec *PolicyExecutionContext) processResponseHeaders(
 ) (*extprocv3.ProcessingResponse, error) {
 	ec.phase = phaseResponseHeaders
 	ec.buildResponseContexts(headers)
+	if resp := ec.checkContentEncoding(ctx, &ec.responseContentEncoding, ec.policyChain.RequiresResponseBody,
+		"response", typev3.StatusCode_BadGateway, "Bad Gateway"); resp != nil {
+		return resp, nil
+	}
 
 	// Detect streaming response: upgrade when chain supports streaming AND
 	// upstream signals chunked/SSE AND body is coming (not EndOfStream).
@@ -645,6 +792,7 @@ func (ec *PolicyExecutionContext) processResponseHeaders(
 		"is_streaming_response", ec.isStreamingResponse,
 	)
 
+	captureIn := ec.responseCaptureInput(ec.responseHeaderCtx.ResponseHeaders, ec.responseHeaderCtx.ResponseStatus, nil)
 	execResult, err := ec.server.executor.ExecuteResponseHeaderPolicies(
 		ctx,
 		ec.policyChain.Policies,
@@ -654,6 +802,7 @@ func (ec *PolicyExecutionContext) processResponseHeaders(
 		ec.routeKey,
 		ec.policyChain.HasExecutionConditions,
 	)
+	ec.capture.RecordResponseHeaders(captureIn, execResult, err)
 	if err != nil {
 		return ec.handlePolicyError(ctx, err, "response_headers"), nil
 	}
@@ -700,7 +849,10 @@ func (ec *PolicyExecutionContext) processResponseBody(
 		// Decompress body if Content-Encoding was set, so policies receive plain JSON.
 		bodyContent := body.Body
 		if ec.responseContentEncoding != "" {
-			decompressed, err := decompressBody(body.Body, ec.responseContentEncoding)
+			decompressed, err := decompressBody(body.Body, ec.responseContentEncoding, ec.server.decompression.MaxDecompressedBytes)
+			if errors.Is(err, errDecompressedBodyTooLarge) {
+				return ec.decompressedTooLargeResponse(ctx, "response", ec.responseContentEncoding), nil
+			}
 			if err != nil {
 				slog.Warn("Failed to decompress response body, passing raw bytes to policies",
 					"request_id", ec.requestID,
@@ -721,6 +873,7 @@ func (ec *PolicyExecutionContext) processResponseBody(
 			Present:     true,
 		}
 
+		captureIn := ec.responseCaptureInput(ec.responseBodyCtx.ResponseHeaders, ec.responseBodyCtx.ResponseStatus, ec.responseBodyCtx.ResponseBody)
 		execResult, err := ec.server.executor.ExecuteResponsePolicies(
 			ctx,
 			ec.policyChain.Policies,
@@ -730,6 +883,7 @@ func (ec *PolicyExecutionContext) processResponseBody(
 			ec.routeKey,
 			ec.policyChain.HasExecutionConditions,
 		)
+		ec.capture.RecordResponseBody(captureIn, execResult, err)
 		if err != nil {
 			return ec.handlePolicyError(ctx, err, "response_body"), nil
 		}
@@ -784,9 +938,16 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 	// handle their own internal state across chunks.
 	if ec.responseContentEncoding != "" {
 		if ec.responseStreamDecomp == nil {
-			ec.responseStreamDecomp = newStreamDecompressor(ec.responseContentEncoding)
+			ec.responseStreamDecomp = newStreamDecompressor(ec.responseContentEncoding, ec.server.decompression.MaxDecompressedBytes)
 		}
 		decompressed, err := ec.responseStreamDecomp.FeedChunk(chunk.Chunk, chunk.EndOfStream)
+		if errors.Is(err, errDecompressedBodyTooLarge) {
+			// Response headers are already committed downstream; as with a mid-stream policy
+			// error, Envoy resets the stream instead of sending this response.
+			ec.responseStreamDecomp.Close()
+			ec.responseStreamDecomp = nil
+			return ec.decompressedTooLargeResponse(ctx, "response", ec.responseContentEncoding), nil
+		}
 		if err != nil {
 			slog.Warn("[streaming] per-chunk response decompression error; disabling decompression",
 				"request_id", ec.requestID,
@@ -825,6 +986,7 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 			"end_of_stream", chunk.EndOfStream,
 		)
 
+		captureIn := ec.responseChunkCaptureInput(chunk)
 		execResult, err := ec.server.executor.ExecuteStreamingResponsePolicies(
 			ctx,
 			ec.policyChain.Policies,
@@ -835,6 +997,7 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 			ec.routeKey,
 			ec.policyChain.HasExecutionConditions,
 		)
+		ec.capture.RecordResponseBodyChunk(captureIn, execResult, err)
 		if err != nil {
 			return ec.handlePolicyError(ctx, err, "response_body_streaming"), nil
 		}
@@ -898,6 +1061,7 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 	)
 	ec.streamAccumulator = nil
 
+	captureIn := ec.responseChunkCaptureInput(flushChunk)
 	execResult, err := ec.server.executor.ExecuteStreamingResponsePolicies(
 		ctx,
 		ec.policyChain.Policies,
@@ -908,6 +1072,7 @@ func (ec *PolicyExecutionContext) processStreamingResponseBody(
 		ec.routeKey,
 		ec.policyChain.HasExecutionConditions,
 	)
+	ec.capture.RecordResponseBodyChunk(captureIn, execResult, err)
 	if err != nil {
 		ec.streamAccumulator = nil
 		// NOTE: Mid-stream error — response headers and any previously flushed chunks
@@ -954,7 +1119,7 @@ func (ec *PolicyExecutionContext) buildRequestContexts(headers *extprocv3.HttpHe
 					requestID = value
 				}
 			case "content-encoding":
-				ec.requestContentEncoding = value
+				ec.requestContentEncoding = normalizeContentEncoding(value)
 			}
 		}
 	}
@@ -1060,7 +1225,7 @@ func (ec *PolicyExecutionContext) buildResponseContexts(headers *extprocv3.HttpH
 					)
 				}
 			case "content-encoding":
-				ec.responseContentEncoding = value
+				ec.responseContentEncoding = normalizeContentEncoding(value)
 			}
 		}
 	}
@@ -20,6 +20,7 @@ package kernel
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -95,6 +96,39 @@ func TestHandlePolicyError(t *testing.T) {
 	assert.Contains(t, bodyStr, "error_id")
 }
 
+func TestHandlePolicyError_PolicyFailure(t *testing.T) {
+	kernel := NewKernel()
+	chainExecutor := executor.NewChainExecutor(nil, nil, nil)
+	server := NewExternalProcessorServer(kernel, chainExecutor, config.TracingConfig{}, "")
+	execCtx := newPolicyExecutionContext(server, "test-route", &registry.PolicyChain{})
+
+	tests := []struct {
+		reason     executor.FailureReason
+		wantStatus uint32
+	}{
+		{executor.FailureReasonPanic, 500},
+		{executor.FailureReasonTimeout, 500},
+		{executor.FailureReasonCircuitOpen, 503},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.reason), func(t *testing.T) {
+			err := &executor.PolicyFailureError{
+				PolicyName: "jwt-auth", PolicyVersion: "v1.0.0",
+				Phase: executor.PhaseRequestHeaders, Reason: tt.reason, Cause: assert.AnError,
+			}
+			resp := execCtx.handlePolicyError(context.Background(), fmt.Errorf("wrapped: %w", err), "request_headers")
+
+			immResp := resp.GetImmediateResponse()
+			require.NotNil(t, immResp)
+			assert.Equal(t, tt.wantStatus, uint32(immResp.Status.Code))
+			bodyStr := string(immResp.Body)
+			assert.Contains(t, bodyStr, `"policy":"jwt-auth"`)
+			assert.Contains(t, bodyStr, `"reason":"`+string(tt.reason)+`"`)
+			assert.NotContains(t, bodyStr, assert.AnError.Error())
+		})
+	}
+}
+
 // =============================================================================
 // getModeOverride Tests
 // =============================================================================
@@ -821,3 +855,160 @@ func TestProcessRequestBody_NoEncoding_PassesThrough(t *testing.T) {
 	require.NotNil(t, execCtx.requestBodyCtx.Body)
 	assert.Equal(t, plainJSON, execCtx.requestBodyCtx.Body.Content)
 }
+
+func TestBuildRequestContext_NormalizesContentEncoding(t *testing.T) {
+	server := NewExternalProcessorServer(NewKernel(), executor.NewChainExecutor(nil, nil, nil), config.TracingConfig{}, "")
+
+	for value, want := range map[string]string{" ZSTD ": "zstd", "Deflate": "deflate", "identity": ""} {
+		execCtx := newPolicyExecutionContext(server, "test-route", &registry.PolicyChain{})
+		execCtx.buildRequestContexts(&extprocv3.HttpHeaders{
+			Headers: &corev3.HeaderMap{
+				Headers: []*corev3.HeaderValue{
+					{Key: "content-encoding", RawValue: []byte(value)},
+				},
+			},
+		}, RouteMetadata{})
+
+		assert.Equal(t, want, execCtx.requestContentEncoding, value)
+	}
+}
+
+func TestProcessRequestBody_DecompressesZstd(t *testing.T) {
+	server := NewExternalProcessorServer(NewKernel(), newTestExecutor(), config.TracingConfig{}, "")
+	chain := &registry.PolicyChain{
+		RequiresRequestBody: true,
+		Policies:            []policy.Policy{&testutils.NoopPolicy{}},
+		PolicySpecs:         []policy.PolicySpec{{Enabled: true}},
+	}
+	execCtx := newPolicyExecutionContext(server, "test-route", chain)
+	execCtx.buildRequestContexts(&extprocv3.HttpHeaders{
+		Headers: &corev3.HeaderMap{
+			Headers: []*corev3.HeaderValue{
+				{Key: "content-encoding", RawValue: []byte("zstd")},
+			},
+		},
+	}, RouteMetadata{})
+
+	originalJSON := []byte(`{"messages":[{"role":"user","content":"Hello"}]}`)
+	_, err := execCtx.processRequestBody(context.Background(), &extprocv3.HttpBody{
+		Body:        zstdCompress(originalJSON),
+		EndOfStream: true,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, execCtx.requestBodyCtx.Body)
+	assert.Equal(t, originalJSON, execCtx.requestBodyCtx.Body.Content)
+}
+
+// =============================================================================
+// Decompression Limit and Unsupported Encoding Tests
+// =============================================================================
+
+func TestProcessRequestBody_DecompressedTooLarge(t *testing.T) {
+	server := NewExternalProcessorServer(NewKernel(), newTestExecutor(), config.TracingConfig{}, "")
+	server.SetDecompressionConfig(DecompressionConfig{MaxDecompressedBytes: 1024})
+
+	var called bool
+	chain := &registry.PolicyChain{
+		RequiresRequestBody: true,
+		Policies: []policy.Policy{&testutils.ConfigurableMockPolicy{
+			MockMode: policy.ProcessingMode{RequestBodyMode: policy.BodyModeBuffer},
+			OnReqFn: func(*policy.RequestContext, map[string]interface{}) policy.RequestAction {
+				called = true
+				return policy.UpstreamRequestModifications{}
+			},
+		}},
+		PolicySpecs: []policy.PolicySpec{{Enabled: true}},
+	}
+	execCtx := newPolicyExecutionContext(server, "test-route", chain)
+	execCtx.buildRequestContexts(&extprocv3.HttpHeaders{
+		Headers: &corev3.HeaderMap{
+			Headers: []*corev3.HeaderValue{
+				{Key: "content-encoding", RawValue: []byte("gzip")},
+			},
+		},
+	}, RouteMetadata{})
+
+	resp, err := execCtx.processRequestBody(context.Background(), &extprocv3.HttpBody{
+		Body:        gzipCompress(make([]byte, 64*1024)),
+		EndOfStream: true,
+	})
+
+	require.NoError(t, err)
+	immResp := resp.GetImmediateResponse()
+	require.NotNil(t, immResp)
+	assert.Equal(t, uint32(413), uint32(immResp.Status.Code))
+	assert.False(t, called, "policies must not see a body that exceeds the limit")
+}
+
+func TestProcessRequestHeaders_UnsupportedEncoding(t *testing.T) {
+	newCtx := func(reject bool) *PolicyExecutionContext {
+		server := NewExternalProcessorServer(NewKernel(), newTestExecutor(), config.TracingConfig{}, "")
+		server.SetDecompressionConfig(DecompressionConfig{
+			MaxDecompressedBytes:      DefaultMaxDecompressedBodyBytes,
+			RejectUnsupportedEncoding: reject,
+		})
+		chain := &registry.PolicyChain{
+			RequiresRequestBody: true,
+			Policies:            []policy.Policy{&testutils.NoopPolicy{}},
+			PolicySpecs:         []policy.PolicySpec{{Enabled: true}},
+		}
+		execCtx := newPolicyExecutionContext(server, "test-route", chain)
+		execCtx.buildRequestContexts(&extprocv3.HttpHeaders{
+			Headers: &corev3.HeaderMap{
+				Headers: []*corev3.HeaderValue{
+					{Key: ":path", RawValue: []byte("/api/chat")},
+					{Key: "content-encoding", RawValue: []byte("compress")},
+				},
+			},
+		}, RouteMetadata{})
+		return execCtx
+	}
+
+	t.Run("reject", func(t *testing.T) {
+		execCtx := newCtx(true)
+
+		resp, err := execCtx.processRequestHeaders(context.Background())
+
+		require.NoError(t, err)
+		immResp := resp.GetImmediateResponse()
+		require.NotNil(t, immResp)
+		assert.Equal(t, uint32(415), uint32(immResp.Status.Code))
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		execCtx := newCtx(false)
+
+		resp, err := execCtx.processRequestHeaders(context.Background())
+
+		require.NoError(t, err)
+		assert.Nil(t, resp.GetImmediateResponse())
+		assert.Empty(t, execCtx.requestContentEncoding, "the body is passed through as received")
+	})
+}
+
+func TestProcessResponseHeaders_UnsupportedEncodingRejected(t *testing.T) {
+	server := NewExternalProcessorServer(NewKernel(), newTestExecutor(), config.TracingConfig{}, "")
+	server.SetDecompressionConfig(DecompressionConfig{RejectUnsupportedEncoding: true})
+	chain := &registry.PolicyChain{
+		RequiresResponseBody: true,
+		Policies:             []policy.Policy{&testutils.NoopPolicy{}},
+		PolicySpecs:          []policy.PolicySpec{{Enabled: true}},
+	}
+	execCtx := newPolicyExecutionContext(server, "test-route", chain)
+	execCtx.buildRequestContexts(&extprocv3.HttpHeaders{Headers: &corev3.HeaderMap{}}, RouteMetadata{})
+
+	resp, err := execCtx.processResponseHeaders(context.Background(), &extprocv3.HttpHeaders{
+		Headers: &corev3.HeaderMap{
+			Headers: []*corev3.HeaderValue{
+				{Key: ":status", RawValue: []byte("200")},
+				{Key: "content-encoding", RawValue: []byte("gzip, br")},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	immResp := resp.GetImmediateResponse()
+	require.NotNil(t, immResp)
+	assert.Equal(t, uint32(502), uint32(immResp.Status.Code))
+}
@@ -44,6 +44,7 @@ import (
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/tracing"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
 )
 
 // ExternalProcessorServer implements the Envoy external processor service
@@ -51,9 +52,10 @@ import (
 type ExternalProcessorServer struct {
 	extprocv3.UnimplementedExternalProcessorServer
 
-	kernel   *Kernel
-	executor *executor.ChainExecutor
-	tracer   trace.Tracer
+	kernel        *Kernel
+	executor      *executor.ChainExecutor
+	tracer        trace.Tracer
+	decompression DecompressionConfig
 }
 
 // NewExternalProcessorServer creates a new ExternalProcessorServer
@@ -65,12 +67,19 @@ func NewExternalProcessorServer(kernel *Kernel, chainExecutor *executor.ChainExe
 	}
 
 	return &ExternalProcessorServer{
-		kernel:   kernel,
-		executor: chainExecutor,
-		tracer:   otel.Tracer(serviceName),
+		kernel:        kernel,
+		executor:      chainExecutor,
+		tracer:        otel.Tracer(serviceName),
+		decompression: DefaultDecompressionConfig(),
 	}
 }
 
+// SetDecompressionConfig sets how compressed bodies are decoded for body policies.
+// Must be called before the server starts handling streams.
+func (s *ExternalProcessorServer) SetDecompressionConfig(cfg DecompressionConfig) {
+	s.decompression = cfg
+}
+
 // Process implements the bidirectional streaming RPC handler
 // T060: Process(stream) bidirectional streaming RPC handler
 func (s *ExternalProcessorServer) Process(stream extprocv3.ExternalProcessor_ProcessServer) error {
@@ -96,6 +105,7 @@ func (s *ExternalProcessorServer) Process(stream extprocv3.ExternalProcessor_Pro
 		// Receive request from Envoy
 		req, err := stream.Recv()
 		if err == io.EOF {
+			execCtx.endStream(ctx, policy.StreamEndClosed, nil)
 			return nil
 		}
 		if err != nil {
@@ -104,17 +114,21 @@ func (s *ExternalProcessorServer) Process(stream extprocv3.ExternalProcessor_Pro
 			if errors.Is(err, context.Canceled) || status.Code(err) == grpccodes.Canceled {
 				// Log at debug level for visibility in troubleshooting
 				slog.DebugContext(ctx, "Stream closed due to context cancellation")
+				execCtx.endStream(ctx, policy.StreamEndCancelled, err)
 				return nil
 			}
 			slog.ErrorContext(ctx, "Error receiving from stream", "error", err)
 			metrics.StreamErrorsTotal.WithLabelValues("receive").Inc()
+			execCtx.endStream(ctx, policy.StreamEndReceiveError, err)
 			return status.Errorf(grpccodes.Unknown, "failed to receive request: %v", err)
 		}
 
 		// Handle the request based on phase
 		resp, err := s.handleProcessingPhase(ctx, req, &execCtx, span)
+		execCtx.recordReceived(req)
 		if err != nil {
 			slog.ErrorContext(ctx, "Error processing request", "error", err)
+			execCtx.endStream(ctx, policy.StreamEndProcessingError, err)
 			return err
 		}
 
@@ -122,8 +136,10 @@ func (s *ExternalProcessorServer) Process(stream extprocv3.ExternalProcessor_Pro
 		if err := stream.Send(resp); err != nil {
 			slog.ErrorContext(ctx, "Error sending response", "error", err)
 			metrics.StreamErrorsTotal.WithLabelValues("send").Inc()
+			execCtx.endStream(ctx, policy.StreamEndSendError, err)
 			return status.Errorf(grpccodes.Unknown, "failed to send response: %v", err)
 		}
+		execCtx.recordSent(req, resp)
 	}
 }
 
@@ -380,6 +396,7 @@ func (s *ExternalProcessorServer) initializeExecutionContext(ctx context.Context
 		(*execCtx).apiContext = routeMetadata.Context
 		(*execCtx).upstreamDefinitionPaths = routeMetadata.UpstreamDefinitionPaths
 		(*execCtx).buildRequestContexts(req.GetRequestHeaders(), routeMetadata)
+		(*execCtx).beginDebugCapture()
 		return &routeMetadata
 	}
 
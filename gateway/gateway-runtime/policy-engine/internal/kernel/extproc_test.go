@@ -70,11 +70,12 @@ func (m *mockExtProcStream) Send(resp *extprocv3.ProcessingResponse) error {
 	return nil
 }
 
+// Recv returns the queued requests in order, then recvErr (or io.EOF when unset).
 func (m *mockExtProcStream) Recv() (*extprocv3.ProcessingRequest, error) {
-	if m.recvErr != nil {
-		return nil, m.recvErr
-	}
 	if m.recvIndex >= len(m.requests) {
+		if m.recvErr != nil {
+			return nil, m.recvErr
+		}
 		return nil, io.EOF
 	}
 	req := m.requests[m.recvIndex]
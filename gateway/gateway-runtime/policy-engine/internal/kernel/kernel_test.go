@@ -199,6 +199,24 @@ func TestApplyWholeRoutes_MultipleRoutes(t *testing.T) {
 	assert.Len(t, kernel.PolicyChains, 3)
 }
 
+func TestApplyWholeRoutes_NotifiesChainsReplaced(t *testing.T) {
+	kernel := NewKernel()
+	var got []map[string]*registry.PolicyChain
+	kernel.OnChainsReplaced(func(chains map[string]*registry.PolicyChain) {
+		// The hook runs outside the kernel lock, so it may read the kernel.
+		assert.Equal(t, len(chains), len(kernel.DumpRouteKeys()))
+		got = append(got, chains)
+	})
+
+	routes := map[string]*registry.PolicyChain{"route-1": {}}
+	kernel.ApplyWholeRoutes(routes)
+	kernel.ApplyWholeRoutesAndSensitiveValues(map[string]*registry.PolicyChain{}, nil)
+
+	require.Len(t, got, 2)
+	assert.Equal(t, routes, got[0])
+	assert.Empty(t, got[1])
+}
+
 // =============================================================================
 // DumpRoutes Tests
 // =============================================================================
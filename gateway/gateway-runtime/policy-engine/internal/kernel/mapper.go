@@ -22,6 +22,7 @@ import (
 	"log/slog"
 	"sync"
 
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/debugcapture"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
 )
 
@@ -56,6 +57,14 @@ type Kernel struct {
 	// Used for value-based redaction in config dumps. Protected by mu (same lock as PolicyChains
 	// so that routes and sensitive values are always updated and read as one atomic snapshot).
 	sensitiveValues []string
+
+	// debugCapture holds the admin-initiated debug capture sessions that record
+	// policy decisions for selected requests.
+	debugCapture *debugcapture.Recorder
+
+	// chainsReplaced, when set, is called with the new chain mapping after every whole-route
+	// replacement, outside mu.
+	chainsReplaced func(map[string]*registry.PolicyChain)
 }
 
 // NewKernel creates a new Kernel instance
@@ -63,9 +72,23 @@ func NewKernel() *Kernel {
 	return &Kernel{
 		RouteConfigs: make(map[string]*RouteConfig),
 		PolicyChains: make(map[string]*registry.PolicyChain),
+		debugCapture: debugcapture.NewRecorder(),
 	}
 }
 
+// DebugCapture returns the recorder for policy decision debug capture sessions.
+func (k *Kernel) DebugCapture() *debugcapture.Recorder {
+	return k.debugCapture
+}
+
+// OnChainsReplaced registers fn to be called with the new chain mapping whenever all policy
+// chains are replaced. It must be called before the kernel receives configuration.
+func (k *Kernel) OnChainsReplaced(fn func(map[string]*registry.PolicyChain)) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.chainsReplaced = fn
+}
+
 // GetRouteConfig retrieves the route config for a given route key.
 func (k *Kernel) GetRouteConfig(routeKey string) *RouteConfig {
 	k.mu.RLock()
@@ -112,7 +135,6 @@ func (k *Kernel) ApplyWholeRouteConfigs(newConfigs map[string]*RouteConfig) {
 // ApplyWholeRoutes atomically replaces all policy chain mappings.
 func (k *Kernel) ApplyWholeRoutes(newRoutes map[string]*registry.PolicyChain) {
 	k.mu.Lock()
-	defer k.mu.Unlock()
 	keys := make([]string, 0, len(newRoutes))
 	for key := range newRoutes {
 		keys = append(keys, key)
@@ -121,6 +143,11 @@ func (k *Kernel) ApplyWholeRoutes(newRoutes map[string]*registry.PolicyChain) {
 		"count", len(newRoutes),
 		"routes", keys)
 	k.PolicyChains = newRoutes
+	notify := k.chainsReplaced
+	k.mu.Unlock()
+	if notify != nil {
+		notify(newRoutes)
+	}
 }
 
 // DumpRouteKeys returns the keys of all registered policy chains for debugging.
@@ -195,7 +222,6 @@ func (k *Kernel) GetSensitiveValues() []string {
 // sensitive values, which would bypass secret redaction.
 func (k *Kernel) ApplyWholeRoutesAndSensitiveValues(newRoutes map[string]*registry.PolicyChain, values []string) {
 	k.mu.Lock()
-	defer k.mu.Unlock()
 	keys := make([]string, 0, len(newRoutes))
 	for key := range newRoutes {
 		keys = append(keys, key)
@@ -206,6 +232,11 @@ func (k *Kernel) ApplyWholeRoutesAndSensitiveValues(newRoutes map[string]*regist
 		"sensitive_value_count", len(values))
 	k.PolicyChains = newRoutes
 	k.sensitiveValues = deduplicateValues(values)
+	notify := k.chainsReplaced
+	k.mu.Unlock()
+	if notify != nil {
+		notify(newRoutes)
+	}
 }
 
 // DumpRoutesAndSensitiveValues returns a consistent snapshot of all policy chain mappings and
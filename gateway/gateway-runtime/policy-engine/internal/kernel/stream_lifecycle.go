@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	extprocconfigv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// recordReceived tracks the phase and body bytes of a message received from Envoy.
+// Safe to call on a nil context (requests without a policy chain).
+func (ec *PolicyExecutionContext) recordReceived(req *extprocv3.ProcessingRequest) {
+	if ec == nil {
+		return
+	}
+	switch r := req.Request.(type) {
+	case *extprocv3.ProcessingRequest_RequestHeaders:
+		ec.lastPhase = "request_headers"
+	case *extprocv3.ProcessingRequest_RequestBody:
+		ec.lastPhase = "request_body"
+		if r.RequestBody != nil {
+			ec.requestBodyBytes += int64(len(r.RequestBody.Body))
+		}
+	case *extprocv3.ProcessingRequest_ResponseHeaders:
+		ec.lastPhase = "response_headers"
+	case *extprocv3.ProcessingRequest_ResponseBody:
+		ec.lastPhase = "response_body"
+		if r.ResponseBody != nil {
+			ec.responseBodyBytes += int64(len(r.ResponseBody.Body))
+		}
+	}
+}
+
+// recordSent marks the exchange complete once the response to its final message has been
+// delivered to Envoy: an immediate response, response headers when no response body will
+// follow, or the last response body message.
+func (ec *PolicyExecutionContext) recordSent(req *extprocv3.ProcessingRequest, resp *extprocv3.ProcessingResponse) {
+	if ec == nil {
+		return
+	}
+	if ir := resp.GetImmediateResponse(); ir != nil {
+		ec.immediateResponse = true
+		ec.exchangeComplete = true
+		if ir.Status != nil {
+			ec.finalStatus = int(ir.Status.Code)
+		}
+		return
+	}
+
+	switch r := req.Request.(type) {
+	case *extprocv3.ProcessingRequest_ResponseHeaders:
+		if ec.responseHeaderCtx != nil {
+			ec.finalStatus = ec.responseHeaderCtx.ResponseStatus
+		}
+		noBody := r.ResponseHeaders != nil && r.ResponseHeaders.EndOfStream
+		if mode := resp.GetModeOverride(); mode != nil {
+			noBody = noBody || mode.ResponseBodyMode == extprocconfigv3.ProcessingMode_NONE
+		} else {
+			noBody = noBody || !ec.policyChain.RequiresResponseBody
+		}
+		if noBody {
+			ec.exchangeComplete = true
+		}
+	case *extprocv3.ProcessingRequest_ResponseBody:
+		if (r.ResponseBody != nil && r.ResponseBody.EndOfStream) || ec.streamTerminated {
+			ec.exchangeComplete = true
+		}
+	}
+}
+
+// endStream reports the end of the stream to the chain's StreamErrorPolicy and
+// RequestCompletePolicy hooks. A stream that ends after the exchange completed is reported
+// as completed whatever the transport outcome. Safe to call on a nil context.
+func (ec *PolicyExecutionContext) endStream(ctx context.Context, reason policy.StreamEndReason, cause error) {
+	if ec == nil {
+		return
+	}
+	if ec.exchangeComplete {
+		reason, cause = policy.StreamEndCompleted, nil
+	}
+	metrics.StreamEndsTotal.WithLabelValues(string(reason)).Inc()
+	ec.capture.Finish(string(reason), ec.finalStatus, time.Since(ec.startTime))
+	if reason != policy.StreamEndCompleted {
+		slog.DebugContext(ctx, "Stream ended before the exchange completed",
+			"request_id", ec.requestID,
+			"route_key", ec.routeKey,
+			"reason", reason,
+			"last_phase", ec.lastPhase,
+			"error", cause,
+		)
+	}
+
+	endCtx := &policy.StreamEndContext{
+		SharedContext:     ec.sharedCtx,
+		Reason:            reason,
+		Err:               cause,
+		LastPhase:         ec.lastPhase,
+		ResponseStatus:    ec.finalStatus,
+		ImmediateResponse: ec.immediateResponse,
+		RequestBodyBytes:  ec.requestBodyBytes,
+		ResponseBodyBytes: ec.responseBodyBytes,
+		Duration:          time.Since(ec.startTime),
+	}
+
+	// The stream context is cancelled or about to be; hooks still need to reach their backends
+	// to release resources, so they only inherit its values.
+	hookCtx := context.WithoutCancel(ctx)
+	apiName := ""
+	if ec.sharedCtx != nil {
+		apiName = ec.sharedCtx.APIName
+	}
+	if reason != policy.StreamEndCompleted {
+		ec.server.executor.ExecuteStreamErrorPolicies(hookCtx, ec.policyChain.Policies, endCtx,
+			ec.policyChain.PolicySpecs, apiName, ec.routeKey)
+	}
+	ec.server.executor.ExecuteRequestCompletePolicies(hookCtx, ec.policyChain.Policies, endCtx,
+		ec.policyChain.PolicySpecs, apiName, ec.routeKey)
+}
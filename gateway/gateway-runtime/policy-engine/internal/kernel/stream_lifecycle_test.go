@@ -0,0 +1,281 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package kernel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	extprocv3 "github.com/envoyproxy/go-control-plane/envoy/service/ext_proc/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/constants"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// lifecyclePolicy records the stream lifecycle hooks it receives.
+type lifecyclePolicy struct {
+	headerAction policy.RequestHeaderAction
+	streamErrors []*policy.StreamEndContext
+	completions  []*policy.StreamEndContext
+	calls        []string
+}
+
+func (p *lifecyclePolicy) Mode() policy.ProcessingMode {
+	return policy.ProcessingMode{RequestHeaderMode: policy.HeaderModeProcess}
+}
+
+func (p *lifecyclePolicy) OnRequestHeaders(_ context.Context, reqCtx *policy.RequestHeaderContext, _ map[string]interface{}) policy.RequestHeaderAction {
+	reqCtx.Metadata["tokens"] = 42
+	return p.headerAction
+}
+
+func (p *lifecyclePolicy) OnStreamError(_ context.Context, endCtx *policy.StreamEndContext, _ map[string]interface{}) {
+	p.streamErrors = append(p.streamErrors, endCtx)
+	p.calls = append(p.calls, "stream_error")
+}
+
+func (p *lifecyclePolicy) OnRequestComplete(_ context.Context, endCtx *policy.StreamEndContext, _ map[string]interface{}) {
+	p.completions = append(p.completions, endCtx)
+	p.calls = append(p.calls, "request_complete")
+}
+
+func newLifecycleServer(pol *lifecyclePolicy) *ExternalProcessorServer {
+	return newLifecycleServerWithChain(&registry.PolicyChain{
+		Policies:              []policy.Policy{pol},
+		PolicySpecs:           []policy.PolicySpec{{Name: "lifecycle", Version: "v1.0.0", Enabled: true}},
+		RequiresRequestHeader: true,
+	})
+}
+
+func newLifecycleServerWithChain(chain *registry.PolicyChain) *ExternalProcessorServer {
+	kernel := NewKernel()
+	kernel.RegisterRoute("lifecycle-route", chain)
+	kernel.ApplyWholeRouteConfigs(map[string]*RouteConfig{
+		"lifecycle-route": {Metadata: RouteMetadata{RouteName: "lifecycle-route", APIName: "lifecycle-api"}},
+	})
+	chainExecutor := executor.NewChainExecutor(nil, nil, noop.NewTracerProvider().Tracer("test"))
+	return NewExternalProcessorServer(kernel, chainExecutor, config.TracingConfig{}, "")
+}
+
+func lifecycleRequestHeaders() *extprocv3.ProcessingRequest {
+	return &extprocv3.ProcessingRequest{
+		Request: &extprocv3.ProcessingRequest_RequestHeaders{
+			RequestHeaders: &extprocv3.HttpHeaders{
+				Headers: &corev3.HeaderMap{
+					Headers: []*corev3.HeaderValue{
+						{Key: ":path", RawValue: []byte("/lifecycle")},
+						{Key: ":method", RawValue: []byte("GET")},
+						{Key: "x-request-id", RawValue: []byte("req-lifecycle")},
+					},
+				},
+			},
+		},
+		Attributes: map[string]*structpb.Struct{
+			constants.ExtProcFilter: {
+				Fields: map[string]*structpb.Value{
+					"xds.route_name": structpb.NewStringValue("lifecycle-route"),
+				},
+			},
+		},
+	}
+}
+
+func lifecycleResponseHeaders(status string, endOfStream bool) *extprocv3.ProcessingRequest {
+	return &extprocv3.ProcessingRequest{
+		Request: &extprocv3.ProcessingRequest_ResponseHeaders{
+			ResponseHeaders: &extprocv3.HttpHeaders{
+				Headers: &corev3.HeaderMap{
+					Headers: []*corev3.HeaderValue{
+						{Key: ":status", RawValue: []byte(status)},
+					},
+				},
+				EndOfStream: endOfStream,
+			},
+		},
+	}
+}
+
+func TestProcess_StreamLifecycle_Completed(t *testing.T) {
+	pol := &lifecyclePolicy{}
+	server := newLifecycleServer(pol)
+
+	stream := newMockStream([]*extprocv3.ProcessingRequest{
+		lifecycleRequestHeaders(),
+		lifecycleResponseHeaders("201", true),
+	})
+	// Envoy cancelling the stream after the exchange completed is a normal end.
+	stream.recvErr = context.Canceled
+
+	require.NoError(t, server.Process(stream))
+
+	assert.Empty(t, pol.streamErrors)
+	require.Len(t, pol.completions, 1)
+	endCtx := pol.completions[0]
+	assert.Equal(t, policy.StreamEndCompleted, endCtx.Reason)
+	assert.NoError(t, endCtx.Err)
+	assert.Equal(t, "response_headers", endCtx.LastPhase)
+	assert.Equal(t, 201, endCtx.ResponseStatus)
+	assert.False(t, endCtx.ImmediateResponse)
+	assert.Equal(t, "req-lifecycle", endCtx.RequestID)
+	assert.Equal(t, 42, endCtx.Metadata["tokens"])
+	assert.Positive(t, endCtx.Duration)
+}
+
+func TestProcess_StreamLifecycle_ImmediateResponseCompletes(t *testing.T) {
+	pol := &lifecyclePolicy{headerAction: policy.ImmediateResponse{StatusCode: 429}}
+	server := newLifecycleServer(pol)
+
+	stream := newMockStream([]*extprocv3.ProcessingRequest{lifecycleRequestHeaders()})
+
+	require.NoError(t, server.Process(stream))
+
+	assert.Empty(t, pol.streamErrors)
+	require.Len(t, pol.completions, 1)
+	assert.Equal(t, policy.StreamEndCompleted, pol.completions[0].Reason)
+	assert.True(t, pol.completions[0].ImmediateResponse)
+	assert.Equal(t, 429, pol.completions[0].ResponseStatus)
+}
+
+func TestProcess_StreamLifecycle_AbortPaths(t *testing.T) {
+	tests := []struct {
+		name       string
+		headerErr  bool // make the request-headers translation fail
+		recvErr    error
+		sendErr    error
+		wantReason policy.StreamEndReason
+		wantErr    bool
+	}{
+		{
+			name:       "stream closed mid-flight",
+			wantReason: policy.StreamEndClosed,
+		},
+		{
+			name:       "stream cancelled mid-flight",
+			recvErr:    context.Canceled,
+			wantReason: policy.StreamEndCancelled,
+		},
+		{
+			name:       "receive error",
+			recvErr:    errors.New("connection reset"),
+			wantReason: policy.StreamEndReceiveError,
+			wantErr:    true,
+		},
+		{
+			name:       "send error",
+			sendErr:    errors.New("broken pipe"),
+			wantReason: policy.StreamEndSendError,
+			wantErr:    true,
+		},
+		{
+			name:       "processing error",
+			headerErr:  true,
+			wantReason: policy.StreamEndProcessingError,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pol := &lifecyclePolicy{}
+			if tt.headerErr {
+				// Channels cannot be converted to analytics metadata.
+				pol.headerAction = policy.UpstreamRequestHeaderModifications{
+					AnalyticsMetadata: map[string]any{"bad": make(chan int)},
+				}
+			}
+			server := newLifecycleServer(pol)
+
+			stream := newMockStream([]*extprocv3.ProcessingRequest{lifecycleRequestHeaders()})
+			stream.recvErr = tt.recvErr
+			stream.sendErr = tt.sendErr
+
+			err := server.Process(stream)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, []string{"stream_error", "request_complete"}, pol.calls)
+			require.Len(t, pol.streamErrors, 1)
+			endCtx := pol.streamErrors[0]
+			assert.Same(t, endCtx, pol.completions[0])
+			assert.Equal(t, tt.wantReason, endCtx.Reason)
+			assert.Equal(t, "request_headers", endCtx.LastPhase)
+			assert.Zero(t, endCtx.ResponseStatus)
+			assert.Equal(t, 42, endCtx.Metadata["tokens"])
+			if tt.recvErr != nil || tt.sendErr != nil || tt.headerErr {
+				assert.Error(t, endCtx.Err)
+			} else {
+				assert.NoError(t, endCtx.Err)
+			}
+		})
+	}
+}
+
+func TestProcess_StreamLifecycle_AbortDuringResponseBody(t *testing.T) {
+	pol := &lifecyclePolicy{}
+	server := newLifecycleServerWithChain(&registry.PolicyChain{
+		Policies:              []policy.Policy{pol},
+		PolicySpecs:           []policy.PolicySpec{{Name: "lifecycle", Version: "v1.0.0", Enabled: true}},
+		RequiresRequestHeader: true,
+		RequiresRequestBody:   true,
+		RequiresResponseBody:  true,
+	})
+
+	stream := newMockStream([]*extprocv3.ProcessingRequest{
+		lifecycleRequestHeaders(),
+		{Request: &extprocv3.ProcessingRequest_RequestBody{RequestBody: &extprocv3.HttpBody{Body: []byte("hello"), EndOfStream: true}}},
+		lifecycleResponseHeaders("200", false),
+		{Request: &extprocv3.ProcessingRequest_ResponseBody{ResponseBody: &extprocv3.HttpBody{Body: []byte("partial"), EndOfStream: false}}},
+	})
+	// The client goes away before the last response body message.
+	stream.recvErr = context.Canceled
+
+	require.NoError(t, server.Process(stream))
+
+	require.Len(t, pol.streamErrors, 1)
+	endCtx := pol.streamErrors[0]
+	assert.Equal(t, policy.StreamEndCancelled, endCtx.Reason)
+	assert.Equal(t, "response_body", endCtx.LastPhase)
+	assert.Equal(t, 200, endCtx.ResponseStatus)
+	assert.Equal(t, int64(5), endCtx.RequestBodyBytes)
+	assert.Equal(t, int64(7), endCtx.ResponseBodyBytes)
+	require.Len(t, pol.completions, 1)
+}
+
+func TestProcess_StreamLifecycle_NoPolicyChain(t *testing.T) {
+	kernel := NewKernel()
+	chainExecutor := executor.NewChainExecutor(nil, nil, nil)
+	server := NewExternalProcessorServer(kernel, chainExecutor, config.TracingConfig{}, "")
+
+	stream := newMockStream([]*extprocv3.ProcessingRequest{lifecycleRequestHeaders()})
+	stream.recvErr = context.Canceled
+
+	assert.NoError(t, server.Process(stream))
+}
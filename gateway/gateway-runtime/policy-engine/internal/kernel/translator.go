@@ -1429,6 +1429,19 @@ func mergeDynamicMetadata(dest map[string]map[string]interface{}, src map[string
 
 // ─── Streaming chunk translators ──────────────────────────────────────────────
 
+// compressStreamChunk re-compresses one chunk of a streamed body, creating the
+// per-direction compressor on the first chunk so that all chunks form a single stream.
+func compressStreamChunk(sc **streamCompressor, encoding string, chunk []byte, endOfStream bool) ([]byte, error) {
+	if *sc == nil {
+		comp, err := newStreamCompressor(encoding)
+		if err != nil {
+			return nil, err
+		}
+		*sc = comp
+	}
+	return (*sc).CompressChunk(chunk, endOfStream)
+}
+
 // TranslateStreamingRequestChunkAction converts a streaming request execution result
 // into an ext_proc StreamedBodyResponse.
 func TranslateStreamingRequestChunkAction(result *executor.StreamingRequestExecutionResult, originalChunk *policy.StreamBody, execCtx *PolicyExecutionContext) (*extprocv3.ProcessingResponse, error) {
@@ -1440,10 +1453,11 @@ func TranslateStreamingRequestChunkAction(result *executor.StreamingRequestExecu
 	}
 
 	// Re-compress the output if the original request was Content-Encoded.
-	// The upstream receives the Content-Encoding header as-is, so the body
-	// bytes must match the encoding the upstream expects.
+	// The upstream receives the Content-Encoding header as-is, so the chunks
+	// must form one stream in the encoding the upstream expects.
 	if execCtx.requestContentEncoding != "" {
-		recompressed, err := recompressBody(outputBody, execCtx.requestContentEncoding)
+		recompressed, err := compressStreamChunk(&execCtx.requestStreamComp, execCtx.requestContentEncoding,
+			outputBody, originalChunk.EndOfStream)
 		if err != nil {
 			slog.Warn("[streaming] failed to re-compress request body; sending uncompressed — Content-Encoding mismatch",
 				"encoding", execCtx.requestContentEncoding,
@@ -1513,12 +1527,17 @@ func TranslateStreamingResponseChunkAction(result *executor.StreamingResponseExe
 		outputBody = originalChunk.Chunk
 	}
 
+	// If a policy terminated the stream early (e.g. guardrail intervention), force
+	// EndOfStream so Envoy closes the connection cleanly after delivering the final chunk.
+	endOfStream := originalChunk.EndOfStream || result.StreamTerminated
+
 	// Re-compress the output if the original response was Content-Encoded.
 	// Response headers (including Content-Encoding) are already committed downstream
 	// in streaming mode and cannot be changed — the body must match the encoding
 	// the client expects.
 	if execCtx.responseContentEncoding != "" {
-		recompressed, err := recompressBody(outputBody, execCtx.responseContentEncoding)
+		recompressed, err := compressStreamChunk(&execCtx.responseStreamComp, execCtx.responseContentEncoding,
+			outputBody, endOfStream)
 		if err != nil {
 			slog.Warn("[streaming] failed to re-compress response body; sending uncompressed — Content-Encoding mismatch",
 				"encoding", execCtx.responseContentEncoding,
@@ -1562,9 +1581,6 @@ func TranslateStreamingResponseChunkAction(result *executor.StreamingResponseExe
 		mergeDynamicMetadata(execCtx.dynamicMetadata, dm)
 	}
 
-	// If a policy terminated the stream early (e.g. guardrail intervention), force
-	// EndOfStream so Envoy closes the connection cleanly after delivering the final chunk.
-	endOfStream := originalChunk.EndOfStream || result.StreamTerminated
 	if result.StreamTerminated {
 		slog.Info("[streaming] stream terminated by policy; forcing EndOfStream on final chunk")
 	}
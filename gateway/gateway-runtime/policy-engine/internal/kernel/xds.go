@@ -26,6 +26,7 @@ import (
 
 	"gopkg.in/yaml.v3"
 
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
 	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
 	policyenginev1 "github.com/wso2/api-platform/sdk/core/policyengine"
@@ -151,6 +152,11 @@ func (cl *ConfigLoader) buildPolicyChain(routeKey string, config *policyenginev1
 				policyConfig.Name, policyConfig.Version, routeKey, err)
 		}
 
+		timeout, onError, err := executor.ValidateFaultSettings(policyConfig.Timeout, policyConfig.OnError)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s:%s for route %s: %w", policyConfig.Name, policyConfig.Version, routeKey, err)
+		}
+
 		spec := policy.PolicySpec{
 			Name:               policyConfig.Name,
 			Version:            policyConfig.Version,
@@ -159,6 +165,8 @@ func (cl *ConfigLoader) buildPolicyChain(routeKey string, config *policyenginev1
 			Parameters: policy.PolicyParameters{
 				Raw: mergedParams,
 			},
+			Timeout: timeout,
+			OnError: onError,
 		}
 
 		if policyConfig.ExecutionCondition != nil && *policyConfig.ExecutionCondition != "" {
@@ -60,8 +60,19 @@ var (
 
 	PolicyErrorsTotal        CounterVec
 	StreamErrorsTotal        CounterVec
+	StreamEndsTotal          CounterVec
 	RouteLookupFailuresTotal Counter
 	PanicRecoveriesTotal     CounterVec
+
+	// Policy fault isolation metrics
+	PolicyCircuitBreakerState      GaugeVec
+	PolicyCircuitBreakerTripsTotal CounterVec
+
+	// Policy outbound HTTP client metrics
+	PolicyHTTPRequestsTotal          CounterVec
+	PolicyHTTPRequestDurationSeconds HistogramVec
+	PolicyHTTPRetriesTotal           CounterVec
+	PolicyHTTPCircuitBreakerState    GaugeVec
 )
 
 // initMetrics initializes all metric variables.
@@ -259,6 +270,15 @@ func initMetrics() {
 		[]string{"error_type"},
 	)
 
+	StreamEndsTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "stream_ends_total",
+			Help:      "Total number of ext_proc streams ended for requests with a policy chain, by end reason",
+		},
+		[]string{"reason"},
+	)
+
 	RouteLookupFailuresTotal = newCounter(
 		prometheus.CounterOpts{
 			Namespace: namespace,
@@ -275,6 +295,61 @@ func initMetrics() {
 		},
 		[]string{"component"},
 	)
+
+	PolicyCircuitBreakerState = newGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "policy_circuit_breaker_state",
+			Help:      "Circuit breaker state per policy instance (0=closed, 1=open, 2=half-open)",
+		},
+		[]string{"policy_name", "policy_version", "route"},
+	)
+
+	PolicyCircuitBreakerTripsTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "policy_circuit_breaker_trips_total",
+			Help:      "Total number of times a policy instance's circuit breaker opened",
+		},
+		[]string{"policy_name", "policy_version", "route"},
+	)
+
+	PolicyHTTPRequestsTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "policy_http_requests_total",
+			Help:      "Total number of outbound HTTP calls made by policies, by final outcome (status class, error or circuit_open)",
+		},
+		[]string{"policy_name", "host", "method", "outcome"},
+	)
+
+	PolicyHTTPRequestDurationSeconds = newHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "policy_http_request_duration_seconds",
+			Help:      "Duration of outbound HTTP calls made by policies in seconds, including retries",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{"policy_name", "host"},
+	)
+
+	PolicyHTTPRetriesTotal = newCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "policy_http_retries_total",
+			Help:      "Total number of retried outbound HTTP attempts made by policies",
+		},
+		[]string{"policy_name", "host"},
+	)
+
+	PolicyHTTPCircuitBreakerState = newGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "policy_http_circuit_breaker_state",
+			Help:      "Circuit breaker state per target host of policy HTTP calls (0=closed, 1=open, 2=half-open)",
+		},
+		[]string{"host"},
+	)
 }
 
 func registerCounterVec(v CounterVec) {
@@ -372,9 +447,18 @@ func initRegistry() {
 
 	registerCounterVec(PolicyErrorsTotal)
 	registerCounterVec(StreamErrorsTotal)
+	registerCounterVec(StreamEndsTotal)
 	registerCounter(RouteLookupFailuresTotal)
 	registerCounterVec(PanicRecoveriesTotal)
 
+	registerGaugeVec(PolicyCircuitBreakerState)
+	registerCounterVec(PolicyCircuitBreakerTripsTotal)
+
+	registerCounterVec(PolicyHTTPRequestsTotal)
+	registerHistogramVec(PolicyHTTPRequestDurationSeconds)
+	registerCounterVec(PolicyHTTPRetriesTotal)
+	registerGaugeVec(PolicyHTTPCircuitBreakerState)
+
 	Up.Set(1)
 }
 
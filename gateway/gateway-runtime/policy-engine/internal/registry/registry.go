@@ -44,6 +44,20 @@ type PolicyRegistry struct {
 
 	// ConfigResolver resolves ${config} CEL expressions in systemParameters
 	ConfigResolver *ConfigResolver
+
+	// StateStore is the state store shared by policies; each policy sees it through
+	// PolicyMetadata.StateStore scoped to the policy name. Nil when not configured.
+	StateStore policy.StateStore
+
+	// HTTPClients hands each policy its outbound HTTP client (PolicyMetadata.HTTPClient).
+	// Nil when not configured.
+	HTTPClients HTTPClientProvider
+}
+
+// HTTPClientProvider returns the outbound HTTP client a policy uses; metrics and traces of
+// its calls are labelled with the policy name.
+type HTTPClientProvider interface {
+	ForPolicy(policyName string) policy.PolicyHTTPClient
 }
 
 // Global singleton registry
@@ -112,6 +126,14 @@ func (r *PolicyRegistry) GetInstance(
 	// Merge resolved initParams with runtime params (params override initParams)
 	mergedParams := mergeParams(initParams, params)
 
+	// Hand policies the shared state store, scoped so policies cannot clobber each other's keys
+	if metadata.StateStore == nil && r.StateStore != nil {
+		metadata.StateStore = policy.NewPrefixedStateStore(r.StateStore, name+":")
+	}
+	if metadata.HTTPClient == nil && r.HTTPClients != nil {
+		metadata.HTTPClient = r.HTTPClients.ForPolicy(name)
+	}
+
 	// Call factory to create instance with merged params
 	instance, err := entry.Factory(metadata, mergedParams)
 	if err != nil {
@@ -165,6 +187,18 @@ func (r *PolicyRegistry) SetConfig(config map[string]interface{}) error {
 	return nil
 }
 
+// SetStateStore sets the state store handed to policies through PolicyMetadata.StateStore.
+// This should be called during startup before policy chains are built
+func (r *PolicyRegistry) SetStateStore(store policy.StateStore) {
+	r.StateStore = store
+}
+
+// SetHTTPClientProvider sets the provider of the HTTP clients handed to policies through
+// PolicyMetadata.HTTPClient. This should be called during startup before policy chains are built
+func (r *PolicyRegistry) SetHTTPClientProvider(provider HTTPClientProvider) {
+	r.HTTPClients = provider
+}
+
 // compositeKey creates a composite key from name and version
 func compositeKey(name, version string) string {
 	return fmt.Sprintf("%s:%s", name, version)
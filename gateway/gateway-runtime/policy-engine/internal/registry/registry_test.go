@@ -19,6 +19,8 @@
 package registry
 
 import (
+	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -562,6 +564,76 @@ func TestGetInstance(t *testing.T) {
 		assert.Nil(t, instance)
 		assert.Contains(t, err.Error(), "failed to resolve config for policy required-system-param:v1")
 	})
+
+	t.Run("state store is scoped to the policy name", func(t *testing.T) {
+		reg := newTestRegistry()
+		require.NoError(t, reg.SetConfig(map[string]interface{}{}))
+		store := policy.NewMemoryStateStore()
+		reg.SetStateStore(store)
+
+		var got policy.StateStore
+		factory := func(metadata policy.PolicyMetadata, _ map[string]interface{}) (policy.Policy, error) {
+			got = metadata.StateStore
+			return &testutils.NoopPolicy{}, nil
+		}
+		require.NoError(t, reg.Register(&policy.PolicyDefinition{Name: "basic-ratelimit", Version: "v1.0.0"}, factory))
+
+		_, _, err := reg.GetInstance("basic-ratelimit", "v1", policy.PolicyMetadata{}, map[string]interface{}{})
+		require.NoError(t, err)
+		require.NotNil(t, got)
+
+		_, err = got.IncrBy(context.Background(), "counter", 3, 0)
+		require.NoError(t, err)
+		value, found, err := store.Get(context.Background(), "basic-ratelimit:counter")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "3", string(value))
+	})
+
+	t.Run("no state store configured", func(t *testing.T) {
+		reg := newTestRegistry()
+		require.NoError(t, reg.SetConfig(map[string]interface{}{}))
+
+		var got policy.StateStore
+		factory := func(metadata policy.PolicyMetadata, _ map[string]interface{}) (policy.Policy, error) {
+			got = metadata.StateStore
+			return &testutils.NoopPolicy{}, nil
+		}
+		require.NoError(t, reg.Register(&policy.PolicyDefinition{Name: "basic-ratelimit", Version: "v1.0.0"}, factory))
+
+		_, _, err := reg.GetInstance("basic-ratelimit", "v1", policy.PolicyMetadata{}, map[string]interface{}{})
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("http client is labelled with the policy name", func(t *testing.T) {
+		reg := newTestRegistry()
+		require.NoError(t, reg.SetConfig(map[string]interface{}{}))
+		provider := &recordingHTTPClientProvider{}
+		reg.SetHTTPClientProvider(provider)
+
+		var got policy.PolicyHTTPClient
+		factory := func(metadata policy.PolicyMetadata, _ map[string]interface{}) (policy.Policy, error) {
+			got = metadata.HTTPClient
+			return &testutils.NoopPolicy{}, nil
+		}
+		require.NoError(t, reg.Register(&policy.PolicyDefinition{Name: "interceptor-service", Version: "v1.0.0"}, factory))
+
+		_, _, err := reg.GetInstance("interceptor-service", "v1", policy.PolicyMetadata{}, map[string]interface{}{})
+		require.NoError(t, err)
+		assert.Same(t, http.DefaultClient, got)
+		assert.Equal(t, []string{"interceptor-service"}, provider.names)
+	})
+}
+
+// recordingHTTPClientProvider hands out http.DefaultClient and records the policy names asked for.
+type recordingHTTPClientProvider struct {
+	names []string
+}
+
+func (p *recordingHTTPClientProvider) ForPolicy(policyName string) policy.PolicyHTTPClient {
+	p.names = append(p.names, policyName)
+	return http.DefaultClient
 }
 
 // TestPolicyChain tests the PolicyChain struct
@@ -0,0 +1,195 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package statestore
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// compareAndSwapScript sets KEYS[1] to ARGV[3] when it is absent (ARGV[1] == "0") or holds
+// ARGV[2] (ARGV[1] == "1"). ARGV[4] is the expiry in milliseconds, 0 for none.
+var compareAndSwapScript = redis.NewScript(`
+if ARGV[1] == "0" then
+  if redis.call("EXISTS", KEYS[1]) == 1 then return 0 end
+elseif redis.call("GET", KEYS[1]) ~= ARGV[2] then
+  return 0
+end
+if tonumber(ARGV[4]) > 0 then
+  redis.call("SET", KEYS[1], ARGV[3], "PX", ARGV[4])
+else
+  redis.call("SET", KEYS[1], ARGV[3])
+end
+return 1
+`)
+
+// incrByScript increments KEYS[1] by ARGV[1] and, when the key was created by this call,
+// sets its expiry to ARGV[2] milliseconds.
+var incrByScript = redis.NewScript(`
+local existed = redis.call("EXISTS", KEYS[1])
+local value = redis.call("INCRBY", KEYS[1], ARGV[1])
+if existed == 0 and tonumber(ARGV[2]) > 0 then
+  redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return value
+`)
+
+// slidingWindowScript keeps a sliding-window log in the sorted set KEYS[1]: members are
+// "<weight>:<id>" scored by the time in milliseconds they were recorded. It drops members older
+// than the window (ARGV[2] ms before ARGV[1]) and, when ARGV[5] is non-empty, records ARGV[3] as
+// member "<ARGV[3]>:<ARGV[5]>" unless the total would exceed ARGV[4] (> 0). Returns {total, added}.
+var slidingWindowScript = redis.NewScript(`
+local now, window = tonumber(ARGV[1]), tonumber(ARGV[2])
+local weight, limit = tonumber(ARGV[3]), tonumber(ARGV[4])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+local total = 0
+for _, member in ipairs(redis.call("ZRANGE", KEYS[1], 0, -1)) do
+  total = total + tonumber(string.match(member, "^(-?%d+):"))
+end
+if ARGV[5] == "" then return {total, 0} end
+if limit > 0 and total + weight > limit then return {total, 0} end
+redis.call("ZADD", KEYS[1], now, weight .. ":" .. ARGV[5])
+redis.call("PEXPIRE", KEYS[1], window)
+return {total + weight, 1}
+`)
+
+// RedisStateStore is a StateStore backed by Redis, shared by every policy engine replica that
+// points at the same server and key prefix. Multi-step operations run as Lua scripts so they are
+// atomic. Sliding windows are timed with the local clock, so replicas need synchronised clocks.
+type RedisStateStore struct {
+	client redis.UniversalClient
+	prefix string
+
+	// now is the clock used for sliding windows; replaceable in tests.
+	now func() time.Time
+}
+
+// NewRedisStateStore creates a state store that keeps its keys under prefix in client.
+func NewRedisStateStore(client redis.UniversalClient, prefix string) *RedisStateStore {
+	return &RedisStateStore{client: client, prefix: prefix, now: time.Now}
+}
+
+// Close closes the underlying Redis client.
+func (s *RedisStateStore) Close() error {
+	return s.client.Close()
+}
+
+// Get implements policy.StateStore.
+func (s *RedisStateStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.client.Get(ctx, s.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, mapRedisError(err)
+	}
+	return value, true, nil
+}
+
+// Set implements policy.StateStore.
+func (s *RedisStateStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	// go-redis treats a negative expiration as KEEPTTL, so normalise "no expiry" to 0.
+	return mapRedisError(s.client.Set(ctx, s.prefix+key, value, clampTTL(ttl)).Err())
+}
+
+// Delete implements policy.StateStore.
+func (s *RedisStateStore) Delete(ctx context.Context, key string) error {
+	return mapRedisError(s.client.Del(ctx, s.prefix+key).Err())
+}
+
+// CompareAndSwap implements policy.StateStore.
+func (s *RedisStateStore) CompareAndSwap(ctx context.Context, key string, oldValue, newValue []byte, ttl time.Duration) (bool, error) {
+	mustExist := "1"
+	if oldValue == nil {
+		mustExist = "0"
+	}
+	swapped, err := compareAndSwapScript.Run(ctx, s.client, []string{s.prefix + key},
+		mustExist, oldValue, newValue, ttlMillis(ttl)).Int()
+	if err != nil {
+		return false, mapRedisError(err)
+	}
+	return swapped == 1, nil
+}
+
+// IncrBy implements policy.StateStore.
+func (s *RedisStateStore) IncrBy(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	value, err := incrByScript.Run(ctx, s.client, []string{s.prefix + key}, delta, ttlMillis(ttl)).Int64()
+	if err != nil {
+		return 0, mapRedisError(err)
+	}
+	return value, nil
+}
+
+// SlidingWindowAdd implements policy.StateStore.
+func (s *RedisStateStore) SlidingWindowAdd(ctx context.Context, key string, weight, limit int64, window time.Duration) (int64, bool, error) {
+	return s.slidingWindow(ctx, key, weight, limit, window, uuid.NewString())
+}
+
+// SlidingWindowCount implements policy.StateStore.
+func (s *RedisStateStore) SlidingWindowCount(ctx context.Context, key string, window time.Duration) (int64, error) {
+	total, _, err := s.slidingWindow(ctx, key, 0, 0, window, "")
+	return total, err
+}
+
+func (s *RedisStateStore) slidingWindow(ctx context.Context, key string, weight, limit int64, window time.Duration, id string) (int64, bool, error) {
+	res, err := slidingWindowScript.Run(ctx, s.client, []string{s.prefix + key},
+		s.now().UnixMilli(), ttlMillis(window), weight, limit, id).Int64Slice()
+	if err != nil {
+		return 0, false, mapRedisError(err)
+	}
+	if len(res) != 2 {
+		return 0, false, errors.New("unexpected sliding window reply from redis")
+	}
+	return res[0], res[1] == 1, nil
+}
+
+func clampTTL(ttl time.Duration) time.Duration {
+	if ttl <= 0 {
+		return 0
+	}
+	if ttl < time.Millisecond {
+		return time.Millisecond
+	}
+	return ttl
+}
+
+// ttlMillis converts ttl to whole milliseconds, rounding sub-millisecond expiries up so they
+// are not mistaken for "no expiry".
+func ttlMillis(ttl time.Duration) int64 {
+	return clampTTL(ttl).Milliseconds()
+}
+
+// mapRedisError translates Redis type errors to policy.ErrStateWrongType.
+func mapRedisError(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	if strings.Contains(msg, "WRONGTYPE") || strings.Contains(msg, "not an integer") {
+		return policy.ErrStateWrongType
+	}
+	return err
+}
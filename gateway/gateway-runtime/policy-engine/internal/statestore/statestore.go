@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package statestore
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// New creates the state store selected by cfg. The returned store may implement io.Closer;
+// callers should close it on shutdown.
+func New(ctx context.Context, cfg config.StateStoreConfig) (policy.StateStore, error) {
+	switch cfg.Type {
+	case "memory", "":
+		return policy.NewMemoryStateStore(), nil
+	case "redis":
+		return newRedisFromConfig(ctx, cfg.Redis)
+	default:
+		return nil, fmt.Errorf("unsupported state store type: %s", cfg.Type)
+	}
+}
+
+// Close closes store if it holds resources.
+func Close(store policy.StateStore) error {
+	if c, ok := store.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+func newRedisFromConfig(ctx context.Context, cfg config.StateStoreRedisConfig) (*RedisStateStore, error) {
+	opts := &redis.Options{
+		Addr:                  cfg.Address,
+		Username:              cfg.Username,
+		Password:              cfg.Password,
+		DB:                    cfg.DB,
+		DialTimeout:           cfg.DialTimeout,
+		ReadTimeout:           cfg.OperationTimeout,
+		WriteTimeout:          cfg.OperationTimeout,
+		PoolSize:              cfg.PoolSize,
+		ContextTimeoutEnabled: true,
+	}
+	if cfg.TLS {
+		opts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+
+	client := redis.NewClient(opts)
+	pingCtx, cancel := context.WithTimeout(ctx, cfg.DialTimeout)
+	defer cancel()
+	if err := client.Ping(pingCtx).Err(); err != nil {
+		_ = client.Close()
+		return nil, fmt.Errorf("failed to connect to redis state store at %s: %w", cfg.Address, err)
+	}
+	return NewRedisStateStore(client, cfg.KeyPrefix), nil
+}
@@ -0,0 +1,325 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package statestore
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// testBackend is a state store under test together with a way to move its clock forward.
+type testBackend struct {
+	store   policy.StateStore
+	advance func(time.Duration)
+}
+
+func newMemoryBackend(t *testing.T) testBackend {
+	t.Helper()
+	now := time.Unix(1_700_000_000, 0)
+	store := policy.NewMemoryStateStore()
+	store.SetClock(func() time.Time { return now })
+	return testBackend{store: store, advance: func(d time.Duration) { now = now.Add(d) }}
+}
+
+func newRedisBackend(t *testing.T) testBackend {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	store := NewRedisStateStore(client, "test:")
+	t.Cleanup(func() { _ = store.Close() })
+
+	now := time.Unix(1_700_000_000, 0)
+	store.now = func() time.Time { return now }
+	return testBackend{store: store, advance: func(d time.Duration) {
+		now = now.Add(d)
+		mr.FastForward(d)
+	}}
+}
+
+// forEachBackend runs fn against every StateStore implementation so they behave identically.
+func forEachBackend(t *testing.T, fn func(t *testing.T, b testBackend)) {
+	t.Run("memory", func(t *testing.T) { fn(t, newMemoryBackend(t)) })
+	t.Run("redis", func(t *testing.T) { fn(t, newRedisBackend(t)) })
+}
+
+func TestStateStore_GetSetDelete(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b testBackend) {
+		ctx := context.Background()
+
+		_, found, err := b.store.Get(ctx, "k")
+		require.NoError(t, err)
+		assert.False(t, found)
+
+		require.NoError(t, b.store.Set(ctx, "k", []byte("v1"), 0))
+		value, found, err := b.store.Get(ctx, "k")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "v1", string(value))
+
+		require.NoError(t, b.store.Delete(ctx, "k"))
+		require.NoError(t, b.store.Delete(ctx, "k"))
+		_, found, err = b.store.Get(ctx, "k")
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
+
+func TestStateStore_SetExpires(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b testBackend) {
+		ctx := context.Background()
+		require.NoError(t, b.store.Set(ctx, "k", []byte("v"), time.Second))
+
+		b.advance(500 * time.Millisecond)
+		_, found, err := b.store.Get(ctx, "k")
+		require.NoError(t, err)
+		assert.True(t, found)
+
+		b.advance(time.Second)
+		_, found, err = b.store.Get(ctx, "k")
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
+
+func TestStateStore_CompareAndSwap(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b testBackend) {
+		ctx := context.Background()
+
+		swapped, err := b.store.CompareAndSwap(ctx, "k", nil, []byte("a"), 0)
+		require.NoError(t, err)
+		assert.True(t, swapped, "set-if-absent on a missing key")
+
+		swapped, err = b.store.CompareAndSwap(ctx, "k", nil, []byte("b"), 0)
+		require.NoError(t, err)
+		assert.False(t, swapped, "set-if-absent on an existing key")
+
+		swapped, err = b.store.CompareAndSwap(ctx, "k", []byte("x"), []byte("b"), 0)
+		require.NoError(t, err)
+		assert.False(t, swapped, "old value does not match")
+
+		swapped, err = b.store.CompareAndSwap(ctx, "k", []byte("a"), []byte("b"), time.Second)
+		require.NoError(t, err)
+		assert.True(t, swapped)
+
+		value, _, err := b.store.Get(ctx, "k")
+		require.NoError(t, err)
+		assert.Equal(t, "b", string(value))
+
+		swapped, err = b.store.CompareAndSwap(ctx, "missing", []byte("a"), []byte("b"), 0)
+		require.NoError(t, err)
+		assert.False(t, swapped, "old value given for a missing key")
+
+		b.advance(2 * time.Second)
+		_, found, err := b.store.Get(ctx, "k")
+		require.NoError(t, err)
+		assert.False(t, found, "swapped value takes the new ttl")
+	})
+}
+
+func TestStateStore_IncrBy(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b testBackend) {
+		ctx := context.Background()
+
+		v, err := b.store.IncrBy(ctx, "c", 2, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), v)
+
+		b.advance(40 * time.Second)
+		v, err = b.store.IncrBy(ctx, "c", 3, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), v)
+
+		// The expiry is set when the counter is created and not extended by later increments.
+		b.advance(30 * time.Second)
+		v, err = b.store.IncrBy(ctx, "c", 1, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), v)
+
+		v, err = b.store.IncrBy(ctx, "c", -4, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, int64(-3), v)
+	})
+}
+
+func TestStateStore_IncrByConcurrent(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b testBackend) {
+		ctx := context.Background()
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 10; j++ {
+					_, err := b.store.IncrBy(ctx, "c", 1, 0)
+					assert.NoError(t, err)
+				}
+			}()
+		}
+		wg.Wait()
+
+		value, _, err := b.store.Get(ctx, "c")
+		require.NoError(t, err)
+		assert.Equal(t, "200", string(value))
+	})
+}
+
+func TestStateStore_SlidingWindow(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b testBackend) {
+		ctx := context.Background()
+		window := 10 * time.Second
+
+		total, added, err := b.store.SlidingWindowAdd(ctx, "w", 3, 5, window)
+		require.NoError(t, err)
+		assert.True(t, added)
+		assert.Equal(t, int64(3), total)
+
+		b.advance(4 * time.Second)
+		total, added, err = b.store.SlidingWindowAdd(ctx, "w", 2, 5, window)
+		require.NoError(t, err)
+		assert.True(t, added)
+		assert.Equal(t, int64(5), total)
+
+		total, added, err = b.store.SlidingWindowAdd(ctx, "w", 1, 5, window)
+		require.NoError(t, err)
+		assert.False(t, added, "limit reached")
+		assert.Equal(t, int64(5), total)
+
+		// The first event leaves the window; the second is still inside it.
+		b.advance(7 * time.Second)
+		count, err := b.store.SlidingWindowCount(ctx, "w", window)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		total, added, err = b.store.SlidingWindowAdd(ctx, "w", 3, 5, window)
+		require.NoError(t, err)
+		assert.True(t, added)
+		assert.Equal(t, int64(5), total)
+
+		total, added, err = b.store.SlidingWindowAdd(ctx, "w", 100, 0, window)
+		require.NoError(t, err)
+		assert.True(t, added, "limit <= 0 is unlimited")
+		assert.Equal(t, int64(105), total)
+
+		b.advance(window + time.Second)
+		count, err = b.store.SlidingWindowCount(ctx, "w", window)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
+
+func TestStateStore_WrongType(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b testBackend) {
+		ctx := context.Background()
+		require.NoError(t, b.store.Set(ctx, "plain", []byte("not-a-number"), 0))
+		_, _, err := b.store.SlidingWindowAdd(ctx, "window", 1, 0, time.Minute)
+		require.NoError(t, err)
+
+		_, err = b.store.IncrBy(ctx, "plain", 1, 0)
+		assert.ErrorIs(t, err, policy.ErrStateWrongType)
+
+		_, _, err = b.store.SlidingWindowAdd(ctx, "plain", 1, 0, time.Minute)
+		assert.ErrorIs(t, err, policy.ErrStateWrongType)
+
+		_, _, err = b.store.Get(ctx, "window")
+		assert.ErrorIs(t, err, policy.ErrStateWrongType)
+
+		_, err = b.store.CompareAndSwap(ctx, "window", []byte("x"), []byte("y"), 0)
+		assert.ErrorIs(t, err, policy.ErrStateWrongType)
+	})
+}
+
+func TestStateStore_Prefixed(t *testing.T) {
+	forEachBackend(t, func(t *testing.T, b testBackend) {
+		ctx := context.Background()
+		a := policy.NewPrefixedStateStore(b.store, "a:")
+		c := policy.NewPrefixedStateStore(b.store, "c:")
+
+		_, err := a.IncrBy(ctx, "n", 1, 0)
+		require.NoError(t, err)
+		_, err = c.IncrBy(ctx, "n", 5, 0)
+		require.NoError(t, err)
+
+		value, _, err := a.Get(ctx, "n")
+		require.NoError(t, err)
+		assert.Equal(t, "1", string(value))
+		value, _, err = b.store.Get(ctx, "c:n")
+		require.NoError(t, err)
+		assert.Equal(t, "5", string(value))
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		store, err := New(context.Background(), config.StateStoreConfig{Type: "memory"})
+		require.NoError(t, err)
+		assert.IsType(t, &policy.MemoryStateStore{}, store)
+		assert.NoError(t, Close(store))
+	})
+
+	t.Run("redis", func(t *testing.T) {
+		mr := miniredis.RunT(t)
+		store, err := New(context.Background(), config.StateStoreConfig{
+			Type: "redis",
+			Redis: config.StateStoreRedisConfig{
+				Address:          mr.Addr(),
+				KeyPrefix:        "gw1:",
+				DialTimeout:      time.Second,
+				OperationTimeout: time.Second,
+			},
+		})
+		require.NoError(t, err)
+		defer Close(store)
+
+		require.NoError(t, store.Set(context.Background(), "k", []byte("v"), 0))
+		got, err := mr.Get("gw1:k")
+		require.NoError(t, err)
+		assert.Equal(t, "v", got)
+	})
+
+	t.Run("redis unreachable", func(t *testing.T) {
+		mr := miniredis.RunT(t)
+		addr := mr.Addr()
+		mr.Close()
+
+		_, err := New(context.Background(), config.StateStoreConfig{
+			Type: "redis",
+			Redis: config.StateStoreRedisConfig{
+				Address:          addr,
+				DialTimeout:      200 * time.Millisecond,
+				OperationTimeout: 200 * time.Millisecond,
+			},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := New(context.Background(), config.StateStoreConfig{Type: "etcd"})
+		assert.Error(t, err)
+	})
+}
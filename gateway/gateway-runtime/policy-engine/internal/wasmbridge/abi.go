@@ -0,0 +1,265 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wasmbridge
+
+import (
+	"fmt"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// The host ABI between the policy engine and a WebAssembly policy module.
+//
+// A module exports its linear memory as "memory" and these functions:
+//
+//	policy_alloc(size i32) i32              required; returns a buffer the host writes input into
+//	policy_free(ptr i32, size i32)          optional; called for input and output buffers
+//	policy_init(ptr i32, size i32) i64      optional; validates params, see initInput/initOutput
+//	on_request_headers(ptr i32, size i32) i64
+//	on_request_body(ptr i32, size i32) i64
+//	on_response_headers(ptr i32, size i32) i64
+//	on_response_body(ptr i32, size i32) i64
+//
+// A module exports at least one on_* function; the exported ones decide the policy's processing
+// mode, the same way implementing RequestHeaderPolicy or RequestPolicy does for Go policies.
+// Each on_* function receives a JSON callInput and returns a JSON callOutput as a packed i64,
+// (ptr << 32) | size; 0 means no action. Byte fields (bodies) are base64 encoded, as encoding/json
+// does for []byte. Modules built for WASI (TinyGo, Rust wasm32-wasip1, Go wasip1 reactors) are
+// supported; "_initialize" is run when exported.
+//
+// Module instances are pooled and shared by every route the policy is attached to, so modules must
+// not keep per-route state between calls: params and the request context come with every call.
+//
+// The host exports one import module, "api_platform":
+//
+//	log(level i32, ptr i32, size i32)       level: 0 debug, 1 info, 2 warn, 3 error
+const (
+	hostModuleName = "api_platform"
+
+	exportMemory            = "memory"
+	exportAlloc             = "policy_alloc"
+	exportFree              = "policy_free"
+	exportInit              = "policy_init"
+	exportOnRequestHeaders  = "on_request_headers"
+	exportOnRequestBody     = "on_request_body"
+	exportOnResponseHeaders = "on_response_headers"
+	exportOnResponseBody    = "on_response_body"
+	exportInitialize        = "_initialize"
+)
+
+// initInput is passed to policy_init when a policy instance is created for a route.
+type initInput struct {
+	Params   map[string]interface{} `json:"params"`
+	Metadata initMetadata           `json:"metadata"`
+}
+
+type initMetadata struct {
+	RouteName  string `json:"routeName"`
+	APIId      string `json:"apiId"`
+	APIName    string `json:"apiName"`
+	APIVersion string `json:"apiVersion"`
+	AttachedTo string `json:"attachedTo"`
+}
+
+// initOutput is returned by policy_init; a non-empty Error rejects the params.
+type initOutput struct {
+	Error string `json:"error,omitempty"`
+}
+
+// callInput is passed to the on_* functions.
+type callInput struct {
+	Phase    string                 `json:"phase"`
+	Params   map[string]interface{} `json:"params"`
+	Shared   sharedInput            `json:"shared"`
+	Request  *requestInput          `json:"request,omitempty"`
+	Response *responseInput         `json:"response,omitempty"`
+}
+
+type sharedInput struct {
+	ProjectID     string                 `json:"projectId,omitempty"`
+	RequestID     string                 `json:"requestId"`
+	APIId         string                 `json:"apiId,omitempty"`
+	APIName       string                 `json:"apiName,omitempty"`
+	APIVersion    string                 `json:"apiVersion,omitempty"`
+	APIKind       string                 `json:"apiKind,omitempty"`
+	APIContext    string                 `json:"apiContext,omitempty"`
+	OperationPath string                 `json:"operationPath,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type requestInput struct {
+	Headers   map[string][]string `json:"headers"`
+	Path      string              `json:"path"`
+	Method    string              `json:"method"`
+	Authority string              `json:"authority,omitempty"`
+	Scheme    string              `json:"scheme,omitempty"`
+	Body      []byte              `json:"body,omitempty"`
+}
+
+type responseInput struct {
+	Headers map[string][]string `json:"headers"`
+	Status  int                 `json:"status"`
+	Body    []byte              `json:"body,omitempty"`
+}
+
+// callOutput is returned by the on_* functions. ImmediateResponse short-circuits the chain;
+// otherwise the modifications valid for the phase are applied and the rest are ignored.
+type callOutput struct {
+	ImmediateResponse *immediateResponseOutput `json:"immediateResponse,omitempty"`
+
+	HeadersToSet    map[string]string `json:"headersToSet,omitempty"`
+	HeadersToRemove []string          `json:"headersToRemove,omitempty"`
+
+	// Request phases only
+	UpstreamName            *string             `json:"upstreamName,omitempty"`
+	Path                    *string             `json:"path,omitempty"`
+	Host                    *string             `json:"host,omitempty"`
+	Method                  *string             `json:"method,omitempty"`
+	QueryParametersToAdd    map[string][]string `json:"queryParametersToAdd,omitempty"`
+	QueryParametersToRemove []string            `json:"queryParametersToRemove,omitempty"`
+
+	// Body phases only; absent means passthrough and "" clears the body
+	Body []byte `json:"body"`
+
+	// on_response_body only
+	StatusCode *int `json:"statusCode,omitempty"`
+
+	AnalyticsMetadata map[string]any            `json:"analyticsMetadata,omitempty"`
+	DynamicMetadata   map[string]map[string]any `json:"dynamicMetadata,omitempty"`
+
+	// Metadata entries are merged into SharedContext.Metadata for later policies
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type immediateResponseOutput struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       []byte            `json:"body,omitempty"`
+}
+
+func newSharedInput(shared *policy.SharedContext) sharedInput {
+	if shared == nil {
+		return sharedInput{}
+	}
+	return sharedInput{
+		ProjectID:     shared.ProjectID,
+		RequestID:     shared.RequestID,
+		APIId:         shared.APIId,
+		APIName:       shared.APIName,
+		APIVersion:    shared.APIVersion,
+		APIKind:       string(shared.APIKind),
+		APIContext:    shared.APIContext,
+		OperationPath: shared.OperationPath,
+		Metadata:      shared.Metadata,
+	}
+}
+
+func headerValues(h *policy.Headers) map[string][]string {
+	if h == nil {
+		return map[string][]string{}
+	}
+	return h.GetAll()
+}
+
+func bodyContent(b *policy.Body) []byte {
+	if b == nil || !b.Present {
+		return nil
+	}
+	return b.Content
+}
+
+func (o *callOutput) immediateResponse() (policy.ImmediateResponse, error) {
+	ir := o.ImmediateResponse
+	if ir.StatusCode < 100 || ir.StatusCode > 599 {
+		return policy.ImmediateResponse{}, fmt.Errorf("immediateResponse has invalid statusCode %d", ir.StatusCode)
+	}
+	return policy.ImmediateResponse{
+		StatusCode:        ir.StatusCode,
+		Headers:           ir.Headers,
+		Body:              ir.Body,
+		AnalyticsMetadata: o.AnalyticsMetadata,
+		DynamicMetadata:   o.DynamicMetadata,
+	}, nil
+}
+
+func (o *callOutput) toRequestHeaderAction() (policy.RequestHeaderAction, error) {
+	if o.ImmediateResponse != nil {
+		return o.immediateResponse()
+	}
+	return policy.UpstreamRequestHeaderModifications{
+		HeadersToSet:            o.HeadersToSet,
+		HeadersToRemove:         o.HeadersToRemove,
+		UpstreamName:            o.UpstreamName,
+		Path:                    o.Path,
+		Host:                    o.Host,
+		Method:                  o.Method,
+		QueryParametersToAdd:    o.QueryParametersToAdd,
+		QueryParametersToRemove: o.QueryParametersToRemove,
+		AnalyticsMetadata:       o.AnalyticsMetadata,
+		DynamicMetadata:         o.DynamicMetadata,
+	}, nil
+}
+
+func (o *callOutput) toRequestAction() (policy.RequestAction, error) {
+	if o.ImmediateResponse != nil {
+		return o.immediateResponse()
+	}
+	return policy.UpstreamRequestModifications{
+		Body:                    o.Body,
+		HeadersToSet:            o.HeadersToSet,
+		HeadersToRemove:         o.HeadersToRemove,
+		UpstreamName:            o.UpstreamName,
+		Path:                    o.Path,
+		Host:                    o.Host,
+		Method:                  o.Method,
+		QueryParametersToAdd:    o.QueryParametersToAdd,
+		QueryParametersToRemove: o.QueryParametersToRemove,
+		AnalyticsMetadata:       o.AnalyticsMetadata,
+		DynamicMetadata:         o.DynamicMetadata,
+	}, nil
+}
+
+func (o *callOutput) toResponseHeaderAction() (policy.ResponseHeaderAction, error) {
+	if o.ImmediateResponse != nil {
+		return o.immediateResponse()
+	}
+	return policy.DownstreamResponseHeaderModifications{
+		HeadersToSet:      o.HeadersToSet,
+		HeadersToRemove:   o.HeadersToRemove,
+		AnalyticsMetadata: o.AnalyticsMetadata,
+		DynamicMetadata:   o.DynamicMetadata,
+	}, nil
+}
+
+func (o *callOutput) toResponseAction() (policy.ResponseAction, error) {
+	if o.ImmediateResponse != nil {
+		return o.immediateResponse()
+	}
+	if o.StatusCode != nil && (*o.StatusCode < 100 || *o.StatusCode > 599) {
+		return nil, fmt.Errorf("invalid statusCode %d", *o.StatusCode)
+	}
+	return policy.DownstreamResponseModifications{
+		Body:              o.Body,
+		StatusCode:        o.StatusCode,
+		HeadersToSet:      o.HeadersToSet,
+		HeadersToRemove:   o.HeadersToRemove,
+		AnalyticsMetadata: o.AnalyticsMetadata,
+		DynamicMetadata:   o.DynamicMetadata,
+	}, nil
+}
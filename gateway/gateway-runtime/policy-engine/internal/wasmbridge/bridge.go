@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wasmbridge
+
+import (
+	"context"
+	"log/slog"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// bridge adapts a WebAssembly policy module to the policy interfaces. Like the Python bridge it
+// implements every phase interface and relies on Mode() to gate which phases run; a failed call
+// (trap, timeout, fuel or memory exhaustion, malformed output) produces a 500 response.
+type bridge struct {
+	policyName    string
+	policyVersion string
+	mode          policy.ProcessingMode
+	module        *module
+	slogger       *slog.Logger
+}
+
+var (
+	_ policy.Policy               = (*bridge)(nil)
+	_ policy.RequestHeaderPolicy  = (*bridge)(nil)
+	_ policy.RequestPolicy        = (*bridge)(nil)
+	_ policy.ResponseHeaderPolicy = (*bridge)(nil)
+	_ policy.ResponsePolicy       = (*bridge)(nil)
+)
+
+func (b *bridge) Mode() policy.ProcessingMode {
+	return b.mode
+}
+
+func (b *bridge) OnRequestHeaders(ctx context.Context, reqCtx *policy.RequestHeaderContext, params map[string]interface{}) policy.RequestHeaderAction {
+	if b.mode.RequestHeaderMode != policy.HeaderModeProcess {
+		return policy.UpstreamRequestHeaderModifications{}
+	}
+
+	in := callInput{
+		Phase:  exportOnRequestHeaders,
+		Params: params,
+		Shared: newSharedInput(reqCtx.SharedContext),
+		Request: &requestInput{
+			Headers:   headerValues(reqCtx.Headers),
+			Path:      reqCtx.Path,
+			Method:    reqCtx.Method,
+			Authority: reqCtx.Authority,
+			Scheme:    reqCtx.Scheme,
+		},
+	}
+	out, ok := b.execute(ctx, exportOnRequestHeaders, in, reqCtx.SharedContext)
+	if !ok {
+		return b.errorImmediateResponse()
+	}
+	action, err := out.toRequestHeaderAction()
+	if err != nil {
+		b.slogger.ErrorContext(ctx, "Invalid WebAssembly request-header output", "error", err)
+		return b.errorImmediateResponse()
+	}
+	return action
+}
+
+func (b *bridge) OnRequestBody(ctx context.Context, reqCtx *policy.RequestContext, params map[string]interface{}) policy.RequestAction {
+	if b.mode.RequestBodyMode == policy.BodyModeSkip {
+		return nil
+	}
+
+	in := callInput{
+		Phase:  exportOnRequestBody,
+		Params: params,
+		Shared: newSharedInput(reqCtx.SharedContext),
+		Request: &requestInput{
+			Headers:   headerValues(reqCtx.Headers),
+			Path:      reqCtx.Path,
+			Method:    reqCtx.Method,
+			Authority: reqCtx.Authority,
+			Scheme:    reqCtx.Scheme,
+			Body:      bodyContent(reqCtx.Body),
+		},
+	}
+	out, ok := b.execute(ctx, exportOnRequestBody, in, reqCtx.SharedContext)
+	if !ok {
+		return b.errorImmediateResponse()
+	}
+	action, err := out.toRequestAction()
+	if err != nil {
+		b.slogger.ErrorContext(ctx, "Invalid WebAssembly request-body output", "error", err)
+		return b.errorImmediateResponse()
+	}
+	return action
+}
+
+func (b *bridge) OnResponseHeaders(ctx context.Context, respCtx *policy.ResponseHeaderContext, params map[string]interface{}) policy.ResponseHeaderAction {
+	if b.mode.ResponseHeaderMode != policy.HeaderModeProcess {
+		return policy.DownstreamResponseHeaderModifications{}
+	}
+
+	in := callInput{
+		Phase:  exportOnResponseHeaders,
+		Params: params,
+		Shared: newSharedInput(respCtx.SharedContext),
+		Request: &requestInput{
+			Headers: headerValues(respCtx.RequestHeaders),
+			Path:    respCtx.RequestPath,
+			Method:  respCtx.RequestMethod,
+			Body:    bodyContent(respCtx.RequestBody),
+		},
+		Response: &responseInput{
+			Headers: headerValues(respCtx.ResponseHeaders),
+			Status:  respCtx.ResponseStatus,
+		},
+	}
+	out, ok := b.execute(ctx, exportOnResponseHeaders, in, respCtx.SharedContext)
+	if !ok {
+		return b.errorImmediateResponse()
+	}
+	action, err := out.toResponseHeaderAction()
+	if err != nil {
+		b.slogger.ErrorContext(ctx, "Invalid WebAssembly response-header output", "error", err)
+		return b.errorImmediateResponse()
+	}
+	return action
+}
+
+func (b *bridge) OnResponseBody(ctx context.Context, respCtx *policy.ResponseContext, params map[string]interface{}) policy.ResponseAction {
+	if b.mode.ResponseBodyMode == policy.BodyModeSkip {
+		return nil
+	}
+
+	in := callInput{
+		Phase:  exportOnResponseBody,
+		Params: params,
+		Shared: newSharedInput(respCtx.SharedContext),
+		Request: &requestInput{
+			Headers: headerValues(respCtx.RequestHeaders),
+			Path:    respCtx.RequestPath,
+			Method:  respCtx.RequestMethod,
+			Body:    bodyContent(respCtx.RequestBody),
+		},
+		Response: &responseInput{
+			Headers: headerValues(respCtx.ResponseHeaders),
+			Status:  respCtx.ResponseStatus,
+			Body:    bodyContent(respCtx.ResponseBody),
+		},
+	}
+	out, ok := b.execute(ctx, exportOnResponseBody, in, respCtx.SharedContext)
+	if !ok {
+		return b.errorImmediateResponse()
+	}
+	action, err := out.toResponseAction()
+	if err != nil {
+		b.slogger.ErrorContext(ctx, "Invalid WebAssembly response-body output", "error", err)
+		return b.errorImmediateResponse()
+	}
+	return action
+}
+
+// execute calls fn and merges the returned metadata into the shared context. ok is false when
+// the call failed; the error has been logged.
+func (b *bridge) execute(ctx context.Context, fn string, in callInput, shared *policy.SharedContext) (*callOutput, bool) {
+	out := &callOutput{}
+	if _, err := b.module.call(ctx, b.slogger, fn, in, out); err != nil {
+		b.slogger.ErrorContext(ctx, "WebAssembly policy call failed", "function", fn, "error", err)
+		return nil, false
+	}
+	if shared != nil && len(out.Metadata) > 0 {
+		if shared.Metadata == nil {
+			shared.Metadata = make(map[string]interface{}, len(out.Metadata))
+		}
+		for key, value := range out.Metadata {
+			shared.Metadata[key] = value
+		}
+	}
+	return out, true
+}
+
+func (b *bridge) errorImmediateResponse() policy.ImmediateResponse {
+	return policy.ImmediateResponse{
+		StatusCode: 500,
+		Headers:    map[string]string{"Content-Type": "text/plain"},
+		Body:       []byte("Internal policy error"),
+	}
+}
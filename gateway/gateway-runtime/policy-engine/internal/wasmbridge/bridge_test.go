@@ -0,0 +1,251 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wasmbridge
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// guestWasm is testdata/guest compiled for wasip1 by TestMain.
+var guestWasm []byte
+
+var testLimits = config.WasmRuntimeConfig{
+	MaxMemoryMB: 64,
+	CallTimeout: 2 * time.Second,
+	PoolSize:    2,
+}
+
+func TestMain(m *testing.M) {
+	code, err := buildGuest()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "build wasm test guest:", err)
+		os.Exit(1)
+	}
+	guestWasm = code
+	Init(testLimits)
+	os.Exit(m.Run())
+}
+
+func buildGuest() ([]byte, error) {
+	dir, err := os.MkdirTemp("", "wasmbridge-guest-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	out := filepath.Join(dir, "guest.wasm")
+	cmd := exec.Command("go", "build", "-buildmode=c-shared", "-o", out, "./testdata/guest")
+	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm", "GOFLAGS=")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, output)
+	}
+	return os.ReadFile(out)
+}
+
+func newTestPolicy(t *testing.T, params map[string]interface{}) *bridge {
+	t.Helper()
+	f := &BridgeFactory{PolicyName: "wasm-test", PolicyVersion: "v1.0.0", Module: guestWasm}
+	p, err := f.GetPolicy(policy.PolicyMetadata{RouteName: "route-1"}, params)
+	require.NoError(t, err)
+	return p.(*bridge)
+}
+
+func newRequestHeaderContext() *policy.RequestHeaderContext {
+	return &policy.RequestHeaderContext{
+		SharedContext: &policy.SharedContext{RequestID: "req-1", Metadata: map[string]interface{}{}},
+		Headers:       policy.NewHeaders(map[string][]string{"x-internal": {"1"}}),
+		Path:          "/pets",
+		Method:        "GET",
+	}
+}
+
+func newRequestContext() *policy.RequestContext {
+	return &policy.RequestContext{
+		SharedContext: &policy.SharedContext{RequestID: "req-1"},
+		Headers:       policy.NewHeaders(map[string][]string{}),
+		Body:          &policy.Body{Content: []byte("{}"), Present: true, EndOfStream: true},
+		Path:          "/pets",
+		Method:        "POST",
+	}
+}
+
+func TestGetPolicy_ModeFromExports(t *testing.T) {
+	p := newTestPolicy(t, nil)
+	assert.Equal(t, policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeProcess,
+		RequestBodyMode:    policy.BodyModeBuffer,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeBuffer,
+	}, p.Mode())
+}
+
+func TestGetPolicy_InitRejectsParams(t *testing.T) {
+	f := &BridgeFactory{PolicyName: "wasm-test", PolicyVersion: "v1.0.0", Module: guestWasm}
+	_, err := f.GetPolicy(policy.PolicyMetadata{}, map[string]interface{}{"invalid": true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid is not a supported parameter")
+}
+
+func TestGetPolicy_InvalidModule(t *testing.T) {
+	f := &BridgeFactory{PolicyName: "broken", PolicyVersion: "v1.0.0", Module: []byte("not wasm")}
+	_, err := f.GetPolicy(policy.PolicyMetadata{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "load WebAssembly module for broken:v1.0.0")
+}
+
+func TestOnRequestHeaders_Modifications(t *testing.T) {
+	params := map[string]interface{}{"tag": "blue"}
+	p := newTestPolicy(t, params)
+	reqCtx := newRequestHeaderContext()
+
+	action := p.OnRequestHeaders(context.Background(), reqCtx, params)
+
+	mods, ok := action.(policy.UpstreamRequestHeaderModifications)
+	require.True(t, ok, "got %T", action)
+	assert.Equal(t, map[string]string{"x-wasm-tag": "blue", "x-request-id": "req-1"}, mods.HeadersToSet)
+	assert.Equal(t, []string{"x-internal"}, mods.HeadersToRemove)
+	require.NotNil(t, mods.Path)
+	assert.Equal(t, "/rewritten/pets", *mods.Path)
+	assert.Equal(t, true, reqCtx.Metadata["wasm.seen"])
+}
+
+func TestOnRequestHeaders_ImmediateResponse(t *testing.T) {
+	params := map[string]interface{}{"deny": true}
+	p := newTestPolicy(t, params)
+
+	action := p.OnRequestHeaders(context.Background(), newRequestHeaderContext(), params)
+
+	resp, ok := action.(policy.ImmediateResponse)
+	require.True(t, ok, "got %T", action)
+	assert.Equal(t, 403, resp.StatusCode)
+	assert.Equal(t, "denied by wasm", string(resp.Body))
+}
+
+func TestOnRequestHeaders_NoOutputIsPassthrough(t *testing.T) {
+	params := map[string]interface{}{"passthrough": true}
+	p := newTestPolicy(t, params)
+
+	action := p.OnRequestHeaders(context.Background(), newRequestHeaderContext(), params)
+	assert.Equal(t, policy.UpstreamRequestHeaderModifications{}, action)
+}
+
+func TestOnResponseBody(t *testing.T) {
+	p := newTestPolicy(t, nil)
+	respCtx := &policy.ResponseContext{
+		SharedContext:   &policy.SharedContext{RequestID: "req-1"},
+		RequestHeaders:  policy.NewHeaders(map[string][]string{}),
+		ResponseHeaders: policy.NewHeaders(map[string][]string{}),
+		ResponseBody:    &policy.Body{Content: []byte("hello"), Present: true, EndOfStream: true},
+		ResponseStatus:  200,
+	}
+
+	action := p.OnResponseBody(context.Background(), respCtx, nil)
+
+	mods, ok := action.(policy.DownstreamResponseModifications)
+	require.True(t, ok, "got %T", action)
+	assert.Equal(t, "HELLO", string(mods.Body))
+	require.NotNil(t, mods.StatusCode)
+	assert.Equal(t, 201, *mods.StatusCode)
+}
+
+func TestOnRequestBody_FailuresReturnInternalError(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]interface{}
+		limits config.WasmRuntimeConfig
+	}{
+		{name: "malformed output", params: map[string]interface{}{"garbage": true}, limits: testLimits},
+		{name: "call timeout", params: map[string]interface{}{"spin": true}, limits: config.WasmRuntimeConfig{CallTimeout: 100 * time.Millisecond, PoolSize: 2}},
+		{name: "memory limit", params: map[string]interface{}{"grow": true}, limits: testLimits},
+		{name: "fuel exhausted", params: map[string]interface{}{"recurse": float64(100000)}, limits: config.WasmRuntimeConfig{CallTimeout: 2 * time.Second, Fuel: 10000, PoolSize: 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Init(tt.limits)
+			defer Init(testLimits)
+			p := newTestPolicy(t, tt.params)
+
+			action := p.OnRequestBody(context.Background(), newRequestContext(), tt.params)
+
+			resp, ok := action.(policy.ImmediateResponse)
+			require.True(t, ok, "got %T", action)
+			assert.Equal(t, 500, resp.StatusCode)
+
+			// The failed instance is discarded; the module keeps serving calls.
+			action = p.OnRequestBody(context.Background(), newRequestContext(), map[string]interface{}{})
+			mods, ok := action.(policy.UpstreamRequestModifications)
+			require.True(t, ok, "got %T", action)
+			assert.Nil(t, mods.Body)
+		})
+	}
+}
+
+func TestFuelAllowsCallsWithinBudget(t *testing.T) {
+	Init(config.WasmRuntimeConfig{CallTimeout: 2 * time.Second, Fuel: 1_000_000, PoolSize: 2})
+	defer Init(testLimits)
+	params := map[string]interface{}{"recurse": float64(1000)}
+	p := newTestPolicy(t, params)
+
+	action := p.OnRequestBody(context.Background(), newRequestContext(), params)
+	_, ok := action.(policy.UpstreamRequestModifications)
+	assert.True(t, ok, "got %T", action)
+}
+
+func TestCall_FuelExhausted(t *testing.T) {
+	Init(config.WasmRuntimeConfig{CallTimeout: 2 * time.Second, Fuel: 10000, PoolSize: 2})
+	defer Init(testLimits)
+	m, err := compileModule("fuel-test", guestWasm)
+	require.NoError(t, err)
+
+	in := callInput{Phase: exportOnRequestBody, Params: map[string]interface{}{"recurse": float64(100000)}}
+	_, err = m.call(context.Background(), nil, exportOnRequestBody, in, &callOutput{})
+	assert.ErrorIs(t, err, errFuelExhausted)
+}
+
+func TestConcurrentCalls(t *testing.T) {
+	params := map[string]interface{}{"tag": "c"}
+	p := newTestPolicy(t, params)
+
+	errs := make(chan error, 16)
+	for i := 0; i < 16; i++ {
+		go func() {
+			action := p.OnRequestHeaders(context.Background(), newRequestHeaderContext(), params)
+			if _, ok := action.(policy.UpstreamRequestHeaderModifications); !ok {
+				errs <- fmt.Errorf("unexpected action %T", action)
+				return
+			}
+			errs <- nil
+		}()
+	}
+	for i := 0; i < 16; i++ {
+		assert.NoError(t, <-errs)
+	}
+}
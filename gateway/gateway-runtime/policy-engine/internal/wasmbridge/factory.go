@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wasmbridge
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	policy "github.com/wso2/api-platform/sdk/core/policy/v1alpha2"
+)
+
+// BridgeFactory creates policy instances backed by a WebAssembly module. The module is compiled
+// on the first GetPolicy call, which happens after Init(cfg) runs in main, and its instances are
+// shared by every policy instance the factory creates.
+type BridgeFactory struct {
+	PolicyName    string
+	PolicyVersion string
+
+	// Module is the WebAssembly binary, embedded into the policy engine by the gateway builder
+	Module []byte
+
+	once       sync.Once
+	module     *module
+	compileErr error
+}
+
+// GetPolicy creates a WebAssembly-backed policy instance.
+func (f *BridgeFactory) GetPolicy(metadata policy.PolicyMetadata, params map[string]interface{}) (policy.Policy, error) {
+	f.once.Do(func() {
+		f.module, f.compileErr = compileModule(f.PolicyName+":"+f.PolicyVersion, f.Module)
+		if f.compileErr == nil {
+			slog.Info("Compiled WebAssembly policy module",
+				"component", "wasmbridge",
+				"policy", f.PolicyName,
+				"version", f.PolicyVersion,
+				"size", len(f.Module))
+		}
+	})
+	if f.compileErr != nil {
+		return nil, fmt.Errorf("load WebAssembly module for %s:%s: %w", f.PolicyName, f.PolicyVersion, f.compileErr)
+	}
+
+	slogger := slog.With(
+		"component", "wasmbridge",
+		"policy", f.PolicyName,
+		"version", f.PolicyVersion,
+		"route", metadata.RouteName,
+	)
+
+	mode := modeFromExports(f.module)
+	if mode == (policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeSkip,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}) {
+		return nil, fmt.Errorf("WebAssembly module for %s:%s exports no on_* policy functions", f.PolicyName, f.PolicyVersion)
+	}
+
+	if f.module.exports(exportInit) {
+		in := initInput{
+			Params: params,
+			Metadata: initMetadata{
+				RouteName:  metadata.RouteName,
+				APIId:      metadata.APIId,
+				APIName:    metadata.APIName,
+				APIVersion: metadata.APIVersion,
+				AttachedTo: string(metadata.AttachedTo),
+			},
+		}
+		var out initOutput
+		if _, err := f.module.call(context.Background(), slogger, exportInit, in, &out); err != nil {
+			return nil, fmt.Errorf("policy_init failed for %s:%s: %w", f.PolicyName, f.PolicyVersion, err)
+		}
+		if out.Error != "" {
+			return nil, fmt.Errorf("policy_init rejected params for %s:%s: %s", f.PolicyName, f.PolicyVersion, out.Error)
+		}
+	}
+
+	slogger.Debug("WebAssembly policy instance created", "mode", mode)
+	return &bridge{
+		policyName:    f.PolicyName,
+		policyVersion: f.PolicyVersion,
+		mode:          mode,
+		module:        f.module,
+		slogger:       slogger,
+	}, nil
+}
+
+// modeFromExports derives the processing mode from the on_* functions the module exports.
+func modeFromExports(m *module) policy.ProcessingMode {
+	mode := policy.ProcessingMode{
+		RequestHeaderMode:  policy.HeaderModeSkip,
+		RequestBodyMode:    policy.BodyModeSkip,
+		ResponseHeaderMode: policy.HeaderModeSkip,
+		ResponseBodyMode:   policy.BodyModeSkip,
+	}
+	if m.exports(exportOnRequestHeaders) {
+		mode.RequestHeaderMode = policy.HeaderModeProcess
+	}
+	if m.exports(exportOnRequestBody) {
+		mode.RequestBodyMode = policy.BodyModeBuffer
+	}
+	if m.exports(exportOnResponseHeaders) {
+		mode.ResponseHeaderMode = policy.HeaderModeProcess
+	}
+	if m.exports(exportOnResponseBody) {
+		mode.ResponseBodyMode = policy.BodyModeBuffer
+	}
+	return mode
+}
@@ -0,0 +1,343 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+package wasmbridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/experimental"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/config"
+)
+
+// wasmPageSize is the size of a WebAssembly linear memory page.
+const wasmPageSize = 64 * 1024
+
+var (
+	limitsMu sync.RWMutex
+	limits   = defaultLimits()
+
+	runtimeOnce   sync.Once
+	sharedRuntime wazero.Runtime
+	runtimeErr    error
+)
+
+// runtimeLimits are the resource limits applied to every module instance and call.
+type runtimeLimits struct {
+	maxMemoryPages uint32
+	callTimeout    time.Duration
+	fuel           int64
+	poolSize       int
+}
+
+func defaultLimits() runtimeLimits {
+	return runtimeLimits{
+		maxMemoryPages: 64 * 1024 * 1024 / wasmPageSize,
+		callTimeout:    time.Second,
+		poolSize:       8,
+	}
+}
+
+// Init configures the WebAssembly runtime limits from the loaded configuration. Must be called
+// from main before the first WebAssembly policy instance is created; zero values keep the defaults.
+func Init(cfg config.WasmRuntimeConfig) {
+	l := defaultLimits()
+	if cfg.MaxMemoryMB > 0 {
+		l.maxMemoryPages = uint32(cfg.MaxMemoryMB * 1024 * 1024 / wasmPageSize)
+	}
+	if cfg.CallTimeout > 0 {
+		l.callTimeout = cfg.CallTimeout
+	}
+	if cfg.PoolSize > 0 {
+		l.poolSize = cfg.PoolSize
+	}
+	l.fuel = cfg.Fuel
+
+	limitsMu.Lock()
+	limits = l
+	limitsMu.Unlock()
+}
+
+func getLimits() runtimeLimits {
+	limitsMu.RLock()
+	defer limitsMu.RUnlock()
+	return limits
+}
+
+// getRuntime returns the runtime shared by all WebAssembly policies, creating it on first use with
+// the configured memory limit, WASI and the host module.
+func getRuntime() (wazero.Runtime, error) {
+	runtimeOnce.Do(func() {
+		ctx := context.Background()
+		rt := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+			WithMemoryLimitPages(getLimits().maxMemoryPages).
+			WithCloseOnContextDone(true))
+
+		if _, err := wasi_snapshot_preview1.Instantiate(ctx, rt); err != nil {
+			runtimeErr = fmt.Errorf("instantiate WASI: %w", err)
+			return
+		}
+		_, err := rt.NewHostModuleBuilder(hostModuleName).
+			NewFunctionBuilder().WithFunc(hostLog).Export("log").
+			Instantiate(ctx)
+		if err != nil {
+			runtimeErr = fmt.Errorf("instantiate host module %s: %w", hostModuleName, err)
+			return
+		}
+		sharedRuntime = rt
+	})
+	return sharedRuntime, runtimeErr
+}
+
+// callInfoKey carries the calling policy's logger and remaining fuel in the call context.
+type callInfoKey struct{}
+
+type callInfo struct {
+	slogger *slog.Logger
+	fuel    int64 // remaining guest function calls; only used when metering is enabled
+}
+
+func callInfoFrom(ctx context.Context) *callInfo {
+	info, _ := ctx.Value(callInfoKey{}).(*callInfo)
+	return info
+}
+
+// hostLog implements api_platform.log.
+func hostLog(ctx context.Context, m api.Module, level, ptr, size uint32) {
+	msg, ok := m.Memory().Read(ptr, size)
+	if !ok {
+		return
+	}
+	logger := slog.Default()
+	if info := callInfoFrom(ctx); info != nil && info.slogger != nil {
+		logger = info.slogger
+	}
+	var lvl slog.Level
+	switch level {
+	case 0:
+		lvl = slog.LevelDebug
+	case 1:
+		lvl = slog.LevelInfo
+	case 2:
+		lvl = slog.LevelWarn
+	default:
+		lvl = slog.LevelError
+	}
+	logger.Log(ctx, lvl, string(msg), "source", "wasm")
+}
+
+// errFuelExhausted is raised inside the guest when a call uses up its fuel.
+var errFuelExhausted = errors.New("fuel exhausted")
+
+// fuelMeter charges one unit of fuel per guest function call. Metering is attached when a module is
+// compiled and costs time on every guest call, so it is only enabled when fuel is configured.
+type fuelMeter struct{}
+
+func (fuelMeter) NewFunctionListener(api.FunctionDefinition) experimental.FunctionListener {
+	return fuelMeter{}
+}
+
+func (fuelMeter) Before(ctx context.Context, _ api.Module, _ api.FunctionDefinition, _ []uint64, _ experimental.StackIterator) {
+	info := callInfoFrom(ctx)
+	if info == nil {
+		return
+	}
+	info.fuel--
+	if info.fuel < 0 {
+		// wazero recovers the panic and fails the call with this error.
+		panic(errFuelExhausted)
+	}
+}
+
+func (fuelMeter) After(context.Context, api.Module, api.FunctionDefinition, []uint64) {}
+
+func (fuelMeter) Abort(context.Context, api.Module, api.FunctionDefinition, error) {}
+
+// module is a compiled policy module and a pool of idle instances of it.
+type module struct {
+	name     string
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	metered  bool
+
+	idle chan api.Module
+}
+
+func compileModule(name string, wasm []byte) (*module, error) {
+	rt, err := getRuntime()
+	if err != nil {
+		return nil, err
+	}
+	l := getLimits()
+	ctx := context.Background()
+	if l.fuel > 0 {
+		ctx = experimental.WithFunctionListenerFactory(ctx, fuelMeter{})
+	}
+	compiled, err := rt.CompileModule(ctx, wasm)
+	if err != nil {
+		return nil, fmt.Errorf("compile module: %w", err)
+	}
+
+	exports := compiled.ExportedFunctions()
+	if _, ok := exports[exportAlloc]; !ok {
+		return nil, fmt.Errorf("module does not export %s", exportAlloc)
+	}
+	if _, ok := compiled.ExportedMemories()[exportMemory]; !ok {
+		return nil, fmt.Errorf("module does not export its memory as %q", exportMemory)
+	}
+	return &module{
+		name:     name,
+		runtime:  rt,
+		compiled: compiled,
+		metered:  l.fuel > 0,
+		idle:     make(chan api.Module, l.poolSize),
+	}, nil
+}
+
+// exports reports whether the module exports function name.
+func (m *module) exports(name string) bool {
+	_, ok := m.compiled.ExportedFunctions()[name]
+	return ok
+}
+
+func (m *module) acquire(ctx context.Context) (api.Module, error) {
+	select {
+	case inst := <-m.idle:
+		return inst, nil
+	default:
+	}
+	cfg := wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions(exportInitialize).
+		WithSysWalltime().
+		WithSysNanotime()
+	// Start functions (language runtime initialisation) are not charged to the call's fuel.
+	inst, err := m.runtime.InstantiateModule(context.WithValue(ctx, callInfoKey{}, nil), m.compiled, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("instantiate module: %w", err)
+	}
+	return inst, nil
+}
+
+// release returns a healthy instance to the pool. Instances that failed a call may be in an
+// inconsistent state and are closed instead.
+func (m *module) release(inst api.Module, healthy bool) {
+	if healthy && !inst.IsClosed() {
+		select {
+		case m.idle <- inst:
+			return
+		default:
+		}
+	}
+	_ = inst.Close(context.Background())
+}
+
+// call invokes export fn with in encoded as JSON and decodes its output into out. It reports
+// whether the export returned an output; a module that returns 0 leaves out untouched.
+func (m *module) call(ctx context.Context, slogger *slog.Logger, fn string, in, out interface{}) (bool, error) {
+	payload, err := json.Marshal(in)
+	if err != nil {
+		return false, fmt.Errorf("encode %s input: %w", fn, err)
+	}
+
+	l := getLimits()
+	ctx, cancel := context.WithTimeout(ctx, l.callTimeout)
+	defer cancel()
+	info := &callInfo{slogger: slogger, fuel: l.fuel}
+	ctx = context.WithValue(ctx, callInfoKey{}, info)
+
+	inst, err := m.acquire(ctx)
+	if err != nil {
+		return false, err
+	}
+	output, err := invoke(ctx, inst, fn, payload)
+	m.release(inst, err == nil)
+	if err != nil {
+		if errors.Is(err, errFuelExhausted) {
+			return false, fmt.Errorf("%s: %w", fn, errFuelExhausted)
+		}
+		if ctx.Err() != nil {
+			return false, fmt.Errorf("%s: no result within %s: %w", fn, l.callTimeout, ctx.Err())
+		}
+		return false, fmt.Errorf("%s: %w", fn, err)
+	}
+	if output == nil {
+		return false, nil
+	}
+	if err := json.Unmarshal(output, out); err != nil {
+		return false, fmt.Errorf("decode %s output: %w", fn, err)
+	}
+	return true, nil
+}
+
+// invoke copies payload into the instance, calls fn and copies its output out.
+func invoke(ctx context.Context, inst api.Module, fn string, payload []byte) ([]byte, error) {
+	alloc := inst.ExportedFunction(exportAlloc)
+	free := inst.ExportedFunction(exportFree)
+	target := inst.ExportedFunction(fn)
+	if target == nil {
+		return nil, fmt.Errorf("module does not export %s", fn)
+	}
+
+	res, err := alloc.Call(ctx, uint64(len(payload)))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", exportAlloc, err)
+	}
+	inPtr := uint32(res[0])
+	if !inst.Memory().Write(inPtr, payload) {
+		return nil, fmt.Errorf("%s returned an out-of-range buffer", exportAlloc)
+	}
+
+	res, err = target.Call(ctx, uint64(inPtr), uint64(len(payload)))
+	if err != nil {
+		return nil, err
+	}
+	if free != nil {
+		if _, err := free.Call(ctx, uint64(inPtr), uint64(len(payload))); err != nil {
+			return nil, fmt.Errorf("%s: %w", exportFree, err)
+		}
+	}
+
+	packed := res[0]
+	if packed == 0 {
+		return nil, nil
+	}
+	outPtr, outSize := uint32(packed>>32), uint32(packed)
+	view, ok := inst.Memory().Read(outPtr, outSize)
+	if !ok {
+		return nil, fmt.Errorf("output buffer (%d, %d) is out of range", outPtr, outSize)
+	}
+	output := make([]byte, len(view))
+	copy(output, view)
+	if free != nil {
+		if _, err := free.Call(ctx, uint64(outPtr), uint64(outSize)); err != nil {
+			return nil, fmt.Errorf("%s: %w", exportFree, err)
+		}
+	}
+	return output, nil
+}
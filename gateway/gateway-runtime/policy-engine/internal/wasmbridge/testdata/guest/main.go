@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+ *
+ * WSO2 LLC. licenses this file to you under the Apache License,
+ * Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing,
+ * software distributed under the License is distributed on an
+ * "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+ * KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations
+ * under the License.
+ */
+
+// Command guest is a WebAssembly policy used by the wasmbridge tests. Build it as a WASI reactor:
+//
+//	GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o guest.wasm ./internal/wasmbridge/testdata/guest
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"unsafe"
+)
+
+// buffers keeps host-visible buffers reachable until policy_free.
+var buffers = map[uint32][]byte{}
+
+//go:wasmimport api_platform log
+func hostLog(level uint32, ptr unsafe.Pointer, size uint32)
+
+func logInfo(msg string) {
+	b := []byte(msg)
+	hostLog(1, unsafe.Pointer(unsafe.SliceData(b)), uint32(len(b)))
+}
+
+//go:wasmexport policy_alloc
+func policyAlloc(size uint32) uint32 {
+	b := make([]byte, size)
+	ptr := uint32(uintptr(unsafe.Pointer(unsafe.SliceData(b))))
+	buffers[ptr] = b
+	return ptr
+}
+
+//go:wasmexport policy_free
+func policyFree(ptr, _ uint32) {
+	delete(buffers, ptr)
+}
+
+func input(ptr, size uint32) map[string]interface{} {
+	var in map[string]interface{}
+	if err := json.Unmarshal(buffers[ptr][:size], &in); err != nil {
+		panic(err)
+	}
+	return in
+}
+
+func output(v interface{}) uint64 {
+	b, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	ptr := uint32(uintptr(unsafe.Pointer(unsafe.SliceData(b))))
+	buffers[ptr] = b
+	return uint64(ptr)<<32 | uint64(len(b))
+}
+
+func params(in map[string]interface{}) map[string]interface{} {
+	p, _ := in["params"].(map[string]interface{})
+	return p
+}
+
+//go:wasmexport policy_init
+func policyInit(ptr, size uint32) uint64 {
+	if _, bad := params(input(ptr, size))["invalid"]; bad {
+		return output(map[string]string{"error": "invalid is not a supported parameter"})
+	}
+	return 0
+}
+
+//go:wasmexport on_request_headers
+func onRequestHeaders(ptr, size uint32) uint64 {
+	in := input(ptr, size)
+	p := params(in)
+	if deny, _ := p["deny"].(bool); deny {
+		return output(map[string]interface{}{
+			"immediateResponse": map[string]interface{}{
+				"statusCode": 403,
+				"headers":    map[string]string{"content-type": "text/plain"},
+				"body":       []byte("denied by wasm"),
+			},
+		})
+	}
+	if _, ok := p["passthrough"]; ok {
+		return 0
+	}
+	req, _ := in["request"].(map[string]interface{})
+	shared, _ := in["shared"].(map[string]interface{})
+	logInfo("request headers for " + req["path"].(string))
+	tag, _ := p["tag"].(string)
+	return output(map[string]interface{}{
+		"headersToSet":    map[string]string{"x-wasm-tag": tag, "x-request-id": shared["requestId"].(string)},
+		"headersToRemove": []string{"x-internal"},
+		"path":            "/rewritten" + req["path"].(string),
+		"metadata":        map[string]interface{}{"wasm.seen": true},
+	})
+}
+
+//go:wasmexport on_request_body
+func onRequestBody(ptr, size uint32) uint64 {
+	p := params(input(ptr, size))
+	switch {
+	case p["spin"] != nil:
+		for {
+		}
+	case p["recurse"] != nil:
+		return uint64(recurse(int(p["recurse"].(float64))))
+	case p["grow"] != nil:
+		var hold [][]byte
+		for {
+			hold = append(hold, make([]byte, 1<<20))
+		}
+	case p["garbage"] != nil:
+		b := []byte("{not json")
+		ptr := uint32(uintptr(unsafe.Pointer(unsafe.SliceData(b))))
+		buffers[ptr] = b
+		return uint64(ptr)<<32 | uint64(len(b))
+	}
+	return 0
+}
+
+//go:noinline
+func recurse(n int) int {
+	if n == 0 {
+		return 0
+	}
+	return recurse(n-1) + 0
+}
+
+//go:wasmexport on_response_body
+func onResponseBody(ptr, size uint32) uint64 {
+	in := input(ptr, size)
+	resp, _ := in["response"].(map[string]interface{})
+	body, _ := resp["body"].(string) // base64
+	var raw []byte
+	_ = json.Unmarshal([]byte(`"`+body+`"`), &raw)
+	return output(map[string]interface{}{
+		"body":       []byte(strings.ToUpper(string(raw))),
+		"statusCode": 201,
+	})
+}
+
+func main() {}
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/wso2/api-platform/common/apikey"
+	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/executor"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/kernel"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/metrics"
 	"github.com/wso2/api-platform/gateway/gateway-runtime/policy-engine/internal/registry"
@@ -397,6 +398,11 @@ func (h *ResourceHandler) buildPolicyChain(routeKey string, config *policyengine
 			return nil, fmt.Errorf("failed to create policy instance %s:%s: %w", policyConfig.Name, policyConfig.Version, err)
 		}
 
+		timeout, onError, err := executor.ValidateFaultSettings(policyConfig.Timeout, policyConfig.OnError)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s:%s: %w", policyConfig.Name, policyConfig.Version, err)
+		}
+
 		spec := policy.PolicySpec{
 			Name:               policyConfig.Name,
 			Version:            policyConfig.Version,
@@ -405,6 +411,8 @@ func (h *ResourceHandler) buildPolicyChain(routeKey string, config *policyengine
 			Parameters: policy.PolicyParameters{
 				Raw: mergedParams,
 			},
+			Timeout: timeout,
+			OnError: onError,
 		}
 
 		if policyConfig.ExecutionCondition != nil && *policyConfig.ExecutionCondition != "" {
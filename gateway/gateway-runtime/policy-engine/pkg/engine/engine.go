@@ -27,6 +27,7 @@ import (
 	"log/slog"
 	"os"
 	"sync"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -296,6 +297,11 @@ func (e *Engine) buildPolicyChain(routeKey string, config *policyengine.PolicyCh
 			return nil, fmt.Errorf("failed to create policy instance %s:%s: %w", pc.Name, pc.Version, err)
 		}
 
+		timeout, onError, err := executor.ValidateFaultSettings(pc.Timeout, pc.OnError)
+		if err != nil {
+			return nil, fmt.Errorf("policy %s:%s: %w", pc.Name, pc.Version, err)
+		}
+
 		spec := policy.PolicySpec{
 			Name:               pc.Name,
 			Version:            pc.Version,
@@ -304,6 +310,8 @@ func (e *Engine) buildPolicyChain(routeKey string, config *policyengine.PolicyCh
 			Parameters: policy.PolicyParameters{
 				Raw: mergedParams,
 			},
+			Timeout: timeout,
+			OnError: onError,
 		}
 
 		if pc.ExecutionCondition != nil && *pc.ExecutionCondition != "" {
@@ -367,6 +375,10 @@ func (e *Engine) BuildChain(routeKey string, specs []PolicySpec) (*registry.Poli
 			Enabled:            s.Enabled,
 			ExecutionCondition: s.ExecutionCondition,
 			Parameters:         s.Parameters,
+			OnError:            s.OnError,
+		}
+		if s.Timeout > 0 {
+			instances[i].Timeout = s.Timeout.String()
 		}
 	}
 	config := &policyengine.PolicyChain{
@@ -383,4 +395,8 @@ type PolicySpec struct {
 	Enabled            bool
 	ExecutionCondition *string
 	Parameters         map[string]interface{}
+	// Timeout is an optional time budget for one invocation of the policy.
+	Timeout time.Duration
+	// OnError is "fail-closed" (default), "fail-open" or "skip".
+	OnError string
 }
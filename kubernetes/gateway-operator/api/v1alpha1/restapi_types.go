@@ -125,6 +125,25 @@ type Operation struct {
 	// Policies List of policies applied only to this operation (overrides or adds to API-level policies)
 	// +optional
 	Policies []Policy `json:"policies,omitempty"`
+
+	// Mirror copies requests to a secondary upstream without affecting clients
+	// +optional
+	Mirror *TrafficMirror `json:"mirror,omitempty"`
+}
+
+// TrafficMirror copies requests to a secondary upstream. Mirrored requests are sent
+// fire-and-forget and their responses are discarded.
+type TrafficMirror struct {
+	// Url Backend URL of the mirror upstream (scheme, host and port only)
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://[a-zA-Z0-9\-._~:/?#\[\]@!$&'()*+,;=%]+$`
+	Url string `json:"url"`
+
+	// Percentage of matching requests to mirror (default 100)
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
 }
 
 // OperationMethod HTTP method
@@ -175,6 +194,19 @@ type Upstream struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern=`^https?://[a-zA-Z0-9\-._~:/?#\[\]@!$&'()*+,;=%]+$`
 	Url string `json:"url"`
+
+	// HostRewrite controls how the Host header is handled when routing to the upstream.
+	// `auto` rewrites it to the upstream host; `manual` keeps the client Host unless a
+	// host-rewrite policy sets it.
+	// +optional
+	// +kubebuilder:validation:Enum=auto;manual
+	HostRewrite *string `json:"hostRewrite,omitempty"`
+
+	// Protocol HTTP protocol spoken to the upstream. `http2` is required for gRPC backends;
+	// over an http URL it is sent as cleartext HTTP/2 (h2c).
+	// +optional
+	// +kubebuilder:validation:Enum=http1;http2
+	Protocol *string `json:"protocol,omitempty"`
 }
 
 // Condition Types for RestApi
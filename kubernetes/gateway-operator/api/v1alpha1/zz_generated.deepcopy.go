@@ -1587,6 +1587,11 @@ func (in *Operation) DeepCopyInto(out *Operation) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(TrafficMirror)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Operation.
@@ -2004,9 +2009,39 @@ func (in *SubscriptionSpec) DeepCopy() *SubscriptionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirror) DeepCopyInto(out *TrafficMirror) {
+	*out = *in
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirror.
+func (in *TrafficMirror) DeepCopy() *TrafficMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Upstream) DeepCopyInto(out *Upstream) {
 	*out = *in
+	if in.HostRewrite != nil {
+		in, out := &in.HostRewrite, &out.HostRewrite
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Upstream.
@@ -2022,11 +2057,11 @@ func (in *Upstream) DeepCopy() *Upstream {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpstreamConfig) DeepCopyInto(out *UpstreamConfig) {
 	*out = *in
-	out.Main = in.Main
+	in.Main.DeepCopyInto(&out.Main)
 	if in.Sandbox != nil {
 		in, out := &in.Sandbox, &out.Sandbox
 		*out = new(Upstream)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
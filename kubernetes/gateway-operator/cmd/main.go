@@ -42,6 +42,7 @@ import (
 	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
 	"github.com/wso2/api-platform/kubernetes/gateway-operator/internal/config"
 	"github.com/wso2/api-platform/kubernetes/gateway-operator/internal/controller"
+	webhookv1alpha1 "github.com/wso2/api-platform/kubernetes/gateway-operator/internal/webhook/v1alpha1"
 	"github.com/wso2/api-platform/kubernetes/gateway-operator/pkg/logger"
 	//+kubebuilder:scaffold:imports
 )
@@ -67,6 +68,7 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var configPath string
+	var webhookCertPath string
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -78,6 +80,9 @@ func main() {
 		"If set the metrics endpoint is served securely")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&webhookCertPath, "webhook-cert-path", "",
+		"Directory containing the webhook server certificate (tls.crt and tls.key). "+
+			"Defaults to the controller-runtime serving-certs directory.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -127,6 +132,7 @@ func main() {
 
 	webhookServer := webhook.NewServer(webhook.Options{
 		TLSOpts: tlsOpts,
+		CertDir: webhookCertPath,
 	})
 
 	// Parse WATCH_NAMESPACES env var
@@ -219,6 +225,15 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "HTTPRoute")
 		os.Exit(1)
 	}
+	if err = controller.NewGRPCRouteReconciler(
+		mgr.GetClient(),
+		mgr.GetScheme(),
+		cfg,
+		zapLog,
+	).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GRPCRoute")
+		os.Exit(1)
+	}
 
 	// Management-API resource controllers (WSO2 APIGateway flow only).
 	// All share one in-memory ResourceTracker keyed by kind+namespace/name.
@@ -243,6 +258,17 @@ func main() {
 			os.Exit(1)
 		}
 	}
+
+	// Admission webhooks need a serving certificate, so they are opt-in; the Helm
+	// chart sets ENABLE_WEBHOOKS=true together with the certificate and the
+	// webhook configurations.
+	if os.Getenv("ENABLE_WEBHOOKS") == "true" {
+		if err := webhookv1alpha1.SetupWebhooksWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhooks")
+			os.Exit(1)
+		}
+		setupLog.Info("admission webhooks enabled")
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
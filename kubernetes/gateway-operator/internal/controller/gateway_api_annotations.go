@@ -36,19 +36,21 @@ const (
 	// ClusterIP, NodePort, LoadBalancer, ExternalName.
 	AnnK8sGatewayServiceType = "gateway.api-platform.wso2.com/service-type"
 
-	// AnnHTTPRouteAPIVersion overrides API version in generated api.yaml (default v1).
+	// AnnHTTPRouteAPIVersion overrides API version in generated api.yaml (default v1). Also read on GRPCRoute.
 	AnnHTTPRouteAPIVersion = "gateway.api-platform.wso2.com/api-version"
 
 	// AnnHTTPRouteContext sets APIConfigData.Context (must match gateway validation pattern).
+	// GRPCRoute rejects any value other than "/".
 	AnnHTTPRouteContext = "gateway.api-platform.wso2.com/context"
 
-	// AnnHTTPRouteDisplayName overrides displayName.
+	// AnnHTTPRouteDisplayName overrides displayName (HTTPRoute and GRPCRoute).
 	AnnHTTPRouteDisplayName = "gateway.api-platform.wso2.com/display-name"
 
 	// AnnProjectID identifies the owning project for analytics/routing metadata propagation.
 	AnnProjectID = "gateway.api-platform.wso2.com/project-id"
 
-	// HTTPRoute rest API handle for gateway-controller (default: derived from ns+name).
+	// HTTPRoute / GRPCRoute rest API handle for gateway-controller (default: derived from ns+name;
+	// GRPCRoute appends "-grpc").
 	AnnHTTPRouteAPIHandle = "gateway.api-platform.wso2.com/api-handle"
 
 	// AnnHTTPRouteLastDeployedParentGateway records the Gateway used for the last successful DeployRestAPI
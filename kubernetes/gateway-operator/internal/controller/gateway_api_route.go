@@ -0,0 +1,515 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package controller
+
+// Helpers shared by the HTTPRoute and GRPCRoute reconcilers. Both kinds are mapped onto a single
+// gateway-controller REST API per route and report status through gatewayv1.RouteParentStatus.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+	"github.com/wso2/api-platform/kubernetes/gateway-operator/internal/config"
+	"github.com/wso2/api-platform/kubernetes/gateway-operator/internal/gatewayclient"
+	"github.com/wso2/api-platform/kubernetes/gateway-operator/internal/registry"
+)
+
+type gatewayParentTarget struct {
+	key client.ObjectKey
+	ref gatewayv1.ParentReference
+}
+
+// gatewayParentTargets returns the Gateway parentRefs of a route in routeNamespace.
+func gatewayParentTargets(routeNamespace string, refs []gatewayv1.ParentReference) []gatewayParentTarget {
+	out := make([]gatewayParentTarget, 0, len(refs))
+	for _, p := range refs {
+		if p.Name == "" {
+			continue
+		}
+		if p.Kind != nil && string(*p.Kind) != "Gateway" {
+			continue
+		}
+		if p.Group != nil && string(*p.Group) != gatewayv1.GroupName {
+			continue
+		}
+		ns := routeNamespace
+		if p.Namespace != nil {
+			ns = string(*p.Namespace)
+		}
+		out = append(out, gatewayParentTarget{
+			key: client.ObjectKey{Namespace: ns, Name: string(p.Name)},
+			ref: p,
+		})
+	}
+	return out
+}
+
+// routeStatusParents returns the per-parent status slice of a supported route kind.
+func routeStatusParents(obj client.Object) (*[]gatewayv1.RouteParentStatus, error) {
+	switch rt := obj.(type) {
+	case *gatewayv1.HTTPRoute:
+		return &rt.Status.Parents, nil
+	case *gatewayv1.GRPCRoute:
+		return &rt.Status.Parents, nil
+	default:
+		return nil, fmt.Errorf("unsupported route type %T", obj)
+	}
+}
+
+// patchRouteParentCondition sets conds on the route status entry owned by this controller for parentRef.
+func patchRouteParentCondition(ctx context.Context, c client.Client, route client.Object, parentRef gatewayv1.ParentReference, conds ...metav1.Condition) error {
+	latest, ok := route.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unsupported route type %T", route)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(route), latest); err != nil {
+		return err
+	}
+	base, _ := latest.DeepCopyObject().(client.Object)
+	parents, err := routeStatusParents(latest)
+	if err != nil {
+		return err
+	}
+
+	idx := -1
+	for i := range *parents {
+		if parentRefMatches((*parents)[i].ParentRef, parentRef, latest.GetNamespace()) &&
+			(*parents)[i].ControllerName == PlatformGatewayControllerName {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		*parents = append(*parents, gatewayv1.RouteParentStatus{
+			ParentRef:      parentRef,
+			ControllerName: PlatformGatewayControllerName,
+		})
+		idx = len(*parents) - 1
+	}
+	for _, cond := range conds {
+		meta.SetStatusCondition(&(*parents)[idx].Conditions, cond)
+	}
+	return c.Status().Patch(ctx, latest, client.MergeFrom(base))
+}
+
+// routeConfigErrorCondition maps an API config build error to the route condition that explains it
+// and the requeue delay (zero when retrying cannot help).
+//
+// Unsupported features (UnsupportedValue, IncompatibleFilters) mean the route as a whole is not
+// accepted; unresolvable references (InvalidKind, missing Services or APIPolicies) are reported on
+// ResolvedRefs, as Gateway API prescribes.
+func routeConfigErrorCondition(err error, generation int64) (metav1.Condition, time.Duration) {
+	cond := metav1.Condition{
+		Type:               string(gatewayv1.RouteConditionResolvedRefs),
+		Status:             metav1.ConditionFalse,
+		Reason:             "Invalid",
+		Message:            err.Error(),
+		ObservedGeneration: generation,
+	}
+	var cfgErr *HTTPRouteConfigError
+	if errors.As(err, &cfgErr) && cfgErr.Reason != "" {
+		cond.Reason = string(cfgErr.Reason)
+		switch cfgErr.Reason {
+		case gatewayv1.RouteReasonUnsupportedValue, gatewayv1.RouteReasonIncompatibleFilters:
+			cond.Type = string(gatewayv1.RouteConditionAccepted)
+		}
+	}
+	if IsInvalidHTTPRouteConfigError(err) {
+		return cond, 0
+	}
+	cond.Reason = "Retrying"
+	return cond, 30 * time.Second
+}
+
+func handleRouteRESTError(ctx context.Context, c client.Client, route client.Object, parentRef gatewayv1.ParentReference, log *zap.Logger, err error) (ctrl.Result, error) {
+	log.Error("gateway REST", zap.Error(err))
+	var msg string
+	switch e := err.(type) {
+	case *gatewayclient.NonRetryableError:
+		msg = e.Error()
+		_ = patchRouteParentCondition(ctx, c, route, parentRef, metav1.Condition{
+			Type:    string(gatewayv1.RouteConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  "DeploymentFailed",
+			Message: msg,
+		})
+		return ctrl.Result{}, nil
+	default:
+		msg = err.Error()
+	}
+	_ = patchRouteParentCondition(ctx, c, route, parentRef, metav1.Condition{
+		Type:    string(gatewayv1.RouteConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  "Retrying",
+		Message: msg,
+	})
+	return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+}
+
+// reconcileRouteDeletion deletes the route's REST API from parentKey's gateway and then drops finalizer.
+func reconcileRouteDeletion(ctx context.Context, c client.Client, route client.Object, finalizer, handle string, parentKey client.ObjectKey, log *zap.Logger) (ctrl.Result, error) {
+	if !controllerutil.ContainsFinalizer(route, finalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if parentKey.Name == "" {
+		log.Info("no Gateway parent for route deletion cleanup; removing finalizer",
+			zap.String("reason", "missing last-deployed parent annotation and spec Gateway parentRefs"))
+		return removeRouteFinalizer(ctx, c, route, finalizer)
+	}
+
+	gwInfo, ok := registry.GetGatewayRegistry().Get(parentKey.Namespace, parentKey.Name)
+	if !ok {
+		parentGW := &gatewayv1.Gateway{}
+		if err := c.Get(ctx, parentKey, parentGW); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("parent Gateway no longer exists during route delete; removing finalizer without REST cleanup",
+					zap.String("parentNamespace", parentKey.Namespace),
+					zap.String("parentName", parentKey.Name))
+				return removeRouteFinalizer(ctx, c, route, finalizer)
+			}
+			return ctrl.Result{}, err
+		}
+		log.Info("parent Gateway exists but not registered during delete; retrying before finalizer removal",
+			zap.String("parentNamespace", parentKey.Namespace),
+			zap.String("parentName", parentKey.Name))
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	auth := httprouteAuthFunc(c, log, gwInfo)
+	if err := gatewayclient.DeleteRestAPI(ctx, gwInfo.GetGatewayServiceEndpoint(), handle, auth); err != nil {
+		log.Error("delete REST API from gateway", zap.Error(err))
+		return ctrl.Result{}, err
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(route), route); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	return removeRouteFinalizer(ctx, c, route, finalizer)
+}
+
+func removeRouteFinalizer(ctx context.Context, c client.Client, route client.Object, finalizer string) (ctrl.Result, error) {
+	controllerutil.RemoveFinalizer(route, finalizer)
+	if err := c.Update(ctx, route); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+func lastDeployedParentGatewayKeyFromAnnotation(route metav1.Object) (client.ObjectKey, bool) {
+	raw := strings.TrimSpace(route.GetAnnotations()[AnnHTTPRouteLastDeployedParentGateway])
+	if raw == "" {
+		return client.ObjectKey{}, false
+	}
+	ns, name, ok := strings.Cut(raw, "/")
+	if !ok || ns == "" || name == "" {
+		return client.ObjectKey{}, false
+	}
+	return client.ObjectKey{Namespace: ns, Name: name}, true
+}
+
+func persistLastDeployedParentGateway(ctx context.Context, c client.Client, route client.Object, parentKey client.ObjectKey) error {
+	val := parentKey.Namespace + "/" + parentKey.Name
+	latest, ok := route.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unsupported route type %T", route)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(route), latest); err != nil {
+		return err
+	}
+	if latest.GetAnnotations()[AnnHTTPRouteLastDeployedParentGateway] == val {
+		return nil
+	}
+	base, _ := latest.DeepCopyObject().(client.Object)
+	annotations := latest.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[AnnHTTPRouteLastDeployedParentGateway] = val
+	latest.SetAnnotations(annotations)
+	return c.Patch(ctx, latest, client.MergeFrom(base))
+}
+
+// cleanupPreviousRouteDeployment deletes the route's REST API from the Gateway it was last deployed
+// to when that differs from currentParent (nil: the route no longer has a managed parent).
+func cleanupPreviousRouteDeployment(ctx context.Context, c client.Client, route client.Object, handle string, currentParent *client.ObjectKey, log *zap.Logger) error {
+	lastParent, ok := lastDeployedParentGatewayKeyFromAnnotation(route)
+	if !ok {
+		return nil
+	}
+	if currentParent != nil && lastParent == *currentParent {
+		return nil
+	}
+	gwInfo, regOK := registry.GetGatewayRegistry().Get(lastParent.Namespace, lastParent.Name)
+	if !regOK {
+		parentGW := &gatewayv1.Gateway{}
+		if err := c.Get(ctx, lastParent, parentGW); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("previous parent Gateway no longer exists; clearing stale last-deployed annotation",
+					zap.String("previousParentNamespace", lastParent.Namespace),
+					zap.String("previousParentName", lastParent.Name))
+				return clearLastDeployedParentGateway(ctx, c, route)
+			}
+			return err
+		}
+		log.Info("previous parent Gateway exists but not registered yet; retrying stale route cleanup",
+			zap.String("previousParentNamespace", lastParent.Namespace),
+			zap.String("previousParentName", lastParent.Name))
+		return fmt.Errorf("previous parent Gateway %s/%s not registered", lastParent.Namespace, lastParent.Name)
+	}
+
+	auth := httprouteAuthFunc(c, log, gwInfo)
+	if err := gatewayclient.DeleteRestAPI(ctx, gwInfo.GetGatewayServiceEndpoint(), handle, auth); err != nil {
+		log.Error("failed to clean up stale route deployment on previous parent Gateway",
+			zap.Error(err),
+			zap.String("previousParentNamespace", lastParent.Namespace),
+			zap.String("previousParentName", lastParent.Name),
+			zap.String("handle", handle))
+		return err
+	}
+	log.Info("cleaned up stale route deployment on previous parent Gateway",
+		zap.String("previousParentNamespace", lastParent.Namespace),
+		zap.String("previousParentName", lastParent.Name),
+		zap.String("handle", handle))
+
+	if currentParent == nil {
+		if err := clearLastDeployedParentGateway(ctx, c, route); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func clearLastDeployedParentGateway(ctx context.Context, c client.Client, route client.Object) error {
+	latest, ok := route.DeepCopyObject().(client.Object)
+	if !ok {
+		return fmt.Errorf("unsupported route type %T", route)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(route), latest); err != nil {
+		return err
+	}
+	annotations := latest.GetAnnotations()
+	if _, ok := annotations[AnnHTTPRouteLastDeployedParentGateway]; !ok {
+		return nil
+	}
+	base, _ := latest.DeepCopyObject().(client.Object)
+	delete(annotations, AnnHTTPRouteLastDeployedParentGateway)
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	latest.SetAnnotations(annotations)
+	return c.Patch(ctx, latest, client.MergeFrom(base))
+}
+
+// routeDeletionParentGatewayKey prefers the last-deployed annotation over the live spec so cleanup
+// targets the Gateway that actually holds the API even if parentRefs were edited.
+func routeDeletionParentGatewayKey(route metav1.Object, parentRefs []gatewayv1.ParentReference) client.ObjectKey {
+	if key, ok := lastDeployedParentGatewayKeyFromAnnotation(route); ok {
+		return key
+	}
+	if targets := gatewayParentTargets(route.GetNamespace(), parentRefs); len(targets) > 0 {
+		return targets[0].key
+	}
+	return client.ObjectKey{}
+}
+
+func payloadMetadataForRoute(route metav1.Object, handle string) gatewayclient.RestAPIPayloadMetadata {
+	md := gatewayclient.RestAPIPayloadMetadata{Name: handle}
+	if route == nil {
+		return md
+	}
+	if src := route.GetAnnotations(); len(src) > 0 {
+		md.Annotations = make(map[string]string, len(src))
+		for k, v := range src {
+			md.Annotations[k] = v
+		}
+	}
+	return md
+}
+
+// gatewayRouteSpec describes one Gateway API route for reconcileGatewayRoute.
+type gatewayRouteSpec struct {
+	kind       string
+	finalizer  string
+	handle     string
+	parentRefs []gatewayv1.ParentReference
+	build      func(ctx context.Context, log *zap.Logger) (*apiv1.APIConfigData, error)
+}
+
+// reconcileGatewayRoute deploys route to its single parent platform gateway as a REST API, cleans up
+// deployments on previous parents and reports the outcome on the route's parent status.
+func reconcileGatewayRoute(ctx context.Context, c client.Client, cfg *config.OperatorConfig, route client.Object, s gatewayRouteSpec, log *zap.Logger) (ctrl.Result, error) {
+	handle := s.handle
+	generation := route.GetGeneration()
+	if !route.GetDeletionTimestamp().IsZero() {
+		return reconcileRouteDeletion(ctx, c, route, s.finalizer, handle, routeDeletionParentGatewayKey(route, s.parentRefs), log)
+	}
+
+	parentTargets := gatewayParentTargets(route.GetNamespace(), s.parentRefs)
+	if len(parentTargets) == 0 {
+		if err := cleanupPreviousRouteDeployment(ctx, c, route, handle, nil, log); err != nil {
+			return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+		log.Debug("skip route: no Gateway parentRefs", zap.String("kind", s.kind))
+		return ctrl.Result{}, nil
+	}
+	if len(parentTargets) > 1 {
+		msg := s.kind + " with multiple Gateway parentRefs is not supported; use a single parent Gateway"
+		log.Info("invalid route parentRefs", zap.Int("gatewayParents", len(parentTargets)), zap.String("reason", msg))
+		for _, target := range parentTargets {
+			_ = patchRouteParentCondition(ctx, c, route, target.ref, metav1.Condition{
+				Type:    string(gatewayv1.RouteConditionResolvedRefs),
+				Status:  metav1.ConditionFalse,
+				Reason:  "Invalid",
+				Message: msg,
+			})
+		}
+		if err := cleanupPreviousRouteDeployment(ctx, c, route, handle, nil, log); err != nil {
+			return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+		return ctrl.Result{}, nil
+	}
+	parentKey := parentTargets[0].key
+	parentRef := parentTargets[0].ref
+
+	parentGW := &gatewayv1.Gateway{}
+	if err := c.Get(ctx, parentKey, parentGW); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("parent Gateway not found; requeue",
+				zap.String("parentNamespace", parentKey.Namespace),
+				zap.String("parentName", parentKey.Name))
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !cfg.ManagedGatewayClass(string(parentGW.Spec.GatewayClassName)) {
+		if err := cleanupPreviousRouteDeployment(ctx, c, route, handle, nil, log); err != nil {
+			return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+		log.Debug("skip route: Gateway uses unmanaged class",
+			zap.String("kind", s.kind),
+			zap.String("parentNamespace", parentKey.Namespace),
+			zap.String("parentName", parentKey.Name),
+			zap.String("gatewayClass", string(parentGW.Spec.GatewayClassName)))
+		return ctrl.Result{}, nil
+	}
+
+	if !controllerutil.ContainsFinalizer(route, s.finalizer) {
+		controllerutil.AddFinalizer(route, s.finalizer)
+		if err := c.Update(ctx, route); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("added route finalizer; requeue", zap.String("kind", s.kind))
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	gwInfo, regOK := registry.GetGatewayRegistry().Get(parentKey.Namespace, parentKey.Name)
+	if !regOK {
+		log.Info("parent Gateway not registered yet; waiting")
+		_ = patchRouteParentCondition(ctx, c, route, parentRef, metav1.Condition{
+			Type:    string(gatewayv1.RouteConditionAccepted),
+			Status:  metav1.ConditionFalse,
+			Reason:  "GatewayPending",
+			Message: "Platform gateway controller endpoint not registered",
+		})
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	spec, err := s.build(ctx, log)
+	if err != nil {
+		log.Error("build API config", zap.Error(err))
+		cond, requeueAfter := routeConfigErrorCondition(err, generation)
+		_ = patchRouteParentCondition(ctx, c, route, parentRef, cond)
+		if requeueAfter > 0 {
+			return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		}
+		return ctrl.Result{}, nil
+	}
+
+	apiYAML, err := gatewayclient.BuildRestAPIYAML(
+		apiv1.GroupVersion.String(),
+		"RestApi",
+		payloadMetadataForRoute(route, handle),
+		*spec,
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	auth := httprouteAuthFunc(c, log, gwInfo)
+	ep := gwInfo.GetGatewayServiceEndpoint()
+	exists, err := gatewayclient.RestAPIExists(ctx, ep, handle, auth)
+	if err != nil {
+		return handleRouteRESTError(ctx, c, route, parentRef, log, err)
+	}
+
+	if err := gatewayclient.DeployRestAPI(ctx, ep, handle, apiYAML, exists, auth); err != nil {
+		return handleRouteRESTError(ctx, c, route, parentRef, log, err)
+	}
+	log.Info("route deployed to gateway",
+		zap.String("kind", s.kind),
+		zap.String("parentGateway", parentKey.Name),
+		zap.String("handle", handle),
+		zap.String("gatewayEndpoint", ep),
+		zap.Bool("updated", exists),
+		zap.Int("operations", len(spec.Operations)),
+		zap.Int("apiLevelPolicies", len(spec.Policies)))
+
+	if err := cleanupPreviousRouteDeployment(ctx, c, route, handle, &parentKey, log); err != nil {
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+	if err := persistLastDeployedParentGateway(ctx, c, route, parentKey); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	_ = patchRouteParentCondition(ctx, c, route, parentRef,
+		metav1.Condition{
+			Type:               string(gatewayv1.RouteConditionResolvedRefs),
+			Status:             metav1.ConditionTrue,
+			Reason:             "ResolvedRefs",
+			Message:            "Backend references resolved and API deployed to platform gateway",
+			ObservedGeneration: generation,
+			LastTransitionTime: metav1.Now(),
+		},
+		metav1.Condition{
+			Type:               string(gatewayv1.RouteConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gatewayv1.RouteReasonAccepted),
+			Message:            "Route accepted by platform gateway operator",
+			ObservedGeneration: generation,
+			LastTransitionTime: metav1.Now(),
+		},
+	)
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,137 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package controller
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	contctrl "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+	"github.com/wso2/api-platform/kubernetes/gateway-operator/internal/config"
+)
+
+const grpcrouteFinalizer = "gateway.api-platform.wso2.com/grpcroute-finalizer"
+
+// GRPCRouteReconciler maps GRPCRoute + backends to gateway-controller REST APIs.
+type GRPCRouteReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+	Config *config.OperatorConfig
+	Logger *zap.Logger
+}
+
+// NewGRPCRouteReconciler creates a reconciler for GRPCRoute.
+func NewGRPCRouteReconciler(cl client.Client, scheme *runtime.Scheme, cfg *config.OperatorConfig, logger *zap.Logger) *GRPCRouteReconciler {
+	return &GRPCRouteReconciler{
+		Client: cl,
+		Scheme: scheme,
+		Config: cfg,
+		Logger: logger,
+	}
+}
+
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=grpcroutes,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=grpcroutes/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=grpcroutes/finalizers,verbs=update
+
+func (r *GRPCRouteReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	route := &gatewayv1.GRPCRoute{}
+	if err := r.Get(ctx, req.NamespacedName, route); err != nil {
+		if apierrors.IsNotFound(err) {
+			if r.Logger != nil {
+				r.Logger.Debug("GRPCRoute not found; likely deleted",
+					zap.String("controller", routeKindGRPCRoute),
+					zap.String("namespace", req.Namespace),
+					zap.String("name", req.Name))
+			}
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	log := r.Logger.With(zap.String("controller", routeKindGRPCRoute), zap.String("namespace", req.Namespace), zap.String("name", req.Name))
+	log.Info("reconcile GRPCRoute",
+		zap.Int64("generation", route.Generation),
+		zap.String("resourceVersion", route.ResourceVersion))
+
+	return reconcileGatewayRoute(ctx, r.Client, r.Config, route, gatewayRouteSpec{
+		kind:       routeKindGRPCRoute,
+		finalizer:  grpcrouteFinalizer,
+		handle:     DefaultGRPCRouteAPIHandle(route),
+		parentRefs: route.Spec.ParentRefs,
+		build: func(ctx context.Context, log *zap.Logger) (*apiv1.APIConfigData, error) {
+			return BuildAPIConfigFromGRPCRoute(ctx, r.Client, route, r.Config.GatewayAPI.ClusterDomain, log)
+		},
+	}, log)
+}
+
+// SetupWithManager wires GRPCRoute reconciliation. Clusters without the GRPCRoute CRD (Gateway API
+// standard channel older than v1.1) are skipped so the operator still starts.
+func (r *GRPCRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	gvk := gatewayv1.SchemeGroupVersion.WithKind(routeKindGRPCRoute)
+	if _, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			if r.Logger != nil {
+				r.Logger.Info("GRPCRoute CRD not installed; GRPCRoute controller disabled")
+			}
+			return nil
+		}
+		return err
+	}
+
+	opts := contctrl.Options{MaxConcurrentReconciles: r.Config.Reconciliation.MaxConcurrentReconciles}
+	if opts.MaxConcurrentReconciles <= 0 {
+		opts.MaxConcurrentReconciles = 1
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(opts).
+		For(&gatewayv1.GRPCRoute{}).
+		Watches(
+			&corev1.Service{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueGRPCRoutesForService),
+			builder.WithPredicates(serviceMutationPredicate()),
+		).
+		Watches(
+			&apiv1.APIPolicy{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueGRPCRoutesForAPIPolicy),
+			builder.WithPredicates(apiPolicyMutationPredicate()),
+		).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueGRPCRoutesForSecret),
+			builder.WithPredicates(secretMutationPredicate()),
+		).
+		Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueGRPCRoutesForConfigMap),
+			builder.WithPredicates(configMapMutationPredicate()),
+		).
+		Complete(r)
+}
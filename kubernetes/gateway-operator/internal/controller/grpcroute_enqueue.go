@@ -0,0 +1,206 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+func grpcRouteReferencesAPIPolicy(route *gatewayv1.GRPCRoute, policyName string) bool {
+	policyName = strings.TrimSpace(policyName)
+	if policyName == "" {
+		return false
+	}
+	for _, rule := range route.Spec.Rules {
+		for _, f := range rule.Filters {
+			if f.Type != gatewayv1.GRPCRouteFilterExtensionRef || f.ExtensionRef == nil {
+				continue
+			}
+			ref := f.ExtensionRef
+			if string(ref.Group) != apiv1.GroupVersion.Group || string(ref.Kind) != "APIPolicy" {
+				continue
+			}
+			if string(ref.Name) == policyName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func grpcRouteReferencesService(route *gatewayv1.GRPCRoute, svcNS, svcName string) bool {
+	for _, rule := range route.Spec.Rules {
+		for _, b := range rule.BackendRefs {
+			if string(b.Name) != svcName {
+				continue
+			}
+			ns := route.Namespace
+			if b.Namespace != nil {
+				ns = string(*b.Namespace)
+			}
+			if ns == svcNS {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// grpcRouteRequests lists GRPCRoutes (optionally in one namespace) and returns requests for those
+// accepted by match.
+func (r *GRPCRouteReconciler) grpcRouteRequests(ctx context.Context, namespace string, match func(*gatewayv1.GRPCRoute) bool) ([]reconcile.Request, error) {
+	routes := &gatewayv1.GRPCRouteList{}
+	var opts []client.ListOption
+	if namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	if err := r.List(ctx, routes, opts...); err != nil {
+		return nil, err
+	}
+	var reqs []reconcile.Request
+	for i := range routes.Items {
+		if match(&routes.Items[i]) {
+			reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&routes.Items[i])})
+		}
+	}
+	return reqs, nil
+}
+
+func (r *GRPCRouteReconciler) logEnqueue(msg, sourceField, source string, reqs []reconcile.Request) {
+	if r.Logger == nil || len(reqs) == 0 {
+		return
+	}
+	names := make([]string, 0, len(reqs))
+	for _, q := range reqs {
+		names = append(names, q.NamespacedName.String())
+	}
+	r.Logger.Info(msg,
+		zap.String("controller", routeKindGRPCRoute),
+		zap.String(sourceField, source),
+		zap.Strings("grpcRoutes", names))
+}
+
+func (r *GRPCRouteReconciler) enqueueGRPCRoutesForService(ctx context.Context, obj client.Object) []reconcile.Request {
+	svc, ok := obj.(*corev1.Service)
+	if !ok {
+		return nil
+	}
+	reqs, err := r.grpcRouteRequests(ctx, "", func(rt *gatewayv1.GRPCRoute) bool {
+		return grpcRouteReferencesService(rt, svc.Namespace, svc.Name)
+	})
+	if err != nil {
+		if r.Logger != nil {
+			r.Logger.Error("watch: list GRPCRoutes for Service enqueue",
+				zap.Error(err),
+				zap.String("service", client.ObjectKeyFromObject(svc).String()))
+		}
+		return nil
+	}
+	r.logEnqueue("watch: Service changed; enqueue GRPCRoutes", "service", client.ObjectKeyFromObject(svc).String(), reqs)
+	return reqs
+}
+
+// grpcRoutesForAPIPolicy returns the GRPCRoutes an APIPolicy applies to, via spec.targetRef or rule
+// ExtensionRef filters.
+func (r *GRPCRouteReconciler) grpcRoutesForAPIPolicy(ctx context.Context, ap *apiv1.APIPolicy) []reconcile.Request {
+	if req, ok := routeRequestForAPIPolicyTarget(ap, routeKindGRPCRoute); ok {
+		return []reconcile.Request{req}
+	}
+	reqs, err := r.grpcRouteRequests(ctx, ap.Namespace, func(rt *gatewayv1.GRPCRoute) bool {
+		return grpcRouteReferencesAPIPolicy(rt, ap.Name)
+	})
+	if err != nil {
+		if r.Logger != nil {
+			r.Logger.Error("watch: list GRPCRoutes for APIPolicy ExtensionRef enqueue",
+				zap.Error(err),
+				zap.String("apiPolicy", client.ObjectKeyFromObject(ap).String()))
+		}
+		return nil
+	}
+	return reqs
+}
+
+// enqueueGRPCRoutesForAPIPolicy maps an APIPolicy event to reconcile of the GRPCRoutes it applies to.
+func (r *GRPCRouteReconciler) enqueueGRPCRoutesForAPIPolicy(ctx context.Context, obj client.Object) []reconcile.Request {
+	ap, ok := obj.(*apiv1.APIPolicy)
+	if !ok {
+		return nil
+	}
+	reqs := r.grpcRoutesForAPIPolicy(ctx, ap)
+	r.logEnqueue("watch: APIPolicy changed; enqueue GRPCRoutes", "apiPolicy", client.ObjectKeyFromObject(ap).String(), reqs)
+	return reqs
+}
+
+func (r *GRPCRouteReconciler) enqueueGRPCRoutesForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+	return r.enqueueGRPCRoutesForValueFrom(ctx, secretKeyRefKey, secret.Namespace, secret.Name,
+		client.ObjectKeyFromObject(secret).String())
+}
+
+func (r *GRPCRouteReconciler) enqueueGRPCRoutesForConfigMap(ctx context.Context, obj client.Object) []reconcile.Request {
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return nil
+	}
+	return r.enqueueGRPCRoutesForValueFrom(ctx, configMapKeyRefKey, cm.Namespace, cm.Name,
+		client.ObjectKeyFromObject(cm).String())
+}
+
+// enqueueGRPCRoutesForValueFrom mirrors enqueueHTTPRoutesForValueFrom for GRPCRoutes.
+func (r *GRPCRouteReconciler) enqueueGRPCRoutesForValueFrom(ctx context.Context, kind, targetNS, targetName, sourceKey string) []reconcile.Request {
+	list := &apiv1.APIPolicyList{}
+	if err := r.List(ctx, list); err != nil {
+		if r.Logger != nil {
+			r.Logger.Error("watch: list APIPolicies for valueFrom enqueue",
+				zap.Error(err),
+				zap.String("kind", kind),
+				zap.String("source", sourceKey))
+		}
+		return nil
+	}
+	seen := make(map[types.NamespacedName]struct{})
+	var reqs []reconcile.Request
+	for i := range list.Items {
+		ap := &list.Items[i]
+		if !apiPolicyReferencesValueFrom(ap, kind, targetNS, targetName) {
+			continue
+		}
+		for _, req := range r.grpcRoutesForAPIPolicy(ctx, ap) {
+			if _, dup := seen[req.NamespacedName]; dup {
+				continue
+			}
+			seen[req.NamespacedName] = struct{}{}
+			reqs = append(reqs, req)
+		}
+	}
+	r.logEnqueue("watch: valueFrom source changed; enqueue GRPCRoutes", "source", sourceKey, reqs)
+	return reqs
+}
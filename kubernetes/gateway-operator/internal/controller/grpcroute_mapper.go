@@ -0,0 +1,220 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+// grpcCatchAllPath matches every gRPC method; gRPC calls are POSTs to /<package.Service>/<Method>.
+const grpcCatchAllPath = "/*"
+
+// BuildAPIConfigFromGRPCRoute maps GRPCRoute rules to APIConfigData. Each method match becomes a POST
+// operation on /<service>/<method> (/<service>/* when only the service is matched); a rule without
+// matches routes every method. Backends follow the same single-Service MVP as HTTPRoute.
+func BuildAPIConfigFromGRPCRoute(ctx context.Context, c client.Client, route *gatewayv1.GRPCRoute, clusterDomain string, log *zap.Logger) (*apiv1.APIConfigData, error) {
+	if len(route.Spec.Rules) == 0 {
+		return nil, newInvalidHTTPRouteConfigError("GRPCRoute has no rules")
+	}
+	if log != nil {
+		log.Info("build API config from GRPCRoute",
+			zap.Int64("generation", route.Generation),
+			zap.String("resourceVersion", route.ResourceVersion),
+			zap.Int("ruleCount", len(route.Spec.Rules)))
+	}
+	if ctxPath := strings.TrimSpace(route.Annotations[AnnHTTPRouteContext]); ctxPath != "" && ctxPath != "/" {
+		return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+			"annotation %s is not supported on GRPCRoute: gRPC clients always call /<service>/<method>", AnnHTTPRouteContext)
+	}
+
+	displayName := route.Name
+	if v := route.Annotations[AnnHTTPRouteDisplayName]; v != "" {
+		displayName = v
+	}
+	version := route.Annotations[AnnHTTPRouteAPIVersion]
+	if version == "" {
+		version = "v1.0"
+	}
+
+	var refs []gatewayv1.BackendObjectReference
+	for _, rule := range route.Spec.Rules {
+		for _, b := range rule.BackendRefs {
+			refs = append(refs, b.BackendObjectReference)
+		}
+	}
+	backend, err := singleServiceBackend(ctx, c, routeKindGRPCRoute, route.Namespace, refs, clusterDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []apiv1.Operation
+	seen := make(map[string]struct{})
+	for ruleIdx, rule := range route.Spec.Rules {
+		rulePolicies, err := grpcRouteRulePolicies(ctx, c, route, rule, ruleIdx, log)
+		if err != nil {
+			return nil, err
+		}
+		var mirrorFilters []*gatewayv1.HTTPRequestMirrorFilter
+		for _, f := range rule.Filters {
+			if f.Type == gatewayv1.GRPCRouteFilterRequestMirror {
+				mirrorFilters = append(mirrorFilters, f.RequestMirror)
+			}
+		}
+		mirror, err := ruleRequestMirror(ctx, c, routeKindGRPCRoute, route.Namespace, mirrorFilters, ruleIdx, clusterDomain)
+		if err != nil {
+			return nil, err
+		}
+		paths, err := grpcRouteRulePaths(rule, ruleIdx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range paths {
+			// Earlier rules win, matching Gateway API precedence for identical matches.
+			if _, dup := seen[p]; dup {
+				continue
+			}
+			seen[p] = struct{}{}
+			ops = append(ops, apiv1.Operation{
+				Method:   apiv1.OperationMethodPOST,
+				Path:     p,
+				Policies: copyPolicies(rulePolicies),
+				Mirror:   mirror.DeepCopy(),
+			})
+		}
+	}
+
+	apiPolicies, err := apiPoliciesFromTargetRef(ctx, c, route, routeKindGRPCRoute, log)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := &apiv1.APIConfigData{
+		Context:     "/",
+		DisplayName: displayName,
+		Operations:  ops,
+		Upstream: apiv1.UpstreamConfig{
+			// gRPC only runs over HTTP/2, whatever the Service port declares.
+			Main: backend.upstream(true),
+		},
+		Version:  version,
+		Policies: apiPolicies,
+	}
+	if err := resolveAPIConfigPolicyParamsValueFrom(ctx, c, route.Namespace, spec, log); err != nil {
+		return nil, err
+	}
+	if log != nil {
+		log.Info("built API config from GRPCRoute",
+			zap.Int("operations", len(spec.Operations)),
+			zap.Int("apiLevelPolicies", len(spec.Policies)))
+	}
+	return spec, nil
+}
+
+// grpcRouteRulePaths returns the operation paths for a GRPCRoute rule's method matches.
+func grpcRouteRulePaths(rule gatewayv1.GRPCRouteRule, ruleIdx int) ([]string, error) {
+	if len(rule.Matches) == 0 {
+		return []string{grpcCatchAllPath}, nil
+	}
+	paths := make([]string, 0, len(rule.Matches))
+	for matchIdx, m := range rule.Matches {
+		if m.Method == nil {
+			paths = append(paths, grpcCatchAllPath)
+			continue
+		}
+		if m.Method.Type != nil && *m.Method.Type != gatewayv1.GRPCMethodMatchExact {
+			return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+				"rule[%d].matches[%d]: gRPC method match type %q is not supported; use Exact", ruleIdx, matchIdx, *m.Method.Type)
+		}
+		service, method := "", ""
+		if m.Method.Service != nil {
+			service = strings.TrimSpace(*m.Method.Service)
+		}
+		if m.Method.Method != nil {
+			method = strings.TrimSpace(*m.Method.Method)
+		}
+		switch {
+		case service != "" && method != "":
+			paths = append(paths, "/"+service+"/"+method)
+		case service != "":
+			paths = append(paths, "/"+service+"/*")
+		case method != "":
+			return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+				"rule[%d].matches[%d]: gRPC method match on method %q without service is not supported", ruleIdx, matchIdx, method)
+		default:
+			paths = append(paths, grpcCatchAllPath)
+		}
+	}
+	return paths, nil
+}
+
+// grpcRouteRulePolicies returns the APIPolicy ExtensionRef policies of a GRPCRoute rule followed by
+// its header modifier filters. RequestMirror filters are mapped separately by ruleRequestMirror.
+func grpcRouteRulePolicies(ctx context.Context, c client.Client, route *gatewayv1.GRPCRoute, rule gatewayv1.GRPCRouteRule, ruleIdx int, log *zap.Logger) ([]apiv1.Policy, error) {
+	var (
+		refs          []gatewayv1.LocalObjectReference
+		filterPolices []apiv1.Policy
+	)
+	for _, f := range rule.Filters {
+		var (
+			pols []apiv1.Policy
+			err  error
+		)
+		switch f.Type {
+		case gatewayv1.GRPCRouteFilterExtensionRef:
+			if f.ExtensionRef != nil {
+				refs = append(refs, *f.ExtensionRef)
+			}
+			continue
+		case gatewayv1.GRPCRouteFilterRequestHeaderModifier:
+			pols, err = headerModifierPolicies(f.RequestHeaderModifier, "request", string(f.Type), ruleIdx)
+		case gatewayv1.GRPCRouteFilterResponseHeaderModifier:
+			pols, err = headerModifierPolicies(f.ResponseHeaderModifier, "response", string(f.Type), ruleIdx)
+		case gatewayv1.GRPCRouteFilterRequestMirror:
+			// Mapped onto the operation mirror (ruleRequestMirror).
+			continue
+		default:
+			err = newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+				"rule[%d]: %s filter is not supported by the platform gateway", ruleIdx, f.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+		filterPolices = append(filterPolices, pols...)
+	}
+	extPolicies, err := policiesFromExtensionRefs(ctx, c, route, routeKindGRPCRoute, refs, ruleIdx, log)
+	if err != nil {
+		return nil, err
+	}
+	return append(extPolicies, filterPolices...), nil
+}
+
+// DefaultGRPCRouteAPIHandle returns a stable handle for rest-apis when no annotation is set. The
+// suffix keeps it distinct from an HTTPRoute of the same name.
+func DefaultGRPCRouteAPIHandle(route *gatewayv1.GRPCRoute) string {
+	if h := route.Annotations[AnnHTTPRouteAPIHandle]; h != "" {
+		return h
+	}
+	return strings.ReplaceAll(route.Namespace+"-"+route.Name, "/", "-") + "-grpc"
+}
@@ -0,0 +1,175 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+func grpcRouteTestClient(t *testing.T) client.Client {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.AddToScheme(scheme))
+	utilruntime.Must(apiv1.AddToScheme(scheme))
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "greeter", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 50051}}},
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(svc).Build()
+}
+
+func grpcTestBackend() []gatewayv1.GRPCBackendRef {
+	return []gatewayv1.GRPCBackendRef{{BackendRef: gatewayv1.BackendRef{
+		BackendObjectReference: gatewayv1.BackendObjectReference{Name: "greeter", Port: ptrPort(50051)},
+	}}}
+}
+
+func TestBuildAPIConfigFromGRPCRoute(t *testing.T) {
+	cl := grpcRouteTestClient(t)
+	svcName := "helloworld.Greeter"
+	sayHello := "SayHello"
+	health := "grpc.health.v1.Health"
+
+	route := &gatewayv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "greeter", Namespace: "default"},
+		Spec: gatewayv1.GRPCRouteSpec{Rules: []gatewayv1.GRPCRouteRule{
+			{
+				Matches: []gatewayv1.GRPCRouteMatch{
+					{Method: &gatewayv1.GRPCMethodMatch{Service: &svcName, Method: &sayHello}},
+					{Method: &gatewayv1.GRPCMethodMatch{Service: &health}},
+				},
+				Filters: []gatewayv1.GRPCRouteFilter{
+					{
+						Type: gatewayv1.GRPCRouteFilterRequestHeaderModifier,
+						RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+							Set: []gatewayv1.HTTPHeader{{Name: "x-tenant", Value: "a"}},
+						},
+					},
+					{
+						Type: gatewayv1.GRPCRouteFilterRequestMirror,
+						RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+							BackendRef: gatewayv1.BackendObjectReference{Name: "greeter", Port: ptrPort(50051)},
+						},
+					},
+				},
+				BackendRefs: grpcTestBackend(),
+			},
+			{
+				// Duplicate of the first rule's match; the earlier rule wins.
+				Matches: []gatewayv1.GRPCRouteMatch{
+					{Method: &gatewayv1.GRPCMethodMatch{Service: &svcName, Method: &sayHello}},
+				},
+				BackendRefs: grpcTestBackend(),
+			},
+			{BackendRefs: grpcTestBackend()},
+		}},
+	}
+
+	spec, err := BuildAPIConfigFromGRPCRoute(context.Background(), cl, route, "cluster.local", nil)
+	require.NoError(t, err)
+	require.Equal(t, "/", spec.Context)
+	require.Equal(t, "http://greeter.default.svc.cluster.local:50051", spec.Upstream.Main.Url)
+	// gRPC backends are always reached over HTTP/2, even without an appProtocol on the port.
+	require.NotNil(t, spec.Upstream.Main.Protocol)
+	require.Equal(t, upstreamProtocolHTTP2, *spec.Upstream.Main.Protocol)
+
+	paths := make([]string, 0, len(spec.Operations))
+	for _, op := range spec.Operations {
+		require.Equal(t, apiv1.OperationMethodPOST, op.Method)
+		paths = append(paths, op.Path)
+	}
+	require.Equal(t, []string{"/helloworld.Greeter/SayHello", "/grpc.health.v1.Health/*", "/*"}, paths)
+	require.Len(t, spec.Operations[0].Policies, 1)
+	require.Equal(t, setHeadersPolicyName, spec.Operations[0].Policies[0].Name)
+	require.Empty(t, spec.Operations[2].Policies)
+	require.NotNil(t, spec.Operations[0].Mirror)
+	require.Equal(t, "http://greeter.default.svc.cluster.local:50051", spec.Operations[0].Mirror.Url)
+	require.Nil(t, spec.Operations[0].Mirror.Percentage)
+	require.Nil(t, spec.Operations[2].Mirror)
+
+	require.Equal(t, "default-greeter-grpc", DefaultGRPCRouteAPIHandle(route))
+}
+
+func TestBuildAPIConfigFromGRPCRoute_Unsupported(t *testing.T) {
+	cl := grpcRouteTestClient(t)
+	regex := gatewayv1.GRPCMethodMatchRegularExpression
+	svcName := "helloworld.Greeter"
+	method := "SayHello"
+
+	tests := []struct {
+		name  string
+		route func(*gatewayv1.GRPCRoute)
+	}{
+		{
+			name: "regular expression method match",
+			route: func(r *gatewayv1.GRPCRoute) {
+				r.Spec.Rules[0].Matches = []gatewayv1.GRPCRouteMatch{{Method: &gatewayv1.GRPCMethodMatch{Type: &regex, Service: &svcName}}}
+			},
+		},
+		{
+			name: "method without service",
+			route: func(r *gatewayv1.GRPCRoute) {
+				r.Spec.Rules[0].Matches = []gatewayv1.GRPCRouteMatch{{Method: &gatewayv1.GRPCMethodMatch{Method: &method}}}
+			},
+		},
+		{
+			name: "two request mirrors",
+			route: func(r *gatewayv1.GRPCRoute) {
+				mirror := gatewayv1.GRPCRouteFilter{
+					Type: gatewayv1.GRPCRouteFilterRequestMirror,
+					RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+						BackendRef: gatewayv1.BackendObjectReference{Name: "greeter", Port: ptrPort(50051)},
+					},
+				}
+				r.Spec.Rules[0].Filters = []gatewayv1.GRPCRouteFilter{mirror, mirror}
+			},
+		},
+		{
+			name: "context annotation",
+			route: func(r *gatewayv1.GRPCRoute) {
+				r.Annotations = map[string]string{AnnHTTPRouteContext: "/grpc"}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := &gatewayv1.GRPCRoute{
+				ObjectMeta: metav1.ObjectMeta{Name: "greeter", Namespace: "default"},
+				Spec: gatewayv1.GRPCRouteSpec{Rules: []gatewayv1.GRPCRouteRule{
+					{BackendRefs: grpcTestBackend()},
+				}},
+			}
+			tt.route(route)
+			_, err := BuildAPIConfigFromGRPCRoute(context.Background(), cl, route, "cluster.local", nil)
+			requireRouteReason(t, err, gatewayv1.RouteReasonUnsupportedValue)
+		})
+	}
+}
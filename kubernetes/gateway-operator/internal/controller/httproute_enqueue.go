@@ -87,13 +87,14 @@ func configMapMutationPredicate() predicate.Predicate {
 	}
 }
 
-// httpRouteRequestForAPIPolicyTarget returns a reconcile request for the HTTPRoute referenced by ap.Spec.targetRef.
-func httpRouteRequestForAPIPolicyTarget(ap *apiv1.APIPolicy) (reconcile.Request, bool) {
+// routeRequestForAPIPolicyTarget returns a reconcile request for the route of routeKind referenced by
+// ap.Spec.targetRef.
+func routeRequestForAPIPolicyTarget(ap *apiv1.APIPolicy, routeKind string) (reconcile.Request, bool) {
 	if ap.Spec.TargetRef == nil {
 		return reconcile.Request{}, false
 	}
 	ref := *ap.Spec.TargetRef
-	if strings.TrimSpace(ref.Kind) != "HTTPRoute" {
+	if strings.TrimSpace(ref.Kind) != routeKind {
 		return reconcile.Request{}, false
 	}
 	if strings.TrimSpace(ref.Group) != gatewayv1.GroupName {
@@ -176,7 +177,7 @@ func (r *HTTPRouteReconciler) enqueueHTTPRouteForAPIPolicy(ctx context.Context,
 	if !ok {
 		return nil
 	}
-	if req, ok := httpRouteRequestForAPIPolicyTarget(ap); ok {
+	if req, ok := routeRequestForAPIPolicyTarget(ap, routeKindHTTPRoute); ok {
 		if r.Logger != nil {
 			r.Logger.Info("watch: APIPolicy changed; enqueue HTTPRoute",
 				zap.String("controller", "HTTPRoute"),
@@ -235,7 +236,7 @@ func (r *HTTPRouteReconciler) enqueueHTTPRoutesForValueFrom(ctx context.Context,
 			continue
 		}
 		var toAdd []reconcile.Request
-		if req, ok := httpRouteRequestForAPIPolicyTarget(ap); ok {
+		if req, ok := routeRequestForAPIPolicyTarget(ap, routeKindHTTPRoute); ok {
 			toAdd = append(toAdd, req)
 		} else {
 			toAdd = append(toAdd, r.enqueueHTTPRoutesReferencingAPIPolicy(ctx, ap)...)
@@ -0,0 +1,360 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+// Gateway policies that implement Gateway API core route filters on the generated operations.
+const (
+	setHeadersPolicyName     = "set-headers"
+	removeHeadersPolicyName  = "remove-headers"
+	requestRewritePolicyName = "request-rewrite"
+	hostRewritePolicyName    = "host-rewrite"
+	respondPolicyName        = "respond"
+	routeFilterPolicyVersion = "v1"
+
+	// upstreamHostRewriteManual stops Envoy from rewriting Host to the upstream cluster host so
+	// the host-rewrite policy (URLRewrite.hostname) takes effect.
+	upstreamHostRewriteManual = "manual"
+)
+
+// httpRouteFilterMatch is the operation a rule's filters are rendered for. Redirects are answered by
+// the gateway itself, so the Location is computed from the matched path at configuration time.
+type httpRouteFilterMatch struct {
+	contextPath string
+	path        string
+}
+
+// httpRouteRuleFilterPolicies maps the core (non-ExtensionRef) filters of an HTTPRoute rule onto
+// gateway policies for one of its matches, in filter order. Filters the platform gateway cannot
+// honour fail the whole route with the Gateway API reason instead of being dropped.
+func httpRouteRuleFilterPolicies(rule gatewayv1.HTTPRouteRule, ruleIdx int, m httpRouteFilterMatch) ([]apiv1.Policy, error) {
+	var hasRedirect, hasRewrite bool
+	for _, f := range rule.Filters {
+		switch f.Type {
+		case gatewayv1.HTTPRouteFilterRequestRedirect:
+			hasRedirect = true
+		case gatewayv1.HTTPRouteFilterURLRewrite:
+			hasRewrite = true
+		}
+	}
+	if hasRedirect && hasRewrite {
+		return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonIncompatibleFilters,
+			"rule[%d]: RequestRedirect and URLRewrite filters cannot be used on the same rule", ruleIdx)
+	}
+
+	var out []apiv1.Policy
+	for _, f := range rule.Filters {
+		var (
+			pols []apiv1.Policy
+			err  error
+		)
+		switch f.Type {
+		case gatewayv1.HTTPRouteFilterExtensionRef:
+			// Loaded separately from APIPolicy objects (policiesFromHTTPRouteRuleExtensionRefs).
+			continue
+		case gatewayv1.HTTPRouteFilterRequestHeaderModifier:
+			pols, err = headerModifierPolicies(f.RequestHeaderModifier, "request", string(f.Type), ruleIdx)
+		case gatewayv1.HTTPRouteFilterResponseHeaderModifier:
+			pols, err = headerModifierPolicies(f.ResponseHeaderModifier, "response", string(f.Type), ruleIdx)
+		case gatewayv1.HTTPRouteFilterURLRewrite:
+			pols, err = urlRewritePolicies(f.URLRewrite, ruleIdx)
+		case gatewayv1.HTTPRouteFilterRequestRedirect:
+			pols, err = requestRedirectPolicies(f.RequestRedirect, ruleIdx, m)
+		case gatewayv1.HTTPRouteFilterRequestMirror:
+			// Mapped onto the operation mirror (ruleRequestMirror).
+			continue
+		default:
+			err = newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+				"rule[%d]: %s filter is not supported by the platform gateway", ruleIdx, f.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pols...)
+	}
+	return out, nil
+}
+
+// ruleRequestMirror maps the RequestMirror filters of a rule onto the mirror of its operations. The
+// gateway mirrors an operation to a single upstream, so a rule may carry at most one RequestMirror.
+func ruleRequestMirror(ctx context.Context, c client.Client, routeKind, routeNS string, filters []*gatewayv1.HTTPRequestMirrorFilter, ruleIdx int, clusterDomain string) (*apiv1.TrafficMirror, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	if len(filters) > 1 {
+		return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+			"rule[%d]: only one RequestMirror filter per rule is supported by the platform gateway", ruleIdx)
+	}
+	f := filters[0]
+	if f == nil {
+		return nil, newInvalidHTTPRouteConfigError("rule[%d]: RequestMirror filter requires requestMirror", ruleIdx)
+	}
+	ref := f.BackendRef
+	if ref.Kind != nil && string(*ref.Kind) != "" && string(*ref.Kind) != "Service" {
+		return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonInvalidKind,
+			"rule[%d]: RequestMirror backendRef kind %q is not supported; use a Service", ruleIdx, *ref.Kind)
+	}
+	if ref.Name == "" {
+		return nil, newInvalidHTTPRouteConfigError("rule[%d]: RequestMirror backendRef requires name", ruleIdx)
+	}
+	svc, err := resolveServiceBackendRef(ctx, c, routeKind, routeNS, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	mirror := &apiv1.TrafficMirror{Url: svc.url(clusterDomain)}
+	switch {
+	case f.Percent != nil:
+		pct := *f.Percent
+		mirror.Percentage = &pct
+	case f.Fraction != nil:
+		denominator := int32(100)
+		if f.Fraction.Denominator != nil {
+			denominator = *f.Fraction.Denominator
+		}
+		if denominator <= 0 || (f.Fraction.Numerator*100)%denominator != 0 {
+			return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+				"rule[%d]: RequestMirror fraction %d/%d is not a whole percentage; use percent", ruleIdx, f.Fraction.Numerator, denominator)
+		}
+		pct := f.Fraction.Numerator * 100 / denominator
+		mirror.Percentage = &pct
+	}
+	return mirror, nil
+}
+
+// headerModifierPolicies maps a RequestHeaderModifier / ResponseHeaderModifier onto set-headers and
+// remove-headers for the given phase ("request" or "response").
+func headerModifierPolicies(f *gatewayv1.HTTPHeaderFilter, phase, filterType string, ruleIdx int) ([]apiv1.Policy, error) {
+	if f == nil {
+		return nil, newInvalidHTTPRouteConfigError("rule[%d]: %s filter requires its configuration block", ruleIdx, filterType)
+	}
+	if len(f.Add) > 0 {
+		// set-headers replaces existing values, which would silently turn an append into an overwrite.
+		return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+			"rule[%d]: %s.add is not supported by the platform gateway (headers can only be set or removed); use set instead",
+			ruleIdx, filterType)
+	}
+
+	var out []apiv1.Policy
+	if len(f.Set) > 0 {
+		headers := make([]map[string]any, 0, len(f.Set))
+		for _, h := range f.Set {
+			headers = append(headers, map[string]any{"name": string(h.Name), "value": h.Value})
+		}
+		p, err := routeFilterPolicy(setHeadersPolicyName, map[string]any{phase: map[string]any{"headers": headers}})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	if len(f.Remove) > 0 {
+		headers := make([]map[string]any, 0, len(f.Remove))
+		for _, name := range f.Remove {
+			headers = append(headers, map[string]any{"name": name})
+		}
+		p, err := routeFilterPolicy(removeHeadersPolicyName, map[string]any{phase: map[string]any{"headers": headers}})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// urlRewritePolicies maps URLRewrite onto request-rewrite (path) and host-rewrite (hostname).
+func urlRewritePolicies(f *gatewayv1.HTTPURLRewriteFilter, ruleIdx int) ([]apiv1.Policy, error) {
+	if f == nil {
+		return nil, newInvalidHTTPRouteConfigError("rule[%d]: URLRewrite filter requires urlRewrite", ruleIdx)
+	}
+	var out []apiv1.Policy
+	if f.Path != nil {
+		var rewrite map[string]any
+		switch f.Path.Type {
+		case gatewayv1.FullPathHTTPPathModifier:
+			if f.Path.ReplaceFullPath == nil {
+				return nil, newInvalidHTTPRouteConfigError("rule[%d]: URLRewrite path type ReplaceFullPath requires replaceFullPath", ruleIdx)
+			}
+			rewrite = map[string]any{"type": string(f.Path.Type), "replaceFullPath": *f.Path.ReplaceFullPath}
+		case gatewayv1.PrefixMatchHTTPPathModifier:
+			if f.Path.ReplacePrefixMatch == nil {
+				return nil, newInvalidHTTPRouteConfigError("rule[%d]: URLRewrite path type ReplacePrefixMatch requires replacePrefixMatch", ruleIdx)
+			}
+			rewrite = map[string]any{"type": string(f.Path.Type), "replacePrefixMatch": *f.Path.ReplacePrefixMatch}
+		default:
+			return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+				"rule[%d]: URLRewrite path type %q is not supported", ruleIdx, f.Path.Type)
+		}
+		p, err := routeFilterPolicy(requestRewritePolicyName, map[string]any{"pathRewrite": rewrite})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	if f.Hostname != nil && *f.Hostname != "" {
+		p, err := routeFilterPolicy(hostRewritePolicyName, map[string]any{"host": string(*f.Hostname)})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+// requestRedirectPolicies answers the operation with a respond policy carrying the redirect Location.
+// The gateway cannot read the request scheme, host or port when building that header, so a scheme or
+// port change is only supported together with an explicit hostname.
+func requestRedirectPolicies(f *gatewayv1.HTTPRequestRedirectFilter, ruleIdx int, m httpRouteFilterMatch) ([]apiv1.Policy, error) {
+	if f == nil {
+		return nil, newInvalidHTTPRouteConfigError("rule[%d]: RequestRedirect filter requires requestRedirect", ruleIdx)
+	}
+	hostname := ""
+	if f.Hostname != nil {
+		hostname = string(*f.Hostname)
+	}
+	if hostname == "" && (f.Scheme != nil || f.Port != nil) {
+		return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+			"rule[%d]: RequestRedirect scheme or port without hostname is not supported by the platform gateway; set requestRedirect.hostname",
+			ruleIdx)
+	}
+
+	path := joinContextPath(m.contextPath, m.path)
+	if f.Path != nil {
+		switch f.Path.Type {
+		case gatewayv1.FullPathHTTPPathModifier:
+			if f.Path.ReplaceFullPath == nil {
+				return nil, newInvalidHTTPRouteConfigError("rule[%d]: RequestRedirect path type ReplaceFullPath requires replaceFullPath", ruleIdx)
+			}
+			path = *f.Path.ReplaceFullPath
+		case gatewayv1.PrefixMatchHTTPPathModifier:
+			if f.Path.ReplacePrefixMatch == nil {
+				return nil, newInvalidHTTPRouteConfigError("rule[%d]: RequestRedirect path type ReplacePrefixMatch requires replacePrefixMatch", ruleIdx)
+			}
+			// Operations match their path exactly, so the whole matched path is the prefix.
+			path = joinContextPath(m.contextPath, *f.Path.ReplacePrefixMatch)
+		default:
+			return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonUnsupportedValue,
+				"rule[%d]: RequestRedirect path type %q is not supported", ruleIdx, f.Path.Type)
+		}
+	}
+
+	location := path
+	if hostname != "" {
+		authority := hostname
+		scheme := ""
+		if f.Scheme != nil {
+			scheme = strings.ToLower(*f.Scheme)
+		}
+		if f.Port != nil && !isDefaultPortForScheme(scheme, int32(*f.Port)) {
+			authority = fmt.Sprintf("%s:%d", hostname, *f.Port)
+		}
+		if scheme != "" {
+			location = scheme + "://" + authority + path
+		} else {
+			// Network-path reference: the client keeps the scheme it used.
+			location = "//" + authority + path
+		}
+	}
+
+	statusCode := http.StatusFound
+	if f.StatusCode != nil {
+		statusCode = *f.StatusCode
+	}
+	p, err := routeFilterPolicy(respondPolicyName, map[string]any{
+		"statusCode": statusCode,
+		"headers":    []map[string]any{{"name": "Location", "value": location}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return []apiv1.Policy{p}, nil
+}
+
+func isDefaultPortForScheme(scheme string, port int32) bool {
+	switch scheme {
+	case "https":
+		return port == 443
+	case "http":
+		return port == 80
+	default:
+		return false
+	}
+}
+
+// joinContextPath returns the client-facing path of an operation path under the API context.
+func joinContextPath(contextPath, path string) string {
+	ctx := strings.TrimSuffix(contextPath, "/")
+	if path == "" {
+		path = "/"
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	if ctx == "" {
+		return path
+	}
+	if path == "/" {
+		return ctx
+	}
+	return ctx + path
+}
+
+func routeFilterPolicy(name string, params map[string]any) (apiv1.Policy, error) {
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return apiv1.Policy{}, newInvalidHTTPRouteConfigError("%s params: %w", name, err)
+	}
+	return apiv1.Policy{
+		Name:    name,
+		Version: routeFilterPolicyVersion,
+		Params:  &runtime.RawExtension{Raw: raw},
+	}, nil
+}
+
+// setManualHostRewriteForHostRewritePolicies switches the main upstream to manual host rewriting
+// when any operation rewrites the Host header (URLRewrite.hostname).
+func setManualHostRewriteForHostRewritePolicies(spec *apiv1.APIConfigData) {
+	for i := range spec.Operations {
+		if policiesContain(spec.Operations[i].Policies, hostRewritePolicyName) {
+			mode := upstreamHostRewriteManual
+			spec.Upstream.Main.HostRewrite = &mode
+			return
+		}
+	}
+}
+
+func policiesContain(policies []apiv1.Policy, name string) bool {
+	for i := range policies {
+		if policies[i].Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,411 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+func policyParams(t *testing.T, p apiv1.Policy) map[string]any {
+	t.Helper()
+	require.NotNil(t, p.Params)
+	var out map[string]any
+	require.NoError(t, json.Unmarshal(p.Params.Raw, &out))
+	return out
+}
+
+func requireRouteReason(t *testing.T, err error, reason gatewayv1.RouteConditionReason) {
+	t.Helper()
+	require.Error(t, err)
+	var cfgErr *HTTPRouteConfigError
+	require.True(t, errors.As(err, &cfgErr), "want HTTPRouteConfigError, got %T", err)
+	require.Equal(t, reason, cfgErr.Reason)
+	require.True(t, IsInvalidHTTPRouteConfigError(err))
+}
+
+func TestHTTPRouteRuleFilterPolicies_HeaderModifiers(t *testing.T) {
+	rule := gatewayv1.HTTPRouteRule{
+		Filters: []gatewayv1.HTTPRouteFilter{
+			{
+				Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+				RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Set:    []gatewayv1.HTTPHeader{{Name: "X-Env", Value: "prod"}},
+					Remove: []string{"X-Debug"},
+				},
+			},
+			{
+				Type: gatewayv1.HTTPRouteFilterResponseHeaderModifier,
+				ResponseHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+					Set: []gatewayv1.HTTPHeader{{Name: "X-Served-By", Value: "gw"}},
+				},
+			},
+		},
+	}
+	pols, err := httpRouteRuleFilterPolicies(rule, 0, httpRouteFilterMatch{contextPath: "/api", path: "/hello"})
+	require.NoError(t, err)
+	require.Len(t, pols, 3)
+
+	require.Equal(t, setHeadersPolicyName, pols[0].Name)
+	require.Equal(t, routeFilterPolicyVersion, pols[0].Version)
+	require.Equal(t, map[string]any{"request": map[string]any{"headers": []any{
+		map[string]any{"name": "X-Env", "value": "prod"},
+	}}}, policyParams(t, pols[0]))
+
+	require.Equal(t, removeHeadersPolicyName, pols[1].Name)
+	require.Equal(t, map[string]any{"request": map[string]any{"headers": []any{
+		map[string]any{"name": "X-Debug"},
+	}}}, policyParams(t, pols[1]))
+
+	require.Equal(t, setHeadersPolicyName, pols[2].Name)
+	require.Contains(t, policyParams(t, pols[2]), "response")
+}
+
+func TestHTTPRouteRuleFilterPolicies_HeaderAddUnsupported(t *testing.T) {
+	rule := gatewayv1.HTTPRouteRule{
+		Filters: []gatewayv1.HTTPRouteFilter{{
+			Type: gatewayv1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: &gatewayv1.HTTPHeaderFilter{
+				Add: []gatewayv1.HTTPHeader{{Name: "X-Env", Value: "prod"}},
+			},
+		}},
+	}
+	_, err := httpRouteRuleFilterPolicies(rule, 0, httpRouteFilterMatch{path: "/"})
+	requireRouteReason(t, err, gatewayv1.RouteReasonUnsupportedValue)
+}
+
+func TestHTTPRouteRuleFilterPolicies_URLRewrite(t *testing.T) {
+	prefix := "/v2"
+	host := gatewayv1.PreciseHostname("internal.example.com")
+	rule := gatewayv1.HTTPRouteRule{
+		Filters: []gatewayv1.HTTPRouteFilter{{
+			Type: gatewayv1.HTTPRouteFilterURLRewrite,
+			URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+				Hostname: &host,
+				Path: &gatewayv1.HTTPPathModifier{
+					Type:               gatewayv1.PrefixMatchHTTPPathModifier,
+					ReplacePrefixMatch: &prefix,
+				},
+			},
+		}},
+	}
+	pols, err := httpRouteRuleFilterPolicies(rule, 0, httpRouteFilterMatch{path: "/v1"})
+	require.NoError(t, err)
+	require.Len(t, pols, 2)
+	require.Equal(t, requestRewritePolicyName, pols[0].Name)
+	require.Equal(t, map[string]any{"pathRewrite": map[string]any{
+		"type": "ReplacePrefixMatch", "replacePrefixMatch": "/v2",
+	}}, policyParams(t, pols[0]))
+	require.Equal(t, hostRewritePolicyName, pols[1].Name)
+	require.Equal(t, map[string]any{"host": "internal.example.com"}, policyParams(t, pols[1]))
+}
+
+func TestHTTPRouteRuleFilterPolicies_RequestRedirect(t *testing.T) {
+	host := gatewayv1.PreciseHostname("example.com")
+	scheme := "https"
+	code := 301
+	full := "/new"
+	tests := []struct {
+		name   string
+		filter gatewayv1.HTTPRequestRedirectFilter
+		status float64
+		loc    string
+	}{
+		{name: "defaults keep matched path", filter: gatewayv1.HTTPRequestRedirectFilter{}, status: 302, loc: "/api/old"},
+		{
+			name:   "hostname scheme and default port",
+			filter: gatewayv1.HTTPRequestRedirectFilter{Hostname: &host, Scheme: &scheme, Port: ptrPort(443), StatusCode: &code},
+			status: 301,
+			loc:    "https://example.com/api/old",
+		},
+		{
+			name:   "hostname with custom port keeps client scheme",
+			filter: gatewayv1.HTTPRequestRedirectFilter{Hostname: &host, Port: ptrPort(8443)},
+			status: 302,
+			loc:    "//example.com:8443/api/old",
+		},
+		{
+			name: "full path replacement",
+			filter: gatewayv1.HTTPRequestRedirectFilter{Path: &gatewayv1.HTTPPathModifier{
+				Type: gatewayv1.FullPathHTTPPathModifier, ReplaceFullPath: &full,
+			}},
+			status: 302,
+			loc:    "/new",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := tt.filter
+			rule := gatewayv1.HTTPRouteRule{Filters: []gatewayv1.HTTPRouteFilter{{
+				Type:            gatewayv1.HTTPRouteFilterRequestRedirect,
+				RequestRedirect: &f,
+			}}}
+			pols, err := httpRouteRuleFilterPolicies(rule, 0, httpRouteFilterMatch{contextPath: "/api", path: "/old"})
+			require.NoError(t, err)
+			require.Len(t, pols, 1)
+			require.Equal(t, respondPolicyName, pols[0].Name)
+			params := policyParams(t, pols[0])
+			require.Equal(t, tt.status, params["statusCode"])
+			require.Equal(t, []any{map[string]any{"name": "Location", "value": tt.loc}}, params["headers"])
+		})
+	}
+}
+
+func TestHTTPRouteRuleFilterPolicies_Rejections(t *testing.T) {
+	scheme := "https"
+	prefix := "/v2"
+	tests := []struct {
+		name    string
+		filters []gatewayv1.HTTPRouteFilter
+		reason  gatewayv1.RouteConditionReason
+	}{
+		{
+			name: "redirect scheme without hostname",
+			filters: []gatewayv1.HTTPRouteFilter{{
+				Type:            gatewayv1.HTTPRouteFilterRequestRedirect,
+				RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{Scheme: &scheme},
+			}},
+			reason: gatewayv1.RouteReasonUnsupportedValue,
+		},
+		{
+			name: "redirect with url rewrite",
+			filters: []gatewayv1.HTTPRouteFilter{
+				{Type: gatewayv1.HTTPRouteFilterRequestRedirect, RequestRedirect: &gatewayv1.HTTPRequestRedirectFilter{}},
+				{Type: gatewayv1.HTTPRouteFilterURLRewrite, URLRewrite: &gatewayv1.HTTPURLRewriteFilter{
+					Path: &gatewayv1.HTTPPathModifier{Type: gatewayv1.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: &prefix},
+				}},
+			},
+			reason: gatewayv1.RouteReasonIncompatibleFilters,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := httpRouteRuleFilterPolicies(gatewayv1.HTTPRouteRule{Filters: tt.filters}, 0, httpRouteFilterMatch{path: "/"})
+			requireRouteReason(t, err, tt.reason)
+		})
+	}
+}
+
+func TestBuildAPIConfigFromHTTPRoute_FiltersAndHostRewrite(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.AddToScheme(scheme))
+	utilruntime.Must(apiv1.AddToScheme(scheme))
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(svc).Build()
+
+	pathType := gatewayv1.PathMatchExact
+	pathVal := "/hello"
+	host := gatewayv1.PreciseHostname("internal.example.com")
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "r", Namespace: "default"},
+		Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{
+			Matches: []gatewayv1.HTTPRouteMatch{{Path: &gatewayv1.HTTPPathMatch{Type: &pathType, Value: &pathVal}}},
+			Filters: []gatewayv1.HTTPRouteFilter{{
+				Type:       gatewayv1.HTTPRouteFilterURLRewrite,
+				URLRewrite: &gatewayv1.HTTPURLRewriteFilter{Hostname: &host},
+			}},
+			BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{Name: "backend", Port: ptrPort(8080)},
+			}}},
+		}}},
+	}
+
+	spec, err := BuildAPIConfigFromHTTPRoute(context.Background(), cl, route, "cluster.local", nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, spec.Operations)
+	require.True(t, policiesContain(spec.Operations[0].Policies, hostRewritePolicyName))
+	require.NotNil(t, spec.Upstream.Main.HostRewrite)
+	require.Equal(t, upstreamHostRewriteManual, *spec.Upstream.Main.HostRewrite)
+}
+
+func TestBuildAPIConfigFromHTTPRoute_RequestMirrorAndH2CBackend(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.AddToScheme(scheme))
+	utilruntime.Must(apiv1.AddToScheme(scheme))
+
+	h2c := appProtocolH2C
+	backend := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080, AppProtocol: &h2c}}},
+	}
+	shadow := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "shadow", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 9090}}},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(backend, shadow).Build()
+
+	pathType := gatewayv1.PathMatchExact
+	pathVal := "/hello"
+	method := gatewayv1.HTTPMethodGet
+	percent := int32(25)
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "r", Namespace: "default"},
+		Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{
+			Matches: []gatewayv1.HTTPRouteMatch{{Path: &gatewayv1.HTTPPathMatch{Type: &pathType, Value: &pathVal}, Method: &method}},
+			Filters: []gatewayv1.HTTPRouteFilter{{
+				Type: gatewayv1.HTTPRouteFilterRequestMirror,
+				RequestMirror: &gatewayv1.HTTPRequestMirrorFilter{
+					BackendRef: gatewayv1.BackendObjectReference{Name: "shadow", Port: ptrPort(9090)},
+					Percent:    &percent,
+				},
+			}},
+			BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{Name: "backend", Port: ptrPort(8080)},
+			}}},
+		}}},
+	}
+
+	spec, err := BuildAPIConfigFromHTTPRoute(context.Background(), cl, route, "cluster.local", nil)
+	require.NoError(t, err)
+	require.NotNil(t, spec.Upstream.Main.Protocol)
+	require.Equal(t, upstreamProtocolHTTP2, *spec.Upstream.Main.Protocol)
+	require.Len(t, spec.Operations, 1)
+	require.Empty(t, spec.Operations[0].Policies)
+	require.Equal(t, &apiv1.TrafficMirror{
+		Url:        "http://shadow.default.svc.cluster.local:9090",
+		Percentage: &percent,
+	}, spec.Operations[0].Mirror)
+}
+
+func TestRuleRequestMirror_Rejections(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.AddToScheme(scheme))
+	shadow := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "shadow", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 9090}}},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(shadow).Build()
+
+	shadowRef := gatewayv1.BackendObjectReference{Name: "shadow", Port: ptrPort(9090)}
+	otherKind := gatewayv1.Kind("Backend")
+	tests := []struct {
+		name    string
+		filters []*gatewayv1.HTTPRequestMirrorFilter
+		reason  gatewayv1.RouteConditionReason
+	}{
+		{
+			name: "two mirrors",
+			filters: []*gatewayv1.HTTPRequestMirrorFilter{
+				{BackendRef: shadowRef},
+				{BackendRef: shadowRef},
+			},
+			reason: gatewayv1.RouteReasonUnsupportedValue,
+		},
+		{
+			name: "fraction that is not a whole percentage",
+			filters: []*gatewayv1.HTTPRequestMirrorFilter{{
+				BackendRef: shadowRef,
+				Fraction:   &gatewayv1.Fraction{Numerator: 1, Denominator: ptrInt32(3)},
+			}},
+			reason: gatewayv1.RouteReasonUnsupportedValue,
+		},
+		{
+			name: "non-Service backend",
+			filters: []*gatewayv1.HTTPRequestMirrorFilter{{
+				BackendRef: gatewayv1.BackendObjectReference{Kind: &otherKind, Name: "shadow"},
+			}},
+			reason: gatewayv1.RouteReasonInvalidKind,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ruleRequestMirror(context.Background(), cl, routeKindHTTPRoute, "default", tt.filters, 0, "cluster.local")
+			requireRouteReason(t, err, tt.reason)
+		})
+	}
+
+	mirror, err := ruleRequestMirror(context.Background(), cl, routeKindHTTPRoute, "default", []*gatewayv1.HTTPRequestMirrorFilter{{
+		BackendRef: shadowRef,
+		Fraction:   &gatewayv1.Fraction{Numerator: 1, Denominator: ptrInt32(4)},
+	}}, 0, "cluster.local")
+	require.NoError(t, err)
+	require.Equal(t, int32(25), *mirror.Percentage)
+}
+
+func ptrInt32(v int32) *int32 {
+	return &v
+}
+
+func TestBuildAPIConfigFromHTTPRoute_ExtensionRefInvalidKind(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(gatewayv1.AddToScheme(scheme))
+	utilruntime.Must(apiv1.AddToScheme(scheme))
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "backend", Namespace: "default"},
+		Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(svc).Build()
+
+	route := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Name: "r", Namespace: "default"},
+		Spec: gatewayv1.HTTPRouteSpec{Rules: []gatewayv1.HTTPRouteRule{{
+			Filters: []gatewayv1.HTTPRouteFilter{{
+				Type:         gatewayv1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &gatewayv1.LocalObjectReference{Group: "example.com", Kind: "Other", Name: "x"},
+			}},
+			BackendRefs: []gatewayv1.HTTPBackendRef{{BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{Name: "backend", Port: ptrPort(8080)},
+			}}},
+		}}},
+	}
+
+	_, err := BuildAPIConfigFromHTTPRoute(context.Background(), cl, route, "cluster.local", nil)
+	requireRouteReason(t, err, gatewayv1.RouteReasonInvalidKind)
+
+	cond, requeue := routeConfigErrorCondition(err, 3)
+	require.Equal(t, string(gatewayv1.RouteConditionResolvedRefs), cond.Type)
+	require.Equal(t, string(gatewayv1.RouteReasonInvalidKind), cond.Reason)
+	require.Equal(t, int64(3), cond.ObservedGeneration)
+	require.Zero(t, requeue)
+}
+
+func TestRouteConfigErrorCondition(t *testing.T) {
+	cond, requeue := routeConfigErrorCondition(
+		newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonIncompatibleFilters, "rule[0]: incompatible"), 2)
+	require.Equal(t, string(gatewayv1.RouteConditionAccepted), cond.Type)
+	require.Equal(t, metav1.ConditionFalse, cond.Status)
+	require.Equal(t, string(gatewayv1.RouteReasonIncompatibleFilters), cond.Reason)
+	require.Equal(t, "rule[0]: incompatible", cond.Message)
+	require.Zero(t, requeue)
+
+	cond, requeue = routeConfigErrorCondition(errors.New("service lookup failed"), 2)
+	require.Equal(t, string(gatewayv1.RouteConditionResolvedRefs), cond.Type)
+	require.Equal(t, "Retrying", cond.Reason)
+	require.NotZero(t, requeue)
+}
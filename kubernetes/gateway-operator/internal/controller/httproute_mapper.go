@@ -33,6 +33,12 @@ import (
 
 const defaultKubernetesClusterDNS = "cluster.local"
 
+// Gateway API route kinds mapped onto gateway-controller REST APIs.
+const (
+	routeKindHTTPRoute = "HTTPRoute"
+	routeKindGRPCRoute = "GRPCRoute"
+)
+
 func effectiveClusterDNSBase(domain string) string {
 	d := strings.Trim(strings.TrimSpace(domain), ".")
 	if d == "" {
@@ -50,7 +56,10 @@ const (
 
 type HTTPRouteConfigError struct {
 	Kind httpRouteConfigErrorKind
-	Err  error
+	// Reason is the Gateway API route condition reason reported for the error; empty means
+	// the generic Invalid / Retrying reasons.
+	Reason gatewayv1.RouteConditionReason
+	Err    error
 }
 
 func (e *HTTPRouteConfigError) Error() string {
@@ -81,6 +90,17 @@ func newTransientHTTPRouteConfigError(format string, args ...any) error {
 	}
 }
 
+// newUnsupportedHTTPRouteConfigError reports route features the platform gateway cannot express.
+// reason is a Gateway API route condition reason such as UnsupportedValue, IncompatibleFilters
+// or InvalidKind; the error is not retried.
+func newUnsupportedHTTPRouteConfigError(reason gatewayv1.RouteConditionReason, format string, args ...any) error {
+	return &HTTPRouteConfigError{
+		Kind:   httpRouteConfigErrorInvalid,
+		Reason: reason,
+		Err:    fmt.Errorf(format, args...),
+	}
+}
+
 func IsInvalidHTTPRouteConfigError(err error) bool {
 	if err == nil {
 		return false
@@ -145,18 +165,35 @@ func BuildAPIConfigFromHTTPRoute(ctx context.Context, c client.Client, route *ga
 		version = "v1.0"
 	}
 
-	// Resolve backend URL (same Service required for all rules in MVP).
-	backendURL, err := firstBackendURL(ctx, c, route, clusterDomain)
+	// Resolve backend (same Service required for all rules in MVP).
+	backend, err := firstBackend(ctx, c, route, clusterDomain)
 	if err != nil {
 		return nil, err
 	}
 
+	contextPath := strings.TrimSpace(route.Annotations[AnnHTTPRouteContext])
+	if contextPath == "" {
+		contextPath = "/"
+	} else if !strings.HasPrefix(contextPath, "/") {
+		contextPath = "/" + contextPath
+	}
+
 	var ops []apiv1.Operation
 	for ruleIdx, rule := range route.Spec.Rules {
 		rulePolicies, err := policiesFromHTTPRouteRuleExtensionRefs(ctx, c, route, rule, ruleIdx, log)
 		if err != nil {
 			return nil, err
 		}
+		var mirrorFilters []*gatewayv1.HTTPRequestMirrorFilter
+		for _, f := range rule.Filters {
+			if f.Type == gatewayv1.HTTPRouteFilterRequestMirror {
+				mirrorFilters = append(mirrorFilters, f.RequestMirror)
+			}
+		}
+		mirror, err := ruleRequestMirror(ctx, c, routeKindHTTPRoute, route.Namespace, mirrorFilters, ruleIdx, clusterDomain)
+		if err != nil {
+			return nil, err
+		}
 		if len(rule.Matches) == 0 {
 			return nil, newInvalidHTTPRouteConfigError(
 				"rule[%d] has no matches; add at least one rule.matches entry (optional match.method; if omitted, all API verbs GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS are emitted)",
@@ -174,12 +211,21 @@ func BuildAPIConfigFromHTTPRoute(ctx context.Context, c client.Client, route *ga
 					}
 				}
 			}
+			// APIPolicy ExtensionRefs run first so authentication and rate limiting also
+			// cover redirects answered by the gateway.
+			filterPolicies, err := httpRouteRuleFilterPolicies(rule, ruleIdx, httpRouteFilterMatch{contextPath: contextPath, path: pathVal})
+			if err != nil {
+				return nil, err
+			}
 			methods := restAPIOperationMethodsForHTTPRouteMatch(m)
 			for _, method := range methods {
+				pols := copyPolicies(rulePolicies)
+				pols = append(pols, copyPolicies(filterPolicies)...)
 				ops = append(ops, apiv1.Operation{
 					Method:   method,
 					Path:     pathVal,
-					Policies: copyPolicies(rulePolicies),
+					Policies: pols,
+					Mirror:   mirror.DeepCopy(),
 				})
 			}
 		}
@@ -189,13 +235,6 @@ func BuildAPIConfigFromHTTPRoute(ctx context.Context, c client.Client, route *ga
 		return nil, newInvalidHTTPRouteConfigError("no operations derived from HTTPRoute")
 	}
 
-	contextPath := strings.TrimSpace(route.Annotations[AnnHTTPRouteContext])
-	if contextPath == "" {
-		contextPath = "/"
-	} else if !strings.HasPrefix(contextPath, "/") {
-		contextPath = "/" + contextPath
-	}
-
 	apiPolicies, err := loadHTTPRouteAPIPolicies(ctx, c, route, log)
 	if err != nil {
 		return nil, err
@@ -206,11 +245,12 @@ func BuildAPIConfigFromHTTPRoute(ctx context.Context, c client.Client, route *ga
 		DisplayName: displayName,
 		Operations:  ops,
 		Upstream: apiv1.UpstreamConfig{
-			Main: apiv1.Upstream{Url: backendURL},
+			Main: backend.upstream(false),
 		},
 		Version:  version,
 		Policies: apiPolicies,
 	}
+	setManualHostRewriteForHostRewritePolicies(spec)
 	if err := resolveAPIConfigPolicyParamsValueFrom(ctx, c, route.Namespace, spec, log); err != nil {
 		return nil, err
 	}
@@ -230,65 +270,139 @@ func BuildAPIConfigFromHTTPRoute(ctx context.Context, c client.Client, route *ga
 	return spec, nil
 }
 
-func firstBackendURL(ctx context.Context, c client.Client, route *gatewayv1.HTTPRoute, clusterDomain string) (string, error) {
-	dnsBase := effectiveClusterDNSBase(clusterDomain)
-	ns := route.Namespace
-	var (
-		baselineSet   bool
-		baselineSvcNS string
-		baselineSvc   string
-		baselinePort  int32
-	)
+func firstBackend(ctx context.Context, c client.Client, route *gatewayv1.HTTPRoute, clusterDomain string) (serviceBackend, error) {
+	var refs []gatewayv1.BackendObjectReference
 	for _, rule := range route.Spec.Rules {
 		for _, b := range rule.BackendRefs {
-			if b.Kind != nil && string(*b.Kind) != "" && string(*b.Kind) != "Service" {
-				continue
-			}
-			if b.Group != nil && string(*b.Group) != "" {
-				return "", newTransientHTTPRouteConfigError(
-					"unsupported backendRef: core Service backends require group to be omitted or empty (got group %q)",
-					string(*b.Group),
-				)
-			}
-			svcNS := ns
-			if b.Namespace != nil && *b.Namespace != "" {
-				svcNS = string(*b.Namespace)
-			}
-			svcName := string(b.Name)
-			if svcName == "" {
-				continue
-			}
-			if err := ensureCrossNamespaceServiceReferenceGrant(ctx, c, ns, svcNS, svcName); err != nil {
-				return "", err
-			}
-			svc := &corev1.Service{}
-			key := types.NamespacedName{Namespace: svcNS, Name: svcName}
-			if err := c.Get(ctx, key, svc); err != nil {
-				return "", newTransientHTTPRouteConfigError("get backend Service %s: %w", key.String(), err)
-			}
-			portNum, err := resolveServicePort(svc, b.Port)
-			if err != nil {
-				return "", err
-			}
-			if !baselineSet {
-				baselineSet = true
-				baselineSvcNS = svcNS
-				baselineSvc = svcName
-				baselinePort = portNum
-				continue
-			}
-			if svcNS != baselineSvcNS || svcName != baselineSvc || portNum != baselinePort {
-				return "", newInvalidHTTPRouteConfigError(
-					"HTTPRoute backendRefs must resolve to a single Service backend (first %s/%s:%d, found %s/%s:%d)",
-					baselineSvcNS, baselineSvc, baselinePort, svcNS, svcName, portNum,
-				)
-			}
+			refs = append(refs, b.BackendObjectReference)
+		}
+	}
+	return singleServiceBackend(ctx, c, routeKindHTTPRoute, route.Namespace, refs, clusterDomain)
+}
+
+// Service port appProtocol values that ask for HTTP/2 to the backend (prior knowledge, no TLS).
+const (
+	appProtocolH2C  = "kubernetes.io/h2c"
+	appProtocolGRPC = "grpc"
+)
+
+// serviceBackend is the in-cluster Service a route's backendRefs resolve to.
+type serviceBackend struct {
+	url string
+	// http2 is set when the Service port's appProtocol is kubernetes.io/h2c or grpc.
+	http2 bool
+}
+
+// upstream returns the main upstream for the backend, speaking HTTP/2 when forceHTTP2 is set or
+// the Service port asks for it.
+func (b serviceBackend) upstream(forceHTTP2 bool) apiv1.Upstream {
+	up := apiv1.Upstream{Url: b.url}
+	if forceHTTP2 || b.http2 {
+		protocol := upstreamProtocolHTTP2
+		up.Protocol = &protocol
+	}
+	return up
+}
+
+// upstreamProtocolHTTP2 is the RestApi upstream protocol for HTTP/2 (h2c) backends.
+const upstreamProtocolHTTP2 = "http2"
+
+// singleServiceBackend resolves the backendRefs of a route to one in-cluster Service
+// (MVP: every Service backendRef must point at the same Service and port).
+func singleServiceBackend(ctx context.Context, c client.Client, routeKind, routeNS string, refs []gatewayv1.BackendObjectReference, clusterDomain string) (serviceBackend, error) {
+	var (
+		baselineSet bool
+		baseline    resolvedServiceRef
+	)
+	for _, b := range refs {
+		if b.Kind != nil && string(*b.Kind) != "" && string(*b.Kind) != "Service" {
+			continue
+		}
+		if string(b.Name) == "" {
+			continue
+		}
+		ref, err := resolveServiceBackendRef(ctx, c, routeKind, routeNS, b)
+		if err != nil {
+			return serviceBackend{}, err
+		}
+		if !baselineSet {
+			baselineSet = true
+			baseline = ref
+			continue
+		}
+		if ref.namespace != baseline.namespace || ref.name != baseline.name || ref.port.Port != baseline.port.Port {
+			return serviceBackend{}, newInvalidHTTPRouteConfigError(
+				"%s backendRefs must resolve to a single Service backend (first %s/%s:%d, found %s/%s:%d)",
+				routeKind, baseline.namespace, baseline.name, baseline.port.Port, ref.namespace, ref.name, ref.port.Port,
+			)
 		}
 	}
 	if baselineSet {
-		return fmt.Sprintf("http://%s.%s.svc.%s:%d", baselineSvc, baselineSvcNS, dnsBase, baselinePort), nil
+		return serviceBackend{
+			url:   baseline.url(clusterDomain),
+			http2: baseline.http2(),
+		}, nil
+	}
+	return serviceBackend{}, newInvalidHTTPRouteConfigError("no Service backendRef found on %s", routeKind)
+}
+
+// resolvedServiceRef is a Service backendRef resolved against the cluster.
+type resolvedServiceRef struct {
+	namespace string
+	name      string
+	port      corev1.ServicePort
+}
+
+func (r resolvedServiceRef) url(clusterDomain string) string {
+	return fmt.Sprintf("http://%s.%s.svc.%s:%d", r.name, r.namespace, effectiveClusterDNSBase(clusterDomain), r.port.Port)
+}
+
+func (r resolvedServiceRef) http2() bool {
+	if r.port.AppProtocol == nil {
+		return false
+	}
+	switch strings.TrimSpace(*r.port.AppProtocol) {
+	case appProtocolH2C, appProtocolGRPC:
+		return true
+	default:
+		return false
+	}
+}
+
+// resolveServiceBackendRef checks the ReferenceGrant for a cross-namespace Service backendRef, then
+// loads the Service and the referenced port.
+func resolveServiceBackendRef(ctx context.Context, c client.Client, routeKind, routeNS string, b gatewayv1.BackendObjectReference) (resolvedServiceRef, error) {
+	if b.Group != nil && string(*b.Group) != "" {
+		return resolvedServiceRef{}, newTransientHTTPRouteConfigError(
+			"unsupported backendRef: core Service backends require group to be omitted or empty (got group %q)",
+			string(*b.Group),
+		)
+	}
+	svcNS := routeNS
+	if b.Namespace != nil && *b.Namespace != "" {
+		svcNS = string(*b.Namespace)
+	}
+	svcName := string(b.Name)
+	if err := ensureCrossNamespaceServiceReferenceGrant(ctx, c, routeKind, routeNS, svcNS, svcName); err != nil {
+		return resolvedServiceRef{}, err
+	}
+	svc := &corev1.Service{}
+	key := types.NamespacedName{Namespace: svcNS, Name: svcName}
+	if err := c.Get(ctx, key, svc); err != nil {
+		return resolvedServiceRef{}, newTransientHTTPRouteConfigError("get backend Service %s: %w", key.String(), err)
+	}
+	portNum, err := resolveServicePort(svc, b.Port)
+	if err != nil {
+		return resolvedServiceRef{}, err
+	}
+	ref := resolvedServiceRef{namespace: svcNS, name: svcName}
+	for _, p := range svc.Spec.Ports {
+		if p.Port == portNum {
+			ref.port = p
+			break
+		}
 	}
-	return "", newInvalidHTTPRouteConfigError("no Service backendRef found on HTTPRoute")
+	return ref, nil
 }
 
 func resolveServicePort(svc *corev1.Service, refPort *gatewayv1.PortNumber) (int32, error) {
@@ -62,12 +62,14 @@ func HTTPRouteOperationPolicyKey(method apiv1.OperationMethod, path string) stri
 }
 
 func loadHTTPRouteAPIPolicies(ctx context.Context, c client.Client, route *gatewayv1.HTTPRoute, log *zap.Logger) ([]apiv1.Policy, error) {
-	return apiPoliciesFromTargetRef(ctx, c, route, log)
+	return apiPoliciesFromTargetRef(ctx, c, route, routeKindHTTPRoute, log)
 }
 
-func apiPoliciesFromTargetRef(ctx context.Context, c client.Client, route *gatewayv1.HTTPRoute, log *zap.Logger) ([]apiv1.Policy, error) {
+// apiPoliciesFromTargetRef returns the policies of every APIPolicy in the route namespace whose
+// spec.targetRef names the route, ordered by APIPolicy name.
+func apiPoliciesFromTargetRef(ctx context.Context, c client.Client, route client.Object, routeKind string, log *zap.Logger) ([]apiv1.Policy, error) {
 	list := &apiv1.APIPolicyList{}
-	if err := c.List(ctx, list, client.InNamespace(route.Namespace)); err != nil {
+	if err := c.List(ctx, list, client.InNamespace(route.GetNamespace())); err != nil {
 		return nil, err
 	}
 	var crNames []string
@@ -77,7 +79,7 @@ func apiPoliciesFromTargetRef(ctx context.Context, c client.Client, route *gatew
 		if ap.Spec.TargetRef == nil {
 			continue
 		}
-		if !apiPolicyTargetRefMatchesHTTPRoute(ap, route) {
+		if !apiPolicyTargetRefMatchesRoute(ap, routeKind, route.GetNamespace(), route.GetName()) {
 			continue
 		}
 		pols, err := embeddedPoliciesFromAPIPolicySpec(&ap.Spec)
@@ -89,8 +91,9 @@ func apiPoliciesFromTargetRef(ctx context.Context, c client.Client, route *gatew
 	}
 	if len(crNames) == 0 {
 		if log != nil {
-			log.Debug("no APIPolicy CRs with spec.targetRef for this HTTPRoute",
-				zap.String("httpRoute", route.Namespace+"/"+route.Name))
+			log.Debug("no APIPolicy CRs with spec.targetRef for this route",
+				zap.String("routeKind", routeKind),
+				zap.String("route", route.GetNamespace()+"/"+route.GetName()))
 		}
 		return nil, nil
 	}
@@ -107,21 +110,23 @@ func apiPoliciesFromTargetRef(ctx context.Context, c client.Client, route *gatew
 	return out, nil
 }
 
-func apiPolicyTargetRefMatchesHTTPRoute(ap *apiv1.APIPolicy, route *gatewayv1.HTTPRoute) bool {
+// apiPolicyTargetRefMatchesRoute reports whether ap.Spec.targetRef names the Gateway API route
+// routeKind routeNamespace/routeName.
+func apiPolicyTargetRefMatchesRoute(ap *apiv1.APIPolicy, routeKind, routeNamespace, routeName string) bool {
 	if ap.Spec.TargetRef == nil {
 		return false
 	}
 	ref := *ap.Spec.TargetRef
-	if strings.TrimSpace(ref.Kind) != "HTTPRoute" {
+	if strings.TrimSpace(ref.Kind) != routeKind {
 		return false
 	}
 	if strings.TrimSpace(ref.Group) != gatewayv1.GroupName {
 		return false
 	}
-	if ref.Name != route.Name {
+	if ref.Name != routeName {
 		return false
 	}
-	if ref.Namespace != nil && strings.TrimSpace(*ref.Namespace) != "" && *ref.Namespace != route.Namespace {
+	if ref.Namespace != nil && strings.TrimSpace(*ref.Namespace) != "" && *ref.Namespace != routeNamespace {
 		return false
 	}
 	return true
@@ -143,55 +148,60 @@ func embeddedPoliciesFromAPIPolicySpec(spec *apiv1.APIPolicySpec) ([]apiv1.Polic
 }
 
 // policiesFromHTTPRouteRuleExtensionRefs loads policies from rule.filters where type is ExtensionRef.
-// Only APIPolicy ExtensionRefs are supported for Gateway API integration.
+// Only APIPolicy ExtensionRefs are supported for Gateway API integration; any other kind is
+// reported as InvalidKind rather than skipped, so the route never serves traffic without it.
 func policiesFromHTTPRouteRuleExtensionRefs(ctx context.Context, c client.Client, route *gatewayv1.HTTPRoute, rule gatewayv1.HTTPRouteRule, ruleIdx int, log *zap.Logger) ([]apiv1.Policy, error) {
-	var merged []apiv1.Policy
+	var refs []gatewayv1.LocalObjectReference
 	for _, f := range rule.Filters {
-		if f.Type != gatewayv1.HTTPRouteFilterExtensionRef || f.ExtensionRef == nil {
-			continue
+		if f.Type == gatewayv1.HTTPRouteFilterExtensionRef && f.ExtensionRef != nil {
+			refs = append(refs, *f.ExtensionRef)
 		}
-		ref := f.ExtensionRef
+	}
+	return policiesFromExtensionRefs(ctx, c, route, routeKindHTTPRoute, refs, ruleIdx, log)
+}
+
+func policiesFromExtensionRefs(ctx context.Context, c client.Client, route client.Object, routeKind string, refs []gatewayv1.LocalObjectReference, ruleIdx int, log *zap.Logger) ([]apiv1.Policy, error) {
+	var merged []apiv1.Policy
+	for _, ref := range refs {
 		name := strings.TrimSpace(string(ref.Name))
 		if name == "" {
-			return nil, newInvalidHTTPRouteConfigError("HTTPRoute rule filter ExtensionRef requires metadata.name")
+			return nil, newInvalidHTTPRouteConfigError("%s rule filter ExtensionRef requires metadata.name", routeKind)
 		}
-		switch string(ref.Kind) {
-		case "APIPolicy":
-			if string(ref.Group) != apiv1.GroupVersion.Group {
-				continue
-			}
-			pl, err := policiesFromAPIPolicyRef(ctx, c, route, name, log)
-			if err != nil {
-				return nil, err
-			}
-			if log != nil {
-				log.Debug("rule ExtensionRef merged policies",
-					zap.Int("ruleIndex", ruleIdx),
-					zap.String("refKind", "APIPolicy"),
-					zap.String("refName", name),
-					zap.Int("policyCount", len(pl)))
-			}
-			merged = append(merged, pl...)
-		default:
-			continue
+		if string(ref.Kind) != "APIPolicy" || string(ref.Group) != apiv1.GroupVersion.Group {
+			return nil, newUnsupportedHTTPRouteConfigError(gatewayv1.RouteReasonInvalidKind,
+				"rule[%d] ExtensionRef %s/%s %q is not supported; only %s/APIPolicy can be referenced",
+				ruleIdx, ref.Group, ref.Kind, name, apiv1.GroupVersion.Group)
+		}
+		pl, err := policiesFromAPIPolicyRef(ctx, c, route, routeKind, name, log)
+		if err != nil {
+			return nil, err
+		}
+		if log != nil {
+			log.Debug("rule ExtensionRef merged policies",
+				zap.Int("ruleIndex", ruleIdx),
+				zap.String("refKind", "APIPolicy"),
+				zap.String("refName", name),
+				zap.Int("policyCount", len(pl)))
 		}
+		merged = append(merged, pl...)
 	}
 	return merged, nil
 }
 
-func policiesFromAPIPolicyRef(ctx context.Context, c client.Client, route *gatewayv1.HTTPRoute, policyName string, log *zap.Logger) ([]apiv1.Policy, error) {
+func policiesFromAPIPolicyRef(ctx context.Context, c client.Client, route client.Object, routeKind, policyName string, log *zap.Logger) ([]apiv1.Policy, error) {
+	ns, routeName := route.GetNamespace(), route.GetName()
 	ap := &apiv1.APIPolicy{}
-	if err := c.Get(ctx, types.NamespacedName{Namespace: route.Namespace, Name: policyName}, ap); err != nil {
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ns, Name: policyName}, ap); err != nil {
 		if apierrors.IsNotFound(err) {
-			return nil, newTransientHTTPRouteConfigError("APIPolicy %s/%s not found: %w", route.Namespace, policyName, err)
+			return nil, newTransientHTTPRouteConfigError("APIPolicy %s/%s not found: %w", ns, policyName, err)
 		}
 		return nil, err
 	}
 	if ap.Spec.TargetRef != nil {
-		if !apiPolicyTargetRefMatchesHTTPRoute(ap, route) {
+		if !apiPolicyTargetRefMatchesRoute(ap, routeKind, ns, routeName) {
 			return nil, newInvalidHTTPRouteConfigError(
-				"APIPolicy %q spec.targetRef must name this HTTPRoute (%s/%s) with group %s kind HTTPRoute when targetRef is set",
-				policyName, route.Namespace, route.Name, gatewayv1.GroupName,
+				"APIPolicy %q spec.targetRef must name this %s (%s/%s) with group %s kind %s when targetRef is set",
+				policyName, routeKind, ns, routeName, gatewayv1.GroupName, routeKind,
 			)
 		}
 	}
@@ -200,8 +210,8 @@ func policiesFromAPIPolicyRef(ctx context.Context, c client.Client, route *gatew
 		return nil, newInvalidHTTPRouteConfigError("APIPolicy %q: %w", policyName, err)
 	}
 	if log != nil {
-		log.Debug("loaded policies from APIPolicy for HTTPRoute rule scope",
-			zap.String("namespace", route.Namespace),
+		log.Debug("loaded policies from APIPolicy for route rule scope",
+			zap.String("namespace", ns),
 			zap.String("apiPolicy", policyName),
 			zap.Int("policyCount", len(pols)))
 	}
@@ -26,9 +26,9 @@ import (
 )
 
 // ensureCrossNamespaceServiceReferenceGrant enforces Gateway API ReferenceGrant
-// for HTTPRoute → Service backends when the Service namespace differs from the route's namespace.
+// for route → Service backends when the Service namespace differs from the route's namespace.
 // ReferenceGrants must be defined in the Service namespace (the referent namespace).
-func ensureCrossNamespaceServiceReferenceGrant(ctx context.Context, c client.Client, routeNS, serviceNS, serviceName string) error {
+func ensureCrossNamespaceServiceReferenceGrant(ctx context.Context, c client.Client, routeKind, routeNS, serviceNS, serviceName string) error {
 	if serviceNS == routeNS {
 		return nil
 	}
@@ -37,17 +37,17 @@ func ensureCrossNamespaceServiceReferenceGrant(ctx context.Context, c client.Cli
 		return newTransientHTTPRouteConfigError("list ReferenceGrant in namespace %q: %w", serviceNS, err)
 	}
 	for i := range list.Items {
-		if referenceGrantAllowsHTTPRouteToService(&list.Items[i].Spec, routeNS, serviceName) {
+		if referenceGrantAllowsRouteToService(&list.Items[i].Spec, routeKind, routeNS, serviceName) {
 			return nil
 		}
 	}
 	return newTransientHTTPRouteConfigError(
-		"cross-namespace backend Service %s/%s: no ReferenceGrant in namespace %q allowing HTTPRoute from namespace %q to core/Service %q",
-		serviceNS, serviceName, serviceNS, routeNS, serviceName,
+		"cross-namespace backend Service %s/%s: no ReferenceGrant in namespace %q allowing %s from namespace %q to core/Service %q",
+		serviceNS, serviceName, serviceNS, routeKind, routeNS, serviceName,
 	)
 }
 
-func referenceGrantAllowsHTTPRouteToService(spec *gatewayv1beta1.ReferenceGrantSpec, routeNS, svcName string) bool {
+func referenceGrantAllowsRouteToService(spec *gatewayv1beta1.ReferenceGrantSpec, routeKind, routeNS, svcName string) bool {
 	if spec == nil {
 		return false
 	}
@@ -56,7 +56,7 @@ func referenceGrantAllowsHTTPRouteToService(spec *gatewayv1beta1.ReferenceGrantS
 		if string(f.Namespace) != routeNS {
 			continue
 		}
-		if string(f.Kind) != "HTTPRoute" {
+		if string(f.Kind) != routeKind {
 			continue
 		}
 		fg := string(f.Group)
@@ -0,0 +1,146 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gwconstants "github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+// apiKeyNameRegex mirrors the gateway-controller's API key name rule: lowercase
+// alphanumerics separated by single hyphens or underscores.
+var apiKeyNameRegex = regexp.MustCompile(`^[a-z0-9]+([_-][a-z0-9]+)*$`)
+
+// SetupApiKeyWebhookWithManager registers the ApiKey validating webhook.
+func SetupApiKeyWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&apiv1.ApiKey{}).
+		WithValidator(&ApiKeyCustomValidator{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-gateway-api-platform-wso2-com-v1alpha1-apikey,mutating=false,failurePolicy=fail,sideEffects=None,groups=gateway.api-platform.wso2.com,resources=apikeys,verbs=create;update,versions=v1alpha1,name=vapikey-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ApiKeyCustomValidator applies the gateway-controller's API key creation rules
+// to an ApiKey. Values sourced from a Secret are not read at admission time.
+type ApiKeyCustomValidator struct {
+	// now is overridden in tests.
+	now func() time.Time
+}
+
+var _ admission.CustomValidator = &ApiKeyCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *ApiKeyCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	apiKey, ok := obj.(*apiv1.ApiKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an ApiKey object but got %T", obj)
+	}
+	return nil, v.validate(apiKey, true)
+}
+
+// ValidateUpdate implements admission.CustomValidator. An expiry that has
+// since passed is not re-checked so existing keys can still be updated.
+func (v *ApiKeyCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldKey, ok := oldObj.(*apiv1.ApiKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an ApiKey object but got %T", oldObj)
+	}
+	apiKey, ok := newObj.(*apiv1.ApiKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an ApiKey object but got %T", newObj)
+	}
+	if skipUpdateValidation(apiKey, oldKey.Spec, apiKey.Spec) {
+		return nil, nil
+	}
+	expiryChanged := !apiKey.Spec.ExpiresAt.Equal(oldKey.Spec.ExpiresAt)
+	return nil, v.validate(apiKey, expiryChanged)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *ApiKeyCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *ApiKeyCustomValidator) validate(apiKey *apiv1.ApiKey, checkExpiry bool) error {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	name := apiKey.Name
+	switch {
+	case len(name) < gwconstants.APIKeyNameMinLength:
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), name,
+			fmt.Sprintf("API key name is too short (minimum %d characters required)", gwconstants.APIKeyNameMinLength)))
+	case len(name) > gwconstants.APIKeyNameMaxLength:
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), name,
+			fmt.Sprintf("API key name is too long (maximum %d characters allowed)", gwconstants.APIKeyNameMaxLength)))
+	case !apiKeyNameRegex.MatchString(name):
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), name,
+			"API key name must be lowercase alphanumeric with hyphens or underscores (no consecutive separators, cannot start/end with hyphen or underscore)"))
+	}
+
+	if strings.TrimSpace(apiKey.Spec.ParentRef.Name) == "" {
+		errs = append(errs, field.Required(specPath.Child("parentRef", "name"), "parent resource name is required"))
+	}
+
+	if displayName := apiKey.Spec.DisplayName; displayName != nil {
+		trimmed := strings.TrimSpace(*displayName)
+		if trimmed == "" {
+			errs = append(errs, field.Invalid(specPath.Child("displayName"), *displayName, "display name cannot be empty"))
+		} else if n := utf8.RuneCountInString(trimmed); n > gwconstants.DisplayNameMaxLength {
+			errs = append(errs, field.TooLong(specPath.Child("displayName"), field.OmitValueType{}, gwconstants.DisplayNameMaxLength))
+		}
+	}
+
+	if src := apiKey.Spec.ApiKey; src != nil && src.Value != nil {
+		valuePath := specPath.Child("apiKey", "value")
+		value := strings.TrimSpace(*src.Value)
+		switch {
+		case len(value) < gwconstants.DefaultMinAPIKeyLength:
+			errs = append(errs, field.Invalid(valuePath, field.OmitValueType{},
+				fmt.Sprintf("API key is too short (minimum %d characters required)", gwconstants.DefaultMinAPIKeyLength)))
+		case len(value) > gwconstants.DefaultMaxAPIKeyLength:
+			errs = append(errs, field.Invalid(valuePath, field.OmitValueType{},
+				fmt.Sprintf("API key is too long (maximum %d characters allowed)", gwconstants.DefaultMaxAPIKeyLength)))
+		}
+	}
+
+	if checkExpiry && apiKey.Spec.ExpiresAt != nil && apiKey.Spec.ExpiresAt.Time.Before(v.currentTime()) {
+		errs = append(errs, field.Invalid(specPath.Child("expiresAt"), apiKey.Spec.ExpiresAt.Format(time.RFC3339),
+			"API key expiration time must be in the future"))
+	}
+
+	return invalidError("ApiKey", apiKey.Name, errs)
+}
+
+func (v *ApiKeyCustomValidator) currentTime() time.Time {
+	if v.now != nil {
+		return v.now()
+	}
+	return time.Now()
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+func newApiKey() *apiv1.ApiKey {
+	return &apiv1.ApiKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "weather-key", Namespace: "default"},
+		Spec: apiv1.ApiKeySpec{
+			ParentRef:   apiv1.ApiKeyParentRef{Kind: "RestApi", Name: "weather"},
+			DisplayName: strPtr("Weather key"),
+			ApiKey:      &apiv1.SecretValueSource{Value: strPtr(strings.Repeat("k", 40))},
+		},
+	}
+}
+
+func TestApiKeyValidatorAcceptsValidSpec(t *testing.T) {
+	_, err := (&ApiKeyCustomValidator{}).ValidateCreate(context.Background(), newApiKey())
+	require.NoError(t, err)
+}
+
+func TestApiKeyValidatorReportsFieldErrors(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	v := &ApiKeyCustomValidator{now: func() time.Time { return now }}
+
+	key := newApiKey()
+	key.Name = "weather.key"
+	key.Spec.DisplayName = strPtr("  ")
+	key.Spec.ApiKey = &apiv1.SecretValueSource{Value: strPtr("too-short")}
+	expired := metav1.NewTime(now.Add(-time.Hour))
+	key.Spec.ExpiresAt = &expired
+
+	_, err := v.ValidateCreate(context.Background(), key)
+	fields := causeFields(t, err)
+	require.ElementsMatch(t, []string{
+		"metadata.name",
+		"spec.displayName",
+		"spec.apiKey.value",
+		"spec.expiresAt",
+	}, fields)
+}
+
+func TestApiKeyValidatorAllowsUpdatesOfExpiredKeys(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	v := &ApiKeyCustomValidator{now: func() time.Time { return now }}
+
+	old := newApiKey()
+	expired := metav1.NewTime(now.Add(-time.Hour))
+	old.Spec.ExpiresAt = &expired
+
+	updated := old.DeepCopy()
+	updated.Spec.DisplayName = strPtr("Renamed key")
+	_, err := v.ValidateUpdate(context.Background(), old, updated)
+	require.NoError(t, err)
+
+	moved := old.DeepCopy()
+	earlier := metav1.NewTime(now.Add(-2 * time.Hour))
+	moved.Spec.ExpiresAt = &earlier
+	_, err = v.ValidateUpdate(context.Background(), old, moved)
+	require.Equal(t, []string{"spec.expiresAt"}, causeFields(t, err))
+}
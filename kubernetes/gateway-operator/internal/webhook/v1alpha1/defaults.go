@@ -0,0 +1,51 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "strings"
+
+// DefaultVersion is assigned to API-like resources created without spec.version.
+const DefaultVersion = "v1.0"
+
+// defaultVersion returns version, or DefaultVersion when it is blank.
+func defaultVersion(version string) string {
+	if strings.TrimSpace(version) == "" {
+		return DefaultVersion
+	}
+	return version
+}
+
+// defaultContext returns context with trailing slashes trimmed, or
+// "/<name>" when it is blank.
+func defaultContext(context, name string) string {
+	context = strings.TrimSpace(context)
+	if context == "" {
+		return "/" + name
+	}
+	if trimmed := strings.TrimRight(context, "/"); trimmed != "" {
+		return trimmed
+	}
+	return "/"
+}
+
+// optionalString returns nil for a nil or blank *string.
+func optionalString(s *string) *string {
+	if s == nil || strings.TrimSpace(*s) == "" {
+		return nil
+	}
+	return s
+}
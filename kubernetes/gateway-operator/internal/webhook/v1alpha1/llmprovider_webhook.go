@@ -0,0 +1,124 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gwapi "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	gwconfig "github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+// SetupLlmProviderWebhookWithManager registers the LlmProvider defaulting and validating webhooks.
+func SetupLlmProviderWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&apiv1.LlmProvider{}).
+		WithDefaulter(&LlmProviderCustomDefaulter{}).
+		WithValidator(&LlmProviderCustomValidator{validator: gwconfig.NewLLMValidator()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-gateway-api-platform-wso2-com-v1alpha1-llmprovider,mutating=true,failurePolicy=fail,sideEffects=None,groups=gateway.api-platform.wso2.com,resources=llmproviders,verbs=create;update,versions=v1alpha1,name=mllmprovider-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// LlmProviderCustomDefaulter fills in the context, version and vhost of an LlmProvider.
+type LlmProviderCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &LlmProviderCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+//
+//   - spec.version defaults to DefaultVersion.
+//   - trailing slashes are trimmed from spec.context; a blank context is
+//     dropped so the gateway serves the provider at its root path.
+//   - a blank spec.vhost is dropped.
+func (d *LlmProviderCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	provider, ok := obj.(*apiv1.LlmProvider)
+	if !ok {
+		return fmt.Errorf("expected an LlmProvider object but got %T", obj)
+	}
+
+	spec := &provider.Spec
+	spec.Version = defaultVersion(spec.Version)
+	if spec.Context = optionalString(spec.Context); spec.Context != nil {
+		normalized := defaultContext(*spec.Context, provider.Name)
+		spec.Context = &normalized
+	}
+	spec.Vhost = optionalString(spec.Vhost)
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-gateway-api-platform-wso2-com-v1alpha1-llmprovider,mutating=false,failurePolicy=fail,sideEffects=None,groups=gateway.api-platform.wso2.com,resources=llmproviders,verbs=create;update,versions=v1alpha1,name=vllmprovider-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// LlmProviderCustomValidator validates an LlmProvider with the gateway-controller's LLMValidator.
+type LlmProviderCustomValidator struct {
+	validator *gwconfig.LLMValidator
+}
+
+var _ admission.CustomValidator = &LlmProviderCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *LlmProviderCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *LlmProviderCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*apiv1.LlmProvider)
+	if !ok {
+		return nil, fmt.Errorf("expected an LlmProvider object but got %T", oldObj)
+	}
+	updated, ok := newObj.(*apiv1.LlmProvider)
+	if !ok {
+		return nil, fmt.Errorf("expected an LlmProvider object but got %T", newObj)
+	}
+	if skipUpdateValidation(updated, old.Spec, updated.Spec) {
+		return nil, nil
+	}
+	return nil, v.validate(updated)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *LlmProviderCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *LlmProviderCustomValidator) validate(obj runtime.Object) error {
+	provider, ok := obj.(*apiv1.LlmProvider)
+	if !ok {
+		return fmt.Errorf("expected an LlmProvider object but got %T", obj)
+	}
+
+	var authValue *apiv1.SecretValueSource
+	if provider.Spec.Upstream.Auth != nil {
+		authValue = &provider.Spec.Upstream.Auth.Value
+	}
+	spec, err := specWithUpstreamAuthValue(provider.Spec, authValue)
+	if err != nil {
+		return invalidSpecError("LlmProvider", provider.Name, err)
+	}
+
+	var payload gwapi.LLMProviderConfiguration
+	if err := parseManagementPayload("LlmProvider", provider, spec, &payload); err != nil {
+		return invalidSpecError("LlmProvider", provider.Name, err)
+	}
+	return invalidError("LlmProvider", provider.Name, toFieldErrors(v.validator.Validate(&payload)))
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gwconfig "github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+func strPtr(s string) *string { return &s }
+
+func newLlmProvider() *apiv1.LlmProvider {
+	return &apiv1.LlmProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "openai", Namespace: "default"},
+		Spec: apiv1.LLMProviderConfigData{
+			DisplayName:   "OpenAI",
+			Version:       "v1.0",
+			Template:      "openai",
+			AccessControl: apiv1.LLMAccessControl{Mode: "allow_all"},
+			Upstream: apiv1.LLMProviderUpstream{
+				Url: strPtr("https://api.openai.com/v1"),
+				Auth: &apiv1.LLMUpstreamAuth{
+					Type:   "api-key",
+					Header: strPtr("Authorization"),
+					Value: apiv1.SecretValueSource{
+						ValueFrom: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "openai-credentials"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestLlmProviderValidatorAcceptsSecretSourcedAuth(t *testing.T) {
+	v := &LlmProviderCustomValidator{validator: gwconfig.NewLLMValidator()}
+	_, err := v.ValidateCreate(context.Background(), newLlmProvider())
+	require.NoError(t, err)
+}
+
+func TestLlmProviderValidatorReportsFieldErrors(t *testing.T) {
+	v := &LlmProviderCustomValidator{validator: gwconfig.NewLLMValidator()}
+	provider := newLlmProvider()
+	provider.Spec.Upstream.Url = strPtr("ftp://api.openai.com")
+	provider.Spec.Upstream.Auth.Header = nil
+	provider.Spec.Upstream.Auth.Value = apiv1.SecretValueSource{Value: strPtr("")}
+
+	_, err := v.ValidateCreate(context.Background(), provider)
+	fields := causeFields(t, err)
+	require.Contains(t, fields, "spec.upstream.url")
+	require.Contains(t, fields, "spec.upstream.auth.header")
+	require.Contains(t, fields, "spec.upstream.auth.value")
+}
+
+func TestLlmProviderDefaulter(t *testing.T) {
+	provider := newLlmProvider()
+	provider.Spec.Version = ""
+	provider.Spec.Context = strPtr("/openai/")
+	provider.Spec.Vhost = strPtr("")
+
+	require.NoError(t, (&LlmProviderCustomDefaulter{}).Default(context.Background(), provider))
+	require.Equal(t, DefaultVersion, provider.Spec.Version)
+	require.Equal(t, "/openai", *provider.Spec.Context)
+	require.Nil(t, provider.Spec.Vhost)
+
+	provider.Spec.Context = strPtr(" ")
+	require.NoError(t, (&LlmProviderCustomDefaulter{}).Default(context.Background(), provider))
+	require.Nil(t, provider.Spec.Context, "a blank context is left to the gateway default")
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gwapi "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	gwconfig "github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+// SetupMcpWebhookWithManager registers the Mcp defaulting and validating webhooks.
+func SetupMcpWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&apiv1.Mcp{}).
+		WithDefaulter(&McpCustomDefaulter{}).
+		WithValidator(&McpCustomValidator{validator: gwconfig.NewMCPValidator()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-gateway-api-platform-wso2-com-v1alpha1-mcp,mutating=true,failurePolicy=fail,sideEffects=None,groups=gateway.api-platform.wso2.com,resources=mcps,verbs=create;update,versions=v1alpha1,name=mmcp-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// McpCustomDefaulter fills in the context, version and vhost of an Mcp.
+type McpCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &McpCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+//
+//   - spec.version defaults to DefaultVersion.
+//   - a blank spec.vhost is dropped.
+//   - spec.context defaults to "/<metadata.name>" when no vhost is set, since
+//     the gateway needs either one to route the proxy; trailing slashes are trimmed.
+func (d *McpCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	mcp, ok := obj.(*apiv1.Mcp)
+	if !ok {
+		return fmt.Errorf("expected an Mcp object but got %T", obj)
+	}
+
+	spec := &mcp.Spec
+	spec.Version = defaultVersion(spec.Version)
+	spec.Vhost = optionalString(spec.Vhost)
+	spec.Context = optionalString(spec.Context)
+	switch {
+	case spec.Context != nil:
+		normalized := defaultContext(*spec.Context, mcp.Name)
+		spec.Context = &normalized
+	case spec.Vhost == nil:
+		normalized := defaultContext("", mcp.Name)
+		spec.Context = &normalized
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-gateway-api-platform-wso2-com-v1alpha1-mcp,mutating=false,failurePolicy=fail,sideEffects=None,groups=gateway.api-platform.wso2.com,resources=mcps,verbs=create;update,versions=v1alpha1,name=vmcp-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// McpCustomValidator validates an Mcp with the gateway-controller's MCPValidator.
+type McpCustomValidator struct {
+	validator *gwconfig.MCPValidator
+}
+
+var _ admission.CustomValidator = &McpCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *McpCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *McpCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*apiv1.Mcp)
+	if !ok {
+		return nil, fmt.Errorf("expected an Mcp object but got %T", oldObj)
+	}
+	updated, ok := newObj.(*apiv1.Mcp)
+	if !ok {
+		return nil, fmt.Errorf("expected an Mcp object but got %T", newObj)
+	}
+	if skipUpdateValidation(updated, old.Spec, updated.Spec) {
+		return nil, nil
+	}
+	return nil, v.validate(updated)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *McpCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *McpCustomValidator) validate(obj runtime.Object) error {
+	mcp, ok := obj.(*apiv1.Mcp)
+	if !ok {
+		return fmt.Errorf("expected an Mcp object but got %T", obj)
+	}
+
+	var authValue *apiv1.SecretValueSource
+	if mcp.Spec.Upstream.Auth != nil {
+		authValue = &mcp.Spec.Upstream.Auth.Value
+	}
+	spec, err := specWithUpstreamAuthValue(mcp.Spec, authValue)
+	if err != nil {
+		return invalidSpecError("Mcp", mcp.Name, err)
+	}
+
+	var payload gwapi.MCPProxyConfiguration
+	if err := parseManagementPayload("Mcp", mcp, spec, &payload); err != nil {
+		return invalidSpecError("Mcp", mcp.Name, err)
+	}
+	return invalidError("Mcp", mcp.Name, toFieldErrors(v.validator.Validate(&payload)))
+}
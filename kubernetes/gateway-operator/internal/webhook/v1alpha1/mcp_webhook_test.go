@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gwconfig "github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+func newMcp() *apiv1.Mcp {
+	return &apiv1.Mcp{
+		ObjectMeta: metav1.ObjectMeta{Name: "everything", Namespace: "default"},
+		Spec: apiv1.MCPProxyConfigData{
+			DisplayName: "Everything",
+			Version:     "v1.0",
+			Context:     strPtr("/everything"),
+			Upstream: apiv1.MCPUpstream{
+				Url: strPtr("http://mcp-server:3001"),
+			},
+		},
+	}
+}
+
+func TestMcpValidatorAcceptsValidSpec(t *testing.T) {
+	v := &McpCustomValidator{validator: gwconfig.NewMCPValidator()}
+	_, err := v.ValidateCreate(context.Background(), newMcp())
+	require.NoError(t, err)
+}
+
+func TestMcpValidatorReportsFieldErrors(t *testing.T) {
+	v := &McpCustomValidator{validator: gwconfig.NewMCPValidator()}
+	mcp := newMcp()
+	mcp.Spec.Context = nil
+	mcp.Spec.SpecVersion = strPtr("2023-01-01")
+
+	_, err := v.ValidateCreate(context.Background(), mcp)
+	fields := causeFields(t, err)
+	require.Contains(t, fields, "spec.vhost")
+	require.Contains(t, fields, "spec.specVersion")
+}
+
+func TestMcpDefaulter(t *testing.T) {
+	mcp := newMcp()
+	mcp.Spec.Version = ""
+	mcp.Spec.Context = nil
+
+	require.NoError(t, (&McpCustomDefaulter{}).Default(context.Background(), mcp))
+	require.Equal(t, DefaultVersion, mcp.Spec.Version)
+	require.Equal(t, "/everything", *mcp.Spec.Context)
+
+	// A vhost alone is enough to route the proxy, so no context is assigned.
+	withVhost := newMcp()
+	withVhost.Spec.Context = strPtr("")
+	withVhost.Spec.Vhost = strPtr("mcp.example.com")
+	require.NoError(t, (&McpCustomDefaulter{}).Default(context.Background(), withVhost))
+	require.Nil(t, withVhost.Spec.Context)
+
+	v := &McpCustomValidator{validator: gwconfig.NewMCPValidator()}
+	_, err := v.ValidateCreate(context.Background(), mcp)
+	require.NoError(t, err)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gwconfig "github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+	"github.com/wso2/api-platform/kubernetes/gateway-operator/internal/gatewayclient"
+)
+
+// secretValuePlaceholder stands in for credentials sourced from a Secret.
+// Admission never reads Secrets, so only the presence of the value is checked
+// here; the reconciler resolves the real value before deploying.
+const secretValuePlaceholder = "secret-value-resolved-at-deploy-time"
+
+// parseManagementPayload renders a CR the same way the reconciler does before
+// calling the gateway-controller and parses it with the gateway-controller's
+// own parser into target (a pointer to a management API type).
+func parseManagementPayload(kind string, obj metav1.Object, spec interface{}, target interface{}) error {
+	body, err := gatewayclient.BuildEnvelopeYAML(apiv1.GroupVersion.String(), kind,
+		gatewayclient.EnvelopeMetadata{
+			Name:        obj.GetName(),
+			Labels:      obj.GetLabels(),
+			Annotations: obj.GetAnnotations(),
+		}, spec)
+	if err != nil {
+		return fmt.Errorf("build payload: %w", err)
+	}
+	return gwconfig.NewParser().ParseAPIConfigYAML(body, target)
+}
+
+// specWithUpstreamAuthValue converts spec to a JSON map and replaces the
+// SecretValueSource at upstream.auth.value with a plain string, matching the
+// shape the gateway-controller expects. Inline values are kept so they are
+// validated as-is; Secret references use secretValuePlaceholder.
+func specWithUpstreamAuthValue(spec interface{}, value *apiv1.SecretValueSource) (map[string]interface{}, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("marshal spec: %w", err)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("spec to map: %w", err)
+	}
+	upstream, ok := m["upstream"].(map[string]interface{})
+	if !ok {
+		return m, nil
+	}
+	auth, ok := upstream["auth"].(map[string]interface{})
+	if !ok {
+		return m, nil
+	}
+	switch {
+	case value != nil && value.Value != nil:
+		auth["value"] = *value.Value
+	case value != nil && value.ValueFrom != nil:
+		auth["value"] = secretValuePlaceholder
+	default:
+		delete(auth, "value")
+	}
+	return m, nil
+}
+
+// toFieldErrors maps gateway-controller validation errors to field errors so
+// that kubectl reports them against the offending manifest fields.
+func toFieldErrors(errs []gwconfig.ValidationError) field.ErrorList {
+	list := make(field.ErrorList, 0, len(errs))
+	for _, e := range errs {
+		path := e.Field
+		// The gateway-controller validators report apiVersion mismatches as "version".
+		if path == "version" {
+			path = "apiVersion"
+		}
+		list = append(list, &field.Error{
+			Type:     field.ErrorTypeInvalid,
+			Field:    path,
+			BadValue: field.OmitValueType{},
+			Detail:   e.Message,
+		})
+	}
+	return list
+}
+
+// invalidError builds the admission error returned for a rejected CR, or nil
+// when errs is empty.
+func invalidError(kind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(apiv1.GroupVersion.WithKind(kind).GroupKind(), name, errs)
+}
+
+// invalidSpecError reports a spec that could not be rendered into a
+// gateway-controller payload.
+func invalidSpecError(kind, name string, err error) error {
+	return invalidError(kind, name, field.ErrorList{field.Invalid(field.NewPath("spec"), field.OmitValueType{}, err.Error())})
+}
+
+// skipUpdateValidation reports whether an update needs no validation: the
+// object is being deleted (e.g. its finalizer is being removed) or its spec
+// is unchanged, so objects admitted before the webhook existed stay manageable.
+func skipUpdateValidation(obj metav1.Object, oldSpec, newSpec interface{}) bool {
+	return obj.GetDeletionTimestamp() != nil || equality.Semantic.DeepEqual(oldSpec, newSpec)
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gwapi "github.com/wso2/api-platform/gateway/gateway-controller/pkg/api/management"
+	gwconfig "github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	gwconstants "github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+// SetupRestApiWebhookWithManager registers the RestApi defaulting and validating webhooks.
+func SetupRestApiWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&apiv1.RestApi{}).
+		WithDefaulter(&RestApiCustomDefaulter{}).
+		WithValidator(&RestApiCustomValidator{validator: gwconfig.NewAPIValidator()}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-gateway-api-platform-wso2-com-v1alpha1-restapi,mutating=true,failurePolicy=fail,sideEffects=None,groups=gateway.api-platform.wso2.com,resources=restapis,verbs=create;update,versions=v1alpha1,name=mrestapi-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// RestApiCustomDefaulter fills in the context, version and vhosts of a RestApi.
+type RestApiCustomDefaulter struct{}
+
+var _ admission.CustomDefaulter = &RestApiCustomDefaulter{}
+
+// Default implements admission.CustomDefaulter.
+//
+//   - spec.version defaults to DefaultVersion.
+//   - spec.context defaults to "/<metadata.name>"; trailing slashes are trimmed.
+//   - an empty spec.vhosts.main defaults to the gateway's default vhost and an
+//     empty spec.vhosts.sandbox is dropped.
+func (d *RestApiCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	restApi, ok := obj.(*apiv1.RestApi)
+	if !ok {
+		return fmt.Errorf("expected a RestApi object but got %T", obj)
+	}
+
+	spec := &restApi.Spec
+	spec.Version = defaultVersion(spec.Version)
+	spec.Context = defaultContext(spec.Context, restApi.Name)
+	if spec.Vhosts != nil {
+		if strings.TrimSpace(spec.Vhosts.Main) == "" {
+			spec.Vhosts.Main = gwconstants.VHostGatewayDefault
+		}
+		spec.Vhosts.Sandbox = optionalString(spec.Vhosts.Sandbox)
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-gateway-api-platform-wso2-com-v1alpha1-restapi,mutating=false,failurePolicy=fail,sideEffects=None,groups=gateway.api-platform.wso2.com,resources=restapis,verbs=create;update,versions=v1alpha1,name=vrestapi-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// RestApiCustomValidator validates a RestApi with the gateway-controller's APIValidator.
+type RestApiCustomValidator struct {
+	validator *gwconfig.APIValidator
+}
+
+var _ admission.CustomValidator = &RestApiCustomValidator{}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *RestApiCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *RestApiCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	old, ok := oldObj.(*apiv1.RestApi)
+	if !ok {
+		return nil, fmt.Errorf("expected a RestApi object but got %T", oldObj)
+	}
+	updated, ok := newObj.(*apiv1.RestApi)
+	if !ok {
+		return nil, fmt.Errorf("expected a RestApi object but got %T", newObj)
+	}
+	if skipUpdateValidation(updated, old.Spec, updated.Spec) {
+		return nil, nil
+	}
+	return nil, v.validate(updated)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *RestApiCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *RestApiCustomValidator) validate(obj runtime.Object) error {
+	restApi, ok := obj.(*apiv1.RestApi)
+	if !ok {
+		return fmt.Errorf("expected a RestApi object but got %T", obj)
+	}
+
+	var payload gwapi.RestAPI
+	if err := parseManagementPayload("RestApi", restApi, restApi.Spec, &payload); err != nil {
+		return invalidSpecError("RestApi", restApi.Name, err)
+	}
+	return invalidError("RestApi", restApi.Name, toFieldErrors(v.validator.Validate(&payload)))
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gwconfig "github.com/wso2/api-platform/gateway/gateway-controller/pkg/config"
+	gwconstants "github.com/wso2/api-platform/gateway/gateway-controller/pkg/constants"
+	apiv1 "github.com/wso2/api-platform/kubernetes/gateway-operator/api/v1alpha1"
+)
+
+func newRestApi() *apiv1.RestApi {
+	return &apiv1.RestApi{
+		ObjectMeta: metav1.ObjectMeta{Name: "weather", Namespace: "default"},
+		Spec: apiv1.APIConfigData{
+			DisplayName: "weather-api",
+			Version:     "v1.0",
+			Context:     "/weather",
+			Upstream: apiv1.UpstreamConfig{
+				Main: apiv1.Upstream{Url: "https://backend.example.com/api"},
+			},
+			Operations: []apiv1.Operation{
+				{Method: apiv1.OperationMethodGET, Path: "/forecast/{city}"},
+			},
+		},
+	}
+}
+
+// causeFields returns the field paths reported by an Invalid admission error.
+func causeFields(t *testing.T, err error) []string {
+	t.Helper()
+	require.Error(t, err)
+	require.True(t, apierrors.IsInvalid(err), "expected Invalid error, got %v", err)
+	var statusErr *apierrors.StatusError
+	require.True(t, errors.As(err, &statusErr))
+	var fields []string
+	for _, c := range statusErr.ErrStatus.Details.Causes {
+		fields = append(fields, c.Field)
+	}
+	return fields
+}
+
+func TestRestApiValidatorAcceptsValidSpec(t *testing.T) {
+	v := &RestApiCustomValidator{validator: gwconfig.NewAPIValidator()}
+	_, err := v.ValidateCreate(context.Background(), newRestApi())
+	require.NoError(t, err)
+}
+
+func TestRestApiValidatorReportsFieldErrors(t *testing.T) {
+	v := &RestApiCustomValidator{validator: gwconfig.NewAPIValidator()}
+	api := newRestApi()
+	api.Spec.Version = "version-one"
+	api.Spec.Operations[0].Path = "/forecast/{city"
+
+	_, err := v.ValidateCreate(context.Background(), api)
+	fields := causeFields(t, err)
+	require.Contains(t, fields, "spec.version")
+	require.Contains(t, fields, "spec.operations[0].path")
+}
+
+func TestRestApiValidatorSkipsUnchangedSpecAndDeletion(t *testing.T) {
+	v := &RestApiCustomValidator{validator: gwconfig.NewAPIValidator()}
+	invalid := newRestApi()
+	invalid.Spec.Version = "version-one"
+
+	// Finalizer bookkeeping on an object admitted before the webhook existed.
+	updated := invalid.DeepCopy()
+	updated.Finalizers = []string{"gateway.api-platform.wso2.com/finalizer"}
+	_, err := v.ValidateUpdate(context.Background(), invalid, updated)
+	require.NoError(t, err)
+
+	deleting := invalid.DeepCopy()
+	deleting.Spec.DisplayName = "renamed"
+	now := metav1.Now()
+	deleting.DeletionTimestamp = &now
+	_, err = v.ValidateUpdate(context.Background(), invalid, deleting)
+	require.NoError(t, err)
+
+	changed := invalid.DeepCopy()
+	changed.Spec.DisplayName = "renamed"
+	_, err = v.ValidateUpdate(context.Background(), invalid, changed)
+	require.Contains(t, causeFields(t, err), "spec.version")
+}
+
+func TestRestApiDefaulter(t *testing.T) {
+	api := newRestApi()
+	api.Spec.Version = ""
+	api.Spec.Context = ""
+	empty := " "
+	api.Spec.Vhosts = &apiv1.VhostConfig{Sandbox: &empty}
+
+	require.NoError(t, (&RestApiCustomDefaulter{}).Default(context.Background(), api))
+	require.Equal(t, DefaultVersion, api.Spec.Version)
+	require.Equal(t, "/weather", api.Spec.Context)
+	require.Equal(t, gwconstants.VHostGatewayDefault, api.Spec.Vhosts.Main)
+	require.Nil(t, api.Spec.Vhosts.Sandbox)
+
+	api.Spec.Context = "/weather/v1/"
+	require.NoError(t, (&RestApiCustomDefaulter{}).Default(context.Background(), api))
+	require.Equal(t, "/weather/v1", api.Spec.Context)
+
+	// Defaulted objects pass validation.
+	v := &RestApiCustomValidator{validator: gwconfig.NewAPIValidator()}
+	_, err := v.ValidateCreate(context.Background(), api)
+	require.NoError(t, err)
+}
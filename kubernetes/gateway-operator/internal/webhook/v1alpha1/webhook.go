@@ -0,0 +1,50 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 implements the admission webhooks for the
+// gateway.api-platform.wso2.com/v1alpha1 management-API CRDs.
+//
+// Validating webhooks render each CR into the payload the reconciler sends to
+// the gateway-controller and run the gateway-controller's own validators on it,
+// so invalid manifests are rejected by kubectl with field-level errors instead
+// of surfacing later as a status condition. Defaulting webhooks fill in the
+// context, version and vhost fields.
+package v1alpha1
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// SetupWebhooksWithManager registers every admission webhook with the manager's webhook server.
+func SetupWebhooksWithManager(mgr ctrl.Manager) error {
+	webhooks := []struct {
+		kind  string
+		setup func(ctrl.Manager) error
+	}{
+		{"RestApi", SetupRestApiWebhookWithManager},
+		{"LlmProvider", SetupLlmProviderWebhookWithManager},
+		{"Mcp", SetupMcpWebhookWithManager},
+		{"ApiKey", SetupApiKeyWebhookWithManager},
+	}
+	for _, wh := range webhooks {
+		if err := wh.setup(mgr); err != nil {
+			return fmt.Errorf("set up %s webhook: %w", wh.kind, err)
+		}
+	}
+	return nil
+}
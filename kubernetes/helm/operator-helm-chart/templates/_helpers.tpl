@@ -40,6 +40,17 @@ configured image.repository:tag.
 {{- end -}}
 {{- end -}}
 
+{{- /*
+Names of the admission webhook Service and serving-certificate Secret.
+*/ -}}
+{{- define "gateway-operator.webhookServiceName" -}}
+{{- printf "%s-webhook" (include "gateway-operator.fullname" .) | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{- define "gateway-operator.webhookCertSecretName" -}}
+{{- printf "%s-webhook-cert" (include "gateway-operator.fullname" .) | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
 {{- /*
 Return pod-level securityContext YAML block according to values and debug flag.
 This helper prints the inner keys only; caller should indent appropriately.
@@ -261,6 +272,7 @@ Common RBAC rules shared between ClusterRole (global) and Role (scoped)
   - gateway.networking.k8s.io
   resources:
   - httproutes
+  - grpcroutes
   verbs:
   - get
   - list
@@ -271,6 +283,7 @@ Common RBAC rules shared between ClusterRole (global) and Role (scoped)
   - gateway.networking.k8s.io
   resources:
   - httproutes/status
+  - grpcroutes/status
   verbs:
   - get
   - patch
@@ -279,6 +292,7 @@ Common RBAC rules shared between ClusterRole (global) and Role (scoped)
   - gateway.networking.k8s.io
   resources:
   - httproutes/finalizers
+  - grpcroutes/finalizers
   verbs:
   - update
 {{ include "gateway-operator.rbacRulesGatewayClass" . }}
@@ -291,3 +305,44 @@ Common RBAC rules shared between ClusterRole (global) and Role (scoped)
   - list
   - watch
 {{- end -}}
+
+{{- /*
+One admission webhook entry for a gateway.api-platform.wso2.com/v1alpha1 resource. Called with
+(dict "root" $ "kind" <lowercase kind> "plural" <resource> "mutating" <bool> "caBundle" <b64>).
+*/ -}}
+{{- define "gateway-operator.webhookEntry" -}}
+{{- $root := .root }}
+{{- $prefix := ternary "mutate" "validate" .mutating }}
+- name: {{ ternary "m" "v" .mutating }}{{ .kind }}-v1alpha1.kb.io
+  admissionReviewVersions:
+    - v1
+  clientConfig:
+    service:
+      name: {{ include "gateway-operator.webhookServiceName" $root }}
+      namespace: {{ $root.Release.Namespace }}
+      path: /{{ $prefix }}-gateway-api-platform-wso2-com-v1alpha1-{{ .kind }}
+    {{- if .caBundle }}
+    caBundle: {{ .caBundle }}
+    {{- end }}
+  failurePolicy: {{ $root.Values.webhook.failurePolicy }}
+  sideEffects: None
+  timeoutSeconds: {{ $root.Values.webhook.timeoutSeconds }}
+  {{- with $root.Values.watchNamespaces }}
+  namespaceSelector:
+    matchExpressions:
+      - key: kubernetes.io/metadata.name
+        operator: In
+        values:
+{{ toYaml . | indent 10 }}
+  {{- end }}
+  rules:
+    - apiGroups:
+        - gateway.api-platform.wso2.com
+      apiVersions:
+        - v1alpha1
+      operations:
+        - CREATE
+        - UPDATE
+      resources:
+        - {{ .plural }}
+{{- end }}
@@ -16,6 +16,13 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for APIDefinitionFormat.
+const (
+	APIDefinitionFormatHar     APIDefinitionFormat = "har"
+	APIDefinitionFormatOpenapi APIDefinitionFormat = "openapi"
+	APIDefinitionFormatPostman APIDefinitionFormat = "postman"
+)
+
 // Defines values for APIKeyItemStatus.
 const (
 	APIKeyItemStatusActive  APIKeyItemStatus = "active"
@@ -142,6 +149,12 @@ const (
 	GatewayResponseFunctionalityTypeRegular GatewayResponseFunctionalityType = "regular"
 )
 
+// Defines values for GitBindingAPISyncStatus.
+const (
+	GitBindingAPISyncStatusFAILED GitBindingAPISyncStatus = "FAILED"
+	GitBindingAPISyncStatusSYNCED GitBindingAPISyncStatus = "SYNCED"
+)
+
 // Defines values for GitRepoBranchesRequestProvider.
 const (
 	GitRepoBranchesRequestProviderBitbucket GitRepoBranchesRequestProvider = "bitbucket"
@@ -163,6 +176,14 @@ const (
 	Tree GitRepoItemType = "tree"
 )
 
+// Defines values for GitRepositoryBindingSyncStatus.
+const (
+	GitRepositoryBindingSyncStatusFAILED  GitRepositoryBindingSyncStatus = "FAILED"
+	GitRepositoryBindingSyncStatusPENDING GitRepositoryBindingSyncStatus = "PENDING"
+	GitRepositoryBindingSyncStatusSYNCED  GitRepositoryBindingSyncStatus = "SYNCED"
+	GitRepositoryBindingSyncStatusSYNCING GitRepositoryBindingSyncStatus = "SYNCING"
+)
+
 // Defines values for ImportAPIProjectRequestApiLifeCycleStatus.
 const (
 	ImportAPIProjectRequestApiLifeCycleStatusBLOCKED    ImportAPIProjectRequestApiLifeCycleStatus = "BLOCKED"
@@ -494,6 +515,12 @@ const (
 	RestApi     ListUserAPIKeysParamsType = "RestApi"
 )
 
+// Defines values for ExportRESTAPIOpenAPIParamsFormat.
+const (
+	ExportRESTAPIOpenAPIParamsFormatJson ExportRESTAPIOpenAPIParamsFormat = "json"
+	ExportRESTAPIOpenAPIParamsFormatYaml ExportRESTAPIOpenAPIParamsFormat = "yaml"
+)
+
 // Defines values for GetDeploymentsParamsStatus.
 const (
 	GetDeploymentsParamsStatusARCHIVED    GetDeploymentsParamsStatus = "ARCHIVED"
@@ -511,6 +538,16 @@ const (
 	REVOKED  ListSubscriptionsParamsStatus = "REVOKED"
 )
 
+// Defines values for ExportWebSubAPIAsyncAPIParamsFormat.
+const (
+	ExportWebSubAPIAsyncAPIParamsFormatJson ExportWebSubAPIAsyncAPIParamsFormat = "json"
+	ExportWebSubAPIAsyncAPIParamsFormatYaml ExportWebSubAPIAsyncAPIParamsFormat = "yaml"
+)
+
+// APIDefinitionFormat Format of an uploaded API definition. Postman collections (v2.1) and HAR files are converted to an
+// OpenAPI definition with inferred context, operations, upstream and JSON schemas.
+type APIDefinitionFormat string
+
 // APIKeyItem defines model for APIKeyItem.
 type APIKeyItem struct {
 	// AllowedTargets Comma-separated list of allowed gateways; 'ALL' means unrestricted
@@ -683,6 +720,9 @@ type Channel struct {
 	// Description Description of the channel
 	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
 
+	// Message Message delivered on a channel, as described by an AsyncAPI definition
+	Message *ChannelMessage `json:"message,omitempty" yaml:"message,omitempty"`
+
 	// Name Name of the channel
 	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
 
@@ -690,6 +730,18 @@ type Channel struct {
 	Request ChannelRequest `json:"request" yaml:"request"`
 }
 
+// ChannelMessage Message delivered on a channel, as described by an AsyncAPI definition
+type ChannelMessage struct {
+	// ContentType Content type of the message payload
+	ContentType *string `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+
+	// Name Name of the message
+	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Payload JSON schema of the message payload
+	Payload *map[string]interface{} `json:"payload,omitempty" yaml:"payload,omitempty"`
+}
+
 // ChannelRequest Request details for a channel within the Async API
 type ChannelRequest struct {
 	// Method Async method for the channel
@@ -847,6 +899,33 @@ type CreateGatewayRequest struct {
 // CreateGatewayRequestFunctionalityType Type of gateway functionality
 type CreateGatewayRequestFunctionalityType string
 
+// CreateGitRepositoryBindingRequest defines model for CreateGitRepositoryBindingRequest.
+type CreateGitRepositoryBindingRequest struct {
+	// AutoDeploy Deploy APIs that changed in a sync to the gateways in `gatewayIds`
+	AutoDeploy *bool `json:"autoDeploy,omitempty" yaml:"autoDeploy,omitempty"`
+
+	// Branch Branch to sync
+	Branch string `binding:"required" json:"branch" yaml:"branch"`
+
+	// GatewayIds Gateways that changed APIs are deployed to when `autoDeploy` is enabled
+	GatewayIds *[]openapi_types.UUID `json:"gatewayIds,omitempty" yaml:"gatewayIds,omitempty"`
+
+	// PollIntervalSeconds How often the branch is polled for new commits. 0 disables polling.
+	PollIntervalSeconds *int `json:"pollIntervalSeconds,omitempty" yaml:"pollIntervalSeconds,omitempty"`
+
+	// ProjectId Project whose APIs are kept in sync with the repository
+	ProjectId openapi_types.UUID `binding:"required" json:"projectId" yaml:"projectId"`
+
+	// RepoUrl URL of the Git repository (`https://`)
+	RepoUrl string `binding:"required" json:"repoUrl" yaml:"repoUrl"`
+
+	// RootPath Directory of the repository searched for API projects (defaults to the repository root)
+	RootPath *string `json:"rootPath,omitempty" yaml:"rootPath,omitempty"`
+
+	// WebhookSecret Secret used to authenticate push webhooks. Webhooks are rejected when no secret is set.
+	WebhookSecret *string `json:"webhookSecret,omitempty" yaml:"webhookSecret,omitempty"`
+}
+
 // CreateLLMProviderAPIKeyRequest defines model for CreateLLMProviderAPIKeyRequest.
 type CreateLLMProviderAPIKeyRequest struct {
 	// AllowedTargets Comma-separated list of gateways this key is valid for.
@@ -1359,6 +1438,32 @@ type GatewayStatusResponse struct {
 	Name *string `json:"name,omitempty" yaml:"name,omitempty"`
 }
 
+// GitBindingAPISync defines model for GitBindingAPISync.
+type GitBindingAPISync struct {
+	// ApiId Handle of the synced API. Absent when the API could not be created.
+	ApiId *string `json:"apiId,omitempty" yaml:"apiId,omitempty"`
+
+	// CommitSha Commit the API was last synced from
+	CommitSha *string `json:"commitSha,omitempty" yaml:"commitSha,omitempty"`
+
+	// Error Why the last sync of the API failed
+	Error *string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	// SourcePath Path of the API artifact relative to the repository root
+	SourcePath string                  `binding:"required" json:"sourcePath" yaml:"sourcePath"`
+	Status     GitBindingAPISyncStatus `binding:"required" json:"status" yaml:"status"`
+	SyncedAt   time.Time               `binding:"required" json:"syncedAt" yaml:"syncedAt"`
+}
+
+// GitBindingAPISyncStatus defines model for GitBindingAPISync.Status.
+type GitBindingAPISyncStatus string
+
+// GitBindingAPISyncListResponse defines model for GitBindingAPISyncListResponse.
+type GitBindingAPISyncListResponse struct {
+	Count int                 `binding:"required" json:"count" yaml:"count"`
+	List  []GitBindingAPISync `binding:"required" json:"list" yaml:"list"`
+}
+
 // GitRepoBranch defines model for GitRepoBranch.
 type GitRepoBranch struct {
 	// IsDefault Whether this branch is the default branch
@@ -1453,6 +1558,57 @@ type GitRepoItem struct {
 // GitRepoItemType Type of the item (file or directory)
 type GitRepoItemType string
 
+// GitRepositoryBinding defines model for GitRepositoryBinding.
+type GitRepositoryBinding struct {
+	// AutoDeploy Whether changed APIs are deployed to the gateways in `gatewayIds`
+	AutoDeploy bool `binding:"required" json:"autoDeploy" yaml:"autoDeploy"`
+
+	// Branch Branch that is synced
+	Branch     string               `binding:"required" json:"branch" yaml:"branch"`
+	CreatedAt  *time.Time           `json:"createdAt,omitempty" yaml:"createdAt,omitempty"`
+	GatewayIds []openapi_types.UUID `binding:"required" json:"gatewayIds" yaml:"gatewayIds"`
+
+	// Id Binding ID
+	Id openapi_types.UUID `binding:"required" json:"id" yaml:"id"`
+
+	// LastCommitSha Commit of the last completed sync
+	LastCommitSha *string `json:"lastCommitSha,omitempty" yaml:"lastCommitSha,omitempty"`
+
+	// LastSyncedAt Time of the last completed sync
+	LastSyncedAt *time.Time `json:"lastSyncedAt,omitempty" yaml:"lastSyncedAt,omitempty"`
+
+	// PollIntervalSeconds How often the branch is polled for new commits. 0 means polling is disabled.
+	PollIntervalSeconds int `binding:"required" json:"pollIntervalSeconds" yaml:"pollIntervalSeconds"`
+
+	// ProjectId Project whose APIs are kept in sync with the repository
+	ProjectId openapi_types.UUID `binding:"required" json:"projectId" yaml:"projectId"`
+
+	// RepoUrl URL of the Git repository
+	RepoUrl string `binding:"required" json:"repoUrl" yaml:"repoUrl"`
+
+	// RootPath Directory of the repository searched for API projects
+	RootPath string `binding:"required" json:"rootPath" yaml:"rootPath"`
+
+	// SyncError Errors of the last sync, if any
+	SyncError *string `json:"syncError,omitempty" yaml:"syncError,omitempty"`
+
+	// SyncStatus Status of the last sync. FAILED means at least one API or API project could not be synced.
+	SyncStatus GitRepositoryBindingSyncStatus `binding:"required" json:"syncStatus" yaml:"syncStatus"`
+	UpdatedAt  *time.Time                     `json:"updatedAt,omitempty" yaml:"updatedAt,omitempty"`
+
+	// WebhookSecretConfigured Whether a webhook secret is set. The secret itself is never returned.
+	WebhookSecretConfigured bool `binding:"required" json:"webhookSecretConfigured" yaml:"webhookSecretConfigured"`
+}
+
+// GitRepositoryBindingSyncStatus Status of the last sync. FAILED means at least one API or API project could not be synced.
+type GitRepositoryBindingSyncStatus string
+
+// GitRepositoryBindingListResponse defines model for GitRepositoryBindingListResponse.
+type GitRepositoryBindingListResponse struct {
+	Count int                    `binding:"required" json:"count" yaml:"count"`
+	List  []GitRepositoryBinding `binding:"required" json:"list" yaml:"list"`
+}
+
 // ImportAPIProjectRequest defines model for ImportAPIProjectRequest.
 type ImportAPIProjectRequest struct {
 	Api struct {
@@ -1522,6 +1678,29 @@ type ImportAPIProjectRequestApiLifeCycleStatus string
 // ImportAPIProjectRequestProvider Git provider (optional - will be auto-detected if not provided)
 type ImportAPIProjectRequestProvider string
 
+// ImportAsyncAPIRequest Multipart form data request for importing an AsyncAPI definition as a WebSub API. Either 'url' or
+// 'definition' must be provided to specify the AsyncAPI source, and 'api' is required to provide the
+// API details for creation. Details given in 'api' take precedence over those in the definition.
+type ImportAsyncAPIRequest struct {
+	// Api Form field containing JSON-encoded WebSub API details for the imported AsyncAPI.
+	// Required fields within the JSON: context, projectId. The name and version default to the
+	// title and version in the definition, and the id is generated from the name when omitted.
+	Api string `binding:"required" json:"api" yaml:"api"`
+
+	// Definition Form field for AsyncAPI definition file upload (YAML or JSON).
+	Definition *openapi_types.File `json:"definition,omitempty" yaml:"definition,omitempty"`
+
+	// Url Form field containing URL to fetch the AsyncAPI definition from.
+	Url   *string `json:"url,omitempty" yaml:"url,omitempty"`
+	union json.RawMessage
+}
+
+// ImportAsyncAPIRequest0 defines model for .
+type ImportAsyncAPIRequest0 = interface{}
+
+// ImportAsyncAPIRequest1 defines model for .
+type ImportAsyncAPIRequest1 = interface{}
+
 // ImportOpenAPIRequest Multipart form data request for importing OpenAPI definition. All fields are provided as form data.
 // Either 'url' or 'definition' must be provided to specify the OpenAPI source, and 'api' is required
 // to provide the API details for creation. If the OpenAPI definition is valid and successfully imported,
@@ -1536,6 +1715,10 @@ type ImportOpenAPIRequest struct {
 	// This should be provided as a file upload in the multipart form.
 	Definition *openapi_types.File `json:"definition,omitempty" yaml:"definition,omitempty"`
 
+	// Format Format of an uploaded API definition. Postman collections (v2.1) and HAR files are converted to an
+	// OpenAPI definition with inferred context, operations, upstream and JSON schemas.
+	Format *APIDefinitionFormat `json:"format,omitempty" yaml:"format,omitempty"`
+
 	// Url Form field containing URL to fetch the OpenAPI definition from.
 	Url   *string `json:"url,omitempty" yaml:"url,omitempty"`
 	union json.RawMessage
@@ -2080,6 +2263,10 @@ type OpenAPIValidationResponse struct {
 		Version  string   `binding:"required" json:"version" yaml:"version"`
 	} `json:"api,omitempty" yaml:"api,omitempty"`
 
+	// Definition OpenAPI definition (JSON) generated from a Postman collection or HAR file. Only present when the
+	// validated format is postman or har.
+	Definition *string `json:"definition,omitempty" yaml:"definition,omitempty"`
+
 	// Errors List of validation errors encountered
 	Errors *[]string `json:"errors,omitempty" yaml:"errors,omitempty"`
 
@@ -2885,6 +3072,30 @@ type UpdateGatewayRequest struct {
 	Properties *map[string]interface{} `json:"properties,omitempty" yaml:"properties,omitempty"`
 }
 
+// UpdateGitRepositoryBindingRequest defines model for UpdateGitRepositoryBindingRequest.
+type UpdateGitRepositoryBindingRequest struct {
+	// AutoDeploy Deploy APIs that changed in a sync to the gateways in `gatewayIds`
+	AutoDeploy *bool `json:"autoDeploy,omitempty" yaml:"autoDeploy,omitempty"`
+
+	// Branch Branch to sync
+	Branch string `binding:"required" json:"branch" yaml:"branch"`
+
+	// GatewayIds Gateways that changed APIs are deployed to when `autoDeploy` is enabled
+	GatewayIds *[]openapi_types.UUID `json:"gatewayIds,omitempty" yaml:"gatewayIds,omitempty"`
+
+	// PollIntervalSeconds How often the branch is polled for new commits. 0 disables polling.
+	PollIntervalSeconds *int `json:"pollIntervalSeconds,omitempty" yaml:"pollIntervalSeconds,omitempty"`
+
+	// RepoUrl URL of the Git repository (`https://`)
+	RepoUrl string `binding:"required" json:"repoUrl" yaml:"repoUrl"`
+
+	// RootPath Directory of the repository searched for API projects
+	RootPath *string `json:"rootPath,omitempty" yaml:"rootPath,omitempty"`
+
+	// WebhookSecret Secret used to authenticate push webhooks. An empty string removes the secret.
+	WebhookSecret *string `json:"webhookSecret,omitempty" yaml:"webhookSecret,omitempty"`
+}
+
 // UpdateProjectRequest defines model for UpdateProjectRequest.
 type UpdateProjectRequest struct {
 	// Description Description of the project
@@ -3045,6 +3256,10 @@ type ValidateOpenAPIRequest struct {
 	// Definition OpenAPI definition file upload (YAML or JSON)
 	Definition *openapi_types.File `json:"definition,omitempty" yaml:"definition,omitempty"`
 
+	// Format Format of an uploaded API definition. Postman collections (v2.1) and HAR files are converted to an
+	// OpenAPI definition with inferred context, operations, upstream and JSON schemas.
+	Format *APIDefinitionFormat `json:"format,omitempty" yaml:"format,omitempty"`
+
 	// Url URL to fetch the OpenAPI definition from
 	Url   *string `json:"url,omitempty" yaml:"url,omitempty"`
 	union json.RawMessage
@@ -3285,6 +3500,12 @@ type GetGatewayArtifactsParams struct {
 // GetGatewayArtifactsParamsArtifactType defines parameters for GetGatewayArtifacts.
 type GetGatewayArtifactsParamsArtifactType string
 
+// ListGitRepositoryBindingsParams defines parameters for ListGitRepositoryBindings.
+type ListGitRepositoryBindingsParams struct {
+	// ProjectId Only return the bindings of this project
+	ProjectId *openapi_types.UUID `form:"projectId,omitempty" json:"projectId,omitempty" yaml:"projectId,omitempty"`
+}
+
 // FetchGitRepoContentParams defines parameters for FetchGitRepoContent.
 type FetchGitRepoContentParams struct {
 	// Depth Limits the depth of directory traversal when fetching Git repository content.
@@ -3452,6 +3673,15 @@ type ListRESTAPIsParams struct {
 	ProjectId ProjectIdQ `form:"projectId" json:"projectId" yaml:"projectId"`
 }
 
+// ExportRESTAPIOpenAPIParams defines parameters for ExportRESTAPIOpenAPI.
+type ExportRESTAPIOpenAPIParams struct {
+	// Format Format of the exported definition
+	Format *ExportRESTAPIOpenAPIParamsFormat `form:"format,omitempty" json:"format,omitempty" yaml:"format,omitempty"`
+}
+
+// ExportRESTAPIOpenAPIParamsFormat defines parameters for ExportRESTAPIOpenAPI.
+type ExportRESTAPIOpenAPIParamsFormat string
+
 // ValidateRESTAPIParams defines parameters for ValidateRESTAPI.
 type ValidateRESTAPIParams struct {
 	// Identifier **API Identifier** to check for existence within the organization.
@@ -3555,6 +3785,15 @@ type ListWebSubAPIsParams struct {
 	Offset    *int   `form:"offset,omitempty" json:"offset,omitempty" yaml:"offset,omitempty"`
 }
 
+// ExportWebSubAPIAsyncAPIParams defines parameters for ExportWebSubAPIAsyncAPI.
+type ExportWebSubAPIAsyncAPIParams struct {
+	// Format Format of the generated definition
+	Format *ExportWebSubAPIAsyncAPIParamsFormat `form:"format,omitempty" json:"format,omitempty" yaml:"format,omitempty"`
+}
+
+// ExportWebSubAPIAsyncAPIParamsFormat defines parameters for ExportWebSubAPIAsyncAPI.
+type ExportWebSubAPIAsyncAPIParamsFormat string
+
 // GetWebSubAPIDeploymentsParams defines parameters for GetWebSubAPIDeployments.
 type GetWebSubAPIDeploymentsParams struct {
 	GatewayId *openapi_types.UUID `form:"gatewayId,omitempty" json:"gatewayId,omitempty" yaml:"gatewayId,omitempty"`
@@ -3597,6 +3836,12 @@ type CreateGatewayJSONRequestBody = CreateGatewayRequest
 // UpdateGatewayJSONRequestBody defines body for UpdateGateway for application/json ContentType.
 type UpdateGatewayJSONRequestBody = UpdateGatewayRequest
 
+// CreateGitRepositoryBindingJSONRequestBody defines body for CreateGitRepositoryBinding for application/json ContentType.
+type CreateGitRepositoryBindingJSONRequestBody = CreateGitRepositoryBindingRequest
+
+// UpdateGitRepositoryBindingJSONRequestBody defines body for UpdateGitRepositoryBinding for application/json ContentType.
+type UpdateGitRepositoryBindingJSONRequestBody = UpdateGitRepositoryBindingRequest
+
 // FetchGitRepoContentJSONRequestBody defines body for FetchGitRepoContent for application/json ContentType.
 type FetchGitRepoContentJSONRequestBody = GitRepoContentRequest
 
@@ -3705,6 +3950,9 @@ type ValidateOpenAPIMultipartRequestBody = ValidateOpenAPIRequest
 // CreateWebSubAPIJSONRequestBody defines body for CreateWebSubAPI for application/json ContentType.
 type CreateWebSubAPIJSONRequestBody = WebSubAPI
 
+// ImportWebSubAPIFromAsyncAPIMultipartRequestBody defines body for ImportWebSubAPIFromAsyncAPI for multipart/form-data ContentType.
+type ImportWebSubAPIFromAsyncAPIMultipartRequestBody = ImportAsyncAPIRequest
+
 // UpdateWebSubAPIJSONRequestBody defines body for UpdateWebSubAPI for application/json ContentType.
 type UpdateWebSubAPIJSONRequestBody = WebSubAPI
 
@@ -3720,6 +3968,128 @@ type PublishWebSubAPIToDevPortalJSONRequestBody = PublishToDevPortalRequest
 // UnpublishWebSubAPIFromDevPortalJSONRequestBody defines body for UnpublishWebSubAPIFromDevPortal for application/json ContentType.
 type UnpublishWebSubAPIFromDevPortalJSONRequestBody = UnpublishFromDevPortalRequest
 
+// AsImportAsyncAPIRequest0 returns the union data inside the ImportAsyncAPIRequest as a ImportAsyncAPIRequest0
+func (t ImportAsyncAPIRequest) AsImportAsyncAPIRequest0() (ImportAsyncAPIRequest0, error) {
+	var body ImportAsyncAPIRequest0
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromImportAsyncAPIRequest0 overwrites any union data inside the ImportAsyncAPIRequest as the provided ImportAsyncAPIRequest0
+func (t *ImportAsyncAPIRequest) FromImportAsyncAPIRequest0(v ImportAsyncAPIRequest0) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeImportAsyncAPIRequest0 performs a merge with any union data inside the ImportAsyncAPIRequest, using the provided ImportAsyncAPIRequest0
+func (t *ImportAsyncAPIRequest) MergeImportAsyncAPIRequest0(v ImportAsyncAPIRequest0) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsImportAsyncAPIRequest1 returns the union data inside the ImportAsyncAPIRequest as a ImportAsyncAPIRequest1
+func (t ImportAsyncAPIRequest) AsImportAsyncAPIRequest1() (ImportAsyncAPIRequest1, error) {
+	var body ImportAsyncAPIRequest1
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromImportAsyncAPIRequest1 overwrites any union data inside the ImportAsyncAPIRequest as the provided ImportAsyncAPIRequest1
+func (t *ImportAsyncAPIRequest) FromImportAsyncAPIRequest1(v ImportAsyncAPIRequest1) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeImportAsyncAPIRequest1 performs a merge with any union data inside the ImportAsyncAPIRequest, using the provided ImportAsyncAPIRequest1
+func (t *ImportAsyncAPIRequest) MergeImportAsyncAPIRequest1(v ImportAsyncAPIRequest1) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t ImportAsyncAPIRequest) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	object := make(map[string]json.RawMessage)
+	if t.union != nil {
+		err = json.Unmarshal(b, &object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	object["api"], err = json.Marshal(t.Api)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'api': %w", err)
+	}
+
+	if t.Definition != nil {
+		object["definition"], err = json.Marshal(t.Definition)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'definition': %w", err)
+		}
+	}
+
+	if t.Url != nil {
+		object["url"], err = json.Marshal(t.Url)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'url': %w", err)
+		}
+	}
+	b, err = json.Marshal(object)
+	return b, err
+}
+
+func (t *ImportAsyncAPIRequest) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	if err != nil {
+		return err
+	}
+	object := make(map[string]json.RawMessage)
+	err = json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["api"]; found {
+		err = json.Unmarshal(raw, &t.Api)
+		if err != nil {
+			return fmt.Errorf("error reading 'api': %w", err)
+		}
+	}
+
+	if raw, found := object["definition"]; found {
+		err = json.Unmarshal(raw, &t.Definition)
+		if err != nil {
+			return fmt.Errorf("error reading 'definition': %w", err)
+		}
+	}
+
+	if raw, found := object["url"]; found {
+		err = json.Unmarshal(raw, &t.Url)
+		if err != nil {
+			return fmt.Errorf("error reading 'url': %w", err)
+		}
+	}
+
+	return err
+}
+
 // AsImportOpenAPIRequest0 returns the union data inside the ImportOpenAPIRequest as a ImportOpenAPIRequest0
 func (t ImportOpenAPIRequest) AsImportOpenAPIRequest0() (ImportOpenAPIRequest0, error) {
 	var body ImportOpenAPIRequest0
@@ -19,6 +19,7 @@ package config
 
 import (
 	"fmt"
+	"os/exec"
 	"sync"
 
 	"github.com/kelseyhightower/envconfig"
@@ -50,6 +51,9 @@ type Server struct {
 
 	// Deployment configurations
 	Deployments Deployments `envconfig:"DEPLOYMENTS"`
+
+	// Git repository binding (GitOps) configurations
+	GitOps GitOps `envconfig:"GITOPS"`
 	// TLS configurations
 	TLS TLS `envconfig:"TLS"`
 
@@ -85,7 +89,7 @@ type TLS struct {
 type JWT struct {
 	SecretKey      string   `envconfig:"SECRET_KEY" default:"your-secret-key-change-in-production"`
 	Issuer         string   `envconfig:"ISSUER" default:"thunder"`
-	SkipPaths      []string `envconfig:"SKIP_PATHS" default:"/health,/metrics,/api/internal/v1/ws/gateways/connect,/api/internal/v1/apis,/api/internal/v1/llm-providers,/api/internal/v1/llm-proxies,/api/internal/v1/subscription-plans,/api/internal/v1/mcp-proxies,/api/internal/v1/gateways,/api/internal/v1/deployments,/api/internal/v1/artifacts,/api/internal/v1/websub-apis"`
+	SkipPaths      []string `envconfig:"SKIP_PATHS" default:"/health,/metrics,/api/internal/v1/ws/gateways/connect,/api/internal/v1/apis,/api/internal/v1/llm-providers,/api/internal/v1/llm-proxies,/api/internal/v1/subscription-plans,/api/internal/v1/mcp-proxies,/api/internal/v1/gateways,/api/internal/v1/deployments,/api/internal/v1/artifacts,/api/internal/v1/websub-apis,/api/v1/git-webhooks"`
 	SkipValidation bool     `envconfig:"SKIP_VALIDATION" default:"true"` // Skip signature validation for development
 }
 
@@ -162,6 +166,21 @@ type Deployments struct {
 	TimeoutDuration int  `envconfig:"TIMEOUT_DURATION" default:"60"` // seconds before a status is considered stale
 }
 
+// GitOps holds configuration for syncing projects from git repository bindings
+type GitOps struct {
+	// Poller settings. The poller wakes up every PollerInterval and syncs the bindings whose own
+	// poll interval has elapsed, so a binding is never polled more often than this.
+	PollerEnabled  bool `envconfig:"POLLER_ENABLED" default:"true"`
+	PollerInterval int  `envconfig:"POLLER_INTERVAL" default:"30"` // seconds between checks
+
+	// SyncTimeout bounds a single sync of a binding, including cloning the repository.
+	SyncTimeout int `envconfig:"SYNC_TIMEOUT" default:"300"` // seconds
+
+	// AllowLocalRepositories permits file:// repository URLs. Only enable it for development and tests,
+	// since it lets users read any git repository on the platform-api host.
+	AllowLocalRepositories bool `envconfig:"ALLOW_LOCAL_REPOSITORIES" default:"false"`
+}
+
 // APIKey holds API key-specific configuration
 type APIKey struct {
 	// HashingAlgorithms is the list of algorithms used to hash API keys before storage and broadcast.
@@ -196,6 +215,9 @@ func GetConfig() *Server {
 		if err == nil {
 			err = validateDeploymentsConfig(&settingInstance.Deployments)
 		}
+		if err == nil {
+			err = validateGitOpsConfig(&settingInstance.GitOps)
+		}
 	})
 	if err != nil {
 		panic(err)
@@ -262,3 +284,20 @@ func validateDeploymentsConfig(cfg *Deployments) error {
 	}
 	return nil
 }
+
+// validateGitOpsConfig validates git repository binding configuration.
+func validateGitOpsConfig(cfg *GitOps) error {
+	if cfg.PollerEnabled && cfg.PollerInterval <= 0 {
+		return fmt.Errorf("GITOPS_POLLER_INTERVAL must be a positive integer (got %d)", cfg.PollerInterval)
+	}
+	if cfg.SyncTimeout <= 0 {
+		return fmt.Errorf("GITOPS_SYNC_TIMEOUT must be a positive integer (got %d)", cfg.SyncTimeout)
+	}
+	// Bindings are synced with the git command line client; fail at startup rather than on every poll
+	if cfg.PollerEnabled {
+		if _, err := exec.LookPath("git"); err != nil {
+			return fmt.Errorf("GITOPS_POLLER_ENABLED requires the git executable on PATH: %w", err)
+		}
+	}
+	return nil
+}
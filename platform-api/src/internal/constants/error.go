@@ -55,6 +55,7 @@ var (
 
 var (
 	ErrAPINotFound                 = errors.New("api not found")
+	ErrAPIDefinitionNotFound       = errors.New("api has no stored definition")
 	ErrAPIAlreadyExists            = errors.New("api already exists in project")
 	ErrAPINameVersionAlreadyExists = errors.New("api with same name and version already exists")
 	ErrInvalidAPIContext           = errors.New("invalid api context format")
@@ -202,6 +203,15 @@ var (
 	ErrSubscriptionPlanAlreadyExists      = errors.New("subscription plan with this name already exists for the organization")
 )
 
+var (
+	// Git repository binding errors
+	ErrGitBindingNotFound         = errors.New("git repository binding not found")
+	ErrGitBindingExists           = errors.New("git repository binding already exists for this repository, branch and path")
+	ErrGitBindingSyncInProgress   = errors.New("git repository binding sync already in progress")
+	ErrInvalidGitRepositoryURL    = errors.New("invalid git repository URL")
+	ErrInvalidGitWebhookSignature = errors.New("invalid git webhook signature")
+)
+
 var (
 	// Gateway Internal API errors
 	ErrMissingAPIKey   = errors.New("API key is required")
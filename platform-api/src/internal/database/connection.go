@@ -176,6 +176,34 @@ func (db *DB) InitSchema(dbSchemaPath string, slogger *slog.Logger) error {
 		return fmt.Errorf("failed to initialize schema: %w", err)
 	}
 
+	return db.addSQLiteColumns()
+}
+
+// sqliteAddedColumns lists columns added to existing tables after their first release.
+// SQLite has no ADD COLUMN IF NOT EXISTS, so addSQLiteColumns adds them when missing.
+var sqliteAddedColumns = []struct {
+	table      string
+	column     string
+	definition string
+}{
+	{table: "rest_apis", column: "openapi_spec", definition: "TEXT"},
+}
+
+// addSQLiteColumns adds the columns in sqliteAddedColumns to tables created without them
+func (db *DB) addSQLiteColumns() error {
+	for _, c := range sqliteAddedColumns {
+		var count int
+		err := db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info(?) WHERE name = ?`, c.table, c.column).Scan(&count)
+		if err != nil {
+			return fmt.Errorf("failed to inspect columns of %s: %w", c.table, err)
+		}
+		if count > 0 {
+			continue
+		}
+		if _, err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", c.table, c.column, c.definition)); err != nil {
+			return fmt.Errorf("failed to add column %s.%s: %w", c.table, c.column, err)
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,66 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package database
+
+import (
+	"database/sql"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"testing"
+)
+
+func TestInitSchema_SQLiteAddsMissingColumns(t *testing.T) {
+	sqlDB, err := sql.Open(DriverSQLite, filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("Failed to open SQLite database: %v", err)
+	}
+	defer sqlDB.Close()
+	db := &DB{DB: sqlDB, driver: DriverSQLite}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	// A rest_apis table from before openapi_spec was added
+	_, err = db.Exec(`CREATE TABLE rest_apis (
+		uuid VARCHAR(40) PRIMARY KEY,
+		description VARCHAR(1023),
+		created_by VARCHAR(200),
+		project_uuid VARCHAR(40) NOT NULL,
+		lifecycle_status VARCHAR(20) DEFAULT 'CREATED',
+		transport VARCHAR(255),
+		configuration TEXT NOT NULL
+	)`)
+	if err != nil {
+		t.Fatalf("Failed to create legacy table: %v", err)
+	}
+
+	// Running the schema twice must succeed, the second time with the column present
+	for i := 0; i < 2; i++ {
+		if err := db.InitSchema("schema.sql", logger); err != nil {
+			t.Fatalf("InitSchema() run %d error = %v", i+1, err)
+		}
+	}
+
+	var count int
+	err = db.QueryRow(`SELECT COUNT(*) FROM pragma_table_info('rest_apis') WHERE name = 'openapi_spec'`).Scan(&count)
+	if err != nil {
+		t.Fatalf("Failed to inspect rest_apis: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("rest_apis.openapi_spec columns = %d, want 1", count)
+	}
+}
@@ -81,6 +81,7 @@ CREATE TABLE IF NOT EXISTS rest_apis (
     project_uuid VARCHAR(40) NOT NULL,
     lifecycle_status VARCHAR(20) DEFAULT 'CREATED',
     transport VARCHAR(255), -- JSON array as TEXT
+    openapi_spec TEXT, -- original OpenAPI definition, kept in sync with operations
     configuration TEXT NOT NULL,
     FOREIGN KEY (uuid) REFERENCES artifacts(uuid) ON DELETE CASCADE,
     FOREIGN KEY (project_uuid) REFERENCES projects(uuid) ON DELETE CASCADE
@@ -366,6 +367,47 @@ CREATE TABLE IF NOT EXISTS websub_apis (
 );
 CREATE INDEX IF NOT EXISTS idx_websub_apis_project ON websub_apis(project_uuid);
 
+-- Git repository bindings table (keeps the APIs of a project in sync with a git branch)
+-- webhook_secret: encrypted value (AES-256-GCM), never returned by the API (responses only flag that it is set)
+CREATE TABLE IF NOT EXISTS git_repository_bindings (
+    uuid VARCHAR(40) PRIMARY KEY,
+    organization_uuid VARCHAR(40) NOT NULL,
+    project_uuid VARCHAR(40) NOT NULL,
+    repo_url VARCHAR(1023) NOT NULL,
+    branch VARCHAR(255) NOT NULL,
+    root_path VARCHAR(1023) NOT NULL DEFAULT '',
+    poll_interval_seconds INTEGER NOT NULL DEFAULT 0,
+    webhook_secret TEXT,
+    auto_deploy BOOLEAN NOT NULL DEFAULT FALSE,
+    gateway_uuids TEXT NOT NULL DEFAULT '[]',
+    last_commit_sha VARCHAR(64),
+    last_synced_at DATETIME,
+    sync_status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    sync_error TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (organization_uuid) REFERENCES organizations(uuid) ON DELETE CASCADE,
+    FOREIGN KEY (project_uuid) REFERENCES projects(uuid) ON DELETE CASCADE,
+    UNIQUE(project_uuid, repo_url, branch, root_path)
+);
+
+-- Per-API sync state of a git repository binding, keyed by the artifact path inside the repository
+CREATE TABLE IF NOT EXISTS git_binding_api_syncs (
+    binding_uuid VARCHAR(40) NOT NULL,
+    source_path VARCHAR(1023) NOT NULL,
+    api_uuid VARCHAR(40),
+    commit_sha VARCHAR(64),
+    content_digest VARCHAR(64), -- sha256 of the artifact and OpenAPI definition, used to skip unchanged APIs
+    status VARCHAR(20) NOT NULL,
+    error TEXT,
+    synced_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (binding_uuid, source_path),
+    FOREIGN KEY (binding_uuid) REFERENCES git_repository_bindings(uuid) ON DELETE CASCADE,
+    FOREIGN KEY (api_uuid) REFERENCES artifacts(uuid) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_git_repository_bindings_project ON git_repository_bindings(project_uuid);
+CREATE INDEX IF NOT EXISTS idx_git_binding_api_syncs_api ON git_binding_api_syncs(api_uuid);
+
 -- API Keys table (stores API keys for artifacts with hashes as JSON string)
 CREATE TABLE IF NOT EXISTS api_keys (
     uuid VARCHAR(40) PRIMARY KEY,
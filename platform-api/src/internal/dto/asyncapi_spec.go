@@ -0,0 +1,63 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package dto
+
+// AsyncAPI 2.6 specification structs
+type AsyncAPI struct {
+	AsyncAPI           string                     `json:"asyncapi" yaml:"asyncapi"`
+	Info               AsyncAPIInfo               `json:"info" yaml:"info"`
+	Servers            map[string]AsyncAPIServer  `json:"servers,omitempty" yaml:"servers,omitempty"`
+	DefaultContentType string                     `json:"defaultContentType,omitempty" yaml:"defaultContentType,omitempty"`
+	Channels           map[string]AsyncAPIChannel `json:"channels" yaml:"channels"`
+}
+
+type AsyncAPIInfo struct {
+	Title       string           `json:"title" yaml:"title"`
+	Version     string           `json:"version" yaml:"version"`
+	Description string           `json:"description,omitempty" yaml:"description,omitempty"`
+	Contact     *AsyncAPIContact `json:"contact,omitempty" yaml:"contact,omitempty"`
+}
+
+type AsyncAPIContact struct {
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+}
+
+type AsyncAPIServer struct {
+	URL         string `json:"url" yaml:"url"`
+	Protocol    string `json:"protocol" yaml:"protocol"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+type AsyncAPIChannel struct {
+	Description string             `json:"description,omitempty" yaml:"description,omitempty"`
+	Subscribe   *AsyncAPIOperation `json:"subscribe,omitempty" yaml:"subscribe,omitempty"`
+	Publish     *AsyncAPIOperation `json:"publish,omitempty" yaml:"publish,omitempty"`
+}
+
+type AsyncAPIOperation struct {
+	OperationID string           `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Summary     string           `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Description string           `json:"description,omitempty" yaml:"description,omitempty"`
+	Message     *AsyncAPIMessage `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+type AsyncAPIMessage struct {
+	Name        string                 `json:"name,omitempty" yaml:"name,omitempty"`
+	ContentType string                 `json:"contentType,omitempty" yaml:"contentType,omitempty"`
+	Payload     map[string]interface{} `json:"payload,omitempty" yaml:"payload,omitempty"`
+}
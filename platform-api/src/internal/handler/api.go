@@ -579,6 +579,48 @@ func (h *APIHandler) GetAPIPublications(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// ExportOpenAPI handles GET /api/v1/rest-apis/:apiId/openapi and returns the OpenAPI definition of the API
+func (h *APIHandler) ExportOpenAPI(c *gin.Context) {
+	orgID, exists := middleware.GetOrganizationFromContext(c)
+	if !exists {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized",
+			"Organization claim not found in token"))
+		return
+	}
+
+	apiID := c.Param("apiId")
+	if apiID == "" {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request",
+			"API ID is required"))
+		return
+	}
+	format := strings.ToLower(strings.TrimSpace(c.DefaultQuery("format", string(api.ExportRESTAPIOpenAPIParamsFormatYaml))))
+
+	definition, err := h.apiService.ExportOpenAPI(apiID, format, orgID)
+	if err != nil {
+		if errors.Is(err, constants.ErrAPINotFound) {
+			c.JSON(http.StatusNotFound, utils.NewErrorResponse(404, "Not Found",
+				"API not found"))
+			return
+		}
+		if errors.Is(err, constants.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request",
+				err.Error()))
+			return
+		}
+		h.slogger.Error("Failed to export OpenAPI definition", "apiID", apiID, "organizationId", orgID, "error", err)
+		c.JSON(http.StatusInternalServerError, utils.NewErrorResponse(500, "Internal Server Error",
+			"Failed to export OpenAPI definition"))
+		return
+	}
+
+	contentType := "application/yaml"
+	if format == string(api.ExportRESTAPIOpenAPIParamsFormatJson) {
+		contentType = "application/json"
+	}
+	c.Data(http.StatusOK, contentType, definition)
+}
+
 // ImportAPIProject handles POST /api/v1/import/api-project
 func (h *APIHandler) ImportAPIProject(c *gin.Context) {
 	orgId, exists := middleware.GetOrganizationFromContext(c)
@@ -755,6 +797,14 @@ func (h *APIHandler) ValidateOpenAPI(c *gin.Context) {
 		req.Url = &url
 	}
 
+	format, ok := parseAPIDefinitionFormat(c.PostForm("format"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request",
+			"Invalid format, expected one of: openapi, postman, har"))
+		return
+	}
+	req.Format = &format
+
 	// Get definition file from form if provided
 	if file, header, err := c.Request.FormFile("definition"); err == nil {
 		definitionHeader = header
@@ -772,7 +822,7 @@ func (h *APIHandler) ValidateOpenAPI(c *gin.Context) {
 	}
 
 	// Validate OpenAPI definition
-	response, err := h.apiService.ValidateOpenAPIDefinition(req.Url, definitionHeader)
+	response, err := h.apiService.ValidateOpenAPIDefinition(req.Url, definitionHeader, format)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, utils.NewErrorResponse(500, "Internal Server Error",
 			"Failed to validate OpenAPI definition"))
@@ -808,6 +858,14 @@ func (h *APIHandler) ImportOpenAPI(c *gin.Context) {
 		req.Url = &url
 	}
 
+	format, ok := parseAPIDefinitionFormat(c.PostForm("format"))
+	if !ok {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request",
+			"Invalid format, expected one of: openapi, postman, har"))
+		return
+	}
+	req.Format = &format
+
 	// Get definition file from form if provided
 	if file, header, err := c.Request.FormFile("definition"); err == nil {
 		definitionHeader = header
@@ -863,7 +921,7 @@ func (h *APIHandler) ImportOpenAPI(c *gin.Context) {
 	}
 
 	// Import API from OpenAPI definition
-	apiResponse, err := h.apiService.ImportFromOpenAPI(&apiDetails, req.Url, definitionHeader, orgId)
+	apiResponse, err := h.apiService.ImportFromOpenAPI(&apiDetails, req.Url, definitionHeader, format, orgId)
 	if err != nil {
 		if errors.Is(err, constants.ErrAPIAlreadyExists) {
 			c.JSON(http.StatusConflict, utils.NewErrorResponse(409, "Conflict",
@@ -905,6 +963,11 @@ func (h *APIHandler) ImportOpenAPI(c *gin.Context) {
 				"Invalid transport protocol"))
 			return
 		}
+		if errors.Is(err, constants.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request",
+				err.Error()))
+			return
+		}
 		// Handle OpenAPI-specific errors
 		if strings.Contains(err.Error(), "failed to fetch OpenAPI from URL") {
 			c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request",
@@ -936,6 +999,18 @@ func (h *APIHandler) ImportOpenAPI(c *gin.Context) {
 	c.JSON(http.StatusCreated, apiResponse)
 }
 
+// parseAPIDefinitionFormat resolves the format form field of the OpenAPI validate and import endpoints,
+// defaulting to openapi
+func parseAPIDefinitionFormat(value string) (api.APIDefinitionFormat, bool) {
+	switch format := api.APIDefinitionFormat(strings.ToLower(strings.TrimSpace(value))); format {
+	case "":
+		return api.APIDefinitionFormatOpenapi, true
+	case api.APIDefinitionFormatOpenapi, api.APIDefinitionFormatPostman, api.APIDefinitionFormatHar:
+		return format, true
+	}
+	return "", false
+}
+
 // ValidateAPI handles GET /api/v1/rest-apis/validate
 func (h *APIHandler) ValidateAPI(c *gin.Context) {
 	orgId, exists := middleware.GetOrganizationFromContext(c)
@@ -1003,6 +1078,7 @@ func (h *APIHandler) RegisterRoutes(r *gin.Engine) {
 		apiGroup.POST("/:apiId/devportals/publish", h.PublishToDevPortal)
 		apiGroup.POST("/:apiId/devportals/unpublish", h.UnpublishFromDevPortal)
 		apiGroup.GET("/:apiId/publications", h.GetAPIPublications)
+		apiGroup.GET("/:apiId/openapi", h.ExportOpenAPI)
 	}
 	importGroup := r.Group("/api/v1/import")
 	{
@@ -18,6 +18,7 @@
 package handler
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -130,6 +131,50 @@ func (h *GatewayInternalAPIHandler) GetAPI(c *gin.Context) {
 	c.Data(http.StatusOK, "application/zip", zipData)
 }
 
+// GetAPIDefinition handles GET /api/internal/v1/apis/:apiId/definition
+func (h *GatewayInternalAPIHandler) GetAPIDefinition(c *gin.Context) {
+	orgID, gatewayID, ok := h.authenticateRequest(c)
+	if !ok {
+		return
+	}
+
+	apiID := c.Param("apiId")
+	if apiID == "" {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request",
+			"API ID is required"))
+		return
+	}
+
+	definition, err := h.gatewayInternalService.GetAPIDefinition(apiID, gatewayID, orgID)
+	if err != nil {
+		if errors.Is(err, constants.ErrAPINotFound) {
+			c.JSON(http.StatusNotFound, utils.NewErrorResponse(404, "Not Found",
+				"API not found"))
+			return
+		}
+		if errors.Is(err, constants.ErrAPIDefinitionNotFound) {
+			c.JSON(http.StatusNotFound, utils.NewErrorResponse(404, "Not Found",
+				"API has no stored definition"))
+			return
+		}
+		if errors.Is(err, constants.ErrDeploymentNotActive) {
+			c.JSON(http.StatusForbidden, utils.NewErrorResponse(403, "Forbidden",
+				"API is not associated with this gateway"))
+			return
+		}
+		h.slogger.Error("Failed to get API definition", "apiId", apiID, "gatewayId", gatewayID, "error", err)
+		c.JSON(http.StatusInternalServerError, utils.NewErrorResponse(500, "Internal Server Error",
+			"Failed to get API definition"))
+		return
+	}
+
+	contentType := "application/yaml"
+	if json.Valid([]byte(definition)) {
+		contentType = "application/json"
+	}
+	c.Data(http.StatusOK, contentType, []byte(definition))
+}
+
 // CreateGatewayDeployment handles POST /api/internal/v1/apis/{apiId}/gateway-deployments
 func (h *GatewayInternalAPIHandler) CreateGatewayDeployment(c *gin.Context) {
 	orgID, gatewayID, ok := h.authenticateRequest(c)
@@ -726,6 +771,7 @@ func (h *GatewayInternalAPIHandler) RegisterRoutes(r *gin.Engine) {
 	{
 		orgGroup.GET("/api-keys", h.GetRestAPIAPIKeys)
 		orgGroup.GET("/:apiId", h.GetAPI)
+		orgGroup.GET("/:apiId/definition", h.GetAPIDefinition)
 		orgGroup.POST("/:apiId/gateway-deployments", h.CreateGatewayDeployment)
 		orgGroup.GET("/:apiId/subscriptions", h.GetSubscriptions)
 	}
@@ -0,0 +1,238 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package handler
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"platform-api/src/api"
+	"platform-api/src/internal/constants"
+	"platform-api/src/internal/middleware"
+	"platform-api/src/internal/service"
+	"platform-api/src/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxGitWebhookPayloadSize bounds the push event payloads accepted from git providers
+const maxGitWebhookPayloadSize = 5 << 20
+
+// GitBindingHandler handles git repository binding routes and their push webhooks
+type GitBindingHandler struct {
+	gitBindingService *service.GitBindingService
+	slogger           *slog.Logger
+}
+
+// NewGitBindingHandler creates a new GitBindingHandler instance
+func NewGitBindingHandler(gitBindingService *service.GitBindingService, slogger *slog.Logger) *GitBindingHandler {
+	return &GitBindingHandler{
+		gitBindingService: gitBindingService,
+		slogger:           slogger,
+	}
+}
+
+// RegisterRoutes registers git repository binding routes
+func (h *GitBindingHandler) RegisterRoutes(r *gin.Engine) {
+	v1 := r.Group("/api/v1")
+	{
+		v1.POST("/git-bindings", h.CreateGitRepositoryBinding)
+		v1.GET("/git-bindings", h.ListGitRepositoryBindings)
+		v1.GET("/git-bindings/:bindingId", h.GetGitRepositoryBinding)
+		v1.PUT("/git-bindings/:bindingId", h.UpdateGitRepositoryBinding)
+		v1.DELETE("/git-bindings/:bindingId", h.DeleteGitRepositoryBinding)
+		v1.POST("/git-bindings/:bindingId/sync", h.SyncGitRepositoryBinding)
+		v1.GET("/git-bindings/:bindingId/apis", h.ListGitRepositoryBindingAPIs)
+		// Authenticated with the binding's webhook secret instead of a token
+		v1.POST("/git-webhooks/:bindingId", h.ReceiveGitRepositoryBindingWebhook)
+	}
+}
+
+// CreateGitRepositoryBinding handles POST /api/v1/git-bindings and starts the initial sync
+func (h *GitBindingHandler) CreateGitRepositoryBinding(c *gin.Context) {
+	orgID, ok := middleware.GetOrganizationFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Organization claim not found in token"))
+		return
+	}
+
+	var req api.CreateGitRepositoryBindingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.slogger.Error("Git repository binding request validation failed", "org_id", orgID, "error", err)
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "Invalid request body"))
+		return
+	}
+
+	resp, err := h.gitBindingService.CreateBinding(&req, orgID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	h.gitBindingService.TriggerSync(utils.OpenAPIUUIDToString(resp.Id))
+	c.JSON(http.StatusCreated, resp)
+}
+
+// ListGitRepositoryBindings handles GET /api/v1/git-bindings
+func (h *GitBindingHandler) ListGitRepositoryBindings(c *gin.Context) {
+	orgID, ok := middleware.GetOrganizationFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Organization claim not found in token"))
+		return
+	}
+
+	resp, err := h.gitBindingService.ListBindings(orgID, c.Query("projectId"))
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetGitRepositoryBinding handles GET /api/v1/git-bindings/:bindingId
+func (h *GitBindingHandler) GetGitRepositoryBinding(c *gin.Context) {
+	orgID, ok := middleware.GetOrganizationFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Organization claim not found in token"))
+		return
+	}
+
+	resp, err := h.gitBindingService.GetBinding(c.Param("bindingId"), orgID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UpdateGitRepositoryBinding handles PUT /api/v1/git-bindings/:bindingId
+func (h *GitBindingHandler) UpdateGitRepositoryBinding(c *gin.Context) {
+	orgID, ok := middleware.GetOrganizationFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Organization claim not found in token"))
+		return
+	}
+
+	var req api.UpdateGitRepositoryBindingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.slogger.Error("Git repository binding request validation failed", "org_id", orgID, "error", err)
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "Invalid request body"))
+		return
+	}
+
+	resp, err := h.gitBindingService.UpdateBinding(c.Param("bindingId"), &req, orgID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteGitRepositoryBinding handles DELETE /api/v1/git-bindings/:bindingId
+func (h *GitBindingHandler) DeleteGitRepositoryBinding(c *gin.Context) {
+	orgID, ok := middleware.GetOrganizationFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Organization claim not found in token"))
+		return
+	}
+
+	if err := h.gitBindingService.DeleteBinding(c.Param("bindingId"), orgID); err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SyncGitRepositoryBinding handles POST /api/v1/git-bindings/:bindingId/sync
+func (h *GitBindingHandler) SyncGitRepositoryBinding(c *gin.Context) {
+	orgID, ok := middleware.GetOrganizationFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Organization claim not found in token"))
+		return
+	}
+
+	resp, err := h.gitBindingService.SyncBinding(c.Param("bindingId"), orgID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListGitRepositoryBindingAPIs handles GET /api/v1/git-bindings/:bindingId/apis
+func (h *GitBindingHandler) ListGitRepositoryBindingAPIs(c *gin.Context) {
+	orgID, ok := middleware.GetOrganizationFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Organization claim not found in token"))
+		return
+	}
+
+	resp, err := h.gitBindingService.ListBindingAPIs(c.Param("bindingId"), orgID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ReceiveGitRepositoryBindingWebhook handles POST /api/v1/git-webhooks/:bindingId
+func (h *GitBindingHandler) ReceiveGitRepositoryBindingWebhook(c *gin.Context) {
+	payload, err := io.ReadAll(io.LimitReader(c.Request.Body, maxGitWebhookPayloadSize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "Failed to read request body"))
+		return
+	}
+
+	triggered, err := h.gitBindingService.HandleWebhook(c.Param("bindingId"),
+		c.GetHeader("X-Hub-Signature-256"), c.GetHeader("X-Gitlab-Token"), payload)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"syncTriggered": triggered})
+}
+
+func (h *GitBindingHandler) handleServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrInvalidInput), errors.Is(err, constants.ErrInvalidGitRepositoryURL):
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", err.Error()))
+	case errors.Is(err, constants.ErrProjectNotFound):
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "Project not found"))
+	case errors.Is(err, constants.ErrGitBindingNotFound):
+		c.JSON(http.StatusNotFound, utils.NewErrorResponse(404, "Not Found", "Git repository binding not found"))
+	case errors.Is(err, constants.ErrGitBindingExists):
+		c.JSON(http.StatusConflict, utils.NewErrorResponse(409, "Conflict",
+			"Project is already bound to this repository branch and root path"))
+	case errors.Is(err, constants.ErrGitBindingSyncInProgress):
+		c.JSON(http.StatusConflict, utils.NewErrorResponse(409, "Conflict", "A sync of this binding is already in progress"))
+	case errors.Is(err, constants.ErrInvalidGitWebhookSignature):
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Invalid webhook signature"))
+	default:
+		h.slogger.Error("Git repository binding service error", "error", err)
+		c.JSON(http.StatusInternalServerError, utils.NewErrorResponse(500, "Internal Server Error", "An unexpected error occurred"))
+	}
+}
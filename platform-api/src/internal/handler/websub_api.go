@@ -20,8 +20,10 @@
 package handler
 
 import (
+	"encoding/json"
 	"errors"
 	"log/slog"
+	"mime/multipart"
 	"net/http"
 	"strconv"
 	"strings"
@@ -54,10 +56,12 @@ func (h *WebSubAPIHandler) RegisterRoutes(r *gin.Engine) {
 	v1 := r.Group("/api/v1")
 	{
 		v1.POST("/websub-apis", h.CreateWebSubAPI)
+		v1.POST("/websub-apis/import", h.ImportWebSubAPI)
 		v1.GET("/websub-apis", h.ListWebSubAPIs)
 		v1.GET("/websub-apis/:apiId", h.GetWebSubAPI)
 		v1.PUT("/websub-apis/:apiId", h.UpdateWebSubAPI)
 		v1.DELETE("/websub-apis/:apiId", h.DeleteWebSubAPI)
+		v1.GET("/websub-apis/:apiId/asyncapi", h.ExportAsyncAPI)
 		v1.POST("/websub-apis/:apiId/devportals/publish", h.PublishToDevPortal)
 		v1.POST("/websub-apis/:apiId/devportals/unpublish", h.UnpublishFromDevPortal)
 	}
@@ -89,6 +93,63 @@ func (h *WebSubAPIHandler) CreateWebSubAPI(c *gin.Context) {
 	c.JSON(http.StatusCreated, resp)
 }
 
+// ImportWebSubAPI handles POST /api/v1/websub-apis/import and creates a WebSub API from an AsyncAPI definition
+func (h *WebSubAPIHandler) ImportWebSubAPI(c *gin.Context) {
+	orgID, ok := middleware.GetOrganizationFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Organization claim not found in token"))
+		return
+	}
+
+	if err := c.Request.ParseMultipartForm(10 << 20); err != nil { // 10 MB max
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "Failed to parse multipart form"))
+		return
+	}
+
+	var url *string
+	if v := c.PostForm("url"); v != "" {
+		url = &v
+	}
+	var definitionHeader *multipart.FileHeader
+	if file, header, err := c.Request.FormFile("definition"); err == nil {
+		definitionHeader = header
+		defer file.Close()
+	}
+	if url == nil && definitionHeader == nil {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "Either URL or definition file must be provided"))
+		return
+	}
+
+	apiJSON := c.PostForm("api")
+	if apiJSON == "" {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "API details are required"))
+		return
+	}
+	var apiDetails api.WebSubAPI
+	if err := json.Unmarshal([]byte(apiJSON), &apiDetails); err != nil {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "Invalid API details: "+err.Error()))
+		return
+	}
+	if apiDetails.ProjectId == "" {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "Project ID is required"))
+		return
+	}
+	if apiDetails.Context == nil || *apiDetails.Context == "" {
+		c.JSON(http.StatusBadRequest, utils.NewErrorResponse(400, "Bad Request", "API context is required"))
+		return
+	}
+
+	createdBy, _ := middleware.GetUsernameFromContext(c)
+
+	resp, err := h.websubAPIService.ImportFromAsyncAPI(orgID, createdBy, &apiDetails, url, definitionHeader)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
 // ListWebSubAPIs handles GET /api/v1/websub-apis
 func (h *WebSubAPIHandler) ListWebSubAPIs(c *gin.Context) {
 	orgID, ok := middleware.GetOrganizationFromContext(c)
@@ -186,6 +247,30 @@ func (h *WebSubAPIHandler) DeleteWebSubAPI(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
+// ExportAsyncAPI handles GET /api/v1/websub-apis/:apiId/asyncapi and returns an AsyncAPI definition of the API
+func (h *WebSubAPIHandler) ExportAsyncAPI(c *gin.Context) {
+	orgID, ok := middleware.GetOrganizationFromContext(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, utils.NewErrorResponse(401, "Unauthorized", "Organization claim not found in token"))
+		return
+	}
+
+	id := c.Param("apiId")
+	format := strings.ToLower(strings.TrimSpace(c.DefaultQuery("format", string(api.ExportWebSubAPIAsyncAPIParamsFormatYaml))))
+
+	definition, err := h.websubAPIService.ExportAsyncAPI(orgID, id, format)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	contentType := "application/yaml"
+	if format == string(api.ExportWebSubAPIAsyncAPIParamsFormatJson) {
+		contentType = "application/json"
+	}
+	c.Data(http.StatusOK, contentType, definition)
+}
+
 // PublishToDevPortal handles POST /api/v1/websub-apis/:apiId/devportals/publish
 func (h *WebSubAPIHandler) PublishToDevPortal(c *gin.Context) {
 	orgID, ok := middleware.GetOrganizationFromContext(c)
@@ -37,6 +37,7 @@ type API struct {
 	LifeCycleStatus string          `json:"lifeCycleStatus,omitempty" db:"lifecycle_status"`
 	Transport       []string        `json:"transport,omitempty" db:"transport"`
 	Channels        []Channel       `json:"channels,omitempty"`
+	OpenAPISpec     string          `json:"openapi,omitempty" db:"openapi_spec"`
 	Configuration   RestAPIConfig    `json:"configuration" db:"-"`
 }
 
@@ -76,9 +77,17 @@ type Operation struct {
 type Channel struct {
 	Name        string          `json:"name,omitempty"`
 	Description string          `json:"description,omitempty"`
+	Message     *ChannelMessage `json:"message,omitempty"`
 	Request     *ChannelRequest `json:"request,omitempty"`
 }
 
+// ChannelMessage represents the message delivered on a channel
+type ChannelMessage struct {
+	Name        string                 `json:"name,omitempty"`
+	ContentType string                 `json:"contentType,omitempty"`
+	Payload     map[string]interface{} `json:"payload,omitempty"`
+}
+
 // OperationRequest represents operation request details
 type OperationRequest struct {
 	Method   string   `json:"method,omitempty"`
@@ -0,0 +1,67 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package model
+
+import (
+	"time"
+)
+
+// GitSyncStatus represents the outcome of syncing a git repository binding or one of its APIs
+type GitSyncStatus string
+
+const (
+	GitSyncStatusPending GitSyncStatus = "PENDING"
+	GitSyncStatusSyncing GitSyncStatus = "SYNCING"
+	GitSyncStatusSynced  GitSyncStatus = "SYNCED"
+	GitSyncStatusFailed  GitSyncStatus = "FAILED"
+)
+
+// GitRepositoryBinding binds a project to a branch of a git repository. Every API project found
+// under RootPath is kept in sync with the APIs of the project.
+type GitRepositoryBinding struct {
+	UUID                string        `json:"id" db:"uuid"`
+	OrganizationUUID    string        `json:"organizationId" db:"organization_uuid"`
+	ProjectUUID         string        `json:"projectId" db:"project_uuid"`
+	RepoURL             string        `json:"repoUrl" db:"repo_url"`
+	Branch              string        `json:"branch" db:"branch"`
+	RootPath            string        `json:"rootPath" db:"root_path"`
+	PollIntervalSeconds int           `json:"pollIntervalSeconds" db:"poll_interval_seconds"`
+	WebhookSecret       string        `json:"-" db:"webhook_secret"` // stored encrypted
+	AutoDeploy          bool          `json:"autoDeploy" db:"auto_deploy"`
+	GatewayUUIDs        []string      `json:"gatewayIds" db:"gateway_uuids"`
+	LastCommitSHA       string        `json:"lastCommitSha,omitempty" db:"last_commit_sha"`
+	LastSyncedAt        *time.Time    `json:"lastSyncedAt,omitempty" db:"last_synced_at"`
+	SyncStatus          GitSyncStatus `json:"syncStatus" db:"sync_status"`
+	SyncError           string        `json:"syncError,omitempty" db:"sync_error"`
+	CreatedAt           time.Time     `json:"createdAt" db:"created_at"`
+	UpdatedAt           time.Time     `json:"updatedAt" db:"updated_at"`
+}
+
+// GitBindingAPISync records the last sync of a single API artifact of a git repository binding.
+// SourcePath is the artifact path relative to the repository root and identifies the API across syncs.
+type GitBindingAPISync struct {
+	BindingUUID   string        `json:"bindingId" db:"binding_uuid"`
+	SourcePath    string        `json:"sourcePath" db:"source_path"`
+	APIUUID       string        `json:"apiUuid,omitempty" db:"api_uuid"`
+	APIHandle     string        `json:"apiId,omitempty" db:"handle"` // populated via JOIN with artifacts
+	CommitSHA     string        `json:"commitSha,omitempty" db:"commit_sha"`
+	ContentDigest string        `json:"-" db:"content_digest"`
+	Status        GitSyncStatus `json:"status" db:"status"`
+	Error         string        `json:"error,omitempty" db:"error"`
+	SyncedAt      time.Time     `json:"syncedAt" db:"synced_at"`
+}
@@ -92,13 +92,13 @@ func (r *APIRepo) CreateAPI(api *model.API) error {
 	}
 
 	apiQuery := `
-		INSERT INTO rest_apis (uuid, description, created_by, project_uuid, lifecycle_status, transport, configuration)
-		VALUES (?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO rest_apis (uuid, description, created_by, project_uuid, lifecycle_status, transport, openapi_spec, configuration)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	_, err = tx.Exec(r.db.Rebind(apiQuery), api.ID, api.Description,
 		api.CreatedBy, api.ProjectID, api.LifeCycleStatus,
-		string(transportJSON), configurationJSON)
+		string(transportJSON), api.OpenAPISpec, configurationJSON)
 	if err != nil {
 		return err
 	}
@@ -113,18 +113,18 @@ func (r *APIRepo) GetAPIByUUID(apiUUID, orgUUID string) (*model.API, error) {
 	query := `
 		SELECT art.uuid, art.handle, art.name, art.kind, a.description, art.version, a.created_by,
 			a.project_uuid, art.organization_uuid, a.lifecycle_status,
-			a.transport, a.configuration, art.created_at, art.updated_at
+			a.transport, a.openapi_spec, a.configuration, art.created_at, art.updated_at
 		FROM rest_apis a INNER JOIN artifacts art
 		ON a.uuid = art.uuid
 		WHERE a.uuid = ? AND art.organization_uuid = ?
 	`
 
 	var transportJSON string
-	var configJSON sql.NullString
+	var openAPISpec, configJSON sql.NullString
 	err := r.db.QueryRow(r.db.Rebind(query), apiUUID, orgUUID).Scan(
 		&api.ID, &api.Handle, &api.Name, &api.Kind, &api.Description,
 		&api.Version, &api.CreatedBy, &api.ProjectID, &api.OrganizationID, &api.LifeCycleStatus,
-		&transportJSON, &configJSON,
+		&transportJSON, &openAPISpec, &configJSON,
 		&api.CreatedAt, &api.UpdatedAt)
 
 	if err != nil {
@@ -138,6 +138,7 @@ func (r *APIRepo) GetAPIByUUID(apiUUID, orgUUID string) (*model.API, error) {
 	if transportJSON != "" {
 		json.Unmarshal([]byte(transportJSON), &api.Transport)
 	}
+	api.OpenAPISpec = openAPISpec.String
 	if config, err := deserializeAPIConfigurations(configJSON); err != nil {
 		return nil, err
 	} else if config != nil {
@@ -412,12 +413,12 @@ func (r *APIRepo) UpdateAPI(api *model.API) error {
 	query := `
 		UPDATE rest_apis SET description = ?,
 			created_by = ?, lifecycle_status = ?,
-			transport = ?, configuration = ?
+			transport = ?, openapi_spec = ?, configuration = ?
 		WHERE uuid = ?
 	`
 	_, err = tx.Exec(r.db.Rebind(query), api.Description,
 		api.CreatedBy, api.LifeCycleStatus,
-		string(transportJSON), configurationJSON,
+		string(transportJSON), api.OpenAPISpec, configurationJSON,
 		api.ID)
 	if err != nil {
 		return err
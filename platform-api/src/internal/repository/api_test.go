@@ -190,6 +190,54 @@ func TestAPIRepo_Update(t *testing.T) {
 	}
 }
 
+func TestAPIRepo_OpenAPISpec(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	t.Cleanup(cleanup)
+
+	repo := NewAPIRepo(db)
+
+	orgUUID := "org-crud-spec"
+	projectUUID := "project-crud-spec"
+	createTestOrganizationAndProject(t, db, orgUUID, projectUUID)
+
+	api := &model.API{
+		Handle:          "spec-api",
+		Name:            "Spec API",
+		Version:         "1.0.0",
+		ProjectID:       projectUUID,
+		OrganizationID:  orgUUID,
+		LifeCycleStatus: "CREATED",
+		OpenAPISpec:     "openapi: 3.0.3\ninfo:\n  title: Spec API\n  version: 1.0.0\npaths: {}\n",
+		Configuration: model.RestAPIConfig{
+			Name:    "Spec API",
+			Version: "1.0.0",
+		},
+	}
+	if err := repo.CreateAPI(api); err != nil {
+		t.Fatalf("CreateAPI failed: %v", err)
+	}
+
+	created, err := repo.GetAPIByUUID(api.ID, orgUUID)
+	if err != nil {
+		t.Fatalf("GetAPIByUUID failed: %v", err)
+	}
+	if created.OpenAPISpec != api.OpenAPISpec {
+		t.Fatalf("OpenAPI definition not persisted: %q", created.OpenAPISpec)
+	}
+
+	api.OpenAPISpec = ""
+	if err := repo.UpdateAPI(api); err != nil {
+		t.Fatalf("UpdateAPI failed: %v", err)
+	}
+	updated, err := repo.GetAPIByUUID(api.ID, orgUUID)
+	if err != nil {
+		t.Fatalf("GetAPIByUUID failed: %v", err)
+	}
+	if updated.OpenAPISpec != "" {
+		t.Fatalf("OpenAPI definition not cleared: %q", updated.OpenAPISpec)
+	}
+}
+
 func TestAPIRepo_Delete(t *testing.T) {
 	db, cleanup := setupTestDB(t)
 	t.Cleanup(cleanup)
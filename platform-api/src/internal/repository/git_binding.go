@@ -0,0 +1,368 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"platform-api/src/internal/constants"
+	"platform-api/src/internal/database"
+	"platform-api/src/internal/model"
+	"platform-api/src/internal/utils"
+)
+
+const gitBindingColumns = `
+	uuid, organization_uuid, project_uuid, repo_url, branch, root_path, poll_interval_seconds,
+	webhook_secret, auto_deploy, gateway_uuids, last_commit_sha, last_synced_at, sync_status, sync_error,
+	created_at, updated_at`
+
+// GitRepositoryBindingRepo implements GitRepositoryBindingRepository
+type GitRepositoryBindingRepo struct {
+	db *database.DB
+}
+
+// NewGitRepositoryBindingRepo creates a new git repository binding repository
+func NewGitRepositoryBindingRepo(db *database.DB) GitRepositoryBindingRepository {
+	return &GitRepositoryBindingRepo{db: db}
+}
+
+// Create inserts a new git repository binding. The webhook secret is encrypted before it is stored.
+func (r *GitRepositoryBindingRepo) Create(binding *model.GitRepositoryBinding) error {
+	if binding.UUID == "" {
+		uuidStr, err := utils.GenerateUUID()
+		if err != nil {
+			return fmt.Errorf("failed to generate git repository binding ID: %w", err)
+		}
+		binding.UUID = uuidStr
+	}
+	now := time.Now().UTC()
+	binding.CreatedAt = now
+	binding.UpdatedAt = now
+	if binding.SyncStatus == "" {
+		binding.SyncStatus = model.GitSyncStatusPending
+	}
+
+	secret, err := encryptGitWebhookSecret(binding.WebhookSecret)
+	if err != nil {
+		return err
+	}
+	gatewaysJSON, err := marshalGatewayUUIDs(binding.GatewayUUIDs)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO git_repository_bindings (` + gitBindingColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err = r.db.Exec(r.db.Rebind(query),
+		binding.UUID, binding.OrganizationUUID, binding.ProjectUUID, binding.RepoURL, binding.Branch,
+		binding.RootPath, binding.PollIntervalSeconds, secret, binding.AutoDeploy, gatewaysJSON,
+		binding.LastCommitSHA, binding.LastSyncedAt, string(binding.SyncStatus), binding.SyncError,
+		binding.CreatedAt, binding.UpdatedAt)
+	if err != nil {
+		if isGitBindingUniqueViolation(err) {
+			return constants.ErrGitBindingExists
+		}
+		return fmt.Errorf("failed to create git repository binding: %w", err)
+	}
+	return nil
+}
+
+// GetByUUID retrieves a git repository binding by its UUID, or nil if it does not exist.
+// Callers must check that the binding belongs to the expected organization.
+func (r *GitRepositoryBindingRepo) GetByUUID(bindingUUID string) (*model.GitRepositoryBinding, error) {
+	query := `SELECT ` + gitBindingColumns + ` FROM git_repository_bindings WHERE uuid = ?`
+	binding, err := r.scanBinding(r.db.QueryRow(r.db.Rebind(query), bindingUUID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return binding, nil
+}
+
+// List retrieves the git repository bindings of an organization, optionally filtered by project
+func (r *GitRepositoryBindingRepo) List(orgUUID, projectUUID string) ([]*model.GitRepositoryBinding, error) {
+	query := `SELECT ` + gitBindingColumns + ` FROM git_repository_bindings WHERE organization_uuid = ?`
+	args := []interface{}{orgUUID}
+	if projectUUID != "" {
+		query += ` AND project_uuid = ?`
+		args = append(args, projectUUID)
+	}
+	query += ` ORDER BY created_at DESC`
+	return r.queryBindings(query, args...)
+}
+
+// ListPollable retrieves every git repository binding with a positive poll interval
+func (r *GitRepositoryBindingRepo) ListPollable() ([]*model.GitRepositoryBinding, error) {
+	query := `SELECT ` + gitBindingColumns + ` FROM git_repository_bindings WHERE poll_interval_seconds > 0`
+	return r.queryBindings(query)
+}
+
+// Update updates the configurable fields of a git repository binding, including its sync state
+func (r *GitRepositoryBindingRepo) Update(binding *model.GitRepositoryBinding) error {
+	binding.UpdatedAt = time.Now().UTC()
+
+	secret, err := encryptGitWebhookSecret(binding.WebhookSecret)
+	if err != nil {
+		return err
+	}
+	gatewaysJSON, err := marshalGatewayUUIDs(binding.GatewayUUIDs)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE git_repository_bindings
+		SET repo_url = ?, branch = ?, root_path = ?, poll_interval_seconds = ?, webhook_secret = ?,
+			auto_deploy = ?, gateway_uuids = ?, last_commit_sha = ?, sync_status = ?, sync_error = ?, updated_at = ?
+		WHERE uuid = ? AND organization_uuid = ?`
+	result, err := r.db.Exec(r.db.Rebind(query),
+		binding.RepoURL, binding.Branch, binding.RootPath, binding.PollIntervalSeconds, secret,
+		binding.AutoDeploy, gatewaysJSON, binding.LastCommitSHA, string(binding.SyncStatus), binding.SyncError,
+		binding.UpdatedAt, binding.UUID, binding.OrganizationUUID)
+	if err != nil {
+		if isGitBindingUniqueViolation(err) {
+			return constants.ErrGitBindingExists
+		}
+		return fmt.Errorf("failed to update git repository binding: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return constants.ErrGitBindingNotFound
+	}
+	return nil
+}
+
+// UpdateSyncStatus sets the sync status of a binding without touching the last synced commit
+func (r *GitRepositoryBindingRepo) UpdateSyncStatus(bindingUUID string, status model.GitSyncStatus, syncError string) error {
+	query := `UPDATE git_repository_bindings SET sync_status = ?, sync_error = ? WHERE uuid = ?`
+	_, err := r.db.Exec(r.db.Rebind(query), string(status), syncError, bindingUUID)
+	if err != nil {
+		return fmt.Errorf("failed to update git repository binding sync status: %w", err)
+	}
+	return nil
+}
+
+// UpdateSyncResult records the outcome of a sync of the given commit
+func (r *GitRepositoryBindingRepo) UpdateSyncResult(bindingUUID, commitSHA string, status model.GitSyncStatus,
+	syncError string, syncedAt time.Time) error {
+	query := `
+		UPDATE git_repository_bindings
+		SET last_commit_sha = ?, last_synced_at = ?, sync_status = ?, sync_error = ?
+		WHERE uuid = ?`
+	_, err := r.db.Exec(r.db.Rebind(query), commitSHA, syncedAt, string(status), syncError, bindingUUID)
+	if err != nil {
+		return fmt.Errorf("failed to update git repository binding sync result: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a git repository binding together with its per-API sync records.
+// The APIs created by the binding are left untouched.
+func (r *GitRepositoryBindingRepo) Delete(bindingUUID, orgUUID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(r.db.Rebind(`DELETE FROM git_binding_api_syncs WHERE binding_uuid = ?`), bindingUUID); err != nil {
+		return fmt.Errorf("failed to delete git binding API syncs: %w", err)
+	}
+	result, err := tx.Exec(r.db.Rebind(`DELETE FROM git_repository_bindings WHERE uuid = ? AND organization_uuid = ?`),
+		bindingUUID, orgUUID)
+	if err != nil {
+		return fmt.Errorf("failed to delete git repository binding: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return constants.ErrGitBindingNotFound
+	}
+	return tx.Commit()
+}
+
+// ListAPISyncs retrieves the per-API sync records of a binding, ordered by source path
+func (r *GitRepositoryBindingRepo) ListAPISyncs(bindingUUID string) ([]*model.GitBindingAPISync, error) {
+	query := `
+		SELECT s.binding_uuid, s.source_path, s.api_uuid, a.handle, s.commit_sha, s.content_digest, s.status, s.error, s.synced_at
+		FROM git_binding_api_syncs s
+		LEFT JOIN artifacts a ON a.uuid = s.api_uuid
+		WHERE s.binding_uuid = ?
+		ORDER BY s.source_path`
+	rows, err := r.db.Query(r.db.Rebind(query), bindingUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list git binding API syncs: %w", err)
+	}
+	defer rows.Close()
+
+	var syncs []*model.GitBindingAPISync
+	for rows.Next() {
+		var apiUUID, handle, commitSHA, digest, syncError sql.NullString
+		var status string
+		entry := &model.GitBindingAPISync{}
+		if err := rows.Scan(&entry.BindingUUID, &entry.SourcePath, &apiUUID, &handle, &commitSHA, &digest, &status,
+			&syncError, &entry.SyncedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan git binding API sync: %w", err)
+		}
+		entry.APIUUID = apiUUID.String
+		entry.APIHandle = handle.String
+		entry.CommitSHA = commitSHA.String
+		entry.ContentDigest = digest.String
+		entry.Status = model.GitSyncStatus(status)
+		entry.Error = syncError.String
+		syncs = append(syncs, entry)
+	}
+	return syncs, rows.Err()
+}
+
+// UpsertAPISync creates or replaces the sync record of an API artifact of a binding
+func (r *GitRepositoryBindingRepo) UpsertAPISync(entry *model.GitBindingAPISync) error {
+	if entry.SyncedAt.IsZero() {
+		entry.SyncedAt = time.Now().UTC()
+	}
+	var apiUUID interface{}
+	if entry.APIUUID != "" {
+		apiUUID = entry.APIUUID
+	}
+	query := `
+		INSERT INTO git_binding_api_syncs (binding_uuid, source_path, api_uuid, commit_sha, content_digest, status, error, synced_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (binding_uuid, source_path) DO UPDATE SET
+			api_uuid = excluded.api_uuid,
+			commit_sha = excluded.commit_sha,
+			content_digest = excluded.content_digest,
+			status = excluded.status,
+			error = excluded.error,
+			synced_at = excluded.synced_at`
+	_, err := r.db.Exec(r.db.Rebind(query), entry.BindingUUID, entry.SourcePath, apiUUID, entry.CommitSHA,
+		entry.ContentDigest, string(entry.Status), entry.Error, entry.SyncedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save git binding API sync: %w", err)
+	}
+	return nil
+}
+
+// DeleteAPISync deletes the sync record of an API artifact of a binding
+func (r *GitRepositoryBindingRepo) DeleteAPISync(bindingUUID, sourcePath string) error {
+	query := `DELETE FROM git_binding_api_syncs WHERE binding_uuid = ? AND source_path = ?`
+	if _, err := r.db.Exec(r.db.Rebind(query), bindingUUID, sourcePath); err != nil {
+		return fmt.Errorf("failed to delete git binding API sync: %w", err)
+	}
+	return nil
+}
+
+func (r *GitRepositoryBindingRepo) queryBindings(query string, args ...interface{}) ([]*model.GitRepositoryBinding, error) {
+	rows, err := r.db.Query(r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list git repository bindings: %w", err)
+	}
+	defer rows.Close()
+
+	var bindings []*model.GitRepositoryBinding
+	for rows.Next() {
+		binding, err := r.scanBinding(rows)
+		if err != nil {
+			return nil, err
+		}
+		bindings = append(bindings, binding)
+	}
+	return bindings, rows.Err()
+}
+
+func (r *GitRepositoryBindingRepo) scanBinding(row interface{ Scan(...any) error }) (*model.GitRepositoryBinding, error) {
+	binding := &model.GitRepositoryBinding{}
+	var secret, lastCommitSHA, syncError sql.NullString
+	var gatewaysJSON, status string
+	var lastSyncedAt sql.NullTime
+	err := row.Scan(&binding.UUID, &binding.OrganizationUUID, &binding.ProjectUUID, &binding.RepoURL, &binding.Branch,
+		&binding.RootPath, &binding.PollIntervalSeconds, &secret, &binding.AutoDeploy, &gatewaysJSON,
+		&lastCommitSHA, &lastSyncedAt, &status, &syncError, &binding.CreatedAt, &binding.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	binding.LastCommitSHA = lastCommitSHA.String
+	binding.SyncStatus = model.GitSyncStatus(status)
+	binding.SyncError = syncError.String
+	if lastSyncedAt.Valid {
+		t := lastSyncedAt.Time
+		binding.LastSyncedAt = &t
+	}
+	if gatewaysJSON != "" {
+		if err := json.Unmarshal([]byte(gatewaysJSON), &binding.GatewayUUIDs); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal gateway IDs of git repository binding: %w", err)
+		}
+	}
+	if secret.String != "" {
+		key, err := getSubscriptionTokenEncryptionKey()
+		if err != nil {
+			return nil, fmt.Errorf("git webhook secret decryption: %w", err)
+		}
+		binding.WebhookSecret, err = utils.DecryptSubscriptionToken(key, secret.String)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt git webhook secret: %w", err)
+		}
+	}
+	return binding, nil
+}
+
+// encryptGitWebhookSecret encrypts a webhook secret with the same key used for subscription tokens
+func encryptGitWebhookSecret(secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	key, err := getSubscriptionTokenEncryptionKey()
+	if err != nil {
+		return "", fmt.Errorf("git webhook secret encryption: %w", err)
+	}
+	encrypted, err := utils.EncryptSubscriptionToken(key, secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt git webhook secret: %w", err)
+	}
+	return encrypted, nil
+}
+
+func marshalGatewayUUIDs(gatewayUUIDs []string) (string, error) {
+	if gatewayUUIDs == nil {
+		gatewayUUIDs = []string{}
+	}
+	data, err := json.Marshal(gatewayUUIDs)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal gateway IDs: %w", err)
+	}
+	return string(data), nil
+}
+
+func isGitBindingUniqueViolation(err error) bool {
+	s := err.Error()
+	return (strings.Contains(s, "UNIQUE constraint failed") && strings.Contains(s, "git_repository_bindings")) ||
+		strings.Contains(s, "duplicate key value violates unique constraint") ||
+		strings.Contains(s, "23505")
+}
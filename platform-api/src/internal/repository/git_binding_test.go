@@ -0,0 +1,202 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package repository
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"platform-api/src/internal/constants"
+	"platform-api/src/internal/model"
+)
+
+func TestGitRepositoryBindingRepo_CRUD(t *testing.T) {
+	// Webhook secrets are encrypted with the subscription token key; the config is loaded on first use
+	t.Setenv("DATABASE_SUBSCRIPTION_TOKEN_ENCRYPTION_KEY", strings.Repeat("ab", 32))
+
+	db, cleanup := setupTestDB(t)
+	t.Cleanup(cleanup)
+
+	repo := NewGitRepositoryBindingRepo(db)
+
+	orgUUID := "org-git-001"
+	projectUUID := "project-git-001"
+	createTestOrganizationAndProject(t, db, orgUUID, projectUUID)
+
+	binding := &model.GitRepositoryBinding{
+		OrganizationUUID:    orgUUID,
+		ProjectUUID:         projectUUID,
+		RepoURL:             "https://example.com/org/apis.git",
+		Branch:              "main",
+		RootPath:            "apis",
+		PollIntervalSeconds: 60,
+		WebhookSecret:       "s3cret",
+		GatewayUUIDs:        []string{"gw-1", "gw-2"},
+	}
+	if err := repo.Create(binding); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if binding.UUID == "" {
+		t.Fatal("Create did not assign a UUID")
+	}
+
+	var storedSecret string
+	if err := db.QueryRow(`SELECT webhook_secret FROM git_repository_bindings WHERE uuid = ?`, binding.UUID).
+		Scan(&storedSecret); err != nil {
+		t.Fatalf("Failed to read stored secret: %v", err)
+	}
+	if storedSecret == "" || storedSecret == binding.WebhookSecret {
+		t.Fatalf("webhook secret not encrypted at rest: %q", storedSecret)
+	}
+
+	got, err := repo.GetByUUID(binding.UUID)
+	if err != nil {
+		t.Fatalf("GetByUUID failed: %v", err)
+	}
+	if got == nil {
+		t.Fatal("GetByUUID returned nil")
+	}
+	if got.WebhookSecret != "s3cret" || got.RootPath != "apis" || got.SyncStatus != model.GitSyncStatusPending {
+		t.Fatalf("unexpected binding: %+v", got)
+	}
+	if !reflect.DeepEqual(got.GatewayUUIDs, []string{"gw-1", "gw-2"}) {
+		t.Fatalf("unexpected gateways: %+v", got.GatewayUUIDs)
+	}
+
+	duplicate := *binding
+	duplicate.UUID = ""
+	if err := repo.Create(&duplicate); !errors.Is(err, constants.ErrGitBindingExists) {
+		t.Fatalf("expected ErrGitBindingExists, got %v", err)
+	}
+
+	syncedAt := time.Now().UTC()
+	if err := repo.UpdateSyncResult(binding.UUID, "abc123", model.GitSyncStatusSynced, "", syncedAt); err != nil {
+		t.Fatalf("UpdateSyncResult failed: %v", err)
+	}
+	got.PollIntervalSeconds = 0
+	got.WebhookSecret = ""
+	got.LastCommitSHA = "abc123"
+	got.SyncStatus = model.GitSyncStatusSynced
+	if err := repo.Update(got); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	updated, err := repo.GetByUUID(binding.UUID)
+	if err != nil {
+		t.Fatalf("GetByUUID failed: %v", err)
+	}
+	if updated.WebhookSecret != "" || updated.LastCommitSHA != "abc123" || updated.LastSyncedAt == nil {
+		t.Fatalf("unexpected updated binding: %+v", updated)
+	}
+
+	pollable, err := repo.ListPollable()
+	if err != nil {
+		t.Fatalf("ListPollable failed: %v", err)
+	}
+	if len(pollable) != 0 {
+		t.Fatalf("expected no pollable bindings, got %d", len(pollable))
+	}
+
+	list, err := repo.List(orgUUID, projectUUID)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("expected 1 binding, got %d", len(list))
+	}
+
+	if err := repo.Delete(binding.UUID, "other-org"); !errors.Is(err, constants.ErrGitBindingNotFound) {
+		t.Fatalf("expected ErrGitBindingNotFound, got %v", err)
+	}
+	if err := repo.Delete(binding.UUID, orgUUID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	deleted, err := repo.GetByUUID(binding.UUID)
+	if err != nil {
+		t.Fatalf("GetByUUID failed: %v", err)
+	}
+	if deleted != nil {
+		t.Fatal("binding not deleted")
+	}
+}
+
+func TestGitRepositoryBindingRepo_APISyncs(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	t.Cleanup(cleanup)
+
+	repo := NewGitRepositoryBindingRepo(db)
+
+	orgUUID := "org-git-002"
+	projectUUID := "project-git-002"
+	createTestOrganizationAndProject(t, db, orgUUID, projectUUID)
+
+	binding := &model.GitRepositoryBinding{
+		OrganizationUUID: orgUUID,
+		ProjectUUID:      projectUUID,
+		RepoURL:          "https://example.com/org/apis.git",
+		Branch:           "main",
+	}
+	if err := repo.Create(binding); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	entry := &model.GitBindingAPISync{
+		BindingUUID:   binding.UUID,
+		SourcePath:    "petstore/petstore.yaml",
+		CommitSHA:     "abc123",
+		ContentDigest: "digest-1",
+		Status:        model.GitSyncStatusFailed,
+		Error:         "malformed WSO2 artifact",
+	}
+	if err := repo.UpsertAPISync(entry); err != nil {
+		t.Fatalf("UpsertAPISync failed: %v", err)
+	}
+	entry.CommitSHA = "def456"
+	entry.ContentDigest = "digest-2"
+	entry.Status = model.GitSyncStatusSynced
+	entry.Error = ""
+	if err := repo.UpsertAPISync(entry); err != nil {
+		t.Fatalf("UpsertAPISync failed: %v", err)
+	}
+
+	syncs, err := repo.ListAPISyncs(binding.UUID)
+	if err != nil {
+		t.Fatalf("ListAPISyncs failed: %v", err)
+	}
+	if len(syncs) != 1 {
+		t.Fatalf("expected 1 sync record, got %d", len(syncs))
+	}
+	if syncs[0].CommitSHA != "def456" || syncs[0].ContentDigest != "digest-2" ||
+		syncs[0].Status != model.GitSyncStatusSynced || syncs[0].Error != "" || syncs[0].APIUUID != "" {
+		t.Fatalf("unexpected sync record: %+v", syncs[0])
+	}
+
+	if err := repo.DeleteAPISync(binding.UUID, entry.SourcePath); err != nil {
+		t.Fatalf("DeleteAPISync failed: %v", err)
+	}
+	syncs, err = repo.ListAPISyncs(binding.UUID)
+	if err != nil {
+		t.Fatalf("ListAPISyncs failed: %v", err)
+	}
+	if len(syncs) != 0 {
+		t.Fatalf("expected no sync records, got %d", len(syncs))
+	}
+}
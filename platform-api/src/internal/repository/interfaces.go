@@ -315,3 +315,18 @@ type CustomPolicyRepository interface {
 	InsertCustomPolicyUsage(policyUUID, apiUUID string) error
 	DeleteCustomPolicyUsage(policyUUID, apiUUID string) error
 }
+
+// GitRepositoryBindingRepository defines the interface for git repository binding persistence
+type GitRepositoryBindingRepository interface {
+	Create(binding *model.GitRepositoryBinding) error
+	GetByUUID(bindingUUID string) (*model.GitRepositoryBinding, error)
+	List(orgUUID, projectUUID string) ([]*model.GitRepositoryBinding, error)
+	ListPollable() ([]*model.GitRepositoryBinding, error)
+	Update(binding *model.GitRepositoryBinding) error
+	UpdateSyncStatus(bindingUUID string, status model.GitSyncStatus, syncError string) error
+	UpdateSyncResult(bindingUUID, commitSHA string, status model.GitSyncStatus, syncError string, syncedAt time.Time) error
+	Delete(bindingUUID, orgUUID string) error
+	ListAPISyncs(bindingUUID string) ([]*model.GitBindingAPISync, error)
+	UpsertAPISync(entry *model.GitBindingAPISync) error
+	DeleteAPISync(bindingUUID, sourcePath string) error
+}
@@ -51,14 +51,15 @@ import (
 )
 
 type Server struct {
-	router         *gin.Engine
-	orgRepo        repository.OrganizationRepository
-	projRepo       repository.ProjectRepository
-	apiRepo        repository.APIRepository
-	gatewayRepo    repository.GatewayRepository
-	wsManager      *websocket.Manager // WebSocket connection manager
-	timeoutService *service.DeploymentTimeoutService
-	logger         *slog.Logger
+	router            *gin.Engine
+	orgRepo           repository.OrganizationRepository
+	projRepo          repository.ProjectRepository
+	apiRepo           repository.APIRepository
+	gatewayRepo       repository.GatewayRepository
+	wsManager         *websocket.Manager // WebSocket connection manager
+	timeoutService    *service.DeploymentTimeoutService
+	gitBindingService *service.GitBindingService
+	logger            *slog.Logger
 }
 
 // StartPlatformAPIServer creates a new server instance with all dependencies initialized
@@ -99,6 +100,7 @@ func StartPlatformAPIServer(cfg *config.Server, slogger *slog.Logger) (*Server,
 	mcpProxyRepo := repository.NewMCPProxyRepo(db)
 	websubAPIRepo := repository.NewWebSubAPIRepo(db)
 	apiKeyRepo := repository.NewAPIKeyRepo(db)
+	gitBindingRepo := repository.NewGitRepositoryBindingRepo(db)
 
 	// Seed default LLM provider templates into the DB (per organization)
 	cfg.LLMTemplateDefinitionsPath = strings.TrimSpace(cfg.LLMTemplateDefinitionsPath)
@@ -242,6 +244,13 @@ func StartPlatformAPIServer(cfg *config.Server, slogger *slog.Logger) (*Server,
 		cfg,
 		slogger,
 	)
+	gitBindingService := service.NewGitBindingService(gitBindingRepo, projectRepo, gatewayRepo, apiService, deploymentService,
+		service.NewGitCLIFetcher(), service.GitBindingConfig{
+			PollerEnabled:          cfg.GitOps.PollerEnabled,
+			PollerInterval:         time.Duration(cfg.GitOps.PollerInterval) * time.Second,
+			SyncTimeout:            time.Duration(cfg.GitOps.SyncTimeout) * time.Second,
+			AllowLocalRepositories: cfg.GitOps.AllowLocalRepositories,
+		}, slogger)
 
 	// Initialize handlers
 	orgHandler := handler.NewOrganizationHandler(orgService, slogger)
@@ -268,6 +277,7 @@ func StartPlatformAPIServer(cfg *config.Server, slogger *slog.Logger) (*Server,
 	websubAPIHandler := handler.NewWebSubAPIHandler(websubAPIService, slogger)
 	websubAPIKeyHandler := handler.NewWebSubAPIKeyHandler(websubAPIService, apiKeyService, slogger)
 	websubAPIDeploymentHandler := handler.NewWebSubAPIDeploymentHandler(websubAPIDeploymentService, slogger)
+	gitBindingHandler := handler.NewGitBindingHandler(gitBindingService, slogger)
 	// Start deployment timeout background job
 	timeoutConfig := service.DeploymentTimeoutConfig{
 		Enabled:  cfg.Deployments.TimeoutEnabled,
@@ -329,6 +339,7 @@ func StartPlatformAPIServer(cfg *config.Server, slogger *slog.Logger) (*Server,
 	websubAPIHandler.RegisterRoutes(router)
 	websubAPIKeyHandler.RegisterRoutes(router)
 	websubAPIDeploymentHandler.RegisterRoutes(router)
+	gitBindingHandler.RegisterRoutes(router)
 	slogger.Info("Registered API routes successfully")
 
 	slogger.Info("WebSocket manager initialized",
@@ -339,14 +350,15 @@ func StartPlatformAPIServer(cfg *config.Server, slogger *slog.Logger) (*Server,
 	)
 
 	return &Server{
-		router:         router,
-		orgRepo:        orgRepo,
-		projRepo:       projectRepo,
-		apiRepo:        apiRepo,
-		gatewayRepo:    gatewayRepo,
-		wsManager:      wsManager,
-		timeoutService: timeoutService,
-		logger:         slogger,
+		router:            router,
+		orgRepo:           orgRepo,
+		projRepo:          projectRepo,
+		apiRepo:           apiRepo,
+		gatewayRepo:       gatewayRepo,
+		wsManager:         wsManager,
+		timeoutService:    timeoutService,
+		gitBindingService: gitBindingService,
+		logger:            slogger,
 	}, nil
 }
 
@@ -478,6 +490,7 @@ func (s *Server) Start(port string, certDir string) error {
 	defer cancel()
 
 	go s.timeoutService.Start(ctx)
+	go s.gitBindingService.Start(ctx)
 
 	errCh := make(chan error, 1)
 	go func() {
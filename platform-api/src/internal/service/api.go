@@ -86,6 +86,11 @@ func NewAPIService(apiRepo repository.APIRepository, projectRepo repository.Proj
 
 // CreateAPI creates a new API with validation and business logic
 func (s *APIService) CreateAPI(req *api.CreateRESTAPIRequest, orgUUID string) (*api.RESTAPI, error) {
+	return s.createAPI(req, orgUUID, "")
+}
+
+// createAPI creates an API and stores the OpenAPI definition it was imported from, if any
+func (s *APIService) createAPI(req *api.CreateRESTAPIRequest, orgUUID string, openAPISpec string) (*api.RESTAPI, error) {
 	// Validate request
 	if err := s.validateCreateAPIRequest(req, orgUUID); err != nil {
 		return nil, err
@@ -143,6 +148,13 @@ func (s *APIService) CreateAPI(req *api.CreateRESTAPIRequest, orgUUID string) (*
 
 	apiREST := s.createRequestToRESTAPI(req, handle)
 	apiModel := s.apiUtil.RESTAPIToModel(apiREST, orgUUID)
+	if openAPISpec != "" {
+		syncedSpec, err := s.apiUtil.SyncOpenAPIDefinitionOperations(openAPISpec, *req.Operations)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", constants.ErrInvalidInput, err)
+		}
+		apiModel.OpenAPISpec = syncedSpec
+	}
 	// Create API in repository (UUID is generated internally by CreateAPI)
 	if err := s.apiRepo.CreateAPI(apiModel); err != nil {
 		s.slogger.Error("Failed to create API in repository", "apiName", req.Name, "error", err)
@@ -259,6 +271,12 @@ func (s *APIService) GetAPIsByOrganization(orgUUID string, projectUUID string) (
 
 // UpdateAPI updates an existing API
 func (s *APIService) UpdateAPI(apiUUID string, req *api.UpdateRESTAPIRequest, orgUUID string) (*api.RESTAPI, error) {
+	return s.updateAPI(apiUUID, req, orgUUID, nil)
+}
+
+// updateAPI updates an existing API. When openAPISpec is set it replaces the stored OpenAPI
+// definition; otherwise the stored definition is kept and synced with the updated operations.
+func (s *APIService) updateAPI(apiUUID string, req *api.UpdateRESTAPIRequest, orgUUID string, openAPISpec *string) (*api.RESTAPI, error) {
 	if apiUUID == "" {
 		return nil, errors.New("API id is required")
 	}
@@ -284,6 +302,20 @@ func (s *APIService) UpdateAPI(apiUUID string, req *api.UpdateRESTAPIRequest, or
 	// Update API in repository
 	updatedAPIModel := s.apiUtil.RESTAPIToModel(updatedAPI, orgUUID)
 	updatedAPIModel.ID = apiUUID // Ensure UUID remains unchanged
+
+	// Keep the stored OpenAPI definition in sync with the operations
+	updatedAPIModel.OpenAPISpec = existingAPIModel.OpenAPISpec
+	if openAPISpec != nil {
+		updatedAPIModel.OpenAPISpec = *openAPISpec
+	}
+	if req.Operations != nil && updatedAPIModel.OpenAPISpec != "" {
+		syncedSpec, err := s.apiUtil.SyncOpenAPIDefinitionOperations(updatedAPIModel.OpenAPISpec, *req.Operations)
+		if err != nil {
+			s.slogger.Warn("Failed to sync stored OpenAPI definition with operations", "apiUUID", apiUUID, "error", err)
+		} else {
+			updatedAPIModel.OpenAPISpec = syncedSpec
+		}
+	}
 	if err := s.apiRepo.UpdateAPI(updatedAPIModel); err != nil {
 		return nil, err
 	}
@@ -914,7 +946,50 @@ func (s *APIService) ImportAPIProject(req *api.ImportAPIProjectRequest, orgUUID
 		createReq.Policies = &policies
 	}
 
-	return s.CreateAPI(createReq, orgUUID)
+	// Keep the project's OpenAPI definition when it is available; the API can be created without it
+	openAPISpec := ""
+	if apiConfig.OpenAPI != "" {
+		openAPIPath := pathpkg.Join(req.Path, pathpkg.Clean(apiConfig.OpenAPI))
+		content, err := gitService.FetchFileContent(req.RepoUrl, req.Branch, openAPIPath)
+		if err != nil {
+			s.slogger.Warn("Failed to fetch OpenAPI definition of API project", "path", openAPIPath, "error", err)
+		} else if err := s.apiUtil.ValidateOpenAPIDefinition(content); err != nil {
+			s.slogger.Warn("Ignoring invalid OpenAPI definition of API project", "path", openAPIPath, "error", err)
+		} else {
+			openAPISpec = string(content)
+		}
+	}
+
+	return s.createAPI(createReq, orgUUID, openAPISpec)
+}
+
+// updateAPIFromSource updates an API from a create request read from an API project, replacing its
+// OpenAPI definition. Version and context identify the deployed API and cannot change in place.
+func (s *APIService) updateAPIFromSource(apiUUID string, req *api.CreateRESTAPIRequest, orgUUID string, openAPISpec string) (*api.RESTAPI, error) {
+	existing, err := s.GetAPIByUUID(apiUUID, orgUUID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Version != existing.Version {
+		return nil, fmt.Errorf("%w: version cannot be changed from %s to %s; use a new metadata.name for the new version",
+			constants.ErrInvalidInput, existing.Version, req.Version)
+	}
+	if req.Context != existing.Context {
+		return nil, fmt.Errorf("%w: context cannot be changed from %s to %s", constants.ErrInvalidInput,
+			existing.Context, req.Context)
+	}
+	if req.Operations == nil || len(*req.Operations) == 0 {
+		defaultOperations := s.generateDefaultOperations()
+		req.Operations = &defaultOperations
+	}
+
+	// The project is the source of truth, so policies removed from it are removed from the API too
+	if req.Policies == nil {
+		req.Policies = &[]api.Policy{}
+	}
+
+	updateReq := s.createRequestToRESTAPI(req, utils.ValueOrEmpty(existing.Id))
+	return s.updateAPI(apiUUID, updateReq, orgUUID, &openAPISpec)
 }
 
 // ValidateAndRetrieveAPIProject validates an API project from Git repository with comprehensive checks
@@ -1131,8 +1206,9 @@ func (s *APIService) convertToAPIDevPortalResponse(dpd *model.APIDevPortalWithDe
 	return apiDevPortalResponse, nil
 }
 
-// ValidateOpenAPIDefinition validates an OpenAPI definition from multipart form data
-func (s *APIService) ValidateOpenAPIDefinition(url *string, definition *multipart.FileHeader) (*api.OpenAPIValidationResponse, error) {
+// ValidateOpenAPIDefinition validates an OpenAPI definition from multipart form data. Postman collections
+// and HAR files are converted first and the generated OpenAPI definition is returned with the response.
+func (s *APIService) ValidateOpenAPIDefinition(url *string, definition *multipart.FileHeader, format api.APIDefinitionFormat) (*api.OpenAPIValidationResponse, error) {
 	errorsList := make([]string, 0)
 	response := &api.OpenAPIValidationResponse{
 		IsRESTAPIDefinitionValid: false,
@@ -1177,6 +1253,27 @@ func (s *APIService) ValidateOpenAPIDefinition(url *string, definition *multipar
 		return response, nil
 	}
 
+	if isConvertedAPIDefinitionFormat(format) {
+		createReq, generated, err := s.convertAPIDefinition(content, format)
+		if err != nil {
+			errorsList = append(errorsList, err.Error())
+			response.Errors = &errorsList
+			return response, nil
+		}
+		if err := s.apiUtil.ValidateOpenAPIDefinition(generated); err != nil {
+			errorsList = append(errorsList, fmt.Sprintf("invalid generated OpenAPI definition: %s", err.Error()))
+			response.Errors = &errorsList
+			return response, nil
+		}
+		response.IsRESTAPIDefinitionValid = true
+		response.Api = s.restAPIToOpenAPIValidationAPI(s.createRequestToRESTAPI(createReq, ""))
+		response.Definition = utils.StringPtrIfNotEmpty(string(generated))
+		if len(errorsList) > 0 {
+			response.Errors = &errorsList
+		}
+		return response, nil
+	}
+
 	// Validate the OpenAPI definition
 	if err := s.apiUtil.ValidateOpenAPIDefinition(content); err != nil {
 		errorsList = append(errorsList, fmt.Sprintf("invalid OpenAPI definition: %s", err.Error()))
@@ -1202,8 +1299,9 @@ func (s *APIService) ValidateOpenAPIDefinition(url *string, definition *multipar
 	return response, nil
 }
 
-// ImportFromOpenAPI imports an API from an OpenAPI definition
-func (s *APIService) ImportFromOpenAPI(userAPI *api.RESTAPI, url *string, definition *multipart.FileHeader, orgId string) (*api.RESTAPI, error) {
+// ImportFromOpenAPI imports an API from an OpenAPI definition, or from a Postman collection or HAR file
+// converted to one
+func (s *APIService) ImportFromOpenAPI(userAPI *api.RESTAPI, url *string, definition *multipart.FileHeader, format api.APIDefinitionFormat, orgId string) (*api.RESTAPI, error) {
 	var content []byte
 	var err error
 	var errorList []string
@@ -1239,10 +1337,20 @@ func (s *APIService) ImportFromOpenAPI(userAPI *api.RESTAPI, url *string, defini
 		return nil, errors.New(strings.Join(errorList, "; "))
 	}
 
-	// Validate and parse the OpenAPI definition
-	apiDetails, err := s.apiUtil.ValidateAndParseOpenAPIToRESTAPI(content)
-	if err != nil {
-		return nil, fmt.Errorf("failed to validate and parse OpenAPI definition: %w", err)
+	var apiDetails *api.RESTAPI
+	if isConvertedAPIDefinitionFormat(format) {
+		createReq, generated, err := s.convertAPIDefinition(content, format)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", constants.ErrInvalidInput, err.Error())
+		}
+		apiDetails = s.createRequestToRESTAPI(createReq, "")
+		content = generated
+	} else {
+		// Validate and parse the OpenAPI definition
+		apiDetails, err = s.apiUtil.ValidateAndParseOpenAPIToRESTAPI(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate and parse OpenAPI definition: %w", err)
+		}
 	}
 
 	// Merge provided API details with extracted details from OpenAPI
@@ -1259,8 +1367,66 @@ func (s *APIService) ImportFromOpenAPI(userAPI *api.RESTAPI, url *string, defini
 		return nil, fmt.Errorf("validation failed for merged API details: %w", err)
 	}
 
-	// Create the API
-	return s.CreateAPI(createReq, orgId)
+	// Create the API, keeping the definition so schemas and examples survive publishing
+	return s.createAPI(createReq, orgId, string(content))
+}
+
+// convertAPIDefinition converts a Postman collection or HAR file to an API creation request and the
+// generated OpenAPI definition
+func (s *APIService) convertAPIDefinition(content []byte, format api.APIDefinitionFormat) (*api.CreateRESTAPIRequest, []byte, error) {
+	switch format {
+	case api.APIDefinitionFormatPostman:
+		return s.apiUtil.ConvertPostmanCollectionToRESTAPI(content)
+	case api.APIDefinitionFormatHar:
+		return s.apiUtil.ConvertHARToRESTAPI(content)
+	}
+	return nil, nil, fmt.Errorf("unsupported definition format %q", format)
+}
+
+func isConvertedAPIDefinitionFormat(format api.APIDefinitionFormat) bool {
+	return format == api.APIDefinitionFormatPostman || format == api.APIDefinitionFormatHar
+}
+
+// ExportOpenAPI returns the OpenAPI definition of an API in the given format (yaml or json). The stored
+// definition is merged with a server per gateway the API is deployed to and with the security schemes of
+// the auth policies on the API.
+func (s *APIService) ExportOpenAPI(handle, format, orgUUID string) ([]byte, error) {
+	if format != "" && format != string(api.ExportRESTAPIOpenAPIParamsFormatYaml) && format != string(api.ExportRESTAPIOpenAPIParamsFormatJson) {
+		return nil, fmt.Errorf("%w: unsupported format %q", constants.ErrInvalidInput, format)
+	}
+	apiUUID, err := s.getAPIUUIDByHandle(handle, orgUUID)
+	if err != nil {
+		return nil, err
+	}
+	apiModel, err := s.apiRepo.GetAPIByUUID(apiUUID, orgUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api: %w", err)
+	}
+	if apiModel == nil {
+		return nil, constants.ErrAPINotFound
+	}
+	restAPI, err := s.apiUtil.ModelToRESTAPI(apiModel)
+	if err != nil {
+		return nil, err
+	}
+
+	gateways, err := s.apiRepo.GetAPIGatewaysWithDetails(apiUUID, orgUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api gateways: %w", err)
+	}
+	deployed := make([]*model.APIGatewayWithDetails, 0, len(gateways))
+	for _, gateway := range gateways {
+		if gateway.IsDeployed {
+			deployed = append(deployed, gateway)
+		}
+	}
+
+	definition, err := s.apiUtil.BuildOpenAPIDefinition(restAPI, apiModel.OpenAPISpec,
+		s.apiUtil.BuildGatewayServers(restAPI.Context, deployed))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build OpenAPI definition: %w", err)
+	}
+	return s.apiUtil.MarshalOpenAPIDefinition(definition, format)
 }
 
 // ValidateAPI validates if an API with the given identifier or name+version combination exists within an organization
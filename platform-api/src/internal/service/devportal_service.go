@@ -652,8 +652,17 @@ func (s *DevPortalService) publishToDevPortal(
 		return fmt.Errorf("API %s already exists in DevPortal %s", apiUUID, devPortal.Name)
 	}
 
-	// Generate OpenAPI definition
-	apiDef, err := s.apiUtil.GenerateOpenAPIDefinitionFromRESTAPI(apiModel, &apiMetadata)
+	// Generate OpenAPI definition, starting from the stored definition when the API was imported from one
+	storedAPI, err := s.apiRepo.GetAPIByUUID(apiUUID, org.ID)
+	if err != nil {
+		s.slogger.Error("API publication failed: failed to load stored OpenAPI definition", "apiUUID", apiUUID, "devPortalName", devPortal.Name, "error", err)
+		return fmt.Errorf("failed to load API %s: %w", apiUUID, err)
+	}
+	definition := ""
+	if storedAPI != nil {
+		definition = storedAPI.OpenAPISpec
+	}
+	apiDef, err := s.apiUtil.GenerateOpenAPIDefinitionFromRESTAPI(apiModel, definition, &apiMetadata)
 	if err != nil {
 		s.slogger.Error("API publication failed: failed to generate OpenAPI definition", "apiUUID", apiUUID, "devPortalName", devPortal.Name, "error", err)
 		return fmt.Errorf("failed to generate OpenAPI definition for API %s: %w", apiUUID, err)
@@ -28,6 +28,7 @@ import (
 	"platform-api/src/internal/model"
 	"platform-api/src/internal/repository"
 	"platform-api/src/internal/utils"
+	"strings"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -187,6 +188,25 @@ func (s *GatewayInternalAPIService) IsAPIDeployedOnGateway(apiID, gatewayID, org
 	return nil
 }
 
+// GetAPIDefinition returns the OpenAPI definition stored for an API deployed on the gateway, as
+// it was imported, or ErrAPIDefinitionNotFound if the API was created without one.
+func (s *GatewayInternalAPIService) GetAPIDefinition(apiID, gatewayID, orgID string) (string, error) {
+	if err := s.IsAPIDeployedOnGateway(apiID, gatewayID, orgID); err != nil {
+		return "", err
+	}
+	apiModel, err := s.apiRepo.GetAPIByUUID(apiID, orgID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get api: %w", err)
+	}
+	if apiModel == nil {
+		return "", constants.ErrAPINotFound
+	}
+	if strings.TrimSpace(apiModel.OpenAPISpec) == "" {
+		return "", constants.ErrAPIDefinitionNotFound
+	}
+	return apiModel.OpenAPISpec, nil
+}
+
 // ListSubscriptionsForAPI lists subscriptions for a given API within an organization.
 func (s *GatewayInternalAPIService) ListSubscriptionsForAPI(apiID, orgID string) ([]dto.GatewaySubscriptionInfo, error) {
 	if apiID == "" || orgID == "" {
@@ -0,0 +1,924 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/url"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"platform-api/src/api"
+	"platform-api/src/internal/constants"
+	"platform-api/src/internal/dto"
+	"platform-api/src/internal/model"
+	"platform-api/src/internal/repository"
+	"platform-api/src/internal/utils"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	apiProjectConfigDir  = ".api-platform"
+	apiProjectConfigFile = "config.yaml"
+
+	// maxRepositoryFileSize bounds the size of project files read from a checkout
+	maxRepositoryFileSize = 10 << 20
+)
+
+// GitBindingConfig holds configuration for git repository bindings and their poller
+type GitBindingConfig struct {
+	PollerEnabled          bool          // Whether bindings are polled for new commits
+	PollerInterval         time.Duration // How often due bindings are checked (default: 30 seconds)
+	SyncTimeout            time.Duration // Upper bound of a single sync (default: 5 minutes)
+	AllowLocalRepositories bool          // Whether file:// repository URLs are accepted
+}
+
+// gitBindingAPIStore is the part of APIService used to apply API projects read from a repository
+type gitBindingAPIStore interface {
+	createRequestFromAPIYAMLData(yamlData *dto.APIYAMLData) *api.CreateRESTAPIRequest
+	createAPI(req *api.CreateRESTAPIRequest, orgUUID string, openAPISpec string) (*api.RESTAPI, error)
+	updateAPIFromSource(apiUUID string, req *api.CreateRESTAPIRequest, orgUUID string, openAPISpec string) (*api.RESTAPI, error)
+	getAPIUUIDByHandle(handle, orgUUID string) (string, error)
+	DeleteAPI(apiUUID, orgUUID string) error
+}
+
+// gitBindingDeployer deploys APIs that changed in a sync
+type gitBindingDeployer interface {
+	DeployAPI(apiUUID string, req *api.DeployRequest, orgUUID string) (*api.DeploymentResponse, error)
+}
+
+// GitBindingService keeps the REST APIs of a project in sync with the API projects of a git branch.
+// Syncs are triggered by the poller, by push webhooks or manually, and never run concurrently for
+// the same binding.
+type GitBindingService struct {
+	bindingRepo repository.GitRepositoryBindingRepository
+	projectRepo repository.ProjectRepository
+	gatewayRepo repository.GatewayRepository
+	apiStore    gitBindingAPIStore
+	deployer    gitBindingDeployer
+	fetcher     GitRepositoryFetcher
+	config      GitBindingConfig
+	slogger     *slog.Logger
+
+	mu         sync.Mutex
+	running    map[string]bool      // bindings with a sync in progress
+	rerun      map[string]bool      // bindings to sync again once the running sync finishes
+	lastPolled map[string]time.Time // when the poller last started a sync of a binding
+}
+
+// NewGitBindingService creates a new git repository binding service
+func NewGitBindingService(
+	bindingRepo repository.GitRepositoryBindingRepository,
+	projectRepo repository.ProjectRepository,
+	gatewayRepo repository.GatewayRepository,
+	apiService *APIService,
+	deploymentService *DeploymentService,
+	fetcher GitRepositoryFetcher,
+	config GitBindingConfig,
+	slogger *slog.Logger,
+) *GitBindingService {
+	return &GitBindingService{
+		bindingRepo: bindingRepo,
+		projectRepo: projectRepo,
+		gatewayRepo: gatewayRepo,
+		apiStore:    apiService,
+		deployer:    deploymentService,
+		fetcher:     fetcher,
+		config:      config,
+		slogger:     slogger,
+		running:     make(map[string]bool),
+		rerun:       make(map[string]bool),
+		lastPolled:  make(map[string]time.Time),
+	}
+}
+
+// CreateBinding binds a project to a branch of a git repository
+func (s *GitBindingService) CreateBinding(req *api.CreateGitRepositoryBindingRequest, orgUUID string) (*api.GitRepositoryBinding, error) {
+	if req == nil {
+		return nil, constants.ErrInvalidInput
+	}
+	projectUUID := utils.OpenAPIUUIDToString(req.ProjectId)
+	project, err := s.projectRepo.GetProjectByUUID(projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil || project.OrganizationID != orgUUID {
+		return nil, constants.ErrProjectNotFound
+	}
+
+	binding := &model.GitRepositoryBinding{
+		OrganizationUUID: orgUUID,
+		ProjectUUID:      projectUUID,
+		RepoURL:          strings.TrimSpace(req.RepoUrl),
+		Branch:           strings.TrimSpace(req.Branch),
+		GatewayUUIDs:     []string{},
+		SyncStatus:       model.GitSyncStatusPending,
+	}
+	if req.RootPath != nil {
+		binding.RootPath = *req.RootPath
+	}
+	if req.PollIntervalSeconds != nil {
+		binding.PollIntervalSeconds = *req.PollIntervalSeconds
+	}
+	if req.WebhookSecret != nil {
+		binding.WebhookSecret = *req.WebhookSecret
+	}
+	if req.AutoDeploy != nil {
+		binding.AutoDeploy = *req.AutoDeploy
+	}
+	if req.GatewayIds != nil {
+		binding.GatewayUUIDs = openAPIUUIDsToStrings(*req.GatewayIds)
+	}
+	if err := s.validateBinding(binding); err != nil {
+		return nil, err
+	}
+
+	if err := s.bindingRepo.Create(binding); err != nil {
+		return nil, err
+	}
+	s.slogger.Info("Created git repository binding", "bindingId", binding.UUID, "projectId", projectUUID,
+		"repoUrl", binding.RepoURL, "branch", binding.Branch)
+	return gitBindingToAPI(binding), nil
+}
+
+// GetBinding retrieves a git repository binding of the organization
+func (s *GitBindingService) GetBinding(bindingUUID, orgUUID string) (*api.GitRepositoryBinding, error) {
+	binding, err := s.getBinding(bindingUUID, orgUUID)
+	if err != nil {
+		return nil, err
+	}
+	return gitBindingToAPI(binding), nil
+}
+
+// ListBindings lists the git repository bindings of the organization, optionally filtered by project
+func (s *GitBindingService) ListBindings(orgUUID, projectUUID string) (*api.GitRepositoryBindingListResponse, error) {
+	bindings, err := s.bindingRepo.List(orgUUID, projectUUID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]api.GitRepositoryBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		list = append(list, *gitBindingToAPI(binding))
+	}
+	return &api.GitRepositoryBindingListResponse{Count: len(list), List: list}, nil
+}
+
+// UpdateBinding updates a git repository binding. Optional fields that are not set keep their value.
+// Pointing the binding at a different repository, branch or root path forces a full sync.
+func (s *GitBindingService) UpdateBinding(bindingUUID string, req *api.UpdateGitRepositoryBindingRequest,
+	orgUUID string) (*api.GitRepositoryBinding, error) {
+	if req == nil {
+		return nil, constants.ErrInvalidInput
+	}
+	binding, err := s.getBinding(bindingUUID, orgUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousSource := binding.RepoURL + "\x00" + binding.Branch + "\x00" + binding.RootPath
+	binding.RepoURL = strings.TrimSpace(req.RepoUrl)
+	binding.Branch = strings.TrimSpace(req.Branch)
+	if req.RootPath != nil {
+		binding.RootPath = *req.RootPath
+	}
+	if req.PollIntervalSeconds != nil {
+		binding.PollIntervalSeconds = *req.PollIntervalSeconds
+	}
+	if req.WebhookSecret != nil {
+		binding.WebhookSecret = *req.WebhookSecret
+	}
+	if req.AutoDeploy != nil {
+		binding.AutoDeploy = *req.AutoDeploy
+	}
+	if req.GatewayIds != nil {
+		binding.GatewayUUIDs = openAPIUUIDsToStrings(*req.GatewayIds)
+	}
+	if err := s.validateBinding(binding); err != nil {
+		return nil, err
+	}
+	if binding.RepoURL+"\x00"+binding.Branch+"\x00"+binding.RootPath != previousSource {
+		binding.LastCommitSHA = ""
+		binding.SyncStatus = model.GitSyncStatusPending
+		binding.SyncError = ""
+	}
+
+	if err := s.bindingRepo.Update(binding); err != nil {
+		return nil, err
+	}
+	return gitBindingToAPI(binding), nil
+}
+
+// DeleteBinding deletes a git repository binding. The APIs it created are kept.
+func (s *GitBindingService) DeleteBinding(bindingUUID, orgUUID string) error {
+	if _, err := s.getBinding(bindingUUID, orgUUID); err != nil {
+		return err
+	}
+	if err := s.bindingRepo.Delete(bindingUUID, orgUUID); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	delete(s.lastPolled, bindingUUID)
+	s.mu.Unlock()
+	return nil
+}
+
+// ListBindingAPIs lists the per-API sync records of a git repository binding
+func (s *GitBindingService) ListBindingAPIs(bindingUUID, orgUUID string) (*api.GitBindingAPISyncListResponse, error) {
+	if _, err := s.getBinding(bindingUUID, orgUUID); err != nil {
+		return nil, err
+	}
+	syncs, err := s.bindingRepo.ListAPISyncs(bindingUUID)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]api.GitBindingAPISync, 0, len(syncs))
+	for _, entry := range syncs {
+		list = append(list, api.GitBindingAPISync{
+			ApiId:      utils.StringPtrIfNotEmpty(entry.APIHandle),
+			CommitSha:  utils.StringPtrIfNotEmpty(entry.CommitSHA),
+			Error:      utils.StringPtrIfNotEmpty(entry.Error),
+			SourcePath: entry.SourcePath,
+			Status:     api.GitBindingAPISyncStatus(entry.Status),
+			SyncedAt:   entry.SyncedAt,
+		})
+	}
+	return &api.GitBindingAPISyncListResponse{Count: len(list), List: list}, nil
+}
+
+// SyncBinding syncs a binding with the head of its branch and waits for the sync to finish.
+// Every API is re-applied, even when the commit has not changed since the last sync.
+func (s *GitBindingService) SyncBinding(bindingUUID, orgUUID string) (*api.GitRepositoryBinding, error) {
+	binding, err := s.getBinding(bindingUUID, orgUUID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.beginSync(bindingUUID, false) {
+		return nil, constants.ErrGitBindingSyncInProgress
+	}
+	defer s.endSync(bindingUUID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.syncTimeout())
+	defer cancel()
+	if err := s.syncBinding(ctx, binding, true); err != nil {
+		return nil, err
+	}
+	return s.GetBinding(bindingUUID, orgUUID)
+}
+
+// TriggerSync syncs a binding in the background. If a sync of the binding is already running,
+// another one is started when it finishes so that the latest commit is always picked up.
+func (s *GitBindingService) TriggerSync(bindingUUID string) {
+	if !s.beginSync(bindingUUID, true) {
+		return
+	}
+	go s.runSync(bindingUUID)
+}
+
+// HandleWebhook authenticates a push webhook of a binding and triggers a sync when the push targets
+// the bound branch. The signature is the X-Hub-Signature-256 header (GitHub, Gitea, Bitbucket) and
+// token the X-Gitlab-Token header; one of them must match the binding's webhook secret.
+// It reports whether a sync was triggered.
+func (s *GitBindingService) HandleWebhook(bindingUUID, signature, token string, payload []byte) (bool, error) {
+	binding, err := s.bindingRepo.GetByUUID(bindingUUID)
+	if err != nil {
+		return false, err
+	}
+	if binding == nil {
+		return false, constants.ErrGitBindingNotFound
+	}
+	if !verifyGitWebhook(binding.WebhookSecret, signature, token, payload) {
+		return false, constants.ErrInvalidGitWebhookSignature
+	}
+
+	var push struct {
+		Ref string `json:"ref"`
+	}
+	if len(payload) > 0 && json.Unmarshal(payload, &push) == nil && push.Ref != "" &&
+		push.Ref != "refs/heads/"+binding.Branch {
+		s.slogger.Debug("Ignoring push webhook for another branch", "bindingId", bindingUUID, "ref", push.Ref)
+		return false, nil
+	}
+
+	s.TriggerSync(bindingUUID)
+	return true, nil
+}
+
+// Start runs the poller until ctx is cancelled. Each binding is polled at its own interval;
+// a poll only syncs when the branch moved or the previous sync failed.
+func (s *GitBindingService) Start(ctx context.Context) {
+	if !s.config.PollerEnabled {
+		s.slogger.Info("Git repository binding poller disabled")
+		return
+	}
+
+	interval := s.config.PollerInterval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+	s.slogger.Info("Git repository binding poller started", slog.String("interval", interval.String()))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.slogger.Info("Git repository binding poller stopped")
+			return
+		case <-ticker.C:
+			s.pollBindings(time.Now())
+		}
+	}
+}
+
+func (s *GitBindingService) pollBindings(now time.Time) {
+	bindings, err := s.bindingRepo.ListPollable()
+	if err != nil {
+		s.slogger.Error("Failed to list git repository bindings to poll", "error", err)
+		return
+	}
+
+	for _, binding := range bindings {
+		interval := time.Duration(binding.PollIntervalSeconds) * time.Second
+		s.mu.Lock()
+		last, polled := s.lastPolled[binding.UUID]
+		due := !polled || now.Sub(last) >= interval
+		if due {
+			s.lastPolled[binding.UUID] = now
+		}
+		s.mu.Unlock()
+
+		if due && s.beginSync(binding.UUID, false) {
+			go s.runSync(binding.UUID)
+		}
+	}
+}
+
+// beginSync marks a binding as syncing. When a sync is already running it returns false and,
+// if queue is set, schedules another sync for when the running one finishes.
+func (s *GitBindingService) beginSync(bindingUUID string, queue bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.running[bindingUUID] {
+		if queue {
+			s.rerun[bindingUUID] = true
+		}
+		return false
+	}
+	s.running[bindingUUID] = true
+	return true
+}
+
+// endSync clears the syncing mark of a binding and starts a queued sync, if any
+func (s *GitBindingService) endSync(bindingUUID string) {
+	s.mu.Lock()
+	rerun := s.rerun[bindingUUID]
+	delete(s.rerun, bindingUUID)
+	if !rerun {
+		delete(s.running, bindingUUID)
+	}
+	s.mu.Unlock()
+
+	if rerun {
+		go s.runSync(bindingUUID)
+	}
+}
+
+// runSync performs a background sync of a binding that beginSync has been called for
+func (s *GitBindingService) runSync(bindingUUID string) {
+	defer s.endSync(bindingUUID)
+
+	binding, err := s.bindingRepo.GetByUUID(bindingUUID)
+	if err != nil {
+		s.slogger.Error("Failed to load git repository binding", "bindingId", bindingUUID, "error", err)
+		return
+	}
+	if binding == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.syncTimeout())
+	defer cancel()
+	if err := s.syncBinding(ctx, binding, false); err != nil {
+		s.slogger.Error("Failed to sync git repository binding", "bindingId", bindingUUID, "error", err)
+	}
+}
+
+// syncBinding applies the head of the bound branch. Problems with the repository or individual
+// API projects are recorded on the binding; only failures to record them are returned.
+func (s *GitBindingService) syncBinding(ctx context.Context, binding *model.GitRepositoryBinding, force bool) error {
+	commitSHA, err := s.fetcher.ResolveBranch(ctx, binding.RepoURL, binding.Branch)
+	if err != nil {
+		return s.bindingRepo.UpdateSyncStatus(binding.UUID, model.GitSyncStatusFailed, err.Error())
+	}
+	if !force && commitSHA == binding.LastCommitSHA && binding.SyncStatus == model.GitSyncStatusSynced {
+		return nil
+	}
+
+	if err := s.bindingRepo.UpdateSyncStatus(binding.UUID, model.GitSyncStatusSyncing, ""); err != nil {
+		return err
+	}
+
+	workDir, err := os.MkdirTemp("", "git-binding-")
+	if err != nil {
+		return s.bindingRepo.UpdateSyncStatus(binding.UUID, model.GitSyncStatusFailed,
+			fmt.Sprintf("failed to create checkout directory: %v", err))
+	}
+	defer os.RemoveAll(workDir)
+
+	checkoutDir := filepath.Join(workDir, "repo")
+	commitSHA, err = s.fetcher.Checkout(ctx, binding.RepoURL, binding.Branch, checkoutDir)
+	if err != nil {
+		return s.bindingRepo.UpdateSyncStatus(binding.UUID, model.GitSyncStatusFailed, err.Error())
+	}
+
+	syncErrors, err := s.applyCheckout(binding, checkoutDir, commitSHA, force)
+	if err != nil {
+		return err
+	}
+
+	status := model.GitSyncStatusSynced
+	if len(syncErrors) > 0 {
+		status = model.GitSyncStatusFailed
+	}
+	s.slogger.Info("Synced git repository binding", "bindingId", binding.UUID, "commit", commitSHA,
+		"status", status, "errors", len(syncErrors))
+	return s.bindingRepo.UpdateSyncResult(binding.UUID, commitSHA, status, strings.Join(syncErrors, "; "),
+		time.Now().UTC())
+}
+
+// applyCheckout creates, updates and deletes the APIs of a binding to match a checkout.
+// It returns the problems found in the repository.
+func (s *GitBindingService) applyCheckout(binding *model.GitRepositoryBinding, checkoutDir, commitSHA string,
+	force bool) ([]string, error) {
+	previous, err := s.bindingRepo.ListAPISyncs(binding.UUID)
+	if err != nil {
+		return nil, err
+	}
+	previousBySource := make(map[string]*model.GitBindingAPISync, len(previous))
+	for _, entry := range previous {
+		previousBySource[entry.SourcePath] = entry
+	}
+
+	projects, err := discoverAPIProjects(checkoutDir, binding.RootPath)
+	if err != nil {
+		// Without the project list nothing can be told apart from a removed API, so leave all APIs as they are
+		return []string{err.Error()}, nil
+	}
+
+	var syncErrors []string
+	seen := make(map[string]bool)
+	var failedProjects []string
+	for _, project := range projects {
+		entries, err := readAPIProjectConfig(checkoutDir, project)
+		if err != nil {
+			syncErrors = append(syncErrors, fmt.Sprintf("%s: %v", displayRepoPath(project), err))
+			failedProjects = append(failedProjects, project)
+			continue
+		}
+
+		for _, entry := range entries {
+			sourcePath := pathpkg.Join(project, entry.WSO2Artifact)
+			if seen[sourcePath] {
+				continue
+			}
+			seen[sourcePath] = true
+
+			record := &model.GitBindingAPISync{
+				BindingUUID: binding.UUID,
+				SourcePath:  sourcePath,
+				CommitSHA:   commitSHA,
+				Status:      model.GitSyncStatusSynced,
+			}
+			last := previousBySource[sourcePath]
+			if last != nil {
+				record.APIUUID = last.APIUUID
+			}
+
+			apiUUID, digest, err := s.applyAPI(binding, checkoutDir, project, entry, last, force)
+			if apiUUID != "" {
+				record.APIUUID = apiUUID
+			}
+			record.ContentDigest = digest
+			if err != nil {
+				record.Status = model.GitSyncStatusFailed
+				record.Error = err.Error()
+				syncErrors = append(syncErrors, fmt.Sprintf("%s: %v", sourcePath, err))
+			}
+			if err := s.bindingRepo.UpsertAPISync(record); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Delete the APIs whose artifacts are gone, except those of projects that could not be read
+	for _, entry := range previous {
+		if seen[entry.SourcePath] || isInRepoPaths(entry.SourcePath, failedProjects) {
+			continue
+		}
+		if entry.APIUUID != "" {
+			if err := s.apiStore.DeleteAPI(entry.APIUUID, binding.OrganizationUUID); err != nil &&
+				!errors.Is(err, constants.ErrAPINotFound) {
+				syncErrors = append(syncErrors, fmt.Sprintf("%s: failed to delete API: %v", entry.SourcePath, err))
+				continue
+			}
+			s.slogger.Info("Deleted API removed from git repository", "bindingId", binding.UUID,
+				"sourcePath", entry.SourcePath, "apiUUID", entry.APIUUID)
+		}
+		if err := s.bindingRepo.DeleteAPISync(binding.UUID, entry.SourcePath); err != nil {
+			return nil, err
+		}
+	}
+
+	return syncErrors, nil
+}
+
+// applyAPI creates or updates the API described by a project entry and deploys it when it changed.
+// It returns the API UUID and the digest of its source files; the digest is empty when the files
+// could not be read so that the next sync retries.
+func (s *GitBindingService) applyAPI(binding *model.GitRepositoryBinding, checkoutDir, project string,
+	entry dto.APIConfigEntry, last *model.GitBindingAPISync, force bool) (string, string, error) {
+	artifactContent, err := readRepositoryFile(checkoutDir, pathpkg.Join(project, entry.WSO2Artifact))
+	if err != nil {
+		return "", "", err
+	}
+	var openAPIContent []byte
+	if entry.OpenAPI != "" {
+		openAPIContent, err = readRepositoryFile(checkoutDir, pathpkg.Join(project, entry.OpenAPI))
+		if err != nil {
+			return "", "", err
+		}
+	}
+	digest := sha256.New()
+	digest.Write(artifactContent)
+	digest.Write([]byte{0})
+	digest.Write(openAPIContent)
+	contentDigest := hex.EncodeToString(digest.Sum(nil))
+
+	apiUUID := ""
+	if last != nil {
+		apiUUID = last.APIUUID
+	}
+	unchanged := last != nil && last.Status == model.GitSyncStatusSynced && last.ContentDigest == contentDigest
+	if apiUUID != "" && unchanged && !force {
+		return apiUUID, contentDigest, nil
+	}
+
+	var artifact dto.APIDeploymentYAML
+	if err := yaml.Unmarshal(artifactContent, &artifact); err != nil {
+		return apiUUID, contentDigest, fmt.Errorf("failed to parse WSO2 artifact: %w", err)
+	}
+	if artifact.Kind == "" || artifact.ApiVersion == "" {
+		return apiUUID, contentDigest, fmt.Errorf("malformed WSO2 artifact: kind and apiVersion are required")
+	}
+	handle := strings.TrimSpace(artifact.Metadata.Name)
+	if handle == "" {
+		return apiUUID, contentDigest, fmt.Errorf("malformed WSO2 artifact: metadata.name is required")
+	}
+
+	req := s.apiStore.createRequestFromAPIYAMLData(&artifact.Spec)
+	req.ProjectId = utils.ParseOpenAPIUUIDOrZero(binding.ProjectUUID)
+	req.Id = &handle
+
+	if apiUUID == "" {
+		// Never take over an API that was created outside of this binding
+		if _, err := s.apiStore.getAPIUUIDByHandle(handle, binding.OrganizationUUID); err == nil {
+			return "", contentDigest, fmt.Errorf("API %s already exists and is not managed by this binding", handle)
+		} else if !errors.Is(err, constants.ErrAPINotFound) {
+			return "", contentDigest, err
+		}
+		if _, err := s.apiStore.createAPI(req, binding.OrganizationUUID, string(openAPIContent)); err != nil {
+			return "", contentDigest, fmt.Errorf("failed to create API %s: %w", handle, err)
+		}
+		apiUUID, err = s.apiStore.getAPIUUIDByHandle(handle, binding.OrganizationUUID)
+		if err != nil {
+			return "", contentDigest, err
+		}
+		s.slogger.Info("Created API from git repository", "bindingId", binding.UUID, "apiId", handle)
+	} else {
+		if _, err := s.apiStore.updateAPIFromSource(apiUUID, req, binding.OrganizationUUID, string(openAPIContent)); err != nil {
+			return apiUUID, contentDigest, fmt.Errorf("failed to update API %s: %w", handle, err)
+		}
+		s.slogger.Info("Updated API from git repository", "bindingId", binding.UUID, "apiId", handle)
+	}
+
+	if binding.AutoDeploy {
+		if err := s.deployAPI(binding, apiUUID, contentDigest); err != nil {
+			return apiUUID, contentDigest, err
+		}
+	}
+	return apiUUID, contentDigest, nil
+}
+
+// deployAPI deploys the current revision of an API to every gateway mapped to the binding
+func (s *GitBindingService) deployAPI(binding *model.GitRepositoryBinding, apiUUID, contentDigest string) error {
+	var failures []string
+	for _, gatewayUUID := range binding.GatewayUUIDs {
+		_, err := s.deployer.DeployAPI(apiUUID, &api.DeployRequest{
+			Base:      "current",
+			GatewayId: utils.ParseOpenAPIUUIDOrZero(gatewayUUID),
+			Name:      "git-" + contentDigest[:12],
+		}, binding.OrganizationUUID)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("gateway %s: %v", gatewayUUID, err))
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to deploy API: %s", strings.Join(failures, ", "))
+	}
+	return nil
+}
+
+func (s *GitBindingService) getBinding(bindingUUID, orgUUID string) (*model.GitRepositoryBinding, error) {
+	binding, err := s.bindingRepo.GetByUUID(bindingUUID)
+	if err != nil {
+		return nil, err
+	}
+	if binding == nil || binding.OrganizationUUID != orgUUID {
+		return nil, constants.ErrGitBindingNotFound
+	}
+	return binding, nil
+}
+
+// validateBinding validates and normalizes the user supplied fields of a binding
+func (s *GitBindingService) validateBinding(binding *model.GitRepositoryBinding) error {
+	if err := validateGitRepositoryURL(binding.RepoURL, s.config.AllowLocalRepositories); err != nil {
+		return err
+	}
+	if binding.Branch == "" || strings.HasPrefix(binding.Branch, "-") || strings.ContainsAny(binding.Branch, " \t\n~^:?*[\\") ||
+		strings.Contains(binding.Branch, "..") {
+		return fmt.Errorf("%w: invalid branch name %q", constants.ErrInvalidInput, binding.Branch)
+	}
+	rootPath, err := cleanRepositoryPath(binding.RootPath)
+	if err != nil {
+		return fmt.Errorf("%w: invalid root path: %v", constants.ErrInvalidInput, err)
+	}
+	binding.RootPath = rootPath
+	if binding.PollIntervalSeconds < 0 {
+		return fmt.Errorf("%w: pollIntervalSeconds must not be negative", constants.ErrInvalidInput)
+	}
+	if binding.AutoDeploy && len(binding.GatewayUUIDs) == 0 {
+		return fmt.Errorf("%w: gatewayIds are required when autoDeploy is enabled", constants.ErrInvalidInput)
+	}
+	for _, gatewayUUID := range binding.GatewayUUIDs {
+		gateway, err := s.gatewayRepo.GetByUUID(gatewayUUID)
+		if err != nil {
+			return err
+		}
+		if gateway == nil || gateway.OrganizationID != binding.OrganizationUUID {
+			return fmt.Errorf("%w: gateway %s not found", constants.ErrInvalidInput, gatewayUUID)
+		}
+	}
+	return nil
+}
+
+func (s *GitBindingService) syncTimeout() time.Duration {
+	if s.config.SyncTimeout > 0 {
+		return s.config.SyncTimeout
+	}
+	return 5 * time.Minute
+}
+
+// validateGitRepositoryURL accepts https URLs and, when allowed, file URLs of local repositories
+func validateGitRepositoryURL(repoURL string, allowLocal bool) error {
+	parsed, err := url.Parse(repoURL)
+	if err != nil || strings.HasPrefix(repoURL, "-") {
+		return fmt.Errorf("%w: %s", constants.ErrInvalidGitRepositoryURL, repoURL)
+	}
+	switch parsed.Scheme {
+	case "https":
+		if parsed.Host == "" {
+			return fmt.Errorf("%w: %s", constants.ErrInvalidGitRepositoryURL, repoURL)
+		}
+	case "file":
+		if !allowLocal {
+			return fmt.Errorf("%w: file:// repositories are not enabled", constants.ErrInvalidGitRepositoryURL)
+		}
+		if parsed.Path == "" {
+			return fmt.Errorf("%w: %s", constants.ErrInvalidGitRepositoryURL, repoURL)
+		}
+	default:
+		return fmt.Errorf("%w: only https:// repositories are supported", constants.ErrInvalidGitRepositoryURL)
+	}
+	return nil
+}
+
+// verifyGitWebhook checks a webhook against the binding secret in constant time
+func verifyGitWebhook(secret, signature, token string, payload []byte) bool {
+	if secret == "" {
+		return false
+	}
+	if signature != "" {
+		expected, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+		if err != nil || !strings.HasPrefix(signature, "sha256=") {
+			return false
+		}
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		return hmac.Equal(expected, mac.Sum(nil))
+	}
+	if token != "" {
+		return subtle.ConstantTimeCompare([]byte(token), []byte(secret)) == 1
+	}
+	return false
+}
+
+// cleanRepositoryPath normalizes a slash separated path inside a repository. The repository root is "".
+func cleanRepositoryPath(p string) (string, error) {
+	p = strings.TrimSpace(p)
+	if p == "" {
+		return "", nil
+	}
+	cleaned := pathpkg.Clean(p)
+	if pathpkg.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("path %s is outside of the repository", p)
+	}
+	if cleaned == "." {
+		return "", nil
+	}
+	return cleaned, nil
+}
+
+// discoverAPIProjects returns the directories under rootPath, relative to the repository root,
+// that contain an API project config
+func discoverAPIProjects(checkoutDir, rootPath string) ([]string, error) {
+	root := filepath.Join(checkoutDir, filepath.FromSlash(rootPath))
+	info, err := os.Lstat(root)
+	if err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("root path %s not found in repository", displayRepoPath(rootPath))
+	}
+
+	var projects []string
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if d.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if d.Name() != apiProjectConfigDir {
+			return nil
+		}
+		if config, err := os.Lstat(filepath.Join(p, apiProjectConfigFile)); err == nil && config.Mode().IsRegular() {
+			rel, err := filepath.Rel(checkoutDir, filepath.Dir(p))
+			if err != nil {
+				return err
+			}
+			project := filepath.ToSlash(rel)
+			if project == "." {
+				project = ""
+			}
+			projects = append(projects, project)
+		}
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover API projects: %w", err)
+	}
+	sort.Strings(projects)
+	return projects, nil
+}
+
+// readAPIProjectConfig reads the config of an API project and normalizes the paths of its APIs
+// relative to the project directory
+func readAPIProjectConfig(checkoutDir, project string) ([]dto.APIConfigEntry, error) {
+	content, err := readRepositoryFile(checkoutDir, pathpkg.Join(project, apiProjectConfigDir, apiProjectConfigFile))
+	if err != nil {
+		return nil, err
+	}
+	var config dto.APIProjectConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("malformed api project: invalid config.yaml format")
+	}
+	if len(config.APIs) == 0 {
+		return nil, fmt.Errorf("malformed api project: no APIs defined in config.yaml")
+	}
+
+	entries := make([]dto.APIConfigEntry, 0, len(config.APIs))
+	for _, entry := range config.APIs {
+		if entry.WSO2Artifact == "" {
+			return nil, fmt.Errorf("malformed api project: apis.wso2Artifact field is required")
+		}
+		artifact, err := cleanRepositoryPath(entry.WSO2Artifact)
+		if err != nil || artifact == "" {
+			return nil, fmt.Errorf("malformed api project: invalid wso2Artifact path: %s", entry.WSO2Artifact)
+		}
+		openAPI, err := cleanRepositoryPath(entry.OpenAPI)
+		if err != nil {
+			return nil, fmt.Errorf("malformed api project: invalid openapi path: %s", entry.OpenAPI)
+		}
+		entry.WSO2Artifact = artifact
+		entry.OpenAPI = openAPI
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// readRepositoryFile reads a regular file of a checkout. Symbolic links that lead outside of the
+// checkout are rejected so that a repository cannot expose files of the host.
+func readRepositoryFile(checkoutDir, relPath string) ([]byte, error) {
+	root, err := filepath.EvalSymlinks(checkoutDir)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := filepath.EvalSymlinks(filepath.Join(checkoutDir, filepath.FromSlash(relPath)))
+	if err != nil {
+		return nil, fmt.Errorf("file %s not found", relPath)
+	}
+	if resolved != root && !strings.HasPrefix(resolved, root+string(filepath.Separator)) {
+		return nil, fmt.Errorf("file %s is outside of the repository", relPath)
+	}
+	info, err := os.Stat(resolved)
+	if err != nil || !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("file %s not found", relPath)
+	}
+	if info.Size() > maxRepositoryFileSize {
+		return nil, fmt.Errorf("file %s is too large", relPath)
+	}
+	return os.ReadFile(resolved)
+}
+
+// isInRepoPaths reports whether p is inside one of the given repository directories
+func isInRepoPaths(p string, dirs []string) bool {
+	for _, dir := range dirs {
+		if dir == "" || strings.HasPrefix(p, dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func displayRepoPath(p string) string {
+	if p == "" {
+		return "/"
+	}
+	return p
+}
+
+func openAPIUUIDsToStrings(ids []openapi_types.UUID) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, utils.OpenAPIUUIDToString(id))
+	}
+	return result
+}
+
+func gitBindingToAPI(binding *model.GitRepositoryBinding) *api.GitRepositoryBinding {
+	gatewayIDs := make([]openapi_types.UUID, 0, len(binding.GatewayUUIDs))
+	for _, id := range binding.GatewayUUIDs {
+		gatewayIDs = append(gatewayIDs, utils.ParseOpenAPIUUIDOrZero(id))
+	}
+	createdAt := binding.CreatedAt
+	updatedAt := binding.UpdatedAt
+	return &api.GitRepositoryBinding{
+		AutoDeploy:              binding.AutoDeploy,
+		Branch:                  binding.Branch,
+		CreatedAt:               &createdAt,
+		GatewayIds:              gatewayIDs,
+		Id:                      utils.ParseOpenAPIUUIDOrZero(binding.UUID),
+		LastCommitSha:           utils.StringPtrIfNotEmpty(binding.LastCommitSHA),
+		LastSyncedAt:            binding.LastSyncedAt,
+		PollIntervalSeconds:     binding.PollIntervalSeconds,
+		ProjectId:               utils.ParseOpenAPIUUIDOrZero(binding.ProjectUUID),
+		RepoUrl:                 binding.RepoURL,
+		RootPath:                binding.RootPath,
+		SyncError:               utils.StringPtrIfNotEmpty(binding.SyncError),
+		SyncStatus:              api.GitRepositoryBindingSyncStatus(binding.SyncStatus),
+		UpdatedAt:               &updatedAt,
+		WebhookSecretConfigured: binding.WebhookSecret != "",
+	}
+}
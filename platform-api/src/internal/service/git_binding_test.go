@@ -0,0 +1,652 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"platform-api/src/api"
+	"platform-api/src/internal/constants"
+	"platform-api/src/internal/dto"
+	"platform-api/src/internal/model"
+	"platform-api/src/internal/repository"
+	"platform-api/src/internal/utils"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	gitTestOrgUUID     = "11111111-1111-1111-1111-111111111111"
+	gitTestProjectUUID = "22222222-2222-2222-2222-222222222222"
+	gitTestGatewayUUID = "33333333-3333-3333-3333-333333333333"
+)
+
+// testGitRepository is a bare repository with a working clone used to push commits to it
+type testGitRepository struct {
+	t       *testing.T
+	bareDir string
+	workDir string
+}
+
+func newTestGitRepository(t *testing.T) *testGitRepository {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	root := t.TempDir()
+	repo := &testGitRepository{t: t, bareDir: filepath.Join(root, "origin.git"), workDir: filepath.Join(root, "work")}
+	repo.git(root, "init", "--quiet", "--bare", "--initial-branch=main", repo.bareDir)
+	repo.git(root, "init", "--quiet", "--initial-branch=main", repo.workDir)
+	repo.git(repo.workDir, "remote", "add", "origin", repo.bareDir)
+	return repo
+}
+
+func (r *testGitRepository) url() string {
+	return "file://" + filepath.ToSlash(r.bareDir)
+}
+
+func (r *testGitRepository) git(dir string, args ...string) string {
+	r.t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		"GIT_CONFIG_NOSYSTEM=1", "HOME="+r.t.TempDir())
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		r.t.Fatalf("git %s failed: %v: %s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// commit writes (or, for empty content, removes) files in the working clone and pushes them
+func (r *testGitRepository) commit(files map[string]string) string {
+	r.t.Helper()
+	for name, content := range files {
+		p := filepath.Join(r.workDir, filepath.FromSlash(name))
+		if content == "" {
+			if err := os.Remove(p); err != nil {
+				r.t.Fatalf("failed to remove %s: %v", name, err)
+			}
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			r.t.Fatalf("failed to create directory for %s: %v", name, err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			r.t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	r.git(r.workDir, "add", "-A")
+	r.git(r.workDir, "commit", "--quiet", "-m", "update")
+	r.git(r.workDir, "push", "--quiet", "origin", "main")
+	return r.git(r.workDir, "rev-parse", "HEAD")
+}
+
+func gitTestAPIProject(handle, context string) map[string]string {
+	return map[string]string{
+		"apis/" + handle + "/.api-platform/config.yaml": "version: 1\napis:\n  - openapi: openapi.yaml\n    wso2Artifact: api.yaml\n",
+		"apis/" + handle + "/openapi.yaml":              "openapi: 3.0.3\ninfo:\n  title: " + handle + "\n  version: v1.0\npaths: {}\n",
+		"apis/" + handle + "/api.yaml": "apiVersion: gateway.api-platform.wso2.com/v1alpha1\nkind: RestApi\nmetadata:\n  name: " +
+			handle + "\nspec:\n  displayName: " + handle + "\n  version: v1.0\n  context: " + context + "\n",
+	}
+}
+
+// mockGitBindingRepository is an in-memory GitRepositoryBindingRepository
+type mockGitBindingRepository struct {
+	mu       sync.Mutex
+	bindings map[string]*model.GitRepositoryBinding
+	syncs    map[string]map[string]*model.GitBindingAPISync
+	handles  map[string]string // API UUID -> handle, stands in for the artifacts join
+}
+
+func newMockGitBindingRepository() *mockGitBindingRepository {
+	return &mockGitBindingRepository{
+		bindings: make(map[string]*model.GitRepositoryBinding),
+		syncs:    make(map[string]map[string]*model.GitBindingAPISync),
+		handles:  make(map[string]string),
+	}
+}
+
+func (m *mockGitBindingRepository) Create(binding *model.GitRepositoryBinding) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	binding.UUID = "44444444-4444-4444-4444-44444444444" + string(rune('0'+len(m.bindings)))
+	binding.CreatedAt = time.Now()
+	binding.UpdatedAt = binding.CreatedAt
+	copied := *binding
+	m.bindings[binding.UUID] = &copied
+	return nil
+}
+
+func (m *mockGitBindingRepository) GetByUUID(bindingUUID string) (*model.GitRepositoryBinding, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	binding, ok := m.bindings[bindingUUID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *binding
+	return &copied, nil
+}
+
+func (m *mockGitBindingRepository) List(orgUUID, projectUUID string) ([]*model.GitRepositoryBinding, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*model.GitRepositoryBinding
+	for _, binding := range m.bindings {
+		if binding.OrganizationUUID == orgUUID && (projectUUID == "" || binding.ProjectUUID == projectUUID) {
+			copied := *binding
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockGitBindingRepository) ListPollable() ([]*model.GitRepositoryBinding, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*model.GitRepositoryBinding
+	for _, binding := range m.bindings {
+		if binding.PollIntervalSeconds > 0 {
+			copied := *binding
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockGitBindingRepository) Update(binding *model.GitRepositoryBinding) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.bindings[binding.UUID]; !ok {
+		return constants.ErrGitBindingNotFound
+	}
+	copied := *binding
+	m.bindings[binding.UUID] = &copied
+	return nil
+}
+
+func (m *mockGitBindingRepository) UpdateSyncStatus(bindingUUID string, status model.GitSyncStatus, syncError string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bindings[bindingUUID].SyncStatus = status
+	m.bindings[bindingUUID].SyncError = syncError
+	return nil
+}
+
+func (m *mockGitBindingRepository) UpdateSyncResult(bindingUUID, commitSHA string, status model.GitSyncStatus,
+	syncError string, syncedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	binding := m.bindings[bindingUUID]
+	binding.LastCommitSHA = commitSHA
+	binding.SyncStatus = status
+	binding.SyncError = syncError
+	binding.LastSyncedAt = &syncedAt
+	return nil
+}
+
+func (m *mockGitBindingRepository) Delete(bindingUUID, orgUUID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.bindings, bindingUUID)
+	delete(m.syncs, bindingUUID)
+	return nil
+}
+
+func (m *mockGitBindingRepository) ListAPISyncs(bindingUUID string) ([]*model.GitBindingAPISync, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []*model.GitBindingAPISync
+	for _, entry := range m.syncs[bindingUUID] {
+		copied := *entry
+		copied.APIHandle = m.handles[entry.APIUUID]
+		result = append(result, &copied)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].SourcePath < result[j].SourcePath })
+	return result, nil
+}
+
+func (m *mockGitBindingRepository) UpsertAPISync(entry *model.GitBindingAPISync) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.syncs[entry.BindingUUID] == nil {
+		m.syncs[entry.BindingUUID] = make(map[string]*model.GitBindingAPISync)
+	}
+	copied := *entry
+	copied.SyncedAt = time.Now()
+	m.syncs[entry.BindingUUID][entry.SourcePath] = &copied
+	return nil
+}
+
+func (m *mockGitBindingRepository) DeleteAPISync(bindingUUID, sourcePath string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.syncs[bindingUUID], sourcePath)
+	return nil
+}
+
+type mockGitBindingProjectRepository struct {
+	repository.ProjectRepository
+}
+
+func (m *mockGitBindingProjectRepository) GetProjectByUUID(projectID string) (*model.Project, error) {
+	if projectID != gitTestProjectUUID {
+		return nil, nil
+	}
+	return &model.Project{ID: projectID, OrganizationID: gitTestOrgUUID}, nil
+}
+
+type mockGitBindingGatewayRepository struct {
+	repository.GatewayRepository
+}
+
+func (m *mockGitBindingGatewayRepository) GetByUUID(gatewayID string) (*model.Gateway, error) {
+	if gatewayID != gitTestGatewayUUID {
+		return nil, nil
+	}
+	return &model.Gateway{ID: gatewayID, OrganizationID: gitTestOrgUUID}, nil
+}
+
+// fakeGitBindingAPIStore records the APIs applied by syncs, keyed by handle
+type fakeGitBindingAPIStore struct {
+	mu       sync.Mutex
+	repo     *mockGitBindingRepository
+	apis     map[string]*api.CreateRESTAPIRequest
+	uuids    map[string]string
+	specs    map[string]string
+	creates  int
+	updates  int
+	deletes  []string
+	deployed []string
+}
+
+func newFakeGitBindingAPIStore(repo *mockGitBindingRepository) *fakeGitBindingAPIStore {
+	return &fakeGitBindingAPIStore{
+		repo:  repo,
+		apis:  make(map[string]*api.CreateRESTAPIRequest),
+		uuids: make(map[string]string),
+		specs: make(map[string]string),
+	}
+}
+
+func (f *fakeGitBindingAPIStore) createRequestFromAPIYAMLData(yamlData *dto.APIYAMLData) *api.CreateRESTAPIRequest {
+	return &api.CreateRESTAPIRequest{Name: yamlData.DisplayName, Context: yamlData.Context, Version: yamlData.Version}
+}
+
+func (f *fakeGitBindingAPIStore) createAPI(req *api.CreateRESTAPIRequest, orgUUID string, openAPISpec string) (*api.RESTAPI, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	handle := utils.ValueOrEmpty(req.Id)
+	apiUUID := "api-" + handle
+	f.apis[handle] = req
+	f.uuids[handle] = apiUUID
+	f.specs[handle] = openAPISpec
+	f.creates++
+	f.repo.mu.Lock()
+	f.repo.handles[apiUUID] = handle
+	f.repo.mu.Unlock()
+	return &api.RESTAPI{Id: req.Id}, nil
+}
+
+func (f *fakeGitBindingAPIStore) updateAPIFromSource(apiUUID string, req *api.CreateRESTAPIRequest, orgUUID string,
+	openAPISpec string) (*api.RESTAPI, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	handle := utils.ValueOrEmpty(req.Id)
+	if f.uuids[handle] != apiUUID {
+		return nil, constants.ErrAPINotFound
+	}
+	f.apis[handle] = req
+	f.specs[handle] = openAPISpec
+	f.updates++
+	return &api.RESTAPI{Id: req.Id}, nil
+}
+
+func (f *fakeGitBindingAPIStore) getAPIUUIDByHandle(handle, orgUUID string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if apiUUID, ok := f.uuids[handle]; ok {
+		return apiUUID, nil
+	}
+	return "", constants.ErrAPINotFound
+}
+
+func (f *fakeGitBindingAPIStore) DeleteAPI(apiUUID, orgUUID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for handle, id := range f.uuids {
+		if id == apiUUID {
+			delete(f.uuids, handle)
+			delete(f.apis, handle)
+			f.deletes = append(f.deletes, handle)
+			return nil
+		}
+	}
+	return constants.ErrAPINotFound
+}
+
+func (f *fakeGitBindingAPIStore) DeployAPI(apiUUID string, req *api.DeployRequest, orgUUID string) (*api.DeploymentResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deployed = append(f.deployed, apiUUID+"@"+utils.OpenAPIUUIDToString(req.GatewayId))
+	return &api.DeploymentResponse{}, nil
+}
+
+func newTestGitBindingService(t *testing.T) (*GitBindingService, *mockGitBindingRepository, *fakeGitBindingAPIStore) {
+	t.Helper()
+	repo := newMockGitBindingRepository()
+	store := newFakeGitBindingAPIStore(repo)
+	svc := &GitBindingService{
+		bindingRepo: repo,
+		projectRepo: &mockGitBindingProjectRepository{},
+		gatewayRepo: &mockGitBindingGatewayRepository{},
+		apiStore:    store,
+		deployer:    store,
+		fetcher:     NewGitCLIFetcher(),
+		config:      GitBindingConfig{AllowLocalRepositories: true, SyncTimeout: time.Minute},
+		slogger:     slog.New(slog.NewTextHandler(io.Discard, nil)),
+		running:     make(map[string]bool),
+		rerun:       make(map[string]bool),
+		lastPolled:  make(map[string]time.Time),
+	}
+	return svc, repo, store
+}
+
+func createTestGitBinding(t *testing.T, svc *GitBindingService, req api.CreateGitRepositoryBindingRequest) *api.GitRepositoryBinding {
+	t.Helper()
+	req.ProjectId = utils.ParseOpenAPIUUIDOrZero(gitTestProjectUUID)
+	binding, err := svc.CreateBinding(&req, gitTestOrgUUID)
+	if err != nil {
+		t.Fatalf("CreateBinding failed: %v", err)
+	}
+	return binding
+}
+
+func TestGitBindingServiceSyncAppliesRepositoryChanges(t *testing.T) {
+	gitRepo := newTestGitRepository(t)
+	files := gitTestAPIProject("petstore", "/petstore")
+	for name, content := range gitTestAPIProject("orders", "/orders") {
+		files[name] = content
+	}
+	files["README.md"] = "not an API project\n"
+	firstCommit := gitRepo.commit(files)
+
+	svc, repo, store := newTestGitBindingService(t)
+	autoDeploy := true
+	rootPath := "./apis/"
+	gateways := []openapi_types.UUID{utils.ParseOpenAPIUUIDOrZero(gitTestGatewayUUID)}
+	created := createTestGitBinding(t, svc, api.CreateGitRepositoryBindingRequest{
+		RepoUrl:    gitRepo.url(),
+		Branch:     "main",
+		RootPath:   &rootPath,
+		AutoDeploy: &autoDeploy,
+		GatewayIds: &gateways,
+	})
+	if created.RootPath != "apis" {
+		t.Fatalf("expected root path to be normalized, got %q", created.RootPath)
+	}
+	bindingID := utils.OpenAPIUUIDToString(created.Id)
+
+	synced, err := svc.SyncBinding(bindingID, gitTestOrgUUID)
+	if err != nil {
+		t.Fatalf("SyncBinding failed: %v", err)
+	}
+	if synced.SyncStatus != api.GitRepositoryBindingSyncStatusSYNCED || utils.ValueOrEmpty(synced.LastCommitSha) != firstCommit {
+		t.Fatalf("unexpected binding after sync: status=%s commit=%s error=%s", synced.SyncStatus,
+			utils.ValueOrEmpty(synced.LastCommitSha), utils.ValueOrEmpty(synced.SyncError))
+	}
+	if store.creates != 2 || store.apis["petstore"] == nil || store.apis["orders"] == nil {
+		t.Fatalf("expected petstore and orders to be created, got %d creates", store.creates)
+	}
+	if !strings.Contains(store.specs["petstore"], "title: petstore") {
+		t.Fatalf("OpenAPI definition not passed to the API: %q", store.specs["petstore"])
+	}
+	if store.apis["petstore"].ProjectId != utils.ParseOpenAPIUUIDOrZero(gitTestProjectUUID) {
+		t.Fatalf("API not created in the bound project")
+	}
+	if len(store.deployed) != 2 {
+		t.Fatalf("expected 2 deployments, got %v", store.deployed)
+	}
+
+	apis, err := svc.ListBindingAPIs(bindingID, gitTestOrgUUID)
+	if err != nil {
+		t.Fatalf("ListBindingAPIs failed: %v", err)
+	}
+	if apis.Count != 2 || apis.List[0].SourcePath != "apis/orders/api.yaml" || utils.ValueOrEmpty(apis.List[0].ApiId) != "orders" ||
+		apis.List[0].Status != api.GitBindingAPISyncStatusSYNCED || utils.ValueOrEmpty(apis.List[0].CommitSha) != firstCommit {
+		t.Fatalf("unexpected API sync records: %+v", apis.List)
+	}
+
+	// An unchanged branch is not synced again by the poller
+	binding, _ := repo.GetByUUID(bindingID)
+	if err := svc.syncBinding(context.Background(), binding, false); err != nil {
+		t.Fatalf("syncBinding failed: %v", err)
+	}
+	if store.creates != 2 || store.updates != 0 {
+		t.Fatalf("unchanged branch should not be applied, got %d creates and %d updates", store.creates, store.updates)
+	}
+
+	// Change one API and remove the other
+	changes := map[string]string{"README.md": "still not an API project\n"}
+	for name := range gitTestAPIProject("orders", "") {
+		changes[name] = ""
+	}
+	changes["apis/petstore/api.yaml"] = gitTestAPIProject("petstore", "/pets")["apis/petstore/api.yaml"]
+	secondCommit := gitRepo.commit(changes)
+
+	binding, _ = repo.GetByUUID(bindingID)
+	if err := svc.syncBinding(context.Background(), binding, false); err != nil {
+		t.Fatalf("syncBinding failed: %v", err)
+	}
+	if store.updates != 1 || store.apis["petstore"].Context != "/pets" {
+		t.Fatalf("expected petstore to be updated, got %d updates", store.updates)
+	}
+	if len(store.deletes) != 1 || store.deletes[0] != "orders" {
+		t.Fatalf("expected orders to be deleted, got %v", store.deletes)
+	}
+	if len(store.deployed) != 3 {
+		t.Fatalf("expected only the changed API to be redeployed, got %v", store.deployed)
+	}
+	apis, _ = svc.ListBindingAPIs(bindingID, gitTestOrgUUID)
+	if apis.Count != 1 || utils.ValueOrEmpty(apis.List[0].CommitSha) != secondCommit {
+		t.Fatalf("unexpected API sync records: %+v", apis.List)
+	}
+}
+
+func TestGitBindingServiceSyncRecordsInvalidProjects(t *testing.T) {
+	gitRepo := newTestGitRepository(t)
+	files := gitTestAPIProject("petstore", "/petstore")
+	files["apis/broken/.api-platform/config.yaml"] = "version: 1\napis:\n  - wso2Artifact: ../../../etc/passwd\n"
+	files["apis/nameless/.api-platform/config.yaml"] = "version: 1\napis:\n  - wso2Artifact: api.yaml\n"
+	files["apis/nameless/api.yaml"] = "apiVersion: v1\nkind: RestApi\nspec:\n  context: /nameless\n"
+	gitRepo.commit(files)
+
+	svc, _, store := newTestGitBindingService(t)
+	// An API with the same handle that the binding does not manage must not be taken over
+	store.uuids["petstore"] = "api-existing"
+
+	created := createTestGitBinding(t, svc, api.CreateGitRepositoryBindingRequest{RepoUrl: gitRepo.url(), Branch: "main"})
+	synced, err := svc.SyncBinding(utils.OpenAPIUUIDToString(created.Id), gitTestOrgUUID)
+	if err != nil {
+		t.Fatalf("SyncBinding failed: %v", err)
+	}
+	if synced.SyncStatus != api.GitRepositoryBindingSyncStatusFAILED {
+		t.Fatalf("expected FAILED status, got %s", synced.SyncStatus)
+	}
+	syncError := utils.ValueOrEmpty(synced.SyncError)
+	for _, want := range []string{"apis/broken", "metadata.name is required", "not managed by this binding"} {
+		if !strings.Contains(syncError, want) {
+			t.Fatalf("expected sync error to mention %q, got %q", want, syncError)
+		}
+	}
+	if store.creates != 0 || store.updates != 0 {
+		t.Fatalf("no API should be applied, got %d creates and %d updates", store.creates, store.updates)
+	}
+}
+
+func TestGitBindingServiceSyncUnknownBranch(t *testing.T) {
+	gitRepo := newTestGitRepository(t)
+	gitRepo.commit(gitTestAPIProject("petstore", "/petstore"))
+
+	svc, _, _ := newTestGitBindingService(t)
+	created := createTestGitBinding(t, svc, api.CreateGitRepositoryBindingRequest{RepoUrl: gitRepo.url(), Branch: "release"})
+	synced, err := svc.SyncBinding(utils.OpenAPIUUIDToString(created.Id), gitTestOrgUUID)
+	if err != nil {
+		t.Fatalf("SyncBinding failed: %v", err)
+	}
+	if synced.SyncStatus != api.GitRepositoryBindingSyncStatusFAILED ||
+		!strings.Contains(utils.ValueOrEmpty(synced.SyncError), "branch release not found") {
+		t.Fatalf("unexpected binding: status=%s error=%s", synced.SyncStatus, utils.ValueOrEmpty(synced.SyncError))
+	}
+}
+
+func TestGitBindingServiceCreateBindingValidation(t *testing.T) {
+	svc, _, _ := newTestGitBindingService(t)
+	autoDeploy := true
+	negative := -1
+	outside := "../other"
+	unknownGateway := []openapi_types.UUID{utils.ParseOpenAPIUUIDOrZero("55555555-5555-5555-5555-555555555555")}
+
+	tests := []struct {
+		name    string
+		req     api.CreateGitRepositoryBindingRequest
+		wantErr error
+	}{
+		{"ssh url", api.CreateGitRepositoryBindingRequest{RepoUrl: "git@example.com:org/apis.git", Branch: "main"}, constants.ErrInvalidGitRepositoryURL},
+		{"http url", api.CreateGitRepositoryBindingRequest{RepoUrl: "http://example.com/org/apis.git", Branch: "main"}, constants.ErrInvalidGitRepositoryURL},
+		{"option branch", api.CreateGitRepositoryBindingRequest{RepoUrl: "https://example.com/org/apis.git", Branch: "--upload-pack=x"}, constants.ErrInvalidInput},
+		{"root outside repository", api.CreateGitRepositoryBindingRequest{RepoUrl: "https://example.com/org/apis.git", Branch: "main", RootPath: &outside}, constants.ErrInvalidInput},
+		{"negative poll interval", api.CreateGitRepositoryBindingRequest{RepoUrl: "https://example.com/org/apis.git", Branch: "main", PollIntervalSeconds: &negative}, constants.ErrInvalidInput},
+		{"auto deploy without gateways", api.CreateGitRepositoryBindingRequest{RepoUrl: "https://example.com/org/apis.git", Branch: "main", AutoDeploy: &autoDeploy}, constants.ErrInvalidInput},
+		{"unknown gateway", api.CreateGitRepositoryBindingRequest{RepoUrl: "https://example.com/org/apis.git", Branch: "main", GatewayIds: &unknownGateway}, constants.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.ProjectId = utils.ParseOpenAPIUUIDOrZero(gitTestProjectUUID)
+			if _, err := svc.CreateBinding(&tt.req, gitTestOrgUUID); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	svc.config.AllowLocalRepositories = false
+	req := api.CreateGitRepositoryBindingRequest{RepoUrl: "file:///srv/git/apis.git", Branch: "main",
+		ProjectId: utils.ParseOpenAPIUUIDOrZero(gitTestProjectUUID)}
+	if _, err := svc.CreateBinding(&req, gitTestOrgUUID); !errors.Is(err, constants.ErrInvalidGitRepositoryURL) {
+		t.Fatalf("expected file URLs to be rejected, got %v", err)
+	}
+
+	req.RepoUrl = "https://example.com/org/apis.git"
+	if _, err := svc.CreateBinding(&req, "another-org"); !errors.Is(err, constants.ErrProjectNotFound) {
+		t.Fatalf("expected ErrProjectNotFound for a project of another organization, got %v", err)
+	}
+}
+
+func TestGitBindingServiceHandleWebhook(t *testing.T) {
+	svc, repo, _ := newTestGitBindingService(t)
+	secret := "webhook-secret"
+	created := createTestGitBinding(t, svc, api.CreateGitRepositoryBindingRequest{
+		RepoUrl:       "https://example.com/org/apis.git",
+		Branch:        "main",
+		WebhookSecret: &secret,
+	})
+	bindingID := utils.OpenAPIUUIDToString(created.Id)
+	if !created.WebhookSecretConfigured {
+		t.Fatal("expected webhookSecretConfigured to be set")
+	}
+	// Responses only flag that a secret is set, and never echo it
+	got, err := svc.GetBinding(bindingID, gitTestOrgUUID)
+	if err != nil {
+		t.Fatalf("GetBinding() error = %v", err)
+	}
+	list, err := svc.ListBindings(gitTestOrgUUID, "")
+	if err != nil {
+		t.Fatalf("ListBindings() error = %v", err)
+	}
+	for _, response := range []any{created, got, list} {
+		data, err := json.Marshal(response)
+		if err != nil {
+			t.Fatalf("failed to marshal response: %v", err)
+		}
+		if strings.Contains(string(data), secret) || !strings.Contains(string(data), `"webhookSecretConfigured":true`) {
+			t.Fatalf("response must flag the webhook secret without returning it: %s", data)
+		}
+	}
+
+	sign := func(payload string) string {
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write([]byte(payload))
+		return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	}
+	otherBranch := `{"ref":"refs/heads/feature"}`
+	if triggered, err := svc.HandleWebhook(bindingID, sign(otherBranch), "", []byte(otherBranch)); err != nil || triggered {
+		t.Fatalf("push to another branch should be ignored, got triggered=%v err=%v", triggered, err)
+	}
+	if _, err := svc.HandleWebhook(bindingID, sign(otherBranch), "", []byte(`{"ref":"refs/heads/main"}`)); !errors.Is(err, constants.ErrInvalidGitWebhookSignature) {
+		t.Fatalf("expected invalid signature, got %v", err)
+	}
+	if _, err := svc.HandleWebhook(bindingID, "", "wrong", nil); !errors.Is(err, constants.ErrInvalidGitWebhookSignature) {
+		t.Fatalf("expected invalid token, got %v", err)
+	}
+	if _, err := svc.HandleWebhook("unknown", "", secret, nil); !errors.Is(err, constants.ErrGitBindingNotFound) {
+		t.Fatalf("expected ErrGitBindingNotFound, got %v", err)
+	}
+
+	// Hold the sync lock so the accepted webhook only queues a sync
+	if !svc.beginSync(bindingID, false) {
+		t.Fatal("failed to mark binding as syncing")
+	}
+	if triggered, err := svc.HandleWebhook(bindingID, "", secret, []byte(`{"ref":"refs/heads/main"}`)); err != nil || !triggered {
+		t.Fatalf("expected sync to be triggered, got triggered=%v err=%v", triggered, err)
+	}
+	svc.mu.Lock()
+	queued := svc.rerun[bindingID]
+	svc.mu.Unlock()
+	if !queued {
+		t.Fatal("expected a sync to be queued behind the running one")
+	}
+	if _, err := svc.SyncBinding(bindingID, gitTestOrgUUID); !errors.Is(err, constants.ErrGitBindingSyncInProgress) {
+		t.Fatalf("expected ErrGitBindingSyncInProgress, got %v", err)
+	}
+
+	// Without a secret every webhook is rejected
+	binding, _ := repo.GetByUUID(bindingID)
+	binding.WebhookSecret = ""
+	_ = repo.Update(binding)
+	if _, err := svc.HandleWebhook(bindingID, "", "", nil); !errors.Is(err, constants.ErrInvalidGitWebhookSignature) {
+		t.Fatalf("expected webhooks to be rejected without a secret, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// GitRepositoryFetcher reads branches of git repositories. Unlike GitService it is not tied to a
+// hosting provider API and works with any repository reachable by plain git.
+type GitRepositoryFetcher interface {
+	// ResolveBranch returns the commit SHA the branch currently points to
+	ResolveBranch(ctx context.Context, repoURL, branch string) (string, error)
+	// Checkout clones the branch into dir and returns the checked out commit SHA
+	Checkout(ctx context.Context, repoURL, branch, dir string) (string, error)
+}
+
+type gitCLIFetcher struct {
+	binary string
+}
+
+// NewGitCLIFetcher creates a GitRepositoryFetcher backed by the git command line client
+func NewGitCLIFetcher() GitRepositoryFetcher {
+	return &gitCLIFetcher{binary: "git"}
+}
+
+// ResolveBranch resolves the branch head with git ls-remote, without cloning the repository
+func (f *gitCLIFetcher) ResolveBranch(ctx context.Context, repoURL, branch string) (string, error) {
+	out, err := f.run(ctx, "", "ls-remote", "--heads", "--", repoURL, "refs/heads/"+branch)
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[1] == "refs/heads/"+branch {
+			return fields[0], nil
+		}
+	}
+	return "", fmt.Errorf("branch %s not found in repository", branch)
+}
+
+// Checkout makes a shallow, single branch clone of the repository into dir
+func (f *gitCLIFetcher) Checkout(ctx context.Context, repoURL, branch, dir string) (string, error) {
+	if _, err := f.run(ctx, "", "clone", "--quiet", "--depth", "1", "--single-branch", "--branch", branch,
+		"--", repoURL, dir); err != nil {
+		return "", err
+	}
+	out, err := f.run(ctx, dir, "rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(out), nil
+}
+
+func (f *gitCLIFetcher) run(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, f.binary, args...)
+	cmd.Dir = dir
+	// Never wait for credentials on a terminal; private repositories must be reachable non-interactively
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return "", fmt.Errorf("git %s failed: %s", args[0], msg)
+	}
+	return stdout.String(), nil
+}
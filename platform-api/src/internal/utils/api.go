@@ -244,10 +244,25 @@ func (u *APIUtil) ChannelAPIToModel(channel *api.Channel) *model.Channel {
 	return &model.Channel{
 		Name:        defaultStringPtr(channel.Name),
 		Description: defaultStringPtr(channel.Description),
+		Message:     u.ChannelMessageAPIToModel(channel.Message),
 		Request:     u.ChannelRequestAPIToModel(&channel.Request),
 	}
 }
 
+func (u *APIUtil) ChannelMessageAPIToModel(message *api.ChannelMessage) *model.ChannelMessage {
+	if message == nil {
+		return nil
+	}
+	modelMessage := &model.ChannelMessage{
+		Name:        defaultStringPtr(message.Name),
+		ContentType: defaultStringPtr(message.ContentType),
+	}
+	if message.Payload != nil {
+		modelMessage.Payload = *message.Payload
+	}
+	return modelMessage
+}
+
 func (u *APIUtil) OperationRequestAPIToModel(req *api.OperationRequest) *model.OperationRequest {
 	if req == nil {
 		return nil
@@ -385,10 +400,26 @@ func (u *APIUtil) ChannelModelToAPI(modelCh *model.Channel) *api.Channel {
 	return &api.Channel{
 		Name:        StringPtrIfNotEmpty(modelCh.Name),
 		Description: StringPtrIfNotEmpty(modelCh.Description),
+		Message:     u.ChannelMessageModelToAPI(modelCh.Message),
 		Request:     request,
 	}
 }
 
+func (u *APIUtil) ChannelMessageModelToAPI(modelMessage *model.ChannelMessage) *api.ChannelMessage {
+	if modelMessage == nil {
+		return nil
+	}
+	message := &api.ChannelMessage{
+		Name:        StringPtrIfNotEmpty(modelMessage.Name),
+		ContentType: StringPtrIfNotEmpty(modelMessage.ContentType),
+	}
+	if modelMessage.Payload != nil {
+		payload := modelMessage.Payload
+		message.Payload = &payload
+	}
+	return message
+}
+
 func (u *APIUtil) OperationRequestModelToAPI(modelReq *model.OperationRequest) *api.OperationRequest {
 	if modelReq == nil {
 		return nil
@@ -579,12 +610,10 @@ func (u *APIUtil) GenerateAPIDeploymentYAML(apiModel *model.API) (string, error)
 	return string(yamlBytes), nil
 }
 
-// TODO: Enhance GenerateOpenAPIDefinition to include request/response schemas, examples,
-// detailed parameters, and complete security configurations from original OpenAPI sources
-// to make the spec more useful for API consumers. Currently generates minimal spec
-// with only available DTO data to avoid inventing information.
-// GenerateOpenAPIDefinitionFromRESTAPI generates an OpenAPI 3.0 definition from a generated api.RESTAPI model.
-func (u *APIUtil) GenerateOpenAPIDefinitionFromRESTAPI(restAPI *api.RESTAPI, req *devportal_client.APIMetadataRequest) ([]byte, error) {
+// GenerateOpenAPIDefinitionFromRESTAPI generates the OpenAPI definition published to a DevPortal. The stored
+// definition is used when there is one, with the DevPortal endpoints as servers and the security schemes of
+// the auth policies on the API merged in.
+func (u *APIUtil) GenerateOpenAPIDefinitionFromRESTAPI(restAPI *api.RESTAPI, definition string, req *devportal_client.APIMetadataRequest) ([]byte, error) {
 	if restAPI == nil {
 		return nil, fmt.Errorf("api model is required")
 	}
@@ -592,11 +621,9 @@ func (u *APIUtil) GenerateOpenAPIDefinitionFromRESTAPI(restAPI *api.RESTAPI, req
 		return nil, fmt.Errorf("metadata request is required")
 	}
 
-	openAPISpec := dto.OpenAPI{
-		OpenAPI: "3.0.3",
-		Info:    u.buildInfoSectionFromRESTAPI(restAPI),
-		Servers: u.buildServersSectionFromRESTAPI(restAPI, &req.EndPoints),
-		Paths:   u.buildPathsSectionFromRESTAPI(restAPI),
+	openAPISpec, err := u.BuildOpenAPIDefinition(restAPI, definition, u.buildServersSectionFromRESTAPI(restAPI, &req.EndPoints))
+	if err != nil {
+		return nil, err
 	}
 
 	apiDefinition, err := json.Marshal(openAPISpec)
@@ -0,0 +1,720 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"platform-api/src/api"
+	"platform-api/src/internal/dto"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// AsyncAPIExportVersion is the AsyncAPI version of generated definitions
+	AsyncAPIExportVersion = "2.6.0"
+
+	maxAsyncAPIRefDepth = 32
+)
+
+var (
+	asyncAPI2VersionPattern       = regexp.MustCompile(`^2\.\d+\.\d+$`)
+	asyncAPI3VersionPattern       = regexp.MustCompile(`^3\.0\.\d+$`)
+	asyncAPIServerVariablePattern = regexp.MustCompile(`\{([^{}]+)\}`)
+)
+
+// asyncAPIDocument is a parsed AsyncAPI 2.x or 3.0 definition. The document is kept as a generic
+// tree so that message payload schemas are carried over as written.
+type asyncAPIDocument struct {
+	root         map[string]interface{}
+	major        int
+	channelOrder []string
+	serverOrder  []string
+}
+
+type asyncAPIServer struct {
+	name     string
+	url      string
+	protocol string
+}
+
+// ValidateAndParseAsyncAPIToWebSubAPI validates AsyncAPI 2.x or 3.0 definition content and parses it into a
+// partial WebSubAPI model.
+//
+// Notes:
+//   - The returned WebSubAPI is *partial*: Id, Context and ProjectId are not present in AsyncAPI and will be empty.
+//   - Every channel is mapped to a SUB channel whose request name is the channel address.
+func (u *APIUtil) ValidateAndParseAsyncAPIToWebSubAPI(content []byte) (*api.WebSubAPI, error) {
+	document, err := parseAsyncAPIDocument(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AsyncAPI definition: %w", err)
+	}
+	if err := document.validate(); err != nil {
+		return nil, fmt.Errorf("invalid AsyncAPI definition: %w", err)
+	}
+
+	info := asyncAPIMap(document.root["info"])
+	websubAPI := &api.WebSubAPI{
+		Name:        asyncAPIString(info, "title"),
+		Version:     asyncAPIString(info, "version"),
+		Description: StringPtrIfNotEmpty(asyncAPIString(info, "description")),
+	}
+
+	if document.major == 3 {
+		websubAPI.Channels, err = document.channelsV3()
+	} else {
+		websubAPI.Channels, err = document.channelsV2()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid AsyncAPI definition: %w", err)
+	}
+
+	upstream, transport := document.upstream()
+	websubAPI.Upstream = upstream
+	if len(transport) > 0 {
+		websubAPI.Transport = &transport
+	}
+	return websubAPI, nil
+}
+
+// MergeWebSubAPIDetails merges user-provided WebSub API details with details extracted from an AsyncAPI definition.
+// User-provided details take precedence for scalar metadata and the upstream; channels come from the definition.
+func (u *APIUtil) MergeWebSubAPIDetails(userAPI *api.WebSubAPI, extractedAPI *api.WebSubAPI) *api.WebSubAPI {
+	if userAPI == nil || extractedAPI == nil {
+		return nil
+	}
+
+	merged := *userAPI
+	if merged.Name == "" {
+		merged.Name = extractedAPI.Name
+	}
+	if merged.Version == "" {
+		merged.Version = extractedAPI.Version
+	}
+	if merged.Description == nil || *merged.Description == "" {
+		merged.Description = extractedAPI.Description
+	}
+	if merged.Transport == nil || len(*merged.Transport) == 0 {
+		merged.Transport = extractedAPI.Transport
+	}
+	if isEmptyUpstreamAPI(merged.Upstream) {
+		merged.Upstream = extractedAPI.Upstream
+	}
+	if len(extractedAPI.Channels) > 0 {
+		merged.Channels = extractedAPI.Channels
+	}
+	return &merged
+}
+
+// GenerateAsyncAPIDefinitionFromWebSubAPI generates an AsyncAPI 2.6 definition from a WebSub API. Each channel
+// becomes a subscribe operation carrying the channel message, and the upstream endpoints become servers.
+func (u *APIUtil) GenerateAsyncAPIDefinitionFromWebSubAPI(websubAPI *api.WebSubAPI) (*dto.AsyncAPI, error) {
+	if websubAPI == nil {
+		return nil, fmt.Errorf("api model is required")
+	}
+
+	definition := &dto.AsyncAPI{
+		AsyncAPI: AsyncAPIExportVersion,
+		Info: dto.AsyncAPIInfo{
+			Title:       websubAPI.Name,
+			Version:     websubAPI.Version,
+			Description: StringPtrValue(websubAPI.Description),
+		},
+		Channels: make(map[string]dto.AsyncAPIChannel, len(websubAPI.Channels)),
+	}
+	if websubAPI.CreatedBy != nil && *websubAPI.CreatedBy != "" {
+		definition.Info.Contact = &dto.AsyncAPIContact{Name: *websubAPI.CreatedBy}
+	}
+
+	servers := map[string]dto.AsyncAPIServer{}
+	if server, ok := asyncAPIServerFromUpstream(&websubAPI.Upstream.Main, "Production server"); ok {
+		servers["production"] = server
+	}
+	if server, ok := asyncAPIServerFromUpstream(websubAPI.Upstream.Sandbox, "Sandbox server"); ok {
+		servers["sandbox"] = server
+	}
+	if len(servers) > 0 {
+		definition.Servers = servers
+	}
+
+	for _, channel := range websubAPI.Channels {
+		address := channel.Request.Name
+		if address == "" {
+			address = StringPtrValue(channel.Name)
+		}
+		if address == "" {
+			continue
+		}
+
+		operation := &dto.AsyncAPIOperation{}
+		if name := StringPtrValue(channel.Name); name != address {
+			operation.Summary = name
+		}
+		if channel.Message != nil {
+			operation.Message = &dto.AsyncAPIMessage{
+				Name:        StringPtrValue(channel.Message.Name),
+				ContentType: StringPtrValue(channel.Message.ContentType),
+			}
+			if channel.Message.Payload != nil {
+				operation.Message.Payload = *channel.Message.Payload
+			}
+		}
+		definition.Channels[address] = dto.AsyncAPIChannel{
+			Description: StringPtrValue(channel.Description),
+			Subscribe:   operation,
+		}
+	}
+
+	return definition, nil
+}
+
+func asyncAPIServerFromUpstream(definition *api.UpstreamDefinition, description string) (dto.AsyncAPIServer, bool) {
+	if definition == nil || definition.Url == nil || *definition.Url == "" {
+		return dto.AsyncAPIServer{}, false
+	}
+	protocol := "https"
+	if parsed, err := url.Parse(*definition.Url); err == nil && parsed.Scheme != "" {
+		protocol = strings.ToLower(parsed.Scheme)
+	}
+	return dto.AsyncAPIServer{URL: *definition.Url, Protocol: protocol, Description: description}, true
+}
+
+// parseAsyncAPIDocument parses YAML or JSON AsyncAPI content and checks its version.
+func parseAsyncAPIDocument(content []byte) (*asyncAPIDocument, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(content, &node); err != nil {
+		return nil, fmt.Errorf("failed to parse document: %s", err.Error())
+	}
+	var root map[string]interface{}
+	if err := node.Decode(&root); err != nil {
+		return nil, fmt.Errorf("failed to parse document: %s", err.Error())
+	}
+	if len(root) == 0 {
+		return nil, fmt.Errorf("document is empty")
+	}
+
+	rawVersion, ok := root["asyncapi"]
+	if !ok {
+		return nil, fmt.Errorf("missing required field: asyncapi")
+	}
+	version := fmt.Sprint(rawVersion)
+
+	document := &asyncAPIDocument{root: root}
+	switch {
+	case asyncAPI2VersionPattern.MatchString(version):
+		document.major = 2
+	case asyncAPI3VersionPattern.MatchString(version):
+		document.major = 3
+	default:
+		return nil, fmt.Errorf("unsupported AsyncAPI version %q: only 2.x and 3.0 are supported", version)
+	}
+
+	document.channelOrder = orderedAsyncAPIKeys(&node, "channels", asyncAPIMap(root["channels"]))
+	document.serverOrder = orderedAsyncAPIKeys(&node, "servers", asyncAPIMap(root["servers"]))
+	return document, nil
+}
+
+// validate checks the parts of the document the WebSub API is built from.
+func (d *asyncAPIDocument) validate() error {
+	info := asyncAPIMap(d.root["info"])
+	if info == nil {
+		return fmt.Errorf("missing required field: info")
+	}
+	if asyncAPIString(info, "title") == "" {
+		return fmt.Errorf("missing required field: info.title")
+	}
+	if asyncAPIString(info, "version") == "" {
+		return fmt.Errorf("missing required field: info.version")
+	}
+
+	channels := asyncAPIMap(d.root["channels"])
+	if len(channels) == 0 {
+		return fmt.Errorf("the definition does not declare any channels")
+	}
+	for _, name := range d.channelOrder {
+		channel, _, err := d.resolve(asyncAPIMap(channels[name]))
+		if err != nil {
+			return fmt.Errorf("channels.%s: %w", name, err)
+		}
+		if channel == nil {
+			return fmt.Errorf("channels.%s must be an object", name)
+		}
+	}
+
+	servers := asyncAPIMap(d.root["servers"])
+	for _, name := range d.serverOrder {
+		server, _, err := d.resolve(asyncAPIMap(servers[name]))
+		if err != nil {
+			return fmt.Errorf("servers.%s: %w", name, err)
+		}
+		if server == nil {
+			return fmt.Errorf("servers.%s must be an object", name)
+		}
+		if asyncAPIString(server, "protocol") == "" {
+			return fmt.Errorf("missing required field: servers.%s.protocol", name)
+		}
+		if d.major == 2 && asyncAPIString(server, "url") == "" {
+			return fmt.Errorf("missing required field: servers.%s.url", name)
+		}
+		if d.major == 3 && asyncAPIString(server, "host") == "" {
+			return fmt.Errorf("missing required field: servers.%s.host", name)
+		}
+	}
+
+	if d.major == 3 {
+		operations := asyncAPIMap(d.root["operations"])
+		for _, id := range sortedAsyncAPIKeys(operations) {
+			operation, _, err := d.resolve(asyncAPIMap(operations[id]))
+			if err != nil {
+				return fmt.Errorf("operations.%s: %w", id, err)
+			}
+			if operation == nil {
+				return fmt.Errorf("operations.%s must be an object", id)
+			}
+			if action := asyncAPIString(operation, "action"); action != "send" && action != "receive" {
+				return fmt.Errorf("operations.%s.action must be send or receive", id)
+			}
+			ref := asyncAPIString(asyncAPIMap(operation["channel"]), "$ref")
+			if ref == "" {
+				return fmt.Errorf("missing required field: operations.%s.channel", id)
+			}
+			if _, err := d.lookup(ref); err != nil {
+				return fmt.Errorf("operations.%s.channel: %w", id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// channelsV2 maps AsyncAPI 2.x channels. The subscribe operation describes the messages subscribers
+// receive, so its message is preferred over the publish operation's.
+func (d *asyncAPIDocument) channelsV2() ([]api.Channel, error) {
+	channels := asyncAPIMap(d.root["channels"])
+	result := make([]api.Channel, 0, len(channels))
+	for _, address := range d.channelOrder {
+		item, _, err := d.resolve(asyncAPIMap(channels[address]))
+		if err != nil {
+			return nil, fmt.Errorf("channels.%s: %w", address, err)
+		}
+
+		description := asyncAPIString(item, "description")
+		operation := asyncAPIMap(item["subscribe"])
+		if operation == nil {
+			operation = asyncAPIMap(item["publish"])
+		}
+		var message *api.ChannelMessage
+		if operation != nil {
+			if description == "" {
+				description = firstNonEmpty(asyncAPIString(operation, "description"), asyncAPIString(operation, "summary"))
+			}
+			message, err = d.message(operation["message"])
+			if err != nil {
+				return nil, fmt.Errorf("channels.%s: %w", address, err)
+			}
+		}
+
+		result = append(result, newImportedChannel(address, address, description, message))
+	}
+	return result, nil
+}
+
+// channelsV3 maps AsyncAPI 3.0 channels. The messages of send operations are what subscribers
+// receive; channels without a send operation fall back to all messages declared on the channel.
+func (d *asyncAPIDocument) channelsV3() ([]api.Channel, error) {
+	operations := asyncAPIMap(d.root["operations"])
+	sentMessages := map[string][]interface{}{}
+	operationDescriptions := map[string]string{}
+	for _, id := range sortedAsyncAPIKeys(operations) {
+		operation, _, err := d.resolve(asyncAPIMap(operations[id]))
+		if err != nil {
+			return nil, fmt.Errorf("operations.%s: %w", id, err)
+		}
+		if asyncAPIString(operation, "action") != "send" {
+			continue
+		}
+		ref := asyncAPIString(asyncAPIMap(operation["channel"]), "$ref")
+		if messages, ok := operation["messages"].([]interface{}); ok {
+			sentMessages[ref] = append(sentMessages[ref], messages...)
+		}
+		if _, ok := operationDescriptions[ref]; !ok {
+			operationDescriptions[ref] = firstNonEmpty(asyncAPIString(operation, "description"), asyncAPIString(operation, "summary"))
+		}
+	}
+
+	channels := asyncAPIMap(d.root["channels"])
+	result := make([]api.Channel, 0, len(channels))
+	for _, id := range d.channelOrder {
+		channel, _, err := d.resolve(asyncAPIMap(channels[id]))
+		if err != nil {
+			return nil, fmt.Errorf("channels.%s: %w", id, err)
+		}
+		ref := "#/channels/" + escapeJSONPointer(id)
+
+		rawMessages := sentMessages[ref]
+		if len(rawMessages) == 0 {
+			declared := asyncAPIMap(channel["messages"])
+			for _, key := range sortedAsyncAPIKeys(declared) {
+				rawMessages = append(rawMessages, map[string]interface{}{"$ref": ref + "/messages/" + escapeJSONPointer(key)})
+			}
+		}
+		messages := make([]*api.ChannelMessage, 0, len(rawMessages))
+		for _, raw := range rawMessages {
+			message, err := d.message(raw)
+			if err != nil {
+				return nil, fmt.Errorf("channels.%s: %w", id, err)
+			}
+			if message != nil {
+				messages = append(messages, message)
+			}
+		}
+
+		address := asyncAPIString(channel, "address")
+		if address == "" {
+			address = id
+		}
+		name := firstNonEmpty(asyncAPIString(channel, "title"), id)
+		description := firstNonEmpty(asyncAPIString(channel, "description"), operationDescriptions[ref])
+		result = append(result, newImportedChannel(name, address, description, combineChannelMessages(messages)))
+	}
+	return result, nil
+}
+
+func newImportedChannel(name, address, description string, message *api.ChannelMessage) api.Channel {
+	return api.Channel{
+		Name:        StringPtrIfNotEmpty(name),
+		Description: StringPtrIfNotEmpty(description),
+		Message:     message,
+		Request: api.ChannelRequest{
+			Method:   api.SUB,
+			Name:     address,
+			Policies: &[]api.Policy{},
+		},
+	}
+}
+
+// message maps an AsyncAPI message object, reference or 2.x oneOf list to a channel message.
+func (d *asyncAPIDocument) message(raw interface{}) (*api.ChannelMessage, error) {
+	object, id, err := d.resolve(asyncAPIMap(raw))
+	if err != nil || object == nil {
+		return nil, err
+	}
+
+	if oneOf, ok := object["oneOf"].([]interface{}); ok {
+		messages := make([]*api.ChannelMessage, 0, len(oneOf))
+		for _, item := range oneOf {
+			message, err := d.message(item)
+			if err != nil {
+				return nil, err
+			}
+			if message != nil {
+				messages = append(messages, message)
+			}
+		}
+		return combineChannelMessages(messages), nil
+	}
+
+	message := &api.ChannelMessage{
+		Name:        StringPtrIfNotEmpty(firstNonEmpty(asyncAPIString(object, "name"), id)),
+		ContentType: StringPtrIfNotEmpty(firstNonEmpty(asyncAPIString(object, "contentType"), asyncAPIString(d.root, "defaultContentType"))),
+	}
+	payload := asyncAPIMap(object["payload"])
+	// AsyncAPI 3.0 multi-format schemas wrap the schema together with its format
+	if schema := asyncAPIMap(payload["schema"]); schema != nil && payload["schemaFormat"] != nil {
+		payload = schema
+	}
+	if payload != nil {
+		if inlined, ok := d.inlineSchema(payload, map[string]bool{}).(map[string]interface{}); ok {
+			message.Payload = &inlined
+		}
+	}
+	return message, nil
+}
+
+// combineChannelMessages folds the messages of a channel into one whose payload is a oneOf of
+// the individual payloads.
+func combineChannelMessages(messages []*api.ChannelMessage) *api.ChannelMessage {
+	switch len(messages) {
+	case 0:
+		return nil
+	case 1:
+		return messages[0]
+	}
+
+	contentType := messages[0].ContentType
+	schemas := make([]interface{}, 0, len(messages))
+	for _, message := range messages {
+		if message.Payload != nil {
+			schemas = append(schemas, *message.Payload)
+		}
+		if StringPtrValue(message.ContentType) != StringPtrValue(contentType) {
+			contentType = nil
+		}
+	}
+	combined := &api.ChannelMessage{ContentType: contentType}
+	if len(schemas) > 0 {
+		payload := map[string]interface{}{"oneOf": schemas}
+		combined.Payload = &payload
+	}
+	return combined
+}
+
+// upstream picks the upstream endpoints and transports from the HTTP servers of the definition.
+// A server named production (or prod) becomes the main endpoint and one named sandbox the sandbox
+// endpoint; otherwise the first HTTP server is the main endpoint.
+func (d *asyncAPIDocument) upstream() (api.Upstream, []api.WebSubAPITransport) {
+	var main, sandbox, first *asyncAPIServer
+	var transport []api.WebSubAPITransport
+	for _, server := range d.servers() {
+		if server.protocol != "http" && server.protocol != "https" {
+			continue
+		}
+		if !slices.Contains(transport, api.WebSubAPITransport(server.protocol)) {
+			transport = append(transport, api.WebSubAPITransport(server.protocol))
+		}
+
+		switch strings.ToLower(server.name) {
+		case "production", "prod":
+			if main == nil {
+				main = &server
+			}
+		case "sandbox":
+			if sandbox == nil {
+				sandbox = &server
+			}
+		default:
+			if first == nil {
+				first = &server
+			}
+		}
+	}
+	if main == nil {
+		main = first
+	}
+
+	upstream := api.Upstream{}
+	if main != nil {
+		upstream.Main = api.UpstreamDefinition{Url: StringPtrIfNotEmpty(main.url)}
+	}
+	if sandbox != nil {
+		upstream.Sandbox = &api.UpstreamDefinition{Url: StringPtrIfNotEmpty(sandbox.url)}
+	}
+	return upstream, transport
+}
+
+// servers returns the servers of the definition in document order with their URLs expanded.
+func (d *asyncAPIDocument) servers() []asyncAPIServer {
+	servers := asyncAPIMap(d.root["servers"])
+	result := make([]asyncAPIServer, 0, len(servers))
+	for _, name := range d.serverOrder {
+		server, _, err := d.resolve(asyncAPIMap(servers[name]))
+		if err != nil || server == nil {
+			continue
+		}
+		protocol := strings.ToLower(asyncAPIString(server, "protocol"))
+
+		address := asyncAPIString(server, "url")
+		if d.major == 3 {
+			address = asyncAPIString(server, "host") + asyncAPIString(server, "pathname")
+		}
+		address = expandServerVariables(address, asyncAPIMap(server["variables"]))
+		if address == "" {
+			continue
+		}
+		if strings.Contains(address, "://") {
+			if parsed, err := url.Parse(address); err == nil && parsed.Scheme != "" {
+				protocol = strings.ToLower(parsed.Scheme)
+			}
+		} else if protocol != "" {
+			address = protocol + "://" + address
+		}
+
+		result = append(result, asyncAPIServer{name: name, url: address, protocol: protocol})
+	}
+	return result
+}
+
+// expandServerVariables replaces {variable} placeholders in a server URL with their default values.
+func expandServerVariables(address string, variables map[string]interface{}) string {
+	return asyncAPIServerVariablePattern.ReplaceAllStringFunc(address, func(placeholder string) string {
+		variable := asyncAPIMap(variables[placeholder[1:len(placeholder)-1]])
+		if value := asyncAPIString(variable, "default"); value != "" {
+			return value
+		}
+		if values, ok := variable["enum"].([]interface{}); ok && len(values) > 0 {
+			return fmt.Sprint(values[0])
+		}
+		return placeholder
+	})
+}
+
+// resolve follows local $ref chains starting at obj. It returns the resolved object together with
+// the last name in the reference path, which is the component name for referenced components.
+func (d *asyncAPIDocument) resolve(obj map[string]interface{}) (map[string]interface{}, string, error) {
+	name := ""
+	for depth := 0; obj != nil; depth++ {
+		ref, ok := obj["$ref"].(string)
+		if !ok {
+			return obj, name, nil
+		}
+		if depth >= maxAsyncAPIRefDepth {
+			return nil, "", fmt.Errorf("reference %s is circular", ref)
+		}
+		target, err := d.lookup(ref)
+		if err != nil {
+			return nil, "", err
+		}
+		obj = target
+		name = unescapeJSONPointer(ref[strings.LastIndex(ref, "/")+1:])
+	}
+	return nil, name, nil
+}
+
+// lookup returns the object a local JSON pointer reference such as #/components/messages/x points to.
+func (d *asyncAPIDocument) lookup(ref string) (map[string]interface{}, error) {
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("unsupported reference %s: only local references are supported", ref)
+	}
+	var current interface{} = d.root
+	for _, segment := range strings.Split(ref[2:], "/") {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unresolvable reference %s", ref)
+		}
+		if current, ok = object[unescapeJSONPointer(segment)]; !ok {
+			return nil, fmt.Errorf("unresolvable reference %s", ref)
+		}
+	}
+	object, ok := current.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unresolvable reference %s", ref)
+	}
+	return object, nil
+}
+
+// inlineSchema returns a copy of a schema with local references replaced by their targets, so that
+// payload schemas stay self-contained once detached from the document. Circular and unresolvable
+// references are kept as they are.
+func (d *asyncAPIDocument) inlineSchema(value interface{}, visiting map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok && !visiting[ref] {
+			if target, err := d.lookup(ref); err == nil {
+				visiting[ref] = true
+				inlined := d.inlineSchema(target, visiting)
+				delete(visiting, ref)
+				return inlined
+			}
+		}
+		copied := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			copied[key] = d.inlineSchema(item, visiting)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = d.inlineSchema(item, visiting)
+		}
+		return copied
+	default:
+		return value
+	}
+}
+
+// orderedAsyncAPIKeys returns the keys of a top-level mapping in document order. Keys the node walk
+// cannot see, such as ones added through YAML merge keys, follow in sorted order.
+func orderedAsyncAPIKeys(node *yaml.Node, field string, values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value != field || node.Content[i+1].Kind != yaml.MappingNode {
+				continue
+			}
+			mapping := node.Content[i+1]
+			for j := 0; j+1 < len(mapping.Content); j += 2 {
+				key := mapping.Content[j].Value
+				if _, ok := values[key]; ok && !seen[key] {
+					keys = append(keys, key)
+					seen[key] = true
+				}
+			}
+		}
+	}
+
+	for _, key := range sortedAsyncAPIKeys(values) {
+		if !seen[key] {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func sortedAsyncAPIKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func asyncAPIMap(value interface{}) map[string]interface{} {
+	m, _ := value.(map[string]interface{})
+	return m
+}
+
+func asyncAPIString(values map[string]interface{}, key string) string {
+	switch v := values[key].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	default:
+		// Unquoted YAML scalars such as version: 1.0 decode as numbers
+		return fmt.Sprint(v)
+	}
+}
+
+func escapeJSONPointer(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
+
+func unescapeJSONPointer(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,312 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"platform-api/src/api"
+)
+
+const asyncAPI2Definition = `
+asyncapi: 2.6.0
+info:
+  title: Issue Events
+  version: 1.2.0
+  description: Events about repository issues
+defaultContentType: application/json
+servers:
+  sandbox:
+    url: https://sandbox.example.com/hub
+    protocol: https
+  production:
+    url: "{scheme}://hub.example.com/{stage}"
+    protocol: https
+    variables:
+      scheme:
+        enum: [https]
+      stage:
+        default: v1
+  broker:
+    url: broker.example.com:9092
+    protocol: kafka
+channels:
+  issues/opened:
+    description: Issues that were opened
+    subscribe:
+      message:
+        $ref: '#/components/messages/IssueOpened'
+  issues/closed:
+    subscribe:
+      summary: Issues that were closed
+      message:
+        oneOf:
+          - $ref: '#/components/messages/IssueOpened'
+          - name: IssueClosed
+            payload:
+              type: object
+              properties:
+                reason:
+                  type: string
+components:
+  messages:
+    IssueOpened:
+      payload:
+        $ref: '#/components/schemas/Issue'
+  schemas:
+    Issue:
+      type: object
+      properties:
+        id:
+          type: integer
+        author:
+          $ref: '#/components/schemas/User'
+    User:
+      type: object
+      properties:
+        login:
+          type: string
+`
+
+const asyncAPI3Definition = `{
+  "asyncapi": "3.0.0",
+  "info": {"title": "Orders", "version": "2.0.0"},
+  "servers": {
+    "local": {"host": "localhost:8080", "pathname": "/orders", "protocol": "http"}
+  },
+  "channels": {
+    "orderCreated": {
+      "address": "orders/created",
+      "title": "Order created",
+      "messages": {
+        "created": {"contentType": "application/cloudevents+json", "payload": {"type": "object"}}
+      }
+    },
+    "audit": {
+      "messages": {
+        "entry": {"payload": {"schemaFormat": "application/schema+json;version=draft-07", "schema": {"type": "string"}}}
+      }
+    }
+  },
+  "operations": {
+    "sendOrderCreated": {
+      "action": "send",
+      "summary": "An order was created",
+      "channel": {"$ref": "#/channels/orderCreated"},
+      "messages": [{"$ref": "#/channels/orderCreated/messages/created"}]
+    }
+  }
+}`
+
+func TestValidateAndParseAsyncAPIToWebSubAPI_V2(t *testing.T) {
+	u := &APIUtil{}
+	websubAPI, err := u.ValidateAndParseAsyncAPIToWebSubAPI([]byte(asyncAPI2Definition))
+	if err != nil {
+		t.Fatalf("ValidateAndParseAsyncAPIToWebSubAPI() error = %v", err)
+	}
+
+	if websubAPI.Name != "Issue Events" || websubAPI.Version != "1.2.0" {
+		t.Errorf("name/version = %q/%q", websubAPI.Name, websubAPI.Version)
+	}
+	if StringPtrValue(websubAPI.Description) != "Events about repository issues" {
+		t.Errorf("description = %q", StringPtrValue(websubAPI.Description))
+	}
+
+	if got := StringPtrValue(websubAPI.Upstream.Main.Url); got != "https://hub.example.com/v1" {
+		t.Errorf("main upstream = %q, want the production server with variables expanded", got)
+	}
+	if websubAPI.Upstream.Sandbox == nil || StringPtrValue(websubAPI.Upstream.Sandbox.Url) != "https://sandbox.example.com/hub" {
+		t.Errorf("sandbox upstream = %+v", websubAPI.Upstream.Sandbox)
+	}
+	if websubAPI.Transport == nil || !reflect.DeepEqual(*websubAPI.Transport, []api.WebSubAPITransport{api.Https}) {
+		t.Errorf("transport = %v, want only https", websubAPI.Transport)
+	}
+
+	if len(websubAPI.Channels) != 2 {
+		t.Fatalf("channels = %d, want 2", len(websubAPI.Channels))
+	}
+	opened := websubAPI.Channels[0]
+	if opened.Request.Name != "issues/opened" || opened.Request.Method != api.SUB {
+		t.Errorf("first channel request = %+v, want SUB issues/opened in document order", opened.Request)
+	}
+	if StringPtrValue(opened.Description) != "Issues that were opened" {
+		t.Errorf("first channel description = %q", StringPtrValue(opened.Description))
+	}
+	if opened.Message == nil || StringPtrValue(opened.Message.Name) != "IssueOpened" ||
+		StringPtrValue(opened.Message.ContentType) != "application/json" {
+		t.Fatalf("first channel message = %+v", opened.Message)
+	}
+	author := (*opened.Message.Payload)["properties"].(map[string]interface{})["author"].(map[string]interface{})
+	if author["type"] != "object" {
+		t.Errorf("nested payload reference was not inlined: %v", author)
+	}
+
+	closed := websubAPI.Channels[1]
+	if StringPtrValue(closed.Description) != "Issues that were closed" {
+		t.Errorf("second channel description = %q, want the operation summary", StringPtrValue(closed.Description))
+	}
+	if closed.Message == nil || closed.Message.Payload == nil {
+		t.Fatalf("second channel message = %+v", closed.Message)
+	}
+	if oneOf, ok := (*closed.Message.Payload)["oneOf"].([]interface{}); !ok || len(oneOf) != 2 {
+		t.Errorf("second channel payload = %v, want a oneOf of both messages", *closed.Message.Payload)
+	}
+}
+
+func TestValidateAndParseAsyncAPIToWebSubAPI_V3(t *testing.T) {
+	u := &APIUtil{}
+	websubAPI, err := u.ValidateAndParseAsyncAPIToWebSubAPI([]byte(asyncAPI3Definition))
+	if err != nil {
+		t.Fatalf("ValidateAndParseAsyncAPIToWebSubAPI() error = %v", err)
+	}
+
+	if got := StringPtrValue(websubAPI.Upstream.Main.Url); got != "http://localhost:8080/orders" {
+		t.Errorf("main upstream = %q", got)
+	}
+	if len(websubAPI.Channels) != 2 {
+		t.Fatalf("channels = %d, want 2", len(websubAPI.Channels))
+	}
+
+	created := websubAPI.Channels[0]
+	if created.Request.Name != "orders/created" || StringPtrValue(created.Name) != "Order created" {
+		t.Errorf("first channel = %q (%q), want address orders/created titled Order created", created.Request.Name, StringPtrValue(created.Name))
+	}
+	if StringPtrValue(created.Description) != "An order was created" {
+		t.Errorf("first channel description = %q, want the send operation summary", StringPtrValue(created.Description))
+	}
+	if created.Message == nil || StringPtrValue(created.Message.Name) != "created" ||
+		StringPtrValue(created.Message.ContentType) != "application/cloudevents+json" {
+		t.Errorf("first channel message = %+v", created.Message)
+	}
+
+	audit := websubAPI.Channels[1]
+	if audit.Request.Name != "audit" {
+		t.Errorf("second channel address = %q, want the channel id when no address is set", audit.Request.Name)
+	}
+	if audit.Message == nil || audit.Message.Payload == nil || (*audit.Message.Payload)["type"] != "string" {
+		t.Errorf("second channel message = %+v, want the multi-format schema unwrapped", audit.Message)
+	}
+}
+
+func TestValidateAndParseAsyncAPIToWebSubAPI_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "not asyncapi", content: "openapi: 3.0.0\ninfo: {title: x, version: '1'}\n", wantErr: "missing required field: asyncapi"},
+		{name: "unsupported version", content: "asyncapi: 1.2.0\n", wantErr: "unsupported AsyncAPI version"},
+		{name: "missing title", content: "asyncapi: 2.6.0\ninfo: {version: '1'}\nchannels: {a: {}}\n", wantErr: "info.title"},
+		{name: "no channels", content: "asyncapi: 2.6.0\ninfo: {title: x, version: '1'}\n", wantErr: "does not declare any channels"},
+		{name: "server without url", content: "asyncapi: 2.6.0\ninfo: {title: x, version: '1'}\nservers: {prod: {protocol: https}}\nchannels: {a: {}}\n", wantErr: "servers.prod.url"},
+		{name: "dangling message reference", content: "asyncapi: 2.6.0\ninfo: {title: x, version: '1'}\nchannels: {a: {subscribe: {message: {$ref: '#/components/messages/missing'}}}}\n", wantErr: "unresolvable reference"},
+		{name: "bad operation action", content: "asyncapi: 3.0.0\ninfo: {title: x, version: '1'}\nchannels: {a: {}}\noperations: {op: {action: publish, channel: {$ref: '#/channels/a'}}}\n", wantErr: "operations.op.action"},
+		{name: "dangling channel reference", content: "asyncapi: 3.0.0\ninfo: {title: x, version: '1'}\nchannels: {a: {}}\noperations: {op: {action: send, channel: {$ref: '#/channels/b'}}}\n", wantErr: "operations.op.channel"},
+	}
+
+	u := &APIUtil{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := u.ValidateAndParseAsyncAPIToWebSubAPI([]byte(tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMergeWebSubAPIDetails(t *testing.T) {
+	u := &APIUtil{}
+	extracted, err := u.ValidateAndParseAsyncAPIToWebSubAPI([]byte(asyncAPI2Definition))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userURL := "https://internal.example.com/hub"
+	context := "/issues"
+	merged := u.MergeWebSubAPIDetails(&api.WebSubAPI{
+		Name:      "Custom Name",
+		Context:   &context,
+		ProjectId: "project-1",
+		Upstream:  api.Upstream{Main: api.UpstreamDefinition{Url: &userURL}},
+	}, extracted)
+
+	if merged.Name != "Custom Name" || merged.Version != "1.2.0" {
+		t.Errorf("name/version = %q/%q, want the user name and the definition version", merged.Name, merged.Version)
+	}
+	if StringPtrValue(merged.Upstream.Main.Url) != userURL {
+		t.Errorf("upstream = %q, want the user upstream", StringPtrValue(merged.Upstream.Main.Url))
+	}
+	if len(merged.Channels) != 2 || merged.ProjectId != "project-1" {
+		t.Errorf("merged = %+v", merged)
+	}
+}
+
+func TestGenerateAsyncAPIDefinitionFromWebSubAPI_RoundTrip(t *testing.T) {
+	u := &APIUtil{}
+	imported, err := u.ValidateAndParseAsyncAPIToWebSubAPI([]byte(asyncAPI2Definition))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	definition, err := u.GenerateAsyncAPIDefinitionFromWebSubAPI(imported)
+	if err != nil {
+		t.Fatalf("GenerateAsyncAPIDefinitionFromWebSubAPI() error = %v", err)
+	}
+	if definition.AsyncAPI != AsyncAPIExportVersion {
+		t.Errorf("asyncapi = %q", definition.AsyncAPI)
+	}
+	if definition.Servers["production"].URL != "https://hub.example.com/v1" || definition.Servers["sandbox"].Protocol != "https" {
+		t.Errorf("servers = %+v", definition.Servers)
+	}
+	opened, ok := definition.Channels["issues/opened"]
+	if !ok || opened.Subscribe == nil || opened.Subscribe.Message == nil || opened.Subscribe.Message.Name != "IssueOpened" {
+		t.Fatalf("issues/opened channel = %+v", opened)
+	}
+
+	content, err := yaml.Marshal(definition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reimported, err := u.ValidateAndParseAsyncAPIToWebSubAPI(content)
+	if err != nil {
+		t.Fatalf("re-importing the exported definition failed: %v\n%s", err, content)
+	}
+	if len(reimported.Channels) != len(imported.Channels) {
+		t.Fatalf("re-imported channels = %d, want %d", len(reimported.Channels), len(imported.Channels))
+	}
+	for i := range imported.Channels {
+		// Exported channels are keyed by address, so their order follows the YAML map order
+		var match *api.Channel
+		for j := range reimported.Channels {
+			if reimported.Channels[j].Request.Name == imported.Channels[i].Request.Name {
+				match = &reimported.Channels[j]
+			}
+		}
+		if match == nil || !reflect.DeepEqual(match.Message, imported.Channels[i].Message) {
+			t.Errorf("channel %q did not survive the round trip: %+v", imported.Channels[i].Request.Name, match)
+		}
+	}
+	if !reflect.DeepEqual(reimported.Upstream, imported.Upstream) {
+		t.Errorf("re-imported upstream = %+v, want %+v", reimported.Upstream, imported.Upstream)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"platform-api/src/api"
+)
+
+// harLog is the part of a HAR 1.2 file the importer reads
+type harLog struct {
+	Log struct {
+		Pages []struct {
+			Title string `json:"title"`
+		} `json:"pages"`
+		Entries []harEntry `json:"entries"`
+	} `json:"log"`
+}
+
+type harEntry struct {
+	Request struct {
+		Method   string      `json:"method"`
+		URL      string      `json:"url"`
+		Headers  []harHeader `json:"headers"`
+		PostData *struct {
+			MimeType string      `json:"mimeType"`
+			Text     string      `json:"text"`
+			Params   []harHeader `json:"params"`
+		} `json:"postData"`
+	} `json:"request"`
+	Response struct {
+		Status     int    `json:"status"`
+		StatusText string `json:"statusText"`
+		Content    struct {
+			MimeType string `json:"mimeType"`
+			Text     string `json:"text"`
+			Encoding string `json:"encoding"`
+		} `json:"content"`
+	} `json:"response"`
+}
+
+type harHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ConvertHARToRESTAPI infers a REST API from the traffic captured in a HAR file. It returns the API
+// creation request together with a generated OpenAPI 3.0 definition that carries the schemas inferred
+// from the captured bodies.
+//
+// Notes:
+//   - Requests for web page resources (HTML, scripts, styles, images, fonts) and CORS preflight
+//     requests are skipped.
+//   - The API is named after the first page title, or the host of the captured API calls.
+//   - Captured bodies only shape the inferred schemas. They are not copied into the definition as
+//     examples, since recorded traffic can carry tokens and personal data.
+func (u *APIUtil) ConvertHARToRESTAPI(content []byte) (*api.CreateRESTAPIRequest, []byte, error) {
+	var har harLog
+	if err := json.Unmarshal(content, &har); err != nil {
+		return nil, nil, fmt.Errorf("invalid HAR file: %w", err)
+	}
+	if har.Log.Entries == nil {
+		return nil, nil, fmt.Errorf("invalid HAR file: log.entries is required")
+	}
+
+	var exchanges []importedExchange
+	for _, entry := range har.Log.Entries {
+		requestURL, err := url.Parse(entry.Request.URL)
+		if err != nil || requestURL.Host == "" || (requestURL.Scheme != "http" && requestURL.Scheme != "https") {
+			continue
+		}
+		if isHARPageResource(entry.Response.Content.MimeType) || isHARPreflight(&entry) {
+			continue
+		}
+
+		exchange := importedExchange{
+			Captured:            true,
+			Method:              entry.Request.Method,
+			URL:                 requestURL,
+			ResponseStatus:      entry.Response.Status,
+			ResponseContentType: entry.Response.Content.MimeType,
+			ResponseBody:        []byte(entry.Response.Content.Text),
+		}
+		if entry.Response.Content.Encoding == "base64" {
+			decoded, err := base64.StdEncoding.DecodeString(entry.Response.Content.Text)
+			if err != nil {
+				decoded = nil
+			}
+			exchange.ResponseBody = decoded
+		}
+		if postData := entry.Request.PostData; postData != nil {
+			exchange.RequestContentType = postData.MimeType
+			exchange.RequestBody = []byte(postData.Text)
+			if postData.Text == "" {
+				for _, param := range postData.Params {
+					exchange.FormFields = append(exchange.FormFields, param.Name)
+				}
+			}
+		}
+		exchanges = append(exchanges, exchange)
+	}
+	if len(exchanges) == 0 {
+		return nil, nil, fmt.Errorf("no API requests found in HAR file")
+	}
+
+	name := ""
+	if len(har.Log.Pages) > 0 {
+		name = har.Log.Pages[0].Title
+	}
+	if parsed, err := url.Parse(name); strings.TrimSpace(name) == "" || (err == nil && parsed.Host != "") {
+		// Browsers use the page URL as its title when the page has none
+		origin := primaryImportedOrigin(exchanges)
+		name = strings.TrimPrefix(strings.TrimPrefix(origin, "https://"), "http://")
+	}
+	return u.buildImportedRESTAPI(name, "", exchanges)
+}
+
+// isHARPageResource reports whether a response is part of rendering a web page rather than an API call
+func isHARPageResource(mimeType string) bool {
+	mimeType = strings.ToLower(strings.TrimSpace(strings.Split(mimeType, ";")[0]))
+	switch {
+	case mimeType == "text/html", mimeType == "text/css":
+		return true
+	case strings.HasPrefix(mimeType, "image/"), strings.HasPrefix(mimeType, "font/"),
+		strings.HasPrefix(mimeType, "audio/"), strings.HasPrefix(mimeType, "video/"):
+		return true
+	case strings.Contains(mimeType, "javascript"):
+		return true
+	}
+	return false
+}
+
+func isHARPreflight(entry *harEntry) bool {
+	if !strings.EqualFold(entry.Request.Method, "OPTIONS") {
+		return false
+	}
+	for _, header := range entry.Request.Headers {
+		if strings.EqualFold(header.Name, "Access-Control-Request-Method") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,487 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"platform-api/src/api"
+	"platform-api/src/internal/dto"
+	"platform-api/src/internal/model"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// Gateway policies that are described as security schemes in exported definitions
+	apiKeyAuthPolicyName = "api-key-auth"
+	jwtAuthPolicyName    = "jwt-auth"
+	basicAuthPolicyName  = "basic-auth"
+
+	// defaultAPIKeyHeader is the header the api-key-auth policy reads when no key is configured
+	defaultAPIKeyHeader = "API-Key"
+)
+
+// openAPIMethods are the path item keys that hold operations
+var openAPIMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// SyncOpenAPIDefinitionOperations aligns the paths of a stored OpenAPI definition with the
+// operations of the API. Operations that were removed are dropped from the definition, new
+// operations are added with their path parameters, and renamed operations get their summary and
+// description updated. Everything else in the definition, such as request and response schemas,
+// is kept as written. The definition is returned unchanged when it already matches.
+func (u *APIUtil) SyncOpenAPIDefinitionOperations(definition string, operations []api.Operation) (string, error) {
+	if strings.TrimSpace(definition) == "" {
+		return definition, nil
+	}
+	root, err := parseOpenAPIDefinitionTree([]byte(definition))
+	if err != nil {
+		return "", err
+	}
+	swagger2 := isSwagger2Definition(root)
+
+	paths := asyncAPIMap(root["paths"])
+	if paths == nil {
+		paths = map[string]interface{}{}
+	}
+	changed := root["paths"] == nil
+
+	wanted := make(map[string]map[string]api.Operation)
+	for _, operation := range operations {
+		path := operation.Request.Path
+		if wanted[path] == nil {
+			wanted[path] = make(map[string]api.Operation)
+		}
+		wanted[path][strings.ToLower(string(operation.Request.Method))] = operation
+	}
+
+	for path, rawItem := range paths {
+		item := asyncAPIMap(rawItem)
+		if item == nil {
+			continue
+		}
+		for _, method := range openAPIMethods {
+			if _, ok := item[method]; !ok {
+				continue
+			}
+			if _, ok := wanted[path][method]; !ok {
+				delete(item, method)
+				changed = true
+			}
+		}
+		if !hasOpenAPIOperations(item) {
+			delete(paths, path)
+			changed = true
+		}
+	}
+
+	for path, methods := range wanted {
+		item := asyncAPIMap(paths[path])
+		if item == nil {
+			item = map[string]interface{}{}
+			paths[path] = item
+		}
+		for method, operation := range methods {
+			existing := asyncAPIMap(item[method])
+			if existing == nil {
+				item[method] = u.newOpenAPIOperation(path, operation, swagger2)
+				changed = true
+				continue
+			}
+			if operation.Name != nil && *operation.Name != "" && asyncAPIString(existing, "summary") != *operation.Name {
+				existing["summary"] = *operation.Name
+				changed = true
+			}
+			if operation.Description != nil && *operation.Description != "" && asyncAPIString(existing, "description") != *operation.Description {
+				existing["description"] = *operation.Description
+				changed = true
+			}
+		}
+	}
+
+	if !changed {
+		return definition, nil
+	}
+	root["paths"] = paths
+
+	var content []byte
+	if isJSONContent([]byte(definition)) {
+		content, err = json.MarshalIndent(root, "", "  ")
+	} else {
+		content, err = yaml.Marshal(root)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal OpenAPI definition: %w", err)
+	}
+	return string(content), nil
+}
+
+// BuildOpenAPIDefinition builds the OpenAPI definition published for a REST API. It starts from
+// the stored definition so that schemas and examples are kept, or from a definition generated from
+// the operations when none was stored. The servers replace the ones in the definition, and the
+// api-key-auth, jwt-auth and basic-auth policies attached to the API and its operations are added
+// as security schemes and security requirements.
+func (u *APIUtil) BuildOpenAPIDefinition(restAPI *api.RESTAPI, definition string, servers []dto.Server) (map[string]interface{}, error) {
+	if restAPI == nil {
+		return nil, fmt.Errorf("api model is required")
+	}
+
+	var root map[string]interface{}
+	var err error
+	if strings.TrimSpace(definition) != "" {
+		root, err = parseOpenAPIDefinitionTree([]byte(definition))
+	} else {
+		root, err = u.generateOpenAPIDefinitionTree(restAPI)
+	}
+	if err != nil {
+		return nil, err
+	}
+	swagger2 := isSwagger2Definition(root)
+
+	info := asyncAPIMap(root["info"])
+	if info == nil {
+		info = map[string]interface{}{}
+		root["info"] = info
+	}
+	info["title"] = restAPI.Name
+	info["version"] = restAPI.Version
+	if restAPI.Description != nil && *restAPI.Description != "" {
+		info["description"] = *restAPI.Description
+	}
+
+	// Servers in the stored definition point at the backend, which is the upstream of the API
+	if swagger2 {
+		setSwagger2Servers(root, servers)
+	} else if len(servers) > 0 {
+		list := make([]interface{}, 0, len(servers))
+		for _, server := range servers {
+			entry := map[string]interface{}{"url": server.URL}
+			if server.Description != "" {
+				entry["description"] = server.Description
+			}
+			list = append(list, entry)
+		}
+		root["servers"] = list
+	} else {
+		delete(root, "servers")
+	}
+
+	u.mergeOpenAPISecurity(root, restAPI, swagger2)
+	return root, nil
+}
+
+// MarshalOpenAPIDefinition encodes a definition built by BuildOpenAPIDefinition as yaml or json
+func (u *APIUtil) MarshalOpenAPIDefinition(root map[string]interface{}, format string) ([]byte, error) {
+	switch format {
+	case "", "yaml":
+		content, err := yaml.Marshal(root)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal OpenAPI definition to YAML: %w", err)
+		}
+		return content, nil
+	case "json":
+		content, err := json.MarshalIndent(root, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal OpenAPI definition to JSON: %w", err)
+		}
+		return content, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+// BuildGatewayServers returns an OpenAPI server per distinct gateway vhost, with the API context appended
+func (u *APIUtil) BuildGatewayServers(context string, gateways []*model.APIGatewayWithDetails) []dto.Server {
+	servers := make([]dto.Server, 0, len(gateways))
+	seen := make(map[string]bool)
+	for _, gateway := range gateways {
+		vhost := strings.TrimSpace(gateway.Vhost)
+		if vhost == "" {
+			continue
+		}
+		serverURL := strings.TrimRight("https://"+vhost+"/"+strings.TrimLeft(context, "/"), "/")
+		if seen[serverURL] {
+			continue
+		}
+		seen[serverURL] = true
+		servers = append(servers, dto.Server{
+			URL:         serverURL,
+			Description: firstNonEmpty(gateway.DisplayName, gateway.Name) + " gateway",
+		})
+	}
+	return servers
+}
+
+// generateOpenAPIDefinitionTree generates a minimal definition from the operations of the API
+func (u *APIUtil) generateOpenAPIDefinitionTree(restAPI *api.RESTAPI) (map[string]interface{}, error) {
+	spec := dto.OpenAPI{
+		OpenAPI: "3.0.3",
+		Info:    u.buildInfoSectionFromRESTAPI(restAPI),
+		Paths:   u.buildPathsSectionFromRESTAPI(restAPI),
+	}
+	for path, item := range spec.Paths {
+		for _, operation := range []*dto.OpenAPIOperation{item.Get, item.Post, item.Put, item.Delete,
+			item.Options, item.Head, item.Patch, item.Trace} {
+			if operation != nil && len(operation.Responses) == 0 {
+				operation.Responses = map[string]dto.Response{"default": {Description: "Response from the backend"}}
+			}
+		}
+		spec.Paths[path] = item
+	}
+
+	content, err := json.Marshal(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OpenAPI definition: %w", err)
+	}
+	var root map[string]interface{}
+	if err := json.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("failed to build OpenAPI definition: %w", err)
+	}
+	return root, nil
+}
+
+// newOpenAPIOperation describes an operation that is not in the stored definition yet
+func (u *APIUtil) newOpenAPIOperation(path string, operation api.Operation, swagger2 bool) map[string]interface{} {
+	entry := map[string]interface{}{
+		"responses": map[string]interface{}{
+			"default": map[string]interface{}{"description": "Response from the backend"},
+		},
+	}
+	if operation.Name != nil && *operation.Name != "" {
+		entry["summary"] = *operation.Name
+	}
+	if operation.Description != nil && *operation.Description != "" {
+		entry["description"] = *operation.Description
+	}
+
+	var parameters []interface{}
+	for _, parameter := range u.buildParameters(path) {
+		p := map[string]interface{}{
+			"name":        parameter.Name,
+			"in":          parameter.In,
+			"required":    parameter.Required,
+			"description": parameter.Description,
+		}
+		if swagger2 {
+			p["type"] = parameter.Schema.Type
+		} else {
+			p["schema"] = map[string]interface{}{"type": parameter.Schema.Type}
+		}
+		parameters = append(parameters, p)
+	}
+	if len(parameters) > 0 {
+		entry["parameters"] = parameters
+	}
+	return entry
+}
+
+// mergeOpenAPISecurity adds the security schemes of the auth policies on the API and its
+// operations. Schemes already in the definition are kept unless a policy defines one with the
+// same name. Security requirements are only replaced where the gateway enforces authentication.
+func (u *APIUtil) mergeOpenAPISecurity(root map[string]interface{}, restAPI *api.RESTAPI, swagger2 bool) {
+	schemes := map[string]interface{}{}
+	apiRequirement := map[string]interface{}{}
+	if restAPI.Policies != nil {
+		for _, policy := range *restAPI.Policies {
+			if name, scheme, ok := openAPISecuritySchemeFromPolicy(policy, swagger2); ok {
+				schemes[name] = scheme
+				apiRequirement[name] = []interface{}{}
+			}
+		}
+	}
+	if len(apiRequirement) > 0 {
+		root["security"] = []interface{}{apiRequirement}
+	}
+
+	paths := asyncAPIMap(root["paths"])
+	if restAPI.Operations != nil && paths != nil {
+		for _, operation := range *restAPI.Operations {
+			if operation.Request.Policies == nil {
+				continue
+			}
+			requirement := map[string]interface{}{}
+			for _, policy := range *operation.Request.Policies {
+				if name, scheme, ok := openAPISecuritySchemeFromPolicy(policy, swagger2); ok {
+					schemes[name] = scheme
+					requirement[name] = []interface{}{}
+				}
+			}
+			if len(requirement) == 0 {
+				continue
+			}
+			entry := asyncAPIMap(asyncAPIMap(paths[operation.Request.Path])[strings.ToLower(string(operation.Request.Method))])
+			if entry == nil {
+				continue
+			}
+			// Operation level security overrides the top level, and the gateway enforces both
+			for name := range apiRequirement {
+				requirement[name] = []interface{}{}
+			}
+			entry["security"] = []interface{}{requirement}
+		}
+	}
+
+	if len(schemes) == 0 {
+		return
+	}
+	var existing map[string]interface{}
+	if swagger2 {
+		existing = asyncAPIMap(root["securityDefinitions"])
+		if existing == nil {
+			existing = map[string]interface{}{}
+			root["securityDefinitions"] = existing
+		}
+	} else {
+		components := asyncAPIMap(root["components"])
+		if components == nil {
+			components = map[string]interface{}{}
+			root["components"] = components
+		}
+		existing = asyncAPIMap(components["securitySchemes"])
+		if existing == nil {
+			existing = map[string]interface{}{}
+			components["securitySchemes"] = existing
+		}
+	}
+	for name, scheme := range schemes {
+		existing[name] = scheme
+	}
+}
+
+// openAPISecuritySchemeFromPolicy describes an auth policy as a security scheme named after the policy
+func openAPISecuritySchemeFromPolicy(policy api.Policy, swagger2 bool) (string, map[string]interface{}, bool) {
+	params := map[string]interface{}{}
+	if policy.Params != nil {
+		params = *policy.Params
+	}
+
+	switch policy.Name {
+	case apiKeyAuthPolicyName:
+		key := firstNonEmpty(asyncAPIString(params, "key"), defaultAPIKeyHeader)
+		in := firstNonEmpty(asyncAPIString(params, "in"), "header")
+		return policy.Name, map[string]interface{}{"type": "apiKey", "name": key, "in": in}, true
+	case jwtAuthPolicyName:
+		if swagger2 {
+			// Swagger 2.0 has no bearer scheme, so the token is described as an Authorization header
+			return policy.Name, map[string]interface{}{
+				"type":        "apiKey",
+				"name":        "Authorization",
+				"in":          "header",
+				"description": "JWT passed as 'Bearer <token>'",
+			}, true
+		}
+		return policy.Name, map[string]interface{}{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"}, true
+	case basicAuthPolicyName:
+		if swagger2 {
+			return policy.Name, map[string]interface{}{"type": "basic"}, true
+		}
+		return policy.Name, map[string]interface{}{"type": "http", "scheme": "basic"}, true
+	}
+	return "", nil, false
+}
+
+// setSwagger2Servers describes the first server with the host, basePath and schemes fields
+func setSwagger2Servers(root map[string]interface{}, servers []dto.Server) {
+	delete(root, "host")
+	delete(root, "basePath")
+	delete(root, "schemes")
+	if len(servers) == 0 {
+		return
+	}
+	parsed, err := url.Parse(servers[0].URL)
+	if err != nil || parsed.Host == "" {
+		return
+	}
+	root["host"] = parsed.Host
+	root["basePath"] = firstNonEmpty(parsed.Path, "/")
+
+	var schemes []interface{}
+	seen := map[string]bool{}
+	for _, server := range servers {
+		s, err := url.Parse(server.URL)
+		if err != nil || s.Host != parsed.Host || seen[s.Scheme] {
+			continue
+		}
+		seen[s.Scheme] = true
+		schemes = append(schemes, s.Scheme)
+	}
+	root["schemes"] = schemes
+}
+
+// parseOpenAPIDefinitionTree parses YAML or JSON OpenAPI 3.x or Swagger 2.0 content into a generic tree
+func parseOpenAPIDefinitionTree(content []byte) (map[string]interface{}, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI definition: %w", err)
+	}
+	root, ok := normalizeOpenAPIValue(raw).(map[string]interface{})
+	if !ok || len(root) == 0 {
+		return nil, fmt.Errorf("OpenAPI definition is empty")
+	}
+	if root["openapi"] == nil && root["swagger"] == nil {
+		return nil, fmt.Errorf("missing required field: openapi")
+	}
+	return root, nil
+}
+
+// normalizeOpenAPIValue converts YAML mappings with non-string keys, such as unquoted response
+// codes, to string keyed maps so the tree can be encoded as JSON
+func normalizeOpenAPIValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = normalizeOpenAPIValue(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			m[fmt.Sprint(key)] = normalizeOpenAPIValue(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeOpenAPIValue(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSwagger2Definition(root map[string]interface{}) bool {
+	return root["swagger"] != nil && root["openapi"] == nil
+}
+
+func hasOpenAPIOperations(item map[string]interface{}) bool {
+	for _, method := range openAPIMethods {
+		if _, ok := item[method]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func isJSONContent(content []byte) bool {
+	trimmed := bytes.TrimSpace(content)
+	return len(trimmed) > 0 && trimmed[0] == '{'
+}
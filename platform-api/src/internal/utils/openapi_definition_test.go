@@ -0,0 +1,323 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"platform-api/src/api"
+	"platform-api/src/internal/dto"
+	"platform-api/src/internal/model"
+)
+
+const petstoreDefinition = `
+openapi: 3.0.3
+info:
+  title: Petstore
+  version: 1.0.0
+servers:
+  - url: https://backend.example.com/v1
+paths:
+  /pets:
+    get:
+      summary: List pets
+      responses:
+        200:
+          description: A list of pets
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: '#/components/schemas/Pet'
+    post:
+      summary: Create a pet
+      requestBody:
+        content:
+          application/json:
+            schema:
+              $ref: '#/components/schemas/Pet'
+      responses:
+        '201':
+          description: Created
+  /pets/{petId}:
+    delete:
+      summary: Delete a pet
+      responses:
+        '204':
+          description: Deleted
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+      example:
+        name: Rex
+  securitySchemes:
+    oauth:
+      type: oauth2
+      flows:
+        clientCredentials:
+          tokenUrl: https://idp.example.com/token
+          scopes: {}
+`
+
+func openAPIOperation(method, path, name string, policies ...api.Policy) api.Operation {
+	return api.Operation{
+		Name: StringPtrIfNotEmpty(name),
+		Request: api.OperationRequest{
+			Method:   api.OperationRequestMethod(method),
+			Path:     path,
+			Policies: &policies,
+		},
+	}
+}
+
+func decodeOpenAPITree(t *testing.T, content string) map[string]interface{} {
+	t.Helper()
+	root, err := parseOpenAPIDefinitionTree([]byte(content))
+	if err != nil {
+		t.Fatalf("failed to parse definition: %v", err)
+	}
+	return root
+}
+
+func TestSyncOpenAPIDefinitionOperations(t *testing.T) {
+	u := &APIUtil{}
+	operations := []api.Operation{
+		openAPIOperation("GET", "/pets", "List all pets"),
+		openAPIOperation("POST", "/pets", "Create a pet"),
+		openAPIOperation("GET", "/pets/{petId}", "Get a pet"),
+	}
+
+	synced, err := u.SyncOpenAPIDefinitionOperations(petstoreDefinition, operations)
+	if err != nil {
+		t.Fatalf("SyncOpenAPIDefinitionOperations failed: %v", err)
+	}
+	root := decodeOpenAPITree(t, synced)
+	paths := asyncAPIMap(root["paths"])
+
+	pets := asyncAPIMap(paths["/pets"])
+	get := asyncAPIMap(pets["get"])
+	if get["summary"] != "List all pets" {
+		t.Errorf("expected renamed summary, got %v", get["summary"])
+	}
+	if _, ok := asyncAPIMap(get["responses"])["200"]; !ok {
+		t.Errorf("expected response schema to be kept, got %v", get["responses"])
+	}
+	if asyncAPIMap(pets["post"])["requestBody"] == nil {
+		t.Error("expected request body to be kept")
+	}
+
+	item := asyncAPIMap(paths["/pets/{petId}"])
+	if _, ok := item["delete"]; ok {
+		t.Error("expected removed operation to be dropped")
+	}
+	added := asyncAPIMap(item["get"])
+	if added == nil {
+		t.Fatal("expected new operation to be added")
+	}
+	params, _ := added["parameters"].([]interface{})
+	if len(params) != 1 || asyncAPIMap(params[0])["name"] != "petId" || asyncAPIMap(params[0])["in"] != "path" {
+		t.Errorf("expected petId path parameter, got %v", added["parameters"])
+	}
+
+	if asyncAPIMap(asyncAPIMap(root["components"])["schemas"])["Pet"] == nil {
+		t.Error("expected component schemas to be kept")
+	}
+}
+
+func TestSyncOpenAPIDefinitionOperations_Unchanged(t *testing.T) {
+	u := &APIUtil{}
+	operations := []api.Operation{
+		openAPIOperation("GET", "/pets", ""),
+		openAPIOperation("POST", "/pets", ""),
+		openAPIOperation("DELETE", "/pets/{petId}", "Delete a pet"),
+	}
+
+	synced, err := u.SyncOpenAPIDefinitionOperations(petstoreDefinition, operations)
+	if err != nil {
+		t.Fatalf("SyncOpenAPIDefinitionOperations failed: %v", err)
+	}
+	if synced != petstoreDefinition {
+		t.Errorf("expected definition to be returned as written")
+	}
+}
+
+func TestSyncOpenAPIDefinitionOperations_KeepsJSON(t *testing.T) {
+	u := &APIUtil{}
+	definition := `{"openapi": "3.0.3", "info": {"title": "T", "version": "1"}, "paths": {"/a": {"get": {"responses": {"200": {"description": "ok"}}}}}}`
+
+	synced, err := u.SyncOpenAPIDefinitionOperations(definition, []api.Operation{openAPIOperation("PUT", "/a", "")})
+	if err != nil {
+		t.Fatalf("SyncOpenAPIDefinitionOperations failed: %v", err)
+	}
+	var root map[string]interface{}
+	if err := json.Unmarshal([]byte(synced), &root); err != nil {
+		t.Fatalf("expected JSON output, got %q: %v", synced, err)
+	}
+	item := asyncAPIMap(asyncAPIMap(root["paths"])["/a"])
+	if item["get"] != nil || item["put"] == nil {
+		t.Errorf("unexpected path item: %v", item)
+	}
+}
+
+func TestBuildOpenAPIDefinition_MergesServersAndSecurity(t *testing.T) {
+	u := &APIUtil{}
+	params := map[string]interface{}{"key": "X-API-Key", "in": "header"}
+	policies := []api.Policy{{Name: "api-key-auth", Version: "v1", Params: &params}}
+	operations := []api.Operation{
+		openAPIOperation("GET", "/pets", "List pets"),
+		openAPIOperation("POST", "/pets", "Create a pet", api.Policy{Name: "jwt-auth", Version: "v1"}),
+		openAPIOperation("DELETE", "/pets/{petId}", "Delete a pet"),
+	}
+	restAPI := &api.RESTAPI{
+		Name:       "Pet Store",
+		Version:    "2.0.0",
+		Context:    "/petstore",
+		Policies:   &policies,
+		Operations: &operations,
+	}
+	servers := u.BuildGatewayServers(restAPI.Context, []*model.APIGatewayWithDetails{
+		{Name: "gw-eu", DisplayName: "EU", Vhost: "eu.gw.example.com"},
+		{Name: "gw-us", Vhost: "us.gw.example.com"},
+		{Name: "gw-us-2", Vhost: "us.gw.example.com"},
+	})
+
+	root, err := u.BuildOpenAPIDefinition(restAPI, petstoreDefinition, servers)
+	if err != nil {
+		t.Fatalf("BuildOpenAPIDefinition failed: %v", err)
+	}
+
+	info := asyncAPIMap(root["info"])
+	if info["title"] != "Pet Store" || info["version"] != "2.0.0" {
+		t.Errorf("unexpected info: %v", info)
+	}
+
+	expectedServers := []interface{}{
+		map[string]interface{}{"url": "https://eu.gw.example.com/petstore", "description": "EU gateway"},
+		map[string]interface{}{"url": "https://us.gw.example.com/petstore", "description": "gw-us gateway"},
+	}
+	if !reflect.DeepEqual(root["servers"], expectedServers) {
+		t.Errorf("unexpected servers: %v", root["servers"])
+	}
+
+	schemes := asyncAPIMap(asyncAPIMap(root["components"])["securitySchemes"])
+	if !reflect.DeepEqual(schemes["api-key-auth"], map[string]interface{}{"type": "apiKey", "name": "X-API-Key", "in": "header"}) {
+		t.Errorf("unexpected api-key-auth scheme: %v", schemes["api-key-auth"])
+	}
+	if !reflect.DeepEqual(schemes["jwt-auth"], map[string]interface{}{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"}) {
+		t.Errorf("unexpected jwt-auth scheme: %v", schemes["jwt-auth"])
+	}
+	if schemes["oauth"] == nil {
+		t.Error("expected security schemes of the definition to be kept")
+	}
+
+	if !reflect.DeepEqual(root["security"], []interface{}{map[string]interface{}{"api-key-auth": []interface{}{}}}) {
+		t.Errorf("unexpected top level security: %v", root["security"])
+	}
+	post := asyncAPIMap(asyncAPIMap(asyncAPIMap(root["paths"])["/pets"])["post"])
+	expectedPostSecurity := []interface{}{map[string]interface{}{"api-key-auth": []interface{}{}, "jwt-auth": []interface{}{}}}
+	if !reflect.DeepEqual(post["security"], expectedPostSecurity) {
+		t.Errorf("unexpected operation security: %v", post["security"])
+	}
+	if post["requestBody"] == nil {
+		t.Error("expected request body to be kept")
+	}
+
+	if _, err := u.MarshalOpenAPIDefinition(root, "json"); err != nil {
+		t.Errorf("MarshalOpenAPIDefinition json failed: %v", err)
+	}
+	content, err := u.MarshalOpenAPIDefinition(root, "yaml")
+	if err != nil {
+		t.Fatalf("MarshalOpenAPIDefinition yaml failed: %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := yaml.Unmarshal(content, &decoded); err != nil {
+		t.Fatalf("exported YAML does not parse: %v", err)
+	}
+}
+
+func TestBuildOpenAPIDefinition_GeneratesWithoutStoredDefinition(t *testing.T) {
+	u := &APIUtil{}
+	operations := []api.Operation{openAPIOperation("GET", "/items/{id}", "Get item", api.Policy{Name: "basic-auth", Version: "v1"})}
+	restAPI := &api.RESTAPI{Name: "Items", Version: "1.0.0", Context: "/items", Operations: &operations}
+
+	root, err := u.BuildOpenAPIDefinition(restAPI, "", []dto.Server{{URL: "https://gw.example.com/items"}})
+	if err != nil {
+		t.Fatalf("BuildOpenAPIDefinition failed: %v", err)
+	}
+	if root["openapi"] != "3.0.3" {
+		t.Errorf("unexpected openapi version: %v", root["openapi"])
+	}
+	get := asyncAPIMap(asyncAPIMap(asyncAPIMap(root["paths"])["/items/{id}"])["get"])
+	if get == nil || get["responses"] == nil {
+		t.Fatalf("expected generated operation with responses, got %v", get)
+	}
+	if !reflect.DeepEqual(get["security"], []interface{}{map[string]interface{}{"basic-auth": []interface{}{}}}) {
+		t.Errorf("unexpected operation security: %v", get["security"])
+	}
+	if root["security"] != nil {
+		t.Errorf("expected no top level security, got %v", root["security"])
+	}
+}
+
+func TestBuildOpenAPIDefinition_Swagger2(t *testing.T) {
+	u := &APIUtil{}
+	definition := `
+swagger: "2.0"
+info:
+  title: Legacy
+  version: 1.0.0
+host: backend.example.com
+basePath: /v1
+schemes: [http]
+paths:
+  /status:
+    get:
+      responses:
+        200:
+          description: OK
+`
+	policies := []api.Policy{{Name: "jwt-auth", Version: "v1"}}
+	restAPI := &api.RESTAPI{Name: "Legacy", Version: "1.0.0", Context: "/legacy", Policies: &policies}
+
+	root, err := u.BuildOpenAPIDefinition(restAPI, definition, []dto.Server{{URL: "https://gw.example.com/legacy"}})
+	if err != nil {
+		t.Fatalf("BuildOpenAPIDefinition failed: %v", err)
+	}
+	if root["host"] != "gw.example.com" || root["basePath"] != "/legacy" {
+		t.Errorf("unexpected host and basePath: %v %v", root["host"], root["basePath"])
+	}
+	if !reflect.DeepEqual(root["schemes"], []interface{}{"https"}) {
+		t.Errorf("unexpected schemes: %v", root["schemes"])
+	}
+	scheme := asyncAPIMap(asyncAPIMap(root["securityDefinitions"])["jwt-auth"])
+	if scheme["type"] != "apiKey" || scheme["name"] != "Authorization" {
+		t.Errorf("unexpected jwt-auth definition: %v", scheme)
+	}
+	if root["servers"] != nil || root["components"] != nil {
+		t.Error("expected no OpenAPI 3 fields in a Swagger 2.0 definition")
+	}
+}
@@ -0,0 +1,393 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"platform-api/src/api"
+)
+
+const postmanCollectionSchemaV21 = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+var postmanVariablePattern = regexp.MustCompile(`\{\{\s*([^{}\s]+)\s*\}\}`)
+
+// postmanCollection is the part of a Postman v2.1 collection the importer reads
+type postmanCollection struct {
+	Info struct {
+		Name        string          `json:"name"`
+		Description json.RawMessage `json:"description"`
+		Schema      string          `json:"schema"`
+	} `json:"info"`
+	Item     []postmanItem     `json:"item"`
+	Variable []postmanVariable `json:"variable"`
+}
+
+// postmanItem is either a folder (with items) or a request
+type postmanItem struct {
+	Name        string            `json:"name"`
+	Description json.RawMessage   `json:"description"`
+	Item        []postmanItem     `json:"item"`
+	Request     json.RawMessage   `json:"request"`
+	Response    []postmanResponse `json:"response"`
+	Variable    []postmanVariable `json:"variable"`
+}
+
+type postmanRequest struct {
+	Method      string          `json:"method"`
+	URL         json.RawMessage `json:"url"`
+	Header      []postmanHeader `json:"header"`
+	Body        *postmanBody    `json:"body"`
+	Description json.RawMessage `json:"description"`
+}
+
+type postmanURL struct {
+	Raw      string            `json:"raw"`
+	Protocol string            `json:"protocol"`
+	Host     json.RawMessage   `json:"host"`
+	Port     string            `json:"port"`
+	Path     json.RawMessage   `json:"path"`
+	Query    []postmanVariable `json:"query"`
+}
+
+type postmanBody struct {
+	Mode       string            `json:"mode"`
+	Raw        string            `json:"raw"`
+	URLEncoded []postmanVariable `json:"urlencoded"`
+	FormData   []postmanVariable `json:"formdata"`
+	Options    struct {
+		Raw struct {
+			Language string `json:"language"`
+		} `json:"raw"`
+	} `json:"options"`
+}
+
+type postmanResponse struct {
+	Name   string          `json:"name"`
+	Code   int             `json:"code"`
+	Header []postmanHeader `json:"header"`
+	Body   string          `json:"body"`
+}
+
+type postmanHeader struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Disabled bool   `json:"disabled"`
+}
+
+type postmanVariable struct {
+	Key      string          `json:"key"`
+	Value    json.RawMessage `json:"value"`
+	Disabled bool            `json:"disabled"`
+}
+
+// ConvertPostmanCollectionToRESTAPI infers a REST API from a Postman v2.1 collection. It returns the
+// API creation request together with a generated OpenAPI 3.0 definition that carries the schemas
+// inferred from example bodies.
+//
+// Notes:
+//   - Folders are flattened; every request becomes an operation, deduplicated by method and path.
+//   - Collection variables are resolved in URLs. :name and unresolved {{name}} path segments become
+//     path parameters named after the variable.
+//   - Saved example responses describe the responses of an operation.
+//   - A collection exported from the Postman API (wrapped in a "collection" object) is accepted as well.
+func (u *APIUtil) ConvertPostmanCollectionToRESTAPI(content []byte) (*api.CreateRESTAPIRequest, []byte, error) {
+	var wrapper struct {
+		Collection *postmanCollection `json:"collection"`
+	}
+	var collection postmanCollection
+	if err := json.Unmarshal(content, &wrapper); err != nil {
+		return nil, nil, fmt.Errorf("invalid Postman collection: %w", err)
+	}
+	if wrapper.Collection != nil {
+		collection = *wrapper.Collection
+	} else if err := json.Unmarshal(content, &collection); err != nil {
+		return nil, nil, fmt.Errorf("invalid Postman collection: %w", err)
+	}
+	if collection.Info.Schema != "" && collection.Info.Schema != postmanCollectionSchemaV21 {
+		return nil, nil, fmt.Errorf("unsupported Postman collection schema %s, export the collection as v2.1", collection.Info.Schema)
+	}
+	if collection.Info.Name == "" {
+		return nil, nil, fmt.Errorf("invalid Postman collection: info.name is required")
+	}
+
+	variables := postmanVariables(nil, collection.Variable)
+	var exchanges []importedExchange
+	if err := collectPostmanExchanges(collection.Item, variables, &exchanges); err != nil {
+		return nil, nil, err
+	}
+	if len(exchanges) == 0 {
+		return nil, nil, fmt.Errorf("no requests found in Postman collection")
+	}
+	return u.buildImportedRESTAPI(collection.Info.Name, postmanDescription(collection.Info.Description), exchanges)
+}
+
+// collectPostmanExchanges walks the items of a collection or folder depth first. Folder variables
+// apply to the requests inside the folder.
+func collectPostmanExchanges(items []postmanItem, variables map[string]string, exchanges *[]importedExchange) error {
+	for _, item := range items {
+		scoped := postmanVariables(variables, item.Variable)
+		if len(item.Request) == 0 {
+			if err := collectPostmanExchanges(item.Item, scoped, exchanges); err != nil {
+				return err
+			}
+			continue
+		}
+
+		request, err := parsePostmanRequest(item.Request)
+		if err != nil {
+			return fmt.Errorf("invalid request %q: %w", item.Name, err)
+		}
+		requestURL, query, err := resolvePostmanURL(request.URL, scoped)
+		if err != nil {
+			return fmt.Errorf("invalid URL of request %q: %w", item.Name, err)
+		}
+		if requestURL == nil {
+			continue
+		}
+		for _, q := range query {
+			values := requestURL.Query()
+			if !values.Has(q) {
+				values.Set(q, "")
+				requestURL.RawQuery = values.Encode()
+			}
+		}
+
+		exchange := importedExchange{
+			Name:               item.Name,
+			Description:        postmanDescription(item.Description),
+			Method:             request.Method,
+			URL:                requestURL,
+			RequestContentType: postmanHeaderValue(request.Header, "Content-Type"),
+		}
+		if exchange.Description == "" {
+			exchange.Description = postmanDescription(request.Description)
+		}
+		if exchange.Method == "" {
+			exchange.Method = "GET"
+		}
+		if body := request.Body; body != nil {
+			switch body.Mode {
+			case "raw":
+				exchange.RequestBody = []byte(body.Raw)
+				if exchange.RequestContentType == "" && body.Options.Raw.Language == "json" {
+					exchange.RequestContentType = "application/json"
+				}
+			case "urlencoded", "formdata":
+				fields := body.URLEncoded
+				if body.Mode == "formdata" {
+					fields = body.FormData
+					if exchange.RequestContentType == "" {
+						exchange.RequestContentType = "multipart/form-data"
+					}
+				}
+				for _, field := range fields {
+					if !field.Disabled && field.Key != "" {
+						exchange.FormFields = append(exchange.FormFields, field.Key)
+					}
+				}
+			}
+		}
+
+		if len(item.Response) == 0 {
+			*exchanges = append(*exchanges, exchange)
+			continue
+		}
+		for _, response := range item.Response {
+			withResponse := exchange
+			withResponse.ResponseStatus = response.Code
+			withResponse.ResponseDescription = response.Name
+			withResponse.ResponseContentType = postmanHeaderValue(response.Header, "Content-Type")
+			withResponse.ResponseBody = []byte(response.Body)
+			*exchanges = append(*exchanges, withResponse)
+		}
+	}
+	return nil
+}
+
+// parsePostmanRequest accepts both the request object and the shorthand of a plain URL string
+func parsePostmanRequest(raw json.RawMessage) (*postmanRequest, error) {
+	var shorthand string
+	if err := json.Unmarshal(raw, &shorthand); err == nil {
+		url, _ := json.Marshal(shorthand)
+		return &postmanRequest{Method: "GET", URL: url}, nil
+	}
+	var request postmanRequest
+	if err := json.Unmarshal(raw, &request); err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// resolvePostmanURL resolves variables in a request URL and returns it with path parameters written
+// as {name}, along with the names of its query parameters. A request without a URL yields nil.
+func resolvePostmanURL(raw json.RawMessage, variables map[string]string) (*url.URL, []string, error) {
+	var structured postmanURL
+	var rawURL string
+	if err := json.Unmarshal(raw, &rawURL); err != nil {
+		if len(raw) == 0 {
+			return nil, nil, nil
+		}
+		if err := json.Unmarshal(raw, &structured); err != nil {
+			return nil, nil, err
+		}
+		rawURL = structured.Raw
+		if rawURL == "" {
+			rawURL = postmanURLFromParts(&structured)
+		}
+	}
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return nil, nil, nil
+	}
+
+	var query []string
+	for _, q := range structured.Query {
+		if !q.Disabled && q.Key != "" {
+			query = append(query, q.Key)
+		}
+	}
+
+	rawURL = postmanVariablePattern.ReplaceAllStringFunc(rawURL, func(match string) string {
+		name := postmanVariablePattern.FindStringSubmatch(match)[1]
+		if value, ok := variables[name]; ok {
+			return value
+		}
+		return match
+	})
+	// A base URL variable that is still unresolved leaves only the path
+	if strings.HasPrefix(rawURL, "{{") {
+		if end := strings.Index(rawURL, "}}"); end >= 0 {
+			rawURL = rawURL[end+2:]
+		}
+	}
+	if !strings.Contains(rawURL, "://") && !strings.HasPrefix(rawURL, "/") {
+		if strings.Contains(strings.SplitN(rawURL, "/", 2)[0], ".") || strings.HasPrefix(rawURL, "localhost") {
+			rawURL = "https://" + rawURL
+		} else {
+			rawURL = "/" + rawURL
+		}
+	}
+
+	pathEnd := len(rawURL)
+	if i := strings.IndexAny(rawURL, "?#"); i >= 0 {
+		pathEnd = i
+	}
+	start := 0
+	if i := strings.Index(rawURL, "://"); i >= 0 {
+		start = i + 3
+		if slash := strings.Index(rawURL[start:pathEnd], "/"); slash >= 0 {
+			start += slash
+		} else {
+			start = pathEnd
+		}
+	}
+	segments := strings.Split(rawURL[start:pathEnd], "/")
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, ":") && len(segment) > 1:
+			segments[i] = "{" + segment[1:] + "}"
+		case postmanVariablePattern.MatchString(segment) && postmanVariablePattern.FindString(segment) == segment:
+			segments[i] = "{" + postmanVariablePattern.FindStringSubmatch(segment)[1] + "}"
+		}
+	}
+	rawURL = rawURL[:start] + strings.Join(segments, "/") + rawURL[pathEnd:]
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	return parsed, query, nil
+}
+
+func postmanURLFromParts(u *postmanURL) string {
+	var host, path []string
+	if err := json.Unmarshal(u.Host, &host); err != nil {
+		var h string
+		if json.Unmarshal(u.Host, &h) == nil && h != "" {
+			host = []string{h}
+		}
+	}
+	if err := json.Unmarshal(u.Path, &path); err != nil {
+		var p string
+		if json.Unmarshal(u.Path, &p) == nil && p != "" {
+			path = []string{strings.TrimPrefix(p, "/")}
+		}
+	}
+	result := strings.Join(host, ".")
+	if u.Protocol != "" && result != "" {
+		result = u.Protocol + "://" + result
+	}
+	if u.Port != "" {
+		result += ":" + u.Port
+	}
+	return result + "/" + strings.Join(path, "/")
+}
+
+// postmanVariables returns the parent variables overlaid with the enabled variables of a scope
+func postmanVariables(parent map[string]string, variables []postmanVariable) map[string]string {
+	scoped := make(map[string]string, len(parent)+len(variables))
+	for k, v := range parent {
+		scoped[k] = v
+	}
+	for _, variable := range variables {
+		if variable.Disabled || variable.Key == "" {
+			continue
+		}
+		var value string
+		if err := json.Unmarshal(variable.Value, &value); err != nil {
+			// Non string values (numbers, booleans) are used as written
+			value = strings.TrimSpace(string(variable.Value))
+		}
+		if value != "" {
+			scoped[variable.Key] = value
+		}
+	}
+	return scoped
+}
+
+// postmanDescription reads a description given as a string or as a {content, type} object
+func postmanDescription(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var description string
+	if err := json.Unmarshal(raw, &description); err == nil {
+		return description
+	}
+	var structured struct {
+		Content string `json:"content"`
+	}
+	if err := json.Unmarshal(raw, &structured); err == nil {
+		return structured.Content
+	}
+	return ""
+}
+
+func postmanHeaderValue(headers []postmanHeader, name string) string {
+	for _, header := range headers {
+		if !header.Disabled && strings.EqualFold(header.Key, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
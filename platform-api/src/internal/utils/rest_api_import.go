@@ -0,0 +1,706 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"platform-api/src/api"
+	"platform-api/src/internal/constants"
+)
+
+const (
+	// ImportedAPIDefaultVersion is the version of APIs imported from sources that carry no version
+	ImportedAPIDefaultVersion = "v1.0"
+
+	importedOpenAPIVersion = "3.0.3"
+)
+
+var (
+	importedNumericSegmentPattern = regexp.MustCompile(`^\d+$`)
+	importedUUIDSegmentPattern    = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	importedNamedSegmentPattern   = regexp.MustCompile(`^\{([A-Za-z_][A-Za-z0-9_.-]*)\}$`)
+	importedVersionSegmentPattern = regexp.MustCompile(`^v\d+(\.\d+)*$`)
+	importedDateTimePattern       = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`)
+)
+
+// importedExchange is a request, and optionally its response, recorded in a Postman collection or HAR file.
+// Path segments written as {name} are named path parameters. Captured marks exchanges recorded from
+// live traffic, whose bodies may hold credentials or personal data; they contribute schemas but never
+// examples to the generated definition.
+type importedExchange struct {
+	Captured            bool
+	Name                string
+	Description         string
+	Method              string
+	URL                 *url.URL
+	RequestContentType  string
+	RequestBody         []byte
+	FormFields          []string
+	ResponseStatus      int
+	ResponseDescription string
+	ResponseContentType string
+	ResponseBody        []byte
+}
+
+// importedOperation aggregates the exchanges that map to the same method and path template
+type importedOperation struct {
+	method      string
+	segments    []importedSegment
+	name        string
+	description string
+	query       []string
+	request     *importedBody
+	responses   map[int]*importedBody
+}
+
+type importedSegment struct {
+	value string // static segment, or parameter name
+	param bool
+	kind  string // integer, uuid or string for parameters
+}
+
+type importedBody struct {
+	description string
+	contentType string
+	schema      *jsonSchemaNode
+	example     interface{}
+}
+
+// buildImportedRESTAPI infers a REST API from recorded exchanges.
+//
+// Notes:
+//   - The upstream is the origin most requests were sent to; requests to other origins are ignored.
+//   - Numeric and UUID path segments become {id} parameters (id2, id3, ... when a path has several).
+//   - The context is the longest static path prefix shared by all requests and becomes part of the
+//     upstream URL. Without a shared prefix the context is derived from the API name.
+//   - JSON request and response bodies are turned into schemas in the returned OpenAPI 3.0 definition.
+func (u *APIUtil) buildImportedRESTAPI(name, description string, exchanges []importedExchange) (*api.CreateRESTAPIRequest, []byte, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, nil, fmt.Errorf("API name could not be determined")
+	}
+
+	origin := primaryImportedOrigin(exchanges)
+	type templatedExchange struct {
+		exchange *importedExchange
+		segments []importedSegment
+	}
+	var templated []templatedExchange
+	for i := range exchanges {
+		exchange := &exchanges[i]
+		exchange.Method = strings.ToUpper(strings.TrimSpace(exchange.Method))
+		if !isImportableMethod(exchange.Method) || exchange.URL == nil {
+			continue
+		}
+		if exchange.URL.Host != "" && importedOrigin(exchange.URL) != origin {
+			continue
+		}
+		templated = append(templated, templatedExchange{exchange: exchange, segments: templateImportedPath(exchange.URL.Path)})
+	}
+	if len(templated) == 0 {
+		return nil, nil, fmt.Errorf("no HTTP requests found")
+	}
+
+	// The context is the static prefix shared by every path, leaving at least one segment per operation
+	prefix := templated[0].segments
+	for _, t := range templated {
+		n := 0
+		for n < len(prefix) && n < len(t.segments)-1 && !prefix[n].param && !t.segments[n].param &&
+			prefix[n].value == t.segments[n].value {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+	prefixValues := make([]string, 0, len(prefix))
+	for _, segment := range prefix {
+		prefixValues = append(prefixValues, segment.value)
+	}
+	context := "/" + strings.Join(prefixValues, "/")
+	upstreamURL := origin
+	if len(prefixValues) > 0 {
+		upstreamURL += context
+	} else {
+		context = "/" + sanitizeToHandle(name)
+	}
+	version := ImportedAPIDefaultVersion
+	for _, value := range prefixValues {
+		if importedVersionSegmentPattern.MatchString(value) {
+			version = value
+		}
+	}
+
+	var operations []*importedOperation
+	operationsByKey := make(map[string]*importedOperation)
+	for _, t := range templated {
+		segments := t.segments[len(prefix):]
+		key := t.exchange.Method + " " + importedPathShape(segments)
+		operation, ok := operationsByKey[key]
+		if !ok {
+			operation = &importedOperation{
+				method:      t.exchange.Method,
+				segments:    nameImportedPathParameters(segments),
+				name:        strings.TrimSpace(t.exchange.Name),
+				description: strings.TrimSpace(t.exchange.Description),
+				responses:   make(map[int]*importedBody),
+			}
+			operationsByKey[key] = operation
+			operations = append(operations, operation)
+		}
+		operation.add(t.exchange)
+	}
+	sort.SliceStable(operations, func(i, j int) bool {
+		pi, pj := operations[i].path(), operations[j].path()
+		if pi != pj {
+			return pi < pj
+		}
+		return methodOrder(operations[i].method) < methodOrder(operations[j].method)
+	})
+
+	apiOperations := make([]api.Operation, 0, len(operations))
+	for _, operation := range operations {
+		apiOperations = append(apiOperations, api.Operation{
+			Name:        StringPtrIfNotEmpty(operation.name),
+			Description: StringPtrIfNotEmpty(operation.description),
+			Request: api.OperationRequest{
+				Method: api.OperationRequestMethod(operation.method),
+				Path:   operation.path(),
+			},
+		})
+	}
+
+	req := &api.CreateRESTAPIRequest{
+		Name:        name,
+		Context:     context,
+		Version:     version,
+		Description: StringPtrIfNotEmpty(strings.TrimSpace(description)),
+		Kind:        StringPtrIfNotEmpty(constants.RestApi),
+		Transport:   stringSlicePtr([]string{"http", "https"}),
+		Operations:  &apiOperations,
+	}
+	if origin != "" {
+		req.Upstream = api.Upstream{Main: api.UpstreamDefinition{Url: StringPtrIfNotEmpty(upstreamURL)}}
+	}
+
+	definition, err := importedOpenAPIDefinition(req, upstreamURL, operations)
+	if err != nil {
+		return nil, nil, err
+	}
+	return req, definition, nil
+}
+
+// add folds an exchange into the operation
+func (o *importedOperation) add(exchange *importedExchange) {
+	if o.name == "" {
+		o.name = strings.TrimSpace(exchange.Name)
+	}
+	if o.description == "" {
+		o.description = strings.TrimSpace(exchange.Description)
+	}
+	for name := range exchange.URL.Query() {
+		if !slices.Contains(o.query, name) {
+			o.query = append(o.query, name)
+		}
+	}
+	sort.Strings(o.query)
+
+	if body := newImportedBody(exchange.RequestContentType, exchange.RequestBody, exchange.FormFields); body != nil {
+		if exchange.Captured {
+			body.example = nil
+		}
+		if o.request == nil {
+			o.request = body
+		} else {
+			o.request.merge(body)
+		}
+	}
+	if exchange.ResponseStatus > 0 {
+		body := newImportedBody(exchange.ResponseContentType, exchange.ResponseBody, nil)
+		if body == nil {
+			body = &importedBody{}
+		}
+		if exchange.Captured {
+			body.example = nil
+		}
+		body.description = exchange.ResponseDescription
+		if existing, ok := o.responses[exchange.ResponseStatus]; ok {
+			existing.merge(body)
+		} else {
+			o.responses[exchange.ResponseStatus] = body
+		}
+	}
+}
+
+// path returns the operation path relative to the context
+func (o *importedOperation) path() string {
+	if len(o.segments) == 0 {
+		return "/"
+	}
+	parts := make([]string, 0, len(o.segments))
+	for _, segment := range o.segments {
+		if segment.param {
+			parts = append(parts, "{"+segment.value+"}")
+		} else {
+			parts = append(parts, segment.value)
+		}
+	}
+	return "/" + strings.Join(parts, "/")
+}
+
+// newImportedBody returns the body of a request or response, or nil when it has no content.
+// Only JSON and form bodies get a schema; other bodies are recorded by content type.
+func newImportedBody(contentType string, content []byte, formFields []string) *importedBody {
+	contentType = strings.TrimSpace(strings.Split(contentType, ";")[0])
+	if len(formFields) > 0 {
+		if contentType == "" {
+			contentType = "application/x-www-form-urlencoded"
+		}
+		fields := make(map[string]interface{}, len(formFields))
+		for _, field := range formFields {
+			fields[field] = ""
+		}
+		schema := &jsonSchemaNode{}
+		schema.add(fields)
+		return &importedBody{contentType: contentType, schema: schema}
+	}
+	if len(strings.TrimSpace(string(content))) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	isJSON := json.Unmarshal(content, &value) == nil
+	if contentType == "" {
+		if !isJSON {
+			return &importedBody{contentType: "text/plain"}
+		}
+		contentType = "application/json"
+	}
+	if !isJSON || !strings.Contains(contentType, "json") {
+		return &importedBody{contentType: contentType}
+	}
+	schema := &jsonSchemaNode{}
+	schema.add(value)
+	return &importedBody{contentType: contentType, schema: schema, example: value}
+}
+
+// merge folds another body of the same operation into b. The first content type and example win.
+func (b *importedBody) merge(other *importedBody) {
+	if b.description == "" {
+		b.description = other.description
+	}
+	if b.contentType == "" {
+		b.contentType = other.contentType
+	}
+	if other.contentType != b.contentType {
+		return
+	}
+	if b.example == nil {
+		b.example = other.example
+	}
+	if other.schema != nil {
+		if b.schema == nil {
+			b.schema = other.schema
+		} else {
+			b.schema.merge(other.schema)
+		}
+	}
+}
+
+// jsonSchemaNode accumulates the JSON values seen at one position of example bodies
+type jsonSchemaNode struct {
+	types      map[string]bool
+	format     string
+	formatSeen bool
+	objects    int
+	properties map[string]*jsonSchemaNode
+	seenIn     map[string]int
+	items      *jsonSchemaNode
+}
+
+func (n *jsonSchemaNode) addType(t string) {
+	if n.types == nil {
+		n.types = make(map[string]bool)
+	}
+	n.types[t] = true
+}
+
+// add records an example value decoded by encoding/json
+func (n *jsonSchemaNode) add(value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		n.addType("null")
+	case bool:
+		n.addType("boolean")
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			n.addType("integer")
+		} else {
+			n.addType("number")
+		}
+	case string:
+		n.addType("string")
+		format := ""
+		switch {
+		case importedUUIDSegmentPattern.MatchString(v):
+			format = "uuid"
+		case importedDateTimePattern.MatchString(v):
+			format = "date-time"
+		}
+		if !n.formatSeen {
+			n.format = format
+			n.formatSeen = true
+		} else if n.format != format {
+			n.format = ""
+		}
+	case []interface{}:
+		n.addType("array")
+		if n.items == nil {
+			n.items = &jsonSchemaNode{}
+		}
+		for _, item := range v {
+			n.items.add(item)
+		}
+	case map[string]interface{}:
+		n.addType("object")
+		n.objects++
+		if n.properties == nil {
+			n.properties = make(map[string]*jsonSchemaNode)
+			n.seenIn = make(map[string]int)
+		}
+		for key, item := range v {
+			property, ok := n.properties[key]
+			if !ok {
+				property = &jsonSchemaNode{}
+				n.properties[key] = property
+			}
+			property.add(item)
+			n.seenIn[key]++
+		}
+	}
+}
+
+// merge folds the values recorded by another node into n
+func (n *jsonSchemaNode) merge(other *jsonSchemaNode) {
+	for t := range other.types {
+		n.addType(t)
+	}
+	if other.formatSeen {
+		if !n.formatSeen {
+			n.format = other.format
+			n.formatSeen = true
+		} else if n.format != other.format {
+			n.format = ""
+		}
+	}
+	if other.items != nil {
+		if n.items == nil {
+			n.items = other.items
+		} else {
+			n.items.merge(other.items)
+		}
+	}
+	if other.objects > 0 {
+		if n.properties == nil {
+			n.properties = make(map[string]*jsonSchemaNode)
+			n.seenIn = make(map[string]int)
+		}
+		n.objects += other.objects
+		for key, property := range other.properties {
+			if existing, ok := n.properties[key]; ok {
+				existing.merge(property)
+			} else {
+				n.properties[key] = property
+			}
+			n.seenIn[key] += other.seenIn[key]
+		}
+	}
+}
+
+// schema renders the node as an OpenAPI 3.0 schema. Properties present in every example object are
+// required; values of conflicting types produce an unconstrained schema.
+func (n *jsonSchemaNode) schema() map[string]interface{} {
+	schema := map[string]interface{}{}
+	types := make([]string, 0, len(n.types))
+	for t := range n.types {
+		if t != "null" {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 2 && n.types["integer"] && n.types["number"] {
+		types = []string{"number"}
+	}
+	if n.types["null"] {
+		schema["nullable"] = true
+	}
+	if len(types) != 1 {
+		return schema
+	}
+
+	schema["type"] = types[0]
+	switch types[0] {
+	case "string":
+		if n.format != "" {
+			schema["format"] = n.format
+		}
+	case "array":
+		if n.items != nil {
+			schema["items"] = n.items.schema()
+		} else {
+			schema["items"] = map[string]interface{}{}
+		}
+	case "object":
+		properties := make(map[string]interface{}, len(n.properties))
+		var required []string
+		for key, property := range n.properties {
+			properties[key] = property.schema()
+			if n.seenIn[key] == n.objects {
+				required = append(required, key)
+			}
+		}
+		schema["properties"] = properties
+		if len(required) > 0 {
+			sort.Strings(required)
+			schema["required"] = required
+		}
+	}
+	return schema
+}
+
+// importedOpenAPIDefinition renders the inferred operations as an OpenAPI 3.0 definition in JSON
+func importedOpenAPIDefinition(req *api.CreateRESTAPIRequest, serverURL string, operations []*importedOperation) ([]byte, error) {
+	info := map[string]interface{}{"title": req.Name, "version": req.Version}
+	if req.Description != nil {
+		info["description"] = *req.Description
+	}
+	doc := map[string]interface{}{
+		"openapi": importedOpenAPIVersion,
+		"info":    info,
+	}
+	if serverURL != "" {
+		doc["servers"] = []interface{}{map[string]interface{}{"url": serverURL}}
+	}
+
+	paths := make(map[string]interface{})
+	for _, operation := range operations {
+		item, ok := paths[operation.path()].(map[string]interface{})
+		if !ok {
+			item = make(map[string]interface{})
+			paths[operation.path()] = item
+		}
+		item[strings.ToLower(operation.method)] = operation.openAPIOperation()
+	}
+	doc["paths"] = paths
+
+	content, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate OpenAPI definition: %w", err)
+	}
+	return content, nil
+}
+
+func (o *importedOperation) openAPIOperation() map[string]interface{} {
+	operation := make(map[string]interface{})
+	if o.name != "" {
+		operation["summary"] = o.name
+	}
+	if o.description != "" {
+		operation["description"] = o.description
+	}
+
+	var parameters []interface{}
+	for _, segment := range o.segments {
+		if !segment.param {
+			continue
+		}
+		schema := map[string]interface{}{"type": "string"}
+		switch segment.kind {
+		case "integer":
+			schema = map[string]interface{}{"type": "integer"}
+		case "uuid":
+			schema["format"] = "uuid"
+		}
+		parameters = append(parameters, map[string]interface{}{
+			"name": segment.value, "in": "path", "required": true, "schema": schema,
+		})
+	}
+	for _, name := range o.query {
+		parameters = append(parameters, map[string]interface{}{
+			"name": name, "in": "query", "required": false, "schema": map[string]interface{}{"type": "string"},
+		})
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+
+	if o.request != nil && o.request.contentType != "" {
+		operation["requestBody"] = map[string]interface{}{
+			"content": map[string]interface{}{o.request.contentType: o.request.mediaType()},
+		}
+	}
+
+	responses := make(map[string]interface{})
+	for status, body := range o.responses {
+		description := body.description
+		if description == "" {
+			description = http.StatusText(status)
+		}
+		if description == "" {
+			description = "Response"
+		}
+		response := map[string]interface{}{"description": description}
+		if body.contentType != "" {
+			response["content"] = map[string]interface{}{body.contentType: body.mediaType()}
+		}
+		responses[strconv.Itoa(status)] = response
+	}
+	if len(responses) == 0 {
+		responses["default"] = map[string]interface{}{"description": "Default response"}
+	}
+	operation["responses"] = responses
+	return operation
+}
+
+func (b *importedBody) mediaType() map[string]interface{} {
+	mediaType := make(map[string]interface{})
+	if b.schema != nil {
+		mediaType["schema"] = b.schema.schema()
+	}
+	if b.example != nil {
+		mediaType["example"] = b.example
+	}
+	return mediaType
+}
+
+// primaryImportedOrigin returns the origin most exchanges were sent to, preferring the first seen on ties
+func primaryImportedOrigin(exchanges []importedExchange) string {
+	counts := make(map[string]int)
+	best := ""
+	for _, exchange := range exchanges {
+		if exchange.URL == nil || exchange.URL.Host == "" {
+			continue
+		}
+		origin := importedOrigin(exchange.URL)
+		counts[origin]++
+		if best == "" || counts[origin] > counts[best] {
+			best = origin
+		}
+	}
+	return best
+}
+
+func importedOrigin(u *url.URL) string {
+	scheme := strings.ToLower(u.Scheme)
+	if scheme == "" {
+		scheme = "https"
+	}
+	return scheme + "://" + strings.ToLower(u.Host)
+}
+
+// templateImportedPath splits a path into segments and turns identifiers into parameters
+func templateImportedPath(p string) []importedSegment {
+	var segments []importedSegment
+	for _, value := range strings.Split(p, "/") {
+		if value == "" {
+			continue
+		}
+		switch {
+		case importedNamedSegmentPattern.MatchString(value):
+			name := importedNamedSegmentPattern.FindStringSubmatch(value)[1]
+			segments = append(segments, importedSegment{value: name, param: true, kind: "string"})
+		case importedNumericSegmentPattern.MatchString(value):
+			segments = append(segments, importedSegment{param: true, kind: "integer"})
+		case importedUUIDSegmentPattern.MatchString(value):
+			segments = append(segments, importedSegment{param: true, kind: "uuid"})
+		default:
+			segments = append(segments, importedSegment{value: value})
+		}
+	}
+	return segments
+}
+
+// importedPathShape identifies a path template regardless of its parameter names
+func importedPathShape(segments []importedSegment) string {
+	parts := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		if segment.param {
+			parts = append(parts, "{}")
+		} else {
+			parts = append(parts, segment.value)
+		}
+	}
+	return "/" + strings.Join(parts, "/")
+}
+
+// nameImportedPathParameters names the unnamed parameters of a path id, id2, id3, ...
+func nameImportedPathParameters(segments []importedSegment) []importedSegment {
+	named := make([]importedSegment, len(segments))
+	copy(named, segments)
+	used := make(map[string]bool)
+	for _, segment := range named {
+		if segment.param && segment.value != "" {
+			used[segment.value] = true
+		}
+	}
+	next := 1
+	for i := range named {
+		if !named[i].param || named[i].value != "" {
+			continue
+		}
+		for {
+			name := "id"
+			if next > 1 {
+				name = "id" + strconv.Itoa(next)
+			}
+			next++
+			if !used[name] {
+				named[i].value = name
+				used[name] = true
+				break
+			}
+		}
+	}
+	return named
+}
+
+func isImportableMethod(method string) bool {
+	switch api.OperationRequestMethod(method) {
+	case api.OperationRequestMethodGET, api.OperationRequestMethodPOST, api.OperationRequestMethodPUT,
+		api.OperationRequestMethodPATCH, api.OperationRequestMethodDELETE, api.OperationRequestMethodHEAD,
+		api.OperationRequestMethodOPTIONS:
+		return true
+	}
+	return false
+}
+
+func methodOrder(method string) int {
+	for i, m := range []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"} {
+		if m == method {
+			return i
+		}
+	}
+	return len(method)
+}
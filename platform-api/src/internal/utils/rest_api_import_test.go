@@ -0,0 +1,262 @@
+/*
+ *  Copyright (c) 2026, WSO2 LLC. (http://www.wso2.org) All Rights Reserved.
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *  http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ */
+
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"platform-api/src/api"
+)
+
+const postmanCollectionDefinition = `{
+  "info": {
+    "name": "Users Service",
+    "description": "Manages users",
+    "schema": "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+  },
+  "variable": [{"key": "baseUrl", "value": "https://api.example.com"}],
+  "item": [
+    {
+      "name": "Users",
+      "item": [
+        {
+          "name": "List users",
+          "request": {"method": "GET", "url": {"raw": "{{baseUrl}}/api/v1/users?page=1", "query": [{"key": "page", "value": "1"}]}},
+          "response": [
+            {"name": "OK", "code": 200, "header": [{"key": "Content-Type", "value": "application/json"}],
+             "body": "[{\"id\": 1, \"name\": \"Ann\", \"email\": null}, {\"id\": 2, \"name\": \"Bob\"}]"}
+          ]
+        },
+        {
+          "name": "Create user",
+          "request": {
+            "method": "POST",
+            "url": "{{baseUrl}}/api/v1/users",
+            "body": {"mode": "raw", "raw": "{\"name\": \"Ann\", \"age\": 30}", "options": {"raw": {"language": "json"}}}
+          }
+        },
+        {
+          "name": "Get user",
+          "request": {"method": "GET", "url": "{{baseUrl}}/api/v1/users/:userId"}
+        },
+        {
+          "name": "Delete order",
+          "request": {"method": "DELETE", "url": "{{baseUrl}}/api/v1/users/42/orders/7"}
+        }
+      ]
+    }
+  ]
+}`
+
+const harDefinition = `{
+  "log": {
+    "version": "1.2",
+    "pages": [{"title": "https://shop.example.com/"}],
+    "entries": [
+      {
+        "request": {"method": "GET", "url": "https://shop.example.com/", "headers": []},
+        "response": {"status": 200, "content": {"mimeType": "text/html", "text": "<html></html>"}}
+      },
+      {
+        "request": {"method": "GET", "url": "https://shop.example.com/static/app.js", "headers": []},
+        "response": {"status": 200, "content": {"mimeType": "application/javascript", "text": ""}}
+      },
+      {
+        "request": {"method": "OPTIONS", "url": "https://shop.example.com/carts/3f2b1c9e-8d4a-4e2f-9b1a-0c5d6e7f8a9b",
+                    "headers": [{"name": "Access-Control-Request-Method", "value": "GET"}]},
+        "response": {"status": 204, "content": {"mimeType": "", "text": ""}}
+      },
+      {
+        "request": {"method": "GET", "url": "https://shop.example.com/carts/3f2b1c9e-8d4a-4e2f-9b1a-0c5d6e7f8a9b", "headers": []},
+        "response": {"status": 200, "content": {"mimeType": "application/json; charset=utf-8",
+                     "text": "eyJpdGVtcyI6IFtdLCAidG90YWwiOiAxMi41fQ==", "encoding": "base64"}}
+      },
+      {
+        "request": {"method": "GET", "url": "https://shop.example.com/carts/7c9e6679-7425-40de-944b-e07fc1f90ae7", "headers": []},
+        "response": {"status": 200, "content": {"mimeType": "application/json", "text": "{\"items\": [{\"sku\": \"a1\"}], \"total\": 3}"}}
+      },
+      {
+        "request": {"method": "POST", "url": "https://shop.example.com/carts", "headers": [],
+                    "postData": {"mimeType": "application/x-www-form-urlencoded", "params": [{"name": "sku", "value": "a1"}]}},
+        "response": {"status": 201, "content": {"mimeType": "application/json", "text": "{\"id\": \"c1\"}"}}
+      },
+      {
+        "request": {"method": "GET", "url": "https://cdn.example.net/config", "headers": []},
+        "response": {"status": 200, "content": {"mimeType": "application/json", "text": "{}"}}
+      }
+    ]
+  }
+}`
+
+func importedOperationPaths(req *api.CreateRESTAPIRequest) []string {
+	var paths []string
+	for _, operation := range *req.Operations {
+		paths = append(paths, string(operation.Request.Method)+" "+operation.Request.Path)
+	}
+	return paths
+}
+
+func decodeImportedDefinition(t *testing.T, definition []byte) map[string]interface{} {
+	t.Helper()
+	if err := (&APIUtil{}).ValidateOpenAPIDefinition(definition); err != nil {
+		t.Fatalf("generated definition is invalid: %v\n%s", err, definition)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(definition, &doc); err != nil {
+		t.Fatalf("failed to decode generated definition: %v", err)
+	}
+	return doc
+}
+
+// lookup walks a decoded JSON document by keys
+func lookup(t *testing.T, doc interface{}, keys ...string) interface{} {
+	t.Helper()
+	current := doc
+	for _, key := range keys {
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			t.Fatalf("no object at %q in path %v", key, keys)
+		}
+		current, ok = object[key]
+		if !ok {
+			t.Fatalf("missing %q in path %v", key, keys)
+		}
+	}
+	return current
+}
+
+func TestConvertPostmanCollectionToRESTAPI(t *testing.T) {
+	req, definition, err := (&APIUtil{}).ConvertPostmanCollectionToRESTAPI([]byte(postmanCollectionDefinition))
+	if err != nil {
+		t.Fatalf("ConvertPostmanCollectionToRESTAPI failed: %v", err)
+	}
+
+	if req.Name != "Users Service" || req.Context != "/api/v1" || req.Version != "v1" {
+		t.Fatalf("unexpected API: name=%q context=%q version=%q", req.Name, req.Context, req.Version)
+	}
+	if req.Description == nil || *req.Description != "Manages users" {
+		t.Fatalf("unexpected description: %v", req.Description)
+	}
+	if got := ValueOrEmpty(req.Upstream.Main.Url); got != "https://api.example.com/api/v1" {
+		t.Fatalf("unexpected upstream %q", got)
+	}
+	want := []string{"GET /users", "POST /users", "DELETE /users/{id}/orders/{id2}", "GET /users/{userId}"}
+	if got := importedOperationPaths(req); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected operations:\n got %v\nwant %v", got, want)
+	}
+
+	doc := decodeImportedDefinition(t, definition)
+	if servers := lookup(t, doc, "servers").([]interface{}); lookup(t, servers[0], "url") != "https://api.example.com/api/v1" {
+		t.Fatalf("unexpected servers %v", servers)
+	}
+	items := lookup(t, doc, "paths", "/users", "get", "responses", "200", "content", "application/json", "schema", "items")
+	if got := lookup(t, items, "required"); !reflect.DeepEqual(got, []interface{}{"id", "name"}) {
+		t.Fatalf("unexpected required properties %v", got)
+	}
+	if got := lookup(t, items, "properties", "id", "type"); got != "integer" {
+		t.Fatalf("unexpected id type %v", got)
+	}
+	if got := lookup(t, items, "properties", "email", "nullable"); got != true {
+		t.Fatalf("expected nullable email, got %v", got)
+	}
+	query := lookup(t, doc, "paths", "/users", "get", "parameters").([]interface{})
+	if len(query) != 1 || lookup(t, query[0], "name") != "page" || lookup(t, query[0], "in") != "query" {
+		t.Fatalf("unexpected query parameters %v", query)
+	}
+	requestSchema := lookup(t, doc, "paths", "/users", "post", "requestBody", "content", "application/json", "schema")
+	if got := lookup(t, requestSchema, "properties", "age", "type"); got != "integer" {
+		t.Fatalf("unexpected age type %v", got)
+	}
+	params := lookup(t, doc, "paths", "/users/{id}/orders/{id2}", "delete", "parameters").([]interface{})
+	if len(params) != 2 || lookup(t, params[0], "schema", "type") != "integer" {
+		t.Fatalf("unexpected path parameters %v", params)
+	}
+}
+
+func TestConvertPostmanCollectionToRESTAPI_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "invalid JSON", content: `{`, wantErr: "invalid Postman collection"},
+		{name: "missing name", content: `{"info": {}, "item": []}`, wantErr: "info.name is required"},
+		{
+			name:    "v2.0 schema",
+			content: `{"info": {"name": "a", "schema": "https://schema.getpostman.com/json/collection/v2.0.0/collection.json"}}`,
+			wantErr: "export the collection as v2.1",
+		},
+		{name: "no requests", content: `{"info": {"name": "a"}, "item": [{"name": "empty", "item": []}]}`, wantErr: "no requests found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := (&APIUtil{}).ConvertPostmanCollectionToRESTAPI([]byte(tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestConvertHARToRESTAPI(t *testing.T) {
+	req, definition, err := (&APIUtil{}).ConvertHARToRESTAPI([]byte(harDefinition))
+	if err != nil {
+		t.Fatalf("ConvertHARToRESTAPI failed: %v", err)
+	}
+
+	// The captured paths share no prefix, so the context is derived from the API name
+	if req.Name != "shop.example.com" || req.Context != "/shopexamplecom" || req.Version != ImportedAPIDefaultVersion {
+		t.Fatalf("unexpected API: name=%q context=%q version=%q", req.Name, req.Context, req.Version)
+	}
+	if got := ValueOrEmpty(req.Upstream.Main.Url); got != "https://shop.example.com" {
+		t.Fatalf("unexpected upstream %q", got)
+	}
+	want := []string{"POST /carts", "GET /carts/{id}"}
+	if got := importedOperationPaths(req); !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected operations:\n got %v\nwant %v", got, want)
+	}
+
+	doc := decodeImportedDefinition(t, definition)
+	param := lookup(t, doc, "paths", "/carts/{id}", "get", "parameters").([]interface{})[0]
+	if lookup(t, param, "schema", "format") != "uuid" {
+		t.Fatalf("expected uuid path parameter, got %v", param)
+	}
+	schema := lookup(t, doc, "paths", "/carts/{id}", "get", "responses", "200", "content", "application/json", "schema")
+	if got := lookup(t, schema, "properties", "total", "type"); got != "number" {
+		t.Fatalf("expected integer and number totals to merge to number, got %v", got)
+	}
+	if got := lookup(t, schema, "properties", "items", "items", "properties", "sku", "type"); got != "string" {
+		t.Fatalf("unexpected item schema %v", got)
+	}
+	// Captured bodies may hold real user data and must not be published as examples
+	if strings.Contains(string(definition), `"example"`) {
+		t.Fatalf("expected no examples from captured traffic, got\n%s", definition)
+	}
+}
+
+func TestConvertHARToRESTAPI_NoAPIRequests(t *testing.T) {
+	content := `{"log": {"entries": [{"request": {"method": "GET", "url": "https://example.com/"},
+		"response": {"status": 200, "content": {"mimeType": "text/html"}}}]}}`
+	_, _, err := (&APIUtil{}).ConvertHARToRESTAPI([]byte(content))
+	if err == nil || !strings.Contains(err.Error(), "no API requests found") {
+		t.Fatalf("expected no API requests error, got %v", err)
+	}
+}
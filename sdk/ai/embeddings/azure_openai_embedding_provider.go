@@ -15,7 +15,7 @@ type AzureOpenAIEmbeddingProvider struct {
 	authHeaderName string
 	azureAPIKey    string
 	endpointURL    string
-	client         *http.Client
+	client         HTTPDoer
 }
 
 // Init initializes the Azure OpenAI embedding provider with configuration
@@ -27,6 +27,10 @@ func (a *AzureOpenAIEmbeddingProvider) Init(config EmbeddingProviderConfig) erro
 	a.azureAPIKey = config.APIKey
 	a.endpointURL = config.EmbeddingEndpoint
 	a.authHeaderName = config.AuthHeaderName
+	if config.HTTPClient != nil {
+		a.client = config.HTTPClient
+		return nil
+	}
 	timeout := DefaultRequestTimeout // Use DefaultRequestTimeout (in seconds)
 	if v, err := strconv.Atoi(config.TimeOut); err == nil {
 		timeout = v
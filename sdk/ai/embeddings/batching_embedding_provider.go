@@ -0,0 +1,132 @@
+package embeddings
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultBatchMaxSize  = 32                   // DefaultBatchMaxSize is the default number of inputs sent in one batch
+	DefaultBatchMaxDelay = 5 * time.Millisecond // DefaultBatchMaxDelay is how long a batch waits for more inputs by default
+)
+
+// BatchOptions configures a BatchingEmbeddingProvider.
+type BatchOptions struct {
+	MaxSize  int           // inputs per upstream call; defaults to DefaultBatchMaxSize
+	MaxDelay time.Duration // longest a call waits for others to join its batch; defaults to DefaultBatchMaxDelay
+}
+
+// BatchingEmbeddingProvider coalesces concurrent GetEmbedding calls into GetEmbeddings calls on
+// the wrapped provider. A batch is sent when it is full or MaxDelay after its first input
+// arrived, whichever comes first. Identical inputs in a batch are embedded once.
+type BatchingEmbeddingProvider struct {
+	inner EmbeddingProvider
+	opts  BatchOptions
+
+	mu      sync.Mutex
+	pending *embeddingBatch
+}
+
+type embeddingBatch struct {
+	inputs  []string
+	index   map[string]int
+	done    chan struct{}
+	results [][]float32
+	err     error
+	timer   *time.Timer
+}
+
+// NewBatchingEmbeddingProvider wraps a provider so concurrent single embeddings are batched.
+func NewBatchingEmbeddingProvider(inner EmbeddingProvider, opts BatchOptions) *BatchingEmbeddingProvider {
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultBatchMaxSize
+	}
+	if opts.MaxDelay <= 0 {
+		opts.MaxDelay = DefaultBatchMaxDelay
+	}
+	return &BatchingEmbeddingProvider{inner: inner, opts: opts}
+}
+
+// Init initializes the wrapped provider
+func (b *BatchingEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
+	return b.inner.Init(config)
+}
+
+// GetType returns the type of the wrapped provider
+func (b *BatchingEmbeddingProvider) GetType() string {
+	return b.inner.GetType()
+}
+
+// GetEmbedding adds the input to the pending batch and waits for the batch's result
+func (b *BatchingEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	b.mu.Lock()
+	batch := b.pending
+	if batch == nil {
+		batch = &embeddingBatch{index: map[string]int{}, done: make(chan struct{})}
+		batch.timer = time.AfterFunc(b.opts.MaxDelay, func() { b.flush(batch) })
+		b.pending = batch
+	}
+	pos, ok := batch.index[input]
+	if !ok {
+		pos = len(batch.inputs)
+		batch.index[input] = pos
+		batch.inputs = append(batch.inputs, input)
+	}
+	// A full batch is detached before the lock is released, so no other input can join it
+	full := len(batch.inputs) >= b.opts.MaxSize
+	if full {
+		b.pending = nil
+		batch.timer.Stop()
+	}
+	b.mu.Unlock()
+
+	if full {
+		b.send(batch)
+	}
+	<-batch.done
+	if batch.err != nil {
+		return nil, batch.err
+	}
+	return batch.results[pos], nil
+}
+
+// GetEmbeddings sends the inputs to the wrapped provider in chunks of MaxSize
+func (b *BatchingEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	embeddings := make([][]float32, 0, len(inputs))
+	for start := 0; start < len(inputs); start += b.opts.MaxSize {
+		end := min(start+b.opts.MaxSize, len(inputs))
+		chunk, err := b.inner.GetEmbeddings(inputs[start:end])
+		if err != nil {
+			return nil, err
+		}
+		if len(chunk) != end-start {
+			return nil, fmt.Errorf("embedding provider returned %d embeddings for %d inputs", len(chunk), end-start)
+		}
+		embeddings = append(embeddings, chunk...)
+	}
+	return embeddings, nil
+}
+
+// flush sends a batch when its MaxDelay expires, unless it was already detached because it
+// became full, so a timer firing after a size-triggered send is a no-op.
+func (b *BatchingEmbeddingProvider) flush(batch *embeddingBatch) {
+	b.mu.Lock()
+	if b.pending != batch {
+		b.mu.Unlock()
+		return
+	}
+	b.pending = nil
+	b.mu.Unlock()
+
+	b.send(batch)
+}
+
+// send embeds the inputs of a detached batch and releases its waiters
+func (b *BatchingEmbeddingProvider) send(batch *embeddingBatch) {
+	batch.results, batch.err = b.inner.GetEmbeddings(batch.inputs)
+	if batch.err == nil && len(batch.results) != len(batch.inputs) {
+		batch.err = fmt.Errorf("embedding provider returned %d embeddings for %d inputs", len(batch.results), len(batch.inputs))
+	}
+	close(batch.done)
+}
@@ -0,0 +1,250 @@
+package embeddings
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	bedrockService = "bedrock"
+	// bedrockBatchConcurrency bounds the parallel invocations of GetEmbeddings, as Titan
+	// embeds one input per request
+	bedrockBatchConcurrency = 4
+)
+
+// BedrockTitanEmbeddingProvider implements the EmbeddingProvider interface for Amazon Titan
+// text embedding models on Bedrock. Requests are SigV4 signed with the configured AWS
+// credentials, or authenticated with a Bedrock API key when no access key is set.
+type BedrockTitanEmbeddingProvider struct {
+	endpointURL     string
+	model           string
+	region          string
+	apiKey          string
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	client          HTTPDoer
+	now             func() time.Time
+}
+
+type titanEmbeddingResponse struct {
+	Embedding []float32 `json:"embedding"`
+	Message   string    `json:"message"`
+}
+
+// Init initializes the Bedrock Titan embedding provider with configuration
+func (b *BedrockTitanEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
+	err := ValidateEmbeddingProviderConfigProps(config)
+	if err != nil {
+		return fmt.Errorf("invalid embedding provider config properties: %v", err)
+	}
+	b.model = config.EmbeddingModel
+	b.region = config.AWSRegion
+	b.apiKey = config.APIKey
+	b.accessKeyID = config.AWSAccessKeyID
+	b.secretAccessKey = config.AWSSecretAccessKey
+	b.sessionToken = config.AWSSessionToken
+	b.now = time.Now
+	b.endpointURL = strings.TrimSuffix(config.EmbeddingEndpoint, "/")
+	if b.endpointURL == "" {
+		b.endpointURL = "https://bedrock-runtime." + b.region + ".amazonaws.com"
+	}
+	if config.HTTPClient != nil {
+		b.client = config.HTTPClient
+		return nil
+	}
+	timeout := DefaultRequestTimeout
+	if v, err := strconv.Atoi(config.TimeOut); err == nil {
+		timeout = v
+	}
+	b.client = &http.Client{
+		Timeout: time.Duration(timeout) * time.Second,
+	}
+	return nil
+}
+
+// GetType returns the type of the embedding provider
+func (b *BedrockTitanEmbeddingProvider) GetType() string {
+	return ProviderBedrockTitan
+}
+
+// GetEmbedding generates an embedding vector for a single input text
+func (b *BedrockTitanEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	body, err := json.Marshal(map[string]interface{}{"inputText": input})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := b.newInvokeRequest(body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var response titanEmbeddingResponse
+	if resp.StatusCode != http.StatusOK {
+		if json.Unmarshal(respBody, &response) == nil && response.Message != "" {
+			return nil, fmt.Errorf("Bedrock API returned status %d: %s", resp.StatusCode, response.Message)
+		}
+		return nil, fmt.Errorf("Bedrock API returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if len(response.Embedding) == 0 {
+		return nil, fmt.Errorf("missing 'embedding' field in response")
+	}
+	return response.Embedding, nil
+}
+
+// GetEmbeddings generates embedding vectors for multiple input texts. Titan has no batch
+// endpoint, so the inputs are embedded with a few requests in parallel.
+func (b *BedrockTitanEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	embeddings := make([][]float32, len(inputs))
+	errs := make([]error, len(inputs))
+	sem := make(chan struct{}, bedrockBatchConcurrency)
+	var wg sync.WaitGroup
+	for i, input := range inputs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, input string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			embeddings[i], errs[i] = b.GetEmbedding(input)
+		}(i, input)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("failed to embed input at index %d: %w", i, err)
+		}
+	}
+	return embeddings, nil
+}
+
+// newInvokeRequest builds an authenticated InvokeModel request for the configured model.
+func (b *BedrockTitanEmbeddingProvider) newInvokeRequest(body []byte) (*http.Request, error) {
+	u, err := url.Parse(b.endpointURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Bedrock endpoint: %w", err)
+	}
+	// Model IDs contain ':' which AWS expects percent-encoded in the path
+	u.RawPath = strings.TrimSuffix(u.EscapedPath(), "/") + "/model/" + awsURIEscape(b.model) + "/invoke"
+	u.Path, err = url.PathUnescape(u.RawPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if b.accessKeyID == "" {
+		req.Header.Set("Authorization", "Bearer "+b.apiKey)
+		return req, nil
+	}
+	b.sign(req, body)
+	return req, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to the request.
+func (b *BedrockTitanEmbeddingProvider) sign(req *http.Request, body []byte) {
+	t := b.now().UTC()
+	amzDate := t.Format("20060102T150405Z")
+	date := t.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	if b.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", b.sessionToken)
+	}
+
+	// Canonical headers are lowercase and sorted by name
+	headers := [][2]string{
+		{"content-type", req.Header.Get("Content-Type")},
+		{"host", req.URL.Host},
+		{"x-amz-date", amzDate},
+	}
+	if b.sessionToken != "" {
+		headers = append(headers, [2]string{"x-amz-security-token", b.sessionToken})
+	}
+	names := make([]string, len(headers))
+	var canonicalHeaders strings.Builder
+	for i, h := range headers {
+		names[i] = h[0]
+		canonicalHeaders.WriteString(h[0] + ":" + strings.TrimSpace(h[1]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	// Services other than S3 sign the already-escaped path escaped once more
+	segments := strings.Split(req.URL.EscapedPath(), "/")
+	for i, s := range segments {
+		segments[i] = awsURIEscape(s)
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		strings.Join(segments, "/"),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + b.region + "/" + bedrockService + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+b.secretAccessKey), date)
+	key = hmacSHA256(key, b.region)
+	key = hmacSHA256(key, bedrockService)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		b.accessKeyID, scope, signedHeaders, signature))
+}
+
+// awsURIEscape percent-encodes every byte except the unreserved characters, as SigV4 requires.
+func awsURIEscape(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' {
+			sb.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&sb, "%%%02X", c)
+	}
+	return sb.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,211 @@
+package embeddings
+
+import (
+	"container/list"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultCacheMaxEntries = 10000 // DefaultCacheMaxEntries is the default number of embeddings kept in the cache
+)
+
+// CacheOptions configures a CachingEmbeddingProvider.
+type CacheOptions struct {
+	Model      string        // part of the cache key, so providers for different models never share entries
+	MaxEntries int           // least recently used entries are evicted beyond this; defaults to DefaultCacheMaxEntries
+	TTL        time.Duration // entries older than this are refetched; zero keeps them until evicted
+	Metrics    Metrics       // optional
+}
+
+// CacheStats is a snapshot of a CachingEmbeddingProvider's counters.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+}
+
+// HitRate returns the fraction of lookups served from the cache.
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// CachingEmbeddingProvider keeps an LRU cache of embeddings keyed by model and normalized
+// input, so repeated prompts skip the embedding API. Inputs are normalized by trimming and
+// collapsing whitespace.
+type CachingEmbeddingProvider struct {
+	inner EmbeddingProvider
+	opts  CacheOptions
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+
+	hits      atomic.Uint64
+	misses    atomic.Uint64
+	evictions atomic.Uint64
+}
+
+type cachedEmbedding struct {
+	key       string
+	embedding []float32
+	storedAt  time.Time
+}
+
+// NewCachingEmbeddingProvider wraps a provider with an embedding cache.
+func NewCachingEmbeddingProvider(inner EmbeddingProvider, opts CacheOptions) *CachingEmbeddingProvider {
+	if opts.MaxEntries <= 0 {
+		opts.MaxEntries = DefaultCacheMaxEntries
+	}
+	return &CachingEmbeddingProvider{
+		inner:   inner,
+		opts:    opts,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+// Init initializes the wrapped provider
+func (c *CachingEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
+	c.opts.Model = config.EmbeddingModel
+	return c.inner.Init(config)
+}
+
+// GetType returns the type of the wrapped provider
+func (c *CachingEmbeddingProvider) GetType() string {
+	return c.inner.GetType()
+}
+
+// GetEmbedding returns the cached embedding of the input, fetching it on a miss
+func (c *CachingEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	key := c.key(input)
+	if embedding, ok := c.lookup(key); ok {
+		return embedding, nil
+	}
+	embedding, err := c.inner.GetEmbedding(input)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, embedding)
+	return embedding, nil
+}
+
+// GetEmbeddings returns the cached embeddings of the inputs, fetching all misses in one call
+func (c *CachingEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	embeddings := make([][]float32, len(inputs))
+	var missInputs, missKeys []string
+	var missPositions [][]int
+	missIndex := map[string]int{}
+	for i, input := range inputs {
+		key := c.key(input)
+		if embedding, ok := c.lookup(key); ok {
+			embeddings[i] = embedding
+			continue
+		}
+		if j, ok := missIndex[key]; ok {
+			missPositions[j] = append(missPositions[j], i)
+			continue
+		}
+		missIndex[key] = len(missInputs)
+		missInputs = append(missInputs, input)
+		missKeys = append(missKeys, key)
+		missPositions = append(missPositions, []int{i})
+	}
+	if len(missInputs) == 0 {
+		return embeddings, nil
+	}
+
+	fetched, err := c.inner.GetEmbeddings(missInputs)
+	if err != nil {
+		return nil, err
+	}
+	if len(fetched) != len(missInputs) {
+		return nil, fmt.Errorf("embedding provider returned %d embeddings for %d inputs", len(fetched), len(missInputs))
+	}
+	for j, embedding := range fetched {
+		c.store(missKeys[j], embedding)
+		for k, i := range missPositions[j] {
+			// Repeated inputs get their own copy, like cache hits do
+			if k > 0 {
+				embedding = slices.Clone(embedding)
+			}
+			embeddings[i] = embedding
+		}
+	}
+	return embeddings, nil
+}
+
+// Stats returns a snapshot of the cache counters.
+func (c *CachingEmbeddingProvider) Stats() CacheStats {
+	c.mu.Lock()
+	entries := c.lru.Len()
+	c.mu.Unlock()
+	return CacheStats{
+		Hits:      c.hits.Load(),
+		Misses:    c.misses.Load(),
+		Evictions: c.evictions.Load(),
+		Entries:   entries,
+	}
+}
+
+func (c *CachingEmbeddingProvider) lookup(key string) ([]float32, bool) {
+	c.mu.Lock()
+	var embedding []float32
+	hit := false
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*cachedEmbedding)
+		if c.opts.TTL > 0 && time.Since(entry.storedAt) > c.opts.TTL {
+			c.lru.Remove(el)
+			delete(c.entries, key)
+		} else {
+			c.lru.MoveToFront(el)
+			// Callers get a copy, so changing it in place cannot corrupt the cache
+			embedding, hit = slices.Clone(entry.embedding), true
+		}
+	}
+	c.mu.Unlock()
+
+	if hit {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+	if c.opts.Metrics != nil {
+		c.opts.Metrics.CacheLookup(c.inner.GetType(), c.opts.Model, hit)
+	}
+	return embedding, hit
+}
+
+func (c *CachingEmbeddingProvider) store(key string, embedding []float32) {
+	// The caller keeps the fetched slice, so the cache keeps its own copy
+	embedding = slices.Clone(embedding)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*cachedEmbedding)
+		entry.embedding, entry.storedAt = embedding, time.Now()
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&cachedEmbedding{key: key, embedding: embedding, storedAt: time.Now()})
+	for c.lru.Len() > c.opts.MaxEntries {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedEmbedding).key)
+		c.evictions.Add(1)
+	}
+}
+
+// key builds the cache key of an input from the model and the whitespace-normalized input.
+func (c *CachingEmbeddingProvider) key(input string) string {
+	return c.opts.Model + "\x00" + strings.Join(strings.Fields(input), " ")
+}
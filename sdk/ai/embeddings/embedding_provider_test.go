@@ -0,0 +1,393 @@
+package embeddings
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeProvider embeds an input as its length and records the batches it receives.
+type fakeProvider struct {
+	mu      sync.Mutex
+	batches [][]string
+	err     error
+}
+
+func (f *fakeProvider) Init(EmbeddingProviderConfig) error { return nil }
+
+func (f *fakeProvider) GetType() string { return "FAKE" }
+
+func (f *fakeProvider) GetEmbedding(input string) ([]float32, error) {
+	embeddings, err := f.GetEmbeddings([]string{input})
+	if err != nil {
+		return nil, err
+	}
+	return embeddings[0], nil
+}
+
+func (f *fakeProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	f.mu.Lock()
+	f.batches = append(f.batches, append([]string(nil), inputs...))
+	f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	out := make([][]float32, len(inputs))
+	for i, input := range inputs {
+		out[i] = []float32{float32(len(input))}
+	}
+	return out, nil
+}
+
+func (f *fakeProvider) calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.batches)
+}
+
+func TestBatchingEmbeddingProvider_CoalescesConcurrentCalls(t *testing.T) {
+	inner := &fakeProvider{}
+	p := NewBatchingEmbeddingProvider(inner, BatchOptions{MaxSize: 4, MaxDelay: time.Second})
+
+	inputs := []string{"a", "bb", "ccc", "bb"}
+	results := make([][]float32, len(inputs))
+	var wg sync.WaitGroup
+	for i, input := range inputs {
+		wg.Add(1)
+		go func(i int, input string) {
+			defer wg.Done()
+			var err error
+			results[i], err = p.GetEmbedding(input)
+			if err != nil {
+				t.Errorf("GetEmbedding(%q) error = %v", input, err)
+			}
+		}(i, input)
+	}
+	// Three distinct inputs never fill the batch, so the delay flushes it
+	wg.Wait()
+
+	if inner.calls() != 1 {
+		t.Fatalf("upstream calls = %d, want 1 (batches: %v)", inner.calls(), inner.batches)
+	}
+	if len(inner.batches[0]) != 3 {
+		t.Fatalf("batch = %v, want 3 distinct inputs", inner.batches[0])
+	}
+	for i, input := range inputs {
+		if results[i][0] != float32(len(input)) {
+			t.Errorf("result for %q = %v", input, results[i])
+		}
+	}
+}
+
+func TestBatchingEmbeddingProvider_FlushesWhenFull(t *testing.T) {
+	inner := &fakeProvider{}
+	p := NewBatchingEmbeddingProvider(inner, BatchOptions{MaxSize: 2, MaxDelay: time.Hour})
+
+	var wg sync.WaitGroup
+	for _, input := range []string{"x", "yy"} {
+		wg.Add(1)
+		go func(input string) {
+			defer wg.Done()
+			if _, err := p.GetEmbedding(input); err != nil {
+				t.Errorf("GetEmbedding(%q) error = %v", input, err)
+			}
+		}(input)
+	}
+	done := make(chan struct{})
+	go func() { wg.Wait(); close(done) }()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("a full batch was not flushed before its delay")
+	}
+}
+
+func TestBatchingEmbeddingProvider_NeverExceedsMaxSize(t *testing.T) {
+	inner := &fakeProvider{}
+	p := NewBatchingEmbeddingProvider(inner, BatchOptions{MaxSize: 4, MaxDelay: 10 * time.Millisecond})
+
+	// Callers keep arriving while full batches are being sent
+	var wg sync.WaitGroup
+	for i := 0; i < 4096; i++ {
+		wg.Add(1)
+		go func(input string) {
+			defer wg.Done()
+			if _, err := p.GetEmbedding(input); err != nil {
+				t.Errorf("GetEmbedding(%q) error = %v", input, err)
+			}
+		}(fmt.Sprintf("input-%d", i))
+	}
+	wg.Wait()
+
+	inner.mu.Lock()
+	defer inner.mu.Unlock()
+	for _, batch := range inner.batches {
+		if len(batch) > 4 {
+			t.Fatalf("upstream batch of %d inputs exceeds MaxSize 4: %v", len(batch), batch)
+		}
+	}
+}
+
+func TestBatchingEmbeddingProvider_PropagatesErrors(t *testing.T) {
+	inner := &fakeProvider{err: fmt.Errorf("upstream down")}
+	p := NewBatchingEmbeddingProvider(inner, BatchOptions{MaxSize: 8, MaxDelay: time.Millisecond})
+	if _, err := p.GetEmbedding("a"); err == nil || !strings.Contains(err.Error(), "upstream down") {
+		t.Fatalf("GetEmbedding() error = %v, want the upstream error", err)
+	}
+}
+
+func TestCachingEmbeddingProvider_HitsAndNormalization(t *testing.T) {
+	inner := &fakeProvider{}
+	metrics := &recordingMetrics{}
+	p := NewCachingEmbeddingProvider(inner, CacheOptions{Model: "m", MaxEntries: 10, Metrics: metrics})
+
+	if _, err := p.GetEmbedding("hello world"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.GetEmbedding("  hello \n world "); err != nil {
+		t.Fatal(err)
+	}
+	got, err := p.GetEmbeddings([]string{"hello world", "new", "new"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 3 || got[1][0] != 3 || got[2][0] != 3 {
+		t.Fatalf("GetEmbeddings() = %v", got)
+	}
+
+	if inner.calls() != 2 {
+		t.Fatalf("upstream calls = %d, want 2 (batches: %v)", inner.calls(), inner.batches)
+	}
+	if len(inner.batches[1]) != 1 {
+		t.Fatalf("second upstream batch = %v, want only the uncached input", inner.batches[1])
+	}
+	stats := p.Stats()
+	if stats.Hits != 2 || stats.Misses != 3 || stats.Entries != 2 {
+		t.Fatalf("Stats() = %+v", stats)
+	}
+	if rate := stats.HitRate(); rate != 0.4 {
+		t.Fatalf("HitRate() = %v, want 0.4", rate)
+	}
+	if metrics.hits.Load() != 2 || metrics.misses.Load() != 3 {
+		t.Fatalf("metrics hits=%d misses=%d", metrics.hits.Load(), metrics.misses.Load())
+	}
+}
+
+func TestCachingEmbeddingProvider_ReturnsCopies(t *testing.T) {
+	inner := &fakeProvider{}
+	p := NewCachingEmbeddingProvider(inner, CacheOptions{Model: "m", MaxEntries: 10})
+
+	// Changing a fetched embedding in place must not change what the cache stored
+	fetched, err := p.GetEmbedding("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fetched[0] = -1
+	hit, err := p.GetEmbedding("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hit[0] != 3 {
+		t.Fatalf("cached embedding = %v after the fetched one was changed, want [3]", hit)
+	}
+
+	// Nor must changing a cache hit, or one of several results for the same input
+	hit[0] = -1
+	got, err := p.GetEmbeddings([]string{"abc", "de", "de"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got[0][0] != 3 {
+		t.Fatalf("cached embedding = %v after a hit was changed, want [3]", got[0])
+	}
+	got[1][0] = -1
+	if got[2][0] != 2 {
+		t.Fatalf("embedding of a repeated input = %v after the first was changed, want [2]", got[2])
+	}
+	again, err := p.GetEmbedding("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again[0] != 2 {
+		t.Fatalf("cached embedding = %v after a batch result was changed, want [2]", again)
+	}
+}
+
+func TestCachingEmbeddingProvider_EvictionAndTTL(t *testing.T) {
+	inner := &fakeProvider{}
+	p := NewCachingEmbeddingProvider(inner, CacheOptions{Model: "m", MaxEntries: 2, TTL: 50 * time.Millisecond})
+
+	for _, input := range []string{"a", "b", "c"} {
+		if _, err := p.GetEmbedding(input); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if stats := p.Stats(); stats.Evictions != 1 || stats.Entries != 2 {
+		t.Fatalf("Stats() after overflow = %+v", stats)
+	}
+	// "a" was least recently used and evicted
+	if _, err := p.GetEmbedding("a"); err != nil {
+		t.Fatal(err)
+	}
+	if inner.calls() != 4 {
+		t.Fatalf("upstream calls = %d, want 4", inner.calls())
+	}
+
+	time.Sleep(80 * time.Millisecond)
+	if _, err := p.GetEmbedding("a"); err != nil {
+		t.Fatal(err)
+	}
+	if inner.calls() != 5 {
+		t.Fatalf("upstream calls after expiry = %d, want 5", inner.calls())
+	}
+}
+
+func TestOpenAICompatibleEmbeddingProvider_OrdersByIndex(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		var body struct {
+			Model string   `json:"model"`
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Model != "nomic-embed-text" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"index":1,"embedding":[2]},{"index":0,"embedding":[1]}]}`)
+	}))
+	defer server.Close()
+
+	p := &OpenAICompatibleEmbeddingProvider{}
+	err := p.Init(EmbeddingProviderConfig{
+		EmbeddingProvider: ProviderOpenAICompatible,
+		EmbeddingEndpoint: server.URL,
+		EmbeddingModel:    "nomic-embed-text",
+	})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	got, err := p.GetEmbeddings([]string{"first", "second"})
+	if err != nil {
+		t.Fatalf("GetEmbeddings() error = %v", err)
+	}
+	if got[0][0] != 1 || got[1][0] != 2 {
+		t.Fatalf("GetEmbeddings() = %v, want embeddings ordered by index", got)
+	}
+	if gotAuth != "" {
+		t.Fatalf("Authorization = %q, want none without an API key", gotAuth)
+	}
+}
+
+func TestGeminiEmbeddingProvider_BatchEmbedContents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1beta/models/text-embedding-004:batchEmbedContents" || r.Header.Get("x-goog-api-key") != "key" {
+			http.Error(w, r.URL.Path, http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"embeddings":[{"values":[0.5]},{"values":[0.25]}]}`)
+	}))
+	defer server.Close()
+
+	p := &GeminiEmbeddingProvider{}
+	err := p.Init(EmbeddingProviderConfig{
+		EmbeddingProvider: ProviderGemini,
+		EmbeddingEndpoint: server.URL + "/v1beta",
+		EmbeddingModel:    "models/text-embedding-004",
+		APIKey:            "key",
+	})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	got, err := p.GetEmbeddings([]string{"a", "b"})
+	if err != nil {
+		t.Fatalf("GetEmbeddings() error = %v", err)
+	}
+	if len(got) != 2 || got[0][0] != 0.5 || got[1][0] != 0.25 {
+		t.Fatalf("GetEmbeddings() = %v", got)
+	}
+}
+
+func TestBedrockTitanEmbeddingProvider_SignsInvokeRequest(t *testing.T) {
+	var gotPath, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.EscapedPath()
+		gotAuth = r.Header.Get("Authorization")
+		fmt.Fprint(w, `{"embedding":[0.1,0.2],"inputTextTokenCount":2}`)
+	}))
+	defer server.Close()
+
+	p := &BedrockTitanEmbeddingProvider{}
+	err := p.Init(EmbeddingProviderConfig{
+		EmbeddingProvider:  ProviderBedrockTitan,
+		EmbeddingEndpoint:  server.URL,
+		EmbeddingModel:     "amazon.titan-embed-text-v2:0",
+		AWSRegion:          "us-east-1",
+		AWSAccessKeyID:     "AKIDEXAMPLE",
+		AWSSecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	got, err := p.GetEmbedding("hello")
+	if err != nil {
+		t.Fatalf("GetEmbedding() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("GetEmbedding() = %v", got)
+	}
+	if gotPath != "/model/amazon.titan-embed-text-v2%3A0/invoke" {
+		t.Fatalf("path = %q", gotPath)
+	}
+	if !strings.HasPrefix(gotAuth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") ||
+		!strings.Contains(gotAuth, "/us-east-1/bedrock/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=") {
+		t.Fatalf("Authorization = %q", gotAuth)
+	}
+}
+
+func TestValidateEmbeddingProviderConfigProps_NewProviders(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  EmbeddingProviderConfig
+		wantErr bool
+	}{
+		{name: "openai compatible without key", config: EmbeddingProviderConfig{EmbeddingProvider: ProviderOpenAICompatible, EmbeddingEndpoint: "http://ollama:11434/v1/embeddings", EmbeddingModel: "nomic-embed-text"}},
+		{name: "openai compatible without model", wantErr: true, config: EmbeddingProviderConfig{EmbeddingProvider: ProviderOpenAICompatible, EmbeddingEndpoint: "http://ollama:11434/v1/embeddings"}},
+		{name: "gemini", config: EmbeddingProviderConfig{EmbeddingProvider: ProviderGemini, EmbeddingModel: "text-embedding-004", APIKey: "key"}},
+		{name: "gemini without key", wantErr: true, config: EmbeddingProviderConfig{EmbeddingProvider: ProviderGemini, EmbeddingModel: "text-embedding-004"}},
+		{name: "titan with access key", config: EmbeddingProviderConfig{EmbeddingProvider: ProviderBedrockTitan, EmbeddingModel: "amazon.titan-embed-text-v2:0", AWSRegion: "us-east-1", AWSAccessKeyID: "id", AWSSecretAccessKey: "secret"}},
+		{name: "titan with api key", config: EmbeddingProviderConfig{EmbeddingProvider: ProviderBedrockTitan, EmbeddingModel: "amazon.titan-embed-text-v2:0", AWSRegion: "us-east-1", APIKey: "key"}},
+		{name: "titan without credentials", wantErr: true, config: EmbeddingProviderConfig{EmbeddingProvider: ProviderBedrockTitan, EmbeddingModel: "amazon.titan-embed-text-v2:0", AWSRegion: "us-east-1"}},
+		{name: "unknown provider", wantErr: true, config: EmbeddingProviderConfig{EmbeddingProvider: "COHERE", AuthHeaderName: "Authorization", APIKey: "key", EmbeddingEndpoint: "http://x", EmbeddingModel: "m"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEmbeddingProviderConfigProps(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateEmbeddingProviderConfigProps() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type recordingMetrics struct {
+	hits, misses, upstream atomic.Int64
+}
+
+func (m *recordingMetrics) CacheLookup(_, _ string, hit bool) {
+	if hit {
+		m.hits.Add(1)
+	} else {
+		m.misses.Add(1)
+	}
+}
+
+func (m *recordingMetrics) UpstreamCall(string, string, int, time.Duration, error) {
+	m.upstream.Add(1)
+}
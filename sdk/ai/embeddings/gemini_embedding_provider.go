@@ -0,0 +1,148 @@
+package embeddings
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultGeminiEndpoint is the Gemini API base URL used when no endpoint is configured
+	DefaultGeminiEndpoint = "https://generativelanguage.googleapis.com/v1beta"
+	geminiAPIKeyHeader    = "x-goog-api-key"
+)
+
+// GeminiEmbeddingProvider implements the EmbeddingProvider interface for Google Gemini. The
+// endpoint is the API base URL; requests go to {endpoint}/models/{model}:batchEmbedContents.
+type GeminiEmbeddingProvider struct {
+	authHeaderName string
+	apiKey         string
+	endpointURL    string
+	model          string
+	client         HTTPDoer
+}
+
+type geminiContent struct {
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiEmbedRequest struct {
+	Model   string        `json:"model"`
+	Content geminiContent `json:"content"`
+}
+
+type geminiBatchEmbedResponse struct {
+	Embeddings []struct {
+		Values []float32 `json:"values"`
+	} `json:"embeddings"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Init initializes the Gemini embedding provider with configuration
+func (g *GeminiEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
+	err := ValidateEmbeddingProviderConfigProps(config)
+	if err != nil {
+		return fmt.Errorf("invalid embedding provider config properties: %v", err)
+	}
+	g.apiKey = config.APIKey
+	g.model = strings.TrimPrefix(config.EmbeddingModel, "models/")
+	g.endpointURL = strings.TrimSuffix(config.EmbeddingEndpoint, "/")
+	if g.endpointURL == "" {
+		g.endpointURL = DefaultGeminiEndpoint
+	}
+	g.authHeaderName = config.AuthHeaderName
+	if g.authHeaderName == "" {
+		g.authHeaderName = geminiAPIKeyHeader
+	}
+	if config.HTTPClient != nil {
+		g.client = config.HTTPClient
+		return nil
+	}
+	timeout := DefaultRequestTimeout
+	if v, err := strconv.Atoi(config.TimeOut); err == nil {
+		timeout = v
+	}
+	g.client = &http.Client{
+		Timeout: time.Duration(timeout) * time.Second,
+	}
+	return nil
+}
+
+// GetType returns the type of the embedding provider
+func (g *GeminiEmbeddingProvider) GetType() string {
+	return ProviderGemini
+}
+
+// GetEmbedding generates an embedding vector for a single input text
+func (g *GeminiEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	embeddings, err := g.GetEmbeddings([]string{input})
+	if err != nil {
+		return nil, err
+	}
+	return embeddings[0], nil
+}
+
+// GetEmbeddings generates embedding vectors for multiple input texts
+func (g *GeminiEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	modelRef := "models/" + g.model
+	requests := make([]geminiEmbedRequest, len(inputs))
+	for i, input := range inputs {
+		requests[i] = geminiEmbedRequest{Model: modelRef, Content: geminiContent{Parts: []geminiPart{{Text: input}}}}
+	}
+	body, err := json.Marshal(map[string]interface{}{"requests": requests})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", g.endpointURL+"/"+modelRef+":batchEmbedContents", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(g.authHeaderName, g.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Gemini API returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var response geminiBatchEmbedResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("Gemini API error: %s", response.Error.Message)
+	}
+	if len(response.Embeddings) != len(inputs) {
+		return nil, fmt.Errorf("Gemini API returned %d embeddings for %d inputs", len(response.Embeddings), len(inputs))
+	}
+
+	embeddings := make([][]float32, len(response.Embeddings))
+	for i, e := range response.Embeddings {
+		if len(e.Values) == 0 {
+			return nil, fmt.Errorf("missing embedding values in response at index %d", i)
+		}
+		embeddings[i] = e.Values
+	}
+	return embeddings, nil
+}
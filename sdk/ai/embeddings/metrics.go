@@ -0,0 +1,54 @@
+package embeddings
+
+import (
+	"time"
+)
+
+// Metrics receives observations from the embedding decorators. Implementations must be safe
+// for concurrent use; the policy engine adapts it to its Prometheus registry.
+type Metrics interface {
+	// CacheLookup records an embedding cache lookup and whether it was a hit
+	CacheLookup(provider, model string, hit bool)
+	// UpstreamCall records a call to the embedding API, the number of inputs it carried,
+	// how long it took and whether it failed
+	UpstreamCall(provider, model string, inputs int, latency time.Duration, err error)
+}
+
+// InstrumentedEmbeddingProvider reports the latency of every call to the wrapped provider.
+type InstrumentedEmbeddingProvider struct {
+	inner   EmbeddingProvider
+	model   string
+	metrics Metrics
+}
+
+// NewInstrumentedEmbeddingProvider wraps a provider so its calls are reported to metrics.
+func NewInstrumentedEmbeddingProvider(inner EmbeddingProvider, model string, metrics Metrics) *InstrumentedEmbeddingProvider {
+	return &InstrumentedEmbeddingProvider{inner: inner, model: model, metrics: metrics}
+}
+
+// Init initializes the wrapped provider
+func (p *InstrumentedEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
+	p.model = config.EmbeddingModel
+	return p.inner.Init(config)
+}
+
+// GetType returns the type of the wrapped provider
+func (p *InstrumentedEmbeddingProvider) GetType() string {
+	return p.inner.GetType()
+}
+
+// GetEmbedding generates an embedding vector and records the call
+func (p *InstrumentedEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	start := time.Now()
+	embedding, err := p.inner.GetEmbedding(input)
+	p.metrics.UpstreamCall(p.inner.GetType(), p.model, 1, time.Since(start), err)
+	return embedding, err
+}
+
+// GetEmbeddings generates embedding vectors and records the call
+func (p *InstrumentedEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	start := time.Now()
+	embeddings, err := p.inner.GetEmbeddings(inputs)
+	p.metrics.UpstreamCall(p.inner.GetType(), p.model, len(inputs), time.Since(start), err)
+	return embeddings, err
+}
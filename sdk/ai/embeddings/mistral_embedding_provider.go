@@ -17,7 +17,7 @@ type MistralEmbeddingProvider struct {
 	mistralAPIKey  string
 	endpointURL    string
 	model          string
-	client         *http.Client
+	client         HTTPDoer
 }
 
 // Init initializes the Mistral embedding provider with configuration
@@ -30,6 +30,10 @@ func (m *MistralEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
 	m.endpointURL = config.EmbeddingEndpoint
 	m.model = config.EmbeddingModel
 	m.authHeaderName = config.AuthHeaderName
+	if config.HTTPClient != nil {
+		m.client = config.HTTPClient
+		return nil
+	}
 	timeout := DefaultRequestTimeout // Use DefaultRequestTimeout (in seconds)
 	if v, err := strconv.Atoi(config.TimeOut); err == nil {
 		timeout = v
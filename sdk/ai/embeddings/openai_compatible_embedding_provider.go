@@ -0,0 +1,131 @@
+package embeddings
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// OpenAICompatibleEmbeddingProvider implements the EmbeddingProvider interface for any server
+// exposing the OpenAI embeddings API, such as Ollama, vLLM or LiteLLM. The API key is optional;
+// when set it is sent as a bearer token in AuthHeaderName, which defaults to Authorization.
+type OpenAICompatibleEmbeddingProvider struct {
+	authHeaderName string
+	apiKey         string
+	endpointURL    string
+	model          string
+	client         HTTPDoer
+}
+
+// openAIEmbeddingResponse is the body returned by OpenAI-style embeddings endpoints
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Init initializes the OpenAI-compatible embedding provider with configuration
+func (o *OpenAICompatibleEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
+	err := ValidateEmbeddingProviderConfigProps(config)
+	if err != nil {
+		return fmt.Errorf("invalid embedding provider config properties: %v", err)
+	}
+	o.apiKey = config.APIKey
+	o.endpointURL = config.EmbeddingEndpoint
+	o.model = config.EmbeddingModel
+	o.authHeaderName = config.AuthHeaderName
+	if o.authHeaderName == "" {
+		o.authHeaderName = "Authorization"
+	}
+	if config.HTTPClient != nil {
+		o.client = config.HTTPClient
+		return nil
+	}
+	timeout := DefaultRequestTimeout
+	if v, err := strconv.Atoi(config.TimeOut); err == nil {
+		timeout = v
+	}
+	o.client = &http.Client{
+		Timeout: time.Duration(timeout) * time.Second,
+	}
+	return nil
+}
+
+// GetType returns the type of the embedding provider
+func (o *OpenAICompatibleEmbeddingProvider) GetType() string {
+	return ProviderOpenAICompatible
+}
+
+// GetEmbedding generates an embedding vector for a single input text
+func (o *OpenAICompatibleEmbeddingProvider) GetEmbedding(input string) ([]float32, error) {
+	embeddings, err := o.GetEmbeddings([]string{input})
+	if err != nil {
+		return nil, err
+	}
+	return embeddings[0], nil
+}
+
+// GetEmbeddings generates embedding vectors for multiple input texts
+func (o *OpenAICompatibleEmbeddingProvider) GetEmbeddings(inputs []string) ([][]float32, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"model": o.model,
+		"input": inputs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", o.endpointURL, bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+	if o.apiKey != "" {
+		req.Header.Set(o.authHeaderName, "Bearer "+o.apiKey)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embedding API returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var response openAIEmbeddingResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("embedding API error: %s", response.Error.Message)
+	}
+	if len(response.Data) != len(inputs) {
+		return nil, fmt.Errorf("embedding API returned %d embeddings for %d inputs", len(response.Data), len(inputs))
+	}
+
+	// Servers may return the embeddings out of order; index identifies the input
+	sort.SliceStable(response.Data, func(i, j int) bool { return response.Data[i].Index < response.Data[j].Index })
+	embeddings := make([][]float32, len(response.Data))
+	for i, d := range response.Data {
+		if len(d.Embedding) == 0 {
+			return nil, fmt.Errorf("missing 'embedding' field in response data at index %d", i)
+		}
+		embeddings[i] = d.Embedding
+	}
+	return embeddings, nil
+}
@@ -16,7 +16,7 @@ type OpenAIEmbeddingProvider struct {
 	openAiAPIKey   string
 	endpointURL    string
 	model          string
-	client         *http.Client
+	client         HTTPDoer
 }
 
 // Init initializes the OpenAI embedding provider with configuration
@@ -29,6 +29,10 @@ func (o *OpenAIEmbeddingProvider) Init(config EmbeddingProviderConfig) error {
 	o.endpointURL = config.EmbeddingEndpoint
 	o.model = config.EmbeddingModel
 	o.authHeaderName = config.AuthHeaderName
+	if config.HTTPClient != nil {
+		o.client = config.HTTPClient
+		return nil
+	}
 	timeout := DefaultRequestTimeout // Use DefaultRequestTimeout (in seconds)
 	if v, err := strconv.Atoi(config.TimeOut); err == nil {
 		timeout = v
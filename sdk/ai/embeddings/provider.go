@@ -1,11 +1,25 @@
 package embeddings
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
 
 const (
 	DefaultRequestTimeout = 30 // DefaultRequestTimeout is the default timeout for requests in seconds (30 seconds)
 )
 
+const (
+	ProviderOpenAI           = "OPENAI"            // ProviderOpenAI is the OpenAI embedding provider type
+	ProviderAzureOpenAI      = "AZURE_OPENAI"      // ProviderAzureOpenAI is the Azure OpenAI embedding provider type
+	ProviderMistral          = "MISTRAL"           // ProviderMistral is the Mistral embedding provider type
+	ProviderOpenAICompatible = "OPENAI_COMPATIBLE" // ProviderOpenAICompatible is any server exposing the OpenAI embeddings API (Ollama, vLLM, LiteLLM)
+	ProviderGemini           = "GEMINI"            // ProviderGemini is the Google Gemini embedding provider type
+	ProviderBedrockTitan     = "BEDROCK_TITAN"     // ProviderBedrockTitan is the Amazon Bedrock Titan embedding provider type
+)
+
 // EmbeddingProvider defines the interface for services that provide text embedding
 type EmbeddingProvider interface {
 	Init(config EmbeddingProviderConfig) error
@@ -14,6 +28,12 @@ type EmbeddingProvider interface {
 	GetEmbeddings(inputs []string) ([][]float32, error)
 }
 
+// HTTPDoer is the minimal HTTP client used by embedding providers. It is satisfied by
+// *http.Client and by the policy engine's shared policy HTTP client.
+type HTTPDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 // EmbeddingProviderConfig defines the properties required for initializing an embedding provider
 type EmbeddingProviderConfig struct {
 	AuthHeaderName    string
@@ -22,10 +42,66 @@ type EmbeddingProviderConfig struct {
 	APIKey            string
 	EmbeddingModel    string
 	TimeOut           string
+	// HTTPClient, when set, is used for calls to the embedding endpoint instead of a
+	// provider-owned client. TimeOut is ignored in that case.
+	HTTPClient HTTPDoer
+
+	// AWSRegion, AWSAccessKeyID, AWSSecretAccessKey and AWSSessionToken configure BEDROCK_TITAN.
+	// Requests are signed with SigV4 when an access key is set; otherwise APIKey is sent as a
+	// Bedrock API key.
+	AWSRegion          string
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	AWSSessionToken    string
+
+	// BatchMaxSize and BatchMaxDelay (milliseconds) enable micro-batching of concurrent
+	// GetEmbedding calls in NewEmbeddingProvider. Batching is off when BatchMaxSize is empty.
+	BatchMaxSize  string
+	BatchMaxDelay string
+	// CacheMaxEntries and CacheTTL (seconds) enable the embedding cache in NewEmbeddingProvider.
+	// Caching is off when CacheMaxEntries is empty.
+	CacheMaxEntries string
+	CacheTTL        string
+	// Metrics, when set, receives cache and upstream call observations.
+	Metrics Metrics
 }
 
 // ValidateEmbeddingProviderConfigProps validates the properties of the embedding provider configuration.
 func ValidateEmbeddingProviderConfigProps(config EmbeddingProviderConfig) error {
+	switch config.EmbeddingProvider {
+	case ProviderOpenAICompatible:
+		// Self-hosted servers often run without authentication, so the API key is optional
+		if config.EmbeddingEndpoint == "" {
+			return fmt.Errorf("missing embedding endpoint in the embedding provider configuration")
+		}
+		if config.EmbeddingModel == "" {
+			return fmt.Errorf("missing embedding model in the embedding provider configuration")
+		}
+		return nil
+	case ProviderGemini:
+		if config.APIKey == "" {
+			return fmt.Errorf("missing API key in the embedding provider configuration")
+		}
+		if config.EmbeddingModel == "" {
+			return fmt.Errorf("missing embedding model in the embedding provider configuration")
+		}
+		return nil
+	case ProviderBedrockTitan:
+		if config.AWSRegion == "" {
+			return fmt.Errorf("missing AWS region in the embedding provider configuration")
+		}
+		if config.EmbeddingModel == "" {
+			return fmt.Errorf("missing embedding model in the embedding provider configuration")
+		}
+		if config.AWSAccessKeyID != "" && config.AWSSecretAccessKey == "" {
+			return fmt.Errorf("missing AWS secret access key in the embedding provider configuration")
+		}
+		if config.AWSAccessKeyID == "" && config.APIKey == "" {
+			return fmt.Errorf("missing AWS credentials or API key in the embedding provider configuration")
+		}
+		return nil
+	}
+
 	if config.AuthHeaderName == "" {
 		return fmt.Errorf("missing auth header name in the embedding provider configuration")
 	}
@@ -35,11 +111,72 @@ func ValidateEmbeddingProviderConfigProps(config EmbeddingProviderConfig) error
 	if config.EmbeddingEndpoint == "" {
 		return fmt.Errorf("missing embedding endpoint in the embedding provider configuration")
 	}
-	if config.EmbeddingProvider != "MISTRAL" && config.EmbeddingProvider != "AZURE_OPENAI" && config.EmbeddingProvider != "OPENAI" {
+	if config.EmbeddingProvider != ProviderMistral && config.EmbeddingProvider != ProviderAzureOpenAI && config.EmbeddingProvider != ProviderOpenAI {
 		return fmt.Errorf("missing/Invalid embedding provider found in the embedding provider configuration")
 	}
-	if config.EmbeddingModel == "" && config.EmbeddingProvider != "AZURE_OPENAI" {
+	if config.EmbeddingModel == "" && config.EmbeddingProvider != ProviderAzureOpenAI {
 		return fmt.Errorf("missing embedding model in the embedding provider configuration")
 	}
 	return nil
 }
+
+// NewEmbeddingProvider creates and initializes the embedding provider for the configured type,
+// wrapped in the batching and caching decorators when the configuration enables them.
+func NewEmbeddingProvider(config EmbeddingProviderConfig) (EmbeddingProvider, error) {
+	var provider EmbeddingProvider
+	switch config.EmbeddingProvider {
+	case ProviderOpenAI:
+		provider = &OpenAIEmbeddingProvider{}
+	case ProviderAzureOpenAI:
+		provider = &AzureOpenAIEmbeddingProvider{}
+	case ProviderMistral:
+		provider = &MistralEmbeddingProvider{}
+	case ProviderOpenAICompatible:
+		provider = &OpenAICompatibleEmbeddingProvider{}
+	case ProviderGemini:
+		provider = &GeminiEmbeddingProvider{}
+	case ProviderBedrockTitan:
+		provider = &BedrockTitanEmbeddingProvider{}
+	default:
+		return nil, fmt.Errorf("unsupported embedding provider: %s", config.EmbeddingProvider)
+	}
+	if err := provider.Init(config); err != nil {
+		return nil, err
+	}
+	if config.Metrics != nil {
+		provider = NewInstrumentedEmbeddingProvider(provider, config.EmbeddingModel, config.Metrics)
+	}
+
+	if config.BatchMaxSize != "" {
+		opts := BatchOptions{}
+		var err error
+		if opts.MaxSize, err = strconv.Atoi(config.BatchMaxSize); err != nil || opts.MaxSize <= 0 {
+			return nil, fmt.Errorf("invalid embedding batch size: %s", config.BatchMaxSize)
+		}
+		if config.BatchMaxDelay != "" {
+			ms, err := strconv.Atoi(config.BatchMaxDelay)
+			if err != nil || ms < 0 {
+				return nil, fmt.Errorf("invalid embedding batch delay: %s", config.BatchMaxDelay)
+			}
+			opts.MaxDelay = time.Duration(ms) * time.Millisecond
+		}
+		provider = NewBatchingEmbeddingProvider(provider, opts)
+	}
+
+	if config.CacheMaxEntries != "" {
+		opts := CacheOptions{Model: config.EmbeddingModel, Metrics: config.Metrics}
+		var err error
+		if opts.MaxEntries, err = strconv.Atoi(config.CacheMaxEntries); err != nil || opts.MaxEntries <= 0 {
+			return nil, fmt.Errorf("invalid embedding cache size: %s", config.CacheMaxEntries)
+		}
+		if config.CacheTTL != "" {
+			secs, err := strconv.Atoi(config.CacheTTL)
+			if err != nil || secs < 0 {
+				return nil, fmt.Errorf("invalid embedding cache TTL: %s", config.CacheTTL)
+			}
+			opts.TTL = time.Duration(secs) * time.Second
+		}
+		provider = NewCachingEmbeddingProvider(provider, opts)
+	}
+	return provider, nil
+}
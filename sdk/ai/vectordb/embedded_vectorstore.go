@@ -0,0 +1,431 @@
+package vectordb
+
+import (
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// embeddedSnapshotVersion is bumped whenever the snapshot layout changes
+const embeddedSnapshotVersion = 1
+
+// EmbeddedVectorStore implements the VectorStore interface with an in-process HNSW index per
+// namespace. It needs no external infrastructure, so it suits single-node deployments. When a
+// snapshot path is configured the live entries are written to disk periodically and on Close,
+// and loaded again on Init.
+type EmbeddedVectorStore struct {
+	mu               sync.RWMutex
+	dimension        int
+	ttl              time.Duration
+	snapshotPath     string
+	snapshotInterval time.Duration
+	namespaces       map[string]*embeddedNamespace
+	dirty            bool
+	stop             chan struct{}
+	done             chan struct{}
+}
+
+type embeddedNamespace struct {
+	graph   *hnswGraph
+	entries map[string]*embeddedEntry
+}
+
+type embeddedEntry struct {
+	node      int
+	embedding []float32
+	payload   []byte
+	metadata  map[string]string
+	expiresAt time.Time
+}
+
+// embeddedSnapshot is the on-disk form of the store.
+type embeddedSnapshot struct {
+	Version   int
+	Dimension int
+	Entries   []embeddedSnapshotEntry
+}
+
+type embeddedSnapshotEntry struct {
+	Namespace string
+	ID        string
+	Embedding []float32
+	Payload   []byte
+	Metadata  map[string]string
+	ExpiresAt time.Time
+}
+
+// Init initializes the embedded vector store with configuration
+func (e *EmbeddedVectorStore) Init(config VectorDBProviderConfig) error {
+	if err := ValidateVectorStoreConfigProps(config); err != nil {
+		return err
+	}
+	dimension, err := strconv.Atoi(config.EmbeddingDimension)
+	if err != nil || dimension <= 0 {
+		return fmt.Errorf("invalid embedding dimension: %s", config.EmbeddingDimension)
+	}
+	ttl := DefaultTTL
+	if config.TTL != "" {
+		ttl, err = strconv.Atoi(config.TTL)
+		if err != nil {
+			return fmt.Errorf("invalid TTL value: %v", err)
+		}
+	}
+	interval := DefaultSnapshotInterval
+	if config.SnapshotInterval != "" {
+		interval, err = strconv.Atoi(config.SnapshotInterval)
+		if err != nil || interval <= 0 {
+			return fmt.Errorf("invalid snapshot interval: %s", config.SnapshotInterval)
+		}
+	}
+
+	e.dimension = dimension
+	e.ttl = time.Duration(ttl) * time.Second
+	e.snapshotPath = config.SnapshotPath
+	e.snapshotInterval = time.Duration(interval) * time.Second
+	e.namespaces = make(map[string]*embeddedNamespace)
+
+	if e.snapshotPath != "" {
+		if err := e.loadSnapshot(); err != nil {
+			return err
+		}
+	}
+
+	e.stop = make(chan struct{})
+	e.done = make(chan struct{})
+	go e.maintain()
+	return nil
+}
+
+// GetType returns the type of the store
+func (e *EmbeddedVectorStore) GetType() string {
+	return ProviderEmbedded
+}
+
+// CreateIndex is a no-op; namespaces are indexed on first use
+func (e *EmbeddedVectorStore) CreateIndex(ctx context.Context) error {
+	return nil
+}
+
+// Store adds an entry to its namespace's index
+func (e *EmbeddedVectorStore) Store(ctx context.Context, entry Entry) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	if entry.Namespace == "" {
+		return "", errNamespaceRequired
+	}
+	if len(entry.Embedding) != e.dimension {
+		return "", fmt.Errorf("embedding dimension mismatch: expected %d, got %d", e.dimension, len(entry.Embedding))
+	}
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+	var expiresAt time.Time
+	if e.ttl > 0 {
+		expiresAt = time.Now().Add(e.ttl)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.put(entry.Namespace, entry.ID, &embeddedEntry{
+		embedding: append([]float32(nil), entry.Embedding...),
+		payload:   entry.Payload,
+		metadata:  copyMetadata(entry.Metadata),
+		expiresAt: expiresAt,
+	})
+	e.dirty = true
+	return entry.ID, nil
+}
+
+// Search returns the entries of a namespace closest to the query embedding
+func (e *EmbeddedVectorStore) Search(ctx context.Context, query SearchQuery) ([]SearchResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(query.Embedding) != e.dimension {
+		return nil, fmt.Errorf("embedding dimension mismatch: expected %d, got %d", e.dimension, len(query.Embedding))
+	}
+	topK := query.TopK
+	if topK <= 0 {
+		topK = 1
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	ns, ok := e.namespaces[query.Namespace]
+	if !ok {
+		return nil, nil
+	}
+	now := time.Now()
+	candidates := ns.graph.search(query.Embedding, topK, func(id int) bool {
+		entry, ok := ns.entries[ns.graph.nodes[id].key]
+		return ok && entry.node == id && !entry.expired(now) && matchesFilter(entry.metadata, query.Filter)
+	})
+
+	results := make([]SearchResult, 0, len(candidates))
+	for _, c := range candidates {
+		score := 1 - c.dist
+		if score < query.MinScore {
+			break
+		}
+		key := ns.graph.nodes[c.id].key
+		entry := ns.entries[key]
+		results = append(results, SearchResult{
+			ID:       key,
+			Score:    score,
+			Payload:  entry.payload,
+			Metadata: copyMetadata(entry.metadata),
+		})
+	}
+	return results, nil
+}
+
+// Delete removes the entries of a namespace matching the metadata filter
+func (e *EmbeddedVectorStore) Delete(ctx context.Context, namespace string, filter map[string]string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ns, ok := e.namespaces[namespace]
+	if !ok {
+		return 0, nil
+	}
+	deleted := 0
+	for id, entry := range ns.entries {
+		if matchesFilter(entry.metadata, filter) {
+			ns.graph.remove(entry.node)
+			delete(ns.entries, id)
+			deleted++
+		}
+	}
+	if deleted > 0 {
+		e.compact(namespace)
+		e.dirty = true
+	}
+	return deleted, nil
+}
+
+// Flush removes every entry of a namespace
+func (e *EmbeddedVectorStore) Flush(ctx context.Context, namespace string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.namespaces[namespace]; ok {
+		delete(e.namespaces, namespace)
+		e.dirty = true
+	}
+	return nil
+}
+
+// Close stops background maintenance and writes a final snapshot
+func (e *EmbeddedVectorStore) Close() error {
+	if e.stop == nil {
+		return nil
+	}
+	close(e.stop)
+	<-e.done
+	e.stop = nil
+	return e.snapshot()
+}
+
+// put indexes an entry, replacing any entry with the same ID. Callers hold the write lock.
+func (e *EmbeddedVectorStore) put(namespace, id string, entry *embeddedEntry) {
+	ns, ok := e.namespaces[namespace]
+	if !ok {
+		ns = &embeddedNamespace{graph: newHNSWGraph(), entries: make(map[string]*embeddedEntry)}
+		e.namespaces[namespace] = ns
+	}
+	if old, ok := ns.entries[id]; ok {
+		ns.graph.remove(old.node)
+	}
+	entry.node = ns.graph.add(id, entry.embedding)
+	ns.entries[id] = entry
+	e.compact(namespace)
+}
+
+// compact rebuilds a namespace's graph once removed nodes outnumber live ones, and drops
+// the namespace when it is empty. Callers hold the write lock.
+func (e *EmbeddedVectorStore) compact(namespace string) {
+	ns := e.namespaces[namespace]
+	if len(ns.entries) == 0 {
+		delete(e.namespaces, namespace)
+		return
+	}
+	if ns.graph.removed < 64 || ns.graph.removed < ns.graph.live() {
+		return
+	}
+	ids := make([]string, 0, len(ns.entries))
+	for id := range ns.entries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	ns.graph = newHNSWGraph()
+	for _, id := range ids {
+		entry := ns.entries[id]
+		entry.node = ns.graph.add(id, entry.embedding)
+	}
+}
+
+// maintain periodically evicts expired entries and snapshots the store.
+func (e *EmbeddedVectorStore) maintain() {
+	defer close(e.done)
+	ticker := time.NewTicker(e.snapshotInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stop:
+			return
+		case <-ticker.C:
+			e.evictExpired()
+			if err := e.snapshot(); err != nil {
+				fmt.Printf("Failed to write the vector store snapshot: %v\n", err)
+			}
+		}
+	}
+}
+
+func (e *EmbeddedVectorStore) evictExpired() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := time.Now()
+	for name, ns := range e.namespaces {
+		evicted := 0
+		for id, entry := range ns.entries {
+			if entry.expired(now) {
+				ns.graph.remove(entry.node)
+				delete(ns.entries, id)
+				evicted++
+			}
+		}
+		if evicted > 0 {
+			e.compact(name)
+			e.dirty = true
+		}
+	}
+}
+
+// snapshot writes the live entries to the snapshot path if anything changed since the last
+// snapshot. The file is replaced atomically so a crash never leaves a partial snapshot.
+func (e *EmbeddedVectorStore) snapshot() error {
+	if e.snapshotPath == "" {
+		return nil
+	}
+	e.mu.Lock()
+	if !e.dirty {
+		e.mu.Unlock()
+		return nil
+	}
+	snap := embeddedSnapshot{Version: embeddedSnapshotVersion, Dimension: e.dimension}
+	now := time.Now()
+	for name, ns := range e.namespaces {
+		for id, entry := range ns.entries {
+			if entry.expired(now) {
+				continue
+			}
+			snap.Entries = append(snap.Entries, embeddedSnapshotEntry{
+				Namespace: name,
+				ID:        id,
+				Embedding: entry.embedding,
+				Payload:   entry.payload,
+				Metadata:  entry.metadata,
+				ExpiresAt: entry.expiresAt,
+			})
+		}
+	}
+	e.dirty = false
+	e.mu.Unlock()
+
+	if err := writeSnapshot(e.snapshotPath, &snap); err != nil {
+		e.mu.Lock()
+		e.dirty = true
+		e.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// loadSnapshot restores the entries of an existing snapshot. A missing snapshot is not an
+// error; one written for a different embedding dimension is ignored.
+func (e *EmbeddedVectorStore) loadSnapshot() error {
+	f, err := os.Open(e.snapshotPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to open vector store snapshot: %w", err)
+	}
+	defer f.Close()
+
+	var snap embeddedSnapshot
+	if err := gob.NewDecoder(f).Decode(&snap); err != nil {
+		return fmt.Errorf("failed to decode vector store snapshot: %w", err)
+	}
+	if snap.Version != embeddedSnapshotVersion || snap.Dimension != e.dimension {
+		fmt.Printf("Ignoring vector store snapshot %s: version %d, dimension %d\n", e.snapshotPath, snap.Version, snap.Dimension)
+		return nil
+	}
+
+	now := time.Now()
+	for _, s := range snap.Entries {
+		entry := &embeddedEntry{embedding: s.Embedding, payload: s.Payload, metadata: s.Metadata, expiresAt: s.ExpiresAt}
+		if entry.expired(now) {
+			continue
+		}
+		e.put(s.Namespace, s.ID, entry)
+	}
+	return nil
+}
+
+func writeSnapshot(path string, snap *embeddedSnapshot) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := gob.NewEncoder(tmp).Encode(snap); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close snapshot: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace snapshot: %w", err)
+	}
+	return nil
+}
+
+func (e *embeddedEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && now.After(e.expiresAt)
+}
+
+func copyMetadata(metadata map[string]string) map[string]string {
+	if metadata == nil {
+		return nil
+	}
+	out := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,397 @@
+package vectordb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestEmbeddedStore(t *testing.T, dimension int, snapshotPath string) *EmbeddedVectorStore {
+	t.Helper()
+	store := &EmbeddedVectorStore{}
+	err := store.Init(VectorDBProviderConfig{
+		VectorStoreProvider: ProviderEmbedded,
+		EmbeddingDimension:  fmt.Sprint(dimension),
+		Threshold:           "0.9",
+		SnapshotPath:        snapshotPath,
+	})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func mustStore(t *testing.T, store VectorStore, entry Entry) string {
+	t.Helper()
+	id, err := store.Store(context.Background(), entry)
+	if err != nil {
+		t.Fatalf("Store(%q) error = %v", entry.ID, err)
+	}
+	return id
+}
+
+func resultIDs(results []SearchResult) []string {
+	ids := make([]string, len(results))
+	for i, r := range results {
+		ids[i] = r.ID
+	}
+	return ids
+}
+
+func equalIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestEmbeddedVectorStore_Search(t *testing.T) {
+	ctx := context.Background()
+	store := newTestEmbeddedStore(t, 3, "")
+	for _, e := range []Entry{
+		{ID: "x", Embedding: []float32{1, 0, 0}, Metadata: map[string]string{"route": "/a"}},
+		{ID: "xy", Embedding: []float32{1, 1, 0}, Metadata: map[string]string{"route": "/b"}},
+		{ID: "y", Embedding: []float32{0, 1, 0}, Metadata: map[string]string{"route": "/a"}},
+		{ID: "z", Embedding: []float32{0, 0, 1}},
+	} {
+		e.Namespace = "api-1"
+		mustStore(t, store, e)
+	}
+	mustStore(t, store, Entry{ID: "other", Namespace: "api-2", Embedding: []float32{1, 0, 0}})
+
+	tests := []struct {
+		name  string
+		query SearchQuery
+		want  []string
+	}{
+		{name: "top-k closest first", query: SearchQuery{Namespace: "api-1", Embedding: []float32{1, 0.1, 0.05}, TopK: 4}, want: []string{"x", "xy", "y", "z"}},
+		{name: "top-k defaults to one", query: SearchQuery{Namespace: "api-1", Embedding: []float32{0, 1, 0}}, want: []string{"y"}},
+		{name: "min score drops far entries", query: SearchQuery{Namespace: "api-1", Embedding: []float32{1, 0, 0}, TopK: 4, MinScore: 0.5}, want: []string{"x", "xy"}},
+		{name: "metadata filter", query: SearchQuery{Namespace: "api-1", Embedding: []float32{1, 0, 0}, TopK: 4, Filter: map[string]string{"route": "/a"}}, want: []string{"x", "y"}},
+		{name: "namespaces are isolated", query: SearchQuery{Namespace: "api-2", Embedding: []float32{0, 1, 0}, TopK: 4}, want: []string{"other"}},
+		{name: "unknown namespace", query: SearchQuery{Namespace: "api-3", Embedding: []float32{1, 0, 0}}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := store.Search(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if got := resultIDs(results); !equalIDs(got, tt.want) {
+				t.Fatalf("Search() = %v, want %v", got, tt.want)
+			}
+			for i := 1; i < len(results); i++ {
+				if results[i-1].Score < results[i].Score {
+					t.Fatalf("Search() scores are not descending: %v", results)
+				}
+			}
+		})
+	}
+}
+
+func TestEmbeddedVectorStore_StoreValidation(t *testing.T) {
+	ctx := context.Background()
+	store := newTestEmbeddedStore(t, 2, "")
+	tests := []struct {
+		name  string
+		entry Entry
+	}{
+		{name: "missing namespace", entry: Entry{Embedding: []float32{1, 0}}},
+		{name: "dimension mismatch", entry: Entry{Namespace: "api-1", Embedding: []float32{1, 0, 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := store.Store(ctx, tt.entry); err == nil {
+				t.Fatal("Store() error = nil, want an error")
+			}
+		})
+	}
+
+	if _, err := store.Search(ctx, SearchQuery{Namespace: "api-1", Embedding: []float32{1}}); err == nil {
+		t.Fatal("Search() with a mismatched dimension error = nil, want an error")
+	}
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := store.Store(cancelled, Entry{Namespace: "api-1", Embedding: []float32{1, 0}}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("Store() with a cancelled context error = %v, want context.Canceled", err)
+	}
+}
+
+func TestEmbeddedVectorStore_ReplaceAndTombstones(t *testing.T) {
+	ctx := context.Background()
+	store := newTestEmbeddedStore(t, 2, "")
+	if id := mustStore(t, store, Entry{Namespace: "api-1", Embedding: []float32{1, 0}}); id == "" {
+		t.Fatal("Store() returned an empty generated ID")
+	}
+	mustStore(t, store, Entry{ID: "a", Namespace: "api-1", Embedding: []float32{1, 0}, Payload: []byte("old")})
+	mustStore(t, store, Entry{ID: "a", Namespace: "api-1", Embedding: []float32{0, 1}, Payload: []byte("new")})
+
+	results, err := store.Search(ctx, SearchQuery{Namespace: "api-1", Embedding: []float32{0, 1}, TopK: 5})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 2 || results[0].ID != "a" || string(results[0].Payload) != "new" {
+		t.Fatalf("Search() = %+v, want the replaced entry first", results)
+	}
+	if ns := store.namespaces["api-1"]; ns.graph.removed != 1 || len(ns.entries) != 2 {
+		t.Fatalf("graph has %d removed nodes and %d entries, want 1 and 2", ns.graph.removed, len(ns.entries))
+	}
+
+	// Enough replacements to outnumber the live nodes rebuild the graph without tombstones.
+	for i := 0; i < 100; i++ {
+		mustStore(t, store, Entry{ID: "a", Namespace: "api-1", Embedding: []float32{1, float32(i)}})
+	}
+	if ns := store.namespaces["api-1"]; ns.graph.removed >= 64 || len(ns.graph.nodes) > 66 {
+		t.Fatalf("graph was not compacted: %d nodes, %d removed", len(ns.graph.nodes), ns.graph.removed)
+	}
+}
+
+func TestEmbeddedVectorStore_TTLEviction(t *testing.T) {
+	ctx := context.Background()
+	store := newTestEmbeddedStore(t, 2, "")
+	mustStore(t, store, Entry{ID: "stale", Namespace: "api-1", Embedding: []float32{1, 0}})
+	mustStore(t, store, Entry{ID: "fresh", Namespace: "api-1", Embedding: []float32{1, 0.1}})
+
+	store.mu.Lock()
+	store.namespaces["api-1"].entries["stale"].expiresAt = time.Now().Add(-time.Second)
+	store.mu.Unlock()
+
+	results, err := store.Search(ctx, SearchQuery{Namespace: "api-1", Embedding: []float32{1, 0}, TopK: 2})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := resultIDs(results); !equalIDs(got, []string{"fresh"}) {
+		t.Fatalf("Search() = %v, want only the unexpired entry", got)
+	}
+
+	store.evictExpired()
+	if _, ok := store.namespaces["api-1"].entries["stale"]; ok {
+		t.Fatal("evictExpired() kept an expired entry")
+	}
+	if _, ok := store.namespaces["api-1"].entries["fresh"]; !ok {
+		t.Fatal("evictExpired() removed an unexpired entry")
+	}
+}
+
+func TestEmbeddedVectorStore_DeleteAndFlush(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		filter      map[string]string
+		wantDeleted int
+		wantLeft    []string
+	}{
+		{name: "single pair", filter: map[string]string{"route": "/a"}, wantDeleted: 2, wantLeft: []string{"c"}},
+		{name: "every pair must match", filter: map[string]string{"route": "/a", "model": "m1"}, wantDeleted: 1, wantLeft: []string{"b", "c"}},
+		{name: "no match", filter: map[string]string{"route": "/z"}, wantDeleted: 0, wantLeft: []string{"a", "b", "c"}},
+		{name: "empty filter removes all", filter: nil, wantDeleted: 3, wantLeft: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newTestEmbeddedStore(t, 2, "")
+			mustStore(t, store, Entry{ID: "a", Namespace: "api-1", Embedding: []float32{1, 0}, Metadata: map[string]string{"route": "/a", "model": "m1"}})
+			mustStore(t, store, Entry{ID: "b", Namespace: "api-1", Embedding: []float32{1, 0.1}, Metadata: map[string]string{"route": "/a", "model": "m2"}})
+			mustStore(t, store, Entry{ID: "c", Namespace: "api-1", Embedding: []float32{1, 0.2}, Metadata: map[string]string{"route": "/c"}})
+			mustStore(t, store, Entry{ID: "d", Namespace: "api-2", Embedding: []float32{1, 0}, Metadata: map[string]string{"route": "/a"}})
+
+			deleted, err := store.Delete(ctx, "api-1", tt.filter)
+			if err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+			if deleted != tt.wantDeleted {
+				t.Fatalf("Delete() = %d, want %d", deleted, tt.wantDeleted)
+			}
+			results, err := store.Search(ctx, SearchQuery{Namespace: "api-1", Embedding: []float32{1, 0}, TopK: 5})
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if got := resultIDs(results); !equalIDs(got, tt.wantLeft) {
+				t.Fatalf("entries left = %v, want %v", got, tt.wantLeft)
+			}
+			if other, _ := store.Search(ctx, SearchQuery{Namespace: "api-2", Embedding: []float32{1, 0}}); len(other) != 1 {
+				t.Fatalf("Delete() touched another namespace: %v", other)
+			}
+		})
+	}
+
+	t.Run("flush", func(t *testing.T) {
+		store := newTestEmbeddedStore(t, 2, "")
+		mustStore(t, store, Entry{Namespace: "api-1", Embedding: []float32{1, 0}})
+		mustStore(t, store, Entry{Namespace: "api-2", Embedding: []float32{1, 0}})
+		if err := store.Flush(ctx, "api-1"); err != nil {
+			t.Fatalf("Flush() error = %v", err)
+		}
+		if err := store.Flush(ctx, "unknown"); err != nil {
+			t.Fatalf("Flush() of an unknown namespace error = %v", err)
+		}
+		if results, _ := store.Search(ctx, SearchQuery{Namespace: "api-1", Embedding: []float32{1, 0}}); len(results) != 0 {
+			t.Fatalf("Search() after Flush() = %v, want none", results)
+		}
+		if results, _ := store.Search(ctx, SearchQuery{Namespace: "api-2", Embedding: []float32{1, 0}}); len(results) != 1 {
+			t.Fatalf("Flush() touched another namespace: %v", results)
+		}
+	})
+}
+
+func TestEmbeddedVectorStore_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cache", "index.gob")
+
+	store := newTestEmbeddedStore(t, 2, path)
+	mustStore(t, store, Entry{ID: "a", Namespace: "api-1", Embedding: []float32{1, 0}, Payload: []byte("pa"), Metadata: map[string]string{"route": "/a"}})
+	mustStore(t, store, Entry{ID: "b", Namespace: "api-2", Embedding: []float32{0, 1}, Payload: []byte("pb")})
+	mustStore(t, store, Entry{ID: "expired", Namespace: "api-1", Embedding: []float32{1, 0.1}})
+	store.mu.Lock()
+	store.namespaces["api-1"].entries["expired"].expiresAt = time.Now().Add(-time.Second)
+	store.mu.Unlock()
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		dimension int
+		want      map[string][]string
+	}{
+		{name: "round trip", dimension: 2, want: map[string][]string{"api-1": {"a"}, "api-2": {"b"}}},
+		{name: "ignored on dimension mismatch", dimension: 3, want: map[string][]string{"api-1": {}, "api-2": {}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restored := newTestEmbeddedStore(t, tt.dimension, path)
+			query := make([]float32, tt.dimension)
+			query[0] = 1
+			for namespace, want := range tt.want {
+				results, err := restored.Search(ctx, SearchQuery{Namespace: namespace, Embedding: query, TopK: 5})
+				if err != nil {
+					t.Fatalf("Search() error = %v", err)
+				}
+				if got := resultIDs(results); !equalIDs(got, want) {
+					t.Fatalf("namespace %s restored %v, want %v", namespace, got, want)
+				}
+			}
+			if tt.dimension == 2 {
+				results, _ := restored.Search(ctx, SearchQuery{Namespace: "api-1", Embedding: query})
+				if string(results[0].Payload) != "pa" || results[0].Metadata["route"] != "/a" {
+					t.Fatalf("restored entry = %+v, want its payload and metadata", results[0])
+				}
+			}
+		})
+	}
+}
+
+func TestNewVectorDBProviderFromStore(t *testing.T) {
+	ctx := context.Background()
+	provider := NewVectorDBProviderFromStore(newTestEmbeddedStore(t, 2, ""))
+	if provider.GetType() != ProviderEmbedded {
+		t.Fatalf("GetType() = %q, want %q", provider.GetType(), ProviderEmbedded)
+	}
+	if err := provider.CreateIndex(); err != nil {
+		t.Fatalf("CreateIndex() error = %v", err)
+	}
+	filter := func(apiID, threshold string) map[string]interface{} {
+		return map[string]interface{}{"ctx": ctx, "api_id": apiID, "threshold": threshold}
+	}
+
+	response := CacheResponse{ResponsePayload: map[string]interface{}{"answer": "42"}}
+	if err := provider.Store([]float32{1, 0}, response, filter("api-1", "")); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		query     []float32
+		filter    map[string]interface{}
+		wantHit   bool
+		wantEmpty bool
+		wantErr   error
+	}{
+		{name: "hit", query: []float32{1, 0.01}, filter: filter("api-1", "0.9"), wantHit: true},
+		{name: "below threshold", query: []float32{0.5, 1}, filter: filter("api-1", "0.9"), wantEmpty: true},
+		{name: "empty namespace", query: []float32{1, 0}, filter: filter("api-2", "0.9"), wantErr: ErrNotFound},
+		{name: "missing threshold", query: []float32{1, 0}, filter: map[string]interface{}{"ctx": ctx, "api_id": "api-1"}},
+		{name: "missing ctx", query: []float32{1, 0}, filter: map[string]interface{}{"api_id": "api-1", "threshold": "0.9"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := provider.Retrieve(tt.query, tt.filter)
+			switch {
+			case tt.wantHit:
+				if err != nil {
+					t.Fatalf("Retrieve() error = %v", err)
+				}
+				if fmt.Sprint(got.ResponsePayload["answer"]) != "42" {
+					t.Fatalf("Retrieve() = %+v, want the stored response", got)
+				}
+			case tt.wantEmpty:
+				if err != nil || got.ResponsePayload != nil {
+					t.Fatalf("Retrieve() = %+v, %v, want an empty response", got, err)
+				}
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Retrieve() error = %v, want %v", err, tt.wantErr)
+				}
+			default:
+				if err == nil {
+					t.Fatal("Retrieve() error = nil, want an error")
+				}
+			}
+		})
+	}
+}
+
+// TestEmbeddedVectorStore_Concurrent exercises the store's locking; run it with -race.
+func TestEmbeddedVectorStore_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	store := newTestEmbeddedStore(t, 4, filepath.Join(t.TempDir(), "index.gob"))
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 64)
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			namespace := fmt.Sprintf("api-%d", w%2)
+			for i := 0; i < 200; i++ {
+				embedding := []float32{float32(w), float32(i), 1, float32(i % 7)}
+				metadata := map[string]string{"worker": fmt.Sprint(w)}
+				if _, err := store.Store(ctx, Entry{ID: fmt.Sprintf("%d-%d", w, i%50), Namespace: namespace, Embedding: embedding, Metadata: metadata}); err != nil {
+					errs <- err
+					return
+				}
+				if _, err := store.Search(ctx, SearchQuery{Namespace: namespace, Embedding: embedding, TopK: 3, Filter: metadata}); err != nil {
+					errs <- err
+					return
+				}
+				if i%25 == 0 {
+					if _, err := store.Delete(ctx, namespace, metadata); err != nil {
+						errs <- err
+						return
+					}
+				}
+				if i%100 == 0 {
+					store.evictExpired()
+					if err := store.snapshot(); err != nil {
+						errs <- err
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("concurrent operation failed: %v", err)
+	}
+}
@@ -0,0 +1,262 @@
+package vectordb
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	hnswM              = 16  // hnswM is the number of neighbors kept per node on the upper layers
+	hnswEfConstruction = 200 // hnswEfConstruction is the candidate list size used while inserting
+	hnswEfSearch       = 64  // hnswEfSearch is the minimum candidate list size used while searching
+)
+
+// hnswGraph is an in-memory Hierarchical Navigable Small World index over cosine distance.
+// Vectors are normalized on insert so the distance is 1 - dot product. Removed nodes are
+// tombstoned and kept for traversal until the owner rebuilds the graph. It is not safe for
+// concurrent use; callers provide their own locking.
+type hnswGraph struct {
+	nodes    []*hnswNode
+	entry    int
+	maxLevel int
+	removed  int
+	rng      *rand.Rand
+}
+
+type hnswNode struct {
+	key     string
+	vector  []float32
+	links   [][]int
+	removed bool
+}
+
+// hnswCandidate is a node with its distance to a query.
+type hnswCandidate struct {
+	id   int
+	dist float64
+}
+
+func newHNSWGraph() *hnswGraph {
+	return &hnswGraph{entry: -1, rng: rand.New(rand.NewSource(rand.Int63()))}
+}
+
+// live returns the number of nodes that have not been removed.
+func (g *hnswGraph) live() int {
+	return len(g.nodes) - g.removed
+}
+
+// add inserts a vector under key and returns its node ID.
+func (g *hnswGraph) add(key string, vector []float32) int {
+	id := len(g.nodes)
+	level := g.randomLevel()
+	node := &hnswNode{key: key, vector: normalize(vector), links: make([][]int, level+1)}
+	g.nodes = append(g.nodes, node)
+
+	if g.entry < 0 {
+		g.entry = id
+		g.maxLevel = level
+		return id
+	}
+
+	ep := []hnswCandidate{{id: g.entry, dist: g.distance(node.vector, g.entry)}}
+	for l := g.maxLevel; l > level; l-- {
+		ep = g.searchLayer(node.vector, ep, 1, l)
+	}
+	for l := min(level, g.maxLevel); l >= 0; l-- {
+		candidates := g.searchLayer(node.vector, ep, hnswEfConstruction, l)
+		neighbors := closest(candidates, maxLinks(l))
+		for _, n := range neighbors {
+			node.links[l] = append(node.links[l], n.id)
+			g.connect(n.id, id, l)
+		}
+		ep = candidates
+	}
+	if level > g.maxLevel {
+		g.maxLevel = level
+		g.entry = id
+	}
+	return id
+}
+
+// remove tombstones a node so searches no longer return it.
+func (g *hnswGraph) remove(id int) {
+	if id < 0 || id >= len(g.nodes) || g.nodes[id].removed {
+		return
+	}
+	g.nodes[id].removed = true
+	g.removed++
+}
+
+// search returns up to k live nodes closest to query for which accept returns true,
+// closest first. The candidate list is widened when filtering leaves too few results.
+func (g *hnswGraph) search(query []float32, k int, accept func(id int) bool) []hnswCandidate {
+	if g.entry < 0 || k <= 0 || g.live() == 0 {
+		return nil
+	}
+	q := normalize(query)
+	ep := []hnswCandidate{{id: g.entry, dist: g.distance(q, g.entry)}}
+	for l := g.maxLevel; l > 0; l-- {
+		ep = g.searchLayer(q, ep, 1, l)
+	}
+
+	ef := max(k, hnswEfSearch)
+	for {
+		var results []hnswCandidate
+		for _, c := range g.searchLayer(q, ep, ef, 0) {
+			if g.nodes[c.id].removed || (accept != nil && !accept(c.id)) {
+				continue
+			}
+			results = append(results, c)
+			if len(results) == k {
+				return results
+			}
+		}
+		if ef >= len(g.nodes) {
+			return results
+		}
+		ef *= 2
+	}
+}
+
+// searchLayer runs a best-first search of one layer and returns up to ef candidates,
+// closest first. Removed nodes are traversed but still counted as candidates.
+func (g *hnswGraph) searchLayer(query []float32, entryPoints []hnswCandidate, ef, level int) []hnswCandidate {
+	visited := make(map[int]struct{}, ef*2)
+	candidates := &candidateHeap{}
+	results := &candidateHeap{max: true}
+	for _, ep := range entryPoints {
+		visited[ep.id] = struct{}{}
+		heap.Push(candidates, ep)
+		heap.Push(results, ep)
+		if results.Len() > ef {
+			heap.Pop(results)
+		}
+	}
+
+	for candidates.Len() > 0 {
+		c := heap.Pop(candidates).(hnswCandidate)
+		if results.Len() >= ef && c.dist > results.items[0].dist {
+			break
+		}
+		node := g.nodes[c.id]
+		if level >= len(node.links) {
+			continue
+		}
+		for _, n := range node.links[level] {
+			if _, seen := visited[n]; seen {
+				continue
+			}
+			visited[n] = struct{}{}
+			d := g.distance(query, n)
+			if results.Len() < ef || d < results.items[0].dist {
+				heap.Push(candidates, hnswCandidate{id: n, dist: d})
+				heap.Push(results, hnswCandidate{id: n, dist: d})
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	out := make([]hnswCandidate, results.Len())
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop(results).(hnswCandidate)
+	}
+	return out
+}
+
+// connect links from to to on a layer, pruning from's links to the closest ones when full.
+func (g *hnswGraph) connect(from, to, level int) {
+	node := g.nodes[from]
+	node.links[level] = append(node.links[level], to)
+	limit := maxLinks(level)
+	if len(node.links[level]) <= limit {
+		return
+	}
+	candidates := make([]hnswCandidate, len(node.links[level]))
+	for i, n := range node.links[level] {
+		candidates[i] = hnswCandidate{id: n, dist: g.distance(node.vector, n)}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].dist < candidates[j].dist })
+	links := make([]int, limit)
+	for i := range links {
+		links[i] = candidates[i].id
+	}
+	node.links[level] = links
+}
+
+func (g *hnswGraph) distance(query []float32, id int) float64 {
+	return 1 - dot(query, g.nodes[id].vector)
+}
+
+func (g *hnswGraph) randomLevel() int {
+	return int(math.Floor(-math.Log(1-g.rng.Float64()) / math.Log(hnswM)))
+}
+
+// maxLinks returns the neighbor limit of a layer; the base layer keeps twice as many.
+func maxLinks(level int) int {
+	if level == 0 {
+		return hnswM * 2
+	}
+	return hnswM
+}
+
+// closest returns the first n of a closest-first candidate list.
+func closest(candidates []hnswCandidate, n int) []hnswCandidate {
+	if len(candidates) > n {
+		return candidates[:n]
+	}
+	return candidates
+}
+
+func normalize(v []float32) []float32 {
+	var sum float64
+	for _, f := range v {
+		sum += float64(f) * float64(f)
+	}
+	out := make([]float32, len(v))
+	if sum == 0 {
+		return out
+	}
+	norm := math.Sqrt(sum)
+	for i, f := range v {
+		out[i] = float32(float64(f) / norm)
+	}
+	return out
+}
+
+func dot(a, b []float32) float64 {
+	var sum float64
+	for i := range a {
+		sum += float64(a[i]) * float64(b[i])
+	}
+	return sum
+}
+
+// candidateHeap orders candidates by distance, nearest first unless max is set.
+type candidateHeap struct {
+	items []hnswCandidate
+	max   bool
+}
+
+func (h *candidateHeap) Len() int { return len(h.items) }
+
+func (h *candidateHeap) Less(i, j int) bool {
+	if h.max {
+		return h.items[i].dist > h.items[j].dist
+	}
+	return h.items[i].dist < h.items[j].dist
+}
+
+func (h *candidateHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *candidateHeap) Push(x any) { h.items = append(h.items, x.(hnswCandidate)) }
+
+func (h *candidateHeap) Pop() any {
+	n := len(h.items)
+	item := h.items[n-1]
+	h.items = h.items[:n-1]
+	return item
+}
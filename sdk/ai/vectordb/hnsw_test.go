@@ -0,0 +1,147 @@
+package vectordb
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func newTestHNSWGraph() *hnswGraph {
+	g := newHNSWGraph()
+	g.rng = rand.New(rand.NewSource(1))
+	return g
+}
+
+func searchKeys(g *hnswGraph, query []float32, k int, accept func(id int) bool) []string {
+	var keys []string
+	for _, c := range g.search(query, k, accept) {
+		keys = append(keys, g.nodes[c.id].key)
+	}
+	return keys
+}
+
+func TestHNSWGraph_Search(t *testing.T) {
+	vectors := map[string][]float32{
+		"x":  {1, 0, 0},
+		"xy": {1, 1, 0},
+		"y":  {0, 1, 0},
+		"z":  {0, 0, 1},
+		"-x": {-1, 0, 0},
+	}
+	tests := []struct {
+		name    string
+		query   []float32
+		k       int
+		removed []string
+		accept  func(key string) bool
+		want    []string
+	}{
+		{name: "nearest first", query: []float32{1, 0.1, 0}, k: 3, want: []string{"x", "xy", "y"}},
+		{name: "scale does not matter", query: []float32{10, 1, 0}, k: 1, want: []string{"x"}},
+		{name: "k larger than graph", query: []float32{1, 0.1, 0.05}, k: 10, want: []string{"x", "xy", "y", "z", "-x"}},
+		{name: "tombstoned nodes are skipped", query: []float32{1, 0.1, 0}, k: 2, removed: []string{"x"}, want: []string{"xy", "y"}},
+		{name: "accept filters candidates", query: []float32{1, 0.1, 0}, k: 2, accept: func(key string) bool { return key != "xy" }, want: []string{"x", "y"}},
+		{name: "zero k", query: []float32{1, 0, 0}, k: 0, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestHNSWGraph()
+			ids := map[string]int{}
+			for _, key := range []string{"x", "xy", "y", "z", "-x"} {
+				ids[key] = g.add(key, vectors[key])
+			}
+			for _, key := range tt.removed {
+				g.remove(ids[key])
+			}
+			var accept func(id int) bool
+			if tt.accept != nil {
+				accept = func(id int) bool { return tt.accept(g.nodes[id].key) }
+			}
+
+			got := searchKeys(g, tt.query, tt.k, accept)
+			if len(got) != len(tt.want) {
+				t.Fatalf("search() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("search() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestHNSWGraph_Remove(t *testing.T) {
+	g := newTestHNSWGraph()
+	if got := g.search([]float32{1, 0}, 1, nil); got != nil {
+		t.Fatalf("search() on an empty graph = %v, want nil", got)
+	}
+	a := g.add("a", []float32{1, 0})
+	g.add("b", []float32{0, 1})
+
+	g.remove(a)
+	g.remove(a)
+	g.remove(42)
+	if g.live() != 1 || g.removed != 1 {
+		t.Fatalf("live() = %d, removed = %d, want 1 and 1", g.live(), g.removed)
+	}
+	if got := searchKeys(g, []float32{1, 0}, 2, nil); len(got) != 1 || got[0] != "b" {
+		t.Fatalf("search() = %v, want [b]", got)
+	}
+
+	g.remove(1)
+	if got := g.search([]float32{1, 0}, 1, nil); got != nil {
+		t.Fatalf("search() with every node removed = %v, want nil", got)
+	}
+}
+
+func TestHNSWGraph_Recall(t *testing.T) {
+	const (
+		dimension = 16
+		size      = 2000
+		queries   = 50
+		k         = 10
+	)
+	rng := rand.New(rand.NewSource(7))
+	randomVector := func() []float32 {
+		v := make([]float32, dimension)
+		for i := range v {
+			v[i] = rng.Float32()*2 - 1
+		}
+		return v
+	}
+
+	g := newTestHNSWGraph()
+	for i := 0; i < size; i++ {
+		g.add(strconv.Itoa(i), randomVector())
+	}
+
+	found := 0
+	for q := 0; q < queries; q++ {
+		query := randomVector()
+		normalized := normalize(query)
+		exact := make([]hnswCandidate, len(g.nodes))
+		for id := range g.nodes {
+			exact[id] = hnswCandidate{id: id, dist: g.distance(normalized, id)}
+		}
+		sort.Slice(exact, func(i, j int) bool { return exact[i].dist < exact[j].dist })
+		want := map[int]bool{}
+		for _, c := range exact[:k] {
+			want[c.id] = true
+		}
+
+		got := g.search(query, k, nil)
+		for i, c := range got {
+			if i > 0 && got[i-1].dist > c.dist {
+				t.Fatalf("search() results are not ordered by distance: %v", got)
+			}
+			if want[c.id] {
+				found++
+			}
+		}
+	}
+	if recall := float64(found) / float64(queries*k); recall < 0.9 {
+		t.Fatalf("recall@%d = %.2f, want at least 0.9", k, recall)
+	}
+}
@@ -0,0 +1,253 @@
+package vectordb
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// These tests run against local pgvector and Qdrant instances and are skipped unless the
+// PGVECTOR_TEST_* or QDRANT_TEST_* environment variables point at one, for example:
+//
+//	docker run -d -p 5432:5432 -e POSTGRES_PASSWORD=postgres pgvector/pgvector:pg17
+//	PGVECTOR_TEST_HOST=localhost PGVECTOR_TEST_PASSWORD=postgres go test ./vectordb/...
+//
+//	docker run -d -p 6333:6333 qdrant/qdrant
+//	QDRANT_TEST_HOST=localhost go test ./vectordb/...
+
+const integrationDimension = 4
+
+func pgvectorTestConfig(t *testing.T, metric, ttl string) VectorDBProviderConfig {
+	t.Helper()
+	host := os.Getenv("PGVECTOR_TEST_HOST")
+	if host == "" {
+		t.Skip("PGVECTOR_TEST_HOST is not set; skipping pgvector integration tests")
+	}
+	return VectorDBProviderConfig{
+		VectorStoreProvider: ProviderPGVector,
+		EmbeddingDimension:  strconv.Itoa(integrationDimension),
+		DistanceMetric:      metric,
+		Threshold:           "0.9",
+		DBHost:              host,
+		DBPort:              envInt("PGVECTOR_TEST_PORT", 5432),
+		Username:            envOr("PGVECTOR_TEST_USER", "postgres"),
+		Password:            envOr("PGVECTOR_TEST_PASSWORD", "postgres"),
+		DatabaseName:        envOr("PGVECTOR_TEST_DATABASE", "postgres"),
+		TTL:                 ttl,
+	}
+}
+
+func qdrantTestConfig(t *testing.T, metric, ttl string) VectorDBProviderConfig {
+	t.Helper()
+	host := os.Getenv("QDRANT_TEST_HOST")
+	if host == "" {
+		t.Skip("QDRANT_TEST_HOST is not set; skipping Qdrant integration tests")
+	}
+	return VectorDBProviderConfig{
+		VectorStoreProvider: ProviderQdrant,
+		EmbeddingDimension:  strconv.Itoa(integrationDimension),
+		DistanceMetric:      metric,
+		Threshold:           "0.9",
+		DBHost:              host,
+		DBPort:              envInt("QDRANT_TEST_PORT", 6333),
+		Password:            os.Getenv("QDRANT_TEST_API_KEY"),
+		TTL:                 ttl,
+	}
+}
+
+func TestPGVectorDBProvider_StoreAndRetrieve(t *testing.T) {
+	for _, metric := range []string{DistanceCosine, DistanceL2, DistanceInnerProduct} {
+		t.Run(metric, func(t *testing.T) {
+			runProviderRoundTrip(t, &PGVectorDBProvider{}, pgvectorTestConfig(t, metric, "60"))
+		})
+	}
+}
+
+func TestPGVectorDBProvider_TTL(t *testing.T) {
+	runProviderExpiry(t, &PGVectorDBProvider{}, pgvectorTestConfig(t, DistanceCosine, "1"))
+}
+
+func TestQdrantVectorDBProvider_StoreAndRetrieve(t *testing.T) {
+	for _, metric := range []string{DistanceCosine, DistanceL2, DistanceInnerProduct} {
+		t.Run(metric, func(t *testing.T) {
+			runProviderRoundTrip(t, &QdrantVectorDBProvider{}, qdrantTestConfig(t, metric, "60"))
+		})
+	}
+}
+
+func TestQdrantVectorDBProvider_TTL(t *testing.T) {
+	runProviderExpiry(t, &QdrantVectorDBProvider{}, qdrantTestConfig(t, DistanceCosine, "1"))
+}
+
+func TestPGVectorStore(t *testing.T) {
+	for _, metric := range []string{DistanceCosine, DistanceL2, DistanceInnerProduct} {
+		t.Run(metric, func(t *testing.T) {
+			runStoreRoundTrip(t, &PGVectorStore{}, pgvectorTestConfig(t, metric, "60"))
+		})
+	}
+}
+
+func TestQdrantVectorStore(t *testing.T) {
+	for _, metric := range []string{DistanceCosine, DistanceL2, DistanceInnerProduct} {
+		t.Run(metric, func(t *testing.T) {
+			runStoreRoundTrip(t, &QdrantVectorStore{}, qdrantTestConfig(t, metric, "60"))
+		})
+	}
+}
+
+// runStoreRoundTrip checks that a VectorStore replaces entries by ID, ranks and filters search
+// results within a namespace, and deletes by filter and namespace.
+func runStoreRoundTrip(t *testing.T, store VectorStore, cfg VectorDBProviderConfig) {
+	t.Helper()
+	if err := store.Init(cfg); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	defer store.Close()
+	ctx := context.Background()
+	if err := store.CreateIndex(ctx); err != nil {
+		t.Fatalf("CreateIndex() error = %v", err)
+	}
+
+	namespace, other := uuid.New().String(), uuid.New().String()
+	entries := []Entry{
+		{ID: "a", Namespace: namespace, Embedding: []float32{1, 0, 0, 0}, Payload: []byte("old"), Metadata: map[string]string{"route": "/chat"}},
+		{ID: "a", Namespace: namespace, Embedding: []float32{1, 0, 0, 0}, Payload: []byte("new"), Metadata: map[string]string{"route": "/chat"}},
+		{ID: "b", Namespace: namespace, Embedding: []float32{1, 1, 0, 0}, Metadata: map[string]string{"route": "/chat", "model": "m1"}},
+		{ID: "c", Namespace: namespace, Embedding: []float32{0, 0, 1, 0}, Metadata: map[string]string{"route": "/other"}},
+		{ID: "a", Namespace: other, Embedding: []float32{1, 0, 0, 0}},
+	}
+	for _, e := range entries {
+		if _, err := store.Store(ctx, e); err != nil {
+			t.Fatalf("Store(%s/%s) error = %v", e.Namespace, e.ID, err)
+		}
+	}
+
+	results, err := store.Search(ctx, SearchQuery{Namespace: namespace, Embedding: []float32{1, 0.1, 0, 0}, TopK: 5})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(results) != 3 || results[0].ID != "a" || string(results[0].Payload) != "new" || results[0].Metadata["route"] != "/chat" {
+		t.Fatalf("Search() = %+v, want 3 entries with the replaced entry first", results)
+	}
+	results, err = store.Search(ctx, SearchQuery{Namespace: namespace, Embedding: []float32{1, 0, 0, 0}, TopK: 5, Filter: map[string]string{"model": "m1"}})
+	if err != nil || len(results) != 1 || results[0].ID != "b" {
+		t.Fatalf("Search() with a filter = %+v, %v, want only b", results, err)
+	}
+
+	deleted, err := store.Delete(ctx, namespace, map[string]string{"route": "/chat"})
+	if err != nil || deleted != 2 {
+		t.Fatalf("Delete() = %d, %v, want 2", deleted, err)
+	}
+	if err := store.Flush(ctx, namespace); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if results, _ := store.Search(ctx, SearchQuery{Namespace: namespace, Embedding: []float32{0, 0, 1, 0}, TopK: 5}); len(results) != 0 {
+		t.Fatalf("Search() after Flush() = %+v, want none", results)
+	}
+	if results, _ := store.Search(ctx, SearchQuery{Namespace: other, Embedding: []float32{1, 0, 0, 0}}); len(results) != 1 {
+		t.Fatalf("Flush() touched another namespace: %+v", results)
+	}
+	if err := store.Flush(ctx, other); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+}
+
+// runProviderRoundTrip checks that a stored response is found for its own API and filter
+// attributes only, and that a dissimilar query falls below the threshold.
+func runProviderRoundTrip(t *testing.T, provider VectorDBProvider, cfg VectorDBProviderConfig) {
+	t.Helper()
+	if err := provider.Init(cfg); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	defer provider.Close()
+	if err := provider.CreateIndex(); err != nil {
+		t.Fatalf("CreateIndex() error = %v", err)
+	}
+
+	ctx := context.Background()
+	apiID := uuid.New().String()
+	stored := CacheResponse{ResponsePayload: map[string]interface{}{"answer": "42"}, RequestHash: "hash", StatusCode: "200"}
+	embedding := []float32{1, 0, 0, 0}
+	err := provider.Store(embedding, stored, map[string]interface{}{"ctx": ctx, "api_id": apiID, "route": "/chat"})
+	if err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+
+	filter := func(id string, extra map[string]interface{}) map[string]interface{} {
+		f := map[string]interface{}{"ctx": ctx, "api_id": id, "threshold": cfg.Threshold}
+		for k, v := range extra {
+			f[k] = v
+		}
+		return f
+	}
+
+	got, err := provider.Retrieve(embedding, filter(apiID, map[string]interface{}{"route": "/chat"}))
+	if err != nil {
+		t.Fatalf("Retrieve() error = %v", err)
+	}
+	if got.ResponsePayload["answer"] != "42" || got.RequestHash != stored.RequestHash || got.StatusCode != stored.StatusCode {
+		t.Fatalf("Retrieve() = %+v, want %+v", got, stored)
+	}
+
+	if _, err := provider.Retrieve(embedding, filter(uuid.New().String(), nil)); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Retrieve() for another API error = %v, want ErrNotFound", err)
+	}
+	if _, err := provider.Retrieve(embedding, filter(apiID, map[string]interface{}{"route": "/other"})); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Retrieve() for another route error = %v, want ErrNotFound", err)
+	}
+
+	got, err = provider.Retrieve([]float32{0, 0, -1, 0.2}, filter(apiID, nil))
+	if err != nil {
+		t.Fatalf("Retrieve() of a dissimilar embedding error = %v", err)
+	}
+	if got.RequestHash != "" {
+		t.Fatalf("Retrieve() of a dissimilar embedding = %+v, want an empty response", got)
+	}
+}
+
+// runProviderExpiry checks that entries are no longer returned once their TTL has passed.
+func runProviderExpiry(t *testing.T, provider VectorDBProvider, cfg VectorDBProviderConfig) {
+	t.Helper()
+	if err := provider.Init(cfg); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	defer provider.Close()
+	if err := provider.CreateIndex(); err != nil {
+		t.Fatalf("CreateIndex() error = %v", err)
+	}
+
+	ctx := context.Background()
+	apiID := uuid.New().String()
+	embedding := []float32{0.5, 0.5, 0.5, 0.5}
+	if err := provider.Store(embedding, CacheResponse{RequestHash: "expiring"}, map[string]interface{}{"ctx": ctx, "api_id": apiID}); err != nil {
+		t.Fatalf("Store() error = %v", err)
+	}
+	filter := map[string]interface{}{"ctx": ctx, "api_id": apiID, "threshold": cfg.Threshold}
+	if _, err := provider.Retrieve(embedding, filter); err != nil {
+		t.Fatalf("Retrieve() before expiry error = %v", err)
+	}
+
+	time.Sleep(2100 * time.Millisecond)
+	if _, err := provider.Retrieve(embedding, filter); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Retrieve() after expiry error = %v, want ErrNotFound", err)
+	}
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+func envInt(key string, fallback int) int {
+	if v, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return v
+	}
+	return fallback
+}
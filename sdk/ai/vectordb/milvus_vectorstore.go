@@ -0,0 +1,270 @@
+package vectordb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/index"
+	"github.com/milvus-io/milvus/client/v2/milvusclient"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+const (
+	milvusPrimaryKeyField = "pk"
+	milvusEntryIDField    = "entry_id"
+	milvusCreatedAtField  = "created_at"
+	milvusMaxKeyLength    = 512
+	milvusMaxPayload      = 65535
+)
+
+// MilvusVectorStore implements the VectorStore interface on top of Milvus. It shares its
+// connection settings with MilvusVectorDBProvider but keeps entries in a separate collection.
+// Payloads are stored base64 encoded and metadata as a JSON field used for filtering.
+type MilvusVectorStore struct {
+	provider       *MilvusVectorDBProvider
+	collectionName string
+}
+
+// Init initializes the Milvus vector store with configuration
+func (m *MilvusVectorStore) Init(config VectorDBProviderConfig) error {
+	m.provider = &MilvusVectorDBProvider{}
+	if err := m.provider.Init(config); err != nil {
+		return err
+	}
+	m.collectionName = VectorIndexPrefix + "v2_" + config.EmbeddingDimension
+	return nil
+}
+
+// GetType returns the type of the store
+func (m *MilvusVectorStore) GetType() string {
+	return ProviderMilvus
+}
+
+// CreateIndex creates the Milvus collection for the store
+func (m *MilvusVectorStore) CreateIndex(ctx context.Context) error {
+	exists, err := m.provider.client.HasCollection(ctx, milvusclient.NewHasCollectionOption(m.collectionName))
+	if err != nil {
+		return fmt.Errorf("failed to check if collection '%s' exists: %v", m.collectionName, err)
+	}
+	if exists {
+		return nil
+	}
+
+	schema := entity.NewSchema().
+		WithField(entity.NewField().
+			WithName(milvusPrimaryKeyField).
+			WithDataType(entity.FieldTypeVarChar).
+			WithIsPrimaryKey(true).
+			WithIsAutoID(false).
+			WithMaxLength(milvusMaxKeyLength * 2),
+		).
+		WithField(entity.NewField().
+			WithName(milvusEntryIDField).
+			WithDataType(entity.FieldTypeVarChar).
+			WithMaxLength(milvusMaxKeyLength),
+		).
+		WithField(entity.NewField().
+			WithName(namespaceField).
+			WithDataType(entity.FieldTypeVarChar).
+			WithMaxLength(milvusMaxKeyLength),
+		).
+		WithField(entity.NewField().
+			WithName(milvusCreatedAtField).
+			WithDataType(entity.FieldTypeInt64),
+		).
+		WithField(entity.NewField().
+			WithName(metadataField).
+			WithDataType(entity.FieldTypeJSON),
+		).
+		WithField(entity.NewField().
+			WithName(embeddingField).
+			WithDataType(entity.FieldTypeFloatVector).
+			WithDim(int64(m.provider.dimension)),
+		).
+		WithField(entity.NewField().
+			WithName(payloadField).
+			WithDataType(entity.FieldTypeVarChar).
+			WithMaxLength(milvusMaxPayload),
+		)
+
+	hnswIndex := index.NewHNSWIndex(entity.COSINE, 64, 100)
+	indexOptions := []milvusclient.CreateIndexOption{milvusclient.NewCreateIndexOption(m.collectionName, embeddingField, hnswIndex)}
+
+	err = m.provider.client.CreateCollection(ctx, milvusclient.NewCreateCollectionOption(m.collectionName, schema).
+		WithIndexOptions(indexOptions...).
+		WithProperty(common.CollectionTTLConfigKey, m.provider.ttl))
+	if err != nil {
+		return fmt.Errorf("failed to create collection: %w", err)
+	}
+	return nil
+}
+
+// Store upserts an entry into the collection
+func (m *MilvusVectorStore) Store(ctx context.Context, entry Entry) (string, error) {
+	if entry.Namespace == "" {
+		return "", errNamespaceRequired
+	}
+	if len(entry.Embedding) != m.provider.dimension {
+		return "", fmt.Errorf("embedding dimension mismatch: expected %d, got %d", m.provider.dimension, len(entry.Embedding))
+	}
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+	if len(entry.ID) > milvusMaxKeyLength || len(entry.Namespace) > milvusMaxKeyLength {
+		return "", fmt.Errorf("entry ID and namespace must be at most %d bytes", milvusMaxKeyLength)
+	}
+	payload := base64.StdEncoding.EncodeToString(entry.Payload)
+	if len(payload) > milvusMaxPayload {
+		return "", fmt.Errorf("payload exceeds the maximum size of %d bytes once encoded", milvusMaxPayload)
+	}
+	metadata := entry.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize metadata: %w", err)
+	}
+
+	row := map[string]interface{}{
+		milvusPrimaryKeyField: entry.Namespace + ":" + entry.ID,
+		milvusEntryIDField:    entry.ID,
+		namespaceField:        entry.Namespace,
+		milvusCreatedAtField:  time.Now().Unix(),
+		metadataField:         metadataJSON,
+		embeddingField:        entry.Embedding,
+		payloadField:          payload,
+	}
+	if _, err := m.provider.client.Upsert(ctx, milvusclient.NewRowBasedInsertOption(m.collectionName, row)); err != nil {
+		return "", fmt.Errorf("failed to upsert data into Milvus: %w", err)
+	}
+	return entry.ID, nil
+}
+
+// Search returns the entries of a namespace closest to the query embedding
+func (m *MilvusVectorStore) Search(ctx context.Context, query SearchQuery) ([]SearchResult, error) {
+	if query.Namespace == "" {
+		return nil, errNamespaceRequired
+	}
+	topK := query.TopK
+	if topK <= 0 {
+		topK = 1
+	}
+	loadTask, err := m.provider.client.LoadCollection(ctx, milvusclient.NewLoadCollectionOption(m.collectionName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load collection: %w", err)
+	}
+	if err := loadTask.Await(ctx); err != nil {
+		return nil, fmt.Errorf("error in fetching the collection: %w", err)
+	}
+
+	expr := m.filterExpr(query.Namespace, query.Filter)
+	if m.provider.ttl > 0 {
+		expr += " && " + milvusCreatedAtField + " >= " + strconv.FormatInt(time.Now().Unix()-int64(m.provider.ttl), 10)
+	}
+	resultSets, err := m.provider.client.Search(ctx, milvusclient.NewSearchOption(
+		m.collectionName,
+		topK,
+		[]entity.Vector{entity.FloatVector(query.Embedding)},
+	).WithConsistencyLevel(entity.ClStrong).
+		WithANNSField(embeddingField).
+		WithFilter(expr).
+		WithOutputFields(milvusEntryIDField, metadataField, payloadField))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search in Milvus: %w", err)
+	}
+	if len(resultSets) == 0 {
+		return nil, nil
+	}
+
+	rs := resultSets[0]
+	ids := rs.GetColumn(milvusEntryIDField)
+	metadataCol := rs.GetColumn(metadataField)
+	payloads := rs.GetColumn(payloadField)
+	if ids == nil || metadataCol == nil || payloads == nil {
+		return nil, fmt.Errorf("search result is missing output fields")
+	}
+
+	out := make([]SearchResult, 0, rs.ResultCount)
+	for i := 0; i < rs.ResultCount; i++ {
+		// Milvus COSINE metric returns similarity score (higher is better)
+		score := float64(rs.Scores[i])
+		if score < query.MinScore {
+			continue
+		}
+		id, err := ids.GetAsString(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read entry ID: %w", err)
+		}
+		encoded, err := payloads.GetAsString(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read payload of entry %s: %w", id, err)
+		}
+		payload, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid payload of entry %s: %w", id, err)
+		}
+		rawMetadata, err := metadataCol.Get(i)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read metadata of entry %s: %w", id, err)
+		}
+		var metadata map[string]string
+		if raw, ok := rawMetadata.([]byte); ok && len(raw) > 0 {
+			if err := json.Unmarshal(raw, &metadata); err != nil {
+				return nil, fmt.Errorf("invalid metadata of entry %s: %w", id, err)
+			}
+		}
+		out = append(out, SearchResult{ID: id, Score: score, Payload: payload, Metadata: metadata})
+	}
+	return out, nil
+}
+
+// Delete removes the entries of a namespace matching the metadata filter
+func (m *MilvusVectorStore) Delete(ctx context.Context, namespace string, filter map[string]string) (int, error) {
+	if namespace == "" {
+		return 0, errNamespaceRequired
+	}
+	result, err := m.provider.client.Delete(ctx, milvusclient.NewDeleteOption(m.collectionName).
+		WithExpr(m.filterExpr(namespace, filter)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete from Milvus: %w", err)
+	}
+	return int(result.DeleteCount), nil
+}
+
+// Flush removes every entry of a namespace
+func (m *MilvusVectorStore) Flush(ctx context.Context, namespace string) error {
+	_, err := m.Delete(ctx, namespace, nil)
+	return err
+}
+
+// Close closes the Milvus client connection
+func (m *MilvusVectorStore) Close() error {
+	if m.provider == nil {
+		return nil
+	}
+	return m.provider.Close()
+}
+
+// filterExpr builds the boolean expression selecting a namespace's entries whose metadata
+// contains every filter pair.
+func (m *MilvusVectorStore) filterExpr(namespace string, filter map[string]string) string {
+	terms := []string{namespaceField + " == " + strconv.Quote(namespace)}
+	keys := make([]string, 0, len(filter))
+	for k := range filter {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		terms = append(terms, fmt.Sprintf("%s[%s] == %s", metadataField, strconv.Quote(k), strconv.Quote(filter[k])))
+	}
+	return strings.Join(terms, " && ")
+}
@@ -0,0 +1,253 @@
+package vectordb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// expirySweepInterval is the minimum time between deletions of expired entries
+const expirySweepInterval = time.Minute
+
+// PGVectorDBProvider implements the VectorDBProvider interface for PostgreSQL with the pgvector
+// extension. Postgres has no native row expiry, so entries carry an expires_at column that
+// lookups skip once passed and that writes periodically purge.
+type PGVectorDBProvider struct {
+	tableName string
+	dimension int
+	metric    string
+	ttl       int
+	pool      *pgxpool.Pool
+
+	sweepMu   sync.Mutex
+	lastSweep time.Time
+}
+
+// Init initializes the pgvector provider with configuration
+func (p *PGVectorDBProvider) Init(config VectorDBProviderConfig) error {
+	err := ValidateVectorStoreConfigProps(config)
+	if err != nil {
+		return err
+	}
+	p.dimension, err = strconv.Atoi(config.EmbeddingDimension)
+	if err != nil || p.dimension <= 0 {
+		return fmt.Errorf("invalid embedding dimension: %s", config.EmbeddingDimension)
+	}
+	p.metric, _ = distanceMetric(config.DistanceMetric)
+	p.tableName = VectorIndexPrefix + config.EmbeddingDimension
+
+	p.ttl = DefaultTTL
+	if config.TTL != "" {
+		p.ttl, err = strconv.Atoi(config.TTL)
+		if err != nil {
+			return fmt.Errorf("invalid TTL value: %v", err)
+		}
+	}
+
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(config.Username, config.Password),
+		Host:   config.DBHost + ":" + strconv.Itoa(config.DBPort),
+		Path:   "/" + config.DatabaseName,
+	}
+	p.pool, err = pgxpool.New(context.Background(), u.String())
+	if err != nil {
+		return fmt.Errorf("failed to connect to PostgreSQL: %w", err)
+	}
+	return nil
+}
+
+// GetType returns the type of the provider
+func (p *PGVectorDBProvider) GetType() string {
+	return ProviderPGVector
+}
+
+// CreateIndex creates the pgvector extension, the entries table and an HNSW index for the
+// configured distance metric
+func (p *PGVectorDBProvider) CreateIndex() error {
+	ctx := context.Background()
+	table := pgx.Identifier{p.tableName}.Sanitize()
+	statements := []string{
+		`CREATE EXTENSION IF NOT EXISTS vector`,
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			id UUID PRIMARY KEY,
+			api_id TEXT NOT NULL,
+			embedding vector(%d) NOT NULL,
+			response BYTEA NOT NULL,
+			metadata JSONB NOT NULL DEFAULT '{}'::jsonb,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			expires_at TIMESTAMPTZ
+		)`, table, p.dimension),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s (api_id)`,
+			pgx.Identifier{p.tableName + "_api_id_idx"}.Sanitize(), table),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s (expires_at)`,
+			pgx.Identifier{p.tableName + "_expires_at_idx"}.Sanitize(), table),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING hnsw (embedding %s)`,
+			pgx.Identifier{p.tableName + "_embedding_" + strings.ToLower(p.metric) + "_idx"}.Sanitize(), table, p.operatorClass()),
+	}
+	for _, stmt := range statements {
+		if _, err := p.pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create pgvector index: %w", err)
+		}
+	}
+	return nil
+}
+
+// Store stores the embeddings and associated response in PostgreSQL
+func (p *PGVectorDBProvider) Store(embeddings []float32, response CacheResponse, filter map[string]interface{}) error {
+	pf, err := translateFilter(filter, false)
+	if err != nil {
+		return err
+	}
+	if len(embeddings) != p.dimension {
+		return fmt.Errorf("embedding dimension mismatch: expected %d, got %d", p.dimension, len(embeddings))
+	}
+	responseBytes, err := SerializeObject(response)
+	if err != nil {
+		return err
+	}
+	metadata, err := SerializeObject(pf.attributes)
+	if err != nil {
+		return err
+	}
+	var expiresAt *time.Time
+	if p.ttl > 0 {
+		t := time.Now().Add(time.Duration(p.ttl) * time.Second)
+		expiresAt = &t
+	}
+
+	_, err = p.pool.Exec(pf.ctx, fmt.Sprintf(
+		`INSERT INTO %s (id, api_id, embedding, response, metadata, expires_at) VALUES ($1, $2, $3::vector, $4, $5::jsonb, $6)`,
+		pgx.Identifier{p.tableName}.Sanitize()),
+		uuid.New().String(), pf.apiID, vectorLiteral(embeddings), responseBytes, string(metadata), expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert data into PostgreSQL: %w", err)
+	}
+	p.sweepExpired(pf.ctx)
+	return nil
+}
+
+// Retrieve retrieves the most similar embedding from PostgreSQL
+func (p *PGVectorDBProvider) Retrieve(embeddings []float32, filter map[string]interface{}) (CacheResponse, error) {
+	pf, err := translateFilter(filter, true)
+	if err != nil {
+		return CacheResponse{}, err
+	}
+
+	args := []any{vectorLiteral(embeddings), pf.apiID}
+	where := []string{"api_id = $2", "(expires_at IS NULL OR expires_at > now())"}
+	for k, v := range pf.attributes {
+		args = append(args, k, v)
+		where = append(where, fmt.Sprintf("metadata ->> $%d = $%d", len(args)-1, len(args)))
+	}
+	query := fmt.Sprintf(`SELECT response, embedding %s $1::vector AS distance FROM %s WHERE %s ORDER BY distance LIMIT 1`,
+		p.operator(), pgx.Identifier{p.tableName}.Sanitize(), strings.Join(where, " AND "))
+
+	var respBytes []byte
+	var distance float64
+	err = p.pool.QueryRow(pf.ctx, query, args...).Scan(&respBytes, &distance)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return CacheResponse{}, ErrNotFound
+	}
+	if err != nil {
+		return CacheResponse{}, fmt.Errorf("failed to search in PostgreSQL: %w", err)
+	}
+
+	similarity := p.similarity(distance)
+	if similarity < pf.threshold {
+		return CacheResponse{}, nil
+	}
+	var resp CacheResponse
+	if err := deserializeObject(respBytes, &resp); err != nil {
+		return CacheResponse{}, err
+	}
+	return resp, nil
+}
+
+// Close closes the PostgreSQL connection pool
+func (p *PGVectorDBProvider) Close() error {
+	if p.pool != nil {
+		p.pool.Close()
+	}
+	return nil
+}
+
+// operator returns the pgvector distance operator of the configured metric.
+func (p *PGVectorDBProvider) operator() string {
+	switch p.metric {
+	case DistanceL2:
+		return "<->"
+	case DistanceInnerProduct:
+		return "<#>"
+	default:
+		return "<=>"
+	}
+}
+
+// operatorClass returns the HNSW operator class matching operator.
+func (p *PGVectorDBProvider) operatorClass() string {
+	switch p.metric {
+	case DistanceL2:
+		return "vector_l2_ops"
+	case DistanceInnerProduct:
+		return "vector_ip_ops"
+	default:
+		return "vector_cosine_ops"
+	}
+}
+
+// similarity converts a pgvector distance into a score where higher is closer, so it can be
+// compared against the threshold.
+func (p *PGVectorDBProvider) similarity(distance float64) float64 {
+	switch p.metric {
+	case DistanceL2:
+		return 1 / (1 + distance)
+	case DistanceInnerProduct:
+		// <#> returns the negative inner product
+		return -distance
+	default:
+		return 1 - distance
+	}
+}
+
+// sweepExpired deletes expired entries at most once per expirySweepInterval.
+func (p *PGVectorDBProvider) sweepExpired(ctx context.Context) {
+	if p.ttl <= 0 {
+		return
+	}
+	p.sweepMu.Lock()
+	if time.Since(p.lastSweep) < expirySweepInterval {
+		p.sweepMu.Unlock()
+		return
+	}
+	p.lastSweep = time.Now()
+	p.sweepMu.Unlock()
+
+	_, err := p.pool.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE expires_at <= now()`, pgx.Identifier{p.tableName}.Sanitize()))
+	if err != nil {
+		fmt.Printf("Failed to delete expired pgvector entries: %v\n", err)
+	}
+}
+
+// vectorLiteral formats an embedding as a pgvector text literal.
+func vectorLiteral(embeddings []float32) string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, f := range embeddings {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(float64(f), 'f', -1, 32))
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
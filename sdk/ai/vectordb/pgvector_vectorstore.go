@@ -0,0 +1,201 @@
+package vectordb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// PGVectorStore implements the VectorStore interface on PostgreSQL with the pgvector extension.
+// It shares its connection settings with PGVectorDBProvider but keeps entries in a separate
+// table keyed by namespace and ID. Metadata is stored as JSONB and filtered by containment.
+type PGVectorStore struct {
+	provider *PGVectorDBProvider
+}
+
+// Init initializes the pgvector store with configuration
+func (p *PGVectorStore) Init(config VectorDBProviderConfig) error {
+	p.provider = &PGVectorDBProvider{}
+	if err := p.provider.Init(config); err != nil {
+		return err
+	}
+	// The provider's expiry sweep and distance helpers then work on the store's table
+	p.provider.tableName = VectorIndexPrefix + "v2_" + config.EmbeddingDimension
+	return nil
+}
+
+// GetType returns the type of the store
+func (p *PGVectorStore) GetType() string {
+	return ProviderPGVector
+}
+
+// CreateIndex creates the pgvector extension, the entries table and an HNSW index for the
+// configured distance metric
+func (p *PGVectorStore) CreateIndex(ctx context.Context) error {
+	name := p.provider.tableName
+	table := p.table()
+	statements := []string{
+		`CREATE EXTENSION IF NOT EXISTS vector`,
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+			namespace TEXT NOT NULL,
+			id TEXT NOT NULL,
+			embedding vector(%d) NOT NULL,
+			payload BYTEA,
+			metadata JSONB NOT NULL DEFAULT '{}'::jsonb,
+			created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			expires_at TIMESTAMPTZ,
+			PRIMARY KEY (namespace, id)
+		)`, table, p.provider.dimension),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s (expires_at)`,
+			pgx.Identifier{name + "_expires_at_idx"}.Sanitize(), table),
+		fmt.Sprintf(`CREATE INDEX IF NOT EXISTS %s ON %s USING hnsw (embedding %s)`,
+			pgx.Identifier{name + "_embedding_" + strings.ToLower(p.provider.metric) + "_idx"}.Sanitize(), table, p.provider.operatorClass()),
+	}
+	for _, stmt := range statements {
+		if _, err := p.provider.pool.Exec(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create pgvector index: %w", err)
+		}
+	}
+	return nil
+}
+
+// Store adds an entry, replacing any entry with the same namespace and ID
+func (p *PGVectorStore) Store(ctx context.Context, entry Entry) (string, error) {
+	if entry.Namespace == "" {
+		return "", errNamespaceRequired
+	}
+	if len(entry.Embedding) != p.provider.dimension {
+		return "", fmt.Errorf("embedding dimension mismatch: expected %d, got %d", p.provider.dimension, len(entry.Embedding))
+	}
+	metadata, err := metadataJSON(entry.Metadata)
+	if err != nil {
+		return "", err
+	}
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+	var expiresAt *time.Time
+	if p.provider.ttl > 0 {
+		t := time.Now().Add(time.Duration(p.provider.ttl) * time.Second)
+		expiresAt = &t
+	}
+
+	_, err = p.provider.pool.Exec(ctx, fmt.Sprintf(
+		`INSERT INTO %s (namespace, id, embedding, payload, metadata, expires_at) VALUES ($1, $2, $3::vector, $4, $5::jsonb, $6)
+		ON CONFLICT (namespace, id) DO UPDATE SET embedding = EXCLUDED.embedding, payload = EXCLUDED.payload,
+			metadata = EXCLUDED.metadata, created_at = now(), expires_at = EXCLUDED.expires_at`, p.table()),
+		entry.Namespace, entry.ID, vectorLiteral(entry.Embedding), entry.Payload, metadata, expiresAt)
+	if err != nil {
+		return "", fmt.Errorf("failed to store entry: %w", err)
+	}
+	p.provider.sweepExpired(ctx)
+	return entry.ID, nil
+}
+
+// Search returns the entries of a namespace closest to the query embedding
+func (p *PGVectorStore) Search(ctx context.Context, query SearchQuery) ([]SearchResult, error) {
+	if query.Namespace == "" {
+		return nil, errNamespaceRequired
+	}
+	if len(query.Embedding) != p.provider.dimension {
+		return nil, fmt.Errorf("embedding dimension mismatch: expected %d, got %d", p.provider.dimension, len(query.Embedding))
+	}
+	topK := query.TopK
+	if topK <= 0 {
+		topK = 1
+	}
+	filter, err := metadataJSON(query.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := p.provider.pool.Query(ctx, fmt.Sprintf(
+		`SELECT id, payload, metadata, embedding %s $1::vector AS distance FROM %s
+		WHERE namespace = $2 AND (expires_at IS NULL OR expires_at > now()) AND metadata @> $3::jsonb
+		ORDER BY distance LIMIT $4`, p.provider.operator(), p.table()),
+		vectorLiteral(query.Embedding), query.Namespace, filter, topK)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search in PostgreSQL: %w", err)
+	}
+	defer rows.Close()
+
+	var out []SearchResult
+	for rows.Next() {
+		var (
+			r        SearchResult
+			metadata []byte
+			distance float64
+		)
+		if err := rows.Scan(&r.ID, &r.Payload, &metadata, &distance); err != nil {
+			return nil, fmt.Errorf("failed to read search result: %w", err)
+		}
+		r.Score = p.provider.similarity(distance)
+		if r.Score < query.MinScore {
+			continue
+		}
+		if err := json.Unmarshal(metadata, &r.Metadata); err != nil {
+			return nil, fmt.Errorf("invalid metadata for entry %s: %w", r.ID, err)
+		}
+		if len(r.Metadata) == 0 {
+			r.Metadata = nil
+		}
+		out = append(out, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to search in PostgreSQL: %w", err)
+	}
+	return out, nil
+}
+
+// Delete removes the entries of a namespace matching the metadata filter
+func (p *PGVectorStore) Delete(ctx context.Context, namespace string, filter map[string]string) (int, error) {
+	if namespace == "" {
+		return 0, errNamespaceRequired
+	}
+	match, err := metadataJSON(filter)
+	if err != nil {
+		return 0, err
+	}
+	tag, err := p.provider.pool.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE namespace = $1 AND metadata @> $2::jsonb`, p.table()),
+		namespace, match)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete entries: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// Flush removes every entry of a namespace
+func (p *PGVectorStore) Flush(ctx context.Context, namespace string) error {
+	_, err := p.Delete(ctx, namespace, nil)
+	return err
+}
+
+// Close closes the PostgreSQL connection pool
+func (p *PGVectorStore) Close() error {
+	if p.provider == nil {
+		return nil
+	}
+	return p.provider.Close()
+}
+
+func (p *PGVectorStore) table() string {
+	return pgx.Identifier{p.provider.tableName}.Sanitize()
+}
+
+// metadataJSON encodes metadata as a JSON object; nil encodes as an empty object, which every
+// row's metadata contains.
+func metadataJSON(metadata map[string]string) (string, error) {
+	if metadata == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize metadata: %w", err)
+	}
+	return string(data), nil
+}
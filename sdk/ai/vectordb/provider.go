@@ -1,13 +1,26 @@
 package vectordb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 const (
-	VectorIndexPrefix = "api_platform_semantic_cache_" // VectorIndexPrefix is the prefix for vector index keys in the cache
-	DefaultTTL        = 3600                           // DefaultTTL is the default time-to-live for cache entries in seconds (1 hour)
+	VectorIndexPrefix       = "api_platform_semantic_cache_" // VectorIndexPrefix is the prefix for vector index keys in the cache
+	DefaultTTL              = 3600                           // DefaultTTL is the default time-to-live for cache entries in seconds (1 hour)
+	DefaultSnapshotInterval = 60                             // DefaultSnapshotInterval is the default interval between embedded store snapshots in seconds
 )
 
-// VectorDBProvider defines the interface for vector database providers
+const (
+	DistanceCosine       = "COSINE" // DistanceCosine ranks by cosine similarity
+	DistanceL2           = "L2"     // DistanceL2 ranks by Euclidean distance
+	DistanceInnerProduct = "IP"     // DistanceInnerProduct ranks by inner (dot) product
+)
+
+// VectorDBProvider defines the interface for vector database providers.
+// New code should use VectorStore, which NewVectorDBProviderFromStore adapts to this interface.
 type VectorDBProvider interface {
 	Init(config VectorDBProviderConfig) error
 	GetType() string
@@ -21,7 +34,7 @@ type VectorDBProvider interface {
 type VectorDBProviderConfig struct {
 	VectorStoreProvider string
 	EmbeddingDimension  string
-	DistanceMetric      string
+	DistanceMetric      string // PGVECTOR and QDRANT only: COSINE (default), L2 or IP
 	Threshold           string
 	DBHost              string
 	DBPort              int
@@ -29,11 +42,15 @@ type VectorDBProviderConfig struct {
 	Password            string
 	DatabaseName        string
 	TTL                 string
+	SnapshotPath        string // EMBEDDED only: file the index is persisted to; empty keeps it in memory
+	SnapshotInterval    string // EMBEDDED only: seconds between snapshots, defaults to DefaultSnapshotInterval
 }
 
 // ValidateVectorStoreConfigProps validates the properties of the vector store configuration.
 func ValidateVectorStoreConfigProps(config VectorDBProviderConfig) error {
-	if config.VectorStoreProvider != "REDIS" && config.VectorStoreProvider != "MILVUS" {
+	switch config.VectorStoreProvider {
+	case ProviderRedis, ProviderMilvus, ProviderEmbedded, ProviderPGVector, ProviderQdrant:
+	default:
 		return fmt.Errorf("invalid vector store provider found in the vector store configuration")
 	}
 	if config.EmbeddingDimension == "" {
@@ -42,12 +59,25 @@ func ValidateVectorStoreConfigProps(config VectorDBProviderConfig) error {
 	if config.Threshold == "" {
 		return fmt.Errorf("missing threshold in the vector store configuration")
 	}
+	if config.VectorStoreProvider == ProviderEmbedded {
+		// The embedded store runs in-process and needs no connection details
+		return nil
+	}
 	if config.DBHost == "" {
 		return fmt.Errorf("missing database host in the vector store configuration")
 	}
 	if config.DBPort == 0 || config.DBPort < 0 {
 		return fmt.Errorf("missing/invalid database port in the vector store configuration")
 	}
+	if config.VectorStoreProvider == ProviderPGVector || config.VectorStoreProvider == ProviderQdrant {
+		if _, err := distanceMetric(config.DistanceMetric); err != nil {
+			return err
+		}
+	}
+	if config.VectorStoreProvider == ProviderQdrant {
+		// Qdrant has no users or databases; Password is sent as the API key when set
+		return nil
+	}
 	if config.Username == "" {
 		return fmt.Errorf("missing DB username in the vector store configuration")
 	}
@@ -59,3 +89,58 @@ func ValidateVectorStoreConfigProps(config VectorDBProviderConfig) error {
 	}
 	return nil
 }
+
+// distanceMetric normalizes a configured distance metric, defaulting to cosine.
+func distanceMetric(metric string) (string, error) {
+	switch strings.ToUpper(strings.TrimSpace(metric)) {
+	case "", DistanceCosine:
+		return DistanceCosine, nil
+	case DistanceL2, "EUCLIDEAN":
+		return DistanceL2, nil
+	case DistanceInnerProduct, "INNER_PRODUCT", "DOT":
+		return DistanceInnerProduct, nil
+	default:
+		return "", fmt.Errorf("invalid distance metric %q in the vector store configuration, expected COSINE, L2 or IP", metric)
+	}
+}
+
+// providerFilter is the translated form of a VectorDBProvider filter map.
+type providerFilter struct {
+	ctx        context.Context
+	apiID      string
+	threshold  float64
+	attributes map[string]string // every other key, compared for equality
+}
+
+// reservedFilterKeys are the filter keys with a fixed meaning rather than an attribute match.
+var reservedFilterKeys = map[string]bool{"ctx": true, "api_id": true, "threshold": true}
+
+// translateFilter reads the context, API ID, optional threshold and attribute matches from a
+// VectorDBProvider filter map. Attribute values are compared as strings.
+func translateFilter(filter map[string]interface{}, requireThreshold bool) (providerFilter, error) {
+	ctx, apiID, err := legacyFilterScope(filter)
+	if err != nil {
+		return providerFilter{}, err
+	}
+	if apiID == "" {
+		return providerFilter{}, fmt.Errorf("api_id is required in filter")
+	}
+	pf := providerFilter{ctx: ctx, apiID: apiID, attributes: map[string]string{}}
+	if requireThreshold {
+		thresholdStr, ok := filter["threshold"].(string)
+		if !ok {
+			return providerFilter{}, fmt.Errorf("missing threshold in filter")
+		}
+		pf.threshold, err = strconv.ParseFloat(thresholdStr, 64)
+		if err != nil {
+			return providerFilter{}, fmt.Errorf("invalid threshold: %w", err)
+		}
+	}
+	for k, v := range filter {
+		if reservedFilterKeys[k] || v == nil {
+			continue
+		}
+		pf.attributes[k] = fmt.Sprint(v)
+	}
+	return pf, nil
+}
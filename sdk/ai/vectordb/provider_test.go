@@ -0,0 +1,118 @@
+package vectordb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestValidateVectorStoreConfigProps_Providers(t *testing.T) {
+	remote := VectorDBProviderConfig{
+		EmbeddingDimension: "4",
+		Threshold:          "0.9",
+		DBHost:             "localhost",
+		DBPort:             5432,
+		Username:           "user",
+		Password:           "secret",
+		DatabaseName:       "cache",
+	}
+	tests := []struct {
+		name    string
+		mutate  func(*VectorDBProviderConfig)
+		wantErr bool
+	}{
+		{name: "redis", mutate: func(c *VectorDBProviderConfig) { c.VectorStoreProvider = ProviderRedis }},
+		{name: "milvus", mutate: func(c *VectorDBProviderConfig) { c.VectorStoreProvider = ProviderMilvus }},
+		{name: "pgvector", mutate: func(c *VectorDBProviderConfig) { c.VectorStoreProvider = ProviderPGVector }},
+		{name: "pgvector with l2", mutate: func(c *VectorDBProviderConfig) {
+			c.VectorStoreProvider = ProviderPGVector
+			c.DistanceMetric = "l2"
+		}},
+		{name: "pgvector without database", wantErr: true, mutate: func(c *VectorDBProviderConfig) {
+			c.VectorStoreProvider = ProviderPGVector
+			c.DatabaseName = ""
+		}},
+		{name: "pgvector with unknown metric", wantErr: true, mutate: func(c *VectorDBProviderConfig) {
+			c.VectorStoreProvider = ProviderPGVector
+			c.DistanceMetric = "HAMMING"
+		}},
+		{name: "qdrant without credentials", mutate: func(c *VectorDBProviderConfig) {
+			c.VectorStoreProvider = ProviderQdrant
+			c.Username, c.Password, c.DatabaseName = "", "", ""
+			c.DistanceMetric = "DOT"
+		}},
+		{name: "qdrant without host", wantErr: true, mutate: func(c *VectorDBProviderConfig) {
+			c.VectorStoreProvider = ProviderQdrant
+			c.DBHost = ""
+		}},
+		{name: "unknown provider", wantErr: true, mutate: func(c *VectorDBProviderConfig) { c.VectorStoreProvider = "CHROMA" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := remote
+			tt.mutate(&cfg)
+			err := ValidateVectorStoreConfigProps(cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateVectorStoreConfigProps() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewVectorStore_Providers(t *testing.T) {
+	tests := []struct {
+		name   string
+		config VectorDBProviderConfig
+		want   VectorStore
+	}{
+		{name: "embedded", config: VectorDBProviderConfig{VectorStoreProvider: ProviderEmbedded}, want: &EmbeddedVectorStore{}},
+		{name: "pgvector", config: VectorDBProviderConfig{VectorStoreProvider: ProviderPGVector, DBHost: "localhost", DBPort: 5432,
+			Username: "user", Password: "secret", DatabaseName: "cache"}, want: &PGVectorStore{}},
+		{name: "qdrant", config: VectorDBProviderConfig{VectorStoreProvider: ProviderQdrant, DBHost: "localhost", DBPort: 6333}, want: &QdrantVectorStore{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := tt.config
+			cfg.EmbeddingDimension = "4"
+			cfg.Threshold = "0.9"
+			if err := ValidateVectorStoreConfigProps(cfg); err != nil {
+				t.Fatalf("ValidateVectorStoreConfigProps() error = %v", err)
+			}
+			store, err := NewVectorStore(cfg)
+			if err != nil {
+				t.Fatalf("NewVectorStore() error = %v", err)
+			}
+			defer store.Close()
+			if fmt.Sprintf("%T", store) != fmt.Sprintf("%T", tt.want) || store.GetType() != cfg.VectorStoreProvider {
+				t.Fatalf("NewVectorStore() = %T (%s), want %T", store, store.GetType(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslateFilter(t *testing.T) {
+	ctx := context.Background()
+	pf, err := translateFilter(map[string]interface{}{
+		"ctx":       ctx,
+		"api_id":    "api-1",
+		"threshold": "0.85",
+		"route":     "/chat",
+		"version":   2,
+	}, true)
+	if err != nil {
+		t.Fatalf("translateFilter() error = %v", err)
+	}
+	if pf.apiID != "api-1" || pf.threshold != 0.85 {
+		t.Fatalf("unexpected scope: api_id=%q threshold=%v", pf.apiID, pf.threshold)
+	}
+	if len(pf.attributes) != 2 || pf.attributes["route"] != "/chat" || pf.attributes["version"] != "2" {
+		t.Fatalf("unexpected attributes: %v", pf.attributes)
+	}
+
+	if _, err := translateFilter(map[string]interface{}{"ctx": ctx, "api_id": "api-1"}, true); err == nil {
+		t.Fatal("expected an error for a missing threshold")
+	}
+	if _, err := translateFilter(map[string]interface{}{"ctx": ctx, "api_id": ""}, false); err == nil {
+		t.Fatal("expected an error for an empty api_id")
+	}
+}
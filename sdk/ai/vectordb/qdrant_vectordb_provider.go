@@ -0,0 +1,306 @@
+package vectordb
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	qdrantAPIKeyHeader = "api-key"
+	qdrantTimeout      = 10 * time.Second
+)
+
+// QdrantVectorDBProvider implements the VectorDBProvider interface for Qdrant through its REST
+// API. Qdrant has no native point expiry, so points carry an expires_at payload field that
+// lookups skip once passed and that writes periodically purge.
+type QdrantVectorDBProvider struct {
+	baseURL        string
+	apiKey         string
+	collectionName string
+	dimension      int
+	metric         string
+	ttl            int
+	client         *http.Client
+
+	sweepMu   sync.Mutex
+	lastSweep time.Time
+}
+
+// qdrantCondition is a Qdrant payload filter condition.
+type qdrantCondition map[string]any
+
+type qdrantFilter struct {
+	Must []qdrantCondition `json:"must,omitempty"`
+}
+
+type qdrantScoredPoint struct {
+	ID      any            `json:"id"`
+	Score   float64        `json:"score"`
+	Payload map[string]any `json:"payload"`
+}
+
+// Init initializes the Qdrant provider with configuration
+func (q *QdrantVectorDBProvider) Init(config VectorDBProviderConfig) error {
+	err := ValidateVectorStoreConfigProps(config)
+	if err != nil {
+		return err
+	}
+	q.dimension, err = strconv.Atoi(config.EmbeddingDimension)
+	if err != nil || q.dimension <= 0 {
+		return fmt.Errorf("invalid embedding dimension: %s", config.EmbeddingDimension)
+	}
+	q.metric, _ = distanceMetric(config.DistanceMetric)
+	// The distance is fixed when a collection is created, so each metric gets its own
+	q.collectionName = VectorIndexPrefix + config.EmbeddingDimension
+	if q.metric != DistanceCosine {
+		q.collectionName += "_" + strings.ToLower(q.metric)
+	}
+	q.apiKey = config.Password
+
+	q.ttl = DefaultTTL
+	if config.TTL != "" {
+		q.ttl, err = strconv.Atoi(config.TTL)
+		if err != nil {
+			return fmt.Errorf("invalid TTL value: %v", err)
+		}
+	}
+
+	u := url.URL{Scheme: "http", Host: config.DBHost + ":" + strconv.Itoa(config.DBPort)}
+	q.baseURL = u.String()
+	q.client = &http.Client{Timeout: qdrantTimeout}
+	return nil
+}
+
+// GetType returns the type of the provider
+func (q *QdrantVectorDBProvider) GetType() string {
+	return ProviderQdrant
+}
+
+// CreateIndex creates the Qdrant collection and the payload indexes used for filtering
+func (q *QdrantVectorDBProvider) CreateIndex() error {
+	ctx := context.Background()
+	status, err := q.do(ctx, http.MethodGet, q.collectionPath(""), nil, nil)
+	if err != nil && status != http.StatusNotFound {
+		return fmt.Errorf("failed to check if collection '%s' exists: %w", q.collectionName, err)
+	}
+	if status == http.StatusOK {
+		return nil
+	}
+
+	body := map[string]any{
+		"vectors": map[string]any{
+			"size":     q.dimension,
+			"distance": q.qdrantDistance(),
+		},
+	}
+	if _, err := q.do(ctx, http.MethodPut, q.collectionPath(""), body, nil); err != nil {
+		return fmt.Errorf("failed to create collection: %w", err)
+	}
+	for field, schema := range map[string]string{"api_id": "keyword", "expires_at": "integer"} {
+		index := map[string]any{"field_name": field, "field_schema": schema}
+		if _, err := q.do(ctx, http.MethodPut, q.collectionPath("/index?wait=true"), index, nil); err != nil {
+			return fmt.Errorf("failed to create payload index on %s: %w", field, err)
+		}
+	}
+	return nil
+}
+
+// Store stores the embeddings and associated response in Qdrant
+func (q *QdrantVectorDBProvider) Store(embeddings []float32, response CacheResponse, filter map[string]interface{}) error {
+	pf, err := translateFilter(filter, false)
+	if err != nil {
+		return err
+	}
+	if len(embeddings) != q.dimension {
+		return fmt.Errorf("embedding dimension mismatch: expected %d, got %d", q.dimension, len(embeddings))
+	}
+	responseBytes, err := SerializeObject(response)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]any{
+		"api_id":     pf.apiID,
+		"created_at": time.Now().Unix(),
+		"response":   string(responseBytes),
+	}
+	if q.ttl > 0 {
+		payload["expires_at"] = time.Now().Unix() + int64(q.ttl)
+	}
+	for k, v := range pf.attributes {
+		if _, reserved := payload[k]; !reserved {
+			payload[k] = v
+		}
+	}
+	body := map[string]any{
+		"points": []map[string]any{{
+			"id":      uuid.New().String(),
+			"vector":  embeddings,
+			"payload": payload,
+		}},
+	}
+	if _, err := q.do(pf.ctx, http.MethodPut, q.collectionPath("/points?wait=true"), body, nil); err != nil {
+		return fmt.Errorf("failed to insert data into Qdrant: %w", err)
+	}
+	q.sweepExpired(pf.ctx)
+	return nil
+}
+
+// Retrieve retrieves the most similar embedding from Qdrant
+func (q *QdrantVectorDBProvider) Retrieve(embeddings []float32, filter map[string]interface{}) (CacheResponse, error) {
+	pf, err := translateFilter(filter, true)
+	if err != nil {
+		return CacheResponse{}, err
+	}
+
+	body := map[string]any{
+		"vector":       embeddings,
+		"limit":        1,
+		"with_payload": []string{"response"},
+		"filter":       q.searchFilter(pf),
+	}
+	var result struct {
+		Result []qdrantScoredPoint `json:"result"`
+	}
+	if _, err := q.do(pf.ctx, http.MethodPost, q.collectionPath("/points/search"), body, &result); err != nil {
+		return CacheResponse{}, fmt.Errorf("failed to search in Qdrant: %w", err)
+	}
+	if len(result.Result) == 0 {
+		return CacheResponse{}, ErrNotFound
+	}
+
+	point := result.Result[0]
+	if q.similarity(point.Score) < pf.threshold {
+		return CacheResponse{}, nil
+	}
+	responseStr, ok := point.Payload["response"].(string)
+	if !ok {
+		return CacheResponse{}, fmt.Errorf("missing response in point %v", point.ID)
+	}
+	var resp CacheResponse
+	if err := deserializeObject([]byte(responseStr), &resp); err != nil {
+		return CacheResponse{}, err
+	}
+	return resp, nil
+}
+
+// Close releases idle HTTP connections
+func (q *QdrantVectorDBProvider) Close() error {
+	if q.client != nil {
+		q.client.CloseIdleConnections()
+	}
+	return nil
+}
+
+// searchFilter translates a provider filter into a Qdrant filter that also skips expired points.
+func (q *QdrantVectorDBProvider) searchFilter(pf providerFilter) qdrantFilter {
+	f := qdrantFilter{Must: []qdrantCondition{
+		{"key": "api_id", "match": map[string]any{"value": pf.apiID}},
+	}}
+	if q.ttl > 0 {
+		f.Must = append(f.Must, qdrantCondition{"key": "expires_at", "range": map[string]any{"gt": time.Now().Unix()}})
+	}
+	for k, v := range pf.attributes {
+		f.Must = append(f.Must, qdrantCondition{"key": k, "match": map[string]any{"value": v}})
+	}
+	return f
+}
+
+// qdrantDistance returns the Qdrant name of the configured metric.
+func (q *QdrantVectorDBProvider) qdrantDistance() string {
+	switch q.metric {
+	case DistanceL2:
+		return "Euclid"
+	case DistanceInnerProduct:
+		return "Dot"
+	default:
+		return "Cosine"
+	}
+}
+
+// similarity converts a Qdrant score into a score where higher is closer. Qdrant already
+// reports similarities for cosine and dot product but a distance for Euclid.
+func (q *QdrantVectorDBProvider) similarity(score float64) float64 {
+	if q.metric == DistanceL2 {
+		return 1 / (1 + score)
+	}
+	return score
+}
+
+// sweepExpired deletes expired points at most once per expirySweepInterval.
+func (q *QdrantVectorDBProvider) sweepExpired(ctx context.Context) {
+	if q.ttl <= 0 {
+		return
+	}
+	q.sweepMu.Lock()
+	if time.Since(q.lastSweep) < expirySweepInterval {
+		q.sweepMu.Unlock()
+		return
+	}
+	q.lastSweep = time.Now()
+	q.sweepMu.Unlock()
+
+	body := map[string]any{
+		"filter": qdrantFilter{Must: []qdrantCondition{
+			{"key": "expires_at", "range": map[string]any{"lte": time.Now().Unix()}},
+		}},
+	}
+	if _, err := q.do(ctx, http.MethodPost, q.collectionPath("/points/delete"), body, nil); err != nil {
+		fmt.Printf("Failed to delete expired Qdrant points: %v\n", err)
+	}
+}
+
+func (q *QdrantVectorDBProvider) collectionPath(suffix string) string {
+	return "/collections/" + url.PathEscape(q.collectionName) + suffix
+}
+
+// do sends a request to the Qdrant REST API and decodes the response into out when set. The
+// response status is returned even when the request fails.
+func (q *QdrantVectorDBProvider) do(ctx context.Context, method, path string, body, out any) (int, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return 0, fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, q.baseURL+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if q.apiKey != "" {
+		req.Header.Set(qdrantAPIKeyHeader, q.apiKey)
+	}
+
+	resp, err := q.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("qdrant returned status %d: %s", resp.StatusCode, string(data))
+	}
+	if out != nil {
+		if err := json.Unmarshal(data, out); err != nil {
+			return resp.StatusCode, fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+	return resp.StatusCode, nil
+}
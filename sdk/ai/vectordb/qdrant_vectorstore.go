@@ -0,0 +1,268 @@
+package vectordb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	qdrantEntryIDField  = "entry_id"
+	qdrantMetadataField = "metadata"
+	qdrantScrollBatch   = 1000
+)
+
+// QdrantVectorStore implements the VectorStore interface on Qdrant. It shares its connection
+// settings with QdrantVectorDBProvider but keeps entries in a separate collection. Point IDs are
+// derived from the namespace and entry ID, since Qdrant only accepts UUIDs and integers, and
+// metadata is stored as a nested payload object so filters can match its fields.
+type QdrantVectorStore struct {
+	provider *QdrantVectorDBProvider
+}
+
+// qdrantEntry is the payload of a store point as read back from Qdrant.
+type qdrantEntry struct {
+	EntryID  string            `json:"entry_id"`
+	Payload  []byte            `json:"payload"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+// Init initializes the Qdrant store with configuration
+func (q *QdrantVectorStore) Init(config VectorDBProviderConfig) error {
+	q.provider = &QdrantVectorDBProvider{}
+	if err := q.provider.Init(config); err != nil {
+		return err
+	}
+	// The provider's request and expiry sweep helpers then work on the store's collection
+	q.provider.collectionName = strings.Replace(q.provider.collectionName, VectorIndexPrefix, VectorIndexPrefix+"v2_", 1)
+	return nil
+}
+
+// GetType returns the type of the store
+func (q *QdrantVectorStore) GetType() string {
+	return ProviderQdrant
+}
+
+// CreateIndex creates the Qdrant collection and the payload indexes used for filtering
+func (q *QdrantVectorStore) CreateIndex(ctx context.Context) error {
+	p := q.provider
+	status, err := p.do(ctx, http.MethodGet, p.collectionPath(""), nil, nil)
+	if err != nil && status != http.StatusNotFound {
+		return fmt.Errorf("failed to check if collection '%s' exists: %w", p.collectionName, err)
+	}
+	if status == http.StatusOK {
+		return nil
+	}
+
+	body := map[string]any{
+		"vectors": map[string]any{
+			"size":     p.dimension,
+			"distance": p.qdrantDistance(),
+		},
+	}
+	if _, err := p.do(ctx, http.MethodPut, p.collectionPath(""), body, nil); err != nil {
+		return fmt.Errorf("failed to create collection: %w", err)
+	}
+	for field, schema := range map[string]string{namespaceField: "keyword", "expires_at": "integer"} {
+		index := map[string]any{"field_name": field, "field_schema": schema}
+		if _, err := p.do(ctx, http.MethodPut, p.collectionPath("/index?wait=true"), index, nil); err != nil {
+			return fmt.Errorf("failed to create payload index on %s: %w", field, err)
+		}
+	}
+	return nil
+}
+
+// Store adds an entry, replacing any entry with the same namespace and ID
+func (q *QdrantVectorStore) Store(ctx context.Context, entry Entry) (string, error) {
+	if entry.Namespace == "" {
+		return "", errNamespaceRequired
+	}
+	if len(entry.Embedding) != q.provider.dimension {
+		return "", fmt.Errorf("embedding dimension mismatch: expected %d, got %d", q.provider.dimension, len(entry.Embedding))
+	}
+	if err := validateQdrantMetadata(entry.Metadata); err != nil {
+		return "", err
+	}
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+
+	payload := map[string]any{
+		namespaceField:      entry.Namespace,
+		qdrantEntryIDField:  entry.ID,
+		payloadField:        entry.Payload,
+		qdrantMetadataField: entry.Metadata,
+		"created_at":        time.Now().Unix(),
+	}
+	if q.provider.ttl > 0 {
+		payload["expires_at"] = time.Now().Unix() + int64(q.provider.ttl)
+	}
+	body := map[string]any{
+		"points": []map[string]any{{
+			"id":      qdrantPointID(entry.Namespace, entry.ID),
+			"vector":  entry.Embedding,
+			"payload": payload,
+		}},
+	}
+	if _, err := q.provider.do(ctx, http.MethodPut, q.provider.collectionPath("/points?wait=true"), body, nil); err != nil {
+		return "", fmt.Errorf("failed to store entry: %w", err)
+	}
+	q.provider.sweepExpired(ctx)
+	return entry.ID, nil
+}
+
+// Search returns the entries of a namespace closest to the query embedding
+func (q *QdrantVectorStore) Search(ctx context.Context, query SearchQuery) ([]SearchResult, error) {
+	if query.Namespace == "" {
+		return nil, errNamespaceRequired
+	}
+	if len(query.Embedding) != q.provider.dimension {
+		return nil, fmt.Errorf("embedding dimension mismatch: expected %d, got %d", q.provider.dimension, len(query.Embedding))
+	}
+	topK := query.TopK
+	if topK <= 0 {
+		topK = 1
+	}
+	filter, err := q.filter(query.Namespace, query.Filter, true)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]any{
+		"vector":       query.Embedding,
+		"limit":        topK,
+		"with_payload": []string{qdrantEntryIDField, payloadField, qdrantMetadataField},
+		"filter":       filter,
+	}
+	var result struct {
+		Result []struct {
+			Score   float64     `json:"score"`
+			Payload qdrantEntry `json:"payload"`
+		} `json:"result"`
+	}
+	if _, err := q.provider.do(ctx, http.MethodPost, q.provider.collectionPath("/points/search"), body, &result); err != nil {
+		return nil, fmt.Errorf("failed to search in Qdrant: %w", err)
+	}
+
+	out := make([]SearchResult, 0, len(result.Result))
+	for _, point := range result.Result {
+		score := q.provider.similarity(point.Score)
+		if score < query.MinScore {
+			continue
+		}
+		out = append(out, SearchResult{
+			ID:       point.Payload.EntryID,
+			Score:    score,
+			Payload:  point.Payload.Payload,
+			Metadata: point.Payload.Metadata,
+		})
+	}
+	return out, nil
+}
+
+// Delete removes the entries of a namespace matching the metadata filter. Matching points are
+// listed first so the number removed can be reported.
+func (q *QdrantVectorStore) Delete(ctx context.Context, namespace string, filter map[string]string) (int, error) {
+	if namespace == "" {
+		return 0, errNamespaceRequired
+	}
+	match, err := q.filter(namespace, filter, false)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for {
+		body := map[string]any{
+			"filter":       match,
+			"limit":        qdrantScrollBatch,
+			"with_payload": false,
+			"with_vector":  false,
+		}
+		var result struct {
+			Result struct {
+				Points []struct {
+					ID any `json:"id"`
+				} `json:"points"`
+			} `json:"result"`
+		}
+		if _, err := q.provider.do(ctx, http.MethodPost, q.provider.collectionPath("/points/scroll"), body, &result); err != nil {
+			return deleted, fmt.Errorf("failed to list entries: %w", err)
+		}
+		points := result.Result.Points
+		if len(points) == 0 {
+			return deleted, nil
+		}
+		ids := make([]any, len(points))
+		for i, p := range points {
+			ids[i] = p.ID
+		}
+		if _, err := q.provider.do(ctx, http.MethodPost, q.provider.collectionPath("/points/delete?wait=true"), map[string]any{"points": ids}, nil); err != nil {
+			return deleted, fmt.Errorf("failed to delete entries: %w", err)
+		}
+		deleted += len(ids)
+		if len(points) < qdrantScrollBatch {
+			return deleted, nil
+		}
+	}
+}
+
+// Flush removes every entry of a namespace
+func (q *QdrantVectorStore) Flush(ctx context.Context, namespace string) error {
+	if namespace == "" {
+		return errNamespaceRequired
+	}
+	match, err := q.filter(namespace, nil, false)
+	if err != nil {
+		return err
+	}
+	if _, err := q.provider.do(ctx, http.MethodPost, q.provider.collectionPath("/points/delete?wait=true"), map[string]any{"filter": match}, nil); err != nil {
+		return fmt.Errorf("failed to flush namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
+// Close releases idle HTTP connections
+func (q *QdrantVectorStore) Close() error {
+	if q.provider == nil {
+		return nil
+	}
+	return q.provider.Close()
+}
+
+// filter builds the Qdrant filter selecting a namespace's entries that carry every metadata
+// pair, skipping expired entries when live is set.
+func (q *QdrantVectorStore) filter(namespace string, metadata map[string]string, live bool) (qdrantFilter, error) {
+	if err := validateQdrantMetadata(metadata); err != nil {
+		return qdrantFilter{}, err
+	}
+	f := qdrantFilter{Must: []qdrantCondition{
+		{"key": namespaceField, "match": map[string]any{"value": namespace}},
+	}}
+	if live && q.provider.ttl > 0 {
+		f.Must = append(f.Must, qdrantCondition{"key": "expires_at", "range": map[string]any{"gt": time.Now().Unix()}})
+	}
+	for k, v := range metadata {
+		f.Must = append(f.Must, qdrantCondition{"key": qdrantMetadataField + "." + k, "match": map[string]any{"value": v}})
+	}
+	return f, nil
+}
+
+// validateQdrantMetadata rejects metadata keys that Qdrant would read as a nested path.
+func validateQdrantMetadata(metadata map[string]string) error {
+	for k := range metadata {
+		if k == "" || strings.ContainsAny(k, ".[]") {
+			return fmt.Errorf("metadata key %q must be non-empty and must not contain '.', '[' or ']'", k)
+		}
+	}
+	return nil
+}
+
+// qdrantPointID derives the point ID of an entry, so storing the same ID again replaces it.
+func qdrantPointID(namespace, id string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(namespace+"\x00"+id)).String()
+}
@@ -0,0 +1,271 @@
+package vectordb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	storeKeyPrefix     = "vdoc:"
+	namespaceField     = "namespace"
+	tagsField          = "tags"
+	metadataField      = "metadata"
+	payloadField       = "payload"
+	redisDeleteBatch   = 1000
+	redisTagsSeparator = ","
+)
+
+// RedisVectorStore implements the VectorStore interface on top of RediSearch. It shares its
+// connection settings with RedisVectorDBProvider but keeps entries in a separate index, so both
+// can run against the same Redis. Metadata is indexed as "key=value" tags for filtering.
+type RedisVectorStore struct {
+	provider *RedisVectorDBProvider
+	indexID  string
+}
+
+// Init initializes the Redis vector store with configuration
+func (r *RedisVectorStore) Init(config VectorDBProviderConfig) error {
+	r.provider = &RedisVectorDBProvider{}
+	if err := r.provider.Init(config); err != nil {
+		return err
+	}
+	r.indexID = VectorIndexPrefix + "v2_" + config.EmbeddingDimension
+	return nil
+}
+
+// GetType returns the type of the store
+func (r *RedisVectorStore) GetType() string {
+	return ProviderRedis
+}
+
+// CreateIndex creates the RediSearch index for the store
+func (r *RedisVectorStore) CreateIndex(ctx context.Context) error {
+	if _, err := r.provider.client.Do(ctx, "FT.INFO", r.indexID).Result(); err == nil {
+		return nil
+	}
+	_, err := r.provider.client.FTCreate(ctx,
+		r.indexID,
+		&redis.FTCreateOptions{
+			OnHash: true,
+			Prefix: []any{storeKeyPrefix},
+		},
+		&redis.FieldSchema{
+			FieldName: namespaceField,
+			FieldType: redis.SearchFieldTypeTag,
+		},
+		&redis.FieldSchema{
+			FieldName: tagsField,
+			FieldType: redis.SearchFieldTypeTag,
+			Separator: redisTagsSeparator,
+		},
+		&redis.FieldSchema{
+			FieldName: embeddingField,
+			FieldType: redis.SearchFieldTypeVector,
+			VectorArgs: &redis.FTVectorArgs{
+				HNSWOptions: &redis.FTHNSWOptions{
+					Dim:            r.provider.dimension,
+					DistanceMetric: "COSINE",
+					Type:           "FLOAT32",
+				},
+			},
+		},
+	).Result()
+	if err != nil {
+		return fmt.Errorf("failed to create index %s: %w", r.indexID, err)
+	}
+	return nil
+}
+
+// Store stores an entry as a Redis hash
+func (r *RedisVectorStore) Store(ctx context.Context, entry Entry) (string, error) {
+	if entry.Namespace == "" {
+		return "", errNamespaceRequired
+	}
+	if len(entry.Embedding) != r.provider.dimension {
+		return "", fmt.Errorf("embedding dimension mismatch: expected %d, got %d", r.provider.dimension, len(entry.Embedding))
+	}
+	tags, err := metadataTags(entry.Metadata)
+	if err != nil {
+		return "", err
+	}
+	metadata, err := json.Marshal(entry.Metadata)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize metadata: %w", err)
+	}
+	if entry.ID == "" {
+		entry.ID = uuid.New().String()
+	}
+
+	key := r.key(entry.Namespace, entry.ID)
+	pipe := r.provider.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, map[string]any{
+		namespaceField: entry.Namespace,
+		tagsField:      strings.Join(tags, redisTagsSeparator),
+		metadataField:  metadata,
+		payloadField:   entry.Payload,
+		embeddingField: FloatsToBytes(entry.Embedding),
+	})
+	if r.provider.ttl > 0 {
+		pipe.Expire(ctx, key, time.Duration(r.provider.ttl)*time.Second)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", fmt.Errorf("failed to store entry: %w", err)
+	}
+	return entry.ID, nil
+}
+
+// Search returns the entries of a namespace closest to the query embedding
+func (r *RedisVectorStore) Search(ctx context.Context, query SearchQuery) ([]SearchResult, error) {
+	if query.Namespace == "" {
+		return nil, errNamespaceRequired
+	}
+	topK := query.TopK
+	if topK <= 0 {
+		topK = 1
+	}
+	knnQuery := fmt.Sprintf("(%s)=>[KNN $K @%s $vec AS score]", r.filterQuery(query.Namespace, query.Filter), embeddingField)
+	results, err := r.provider.client.FTSearchWithArgs(ctx, r.indexID, knnQuery, &redis.FTSearchOptions{
+		Return: []redis.FTSearchReturn{
+			{FieldName: payloadField},
+			{FieldName: metadataField},
+			{FieldName: "score"},
+		},
+		SortBy:         []redis.FTSearchSortBy{{FieldName: "score", Asc: true}},
+		LimitOffset:    0,
+		Limit:          topK,
+		DialectVersion: 2,
+		Params: map[string]any{
+			"K":   topK,
+			"vec": FloatsToBytes(query.Embedding),
+		},
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to search index %s: %w", r.indexID, err)
+	}
+
+	out := make([]SearchResult, 0, len(results.Docs))
+	for _, doc := range results.Docs {
+		distance, err := strconv.ParseFloat(doc.Fields["score"], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid score for document %s: %w", doc.ID, err)
+		}
+		// RediSearch reports cosine distance; convert it to similarity
+		score := 1 - distance
+		if score < query.MinScore {
+			continue
+		}
+		var metadata map[string]string
+		if raw := doc.Fields[metadataField]; raw != "" {
+			if err := json.Unmarshal([]byte(raw), &metadata); err != nil {
+				return nil, fmt.Errorf("invalid metadata for document %s: %w", doc.ID, err)
+			}
+		}
+		out = append(out, SearchResult{
+			ID:       strings.TrimPrefix(doc.ID, r.key(query.Namespace, "")),
+			Score:    score,
+			Payload:  []byte(doc.Fields[payloadField]),
+			Metadata: metadata,
+		})
+	}
+	return out, nil
+}
+
+// Delete removes the entries of a namespace matching the metadata filter
+func (r *RedisVectorStore) Delete(ctx context.Context, namespace string, filter map[string]string) (int, error) {
+	if namespace == "" {
+		return 0, errNamespaceRequired
+	}
+	query := r.filterQuery(namespace, filter)
+	deleted := 0
+	for {
+		results, err := r.provider.client.FTSearchWithArgs(ctx, r.indexID, query, &redis.FTSearchOptions{
+			NoContent:      true,
+			LimitOffset:    0,
+			Limit:          redisDeleteBatch,
+			DialectVersion: 2,
+		}).Result()
+		if err != nil {
+			return deleted, fmt.Errorf("failed to search index %s: %w", r.indexID, err)
+		}
+		if len(results.Docs) == 0 {
+			return deleted, nil
+		}
+		keys := make([]string, len(results.Docs))
+		for i, doc := range results.Docs {
+			keys[i] = doc.ID
+		}
+		n, err := r.provider.client.Del(ctx, keys...).Result()
+		if err != nil {
+			return deleted, fmt.Errorf("failed to delete entries: %w", err)
+		}
+		deleted += int(n)
+		if len(results.Docs) < redisDeleteBatch {
+			return deleted, nil
+		}
+	}
+}
+
+// Flush removes every entry of a namespace
+func (r *RedisVectorStore) Flush(ctx context.Context, namespace string) error {
+	_, err := r.Delete(ctx, namespace, nil)
+	return err
+}
+
+// Close closes the Redis client connection
+func (r *RedisVectorStore) Close() error {
+	if r.provider == nil {
+		return nil
+	}
+	return r.provider.Close()
+}
+
+func (r *RedisVectorStore) key(namespace, id string) string {
+	return storeKeyPrefix + namespace + ":" + id
+}
+
+// filterQuery builds the RediSearch query selecting a namespace's entries that carry every
+// filter pair as a tag.
+func (r *RedisVectorStore) filterQuery(namespace string, filter map[string]string) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "@%s:{%s}", namespaceField, escapeTagValue(namespace))
+	tags, _ := metadataTags(filter)
+	for _, tag := range tags {
+		fmt.Fprintf(&sb, " @%s:{%s}", tagsField, escapeTagValue(tag))
+	}
+	return sb.String()
+}
+
+// metadataTags returns the sorted "key=value" tags of a metadata map.
+func metadataTags(metadata map[string]string) ([]string, error) {
+	tags := make([]string, 0, len(metadata))
+	for k, v := range metadata {
+		if strings.Contains(k, redisTagsSeparator) || strings.Contains(v, redisTagsSeparator) {
+			return nil, fmt.Errorf("metadata %q must not contain %q", k, redisTagsSeparator)
+		}
+		tags = append(tags, k+"="+v)
+	}
+	sort.Strings(tags)
+	return tags, nil
+}
+
+// escapeTagValue escapes the characters RediSearch treats as syntax inside a tag query.
+func escapeTagValue(value string) string {
+	var sb strings.Builder
+	for _, c := range value {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
@@ -0,0 +1,220 @@
+package vectordb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+const (
+	ProviderRedis    = "REDIS"    // ProviderRedis is the Redis (RediSearch) vector store provider type
+	ProviderMilvus   = "MILVUS"   // ProviderMilvus is the Milvus vector store provider type
+	ProviderEmbedded = "EMBEDDED" // ProviderEmbedded is the in-process HNSW vector store provider type
+	ProviderPGVector = "PGVECTOR" // ProviderPGVector is the PostgreSQL pgvector provider type
+	ProviderQdrant   = "QDRANT"   // ProviderQdrant is the Qdrant provider type
+)
+
+// ErrNotFound is returned by the legacy VectorDBProvider adapter when no entry matches a lookup
+var ErrNotFound = errors.New("no results found")
+
+var errNamespaceRequired = errors.New("namespace is required")
+
+// VectorStore is the context-aware vector store interface. Entries live in namespaces
+// (typically one per API) and carry an opaque payload plus string metadata that searches
+// and deletes can filter on. Scores are similarities: higher is closer, 1 is identical.
+type VectorStore interface {
+	// Init initializes the store with configuration
+	Init(config VectorDBProviderConfig) error
+	// GetType returns the provider type of the store
+	GetType() string
+	// CreateIndex creates the backing index or collection if it does not exist yet
+	CreateIndex(ctx context.Context) error
+	// Store adds an entry, replacing any entry with the same namespace and ID, and returns its ID
+	Store(ctx context.Context, entry Entry) (string, error)
+	// Search returns up to TopK entries of a namespace, closest first
+	Search(ctx context.Context, query SearchQuery) ([]SearchResult, error)
+	// Delete removes the entries of a namespace whose metadata contains every filter pair,
+	// and returns how many were removed
+	Delete(ctx context.Context, namespace string, filter map[string]string) (int, error)
+	// Flush removes every entry of a namespace
+	Flush(ctx context.Context, namespace string) error
+	// Close releases the store's resources
+	Close() error
+}
+
+// Entry is a vector stored with its payload.
+type Entry struct {
+	ID        string // generated when empty
+	Namespace string
+	Embedding []float32
+	Payload   []byte
+	Metadata  map[string]string
+}
+
+// SearchQuery selects the entries closest to an embedding.
+type SearchQuery struct {
+	Namespace string
+	Embedding []float32
+	TopK      int               // defaults to 1
+	MinScore  float64           // results scoring below this similarity are dropped
+	Filter    map[string]string // only entries whose metadata contains every pair match
+}
+
+// SearchResult is an entry returned by a search, with its similarity to the query.
+type SearchResult struct {
+	ID       string
+	Score    float64
+	Payload  []byte
+	Metadata map[string]string
+}
+
+// NewVectorStore creates and initializes the vector store for the configured provider.
+func NewVectorStore(config VectorDBProviderConfig) (VectorStore, error) {
+	var store VectorStore
+	switch config.VectorStoreProvider {
+	case ProviderRedis:
+		store = &RedisVectorStore{}
+	case ProviderMilvus:
+		store = &MilvusVectorStore{}
+	case ProviderEmbedded:
+		store = &EmbeddedVectorStore{}
+	case ProviderPGVector:
+		store = &PGVectorStore{}
+	case ProviderQdrant:
+		store = &QdrantVectorStore{}
+	default:
+		return nil, fmt.Errorf("unsupported vector store provider: %s", config.VectorStoreProvider)
+	}
+	if err := store.Init(config); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// NewCacheEntry builds an entry holding a cached response.
+func NewCacheEntry(namespace string, embedding []float32, response CacheResponse, metadata map[string]string) (Entry, error) {
+	payload, err := SerializeObject(response)
+	if err != nil {
+		return Entry{}, fmt.Errorf("failed to serialize cache response: %w", err)
+	}
+	return Entry{Namespace: namespace, Embedding: embedding, Payload: payload, Metadata: metadata}, nil
+}
+
+// CacheResponse decodes a result stored with NewCacheEntry.
+func (r SearchResult) CacheResponse() (CacheResponse, error) {
+	var resp CacheResponse
+	if err := deserializeObject(r.Payload, &resp); err != nil {
+		return CacheResponse{}, fmt.Errorf("failed to deserialize cache response: %w", err)
+	}
+	return resp, nil
+}
+
+// matchesFilter reports whether metadata contains every filter pair.
+func matchesFilter(metadata, filter map[string]string) bool {
+	for k, v := range filter {
+		if mv, ok := metadata[k]; !ok || mv != v {
+			return false
+		}
+	}
+	return true
+}
+
+// legacyVectorDBProvider adapts a VectorStore to the VectorDBProvider interface, reading the
+// context, API ID and threshold from the filter map the way the Redis and Milvus providers do.
+type legacyVectorDBProvider struct {
+	store VectorStore
+}
+
+// NewVectorDBProviderFromStore adapts a VectorStore to the VectorDBProvider interface so
+// callers of the original interface can use any store, including the embedded one.
+func NewVectorDBProviderFromStore(store VectorStore) VectorDBProvider {
+	return &legacyVectorDBProvider{store: store}
+}
+
+// Init initializes the underlying store
+func (l *legacyVectorDBProvider) Init(config VectorDBProviderConfig) error {
+	return l.store.Init(config)
+}
+
+// GetType returns the type of the underlying store
+func (l *legacyVectorDBProvider) GetType() string {
+	return l.store.GetType()
+}
+
+// CreateIndex creates the index of the underlying store
+func (l *legacyVectorDBProvider) CreateIndex() error {
+	return l.store.CreateIndex(context.Background())
+}
+
+// Store stores a response under the filter's api_id namespace
+func (l *legacyVectorDBProvider) Store(embeddings []float32, response CacheResponse, filter map[string]interface{}) error {
+	ctx, apiID, err := legacyFilterScope(filter)
+	if err != nil {
+		return err
+	}
+	entry, err := NewCacheEntry(apiID, embeddings, response, nil)
+	if err != nil {
+		return err
+	}
+	_, err = l.store.Store(ctx, entry)
+	return err
+}
+
+// Retrieve returns the closest response of the filter's api_id namespace. An empty response
+// is returned when the closest entry is below the filter's threshold.
+func (l *legacyVectorDBProvider) Retrieve(embeddings []float32, filter map[string]interface{}) (CacheResponse, error) {
+	ctx, apiID, err := legacyFilterScope(filter)
+	if err != nil {
+		return CacheResponse{}, err
+	}
+	if apiID == "" {
+		return CacheResponse{}, errors.New("api_id is required in filter")
+	}
+	thresholdStr, ok := filter["threshold"].(string)
+	if !ok {
+		return CacheResponse{}, fmt.Errorf("missing threshold in filter")
+	}
+	threshold, err := strconv.ParseFloat(thresholdStr, 64)
+	if err != nil {
+		return CacheResponse{}, fmt.Errorf("invalid threshold: %w", err)
+	}
+
+	results, err := l.store.Search(ctx, SearchQuery{Namespace: apiID, Embedding: embeddings, TopK: 1})
+	if err != nil {
+		return CacheResponse{}, err
+	}
+	if len(results) == 0 {
+		return CacheResponse{}, ErrNotFound
+	}
+	if results[0].Score < threshold {
+		return CacheResponse{}, nil
+	}
+	return results[0].CacheResponse()
+}
+
+// Close closes the underlying store
+func (l *legacyVectorDBProvider) Close() error {
+	return l.store.Close()
+}
+
+// legacyFilterScope reads the context and API ID from a VectorDBProvider filter map.
+func legacyFilterScope(filter map[string]interface{}) (context.Context, string, error) {
+	ctxVal, ok := filter["ctx"]
+	if !ok {
+		return nil, "", errors.New("missing 'ctx' key in filter")
+	}
+	ctx, ok := ctxVal.(context.Context)
+	if !ok {
+		return nil, "", fmt.Errorf("'ctx' must be of type context.Context, got %T", ctxVal)
+	}
+	apiIDVal, ok := filter["api_id"]
+	if !ok {
+		return nil, "", errors.New("missing 'api_id' key in filter")
+	}
+	apiID, ok := apiIDVal.(string)
+	if !ok {
+		return nil, "", fmt.Errorf("'api_id' must be of type string, got %T", apiIDVal)
+	}
+	return ctx, apiID, nil
+}
@@ -1,5 +1,7 @@
 package policyv1alpha2
 
+import "time"
+
 // Body represents HTTP request or response body data
 type Body struct {
 	// Content is the body payload (may be nil)
@@ -191,3 +193,67 @@ type ResponseStreamContext struct {
 	// Current response status code
 	ResponseStatus int
 }
+
+// ─── Stream lifecycle context ────────────────────────────────────────────────
+
+// StreamEndReason describes how the ext_proc stream for a request ended.
+type StreamEndReason string
+
+const (
+	// StreamEndCompleted — the exchange finished: the final response phase the
+	// chain takes part in was processed, or an immediate response was sent.
+	StreamEndCompleted StreamEndReason = "completed"
+
+	// StreamEndCancelled — Envoy cancelled the stream before the exchange
+	// finished (client disconnect, upstream reset, route timeout).
+	StreamEndCancelled StreamEndReason = "cancelled"
+
+	// StreamEndClosed — Envoy closed the stream before the exchange finished.
+	StreamEndClosed StreamEndReason = "closed"
+
+	// StreamEndReceiveError — reading the next message from Envoy failed.
+	StreamEndReceiveError StreamEndReason = "receive_error"
+
+	// StreamEndSendError — sending a response to Envoy failed.
+	StreamEndSendError StreamEndReason = "send_error"
+
+	// StreamEndProcessingError — the policy engine could not process a message.
+	StreamEndProcessingError StreamEndReason = "processing_error"
+)
+
+// StreamEndContext is passed to StreamErrorPolicy.OnStreamError and
+// RequestCompletePolicy.OnRequestComplete once the stream for a request has ended.
+// Metadata on the embedded SharedContext holds whatever policies stored during
+// the request and response phases.
+type StreamEndContext struct {
+	*SharedContext
+
+	// Reason is how the stream ended.
+	Reason StreamEndReason
+
+	// Err is the error that ended the stream. Nil when Reason is
+	// StreamEndCompleted or StreamEndClosed.
+	Err error
+
+	// LastPhase is the last ext_proc phase the policy engine received:
+	// "request_headers", "request_body", "response_headers" or "response_body".
+	LastPhase string
+
+	// ResponseStatus is the final status code: the status of the immediate
+	// response when one was sent, otherwise the upstream response status.
+	// Zero when no response reached the policy engine.
+	ResponseStatus int
+
+	// ImmediateResponse reports whether the exchange ended with an immediate
+	// response (a short-circuiting policy or a policy error response).
+	ImmediateResponse bool
+
+	// RequestBodyBytes and ResponseBodyBytes count the body bytes Envoy delivered
+	// to the policy engine, as received (before decompression). They stay zero
+	// for a phase whose body is not processed by the chain.
+	RequestBodyBytes  int64
+	ResponseBodyBytes int64
+
+	// Duration is the time from the request headers to the end of the stream.
+	Duration time.Duration
+}
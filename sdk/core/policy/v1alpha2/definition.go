@@ -1,5 +1,7 @@
 package policyv1alpha2
 
+import "time"
+
 // PolicyParameters holds policy configuration with type-safe validated values
 type PolicyParameters struct {
 	// Raw parameter values as received from xDS config (JSON/YAML)
@@ -51,4 +53,38 @@ type PolicySpec struct {
 	// non-nil = only execute when expression evaluates to true
 	// Expression context: RequestContext (request phase) or ResponseContext (response phase)
 	ExecutionCondition *string `yaml:"executionCondition,omitempty" json:"executionCondition,omitempty"`
+
+	// Optional time budget for a single invocation of the policy
+	// Zero = no per-policy limit (only the ext_proc message timeout applies)
+	Timeout time.Duration `yaml:"timeout,omitempty" json:"timeout,omitempty"`
+
+	// How the engine reacts when an invocation panics, exceeds Timeout or is
+	// rejected by the policy's circuit breaker
+	// Empty = OnErrorFailClosed
+	OnError OnErrorMode `yaml:"onError,omitempty" json:"onError,omitempty"`
+}
+
+// OnErrorMode selects how a failed policy invocation is handled
+type OnErrorMode string
+
+const (
+	// OnErrorFailClosed aborts the request with an error response
+	OnErrorFailClosed OnErrorMode = "fail-closed"
+
+	// OnErrorFailOpen continues the chain without the failed policy's action and
+	// reports the invocation as failed
+	OnErrorFailOpen OnErrorMode = "fail-open"
+
+	// OnErrorSkip continues the chain and reports the policy as skipped
+	OnErrorSkip OnErrorMode = "skip"
+)
+
+// IsValid reports whether m is empty or one of the defined modes
+func (m OnErrorMode) IsValid() bool {
+	switch m {
+	case "", OnErrorFailClosed, OnErrorFailOpen, OnErrorSkip:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,37 @@
+package policyv1alpha2
+
+import (
+	"errors"
+	"net/http"
+)
+
+// PolicyHTTPClient sends HTTP requests on behalf of a policy (callouts to interceptor
+// services, guardrail and embedding providers). The policy engine hands each policy an
+// instance through PolicyMetadata.HTTPClient. It:
+//
+//   - reuses pooled connections shared by all policies;
+//   - propagates the W3C trace context of the request's context (build requests with
+//     http.NewRequestWithContext and the ctx passed to the policy);
+//   - trusts the system CA bundle, the certificates managed by the gateway controller and
+//     any CA configured for the policy engine, and presents the configured client
+//     certificate for mTLS;
+//   - retries idempotent requests on connection errors and 429, 502, 503 and 504 responses
+//     with exponential backoff, honouring Retry-After;
+//   - stops calling a host whose calls keep failing for a while (ErrHTTPCircuitOpen);
+//   - records request count, latency and retries per policy and target host.
+//
+// A request is retried only when its method is idempotent (GET, HEAD, OPTIONS, PUT,
+// DELETE, TRACE) or it carries an Idempotency-Key header, and its body can be replayed
+// (http.NewRequest sets GetBody for bytes, strings and bytes.Buffer readers).
+//
+// *http.Client satisfies PolicyHTTPClient, so policies can fall back to http.DefaultClient
+// when PolicyMetadata.HTTPClient is nil.
+type PolicyHTTPClient interface {
+	// Do sends req and returns the response. As with http.Client, a non-2xx status is not
+	// an error and the caller must close the response body.
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// ErrHTTPCircuitOpen is returned by PolicyHTTPClient.Do without sending the request when
+// recent calls to the target host have failed repeatedly.
+var ErrHTTPCircuitOpen = errors.New("circuit open for target host")
@@ -39,6 +39,16 @@ type PolicyMetadata struct {
 
 	// AttachedTo indicates where the policy is attached (e.g., LevelAPI, LevelRoute).
 	AttachedTo Level
+
+	// StateStore is the policy engine's shared state store, scoped to this policy's name.
+	// With a distributed backend it is shared across policy engine replicas.
+	// Nil when the policy engine has no state store configured.
+	StateStore StateStore
+
+	// HTTPClient sends the policy's outbound HTTP calls through the policy engine's shared
+	// client: pooled connections, trace propagation, retries, per-host circuit breaking and
+	// metrics. Nil outside the policy engine (for example in unit tests).
+	HTTPClient PolicyHTTPClient
 }
 
 // Level defines the attachment level of a policy.
@@ -59,6 +69,10 @@ type ProcessingMode struct {
 	RequestBodyMode    BodyProcessingMode
 	ResponseHeaderMode HeaderProcessingMode
 	ResponseBodyMode   BodyProcessingMode
+
+	// RequestHeaderConcurrency declares whether OnRequestHeaders may run concurrently
+	// with adjacent policies in the chain that declare the same. Empty means sequential.
+	RequestHeaderConcurrency ConcurrencyMode
 }
 
 // HeaderProcessingMode defines how a policy processes headers.
@@ -86,6 +100,39 @@ const (
 	BodyModeStream BodyProcessingMode = "STREAM"
 )
 
+// ConcurrencyMode declares whether a policy's phase method depends on the other
+// policies in the chain.
+//
+// Consecutive policies that declare ConcurrencyReadOnly or ConcurrencyCommutative run
+// concurrently. Each of them sees the request as it was before the group started and
+// gets its own copy of SharedContext.Metadata, so it must replace rather than modify
+// metadata values in place. Once the whole group has returned, the executor applies
+// their actions and metadata changes in chain order, and the first ImmediateResponse
+// in chain order short-circuits the chain: the actions of later policies in the
+// group are discarded although those policies have already run.
+type ConcurrencyMode string
+
+const (
+	// ConcurrencySequential — run in chain order, after every earlier policy's
+	// mutations have been applied. This is the default.
+	ConcurrencySequential ConcurrencyMode = "SEQUENTIAL"
+
+	// ConcurrencyReadOnly — the policy only inspects the request; it returns nil, an
+	// ImmediateResponse or analytics metadata, such as an authorization check or a
+	// guardrail call to an external service.
+	ConcurrencyReadOnly ConcurrencyMode = "READ_ONLY"
+
+	// ConcurrencyCommutative — the policy modifies the request, but its result does not
+	// depend on the modifications of neighbouring policies, and it does not touch
+	// headers or metadata keys that they set.
+	ConcurrencyCommutative ConcurrencyMode = "COMMUTATIVE"
+)
+
+// IsConcurrent reports whether the mode lets the policy run concurrently with its neighbours.
+func (m ConcurrencyMode) IsConcurrent() bool {
+	return m == ConcurrencyReadOnly || m == ConcurrencyCommutative
+}
+
 // ─── Phase-specific sub-interfaces ───────────────────────────────────────────
 
 // RequestHeaderPolicy processes request headers.
@@ -123,9 +170,8 @@ type ResponsePolicy interface {
 // Error handling limitation: if an error occurs after one or more chunks have
 // already been forwarded to upstream, the upstream connection is already in
 // progress and the error cannot be cleanly surfaced — the stream will be
-// aborted. Policies that hold per-stream resources should handle cleanup in
-// their own error paths; there is currently no dedicated error-notification
-// hook on this interface.
+// aborted. Policies that hold per-stream resources should implement
+// StreamErrorPolicy or RequestCompletePolicy to release them.
 type StreamingRequestPolicy interface {
 	RequestPolicy
 	OnRequestBodyChunk(ctx context.Context, reqCtx *RequestStreamContext, chunk *StreamBody, params map[string]interface{}) StreamingRequestAction
@@ -143,11 +189,38 @@ type StreamingRequestPolicy interface {
 // headers are committed to the client. A mid-stream error cannot be surfaced
 // as a clean HTTP error response — Envoy will abort the HTTP/2 stream or reset
 // the connection. ImmediateResponse is silently ignored in this context. There
-// is currently no dedicated error-notification hook on this interface; a future
-// OnStreamError method is planned to allow cleanup of per-stream resources
-// (open connections, partial buffers, token counters for billing).
+// is no error-notification method on this interface; implement StreamErrorPolicy
+// to clean up per-stream resources (open connections, partial buffers, token
+// counters for billing) when the stream aborts.
 type StreamingResponsePolicy interface {
 	ResponsePolicy
 	OnResponseBodyChunk(ctx context.Context, respCtx *ResponseStreamContext, chunk *StreamBody, params map[string]interface{}) StreamingResponseAction
 	NeedsMoreResponseData(accumulated []byte) bool
 }
+
+// ─── Stream lifecycle hooks ──────────────────────────────────────────────────
+
+// StreamErrorPolicy is notified when the stream for a request ends before the
+// exchange completed: the client went away, Envoy reset or closed the stream,
+// or the policy engine failed to receive, process or send a message. Implement
+// this to release per-request resources that a completed exchange would have
+// released (token reservations, upstream sockets, partial cache writes).
+//
+// The hook runs after the stream has ended, so it cannot change the response.
+// ctx is not cancelled with the stream, but the policy's timeout still applies.
+// It is called for every policy in the chain that implements it, in chain
+// order, including policies whose phase methods were skipped for the request.
+type StreamErrorPolicy interface {
+	OnStreamError(ctx context.Context, endCtx *StreamEndContext, params map[string]interface{})
+}
+
+// RequestCompletePolicy is notified once per request when its stream ends,
+// whether or not the exchange completed; check endCtx.Reason to tell them
+// apart. On an aborted stream it runs after every OnStreamError hook, so it is
+// the place for unconditional cleanup and for accounting (final status, body
+// byte counts, duration).
+//
+// The same calling rules as StreamErrorPolicy apply.
+type RequestCompletePolicy interface {
+	OnRequestComplete(ctx context.Context, endCtx *StreamEndContext, params map[string]interface{})
+}
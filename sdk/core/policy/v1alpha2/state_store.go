@@ -0,0 +1,303 @@
+package policyv1alpha2
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// StateStore is a key-value store for state that policies share across requests and, with a
+// distributed backend, across policy engine replicas (rate-limit counters, quotas, locks).
+// The policy engine configures one store at startup and hands it to policies through
+// PolicyMetadata.StateStore, already namespaced by policy name.
+//
+// All operations are atomic with respect to each other for the same key. A ttl <= 0 means the
+// key does not expire. Implementations return an error only when the backend cannot be reached
+// or the stored value has the wrong type; policies decide whether to fail open or closed.
+type StateStore interface {
+	// Get returns the value stored under key. found is false when the key does not exist or
+	// has expired.
+	Get(ctx context.Context, key string) (value []byte, found bool, err error)
+
+	// Set stores value under key, replacing any previous value and expiry.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Delete removes key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+
+	// CompareAndSwap stores newValue under key only if the current value equals oldValue.
+	// A nil oldValue means the key must not exist, which makes CompareAndSwap usable as
+	// set-if-absent. It reports whether the value was stored.
+	CompareAndSwap(ctx context.Context, key string, oldValue, newValue []byte, ttl time.Duration) (swapped bool, err error)
+
+	// IncrBy adds delta to the integer stored under key and returns the new value. A missing
+	// key starts at zero and gets ttl as its expiry; incrementing an existing key keeps its
+	// expiry, so IncrBy with a ttl implements a fixed-window counter.
+	IncrBy(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error)
+
+	// SlidingWindowAdd records weight in the sliding-window log under key, provided the total
+	// weight recorded within the last window stays at or below limit (limit <= 0 means no
+	// limit). It returns the total weight within the window after the call and whether
+	// weight was recorded.
+	SlidingWindowAdd(ctx context.Context, key string, weight, limit int64, window time.Duration) (total int64, added bool, err error)
+
+	// SlidingWindowCount returns the total weight recorded under key within the last window.
+	SlidingWindowCount(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+// ErrStateWrongType is returned when an operation does not match the kind of value stored under
+// the key: IncrBy on a non-integer value, or mixing sliding-window and plain value operations.
+var ErrStateWrongType = errors.New("state value has the wrong type for this operation")
+
+// ─── Key prefixing ───────────────────────────────────────────────────────────
+
+// prefixedStateStore scopes every key of an underlying store under a fixed prefix.
+type prefixedStateStore struct {
+	store  StateStore
+	prefix string
+}
+
+// NewPrefixedStateStore returns a StateStore that stores every key under prefix in store.
+func NewPrefixedStateStore(store StateStore, prefix string) StateStore {
+	if store == nil {
+		return nil
+	}
+	return &prefixedStateStore{store: store, prefix: prefix}
+}
+
+func (s *prefixedStateStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	return s.store.Get(ctx, s.prefix+key)
+}
+
+func (s *prefixedStateStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.store.Set(ctx, s.prefix+key, value, ttl)
+}
+
+func (s *prefixedStateStore) Delete(ctx context.Context, key string) error {
+	return s.store.Delete(ctx, s.prefix+key)
+}
+
+func (s *prefixedStateStore) CompareAndSwap(ctx context.Context, key string, oldValue, newValue []byte, ttl time.Duration) (bool, error) {
+	return s.store.CompareAndSwap(ctx, s.prefix+key, oldValue, newValue, ttl)
+}
+
+func (s *prefixedStateStore) IncrBy(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	return s.store.IncrBy(ctx, s.prefix+key, delta, ttl)
+}
+
+func (s *prefixedStateStore) SlidingWindowAdd(ctx context.Context, key string, weight, limit int64, window time.Duration) (int64, bool, error) {
+	return s.store.SlidingWindowAdd(ctx, s.prefix+key, weight, limit, window)
+}
+
+func (s *prefixedStateStore) SlidingWindowCount(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return s.store.SlidingWindowCount(ctx, s.prefix+key, window)
+}
+
+// ─── In-memory implementation ────────────────────────────────────────────────
+
+// memoryStateSweepInterval is the number of writes between sweeps of expired entries.
+const memoryStateSweepInterval = 1024
+
+type memoryStateEntry struct {
+	value     []byte
+	expiresAt time.Time // zero means no expiry
+
+	// window holds the sliding-window log when the key is used by SlidingWindowAdd.
+	window []memoryWindowEvent
+}
+
+type memoryWindowEvent struct {
+	at     time.Time
+	weight int64
+}
+
+// MemoryStateStore is a process-local StateStore. State is not shared between policy engine
+// replicas, which makes it suitable for single-replica deployments and for policy tests.
+type MemoryStateStore struct {
+	mu      sync.Mutex
+	entries map[string]*memoryStateEntry
+	writes  int
+
+	// now is the clock; replaceable in tests.
+	now func() time.Time
+}
+
+// NewMemoryStateStore creates an empty in-memory state store.
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{
+		entries: make(map[string]*memoryStateEntry),
+		now:     time.Now,
+	}
+}
+
+// SetClock replaces the clock used for expiry and sliding windows. Intended for tests.
+func (s *MemoryStateStore) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// liveLocked returns the entry for key, dropping it when it has expired.
+func (s *MemoryStateStore) liveLocked(key string, now time.Time) *memoryStateEntry {
+	e, ok := s.entries[key]
+	if !ok {
+		return nil
+	}
+	if !e.expiresAt.IsZero() && !now.Before(e.expiresAt) {
+		delete(s.entries, key)
+		return nil
+	}
+	return e
+}
+
+// wroteLocked counts a write and periodically drops expired entries so keys that are never
+// read again do not accumulate.
+func (s *MemoryStateStore) wroteLocked(now time.Time) {
+	s.writes++
+	if s.writes < memoryStateSweepInterval {
+		return
+	}
+	s.writes = 0
+	for key := range s.entries {
+		s.liveLocked(key, now)
+	}
+}
+
+func expiryFrom(now time.Time, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+// Get implements StateStore.
+func (s *MemoryStateStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := s.liveLocked(key, s.now())
+	if e == nil {
+		return nil, false, nil
+	}
+	if e.window != nil {
+		return nil, false, ErrStateWrongType
+	}
+	return bytes.Clone(e.value), true, nil
+}
+
+// Set implements StateStore.
+func (s *MemoryStateStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.entries[key] = &memoryStateEntry{value: bytes.Clone(value), expiresAt: expiryFrom(now, ttl)}
+	s.wroteLocked(now)
+	return nil
+}
+
+// Delete implements StateStore.
+func (s *MemoryStateStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.entries, key)
+	return nil
+}
+
+// CompareAndSwap implements StateStore.
+func (s *MemoryStateStore) CompareAndSwap(_ context.Context, key string, oldValue, newValue []byte, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	e := s.liveLocked(key, now)
+	switch {
+	case oldValue == nil && e != nil:
+		return false, nil
+	case oldValue != nil && e == nil:
+		return false, nil
+	case oldValue != nil && e.window != nil:
+		return false, ErrStateWrongType
+	case oldValue != nil && !bytes.Equal(e.value, oldValue):
+		return false, nil
+	}
+	s.entries[key] = &memoryStateEntry{value: bytes.Clone(newValue), expiresAt: expiryFrom(now, ttl)}
+	s.wroteLocked(now)
+	return true, nil
+}
+
+// IncrBy implements StateStore.
+func (s *MemoryStateStore) IncrBy(_ context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	e := s.liveLocked(key, now)
+	if e == nil {
+		e = &memoryStateEntry{value: []byte("0"), expiresAt: expiryFrom(now, ttl)}
+		s.entries[key] = e
+	}
+	if e.window != nil {
+		return 0, ErrStateWrongType
+	}
+	current, err := strconv.ParseInt(string(e.value), 10, 64)
+	if err != nil {
+		return 0, ErrStateWrongType
+	}
+	current += delta
+	e.value = []byte(strconv.FormatInt(current, 10))
+	s.wroteLocked(now)
+	return current, nil
+}
+
+// trimWindow drops events older than window and returns the total weight of the rest.
+func trimWindow(e *memoryStateEntry, now time.Time, window time.Duration) int64 {
+	cutoff := now.Add(-window)
+	kept := e.window[:0]
+	var total int64
+	for _, ev := range e.window {
+		if ev.at.After(cutoff) {
+			kept = append(kept, ev)
+			total += ev.weight
+		}
+	}
+	e.window = kept
+	return total
+}
+
+// SlidingWindowAdd implements StateStore.
+func (s *MemoryStateStore) SlidingWindowAdd(_ context.Context, key string, weight, limit int64, window time.Duration) (int64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	e := s.liveLocked(key, now)
+	if e == nil {
+		e = &memoryStateEntry{window: []memoryWindowEvent{}}
+		s.entries[key] = e
+	}
+	if e.window == nil {
+		return 0, false, ErrStateWrongType
+	}
+	total := trimWindow(e, now, window)
+	if limit > 0 && total+weight > limit {
+		return total, false, nil
+	}
+	e.window = append(e.window, memoryWindowEvent{at: now, weight: weight})
+	e.expiresAt = now.Add(window)
+	s.wroteLocked(now)
+	return total + weight, true, nil
+}
+
+// SlidingWindowCount implements StateStore.
+func (s *MemoryStateStore) SlidingWindowCount(_ context.Context, key string, window time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	e := s.liveLocked(key, now)
+	if e == nil {
+		return 0, nil
+	}
+	if e.window == nil {
+		return 0, ErrStateWrongType
+	}
+	return trimWindow(e, now, window), nil
+}
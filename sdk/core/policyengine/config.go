@@ -34,6 +34,14 @@ type PolicyInstance struct {
 	// Parameters contains configuration parameters for the policy
 	// Structure depends on the specific policy's schema
 	Parameters map[string]interface{} `json:"parameters" yaml:"parameters"`
+
+	// Timeout is an optional time budget for one invocation of the policy,
+	// as a Go duration string (e.g., "250ms")
+	Timeout string `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// OnError selects how a failed invocation is handled:
+	// "fail-closed" (default), "fail-open" or "skip"
+	OnError string `json:"onError,omitempty" yaml:"onError,omitempty"`
 }
 
 // Configuration represents a collection of policy chains with metadata.